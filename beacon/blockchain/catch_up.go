@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package blockchain
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// catchUpPolicy is the verification policy of a finalized block.
+type catchUpPolicy struct {
+	// relaxed is set if the block is replayed in catch-up mode, in which
+	// the signatures already checked by the validators that finalized it
+	// are not checked again.
+	relaxed bool
+	// submitPayload is set if the execution payload of the block is
+	// submitted to the execution client.
+	submitPayload bool
+}
+
+// catchUpState tracks whether blocks are replayed in catch-up mode. It is
+// only accessed when finalizing blocks.
+type catchUpState struct {
+	cfg CatchUpConfig
+	// active is set while blocks are replayed in catch-up mode.
+	active bool
+}
+
+// policyFor returns the verification policy of a block of the given age,
+// finalized while the node lags the given number of blocks behind its peers,
+// and carrying the execution payload of the given number. It reports whether
+// the block enters or leaves the catch-up mode.
+//
+// Blocks are replayed in catch-up mode only if they are both older than the
+// configured age and far enough behind the head reported by the peers, such
+// that a node with a wrong clock does not skip the verification of the tip.
+// In catch-up mode, only the payload of the last block of each batch is
+// submitted, along with the payload of the last block replayed before the
+// node gets within the configured distance of its peers, so that the
+// execution client is given the final payload before leaving catch-up mode.
+func (c *catchUpState) policyFor(
+	age time.Duration,
+	blocksBehind uint64,
+	payloadNum uint64,
+) (catchUpPolicy, bool) {
+	relaxed := c.cfg.Enabled &&
+		age > c.cfg.MinBlockAge &&
+		blocksBehind > c.cfg.MinBlocksBehind
+	switched := relaxed != c.active
+	c.active = relaxed
+	if !relaxed {
+		return catchUpPolicy{relaxed: false, submitPayload: true}, switched
+	}
+	return catchUpPolicy{
+		relaxed: true,
+		submitPayload: c.cfg.PayloadBatchSize <= 1 ||
+			payloadNum%c.cfg.PayloadBatchSize == 0 ||
+			// The next block may be fully verified.
+			blocksBehind <= c.cfg.MinBlocksBehind+1,
+	}, switched
+}
+
+// catchUpPolicyFor returns the verification policy of the block finalized
+// at the given consensus time, carrying the execution payload of the given
+// number. The node is assumed not to be behind its peers if their head is
+// unknown, in which case the block is fully verified.
+func (s *Service[
+	_, _, _, _, _, _,
+]) catchUpPolicyFor(
+	consensusTime math.U64,
+	payloadNum math.U64,
+) catchUpPolicy {
+	//#nosec:G115 // consensus times are well within int64.
+	age := time.Since(time.Unix(int64(consensusTime.Unwrap()), 0))
+	var behind uint64
+	if s.catchUp.cfg.Enabled && s.syncReporter != nil {
+		var err error
+		if behind, err = s.syncReporter.BlocksBehind(); err != nil {
+			s.logger.Debug("Failed to get the sync status", "error", err)
+			behind = 0
+		}
+	}
+	policy, switched := s.catchUp.policyFor(age, behind, payloadNum.Unwrap())
+	switch {
+	case switched && policy.relaxed:
+		s.logger.Info(
+			"Catching up with relaxed verification",
+			"block_age", age.Round(time.Second),
+			"blocks_behind", behind,
+		)
+	case switched:
+		s.logger.Info("Caught up, resuming full verification")
+	}
+	return policy
+}
+
+// SetSyncReporter sets the reporter of how far the node lags behind its
+// peers, which bounds the catch-up mode. It must be set before the service
+// is started, blocks being fully verified otherwise.
+func (s *Service[
+	_, _, _, _, _, _,
+]) SetSyncReporter(reporter SyncReporter) {
+	s.syncReporter = reporter
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package blockchain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newCatchUpState(batchSize uint64) *catchUpState {
+	return &catchUpState{cfg: CatchUpConfig{
+		Enabled:          true,
+		MinBlockAge:      10 * time.Minute,
+		MinBlocksBehind:  100,
+		PayloadBatchSize: batchSize,
+	}}
+}
+
+func TestCatchUpPolicyThresholds(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		enabled bool
+		age     time.Duration
+		behind  uint64
+		relaxed bool
+	}{
+		{"disabled", false, time.Hour, 1000, false},
+		{"recent block", true, 10 * time.Minute, 1000, false},
+		{"old block", true, 10*time.Minute + time.Second, 1000, true},
+		{"old block near the head", true, time.Hour, 100, false},
+		{"old block without peers", true, time.Hour, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := newCatchUpState(64)
+			c.cfg.Enabled = tt.enabled
+			policy, switched := c.policyFor(tt.age, tt.behind, 1)
+			require.Equal(t, tt.relaxed, policy.relaxed)
+			require.Equal(t, tt.relaxed, switched)
+			require.Equal(t, tt.relaxed, c.active)
+			if !tt.relaxed {
+				require.True(t, policy.submitPayload)
+			}
+		})
+	}
+}
+
+func TestCatchUpPolicyBatchBoundary(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		batchSize uint64
+		submitted []uint64
+	}{
+		{"batch size 0 submits every payload", 0, []uint64{1, 2, 3, 4, 5}},
+		{"batch size 1 submits every payload", 1, []uint64{1, 2, 3, 4, 5}},
+		{"batch size 2", 2, []uint64{2, 4}},
+		{"batch size 4", 4, []uint64{4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := newCatchUpState(tt.batchSize)
+			var submitted []uint64
+			for num := uint64(1); num <= 5; num++ {
+				policy, _ := c.policyFor(time.Hour, 1000, num)
+				require.True(t, policy.relaxed)
+				if policy.submitPayload {
+					submitted = append(submitted, num)
+				}
+			}
+			require.Equal(t, tt.submitted, submitted)
+		})
+	}
+}
+
+func TestCatchUpPolicySwitchBack(t *testing.T) {
+	t.Parallel()
+	c := newCatchUpState(64)
+
+	// The payloads in the middle of a batch are not submitted.
+	policy, switched := c.policyFor(time.Hour, 103, 1)
+	require.True(t, switched)
+	require.Equal(t, catchUpPolicy{relaxed: true}, policy)
+	policy, switched = c.policyFor(time.Hour, 102, 2)
+	require.False(t, switched)
+	require.Equal(t, catchUpPolicy{relaxed: true}, policy)
+
+	// The payload of the last block replayed in catch-up mode is submitted.
+	policy, switched = c.policyFor(time.Hour, 101, 3)
+	require.False(t, switched)
+	require.Equal(t, catchUpPolicy{relaxed: true, submitPayload: true}, policy)
+
+	// The following blocks are fully verified.
+	policy, switched = c.policyFor(time.Hour, 100, 4)
+	require.True(t, switched)
+	require.Equal(t, catchUpPolicy{submitPayload: true}, policy)
+	require.False(t, c.active)
+	policy, switched = c.policyFor(time.Hour, 99, 5)
+	require.False(t, switched)
+	require.Equal(t, catchUpPolicy{submitPayload: true}, policy)
+
+	// Recent blocks are fully verified, however far behind the node is.
+	policy, switched = c.policyFor(time.Minute, 1000, 6)
+	require.False(t, switched)
+	require.Equal(t, catchUpPolicy{submitPayload: true}, policy)
+}
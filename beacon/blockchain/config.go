@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import "time"
+
+const (
+	// defaultCatchUpMinBlockAge is the default age past which blocks are
+	// replayed in catch-up mode.
+	defaultCatchUpMinBlockAge = 10 * time.Minute
+	// defaultCatchUpMinBlocksBehind is the default number of blocks the node
+	// must lag behind its peers to replay blocks in catch-up mode.
+	defaultCatchUpMinBlocksBehind = 128
+	// defaultCatchUpPayloadBatchSize is the default number of blocks whose
+	// execution payloads are submitted at once in catch-up mode.
+	defaultCatchUpPayloadBatchSize = 64
+)
+
+// CatchUpConfig is the configuration of the catch-up mode, in which the
+// blocks far behind the head, already finalized by CometBFT, are replayed
+// with relaxed verification.
+type CatchUpConfig struct {
+	// Enabled enables the catch-up mode.
+	Enabled bool `mapstructure:"enabled"`
+	// MinBlockAge is the age past which blocks are replayed in catch-up mode.
+	// More recent blocks are fully verified.
+	MinBlockAge time.Duration `mapstructure:"min-block-age"`
+	// MinBlocksBehind is the number of blocks the node must lag behind its
+	// peers to replay blocks in catch-up mode, whatever their age.
+	MinBlocksBehind uint64 `mapstructure:"min-blocks-behind"`
+	// PayloadBatchSize is the number of blocks whose execution payloads are
+	// submitted at once. Only the payload of the last block of each batch is
+	// submitted, the execution client fetches the others from its peers.
+	PayloadBatchSize uint64 `mapstructure:"payload-batch-size"`
+}
+
+// DefaultCatchUpConfig returns the default configuration of the catch-up
+// mode.
+func DefaultCatchUpConfig() CatchUpConfig {
+	return CatchUpConfig{
+		Enabled:          false,
+		MinBlockAge:      defaultCatchUpMinBlockAge,
+		MinBlocksBehind:  defaultCatchUpMinBlocksBehind,
+		PayloadBatchSize: defaultCatchUpPayloadBatchSize,
+	}
+}
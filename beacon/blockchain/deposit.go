@@ -22,8 +22,6 @@ package blockchain
 
 import (
 	"context"
-	"maps"
-	"slices"
 	"strconv"
 	"time"
 
@@ -49,6 +47,7 @@ func (s *Service[
 	}
 
 	s.fetchAndStoreDeposits(ctx, blockNum-s.eth1FollowDistance)
+	s.setProgress(progressDepositsScanned, blockNum-s.eth1FollowDistance)
 }
 
 func (s *Service[
@@ -65,9 +64,7 @@ func (s *Service[
 			"block_num",
 			strconv.FormatUint(blockNum.Unwrap(), 10),
 		)
-		s.failedBlocksMu.Lock()
-		s.failedBlocks[blockNum] = struct{}{}
-		s.failedBlocksMu.Unlock()
+		s.markFailedBlock(blockNum)
 		return
 	}
 
@@ -80,15 +77,46 @@ func (s *Service[
 
 	if err = s.storageBackend.DepositStore().EnqueueDeposits(deposits); err != nil {
 		s.logger.Error("Failed to store deposits", "error", err)
-		s.failedBlocksMu.Lock()
-		s.failedBlocks[blockNum] = struct{}{}
-		s.failedBlocksMu.Unlock()
+		s.markFailedBlock(blockNum)
 		return
 	}
 
-	s.failedBlocksMu.Lock()
-	delete(s.failedBlocks, blockNum)
-	s.failedBlocksMu.Unlock()
+	// The first deposit of the block is recorded for the deposit store to be
+	// rewound on rollback.
+	if len(deposits) > 0 {
+		if err = s.storageBackend.DepositStore().SetBlockDeposits(
+			blockNum.Unwrap(), deposits[0].GetIndex().Unwrap(),
+		); err != nil {
+			s.logger.Error(
+				"Failed to record deposits block",
+				"block", blockNum, "error", err,
+			)
+		}
+	}
+
+	if err = s.storageBackend.DepositStore().RemoveFailedBlock(
+		blockNum.Unwrap(),
+	); err != nil {
+		s.logger.Error(
+			"Failed to remove failed deposits block",
+			"block", blockNum, "error", err,
+		)
+	}
+}
+
+// markFailedBlock persists the execution block whose deposits failed to be
+// fetched, so that fetching is retried even if the node restarts meanwhile.
+func (s *Service[
+	_, _, ConsensusBlockT, _, _, _,
+]) markFailedBlock(blockNum math.U64) {
+	if err := s.storageBackend.DepositStore().AddFailedBlock(
+		blockNum.Unwrap(),
+	); err != nil {
+		s.logger.Error(
+			"Failed to store failed deposits block",
+			"block", blockNum, "error", err,
+		)
+	}
 }
 
 func (s *Service[
@@ -101,9 +129,11 @@ func (s *Service[
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			s.failedBlocksMu.RLock()
-			failedBlks := slices.Collect(maps.Keys(s.failedBlocks))
-			s.failedBlocksMu.RUnlock()
+			failedBlks, err := s.storageBackend.DepositStore().GetFailedBlocks()
+			if err != nil {
+				s.logger.Error("Failed to load failed deposits blocks", "error", err)
+				continue
+			}
 			if len(failedBlks) == 0 {
 				continue
 			}
@@ -115,7 +145,7 @@ func (s *Service[
 
 			// Fetch deposits for blocks that failed to be processed.
 			for _, blockNum := range failedBlks {
-				s.fetchAndStoreDeposits(ctx, blockNum)
+				s.fetchAndStoreDeposits(ctx, math.U64(blockNum))
 			}
 		}
 	}
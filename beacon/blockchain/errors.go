@@ -29,4 +29,7 @@ var (
 	ErrNilBlob = errors.New("nil blob")
 	// ErrDataNotAvailable indicates that the required data is not available.
 	ErrDataNotAvailable = errors.New("data not available")
+	// ErrStopTimeout is an error for when the background tasks of the
+	// service do not complete in time on shutdown.
+	ErrStopTimeout = errors.New("timed out stopping the blockchain service")
 )
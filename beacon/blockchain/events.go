@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"github.com/berachain/beacon-kit/beacon/events"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// publishBlockEvents publishes the events of a finalized block and of its blob
+// sidecars. Blocks are final once committed by CometBFT, so each block is the
+// new head and the first block of an epoch is its finalized checkpoint.
+func (s *Service[
+	_, _, _, _, _, _,
+]) publishBlockEvents(
+	blk *ctypes.BeaconBlock,
+	sidecars datypes.BlobSidecars,
+) {
+	if s.eventBus == nil {
+		return
+	}
+
+	var (
+		slot            = blk.GetSlot()
+		blockRoot       = blk.HashTreeRoot()
+		stateRoot       = blk.GetStateRoot()
+		optimistic      = s.executionEngine.IsSyncing()
+		epochTransition = slot.Unwrap()%s.chainSpec.SlotsPerEpoch() == 0
+	)
+	s.eventBus.Publish(events.TopicBlock, &events.Block{
+		Slot:                slot.Unwrap(),
+		Block:               blockRoot,
+		ExecutionOptimistic: optimistic,
+	})
+	for _, sidecar := range sidecars {
+		commitment := sidecar.GetKzgCommitment()
+		s.eventBus.Publish(events.TopicBlobSidecar, &events.BlobSidecar{
+			BlockRoot:     blockRoot,
+			Index:         sidecar.GetIndex(),
+			Slot:          slot.Unwrap(),
+			KzgCommitment: commitment,
+			VersionedHash: common.ExecutionHash(
+				commitment.ToVersionedHash(),
+			),
+		})
+	}
+	// There are no attester duties, hence no duty dependent roots.
+	s.eventBus.Publish(events.TopicHead, &events.Head{
+		Slot:                slot.Unwrap(),
+		Block:               blockRoot,
+		State:               stateRoot,
+		EpochTransition:     epochTransition,
+		ExecutionOptimistic: optimistic,
+	})
+	if epochTransition {
+		s.eventBus.Publish(
+			events.TopicFinalizedCheckpoint,
+			&events.FinalizedCheckpoint{
+				Block:               blockRoot,
+				State:               stateRoot,
+				Epoch:               s.chainSpec.SlotToEpoch(slot).Unwrap(),
+				ExecutionOptimistic: optimistic,
+			},
+		)
+	}
+}
@@ -25,7 +25,7 @@ import (
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
-	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/primitives/math"
 )
 
 // sendPostBlockFCU sends a forkchoice update to the execution client after a
@@ -37,22 +37,25 @@ func (s *Service[
 	_, _, ConsensusBlockT, _, _, _,
 ]) sendPostBlockFCU(
 	ctx context.Context,
-	st *statedb.StateDB,
+	lph *ctypes.ExecutionPayloadHeader,
 	blk ConsensusBlockT,
 ) {
-	lph, err := st.GetLatestExecutionPayloadHeader()
-	if err != nil {
-		s.logger.Error(
-			"failed to get latest execution payload in postBlockProcess",
-			"error", err,
-		)
-		return
-	}
+	s.sendHeadFCU(ctx, lph, blk.GetBeaconBlock().GetSlot())
+}
 
+// sendHeadFCU notifies the execution client that the given payload, of the
+// block of the given slot, is the head, and records it as the last head
+// submitted to the execution client.
+func (s *Service[
+	_, _, _, _, _, _,
+]) sendHeadFCU(
+	ctx context.Context,
+	lph *ctypes.ExecutionPayloadHeader,
+	slot math.Slot,
+) {
 	// Send a forkchoice update without payload attributes to notify
 	// EL of the new head.
-	beaconBlk := blk.GetBeaconBlock()
-	if _, _, err = s.executionEngine.NotifyForkchoiceUpdate(
+	if _, _, err := s.executionEngine.NotifyForkchoiceUpdate(
 		ctx,
 		// TODO: Switch to New().
 		ctypes.
@@ -62,12 +65,14 @@ func (s *Service[
 					SafeBlockHash:      lph.GetParentHash(),
 					FinalizedBlockHash: lph.GetParentHash(),
 				},
-				s.chainSpec.ActiveForkVersionForSlot(beaconBlk.GetSlot()),
+				s.chainSpec.ActiveForkVersionForSlot(slot),
 			),
 	); err != nil {
 		s.logger.Error(
 			"failed to send forkchoice update without attributes",
 			"error", err,
 		)
+		return
 	}
+	s.setProgress(progressForkchoiceHead, lph.GetNumber())
 }
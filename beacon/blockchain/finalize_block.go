@@ -26,6 +26,8 @@ import (
 
 	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
 	"github.com/berachain/beacon-kit/consensus/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
@@ -55,18 +57,34 @@ func (s *Service[
 				math.Slot(req.Height),
 			))
 	if err != nil {
+		s.reportMissedSlot(ctx, req)
 		//nolint:nilerr // If we don't have a block, we can't do anything.
 		return nil, nil
 	}
 
+	// The entries logged while finalizing the block are about it.
+	ctx = ctx.WithContext(log.ContextWithFields(
+		ctx.Context(),
+		log.SlotKey, blk.GetSlot(),
+		log.BlockRootKey, blk.HashTreeRoot(),
+	))
+	logger := log.WithContext(s.logger, ctx)
+
+	// Resume following the execution layer from where the node was before
+	// it restarted, before the block moves the head further.
+	s.resumeSyncOnce.Do(func() {
+		s.resumeSync(ctx, s.storageBackend.StateFromContext(ctx))
+	})
+
 	// STEP 2: Finalize sidecars first (block will check for
 	// sidecar availability)
 	err = s.blobProcessor.ProcessSidecars(
+		ctx,
 		s.storageBackend.AvailabilityStore(),
 		blobs,
 	)
 	if err != nil {
-		s.logger.Error("Failed to process blob sidecars", "error", err)
+		logger.Error("Failed to process blob sidecars", "error", err)
 	}
 
 	// STEP 3: finalize the block
@@ -75,6 +93,7 @@ func (s *Service[
 		blk,
 		req.GetProposerAddress(),
 		req.GetTime(),
+		encoding.MisbehaviorsFromABCI(req.GetMisbehavior()),
 	)
 
 	cBlk, ok := any(consensusBlk).(ConsensusBlockT)
@@ -82,10 +101,21 @@ func (s *Service[
 		panic("failed to convert consensusBlk to ConsensusBlockT")
 	}
 
-	st := s.storageBackend.StateFromContext(ctx)
-	valUpdates, finalizeErr = s.finalizeBeaconBlock(ctx, st, cBlk)
+	// Blocks far behind the head are replayed with relaxed verification, if
+	// the catch-up mode is enabled.
+	policy := s.catchUpPolicyFor(
+		cBlk.GetConsensusTime(),
+		blk.GetBody().GetExecutionPayload().GetNumber(),
+	)
+
+	// The mutations of the beacon state are accumulated while the block is
+	// processed, then committed to the store at once. They are committed even
+	// if processing failed, as the block is final either way.
+	st, commit := s.storageBackend.StateFromContext(ctx).Batch()
+	valUpdates, finalizeErr = s.finalizeBeaconBlock(ctx, st, cBlk, policy)
+	commit()
 	if finalizeErr != nil {
-		s.logger.Error("Failed to process verified beacon block",
+		logger.Error("Failed to process verified beacon block",
 			"error", finalizeErr,
 		)
 	}
@@ -97,20 +127,41 @@ func (s *Service[
 	s.depositFetcher(ctx, blockNum)
 
 	// store the finalized block in the KVStore.
-	slot := blk.GetSlot()
 	if err = s.storageBackend.BlockStore().Set(blk); err != nil {
-		s.logger.Error(
-			"failed to store block", "slot", slot, "error", err,
-		)
+		logger.Error("failed to store block", "error", err)
 	}
 
 	// prune the availability and deposit store
 	err = s.processPruning(blk)
 	if err != nil {
-		s.logger.Error("failed to processPruning", "error", err)
+		logger.Error("failed to processPruning", "error", err)
+	}
+
+	if finalizeErr == nil {
+		s.publishBlockEvents(blk, blobs)
+		for _, o := range s.slotObservers {
+			o.OnBlockFinalized(
+				blk, req.GetProposerAddress(), cBlk.GetConsensusTime(),
+			)
+		}
 	}
 
-	go s.sendPostBlockFCU(ctx, st, cBlk)
+	// Read the new head before handing it over, as the state is committed
+	// concurrently with the forkchoice update.
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		logger.Error(
+			"failed to get latest execution payload in postBlockProcess",
+			"error", err,
+		)
+		return valUpdates, nil
+	}
+	// In catch-up mode, the head is only submitted along with the payloads.
+	if policy.submitPayload {
+		s.goTask(ctx, func(ctx context.Context) {
+			s.sendPostBlockFCU(ctx, lph, cBlk)
+		})
+	}
 
 	return valUpdates, nil
 }
@@ -123,6 +174,7 @@ func (s *Service[
 	ctx context.Context,
 	st *statedb.StateDB,
 	blk ConsensusBlockT,
+	policy catchUpPolicy,
 ) (transition.ValidatorUpdates, error) {
 	beaconBlk := blk.GetBeaconBlock()
 
@@ -131,7 +183,7 @@ func (s *Service[
 		return nil, ErrNilBlk
 	}
 
-	valUpdates, err := s.executeStateTransition(ctx, st, blk)
+	valUpdates, err := s.executeStateTransition(ctx, st, blk, policy)
 	if err != nil {
 		return nil, err
 	}
@@ -154,9 +206,15 @@ func (s *Service[
 	ctx context.Context,
 	st *statedb.StateDB,
 	blk ConsensusBlockT,
+	policy catchUpPolicy,
 ) (transition.ValidatorUpdates, error) {
 	startTime := time.Now()
 	defer s.metrics.measureStateTransitionDuration(startTime)
+	ctx, span := tracing.Start(
+		ctx, "blockchain.ExecuteStateTransition",
+		tracing.Slot(blk.GetBeaconBlock().GetSlot()),
+	)
+	defer span.End()
 	valUpdates, err := s.stateProcessor.Transition(
 		&transition.Context{
 			Context: ctx,
@@ -181,13 +239,24 @@ func (s *Service[
 			// of validators in their process proposal call and thus
 			// the "verification aspect" of this NewPayload call is
 			// actually irrelevant at this point.
-			SkipPayloadVerification: false,
+			//
+			// In catch-up mode, the payloads are submitted in batches: the
+			// execution client fetches the payloads of the rest of each
+			// batch from its peers when given its last one.
+			SkipPayloadVerification: !policy.submitPayload,
+
+			// In catch-up mode, the RANDAO reveal is not checked again, as
+			// the block was already verified by the validators that
+			// finalized it.
+			SkipValidateRandao: policy.relaxed,
 
 			ProposerAddress: blk.GetProposerAddress(),
 			ConsensusTime:   blk.GetConsensusTime(),
+			Misbehaviors:    blk.GetMisbehaviors(),
 		},
 		st,
 		blk.GetBeaconBlock(),
 	)
+	tracing.RecordError(span, err)
 	return valUpdates, err
 }
@@ -41,8 +41,10 @@ func (s *Service[
 		return nil, err
 	}
 
+	// The genesis state is committed to the store at once when initialized.
+	st, commit := s.storageBackend.StateFromContext(ctx).Batch()
 	validatorUpdates, err := s.stateProcessor.InitializePreminedBeaconStateFromEth1(
-		s.storageBackend.StateFromContext(ctx),
+		st,
 		genesisData.GetDeposits(),
 		genesisData.GetExecutionPayloadHeader(),
 		genesisData.GetForkVersion(),
@@ -50,6 +52,7 @@ func (s *Service[
 	if err != nil {
 		return nil, err
 	}
+	commit()
 
 	// After deposits are validated, store the genesis deposits in the deposit store.
 	if err = s.storageBackend.DepositStore().EnqueueDeposits(
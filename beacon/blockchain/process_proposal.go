@@ -31,6 +31,8 @@ import (
 	"github.com/berachain/beacon-kit/consensus/types"
 	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
@@ -55,11 +57,12 @@ func (s *Service[
 	req *cmtabci.ProcessProposalRequest,
 ) (*cmtabci.ProcessProposalResponse, error) {
 	// Decode the beacon block.
+	forkVersion := s.chainSpec.ActiveForkVersionForSlot(math.U64(req.Height))
 	blk, err := encoding.
 		UnmarshalBeaconBlockFromABCIRequest(
 			req,
 			BeaconBlockTxIndex,
-			s.chainSpec.ActiveForkVersionForSlot(math.U64(req.Height)),
+			forkVersion,
 		)
 	if err != nil {
 		return createProcessProposalResponse(errors.WrapNonFatal(err))
@@ -70,16 +73,25 @@ func (s *Service[
 		return createProcessProposalResponse(errors.WrapNonFatal(ErrNilBlk))
 	}
 
+	// The entries logged while verifying the block are about it.
+	ctx = ctx.WithContext(log.ContextWithFields(
+		ctx.Context(),
+		log.SlotKey, blk.GetSlot(),
+		log.BlockRootKey, blk.HashTreeRoot(),
+	))
+	logger := log.WithContext(s.logger, ctx)
+
 	// Decode the blob sidecars.
 	sidecars, err := encoding.
 		UnmarshalBlobSidecarsFromABCIRequest(
 			req,
 			BlobSidecarsTxIndex,
+			forkVersion,
 		)
 	if err != nil {
 		return createProcessProposalResponse(errors.WrapNonFatal(err))
 	} else if sidecars.IsNil() {
-		s.logger.Warn(
+		logger.Warn(
 			"Aborting block verification - blob sidecars not found in proposal",
 		)
 		return createProcessProposalResponse(errors.WrapNonFatal(ErrNilBlob))
@@ -113,7 +125,7 @@ func (s *Service[
 			consensusSidecars,
 		)
 		if err != nil {
-			s.logger.Error(
+			logger.Error(
 				"failed to verify incoming blob sidecars",
 				"error", err,
 			)
@@ -127,15 +139,17 @@ func (s *Service[
 		blk,
 		req.GetProposerAddress(),
 		req.GetTime(),
+		encoding.MisbehaviorsFromABCI(req.GetMisbehavior()),
 	)
 	err = s.VerifyIncomingBlock(
 		ctx,
 		consensusBlk.GetBeaconBlock(),
 		consensusBlk.GetConsensusTime(),
 		consensusBlk.GetProposerAddress(),
+		consensusBlk.GetMisbehaviors(),
 	)
 	if err != nil {
-		s.logger.Error("failed to verify incoming block", "error", err)
+		logger.Error("failed to verify incoming block", "error", err)
 		return createProcessProposalResponse(errors.WrapNonFatal(err))
 	}
 
@@ -153,7 +167,8 @@ func (s *Service[
 ) error {
 	sidecars := cSidecars.GetSidecars()
 
-	s.logger.Info("Received incoming blob sidecars")
+	logger := log.WithContext(s.logger, ctx)
+	logger.Info("Received incoming blob sidecars")
 
 	// TODO: Clean this up once we remove generics.
 	cs := convertConsensusSidecars[ConsensusSidecarsT](cSidecars)
@@ -163,7 +178,7 @@ func (s *Service[
 		s.storageBackend.StateFromContext(ctx),
 	)
 	if err != nil {
-		s.logger.Error(
+		logger.Error(
 			"an error incurred while calculating the sidecar verifier",
 			"reason", err,
 		)
@@ -171,16 +186,16 @@ func (s *Service[
 	}
 
 	// Verify the blobs and ensure they match the local state.
-	err = s.blobProcessor.VerifySidecars(cs, sidecarVerifierFn)
+	err = s.blobProcessor.VerifySidecars(ctx, cs, sidecarVerifierFn)
 	if err != nil {
-		s.logger.Error(
+		logger.Error(
 			"rejecting incoming blob sidecars",
 			"reason", err,
 		)
 		return err
 	}
 
-	s.logger.Info(
+	logger.Info(
 		"Blob sidecars verification succeeded - accepting incoming blob sidecars",
 		"num_blobs",
 		len(sidecars),
@@ -198,6 +213,7 @@ func (s *Service[
 	beaconBlk *ctypes.BeaconBlock,
 	consensusTime math.U64,
 	proposerAddress []byte,
+	misbehaviors []*transition.Misbehavior,
 ) error {
 	// Grab a copy of the state to verify the incoming block.
 	preState := s.storageBackend.StateFromContext(ctx)
@@ -208,9 +224,10 @@ func (s *Service[
 	// ideally via some broader sync service.
 	s.forceStartupSyncOnce.Do(func() { s.forceStartupHead(ctx, preState) })
 
-	s.logger.Info(
+	logger := log.WithContext(s.logger, ctx)
+	logger.Info(
 		"Received incoming beacon block",
-		"state_root", beaconBlk.GetStateRoot(), "slot", beaconBlk.GetSlot(),
+		"state_root", beaconBlk.GetStateRoot(),
 	)
 
 	// We purposefully make a copy of the BeaconState in order
@@ -225,9 +242,11 @@ func (s *Service[
 		postState,
 		beaconBlk,
 		consensusTime,
-		proposerAddress)
+		proposerAddress,
+		misbehaviors,
+	)
 	if err != nil {
-		s.logger.Error(
+		logger.Error(
 			"Rejecting incoming beacon block ❌ ",
 			"state_root",
 			beaconBlk.GetStateRoot(),
@@ -242,21 +261,22 @@ func (s *Service[
 				return err
 			}
 
-			go s.handleRebuildPayloadForRejectedBlock(
-				ctx,
-				preState,
-				payloadtime.Next(
-					consensusTime,
-					lph.GetTimestamp(),
-					true, // buildOptimistically
-				),
+			nextPayloadTime := payloadtime.Next(
+				consensusTime,
+				lph.GetTimestamp(),
+				true, // buildOptimistically
 			)
+			s.goTask(ctx, func(ctx context.Context) {
+				s.handleRebuildPayloadForRejectedBlock(
+					ctx, preState, nextPayloadTime,
+				)
+			})
 		}
 
 		return err
 	}
 
-	s.logger.Info(
+	logger.Info(
 		"State root verification succeeded - accepting incoming beacon block",
 		"state_root",
 		beaconBlk.GetStateRoot(),
@@ -269,16 +289,16 @@ func (s *Service[
 			return err
 		}
 
-		go s.handleOptimisticPayloadBuild(
-			ctx,
-			postState,
-			beaconBlk,
-			payloadtime.Next(
-				consensusTime,
-				lph.GetTimestamp(),
-				true, // buildOptimistically
-			),
+		nextPayloadTime := payloadtime.Next(
+			consensusTime,
+			lph.GetTimestamp(),
+			true, // buildOptimistically
 		)
+		s.goTask(ctx, func(ctx context.Context) {
+			s.handleOptimisticPayloadBuild(
+				ctx, postState, beaconBlk, nextPayloadTime,
+			)
+		})
 	}
 
 	return nil
@@ -294,9 +314,14 @@ func (s *Service[
 	blk *ctypes.BeaconBlock,
 	consensusTime math.U64,
 	proposerAddress []byte,
+	misbehaviors []*transition.Misbehavior,
 ) error {
 	startTime := time.Now()
 	defer s.metrics.measureStateRootVerificationTime(startTime)
+	ctx, span := tracing.Start(
+		ctx, "blockchain.VerifyStateRoot", tracing.Slot(blk.GetSlot()),
+	)
+	defer span.End()
 	_, err := s.stateProcessor.Transition(
 		// We run with a non-optimistic engine here to ensure
 		// that the proposer does not try to push through a bad block.
@@ -308,6 +333,7 @@ func (s *Service[
 			SkipValidateRandao:      false,
 			ProposerAddress:         proposerAddress,
 			ConsensusTime:           consensusTime,
+			Misbehaviors:            misbehaviors,
 		},
 		st, blk,
 	)
@@ -320,6 +346,7 @@ func (s *Service[
 		return nil
 	}
 
+	tracing.RecordError(span, err)
 	return err
 }
 
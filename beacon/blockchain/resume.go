@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// The progress markers persisted in the deposit store while following the
+// execution layer. The last verified slot needs none, as it is the height of
+// the committed beacon state.
+const (
+	// progressDepositsScanned is the last execution block whose deposits
+	// were fetched or recorded as failed.
+	progressDepositsScanned = "deposits_scanned"
+	// progressForkchoiceHead is the last execution block submitted to the
+	// execution client as the head.
+	progressForkchoiceHead = "forkchoice_head"
+)
+
+// resumeSync resumes following the execution layer from the progress
+// persisted before the node restarted, up to the head of the given state,
+// the last block verified before the restart. Interrupted deposits scans are
+// handed over to the deposit catchup fetcher, and the head is submitted
+// again to the execution client if it did not receive it.
+func (s *Service[
+	_, _, _, _, _, _,
+]) resumeSync(ctx context.Context, st *statedb.StateDB) {
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		s.logger.Error(
+			"failed to get latest execution payload to resume sync",
+			"error", err,
+		)
+		return
+	}
+	s.resumeDepositsScan(lph.GetNumber())
+	s.resumeForkchoiceHead(ctx, st, lph)
+}
+
+// resumeDepositsScan marks the execution blocks up to the given head, minus
+// the follow distance, whose deposits were not scanned as failed, for the
+// deposit catchup fetcher to fetch them.
+func (s *Service[
+	_, _, _, _, _, _,
+]) resumeDepositsScan(headNum math.U64) {
+	if headNum <= s.eth1FollowDistance {
+		return
+	}
+	target := headNum - s.eth1FollowDistance
+	scanned, err := s.storageBackend.DepositStore().GetProgress(
+		progressDepositsScanned,
+	)
+	if err != nil {
+		s.logger.Error("Failed to load deposits scan progress", "error", err)
+		return
+	}
+
+	// Without progress, as on nodes restored from a snapshot, the scan is
+	// only resumed by the next finalized block.
+	if scanned == 0 || math.U64(scanned) >= target {
+		return
+	}
+	s.logger.Info(
+		"Resuming deposits scan",
+		"from", scanned+1, "to", target,
+	)
+	for blockNum := math.U64(scanned + 1); blockNum <= target; blockNum++ {
+		s.markFailedBlock(blockNum)
+	}
+	s.setProgress(progressDepositsScanned, target)
+}
+
+// resumeForkchoiceHead submits the given head to the execution client again
+// if it was not submitted before the node restarted, as the post block
+// forkchoice updates are sent in the background.
+func (s *Service[
+	_, _, _, _, _, _,
+]) resumeForkchoiceHead(
+	ctx context.Context,
+	st *statedb.StateDB,
+	lph *ctypes.ExecutionPayloadHeader,
+) {
+	head, err := s.storageBackend.DepositStore().GetProgress(
+		progressForkchoiceHead,
+	)
+	if err != nil {
+		s.logger.Error("Failed to load forkchoice head progress", "error", err)
+		return
+	}
+	if math.U64(head) >= lph.GetNumber() {
+		return
+	}
+	slot, err := st.GetSlot()
+	if err != nil {
+		s.logger.Error(
+			"failed to get slot to resume forkchoice head",
+			"error", err,
+		)
+		return
+	}
+	s.logger.Info(
+		"Resuming forkchoice head of execution client",
+		"head", lph.GetNumber(), "last_submitted", head,
+	)
+	s.sendHeadFCU(ctx, lph, slot)
+}
+
+// setProgress records the execution block reached by the given progress
+// marker.
+func (s *Service[
+	_, _, _, _, _, _,
+]) setProgress(marker string, blockNum math.U64) {
+	if err := s.storageBackend.DepositStore().SetProgress(
+		marker, blockNum.Unwrap(),
+	); err != nil {
+		s.logger.Error(
+			"Failed to store sync progress",
+			"marker", marker, "block", blockNum, "error", err,
+		)
+	}
+}
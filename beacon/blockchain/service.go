@@ -23,9 +23,12 @@ package blockchain
 import (
 	"context"
 	"sync"
+	"time"
 
+	"github.com/berachain/beacon-kit/beacon/events"
 	"github.com/berachain/beacon-kit/chain-spec/chain"
 	"github.com/berachain/beacon-kit/da/da"
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/execution/deposit"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/backend"
@@ -34,6 +37,10 @@ import (
 	"github.com/berachain/beacon-kit/primitives/transition"
 )
 
+// defaultStopTimeout bounds the time Stop waits for the background tasks
+// of the service.
+const defaultStopTimeout = 30 * time.Second
+
 // Service is the blockchain service.
 type Service[
 	AvailabilityStoreT AvailabilityStore,
@@ -54,11 +61,6 @@ type Service[
 	depositContract deposit.Contract
 	// eth1FollowDistance is the follow distance for Ethereum 1.0 blocks.
 	eth1FollowDistance math.U64
-	// failedBlocksMu protects failedBlocks for concurrent access.
-	failedBlocksMu sync.RWMutex
-	// failedBlocks is a map of blocks that failed to be processed
-	// and should be retried.
-	failedBlocks map[math.U64]struct{}
 	// logger is used for logging messages in the service.
 	logger log.Logger
 	// chainSpec holds the chain specifications.
@@ -73,11 +75,34 @@ type Service[
 	stateProcessor StateProcessor[*transition.Context]
 	// metrics is the metrics for the service.
 	metrics *chainMetrics
+	// eventBus is the bus the events of finalized blocks are published on.
+	eventBus *events.Bus
+	// slotObservers are notified of the finalized slots.
+	slotObservers []SlotObserver
 	// optimisticPayloadBuilds is a flag used when the optimistic payload
 	// builder is enabled.
 	optimisticPayloadBuilds bool
+	// catchUp tracks whether blocks are replayed in catch-up mode.
+	catchUp catchUpState
+	// syncReporter reports how far the node lags behind its peers.
+	syncReporter SyncReporter
 	// forceStartupSyncOnce is used to force a sync of the startup head.
 	forceStartupSyncOnce *sync.Once
+	// resumeSyncOnce is used to resume following the execution layer from
+	// the progress persisted before the node restarted.
+	resumeSyncOnce *sync.Once
+	// tasks tracks the background tasks spawned by the service, which are
+	// waited for upon Stop.
+	tasks sync.WaitGroup
+	// stopTimeout bounds the time Stop waits for the background tasks
+	// before cancelling them.
+	stopTimeout time.Duration
+	// tasksCtx is cancelled when the background tasks are cancelled.
+	tasksCtx context.Context
+	// cancelTasks cancels the contexts of the background tasks.
+	cancelTasks context.CancelFunc
+	// cancelCatchup stops the deposit catchup fetcher.
+	cancelCatchup context.CancelFunc
 }
 
 // NewService creates a new validator service.
@@ -107,7 +132,10 @@ func NewService[
 	localBuilder LocalBuilder,
 	stateProcessor StateProcessor[*transition.Context],
 	telemetrySink TelemetrySink,
+	eventBus *events.Bus,
+	slotObservers []SlotObserver,
 	optimisticPayloadBuilds bool,
+	catchUp CatchUpConfig,
 ) *Service[
 	AvailabilityStoreT, DepositStoreT,
 	ConsensusBlockT,
@@ -126,15 +154,19 @@ func NewService[
 		blobProcessor:           blobProcessor,
 		depositContract:         depositContract,
 		eth1FollowDistance:      eth1FollowDistance,
-		failedBlocks:            make(map[math.Slot]struct{}),
 		logger:                  logger,
 		chainSpec:               chainSpec,
 		executionEngine:         executionEngine,
 		localBuilder:            localBuilder,
 		stateProcessor:          stateProcessor,
 		metrics:                 newChainMetrics(telemetrySink),
+		eventBus:                eventBus,
+		slotObservers:           slotObservers,
 		optimisticPayloadBuilds: optimisticPayloadBuilds,
+		catchUp:                 catchUpState{cfg: catchUp},
 		forceStartupSyncOnce:    new(sync.Once),
+		resumeSyncOnce:          new(sync.Once),
+		stopTimeout:             defaultStopTimeout,
 	}
 }
 
@@ -148,14 +180,62 @@ func (s *Service[
 func (s *Service[
 	_, _, _, _, _, _,
 ]) Start(ctx context.Context) error {
+	s.tasksCtx, s.cancelTasks = context.WithCancel(context.Background())
+
 	// Catchup deposits for failed blocks.
-	go s.depositCatchupFetcher(ctx)
+	ctx, s.cancelCatchup = context.WithCancel(ctx)
+	s.goTask(ctx, s.depositCatchupFetcher)
 
 	return nil
 }
 
+// Stop waits for the background tasks of the service, such as post block
+// forkchoice updates and optimistic payload builds, to complete. Past the
+// stop timeout, it cancels their contexts, not to hang the shutdown of the
+// node, and still waits for them to return, so that none of them outlives
+// the stores closed after the service is stopped.
 func (s *Service[
 	_, _, _, _, _, _,
 ]) Stop() error {
-	return nil
+	if s.cancelCatchup != nil {
+		s.cancelCatchup()
+	}
+	s.logger.Info("Waiting for blockchain background tasks to complete")
+	done := make(chan struct{})
+	go func() {
+		s.tasks.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-time.After(s.stopTimeout):
+	}
+
+	s.logger.Warn("Cancelling blockchain background tasks still running",
+		"timeout", s.stopTimeout)
+	if s.cancelTasks != nil {
+		s.cancelTasks()
+	}
+	<-done
+	return errors.Wrapf(
+		ErrStopTimeout, "background tasks cancelled after %s", s.stopTimeout,
+	)
+}
+
+// goTask runs f in a background task tracked by the service, with a context
+// derived from the given one which is also cancelled when the background
+// tasks are cancelled upon Stop.
+func (s *Service[
+	_, _, _, _, _, _,
+]) goTask(ctx context.Context, f func(ctx context.Context)) {
+	ctx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(s.tasksCtx, cancel)
+	s.tasks.Add(1)
+	go func() {
+		defer s.tasks.Done()
+		defer cancel()
+		defer stop()
+		f(ctx)
+	}()
 }
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/da/da"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	blockstore "github.com/berachain/beacon-kit/node-api/block_store"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestService(stopTimeout time.Duration) *Service[
+	AvailabilityStore, backend.DepositStore, ConsensusBlock,
+	blockstore.BlockStore, Genesis, da.ConsensusSidecars,
+] {
+	return &Service[
+		AvailabilityStore, backend.DepositStore, ConsensusBlock,
+		blockstore.BlockStore, Genesis, da.ConsensusSidecars,
+	]{
+		logger:      noop.NewLogger[any](),
+		stopTimeout: stopTimeout,
+	}
+}
+
+func TestStopDrainsTasks(t *testing.T) {
+	t.Parallel()
+	s := newTestService(time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, s.Start(ctx))
+
+	release := make(chan struct{})
+	completed := make(chan struct{})
+	s.goTask(context.Background(), func(context.Context) {
+		<-release
+		close(completed)
+	})
+
+	// Cancelling the context of the service does not drop the task in
+	// flight, Stop waits for it.
+	cancel()
+	stopped := make(chan error)
+	go func() { stopped <- s.Stop() }()
+	select {
+	case <-stopped:
+		t.Fatal("stopped before the task in flight completed")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	require.NoError(t, <-stopped)
+	select {
+	case <-completed:
+	default:
+		t.Fatal("task in flight dropped")
+	}
+}
+
+func TestStopTimeout(t *testing.T) {
+	t.Parallel()
+	s := newTestService(50 * time.Millisecond)
+	require.NoError(t, s.Start(context.Background()))
+
+	// Tasks which do not complete in time are cancelled, not to hang the
+	// shutdown, and Stop waits for them to return.
+	returned := make(chan struct{})
+	s.goTask(context.Background(), func(ctx context.Context) {
+		<-ctx.Done()
+		time.Sleep(20 * time.Millisecond)
+		close(returned)
+	})
+
+	start := time.Now()
+	require.ErrorIs(t, s.Stop(), ErrStopTimeout)
+	require.Less(t, time.Since(start), time.Second)
+	select {
+	case <-returned:
+	default:
+		t.Fatal("stopped before the cancelled task returned")
+	}
+}
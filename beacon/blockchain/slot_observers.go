@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"github.com/berachain/beacon-kit/primitives/math"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// reportMissedSlot reports to the slot observers a slot finalized without a
+// beacon block, attributing the miss to the validator which proposed it.
+func (s *Service[
+	_, _, _, _, _, _,
+]) reportMissedSlot(
+	ctx sdk.Context,
+	req *cmtabci.FinalizeBlockRequest,
+) {
+	if len(s.slotObservers) == 0 {
+		return
+	}
+
+	proposer, err := s.storageBackend.StateFromContext(ctx).
+		ValidatorIndexByCometBFTAddress(req.GetProposerAddress())
+	if err != nil {
+		s.logger.Error(
+			"Failed to resolve the proposer of a missed slot",
+			"slot", req.GetHeight(),
+			"error", err,
+		)
+		return
+	}
+	for _, o := range s.slotObservers {
+		o.OnSlotMissed(
+			math.Slot(req.GetHeight()),
+			proposer,
+			req.GetProposerAddress(),
+			math.U64(req.GetTime().Unix()),
+		)
+	}
+}
@@ -59,6 +59,10 @@ type ConsensusBlock interface {
 	// GetConsensusTime returns the timestamp of current consensus request.
 	// It is used to build next payload and to validate currentpayload.
 	GetConsensusTime() math.U64
+
+	// GetMisbehaviors returns the validators misbehaviors reported by
+	// consensus, to be slashed along with the block.
+	GetMisbehaviors() []*transition.Misbehavior
 }
 
 type BlobSidecars[T any] interface {
@@ -75,6 +79,9 @@ type ExecutionEngine interface {
 		ctx context.Context,
 		req *ctypes.ForkchoiceUpdateRequest,
 	) (*engineprimitives.PayloadID, *common.ExecutionHash, error)
+	// IsSyncing returns whether the execution head is optimistic, i.e.
+	// whether the execution client is still syncing up to it.
+	IsSyncing() bool
 }
 
 // ExecutionPayload is the interface for the execution payload.
@@ -206,6 +213,35 @@ type TelemetrySink interface {
 	MeasureSince(key string, start time.Time, args ...string)
 }
 
+// SyncReporter reports how far the node lags behind its peers.
+type SyncReporter interface {
+	// BlocksBehind returns the number of blocks the node lags behind the
+	// highest block reported by its peers.
+	BlocksBehind() (uint64, error)
+}
+
+// SlotObserver observes the finalized slots, e.g. to track the health of the
+// chain or the performance of the validators.
+type SlotObserver interface {
+	// OnBlockFinalized is called with each finalized beacon block, along
+	// with the CometBFT address of its proposer and the time it was decided
+	// by consensus.
+	OnBlockFinalized(
+		blk *ctypes.BeaconBlock,
+		proposerAddress []byte,
+		consensusTime math.U64,
+	)
+	// OnSlotMissed is called for each slot finalized without a beacon block,
+	// along with the index and CometBFT address of the validator which
+	// proposed it.
+	OnSlotMissed(
+		slot math.Slot,
+		proposer math.ValidatorIndex,
+		proposerAddress []byte,
+		consensusTime math.U64,
+	)
+}
+
 //nolint:revive // its ok
 type BlockchainI interface {
 	ProcessGenesisData(
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import "sync"
+
+// defaultBufferSize is the number of events buffered for each subscription.
+const defaultBufferSize = 64
+
+// Event is an event published on the bus.
+type Event struct {
+	// Topic is the topic the event was published on.
+	Topic Topic
+	// Data is the payload of the event, one of the types of this package.
+	Data any
+}
+
+// Bus is an in-process publish/subscribe event bus. Publishing never blocks:
+// a subscription whose buffer is full misses the event.
+type Bus struct {
+	mu   sync.RWMutex
+	subs map[*Subscription]struct{}
+}
+
+// NewBus creates a new event bus.
+func NewBus() *Bus {
+	return &Bus{
+		subs: make(map[*Subscription]struct{}),
+	}
+}
+
+// Publish sends the event to every subscription to the topic.
+func (b *Bus) Publish(topic Topic, data any) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for sub := range b.subs {
+		if _, ok := sub.topics[topic]; !ok {
+			continue
+		}
+		select {
+		case sub.ch <- Event{Topic: topic, Data: data}:
+		default:
+		}
+	}
+}
+
+// Subscribe returns a subscription to the given topics. It must be
+// unsubscribed once no longer read from.
+func (b *Bus) Subscribe(topics ...Topic) *Subscription {
+	sub := &Subscription{
+		bus:    b,
+		topics: make(map[Topic]struct{}, len(topics)),
+		ch:     make(chan Event, defaultBufferSize),
+	}
+	for _, topic := range topics {
+		sub.topics[topic] = struct{}{}
+	}
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+	return sub
+}
+
+// Subscription receives the events published on a set of topics.
+type Subscription struct {
+	bus    *Bus
+	topics map[Topic]struct{}
+	ch     chan Event
+}
+
+// Events returns the channel the subscribed events are delivered to.
+func (s *Subscription) Events() <-chan Event {
+	return s.ch
+}
+
+// Unsubscribe stops the delivery of events to the subscription.
+func (s *Subscription) Unsubscribe() {
+	s.bus.mu.Lock()
+	delete(s.bus.subs, s)
+	s.bus.mu.Unlock()
+}
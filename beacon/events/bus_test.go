@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/beacon/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBus(t *testing.T) {
+	bus := events.NewBus()
+	heads := bus.Subscribe(events.TopicHead)
+	blocks := bus.Subscribe(events.TopicBlock, events.TopicHead)
+
+	bus.Publish(events.TopicBlock, &events.Block{Slot: 1})
+	bus.Publish(events.TopicHead, &events.Head{Slot: 1})
+
+	require.Equal(t, events.Event{
+		Topic: events.TopicHead, Data: &events.Head{Slot: 1},
+	}, <-heads.Events())
+	require.Equal(t, events.TopicBlock, (<-blocks.Events()).Topic)
+	require.Equal(t, events.TopicHead, (<-blocks.Events()).Topic)
+	require.Empty(t, heads.Events())
+
+	// Unsubscribed subscriptions no longer receive events.
+	heads.Unsubscribe()
+	bus.Publish(events.TopicHead, &events.Head{Slot: 2})
+	require.Empty(t, heads.Events())
+	require.Len(t, blocks.Events(), 1)
+}
+
+func TestBus_SlowSubscriber(t *testing.T) {
+	bus := events.NewBus()
+	sub := bus.Subscribe(events.TopicBlock)
+	defer sub.Unsubscribe()
+
+	// Publishing never blocks, events overflowing the buffer are dropped.
+	for slot := range uint64(1000) {
+		bus.Publish(events.TopicBlock, &events.Block{Slot: slot})
+	}
+	require.Less(t, len(sub.Events()), 1000)
+	first, ok := (<-sub.Events()).Data.(*events.Block)
+	require.True(t, ok)
+	require.Equal(t, uint64(0), first.Slot)
+}
+
+func TestParseTopic(t *testing.T) {
+	topic, err := events.ParseTopic("finalized_checkpoint")
+	require.NoError(t, err)
+	require.Equal(t, events.TopicFinalizedCheckpoint, topic)
+
+	_, err = events.ParseTopic("chain_reorg")
+	require.ErrorIs(t, err, events.ErrUnknownTopic)
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+)
+
+// Topic is a topic events are published on, named after the matching Beacon
+// API event stream topic.
+type Topic string
+
+const (
+	// TopicHead is the topic of new chain heads.
+	TopicHead Topic = "head"
+	// TopicBlock is the topic of imported blocks.
+	TopicBlock Topic = "block"
+	// TopicFinalizedCheckpoint is the topic of finalized epoch checkpoints.
+	TopicFinalizedCheckpoint Topic = "finalized_checkpoint"
+	// TopicBlobSidecar is the topic of imported blob sidecars.
+	TopicBlobSidecar Topic = "blob_sidecar"
+	// TopicValidatorKey is the topic of the validator keys loaded or unloaded
+	// by the node, which is specific to beacon-kit.
+	TopicValidatorKey Topic = "validator_key"
+)
+
+// The actions published on TopicValidatorKey.
+const (
+	// ValidatorKeyAdded is published when a validator key is loaded.
+	ValidatorKeyAdded = "added"
+	// ValidatorKeyRemoved is published when a validator key is unloaded.
+	ValidatorKeyRemoved = "removed"
+)
+
+// ErrUnknownTopic is returned when parsing an unknown topic.
+var ErrUnknownTopic = errors.New("unknown event topic")
+
+// ParseTopic returns the topic with the given name.
+func ParseTopic(name string) (Topic, error) {
+	switch topic := Topic(name); topic {
+	case TopicHead, TopicBlock, TopicFinalizedCheckpoint, TopicBlobSidecar,
+		TopicValidatorKey:
+		return topic, nil
+	default:
+		return "", errors.Wrap(ErrUnknownTopic, name)
+	}
+}
+
+// Head is published on TopicHead.
+type Head struct {
+	Slot                      uint64      `json:"slot,string"`
+	Block                     common.Root `json:"block"`
+	State                     common.Root `json:"state"`
+	EpochTransition           bool        `json:"epoch_transition"`
+	PreviousDutyDependentRoot common.Root `json:"previous_duty_dependent_root"`
+	CurrentDutyDependentRoot  common.Root `json:"current_duty_dependent_root"`
+	ExecutionOptimistic       bool        `json:"execution_optimistic"`
+}
+
+// Block is published on TopicBlock.
+type Block struct {
+	Slot                uint64      `json:"slot,string"`
+	Block               common.Root `json:"block"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+}
+
+// FinalizedCheckpoint is published on TopicFinalizedCheckpoint.
+type FinalizedCheckpoint struct {
+	Block               common.Root `json:"block"`
+	State               common.Root `json:"state"`
+	Epoch               uint64      `json:"epoch,string"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+}
+
+// BlobSidecar is published on TopicBlobSidecar.
+type BlobSidecar struct {
+	BlockRoot     common.Root           `json:"block_root"`
+	Index         uint64                `json:"index,string"`
+	Slot          uint64                `json:"slot,string"`
+	KzgCommitment eip4844.KZGCommitment `json:"kzg_commitment"`
+	VersionedHash common.ExecutionHash  `json:"versioned_hash"`
+}
+
+// ValidatorKey is published on TopicValidatorKey.
+type ValidatorKey struct {
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+	// Path is the path of the keystore the key was loaded from.
+	Path string `json:"path"`
+	// Action is either ValidatorKeyAdded or ValidatorKeyRemoved.
+	Action string `json:"action"`
+}
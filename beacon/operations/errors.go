@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package operations
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrInvalidOperation is wrapped by the errors rejecting an operation.
+	ErrInvalidOperation = errors.New("invalid operation")
+
+	// ErrDuplicateOperation is returned when the pool already holds an
+	// operation of the same kind for the validator.
+	ErrDuplicateOperation = errors.Wrap(
+		ErrInvalidOperation, "operation already in pool",
+	)
+
+	// ErrUnknownValidator is returned when the validator of an operation is
+	// not in the registry.
+	ErrUnknownValidator = errors.Wrap(
+		ErrInvalidOperation, "unknown validator",
+	)
+
+	// ErrValidatorNotActive is returned when exiting a validator that is not
+	// active.
+	ErrValidatorNotActive = errors.Wrap(
+		ErrInvalidOperation, "validator is not active",
+	)
+
+	// ErrValidatorExiting is returned when exiting a validator that already
+	// initiated its exit.
+	ErrValidatorExiting = errors.Wrap(
+		ErrInvalidOperation, "validator is already exiting",
+	)
+
+	// ErrExitNotYetValid is returned when an exit is for a future epoch.
+	ErrExitNotYetValid = errors.Wrap(
+		ErrInvalidOperation, "exit epoch is in the future",
+	)
+
+	// ErrCredentialsMismatch is returned when the credentials of the
+	// validator do not commit to the BLS withdrawal key of a change.
+	ErrCredentialsMismatch = errors.Wrap(
+		ErrInvalidOperation, "credentials do not match the BLS withdrawal key",
+	)
+
+	// ErrInvalidSignature is returned when the signature of an operation
+	// does not verify.
+	ErrInvalidSignature = errors.Wrap(
+		ErrInvalidOperation, "invalid signature",
+	)
+)
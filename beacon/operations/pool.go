@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package operations
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ReadOnlyBeaconState is the state operations are validated against.
+type ReadOnlyBeaconState interface {
+	// GetSlot returns the slot of the state.
+	GetSlot() (math.Slot, error)
+	// GetGenesisValidatorsRoot returns the root of the genesis validators.
+	GetGenesisValidatorsRoot() (common.Root, error)
+	// GetTotalValidators returns the number of validators in the registry.
+	GetTotalValidators() (uint64, error)
+	// ValidatorByIndex returns the validator at the given index.
+	ValidatorByIndex(index math.ValidatorIndex) (*ctypes.Validator, error)
+}
+
+// SignatureVerifier verifies a BLS signature of a message.
+type SignatureVerifier func(
+	pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+) error
+
+// Pool holds the voluntary exits and BLS to execution changes submitted to
+// the node. Operations are validated against the state when added, and the
+// pool holds at most one operation of each kind per validator.
+type Pool struct {
+	cs              chain.ChainSpec
+	verifySignature SignatureVerifier
+
+	mu         sync.RWMutex
+	exits      map[math.ValidatorIndex]*ctypes.SignedVoluntaryExit
+	blsChanges map[math.ValidatorIndex]*ctypes.SignedBLSToExecutionChange
+}
+
+// NewPool creates a new, empty operations pool.
+func NewPool(cs chain.ChainSpec, verifySignature SignatureVerifier) *Pool {
+	return &Pool{
+		cs:              cs,
+		verifySignature: verifySignature,
+		exits: make(
+			map[math.ValidatorIndex]*ctypes.SignedVoluntaryExit,
+		),
+		blsChanges: make(
+			map[math.ValidatorIndex]*ctypes.SignedBLSToExecutionChange,
+		),
+	}
+}
+
+// AddVoluntaryExit validates the exit against the given state and adds it to
+// the pool. The validator must be active, not exiting yet, and have signed
+// the exit.
+func (p *Pool) AddVoluntaryExit(
+	st ReadOnlyBeaconState, exit *ctypes.SignedVoluntaryExit,
+) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	index := exit.Message.ValidatorIndex
+	if _, ok := p.exits[index]; ok {
+		return ErrDuplicateOperation
+	}
+	val, err := validatorByIndex(st, index)
+	if err != nil {
+		return err
+	}
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := p.cs.SlotToEpoch(slot)
+	switch {
+	case !val.IsActive(epoch):
+		return ErrValidatorNotActive
+	case val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch):
+		return ErrValidatorExiting
+	case epoch < exit.Message.Epoch:
+		return ErrExitNotYetValid
+	}
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+	if err = exit.VerifySignature(
+		ctypes.NewForkDataAtEpoch(
+			p.cs, exit.Message.Epoch, genesisValidatorsRoot,
+		),
+		val.GetPubkey(),
+		p.cs.DomainTypeVoluntaryExit(),
+		p.verifySignature,
+	); err != nil {
+		return errors.Join(ErrInvalidSignature, err)
+	}
+
+	p.exits[index] = exit
+	return nil
+}
+
+// AddBLSToExecutionChange validates the change against the given state and
+// adds it to the pool. The credentials of the validator must commit to the
+// BLS withdrawal key that signed the change.
+func (p *Pool) AddBLSToExecutionChange(
+	st ReadOnlyBeaconState, change *ctypes.SignedBLSToExecutionChange,
+) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	index := change.Message.ValidatorIndex
+	if _, ok := p.blsChanges[index]; ok {
+		return ErrDuplicateOperation
+	}
+	val, err := validatorByIndex(st, index)
+	if err != nil {
+		return err
+	}
+	if !change.Message.MatchesCredentials(val.GetWithdrawalCredentials()) {
+		return ErrCredentialsMismatch
+	}
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+	if err = change.VerifySignature(
+		ctypes.NewForkDataAtEpoch(
+			p.cs, math.Epoch(constants.GenesisEpoch), genesisValidatorsRoot,
+		),
+		p.cs.DomainTypeBLSToExecutionChange(),
+		p.verifySignature,
+	); err != nil {
+		return errors.Join(ErrInvalidSignature, err)
+	}
+
+	p.blsChanges[index] = change
+	return nil
+}
+
+// VoluntaryExits returns the voluntary exits in the pool, ordered by
+// validator index.
+func (p *Pool) VoluntaryExits() []*ctypes.SignedVoluntaryExit {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return sortedValues(p.exits)
+}
+
+// BLSToExecutionChanges returns the BLS to execution changes in the pool,
+// ordered by validator index.
+func (p *Pool) BLSToExecutionChanges() []*ctypes.SignedBLSToExecutionChange {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return sortedValues(p.blsChanges)
+}
+
+// validatorByIndex returns the validator at the given index of the registry.
+func validatorByIndex(
+	st ReadOnlyBeaconState, index math.ValidatorIndex,
+) (*ctypes.Validator, error) {
+	total, err := st.GetTotalValidators()
+	if err != nil {
+		return nil, err
+	}
+	if index.Unwrap() >= total {
+		return nil, ErrUnknownValidator
+	}
+	return st.ValidatorByIndex(index)
+}
+
+// sortedValues returns the values of the map, ordered by key.
+func sortedValues[T any](m map[math.ValidatorIndex]T) []T {
+	indices := make([]math.ValidatorIndex, 0, len(m))
+	for index := range m {
+		indices = append(indices, index)
+	}
+	slices.Sort(indices)
+	values := make([]T, len(indices))
+	for i, index := range indices {
+		values[i] = m[index]
+	}
+	return values
+}
@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package operations_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/beacon/operations"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+var errBadSignature = errors.New("bad signature")
+
+// testState is a beacon state holding a registry of validators at a slot.
+type testState struct {
+	slot       math.Slot
+	validators []*ctypes.Validator
+}
+
+func (s *testState) GetSlot() (math.Slot, error) { return s.slot, nil }
+
+func (*testState) GetGenesisValidatorsRoot() (common.Root, error) {
+	return common.Root{0x01}, nil
+}
+
+func (s *testState) GetTotalValidators() (uint64, error) {
+	return uint64(len(s.validators)), nil
+}
+
+func (s *testState) ValidatorByIndex(
+	index math.ValidatorIndex,
+) (*ctypes.Validator, error) {
+	return s.validators[index], nil
+}
+
+func newValidator(pubkey byte, creds ctypes.WithdrawalCredentials) *ctypes.Validator {
+	return &ctypes.Validator{
+		Pubkey:                     crypto.BLSPubkey{pubkey},
+		WithdrawalCredentials:      creds,
+		ActivationEligibilityEpoch: 0,
+		ActivationEpoch:            0,
+		ExitEpoch:                  math.Epoch(constants.FarFutureEpoch),
+		WithdrawableEpoch:          math.Epoch(constants.FarFutureEpoch),
+	}
+}
+
+func TestAddVoluntaryExit(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	exiting := newValidator(0x02, ctypes.WithdrawalCredentials{})
+	exiting.SetExitEpoch(20)
+	st := &testState{
+		slot: math.Slot(10 * cs.SlotsPerEpoch()),
+		validators: []*ctypes.Validator{
+			newValidator(0x01, ctypes.WithdrawalCredentials{}),
+			exiting,
+		},
+	}
+
+	// The signature is checked against the pubkey of the validator, within
+	// the voluntary exit domain.
+	exit := &ctypes.SignedVoluntaryExit{
+		Message:   &ctypes.VoluntaryExit{Epoch: 3, ValidatorIndex: 0},
+		Signature: crypto.BLSSignature{0xaa},
+	}
+	signingRoot := ctypes.ComputeSigningRoot(
+		exit.Message,
+		ctypes.NewForkData(
+			version.FromUint32[common.Version](
+				cs.ActiveForkVersionForEpoch(3),
+			), common.Root{0x01},
+		).ComputeDomain(cs.DomainTypeVoluntaryExit()),
+	)
+	verify := func(
+		pubkey crypto.BLSPubkey, msg []byte, sig crypto.BLSSignature,
+	) error {
+		if pubkey != (crypto.BLSPubkey{0x01}) ||
+			common.Root(msg) != signingRoot || sig != exit.Signature {
+			return errBadSignature
+		}
+		return nil
+	}
+	pool := operations.NewPool(cs, verify)
+
+	tests := []struct {
+		name string
+		exit *ctypes.VoluntaryExit
+		sig  crypto.BLSSignature
+		err  error
+	}{
+		{
+			name: "unknown validator",
+			exit: &ctypes.VoluntaryExit{Epoch: 3, ValidatorIndex: 2},
+			err:  operations.ErrUnknownValidator,
+		},
+		{
+			name: "already exiting",
+			exit: &ctypes.VoluntaryExit{Epoch: 3, ValidatorIndex: 1},
+			err:  operations.ErrValidatorExiting,
+		},
+		{
+			name: "future epoch",
+			exit: &ctypes.VoluntaryExit{Epoch: 11, ValidatorIndex: 0},
+			err:  operations.ErrExitNotYetValid,
+		},
+		{
+			name: "invalid signature",
+			exit: &ctypes.VoluntaryExit{Epoch: 3, ValidatorIndex: 0},
+			sig:  crypto.BLSSignature{0xbb},
+			err:  operations.ErrInvalidSignature,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err = pool.AddVoluntaryExit(st, &ctypes.SignedVoluntaryExit{
+				Message:   tt.exit,
+				Signature: tt.sig,
+			})
+			require.ErrorIs(t, err, tt.err)
+			require.ErrorIs(t, err, operations.ErrInvalidOperation)
+		})
+	}
+	require.Empty(t, pool.VoluntaryExits())
+
+	require.NoError(t, pool.AddVoluntaryExit(st, exit))
+	require.Equal(t, []*ctypes.SignedVoluntaryExit{exit}, pool.VoluntaryExits())
+
+	// A second exit of the validator is rejected.
+	err = pool.AddVoluntaryExit(st, exit)
+	require.ErrorIs(t, err, operations.ErrDuplicateOperation)
+}
+
+func TestAddBLSToExecutionChange(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	fromPubkey := crypto.BLSPubkey{0x0f}
+	blsCreds := ctypes.WithdrawalCredentials(sha256.Hash(fromPubkey[:]))
+	blsCreds[0] = ctypes.BLSCredentialPrefix
+	st := &testState{
+		validators: []*ctypes.Validator{
+			newValidator(0x01, blsCreds),
+			newValidator(0x02, ctypes.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{0x02},
+			)),
+		},
+	}
+	pool := operations.NewPool(cs, func(
+		pubkey crypto.BLSPubkey, _ []byte, _ crypto.BLSSignature,
+	) error {
+		if pubkey != fromPubkey {
+			return errBadSignature
+		}
+		return nil
+	})
+
+	change := func(index math.ValidatorIndex) *ctypes.SignedBLSToExecutionChange {
+		return &ctypes.SignedBLSToExecutionChange{
+			Message: &ctypes.BLSToExecutionChange{
+				ValidatorIndex:     index,
+				FromBLSPubkey:      fromPubkey,
+				ToExecutionAddress: common.ExecutionAddress{0x03},
+			},
+		}
+	}
+
+	// Validators with execution credentials cannot change them.
+	err = pool.AddBLSToExecutionChange(st, change(1))
+	require.ErrorIs(t, err, operations.ErrCredentialsMismatch)
+
+	require.NoError(t, pool.AddBLSToExecutionChange(st, change(0)))
+	require.Len(t, pool.BLSToExecutionChanges(), 1)
+
+	err = pool.AddBLSToExecutionChange(st, change(0))
+	require.ErrorIs(t, err, operations.ErrDuplicateOperation)
+}
@@ -30,6 +30,8 @@ import (
 	"github.com/berachain/beacon-kit/consensus/types"
 	datypes "github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
@@ -53,6 +55,9 @@ func (s *Service[_]) BuildBlockAndSidecars(
 	startTime := time.Now()
 	defer s.metrics.measureRequestBlockForProposalTime(startTime)
 
+	// The entries logged while building the block are about its slot.
+	ctx = log.ContextWithFields(ctx, log.SlotKey, slotData.GetSlot())
+
 	// The goal here is to acquire a payload whose parent is the previously
 	// finalized block, such that, if this payload is accepted, it will be
 	// the next finalized block in the chain. A byproduct of this design
@@ -69,24 +74,35 @@ func (s *Service[_]) BuildBlockAndSidecars(
 		return nil, nil, err
 	}
 
-	// Build forkdata used for the signing root of the reveal and the sidecars
-	forkData, err := s.buildForkData(st, slotData.GetSlot())
-	if err != nil {
+	// Do not propose on top of a head the execution client has not validated
+	// yet, since it cannot build a payload while syncing anyway.
+	if err := s.ensureHeadValidated(st); err != nil {
 		return nil, nil, err
 	}
 
-	// Build the reveal for the current slot.
-	// TODO: We can optimize to pre-compute this in parallel?
-	reveal, err := s.buildRandaoReveal(forkData, slotData.GetSlot())
+	// Build forkdata used for the signing root of the reveal and the sidecars
+	forkData, err := s.buildForkData(st, slotData.GetSlot())
 	if err != nil {
 		return nil, nil, err
 	}
 
+	// Build the reveal for the current slot concurrently with the retrieval
+	// of the payload, since signing may take a round trip to a remote
+	// signer or to the other operators of a distributed validator.
+	revealCh := make(chan revealResult, 1)
+	go func() {
+		reveal, revealErr := s.buildRandaoReveal(forkData, slotData.GetSlot())
+		revealCh <- revealResult{reveal: reveal, err: revealErr}
+	}()
+
 	// Create a new empty block from the current state.
 	blk, err = s.getEmptyBeaconBlockForSlot(st, slotData.GetSlot())
 	if err != nil {
 		return nil, nil, err
 	}
+	ctx = log.ContextWithFields(
+		ctx, log.ValidatorIndexKey, blk.GetProposerIndex(),
+	)
 
 	// Get the payload for the block.
 	envelope, err := s.retrieveExecutionPayload(ctx, st, blk, slotData)
@@ -97,10 +113,15 @@ func (s *Service[_]) BuildBlockAndSidecars(
 		return nil, nil, ErrNilPayload
 	}
 
+	revealRes := <-revealCh
+	if revealRes.err != nil {
+		return nil, nil, revealRes.err
+	}
+
 	// We have to assemble the block body prior to producing the sidecars
 	// since we need to generate the inclusion proofs.
 	if err = s.buildBlockBody(
-		ctx, st, blk, reveal, envelope, slotData,
+		ctx, st, blk, revealRes.reveal, envelope, slotData,
 	); err != nil {
 		return nil, nil, err
 	}
@@ -110,6 +131,7 @@ func (s *Service[_]) BuildBlockAndSidecars(
 		ctx,
 		slotData.GetProposerAddress(),
 		slotData.GetConsensusTime(),
+		slotData.GetMisbehaviors(),
 		st,
 		blk,
 	); err != nil {
@@ -127,9 +149,13 @@ func (s *Service[_]) BuildBlockAndSidecars(
 		return nil, nil, err
 	}
 
-	s.logger.Info(
+	s.performance.OnPayloadBuilt(
+		blk.GetSlot(), s.signer.PublicKey(), envelope.GetValue(),
+	)
+
+	log.WithContext(s.logger, ctx).Info(
 		"Beacon block successfully built",
-		"slot", slotData.GetSlot().Base10(),
+		log.BlockRootKey, blk.HashTreeRoot(),
 		"state_root", blk.GetStateRoot(),
 		"duration", time.Since(startTime).String(),
 	)
@@ -189,14 +215,18 @@ func (s *Service[_]) buildForkData(
 		return nil, err
 	}
 
-	return ctypes.NewForkData(
-		version.FromUint32[common.Version](
-			s.chainSpec.ActiveForkVersionForEpoch(epoch),
-		),
-		genesisValidatorsRoot,
+	return ctypes.NewForkDataAtEpoch(
+		s.chainSpec, epoch, genesisValidatorsRoot,
 	), nil
 }
 
+// revealResult is the randao reveal built for a slot, or the error building
+// it.
+type revealResult struct {
+	reveal crypto.BLSSignature
+	err    error
+}
+
 // buildRandaoReveal builds a randao reveal for the given slot.
 func (s *Service[_]) buildRandaoReveal(
 	forkData *ctypes.ForkData,
@@ -207,7 +237,9 @@ func (s *Service[_]) buildRandaoReveal(
 		s.chainSpec.DomainTypeRandao(),
 		epoch,
 	)
-	return s.signer.Sign(signingRoot[:])
+	return crypto.SignRequest(
+		s.signer, ctypes.NewRandaoSigningRequest(forkData, epoch, signingRoot),
+	)
 }
 
 // retrieveExecutionPayload retrieves the execution payload for the block.
@@ -268,6 +300,20 @@ func (s *Service[_]) retrieveExecutionPayload(
 	)
 }
 
+// ensureHeadValidated returns ErrOptimisticHead if the latest execution
+// payload of the given state was only imported optimistically.
+func (s *Service[_]) ensureHeadValidated(st *statedb.StateDB) error {
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return err
+	}
+	if s.executionEngine.IsOptimistic(lph.GetBlockHash()) {
+		s.metrics.optimisticHead()
+		return ErrOptimisticHead
+	}
+	return nil
+}
+
 // BuildBlockBody assembles the block body with necessary components.
 func (s *Service[_]) buildBlockBody(
 	_ context.Context,
@@ -309,8 +355,16 @@ func (s *Service[_]) buildBlockBody(
 		return err
 	}
 
+	// From the deposit count fork, commit to the count of deposits, which
+	// verifiers cross-check against the deposits they observed.
+	var depositCount math.U64
+	if s.chainSpec.SlotToEpoch(blk.GetSlot()) >=
+		s.chainSpec.DepositCountForkEpoch() {
+		depositCount = math.U64(len(deposits))
+	}
+
 	var eth1Data *ctypes.Eth1Data
-	body.SetEth1Data(eth1Data.New(deposits.HashTreeRoot(), 0, common.ExecutionHash{}))
+	body.SetEth1Data(eth1Data.New(deposits.HashTreeRoot(), depositCount, common.ExecutionHash{}))
 
 	// Set just the block deposits (after current index) on the block body.
 	if uint64(len(deposits)) < depositIndex {
@@ -326,8 +380,7 @@ func (s *Service[_]) buildBlockBody(
 	body.SetDeposits(deposits[depositIndex:])
 
 	// Set the graffiti on the block body.
-	sizedGraffiti := bytes.ExtendToSize([]byte(s.cfg.Graffiti), bytes.B32Size)
-	graffiti, err := bytes.ToBytes32(sizedGraffiti)
+	graffiti, err := s.graffiti()
 	if err != nil {
 		return fmt.Errorf("failed processing graffiti: %w", err)
 	}
@@ -349,12 +402,27 @@ func (s *Service[_]) buildBlockBody(
 	return nil
 }
 
+// graffiti returns the graffiti registered for the validator run by the
+// node, falling back to the configured one.
+func (s *Service[_]) graffiti() (common.Bytes32, error) {
+	md, err := s.metadata.Get(s.signer.PublicKey())
+	if err != nil {
+		return common.Bytes32{}, err
+	}
+	if md.Graffiti != (common.Bytes32{}) {
+		return md.Graffiti, nil
+	}
+	sizedGraffiti := bytes.ExtendToSize([]byte(s.cfg.Graffiti), bytes.B32Size)
+	return bytes.ToBytes32(sizedGraffiti)
+}
+
 // computeAndSetStateRoot computes the state root of an outgoing block
 // and sets it in the block.
 func (s *Service[_]) computeAndSetStateRoot(
 	ctx context.Context,
 	proposerAddress []byte,
 	consensusTime math.U64,
+	misbehaviors []*transition.Misbehavior,
 	st *statedb.StateDB,
 	blk *ctypes.BeaconBlock,
 ) error {
@@ -362,13 +430,13 @@ func (s *Service[_]) computeAndSetStateRoot(
 		ctx,
 		proposerAddress,
 		consensusTime,
+		misbehaviors,
 		st,
 		blk,
 	)
 	if err != nil {
-		s.logger.Error(
+		log.WithContext(s.logger, ctx).Error(
 			"failed to compute state root while building block ❗️ ",
-			"slot", blk.GetSlot().Base10(),
 			"error", err,
 		)
 		return err
@@ -382,11 +450,16 @@ func (s *Service[_]) computeStateRoot(
 	ctx context.Context,
 	proposerAddress []byte,
 	consensusTime math.U64,
+	misbehaviors []*transition.Misbehavior,
 	st *statedb.StateDB,
 	blk *ctypes.BeaconBlock,
 ) (common.Root, error) {
 	startTime := time.Now()
 	defer s.metrics.measureStateRootComputationTime(startTime)
+	ctx, span := tracing.Start(
+		ctx, "validator.ComputeStateRoot", tracing.Slot(blk.GetSlot()),
+	)
+	defer span.End()
 	if _, err := s.stateProcessor.Transition(
 		// TODO: We should think about how having optimistic
 		// engine enabled here would affect the proposer when
@@ -399,9 +472,11 @@ func (s *Service[_]) computeStateRoot(
 			SkipValidateRandao:      true,
 			ProposerAddress:         proposerAddress,
 			ConsensusTime:           consensusTime,
+			Misbehaviors:            misbehaviors,
 		},
 		st, blk,
 	); err != nil {
+		tracing.RecordError(span, err)
 		return common.Root{}, err
 	}
 
@@ -20,10 +20,19 @@
 
 package validator
 
+import "time"
+
 const (
 	// defaultGraffiti is the default graffiti string.
 	defaultGraffiti = ""
 
+	// defaultMetadataFile is the default validators metadata file path.
+	defaultMetadataFile = ""
+
+	// defaultMetadataFileWatchInterval is the default interval at which the
+	// validators metadata file is polled for changes.
+	defaultMetadataFileWatchInterval = 5 * time.Second
+
 	// defaultEnableOptimisticPayloadBuilds is the default
 	// for enabling the optimistic payload builder.
 	defaultEnableOptimisticPayloadBuilds = true
@@ -35,6 +44,16 @@ type Config struct {
 	// graffiti field of the beacon block.
 	Graffiti string `mapstructure:"graffiti"`
 
+	// MetadataFile is the path to a JSON file holding the per validator
+	// metadata (fee recipient, graffiti, gas limit, builder) loaded at
+	// startup.
+	MetadataFile string `mapstructure:"metadata-file"`
+
+	// MetadataFileWatchInterval is the interval at which the metadata file
+	// is polled for changes, which are applied at runtime. Zero disables
+	// the polling.
+	MetadataFileWatchInterval time.Duration `mapstructure:"metadata-file-watch-interval"`
+
 	// EnableOptimisticPayloadBuilds is the optimistic block builder.
 	EnableOptimisticPayloadBuilds bool `mapstructure:"enable-optimistic-payload-builds"`
 }
@@ -43,6 +62,8 @@ type Config struct {
 func DefaultConfig() Config {
 	return Config{
 		Graffiti:                      defaultGraffiti,
+		MetadataFile:                  defaultMetadataFile,
+		MetadataFileWatchInterval:     defaultMetadataFileWatchInterval,
 		EnableOptimisticPayloadBuilds: defaultEnableOptimisticPayloadBuilds,
 	}
 }
@@ -41,4 +41,8 @@ var (
 	// ErrDepositStoreIncomplete is an error for when the deposit store has not returned
 	// the expected amount of deposits. Could be due to pruning when it should not be enabled.
 	ErrDepositStoreIncomplete = errors.New("deposits from deposit store incomplete")
+
+	// ErrOptimisticHead is an error for when the execution head is not
+	// validated by the execution client yet, which is then still syncing.
+	ErrOptimisticHead = errors.New("execution head is optimistic")
 )
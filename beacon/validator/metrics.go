@@ -74,3 +74,9 @@ func (cm *validatorMetrics) failedToRetrievePayload(
 		err.Error(),
 	)
 }
+
+// optimisticHead increments the counter for the number of times the
+// validator skipped a proposal because the execution head is optimistic.
+func (cm *validatorMetrics) optimisticHead() {
+	cm.sink.IncrementCounter("beacon_kit.validator.optimistic_head")
+}
@@ -41,8 +41,14 @@ type Service[
 	chainSpec chain.ChainSpec
 	// signer is used to retrieve the public key of this node.
 	signer crypto.BLSSigner
+	// metadata is the validators metadata registry, which may override
+	// the configured graffiti for the validator run by the node.
+	metadata MetadataStore
 	// blobFactory is used to create blob sidecars for blocks.
 	blobFactory BlobFactory
+	// executionEngine tells whether the execution head is optimistic, in
+	// which case no block is proposed on top of it.
+	executionEngine ExecutionEngine
 	// sb is the beacon state backend.
 	sb StorageBackend[DepositStoreT]
 	// stateProcessor is responsible for processing the state.
@@ -57,6 +63,8 @@ type Service[
 	remotePayloadBuilders []PayloadBuilder
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
+	// performance is notified of the payloads of the blocks built.
+	performance PerformanceTracker
 }
 
 // NewService creates a new validator service.
@@ -69,10 +77,13 @@ func NewService[
 	sb StorageBackend[DepositStoreT],
 	stateProcessor StateProcessor[*transition.Context],
 	signer crypto.BLSSigner,
+	metadata MetadataStore,
 	blobFactory BlobFactory,
+	executionEngine ExecutionEngine,
 	localPayloadBuilder PayloadBuilder,
 	remotePayloadBuilders []PayloadBuilder,
 	ts TelemetrySink,
+	performance PerformanceTracker,
 ) *Service[DepositStoreT] {
 	return &Service[DepositStoreT]{
 		cfg:                   cfg,
@@ -80,11 +91,14 @@ func NewService[
 		sb:                    sb,
 		chainSpec:             chainSpec,
 		signer:                signer,
+		metadata:              metadata,
 		stateProcessor:        stateProcessor,
 		blobFactory:           blobFactory,
+		executionEngine:       executionEngine,
 		localPayloadBuilder:   localPayloadBuilder,
 		remotePayloadBuilders: remotePayloadBuilders,
 		metrics:               newValidatorMetrics(ts),
+		performance:           performance,
 	}
 }
 
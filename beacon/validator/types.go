@@ -34,6 +34,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/metadata"
 )
 
 // BeaconBlock represents a beacon block interface.
@@ -123,6 +124,13 @@ type DepositStore interface {
 	) (ctypes.Deposits, error)
 }
 
+// ExecutionEngine is the interface for the execution engine.
+type ExecutionEngine interface {
+	// IsOptimistic returns whether the given execution block is not
+	// validated by the execution client yet.
+	IsOptimistic(hash common.ExecutionHash) bool
+}
+
 // ForkData represents the fork data interface.
 type ForkData[T any] interface {
 	// New creates a new fork data with the given parameters.
@@ -139,6 +147,21 @@ type ForkData[T any] interface {
 	ComputeDomain(common.DomainType) common.Domain
 }
 
+// MetadataStore is the interface for the validators metadata registry.
+type MetadataStore interface {
+	// Get returns the metadata of the given validator.
+	Get(pubkey crypto.BLSPubkey) (*metadata.Metadata, error)
+}
+
+// PerformanceTracker tracks the performance of the local validators.
+type PerformanceTracker interface {
+	// OnPayloadBuilt is called with the value, in Wei, of the payload of each
+	// block built by the given proposer.
+	OnPayloadBuilt(
+		slot math.Slot, proposer crypto.BLSPubkey, value *math.U256,
+	)
+}
+
 // PayloadBuilder represents a service that is responsible for
 // building eth1 blocks.
 type PayloadBuilder interface {
@@ -175,6 +198,9 @@ type SlotData interface {
 	// GetConsensusTime returns the timestamp of current consensus request.
 	// It is used to build next payload and to validate currentpayload.
 	GetConsensusTime() math.U64
+	// GetMisbehaviors returns the validators misbehaviors reported by
+	// consensus, to be slashed along with the block.
+	GetMisbehaviors() []*transition.Misbehavior
 }
 
 // StateProcessor defines the interface for processing the state.
@@ -21,6 +21,7 @@
 package chain
 
 import (
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
@@ -106,6 +107,10 @@ type Spec[
 	// DomainTypeApplicationMask returns the domain for application signatures.
 	DomainTypeApplicationMask() DomainTypeT
 
+	// DomainTypeBLSToExecutionChange returns the domain for BLS to execution
+	// change signatures.
+	DomainTypeBLSToExecutionChange() DomainTypeT
+
 	// Eth1-related values.
 
 	// DepositContractAddress returns the deposit contract address.
@@ -131,6 +136,15 @@ type Spec[
 	// ElectraForkEpoch returns the epoch at which the Electra fork takes
 	// effect.
 	ElectraForkEpoch() EpochT
+	// DepositBoundsForkEpoch returns the epoch from which deposits creating
+	// a validator are checked against the min deposit amount.
+	DepositBoundsForkEpoch() EpochT
+	// DepositCountForkEpoch returns the epoch from which blocks commit to
+	// the count of deposits, cross-checked against the observed deposits.
+	DepositCountForkEpoch() EpochT
+	// SlashingForkEpoch returns the epoch from which the validators reported
+	// as misbehaving by consensus are slashed.
+	SlashingForkEpoch() EpochT
 
 	// State list lengths
 
@@ -157,6 +171,10 @@ type Spec[
 	// slashing penalties.
 	ProportionalSlashingMultiplier() uint64
 
+	// MinSlashingPenaltyQuotient returns the quotient used to compute the
+	// initial penalty of a slashed validator.
+	MinSlashingPenaltyQuotient() uint64
+
 	// Capella Values
 
 	// MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
@@ -213,6 +231,14 @@ type Spec[
 	// validators allowed in the active set.
 	ValidatorSetCap() uint64
 
+	// ValidatorPowerStrategy returns the strategy mapping the effective
+	// balance of validators to their CometBFT voting power.
+	ValidatorPowerStrategy() string
+
+	// ValidatorPowerCap returns the maximum voting power of a validator
+	// under the capped power strategy.
+	ValidatorPowerCap() uint64
+
 	// EVMInflationAddress returns the address on the EVM which will receive
 	// the inflation amount of native EVM balance through a withdrawal every
 	// block.
@@ -266,12 +292,81 @@ func (c *chainSpec[
 		return ErrInvalidValidatorSetCap
 	}
 
+	switch c.ValidatorPowerStrategy() {
+	case ValidatorPowerEffectiveBalance, ValidatorPowerEqual:
+		// nothing to validate
+	case ValidatorPowerCapped:
+		if c.ValidatorPowerCap() == 0 {
+			return ErrInvalidValidatorPowerCap
+		}
+	default:
+		return ErrInvalidValidatorPowerStrategy
+	}
+
+	if err := c.validateConsistency(); err != nil {
+		return err
+	}
+
 	// EVM Inflation values can be zero or non-zero, no validation needed.
 
 	// TODO: Add more validation rules here.
 	return nil
 }
 
+// bytesPerFieldElement is the size of a blob field element.
+const bytesPerFieldElement = 32
+
+// validateConsistency ensures that related chain spec values agree with
+// each other, so that a misconfigured spec is rejected at startup rather
+// than halting the chain later on.
+func (c *chainSpec[
+	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
+]) validateConsistency() error {
+	nonZero := []struct {
+		name  string
+		value uint64
+	}{
+		{"slots-per-epoch", c.SlotsPerEpoch()},
+		{"effective-balance-increment", c.EffectiveBalanceIncrement()},
+		{"hysteresis-quotient", c.HysteresisQuotient()},
+		{"epochs-per-slashings-vector", c.EpochsPerSlashingsVector()},
+		{"min-slashing-penalty-quotient", c.MinSlashingPenaltyQuotient()},
+	}
+	for _, v := range nonZero {
+		if v.value == 0 {
+			return errors.Wrap(ErrZeroSpecValue, v.name)
+		}
+	}
+
+	if c.ElectraForkEpoch() < c.DenebPlusForkEpoch() {
+		return errors.Wrapf(
+			ErrInvalidForkEpochs, "deneb plus: %d, electra: %d",
+			c.DenebPlusForkEpoch(), c.ElectraForkEpoch(),
+		)
+	}
+
+	if c.MaxBlobsPerBlock() > c.MaxBlobCommitmentsPerBlock() {
+		return errors.Wrapf(
+			ErrInvalidBlobParams,
+			"max blobs per block %d exceeds max blob commitments per block %d",
+			c.MaxBlobsPerBlock(), c.MaxBlobCommitmentsPerBlock(),
+		)
+	}
+	if c.BytesPerBlob() != c.FieldElementsPerBlob()*bytesPerFieldElement {
+		return errors.Wrapf(
+			ErrInvalidBlobParams,
+			"bytes per blob %d does not match %d field elements per blob",
+			c.BytesPerBlob(), c.FieldElementsPerBlob(),
+		)
+	}
+
+	if c.EjectionBalance() > c.MaxEffectiveBalance(false) ||
+		c.EjectionBalance() > c.MaxEffectiveBalance(true) {
+		return ErrInvalidEjectionBalance
+	}
+	return nil
+}
+
 // MinDepositAmount returns the minimum deposit amount required.
 func (c chainSpec[
 	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
@@ -401,6 +496,14 @@ func (c chainSpec[
 	return c.Data.DomainTypeApplicationMask
 }
 
+// DomainTypeBLSToExecutionChange returns the domain for BLS to execution
+// change signatures.
+func (c chainSpec[
+	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
+]) DomainTypeBLSToExecutionChange() DomainTypeT {
+	return c.Data.DomainTypeBLSToExecutionChange
+}
+
 // DepositContractAddress returns the address of the deposit contract.
 func (c chainSpec[
 	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
@@ -451,6 +554,30 @@ func (c chainSpec[
 	return c.Data.ElectraForkEpoch
 }
 
+// DepositBoundsForkEpoch returns the epoch from which the min deposit amount
+// applies.
+func (c chainSpec[
+	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
+]) DepositBoundsForkEpoch() EpochT {
+	return c.Data.DepositBoundsForkEpoch
+}
+
+// DepositCountForkEpoch returns the epoch from which the deposit count is
+// committed to and cross-checked.
+func (c chainSpec[
+	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
+]) DepositCountForkEpoch() EpochT {
+	return c.Data.DepositCountForkEpoch
+}
+
+// SlashingForkEpoch returns the epoch from which misbehaving validators are
+// slashed.
+func (c chainSpec[
+	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
+]) SlashingForkEpoch() EpochT {
+	return c.Data.SlashingForkEpoch
+}
+
 // EpochsPerHistoricalVector returns the number of epochs per historical vector.
 func (c chainSpec[
 	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
@@ -493,6 +620,13 @@ func (c chainSpec[
 	return c.Data.ProportionalSlashingMultiplier
 }
 
+// MinSlashingPenaltyQuotient returns the minimum slashing penalty quotient.
+func (c chainSpec[
+	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
+]) MinSlashingPenaltyQuotient() uint64 {
+	return c.Data.MinSlashingPenaltyQuotient
+}
+
 // MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
 // payload.
 func (c chainSpec[
@@ -566,6 +700,25 @@ func (c chainSpec[
 	return c.Data.ValidatorSetCap
 }
 
+// ValidatorPowerStrategy returns the strategy mapping the effective balance of
+// validators to their CometBFT voting power.
+func (c chainSpec[
+	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
+]) ValidatorPowerStrategy() string {
+	if c.Data.ValidatorPowerStrategy == "" {
+		return ValidatorPowerEffectiveBalance
+	}
+	return c.Data.ValidatorPowerStrategy
+}
+
+// ValidatorPowerCap returns the maximum voting power of a validator under the
+// capped power strategy.
+func (c chainSpec[
+	DomainTypeT, EpochT, SlotT, CometBFTConfigT,
+]) ValidatorPowerCap() uint64 {
+	return c.Data.ValidatorPowerCap
+}
+
 // EVMInflationAddress returns the address on the EVM which will receive the
 // inflation amount of native EVM balance through a withdrawal every block.
 func (c chainSpec[
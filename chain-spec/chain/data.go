@@ -84,6 +84,9 @@ type SpecData[
 	DomainTypeAggregateAndProof DomainTypeT `mapstructure:"domain-type-aggregate-and-proof"`
 	// DomainTypeApplicationMask is the domain for the application mask.
 	DomainTypeApplicationMask DomainTypeT `mapstructure:"domain-type-application-mask"`
+	// DomainTypeBLSToExecutionChange is the domain for BLS to execution
+	// change signatures.
+	DomainTypeBLSToExecutionChange DomainTypeT `mapstructure:"domain-type-bls-to-execution-change"`
 
 	// Eth1-related values.
 	//
@@ -106,6 +109,15 @@ type SpecData[
 	DenebPlusForkEpoch EpochT `mapstructure:"deneb-plus-fork-epoch"`
 	// ElectraForkEpoch is the epoch at which the Electra fork is activated.
 	ElectraForkEpoch EpochT `mapstructure:"electra-fork-epoch"`
+	// DepositBoundsForkEpoch is the epoch from which deposits creating a
+	// validator are checked against MinDepositAmount.
+	DepositBoundsForkEpoch EpochT `mapstructure:"deposit-bounds-fork-epoch"`
+	// DepositCountForkEpoch is the epoch from which blocks commit to the
+	// count of deposits, cross-checked against the observed deposits.
+	DepositCountForkEpoch EpochT `mapstructure:"deposit-count-fork-epoch"`
+	// SlashingForkEpoch is the epoch from which the validators reported as
+	// misbehaving by consensus are slashed.
+	SlashingForkEpoch EpochT `mapstructure:"slashing-fork-epoch"`
 
 	// State list lengths
 	//
@@ -127,6 +139,9 @@ type SpecData[
 	// ProportionalSlashingMultiplier is the slashing multiplier relative to the
 	// base penalty.
 	ProportionalSlashingMultiplier uint64 `mapstructure:"proportional-slashing-multiplier"`
+	// MinSlashingPenaltyQuotient is the quotient of the effective balance
+	// deducted from a validator when it gets slashed.
+	MinSlashingPenaltyQuotient uint64 `mapstructure:"min-slashing-penalty-quotient"`
 
 	// Capella Values
 	//
@@ -169,6 +184,13 @@ type SpecData[
 	// for a given epoch
 	// Note: ValidatorSetCap must be smaller than ValidatorRegistryLimit.
 	ValidatorSetCap uint64 `mapstructure:"validator-set-cap-size"`
+	// ValidatorPowerStrategy selects how the effective balance of validators
+	// maps to their CometBFT voting power. Left empty, voting power equals
+	// the effective balance.
+	ValidatorPowerStrategy string `mapstructure:"validator-power-strategy"`
+	// ValidatorPowerCap is the maximum voting power, in Gwei of effective
+	// balance, of a validator under the capped power strategy.
+	ValidatorPowerCap uint64 `mapstructure:"validator-power-cap"`
 	// EVMInflationAddress is the address on the EVM which will receive the
 	// inflation amount of native EVM balance through a withdrawal every block.
 	EVMInflationAddress common.ExecutionAddress `mapstructure:"evm-inflation-address"`
@@ -35,4 +35,36 @@ var (
 	ErrInvalidValidatorSetCap = errors.New(
 		"validator set cap must be less than the validator registry limit",
 	)
+
+	// ErrInvalidValidatorPowerStrategy is returned when the validator power
+	// strategy is unknown.
+	ErrInvalidValidatorPowerStrategy = errors.New(
+		"unknown validator power strategy",
+	)
+
+	// ErrInvalidValidatorPowerCap is returned when the capped validator power
+	// strategy is selected without a cap.
+	ErrInvalidValidatorPowerCap = errors.New(
+		"validator power cap must be set for the capped power strategy",
+	)
+
+	// ErrZeroSpecValue is returned when a chain spec value used as a divisor
+	// or period length is zero.
+	ErrZeroSpecValue = errors.New("chain spec value must not be zero")
+
+	// ErrInvalidForkEpochs is returned when fork epochs are not in
+	// activation order.
+	ErrInvalidForkEpochs = errors.New(
+		"fork epochs must be in activation order",
+	)
+
+	// ErrInvalidBlobParams is returned when the blob parameters are not
+	// consistent with each other.
+	ErrInvalidBlobParams = errors.New("inconsistent blob parameters")
+
+	// ErrInvalidEjectionBalance is returned when the ejection balance is
+	// greater than the max effective balance.
+	ErrInvalidEjectionBalance = errors.New(
+		"ejection balance must not exceed the max effective balance",
+	)
 )
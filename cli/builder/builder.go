@@ -124,8 +124,8 @@ func (cb *CLIBuilder[_, LoggerT]) defaultRunHandler(
 		return cb.InterceptConfigsPreRunHandler(
 			cmd,
 			logger,
-			DefaultAppConfigTemplate(),
-			DefaultAppConfig(),
+			config.DefaultAppConfigTemplate(),
+			config.DefaultAppConfig(),
 			DefaultCometConfig(),
 		)
 	}
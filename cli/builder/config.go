@@ -23,19 +23,9 @@ package builder
 import (
 	"time"
 
-	"github.com/berachain/beacon-kit/config"
-	serverconfig "github.com/berachain/beacon-kit/config/config"
-	"github.com/berachain/beacon-kit/config/template"
 	cmtcfg "github.com/cometbft/cometbft/config"
 )
 
-// DefaultAppConfigTemplate returns the default configuration template for the
-// application.
-func DefaultAppConfigTemplate() string {
-	return serverconfig.DefaultConfigTemplate +
-		"\n" + template.TomlTemplate
-}
-
 // DefaultCometConfig returns the default configuration for the CometBFT
 // consensus engine.
 //
@@ -64,31 +54,3 @@ func DefaultCometConfig() *cmtcfg.Config {
 	cfg.P2P.MaxNumOutboundPeers = 40
 	return cfg
 }
-
-// DefaultAppConfig returns the default configuration for the application.
-func DefaultAppConfig() any {
-	// Define a struct for the custom app configuration.
-	type CustomAppConfig struct {
-		serverconfig.Config
-		BeaconKit *config.Config `mapstructure:"beacon-kit"`
-	}
-
-	// Start with the default server configuration.
-	cfg := serverconfig.DefaultConfig()
-	cfg.Telemetry.Enabled = true
-
-	// BeaconKit forces PebbleDB as the database backend.
-	cfg.Pruning = "everything"
-
-	// IAVL FastNode should ALWAYS be disabled on IAVL v1.x.
-	cfg.IAVLDisableFastNode = true
-	cfg.IAVLCacheSize = 2500
-
-	// Create the custom app configuration.
-	customAppConfig := CustomAppConfig{
-		Config:    *cfg,
-		BeaconKit: config.DefaultConfig(),
-	}
-
-	return customAppConfig
-}
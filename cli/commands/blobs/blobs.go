@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blobs
+
+import (
+	"bufio"
+	"io"
+	"os"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/config"
+	dastore "github.com/berachain/beacon-kit/da/store"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// from is the flag for the first slot to export.
+	from = "from"
+	// to is the flag for the last slot to export.
+	to = "to"
+	// output is the flag for the file to write the archive to.
+	output = "output"
+)
+
+// Commands creates a new command for exporting and importing the blob
+// sidecars of the availability store.
+func Commands[
+	LoggerT log.AdvancedLogger[LoggerT],
+](cs chain.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "blobs",
+		Short:                      "Blob sidecar subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewExportCmd[LoggerT](cs),
+		NewImportCmd[LoggerT](cs),
+	)
+	return cmd
+}
+
+// NewExportCmd creates a new command exporting the blob sidecars of a slot
+// range.
+func NewExportCmd[
+	LoggerT log.AdvancedLogger[LoggerT],
+](cs chain.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports the blob sidecars of a slot range",
+		Long: `Exports the blob sidecars stored for the slots from --from to --to,
+		both included, to an archive that can be imported on another node, e.g.
+		to seed a new node with the sidecars of the retention window.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			first, err := cmd.Flags().GetUint64(from)
+			if err != nil {
+				return err
+			}
+			last, err := cmd.Flags().GetUint64(to)
+			if err != nil {
+				return err
+			}
+			out, err := cmd.Flags().GetString(output)
+			if err != nil {
+				return err
+			}
+			store, err := openStore[LoggerT](cmd, cs)
+			if err != nil {
+				return err
+			}
+
+			if out == "" {
+				_, err = store.Export(
+					cmd.OutOrStdout(), math.Slot(first), math.Slot(last),
+				)
+				return err
+			}
+			f, err := os.OpenFile(out, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+			if err != nil {
+				return err
+			}
+			w := bufio.NewWriter(f)
+			n, err := store.Export(w, math.Slot(first), math.Slot(last))
+			if err = errors.Join(err, w.Flush(), f.Close()); err != nil {
+				return err
+			}
+			cmd.Printf(
+				"exported %d blob sidecars of slots %d to %d to %s\n",
+				n, first, last, out,
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(from, 0, "first slot to export")
+	cmd.Flags().Uint64(to, 0, "last slot to export")
+	cmd.Flags().String(output, "", "file to write to, stdout if empty")
+	_ = cmd.MarkFlagRequired(to)
+	return cmd
+}
+
+// NewImportCmd creates a new command importing a blob sidecar archive.
+func NewImportCmd[
+	LoggerT log.AdvancedLogger[LoggerT],
+](cs chain.ChainSpec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "import [file]",
+		Short: "Imports a blob sidecar archive",
+		Long: `Imports the blob sidecars of an archive written by export, or of
+		stdin if the file is -. The inclusion proof of each sidecar is verified
+		and sidecars already stored are skipped. The node must be stopped.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var r io.Reader = cmd.InOrStdin()
+			if args[0] != "-" {
+				f, err := os.Open(args[0])
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				r = f
+			}
+			store, err := openStore[LoggerT](cmd, cs)
+			if err != nil {
+				return err
+			}
+
+			n, err := store.Import(bufio.NewReader(r))
+			if err != nil {
+				return errors.Wrapf(
+					err, "imported %d blob sidecars before failing", n,
+				)
+			}
+			cmd.Printf("imported %d blob sidecars\n", n)
+			return nil
+		},
+	}
+}
+
+// openStore opens the availability store of the node home, decrypting the
+// blob sidecars with the configured key if any.
+func openStore[
+	LoggerT log.AdvancedLogger[LoggerT],
+](cmd *cobra.Command, cs chain.ChainSpec) (*dastore.Store, error) {
+	v := clicontext.GetViperFromCmd(cmd)
+	cfg, err := config.ReadConfigFromAppOpts(v)
+	if err != nil {
+		return nil, err
+	}
+	return components.ProvideAvailibilityStore(
+		components.AvailabilityStoreInput[LoggerT]{
+			AppOpts:   v,
+			ChainSpec: cs,
+			Config:    cfg,
+			Logger:    clicontext.GetLoggerFromCmd[LoggerT](cmd),
+		},
+	)
+}
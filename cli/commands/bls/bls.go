@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bls
+
+import (
+	"github.com/berachain/beacon-kit/primitives/crypto/bls"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// iterations is the flag for the number of signatures benchmarked.
+	iterations = "iterations"
+
+	// defaultIterations is the number of signatures benchmarked by default.
+	defaultIterations = 100
+)
+
+// Commands creates a new command for the BLS implementations.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "bls",
+		Short:                      "BLS implementation subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewBenchmarkCmd(),
+	)
+
+	return cmd
+}
+
+// NewBenchmarkCmd creates a new command to self-test and benchmark the BLS
+// implementations compiled into the binary.
+func NewBenchmarkCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "benchmark",
+		Short: "Self-tests and benchmarks the BLS implementations",
+		Long: `Self-tests the BLS implementations compiled into the binary, then
+		shows the average duration of signing and verifying with each.
+		supranational/blst is only compiled in with the bls12381 build tag.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			n, err := cmd.Flags().GetInt(iterations)
+			if err != nil {
+				return err
+			}
+			for _, name := range bls.Implementations() {
+				impl, err := bls.New(name)
+				if err != nil {
+					return err
+				}
+				result, err := bls.Benchmark(impl, n)
+				if err != nil {
+					return err
+				}
+				cmd.Printf(
+					"%s: sign %s/op, verify %s/op\n",
+					result.Implementation, result.Sign, result.Verify,
+				)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Int(
+		iterations, defaultIterations, "number of signatures benchmarked",
+	)
+	return cmd
+}
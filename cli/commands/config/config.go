@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// online is the flag for checking that endpoints are reachable.
+	online = "online"
+
+	onlineMsg = "also check that the configured endpoints are reachable"
+)
+
+// Commands creates a new command for managing the node configuration.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "config",
+		Short:                      "Configuration subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewShowCmd(),
+		NewValidateCmd(),
+	)
+	return cmd
+}
+
+// NewShowCmd creates a new command printing the effective node
+// configuration.
+func NewShowCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show",
+		Short: "Prints the effective node configuration",
+		Long: `Prints the effective beacon-kit configuration in the app.toml
+		format, once flags, environment variables, the app.toml file and
+		defaults are merged, in this order of precedence.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := config.ReadConfigFromAppOpts(
+				context.GetViperFromCmd(cmd),
+			)
+			if err != nil {
+				return err
+			}
+			bz, err := cfg.Render()
+			if err != nil {
+				return err
+			}
+			cmd.Print(string(bz))
+			return nil
+		},
+	}
+}
+
+// NewValidateCmd creates a new command validating the node configuration.
+func NewValidateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validates the node configuration",
+		Long: `Loads the whole node configuration (app.toml, chain spec, engine
+		endpoint, JWT secret, validator key and keystores), cross-validates it
+		and prints a report, so that misconfigurations are caught before the
+		node is started. With --online, the configured endpoints must also be
+		reachable.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			checkOnline, err := cmd.Flags().GetBool(online)
+			if err != nil {
+				return err
+			}
+
+			results := Validate(
+				cmd.Context(), context.GetViperFromCmd(cmd), checkOnline,
+			)
+			failed := 0
+			for _, res := range results {
+				if res.Err != nil {
+					failed++
+					cmd.Printf("[FAIL] %s: %v\n", res.Name, res.Err)
+					continue
+				}
+				cmd.Printf("[ OK ] %s\n", res.Name)
+			}
+			if failed > 0 {
+				return errors.Wrap(
+					ErrInvalidConfig, fmt.Sprintf("%d checks failed", failed),
+				)
+			}
+			cmd.Println("configuration is valid")
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(online, false, onlineMsg)
+	return cmd
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrInvalidConfig is returned when at least one configuration check
+	// fails.
+	ErrInvalidConfig = errors.New("invalid configuration")
+
+	// ErrAddressConflict is returned when two services listen on the same
+	// address.
+	ErrAddressConflict = errors.New("listen address conflict")
+
+	// ErrMissingEngineURL is returned when no engine endpoint is configured.
+	ErrMissingEngineURL = errors.New("engine endpoint not configured")
+
+	// ErrUnsupportedEngineURL is returned when the engine endpoint scheme is
+	// not supported.
+	ErrUnsupportedEngineURL = errors.New("unsupported engine endpoint scheme")
+
+	// ErrMissingValidatorKey is returned when a validator node has no
+	// validator key.
+	ErrMissingValidatorKey = errors.New("validator key not found")
+
+	// ErrSignerTimeout is returned when the threshold signer may wait for
+	// the other operators longer than a proposal may take.
+	ErrSignerTimeout = errors.New("signer timeout exceeds the propose timeout")
+)
@@ -0,0 +1,337 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/config"
+	dastore "github.com/berachain/beacon-kit/da/store"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/primitives/crypto/bls"
+	"github.com/berachain/beacon-kit/storage/metadata"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/spf13/viper"
+)
+
+const (
+	// dialTimeout bounds the time waited for an endpoint to be reachable.
+	dialTimeout = 3 * time.Second
+
+	// keystoresDir is the keystores directory, relative to the node home.
+	keystoresDir = "config/keystores"
+)
+
+// Result is the outcome of a configuration check.
+type Result struct {
+	// Name describes the checked configuration.
+	Name string
+	// Err is the reason the check failed, nil if it passed.
+	Err error
+}
+
+// Validate loads the node configuration held by v and checks it. Endpoints
+// are dialed only if online is set. The checks depending on the application
+// configuration are skipped if it cannot be loaded.
+func Validate(
+	ctx context.Context, v *viper.Viper, online bool,
+) []Result {
+	cfg, err := config.ReadConfigFromAppOpts(v)
+	results := []Result{{Name: "app config", Err: err}}
+	if err != nil {
+		return results
+	}
+
+	cmtCfg := clicontext.GetConfigFromViper(v)
+	_, chainSpecErr := components.ProvideChainSpec(
+		components.ChainSpecInput{AppOpts: v},
+	)
+	role, roleErr := types.ParseRole(cfg.Role)
+	results = append(results,
+		Result{Name: "comet config", Err: cmtCfg.ValidateBasic()},
+		Result{Name: "chain spec", Err: chainSpecErr},
+		Result{Name: "role", Err: roleErr},
+		Result{Name: "engine endpoint", Err: checkEngineURL(cfg)},
+		Result{Name: "jwt secret", Err: checkJWTSecret(cfg)},
+		Result{Name: "validator key", Err: checkValidatorKey(cmtCfg, role)},
+		Result{Name: "validator metadata", Err: checkMetadataFile(cfg)},
+		Result{Name: "blob encryption key", Err: checkBlobEncryptionKey(cfg)},
+		Result{Name: "keystores", Err: checkKeystores(cfg, cmtCfg)},
+		Result{Name: "remote signer", Err: checkRemoteSigner(cfg)},
+		Result{Name: "signer backend", Err: checkSignerBackend(cfg)},
+		Result{Name: "signer timeout", Err: checkSignerTimeout(cfg, cmtCfg)},
+		Result{Name: "bls implementation", Err: checkBLS(cfg)},
+		Result{
+			Name: "listen addresses",
+			Err:  checkListenAddresses(cfg, cmtCfg),
+		},
+	)
+	if online {
+		results = append(results, Result{
+			Name: "engine reachability",
+			Err:  checkEngineReachable(ctx, cfg),
+		})
+	}
+	return results
+}
+
+// checkEngineURL checks that an engine endpoint with a supported scheme is
+// configured.
+func checkEngineURL(cfg *config.Config) error {
+	dialURL := cfg.GetEngine().RPCDialURL
+	switch {
+	case dialURL == nil || dialURL.URL == nil || dialURL.String() == "":
+		return ErrMissingEngineURL
+	case dialURL.IsHTTP(), dialURL.IsHTTPS(), dialURL.IsIPC():
+		return nil
+	default:
+		return errors.Wrap(ErrUnsupportedEngineURL, dialURL.Scheme)
+	}
+}
+
+// checkJWTSecret checks that the JWT secret file holds a valid secret.
+func checkJWTSecret(cfg *config.Config) error {
+	_, err := components.LoadJWTFromFile(cfg.GetEngine().JWTSecretPath)
+	return err
+}
+
+// checkValidatorKey checks that validator nodes have a validator key. Other
+// nodes generate one if needed.
+func checkValidatorKey(cmtCfg *cmtcfg.Config, role types.Role) error {
+	if !role.ProposesBlocks() {
+		return nil
+	}
+	keyFile := cmtCfg.PrivValidatorKeyFile()
+	if _, err := os.Stat(keyFile); err != nil {
+		if os.IsNotExist(err) {
+			return errors.Wrap(ErrMissingValidatorKey, keyFile)
+		}
+		return err
+	}
+	return nil
+}
+
+// checkMetadataFile checks that the validator metadata file, if any, can be
+// loaded.
+func checkMetadataFile(cfg *config.Config) error {
+	if cfg.Validator.MetadataFile == "" {
+		return nil
+	}
+	_, err := metadata.LoadFile(cfg.Validator.MetadataFile)
+	return err
+}
+
+// checkBlobEncryptionKey checks that the key encrypting the blob sidecars at
+// rest, if any, can be loaded.
+func checkBlobEncryptionKey(cfg *config.Config) error {
+	if !cfg.AvailabilityStore.Encrypted() {
+		return nil
+	}
+	_, err := dastore.LoadCipher(cfg.AvailabilityStore.EncryptionKeyPath)
+	return err
+}
+
+// checkKeystores checks that the keystores of the keystores directory, if
+// any, are well formed, and that their password file can be read if they are
+// loaded by the node. They are not decrypted.
+func checkKeystores(cfg *config.Config, cmtCfg *cmtcfg.Config) error {
+	dir := cfg.Keystores.Dir
+	if dir == "" {
+		dir = filepath.Join(cmtCfg.RootDir, keystoresDir)
+	}
+	if cfg.Keystores.Enabled {
+		if _, err := signer.ReadPasswordFile(
+			cfg.Keystores.PasswordFile,
+		); err != nil {
+			return errors.Wrap(err, "keystores password file")
+		}
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		if _, err = signer.ReadKeystoreFile(path); err != nil {
+			return errors.Wrap(err, path)
+		}
+	}
+	return nil
+}
+
+// checkRemoteSigner checks that the pubkey and TLS files of the remote
+// signer, if any, can be loaded. The remote signer is not dialed.
+func checkRemoteSigner(cfg *config.Config) error {
+	if !cfg.RemoteSigner.Enabled() {
+		return nil
+	}
+	_, err := signer.NewRemoteSigner(cfg.RemoteSigner)
+	return err
+}
+
+// checkSignerBackend checks that the signer backend can be created, i.e.
+// that its keystore can be decrypted. The external signer is not started,
+// and the transport of the threshold signer is not dialed. The transport of
+// a threshold signer without a transport URL is provided by a plugin.
+func checkSignerBackend(cfg *config.Config) error {
+	_, err := signer.NewBackend(cfg.Signer, nil)
+	if errors.Is(err, signer.ErrNoThresholdTransport) {
+		_, err = signer.NewThresholdBackend(cfg.Signer, nil)
+	}
+	return err
+}
+
+// checkSignerTimeout checks that the threshold signer, which waits for the
+// other operators every time the proposer signs, times out before the
+// proposal does.
+func checkSignerTimeout(cfg *config.Config, cmtCfg *cmtcfg.Config) error {
+	if cfg.Signer.Backend != signer.BackendThreshold ||
+		cfg.Signer.Timeout < cmtCfg.Consensus.TimeoutPropose {
+		return nil
+	}
+	return errors.Wrapf(
+		ErrSignerTimeout, "%s, timeout_propose is %s",
+		cfg.Signer.Timeout, cmtCfg.Consensus.TimeoutPropose,
+	)
+}
+
+// checkBLS checks that the BLS implementation is compiled in and passes
+// its self-test.
+func checkBLS(cfg *config.Config) error {
+	impl, err := bls.New(cfg.BLS.Implementation)
+	if err != nil {
+		return err
+	}
+	return bls.SelfTest(impl)
+}
+
+// checkListenAddresses checks that no two enabled services listen on the
+// same port of overlapping hosts.
+func checkListenAddresses(cfg *config.Config, cmtCfg *cmtcfg.Config) error {
+	addrs := map[string]string{
+		"comet rpc": cmtCfg.RPC.ListenAddress,
+		"comet p2p": cmtCfg.P2P.ListenAddress,
+	}
+	if cmtCfg.RPC.PprofListenAddress != "" {
+		addrs["comet pprof"] = cmtCfg.RPC.PprofListenAddress
+	}
+	if cfg.NodeAPI.Enabled {
+		addrs["node api"] = cfg.NodeAPI.Address
+		if cfg.NodeAPI.AuthenticatedAddress != "" {
+			addrs["node api authenticated"] = cfg.NodeAPI.AuthenticatedAddress
+		}
+	}
+	if cfg.Diagnostics.Enabled {
+		addrs["diagnostics"] = cfg.Diagnostics.Address
+	}
+	if cfg.Probes.Enabled {
+		addrs["probes"] = cfg.Probes.Address
+	}
+	return conflictingAddresses(addrs)
+}
+
+// conflictingAddresses returns an error naming the services, keyed by name,
+// whose listen addresses conflict.
+func conflictingAddresses(addrs map[string]string) error {
+	type hostPort struct{ name, host, port string }
+	parsed := make([]hostPort, 0, len(addrs))
+	for name, addr := range addrs {
+		host, port, err := splitListenAddress(addr)
+		if err != nil {
+			return errors.Wrapf(err, "%s listen address", name)
+		}
+		parsed = append(parsed, hostPort{name, host, port})
+	}
+
+	var errs []error
+	for i, a := range parsed {
+		for _, b := range parsed[i+1:] {
+			if a.port != b.port || !hostsOverlap(a.host, b.host) {
+				continue
+			}
+			errs = append(errs, errors.Wrapf(
+				ErrAddressConflict, "%s and %s both listen on port %s",
+				min(a.name, b.name), max(a.name, b.name), a.port,
+			))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// splitListenAddress splits a listen address, optionally prefixed by its
+// protocol (e.g. tcp://), into its host and port.
+func splitListenAddress(addr string) (string, string, error) {
+	if _, rest, found := strings.Cut(addr, "://"); found {
+		addr = rest
+	}
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", "", err
+	}
+	if host == "localhost" {
+		host = "127.0.0.1"
+	}
+	return host, port, nil
+}
+
+// hostsOverlap returns whether listening on both hosts may conflict, i.e.
+// whether they are the same or one of them is unspecified.
+func hostsOverlap(a, b string) bool {
+	unspecified := func(host string) bool {
+		ip := net.ParseIP(host)
+		return host == "" || (ip != nil && ip.IsUnspecified())
+	}
+	return a == b || unspecified(a) || unspecified(b)
+}
+
+// checkEngineReachable checks that the engine endpoint accepts connections.
+func checkEngineReachable(ctx context.Context, cfg *config.Config) error {
+	dialURL := cfg.GetEngine().RPCDialURL
+	if dialURL == nil || dialURL.URL == nil {
+		return ErrMissingEngineURL
+	}
+
+	network, address := "tcp", dialURL.Host
+	if dialURL.IsIPC() {
+		network, address = "unix", dialURL.Path
+	} else if dialURL.Port() == "" {
+		port := "80"
+		if dialURL.IsHTTPS() {
+			port = "443"
+		}
+		address = net.JoinHostPort(dialURL.Hostname(), port)
+	}
+
+	dialer := net.Dialer{Timeout: dialTimeout}
+	conn, err := dialer.DialContext(ctx, network, address)
+	if err != nil {
+		return errors.Wrapf(err, "dialing %s", dialURL.Redacted())
+	}
+	return conn.Close()
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config_test
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/config"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+// newViper returns a viper instance holding a valid full node
+// configuration rooted in a temporary node home.
+func newViper(t *testing.T) *viper.Viper {
+	t.Helper()
+	t.Setenv("CHAIN_SPEC", "devnet")
+
+	home := t.TempDir()
+	jwtPath := filepath.Join(home, "jwt.hex")
+	require.NoError(t, os.WriteFile(
+		jwtPath, []byte("0x"+strings.Repeat("ab", 32)), 0o600,
+	))
+
+	v := viper.New()
+	v.Set(flags.FlagHome, home)
+	v.Set("beacon-kit.role", "full")
+	v.Set("beacon-kit.engine.rpc-dial-url", "http://localhost:8551")
+	v.Set("beacon-kit.engine.jwt-secret-path", jwtPath)
+	return v
+}
+
+// failures returns the errors of the failed checks, keyed by check name.
+func failures(results []config.Result) map[string]error {
+	failed := make(map[string]error)
+	for _, res := range results {
+		if res.Err != nil {
+			failed[res.Name] = res.Err
+		}
+	}
+	return failed
+}
+
+func TestValidate(t *testing.T) {
+	v := newViper(t)
+	results := config.Validate(context.Background(), v, false)
+	require.Empty(t, failures(results))
+}
+
+func TestValidateReportsMisconfigurations(t *testing.T) {
+	v := newViper(t)
+	v.Set("beacon-kit.role", "validator")
+	v.Set("beacon-kit.engine.rpc-dial-url", "ftp://localhost:8551")
+	v.Set("beacon-kit.engine.jwt-secret-path", "")
+	v.Set("beacon-kit.diagnostics.enabled", true)
+	v.Set("beacon-kit.diagnostics.address", "0.0.0.0:26657")
+
+	failed := failures(config.Validate(context.Background(), v, false))
+	require.ErrorIs(
+		t, failed["engine endpoint"], config.ErrUnsupportedEngineURL,
+	)
+	require.Error(t, failed["jwt secret"])
+	require.ErrorIs(t, failed["validator key"], config.ErrMissingValidatorKey)
+	require.ErrorIs(t, failed["listen addresses"], config.ErrAddressConflict)
+	require.Len(t, failed, 4)
+}
+
+func TestValidateOnline(t *testing.T) {
+	v := newViper(t)
+
+	var lc net.ListenConfig
+	listener, err := lc.Listen(context.Background(), "tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	v.Set("beacon-kit.engine.rpc-dial-url", "http://"+addr)
+
+	results := config.Validate(context.Background(), v, true)
+	require.Empty(t, failures(results))
+
+	require.NoError(t, listener.Close())
+	failed := failures(config.Validate(context.Background(), v, true))
+	require.Contains(t, failed, "engine reachability")
+}
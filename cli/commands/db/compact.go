@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"bytes"
+	"path/filepath"
+
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// Compaction is the outcome of the compaction of a database.
+type Compaction struct {
+	// Name is the name of the database.
+	Name string
+	// Before is the size of the database files before the compaction.
+	Before uint64
+	// After is the size of the database files after the compaction.
+	After uint64
+}
+
+// Compact compacts the whole key range of the key-value databases of the
+// node data directory which exist, or of the named ones if any, reclaiming
+// the space of deleted and overwritten keys.
+func Compact(homeDir string, names ...string) ([]Compaction, error) {
+	if len(names) == 0 {
+		names = Databases()
+	}
+
+	compactions := make([]Compaction, 0, len(names))
+	for _, name := range names {
+		db, err := openDB(homeDir, name)
+		if err != nil {
+			return nil, err
+		}
+		if db == nil {
+			continue
+		}
+		c, err := compact(homeDir, name, db)
+		if cerr := db.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return nil, err
+		}
+		compactions = append(compactions, c)
+	}
+	return compactions, nil
+}
+
+// compact compacts the whole key range of the given database.
+func compact(homeDir, name string, db *dbm.PebbleDB) (Compaction, error) {
+	path := filepath.Join(homeDir, "data", name+".db")
+	before, err := usage(path)
+	if err != nil {
+		return Compaction{}, err
+	}
+
+	first, last, err := keyRange(db)
+	if err != nil {
+		return Compaction{}, err
+	}
+	if first != nil {
+		// The end of the compacted range is exclusive.
+		end := append(last, 0)
+		if err = db.DB().Compact(first, end, true); err != nil {
+			return Compaction{}, err
+		}
+	}
+
+	after, err := usage(path)
+	if err != nil {
+		return Compaction{}, err
+	}
+	return Compaction{Name: name, Before: before.Bytes, After: after.Bytes}, nil
+}
+
+// keyRange returns the first and last keys of the given database, or nil if
+// it is empty.
+func keyRange(db dbm.DB) ([]byte, []byte, error) {
+	iter, err := db.Iterator(nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil, nil, iter.Error()
+	}
+	first := bytes.Clone(iter.Key())
+
+	riter, err := db.ReverseIterator(nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer riter.Close()
+	if !riter.Valid() {
+		return nil, nil, riter.Error()
+	}
+	return first, bytes.Clone(riter.Key()), nil
+}
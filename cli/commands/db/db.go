@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// Commands creates a new command for inspecting and maintaining the node
+// databases.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "db",
+		Short:                      "Node database subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewStatsCmd(),
+		NewCompactCmd(),
+	)
+	return cmd
+}
+
+// NewStatsCmd creates a new command reporting the node database statistics.
+func NewStatsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "stats",
+		Short: "Reports the node database statistics",
+		Long: `Reports the disk usage of the node data directory, the key counts
+		and sizes of the node databases grouped by key prefix, and those of the
+		latest beacon state grouped by collection. The node must be stopped.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			homeDir := clicontext.GetConfigFromCmd(cmd).RootDir
+			usages, err := DiskUsage(homeDir)
+			if err != nil {
+				return err
+			}
+			stats, err := Stats(homeDir)
+			if err != nil {
+				return err
+			}
+			state, err := StateStats(homeDir)
+			if err != nil {
+				return err
+			}
+			if state != nil {
+				stats = append(stats, *state)
+			}
+			return printStats(cmd.OutOrStdout(), usages, stats)
+		},
+	}
+}
+
+// NewCompactCmd creates a new command compacting the node databases.
+func NewCompactCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "compact [database...]",
+		Short: "Compacts the node databases",
+		Long: `Compacts the given node databases, or all of them, reclaiming the
+		space of deleted and overwritten keys, such as the ones of pruned
+		versions of the application state. The node must be stopped.`,
+		ValidArgs: Databases(),
+		Args:      cobra.OnlyValidArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			compactions, err := Compact(
+				clicontext.GetConfigFromCmd(cmd).RootDir, args...,
+			)
+			if err != nil {
+				return err
+			}
+			for _, c := range compactions {
+				cmd.Printf(
+					"compacted %s from %d to %d bytes\n",
+					c.Name, c.Before, c.After,
+				)
+			}
+			return nil
+		},
+	}
+}
+
+// printStats writes the disk usage and the database statistics as tables.
+func printStats(out io.Writer, usages []Usage, stats []DBStats) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DATA\tFILES\tBYTES\t")
+	for _, u := range usages {
+		fmt.Fprintf(w, "%s\t%d\t%d\t\n", u.Name, u.Files, u.Bytes)
+	}
+	for _, s := range stats {
+		fmt.Fprintf(w, "\t\t\t\n%s\tKEYS\tBYTES\t\n", s.Name)
+		for _, p := range s.Prefixes {
+			fmt.Fprintf(w, "%s\t%d\t%d\t\n", p.Prefix, p.Keys, p.Bytes)
+		}
+		fmt.Fprintf(w, "total\t%d\t%d\t\n", s.Keys, s.Bytes)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/cli/commands/db"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/storage/beacondb/keys"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+// testHome returns a node home whose application database holds a committed
+// beacon state and whose deposit database holds a few keys.
+func testHome(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	dataDir := filepath.Join(home, "data")
+
+	appDB, err := dbm.NewPebbleDB("application", dataDir, nil)
+	require.NoError(t, err)
+	cms := store.NewCommitMultiStore(
+		appDB, log.NewNopLogger(), storemetrics.NewNoOpMetrics(),
+	)
+	key := components.ProvideKVStoreKey()
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	kv := cms.GetKVStore(key)
+	kv.Set([]byte{keys.SlotPrefix}, []byte{0x0a})
+	kv.Set([]byte{keys.BalancesPrefix, 0x00}, []byte{0x01, 0x02})
+	kv.Set([]byte{keys.BalancesPrefix, 0x01}, []byte{0x03, 0x04})
+	kv.Set(append([]byte("val_pk_to_idx"), 0x01), []byte{0x00})
+	cms.Commit()
+	require.NoError(t, appDB.Close())
+
+	deposits, err := dbm.NewPebbleDB("deposits", dataDir, nil)
+	require.NoError(t, err)
+	require.NoError(t, deposits.Set([]byte{0x00, 0x01}, []byte{0x01}))
+	require.NoError(t, deposits.Set([]byte{0x00, 0x02}, []byte{0x02}))
+	require.NoError(t, deposits.Set([]byte{0x01}, []byte{0x03}))
+	require.NoError(t, deposits.Delete([]byte{0x01}))
+	require.NoError(t, deposits.Close())
+	return home
+}
+
+func TestStats(t *testing.T) {
+	home := testHome(t)
+
+	stats, err := db.Stats(home)
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	require.Equal(t, "application", stats[0].Name)
+	prefixes := stats[0].Prefixes
+	require.Equal(t, "s/k:beacon/", prefixes[len(prefixes)-1].Prefix)
+	require.Equal(t, "deposits", stats[1].Name)
+	require.Equal(t, []db.PrefixStats{
+		{Prefix: "0x00", Keys: 2, Bytes: 6},
+	}, stats[1].Prefixes)
+	require.Equal(t, uint64(2), stats[1].Keys)
+	require.Equal(t, uint64(6), stats[1].Bytes)
+
+	state, err := db.StateStats(home)
+	require.NoError(t, err)
+	require.Equal(t, "beacon state at height 1", state.Name)
+	require.Equal(t, []db.PrefixStats{
+		{Prefix: keys.BalancesPrefixHumanReadable, Keys: 2, Bytes: 8},
+		{Prefix: keys.SlotPrefixHumanReadable, Keys: 1, Bytes: 2},
+		{Prefix: "val_pk_to_idx", Keys: 1, Bytes: 15},
+	}, state.Prefixes)
+
+	usages, err := db.DiskUsage(home)
+	require.NoError(t, err)
+	require.Len(t, usages, 2)
+	require.Equal(t, "application.db", usages[0].Name)
+	require.NotZero(t, usages[0].Bytes)
+}
+
+func TestStatsEmptyHome(t *testing.T) {
+	home := t.TempDir()
+
+	stats, err := db.Stats(home)
+	require.NoError(t, err)
+	require.Empty(t, stats)
+
+	state, err := db.StateStats(home)
+	require.NoError(t, err)
+	require.Nil(t, state)
+}
+
+func TestCompact(t *testing.T) {
+	home := testHome(t)
+
+	compactions, err := db.Compact(home, "deposits")
+	require.NoError(t, err)
+	require.Len(t, compactions, 1)
+	require.Equal(t, "deposits", compactions[0].Name)
+	require.NotZero(t, compactions[0].After)
+
+	// The compacted database keeps its keys.
+	stats, err := db.Stats(home)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), stats[1].Keys)
+
+	compactions, err = db.Compact(home)
+	require.NoError(t, err)
+	require.Len(t, compactions, 2)
+}
@@ -0,0 +1,302 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/storage/beacondb/index"
+	"github.com/berachain/beacon-kit/storage/beacondb/keys"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+const (
+	// applicationDB is the name of the application database.
+	applicationDB = "application"
+	// storePrefix is the prefix of the keys of the stores mounted in the
+	// application database.
+	storePrefix = "s/k:"
+)
+
+// Databases returns the names of the key-value databases kept by the node
+// in its data directory.
+func Databases() []string {
+	return []string{
+		applicationDB,
+		"deposits",
+		"state-snapshots",
+		"validator-metadata",
+	}
+}
+
+// Usage is the disk usage of an entry of the data directory.
+type Usage struct {
+	// Name is the name of the entry.
+	Name string
+	// Files is the number of files of the entry.
+	Files uint64
+	// Bytes is the size of the files of the entry.
+	Bytes uint64
+}
+
+// PrefixStats are the statistics of the keys sharing a prefix.
+type PrefixStats struct {
+	// Prefix is the key prefix, or the name of the collection it belongs
+	// to when known.
+	Prefix string
+	// Keys is the number of keys with the prefix.
+	Keys uint64
+	// Bytes is the size of the keys with the prefix and their values.
+	Bytes uint64
+}
+
+// DBStats are the statistics of a key-value database.
+type DBStats struct {
+	// Name is the name of the database.
+	Name string
+	// Keys is the number of keys of the database.
+	Keys uint64
+	// Bytes is the size of the keys of the database and their values.
+	Bytes uint64
+	// Prefixes are the statistics of the keys grouped by prefix.
+	Prefixes []PrefixStats
+}
+
+// DiskUsage returns the disk usage of each entry of the node data
+// directory, including the CometBFT databases, the availability store and
+// the freezer.
+func DiskUsage(homeDir string) ([]Usage, error) {
+	dataDir := filepath.Join(homeDir, "data")
+	entries, err := os.ReadDir(dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make([]Usage, 0, len(entries))
+	for _, e := range entries {
+		var u Usage
+		u, err = usage(filepath.Join(dataDir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		usages = append(usages, u)
+	}
+	return usages, nil
+}
+
+// usage returns the disk usage of the given file or directory.
+func usage(path string) (Usage, error) {
+	u := Usage{Name: filepath.Base(path)}
+	err := filepath.WalkDir(
+		path,
+		func(_ string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			u.Files++
+			//#nosec:G115 // file sizes are not negative.
+			u.Bytes += uint64(info.Size())
+			return nil
+		},
+	)
+	return u, err
+}
+
+// Stats returns the statistics of the key-value databases of the node data
+// directory which exist, with their keys grouped by prefix.
+func Stats(homeDir string) ([]DBStats, error) {
+	stats := make([]DBStats, 0, len(Databases()))
+	for _, name := range Databases() {
+		db, err := openDB(homeDir, name)
+		if err != nil {
+			return nil, err
+		}
+		if db == nil {
+			continue
+		}
+		groupBy := bytePrefix
+		if name == applicationDB {
+			groupBy = storeKeyPrefix
+		}
+		s, err := scanDB(db, groupBy)
+		if cerr := db.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return nil, err
+		}
+		s.Name = name
+		stats = append(stats, s)
+	}
+	return stats, nil
+}
+
+// StateStats returns the statistics of the latest beacon state held by the
+// application database, with its keys grouped by collection. It returns nil
+// if the node has not committed a state yet.
+func StateStats(homeDir string) (*DBStats, error) {
+	db, err := openDB(homeDir, applicationDB)
+	if err != nil || db == nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	// Loading the stores of an empty database would write to it.
+	if rootmulti.GetLatestVersion(db) == 0 {
+		return nil, nil
+	}
+	cms := store.NewCommitMultiStore(
+		db, log.NewNopLogger(), storemetrics.NewNoOpMetrics(),
+	)
+	key := components.ProvideKVStoreKey()
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	if err = cms.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+
+	s, err := scan(cms.GetKVStore(key).Iterator(nil, nil), collectionPrefix)
+	if err != nil {
+		return nil, err
+	}
+	s.Name = fmt.Sprintf("beacon state at height %d", cms.LatestVersion())
+	return &s, nil
+}
+
+// openDB opens the database of the node data directory with the given name,
+// returning nil if it does not exist.
+func openDB(homeDir, name string) (*dbm.PebbleDB, error) {
+	dataDir := filepath.Join(homeDir, "data")
+	if _, err := os.Stat(filepath.Join(dataDir, name+".db")); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	db, err := dbm.NewPebbleDB(name, dataDir, nil)
+	if err != nil {
+		return nil, err
+	}
+	//nolint:errcheck // NewPebbleDB always returns a *PebbleDB.
+	return db.(*dbm.PebbleDB), nil
+}
+
+// scan returns the statistics of the keys of the given iterator, grouped by
+// the prefix returned by groupBy. It closes the iterator.
+func scan(
+	iter storetypes.Iterator, groupBy func([]byte) string,
+) (DBStats, error) {
+	defer iter.Close()
+
+	var stats DBStats
+	byPrefix := make(map[string]*PrefixStats)
+	for ; iter.Valid(); iter.Next() {
+		k := iter.Key()
+		size := uint64(len(k) + len(iter.Value()))
+		prefix := groupBy(k)
+		p, ok := byPrefix[prefix]
+		if !ok {
+			p = &PrefixStats{Prefix: prefix}
+			byPrefix[prefix] = p
+		}
+		p.Keys++
+		p.Bytes += size
+		stats.Keys++
+		stats.Bytes += size
+	}
+	if err := iter.Error(); err != nil {
+		return DBStats{}, err
+	}
+
+	stats.Prefixes = make([]PrefixStats, 0, len(byPrefix))
+	for _, p := range byPrefix {
+		stats.Prefixes = append(stats.Prefixes, *p)
+	}
+	sort.Slice(stats.Prefixes, func(i, j int) bool {
+		return stats.Prefixes[i].Prefix < stats.Prefixes[j].Prefix
+	})
+	return stats, nil
+}
+
+// scanDB returns the statistics of all the keys of the given database,
+// grouped by the prefix returned by groupBy.
+func scanDB(db dbm.DB, groupBy func([]byte) string) (DBStats, error) {
+	iter, err := db.Iterator(nil, nil)
+	if err != nil {
+		return DBStats{}, err
+	}
+	return scan(iter, groupBy)
+}
+
+// bytePrefix groups keys by their first byte, which is the collection
+// prefix of the stores built on collections.
+func bytePrefix(key []byte) string {
+	if len(key) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("0x%02x", key[0])
+}
+
+// storeKeyPrefix groups the keys of the application database by the store
+// they belong to, keeping the other keys, such as the commit info, under
+// their first path segment.
+func storeKeyPrefix(key []byte) string {
+	k := string(key)
+	if strings.HasPrefix(k, storePrefix) {
+		if i := strings.IndexByte(k[len(storePrefix):], '/'); i >= 0 {
+			return k[:len(storePrefix)+i+1]
+		}
+	}
+	if i := strings.IndexByte(k, '/'); i >= 0 {
+		return k[:i+1]
+	}
+	return bytePrefix(key)
+}
+
+// collectionPrefix groups the keys of the beacon state by the collection
+// they belong to.
+func collectionPrefix(key []byte) string {
+	for _, p := range index.Prefixes() {
+		if strings.HasPrefix(string(key), p) {
+			return p
+		}
+	}
+	if len(key) > 0 {
+		if name, ok := keys.Name(key[0]); ok {
+			return name
+		}
+	}
+	return bytePrefix(key)
+}
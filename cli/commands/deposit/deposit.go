@@ -44,6 +44,7 @@ func Commands(
 	cmd.AddCommand(
 		NewValidateDeposit(chainSpec),
 		NewCreateValidator(chainSpec),
+		NewCreateDeposit(chainSpec),
 	)
 
 	return cmd
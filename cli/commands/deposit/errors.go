@@ -42,4 +42,15 @@ var (
 	// ErrPrivateKeyEmpty is returned when the private key is empty.
 	ErrPrivateKeyEmpty = errors.New(
 		"private key is empty")
+
+	// ErrKeystoreRequired is returned when the keystore flag is not set.
+	ErrKeystoreRequired = errors.New("keystore required")
+
+	// ErrPasswordFileRequired is returned when the password file flag is not
+	// set.
+	ErrPasswordFileRequired = errors.New("password file required")
+
+	// ErrDepositTransactionFailed is returned when the deposit transaction
+	// is reverted.
+	ErrDepositTransactionFailed = errors.New("deposit transaction failed")
 )
@@ -29,6 +29,29 @@ const (
 
 	// validatorPrivateKey is the flag for the validator private key.
 	valPrivateKey = "validator-private-key"
+
+	// keystore is the flag for the validator keystore file.
+	keystore = "keystore"
+
+	// passwordFile is the flag for the file holding the keystore password.
+	passwordFile = "password-file"
+
+	// genesisValidatorsRoot is the flag for the genesis validators root the
+	// deposit is signed over.
+	genesisValidatorsRoot = "genesis-validators-root"
+
+	// epoch is the flag for the epoch whose active fork the deposit is
+	// signed over.
+	epoch = "epoch"
+
+	// operator is the flag for the validator operator address.
+	operator = "operator"
+
+	// broadcast is the flag for broadcasting the deposit transaction.
+	broadcast = "broadcast"
+
+	// rpcURL is the flag for the execution client JSON-RPC url.
+	rpcURL = "rpc-url"
 )
 
 const (
@@ -47,6 +70,9 @@ const (
 	// defaultValidatorPrivateKey is the default value for the
 	// validatorPrivateKey flag.
 	defaultValidatorPrivateKey = ""
+
+	// defaultRPCURL is the default value for the rpcURL flag.
+	defaultRPCURL = "http://localhost:8545"
 )
 
 const (
@@ -62,4 +88,27 @@ const (
 	// valPrivateKey flag.
 	valPrivateKeyMsg = `validator private key. This is required if the 
 	override-node-key flag is set.`
+
+	// keystoreMsg is the usage description for the keystore flag.
+	keystoreMsg = "EIP-2335 keystore holding the validator key"
+
+	// passwordFileMsg is the usage description for the passwordFile flag.
+	passwordFileMsg = "file holding the keystore password"
+
+	// genesisValidatorsRootMsg is the usage description for the
+	// genesisValidatorsRoot flag.
+	genesisValidatorsRootMsg = "genesis validators root of the chain"
+
+	// epochMsg is the usage description for the epoch flag.
+	epochMsg = "epoch whose active fork version the deposit is signed over"
+
+	// operatorMsg is the usage description for the operator flag.
+	operatorMsg = `validator operator address. Defaults to the withdrawal
+	address.`
+
+	// broadcastMsg is the usage description for the broadcast flag.
+	broadcastMsg = "sign and broadcast the deposit transaction"
+
+	// rpcURLMsg is the usage description for the rpcURL flag.
+	rpcURLMsg = "execution client JSON-RPC url the transaction is sent to"
 )
@@ -0,0 +1,312 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"os"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/cli/utils/parser"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/geth-primitives/bind"
+	gethcrypto "github.com/berachain/beacon-kit/geth-primitives/crypto"
+	"github.com/berachain/beacon-kit/geth-primitives/deposit"
+	"github.com/berachain/beacon-kit/geth-primitives/ethclient"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/hex"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/spf13/cobra"
+)
+
+// NewCreateDeposit creates a new command to create a deposit contract
+// transaction.
+func NewCreateDeposit(chainSpec chain.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [withdrawal-address] [amount]",
+		Short: "Creates a deposit contract transaction from a keystore",
+		Long: `Creates a deposit contract transaction for the validator key held
+		by the keystore, depositing the given amount in Gwei with the
+		credentials of the given withdrawal address. The deposit is signed over
+		the deposit domain of the fork active at the given epoch. The deposit
+		data root and the transaction calldata are printed. If the broadcast
+		flag is set, the transaction is signed with the given private key and
+		sent to the execution client.`,
+		Args: cobra.ExactArgs(2), //nolint:mnd // The number of arguments.
+		RunE: createDepositCmd(chainSpec),
+	}
+
+	cmd.Flags().String(keystore, "", keystoreMsg)
+	cmd.Flags().String(passwordFile, "", passwordFileMsg)
+	cmd.Flags().String(
+		genesisValidatorsRoot, common.Root{}.String(), genesisValidatorsRootMsg,
+	)
+	cmd.Flags().Uint64(epoch, 0, epochMsg)
+	cmd.Flags().String(operator, "", operatorMsg)
+	cmd.Flags().Bool(broadcast, false, broadcastMsg)
+	cmd.Flags().String(privateKey, defaultPrivateKey, privateKeyMsg)
+	cmd.Flags().String(rpcURL, defaultRPCURL, rpcURLMsg)
+
+	return cmd
+}
+
+// createDepositCmd returns a command that builds a deposit contract
+// transaction.
+func createDepositCmd(
+	chainSpec chain.ChainSpec,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		logger := log.NewLogger(os.Stdout)
+
+		blsSigner, err := getKeystoreSigner(cmd)
+		if err != nil {
+			return err
+		}
+
+		withdrawalAddress, err := parser.ConvertWithdrawalAddress(args[0])
+		if err != nil {
+			return err
+		}
+		amount, err := parser.ConvertAmount(args[1])
+		if err != nil {
+			return err
+		}
+		operatorAddress, err := getOperator(cmd, withdrawalAddress)
+		if err != nil {
+			return err
+		}
+		forkData, err := getForkData(cmd, chainSpec)
+		if err != nil {
+			return err
+		}
+
+		// Create, sign and verify the deposit message.
+		depositMsg, signature, err := types.CreateAndSignDepositMessage(
+			forkData,
+			chainSpec.DomainTypeDeposit(),
+			blsSigner,
+			types.NewCredentialsFromExecutionAddress(withdrawalAddress),
+			amount,
+		)
+		if err != nil {
+			return err
+		}
+		if err = depositMsg.VerifyCreateValidator(
+			forkData,
+			signature,
+			chainSpec.DomainTypeDeposit(),
+			blsSigner.VerifySignature,
+		); err != nil {
+			return err
+		}
+		depositData := types.NewDepositData(depositMsg, signature)
+
+		contractABI, err := deposit.DepositContractMetaData.GetAbi()
+		if err != nil {
+			return err
+		}
+		callData, err := contractABI.Pack(
+			"deposit",
+			depositMsg.Pubkey[:],
+			depositMsg.Credentials[:],
+			signature[:],
+			gethprimitives.ExecutionAddress(operatorAddress),
+		)
+		if err != nil {
+			return err
+		}
+
+		logger.Info(
+			"Deposit Transaction",
+			"to", chainSpec.DepositContractAddress().String(),
+			"value", amount.ToWei().String(),
+			"pubkey", depositMsg.Pubkey.String(),
+			"withdrawal credentials", depositMsg.Credentials.String(),
+			"amount", depositMsg.Amount,
+			"signature", signature.String(),
+			"deposit data root", depositData.HashTreeRoot().String(),
+			"calldata", hex.EncodeBytes(callData),
+		)
+
+		shouldBroadcast, err := cmd.Flags().GetBool(broadcast)
+		if err != nil || !shouldBroadcast {
+			return err
+		}
+		return broadcastDeposit(
+			cmd, logger, chainSpec, depositData, operatorAddress,
+		)
+	}
+}
+
+// broadcastDeposit signs the deposit contract transaction with the private
+// key flag and sends it to the execution client, waiting for it to be mined.
+func broadcastDeposit(
+	cmd *cobra.Command,
+	logger log.Logger,
+	chainSpec chain.ChainSpec,
+	depositData *types.DepositData,
+	operatorAddress common.ExecutionAddress,
+) error {
+	pk, err := cmd.Flags().GetString(privateKey)
+	if err != nil {
+		return err
+	}
+	if pk == "" {
+		return ErrPrivateKeyRequired
+	}
+	ecdsaKey, err := gethcrypto.HexToECDSA(pk)
+	if err != nil {
+		return err
+	}
+	url, err := cmd.Flags().GetString(rpcURL)
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	client, err := ethclient.Dial(url)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(ecdsaKey, chainID)
+	if err != nil {
+		return err
+	}
+	opts.Context = ctx
+	opts.Value = depositData.Amount.ToWei().ToBig()
+
+	contract, err := deposit.NewDepositContract(
+		gethprimitives.ExecutionAddress(chainSpec.DepositContractAddress()),
+		client,
+	)
+	if err != nil {
+		return err
+	}
+	tx, err := contract.Deposit(
+		opts,
+		depositData.Pubkey[:],
+		depositData.Credentials[:],
+		depositData.Signature[:],
+		gethprimitives.ExecutionAddress(operatorAddress),
+	)
+	if err != nil {
+		return err
+	}
+
+	receipt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil {
+		return err
+	}
+	if receipt == nil {
+		return ErrDepositReceiptEmpty
+	}
+	if receipt.Status != 1 {
+		return errors.Wrapf(
+			ErrDepositTransactionFailed, "tx %s", tx.Hash().Hex(),
+		)
+	}
+
+	logger.Info(
+		"Deposit transaction mined",
+		"tx", tx.Hash().Hex(),
+		"block", receipt.BlockNumber.String(),
+	)
+	return nil
+}
+
+// getKeystoreSigner returns a BLS signer holding the key of the keystore
+// flag, decrypted with the password of the password file flag.
+func getKeystoreSigner(cmd *cobra.Command) (*signer.LegacySigner, error) {
+	keystorePath, err := cmd.Flags().GetString(keystore)
+	if err != nil {
+		return nil, err
+	}
+	if keystorePath == "" {
+		return nil, ErrKeystoreRequired
+	}
+	pwPath, err := cmd.Flags().GetString(passwordFile)
+	if err != nil {
+		return nil, err
+	}
+	if pwPath == "" {
+		return nil, ErrPasswordFileRequired
+	}
+
+	password, err := signer.ReadPasswordFile(pwPath)
+	if err != nil {
+		return nil, err
+	}
+	ks, err := signer.ReadKeystoreFile(keystorePath)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ks.Decrypt(password)
+	if err != nil {
+		return nil, err
+	}
+	return signer.NewLegacySigner(key)
+}
+
+// getOperator returns the operator flag, defaulting to the withdrawal
+// address.
+func getOperator(
+	cmd *cobra.Command,
+	withdrawalAddress common.ExecutionAddress,
+) (common.ExecutionAddress, error) {
+	operatorHex, err := cmd.Flags().GetString(operator)
+	if err != nil {
+		return common.ExecutionAddress{}, err
+	}
+	if operatorHex == "" {
+		return withdrawalAddress, nil
+	}
+	return parser.ConvertWithdrawalAddress(operatorHex)
+}
+
+// getForkData returns the fork data the deposit is signed over, which is
+// the one of the fork active at the epoch flag.
+func getForkData(
+	cmd *cobra.Command,
+	chainSpec chain.ChainSpec,
+) (*types.ForkData, error) {
+	rootHex, err := cmd.Flags().GetString(genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	root, err := parser.ConvertGenesisValidatorRoot(rootHex)
+	if err != nil {
+		return nil, err
+	}
+	e, err := cmd.Flags().GetUint64(epoch)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewForkDataAtEpoch(chainSpec, math.Epoch(e), root), nil
+}
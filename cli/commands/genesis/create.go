@@ -0,0 +1,396 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/cli/utils/parser"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/cometbft/cometbft/privval"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	cosmosversion "github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagAmount is the flag for the deposit amount of each validator.
+	flagAmount = "amount"
+	// flagWithdrawalAddress is the flag for the withdrawal address of the
+	// validators.
+	flagWithdrawalAddress = "withdrawal-address"
+	// flagOutputDir is the flag for the directory the nodes files are
+	// written to.
+	flagOutputDir = "output-dir"
+	// flagOverwrite is the flag for overwriting existing files.
+	flagOverwrite = "overwrite"
+)
+
+// ErrNoValidators is returned when creating a genesis without validators.
+var ErrNoValidators = errors.New("at least one validator is required")
+
+// CreateGenesisCmd returns the cobra command creating a complete genesis
+// with premined validators. The resulting genesis is validated by the given
+// genesis validator.
+func CreateGenesisCmd(
+	cs chain.ChainSpec,
+	gv interface {
+		ValidateGenesis(genesisData map[string]json.RawMessage) error
+	},
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [num-validators] [eth/genesis/file.json]",
+		Short: "creates a genesis file with premined validators",
+		Long: `Creates a complete genesis with the given number of premined
+		validators. A validator key is generated for each validator, along
+		with its genesis deposit of the given amount. The beacon genesis embeds
+		the execution payload of the given eth1 genesis, and the CometBFT
+		genesis file is written for each of the validator nodes, whose files
+		are laid out as node homes in the output directory.`,
+		Args: cobra.ExactArgs(2), //nolint:mnd // The number of arguments.
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var numValidators int
+			if _, err := fmt.Sscan(args[0], &numValidators); err != nil {
+				return errors.Wrap(err, "invalid number of validators")
+			}
+			if numValidators <= 0 {
+				return ErrNoValidators
+			}
+			ethGenesisBz, err := afero.ReadFile(afero.NewOsFs(), args[1])
+			if err != nil {
+				return errors.Wrap(err, "failed to read eth1 genesis file")
+			}
+
+			amount, err := getAmount(cmd, cs)
+			if err != nil {
+				return err
+			}
+			addressHex, err := cmd.Flags().GetString(flagWithdrawalAddress)
+			if err != nil {
+				return err
+			}
+			withdrawalAddress, err := parser.ConvertWithdrawalAddress(
+				addressHex,
+			)
+			if err != nil {
+				return err
+			}
+			outputDir, err := getOutputDir(cmd)
+			if err != nil {
+				return err
+			}
+			overwrite, err := cmd.Flags().GetBool(flagOverwrite)
+			if err != nil {
+				return err
+			}
+
+			// Generate the validator keys.
+			keys := make([]*bls12381.PrivKey, numValidators)
+			signers := make([]crypto.BLSSigner, numValidators)
+			for i := range numValidators {
+				if keys[i], err = bls12381.GenPrivKey(); err != nil {
+					return err
+				}
+				if signers[i], err = signer.NewLegacySigner(
+					signer.LegacyKey(keys[i].Bytes()),
+				); err != nil {
+					return err
+				}
+			}
+
+			genesis, err := BuildGenesis(
+				cs, signers, amount, withdrawalAddress, ethGenesisBz,
+			)
+			if err != nil {
+				return err
+			}
+			appGenesis, err := buildAppGenesis(cmd, cs, genesis)
+			if err != nil {
+				return err
+			}
+			appGenesisState, err := genutiltypes.GenesisStateFromAppGenesis(
+				appGenesis,
+			)
+			if err != nil {
+				return err
+			}
+			if err = gv.ValidateGenesis(appGenesisState); err != nil {
+				return errors.Wrap(err, "invalid genesis")
+			}
+
+			// Write the genesis and the validator keys of each node.
+			for i, key := range keys {
+				home := filepath.Join(outputDir, fmt.Sprintf("node%d", i))
+				if err = writeNodeFiles(
+					home, key, appGenesis, overwrite,
+				); err != nil {
+					return err
+				}
+			}
+			genesisFile := filepath.Join(outputDir, "genesis.json")
+			if err = exportGenesis(
+				appGenesis, genesisFile, overwrite,
+			); err != nil {
+				return err
+			}
+
+			cmd.Printf(
+				"Successfully wrote genesis with %d validators to: %s\n"+
+					"genesis validators root: %s\n",
+				numValidators, genesisFile,
+				genesisValidatorsRoot(cs, genesis.Deposits),
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(
+		flagAmount, "", "deposit amount of each validator in Gwei. "+
+			"Defaults to the max effective balance.",
+	)
+	cmd.Flags().String(
+		flagWithdrawalAddress, common.ExecutionAddress{}.String(),
+		"withdrawal address of the validators",
+	)
+	cmd.Flags().String(
+		flagOutputDir, "", "directory the node homes and genesis are "+
+			"written to. Defaults to the testnet directory of the home.",
+	)
+	cmd.Flags().String(flags.FlagChainID, "", "genesis file chain-id")
+	cmd.Flags().Bool(flagOverwrite, false, "overwrite existing files")
+	return cmd
+}
+
+// BuildGenesis builds the beacon genesis of the given validators, each
+// depositing the given amount, on top of the given eth1 genesis.
+func BuildGenesis(
+	cs chain.ChainSpec,
+	signers []crypto.BLSSigner,
+	amount math.Gwei,
+	withdrawalAddress common.ExecutionAddress,
+	ethGenesisBz []byte,
+) (*types.Genesis, error) {
+	if len(signers) == 0 {
+		return nil, ErrNoValidators
+	}
+
+	forkVersion := version.FromUint32[common.Version](
+		cs.ActiveForkVersionForEpoch(math.Epoch(constants.GenesisEpoch)),
+	)
+	forkData := types.NewForkData(forkVersion, common.Root{})
+	credentials := types.NewCredentialsFromExecutionAddress(withdrawalAddress)
+
+	deposits := make([]*types.Deposit, len(signers))
+	for i, s := range signers {
+		depositMsg, signature, err := types.CreateAndSignDepositMessage(
+			forkData, cs.DomainTypeDeposit(), s, credentials, amount,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if err = depositMsg.VerifyCreateValidator(
+			forkData, signature, cs.DomainTypeDeposit(), s.VerifySignature,
+		); err != nil {
+			return nil, err
+		}
+		deposits[i] = types.NewDeposit(
+			depositMsg.Pubkey,
+			depositMsg.Credentials,
+			depositMsg.Amount,
+			signature,
+			uint64(i),
+		)
+	}
+
+	ethGenesis := &gethprimitives.Genesis{}
+	if err := ethGenesis.UnmarshalJSON(ethGenesisBz); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal eth1 genesis")
+	}
+	payload := gethprimitives.BlockToExecutableData(
+		ethGenesis.ToBlock(), nil, nil,
+	).ExecutionPayload
+	header, err := executableDataToExecutionPayloadHeader(
+		version.ToUint32(forkVersion), payload, cs.MaxWithdrawalsPerPayload(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Genesis{
+		ForkVersion:            forkVersion,
+		Deposits:               deposits,
+		ExecutionPayloadHeader: header,
+	}, nil
+}
+
+// buildAppGenesis wraps the beacon genesis into the CometBFT genesis.
+func buildAppGenesis(
+	cmd *cobra.Command,
+	cs chain.ChainSpec,
+	genesis *types.Genesis,
+) (*genutiltypes.AppGenesis, error) {
+	chainID, err := cmd.Flags().GetString(flags.FlagChainID)
+	if err != nil {
+		return nil, err
+	}
+	if chainID == "" {
+		chainID = fmt.Sprintf("beacond-%d", cs.DepositEth1ChainID())
+	}
+	consensusParams, ok := cs.
+		GetCometBFTConfigForSlot(0).(*cmttypes.ConsensusParams)
+	if !ok {
+		return nil, errors.New("chain spec has no CometBFT consensus params")
+	}
+
+	beaconGenesis, err := json.Marshal(genesis)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal beacon genesis")
+	}
+	appState, err := json.MarshalIndent(
+		map[string]json.RawMessage{"beacon": beaconGenesis}, "", "  ",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &genutiltypes.AppGenesis{
+		AppName:       cosmosversion.AppName,
+		AppVersion:    cosmosversion.Version,
+		ChainID:       chainID,
+		AppState:      appState,
+		InitialHeight: 1,
+		Consensus: &genutiltypes.ConsensusGenesis{
+			Params: consensusParams,
+		},
+	}, nil
+}
+
+// writeNodeFiles writes the validator key and the genesis of a node home.
+func writeNodeFiles(
+	home string,
+	key *bls12381.PrivKey,
+	appGenesis *genutiltypes.AppGenesis,
+	overwrite bool,
+) error {
+	fs := afero.NewOsFs()
+	keyFile := filepath.Join(home, "config", "priv_validator_key.json")
+	stateFile := filepath.Join(home, "data", "priv_validator_state.json")
+	exists, err := afero.Exists(fs, keyFile)
+	if err != nil {
+		return err
+	}
+	if exists && !overwrite {
+		return fmt.Errorf("validator key already exists: %s", keyFile)
+	}
+	if err = fs.MkdirAll(filepath.Dir(keyFile), os.ModePerm); err != nil {
+		return err
+	}
+	if err = fs.MkdirAll(filepath.Dir(stateFile), os.ModePerm); err != nil {
+		return err
+	}
+	privval.NewFilePV(key, keyFile, stateFile).Save()
+
+	return exportGenesis(
+		appGenesis, filepath.Join(home, "config", "genesis.json"), overwrite,
+	)
+}
+
+// exportGenesis writes the genesis to the given file.
+func exportGenesis(
+	appGenesis *genutiltypes.AppGenesis,
+	genesisFile string,
+	overwrite bool,
+) error {
+	exists, err := afero.Exists(afero.NewOsFs(), genesisFile)
+	if err != nil {
+		return err
+	}
+	if exists && !overwrite {
+		return fmt.Errorf("genesis file already exists: %s", genesisFile)
+	}
+	return genutil.ExportGenesisFile(appGenesis, genesisFile)
+}
+
+// getAmount returns the amount flag, defaulting to the max effective
+// balance.
+func getAmount(cmd *cobra.Command, cs chain.ChainSpec) (math.Gwei, error) {
+	amount, err := cmd.Flags().GetString(flagAmount)
+	if err != nil {
+		return 0, err
+	}
+	if amount == "" {
+		return math.Gwei(cs.MaxEffectiveBalance(false)), nil
+	}
+	return parser.ConvertAmount(amount)
+}
+
+// getOutputDir returns the output dir flag, defaulting to the testnet
+// directory of the home.
+func getOutputDir(cmd *cobra.Command) (string, error) {
+	outputDir, err := cmd.Flags().GetString(flagOutputDir)
+	if err != nil {
+		return "", err
+	}
+	if outputDir != "" {
+		return outputDir, nil
+	}
+	return filepath.Join(
+		context.GetConfigFromCmd(cmd).RootDir, "testnet",
+	), nil
+}
+
+// genesisValidatorsRoot computes the genesis validators root of the given
+// genesis deposits.
+func genesisValidatorsRoot(
+	cs chain.ChainSpec,
+	deposits []*types.Deposit,
+) common.Root {
+	validators := make(types.Validators, len(deposits))
+	for i, deposit := range deposits {
+		var val *types.Validator
+		validators[i] = val.New(
+			deposit.Pubkey,
+			deposit.Credentials,
+			deposit.Amount,
+			math.Gwei(cs.EffectiveBalanceIncrement()),
+			math.Gwei(cs.MaxEffectiveBalance(false)),
+		)
+	}
+	return validators.HashTreeRoot()
+}
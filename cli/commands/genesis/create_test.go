@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/genesis"
+	"github.com/berachain/beacon-kit/config/spec"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/mocks"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildGenesis(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	ethGenesisBz, err := afero.ReadFile(
+		afero.NewOsFs(), "../../../testing/files/eth-genesis.json",
+	)
+	require.NoError(t, err)
+
+	signers := make([]crypto.BLSSigner, 3)
+	for i := range signers {
+		s := &mocks.BLSSigner{}
+		s.On("PublicKey").Return(crypto.BLSPubkey{byte(i + 1)})
+		s.On("Sign", mock.Anything).Return(crypto.BLSSignature{}, nil)
+		s.On("VerifySignature", mock.Anything, mock.Anything, mock.Anything).
+			Return(nil)
+		signers[i] = s
+	}
+
+	amount := math.Gwei(cs.MaxEffectiveBalance(false))
+	withdrawalAddress := common.ExecutionAddress{0x01}
+	gen, err := genesis.BuildGenesis(
+		cs, signers, amount, withdrawalAddress, ethGenesisBz,
+	)
+	require.NoError(t, err)
+
+	require.Len(t, gen.Deposits, len(signers))
+	for i, deposit := range gen.Deposits {
+		require.Equal(t, uint64(i), deposit.Index)
+		require.Equal(t, crypto.BLSPubkey{byte(i + 1)}, deposit.Pubkey)
+		require.Equal(t, amount, deposit.Amount)
+		addr, addrErr := deposit.Credentials.ToExecutionAddress()
+		require.NoError(t, addrErr)
+		require.Equal(t, withdrawalAddress, addr)
+	}
+
+	// the execution genesis block is embedded in the beacon genesis
+	ethGenesis := &gethprimitives.Genesis{}
+	require.NoError(t, ethGenesis.UnmarshalJSON(ethGenesisBz))
+	require.Equal(t,
+		common.ExecutionHash(ethGenesis.ToBlock().Hash()),
+		gen.ExecutionPayloadHeader.GetBlockHash(),
+	)
+
+	_, err = genesis.BuildGenesis(
+		cs, nil, amount, withdrawalAddress, ethGenesisBz,
+	)
+	require.ErrorIs(t, err, genesis.ErrNoValidators)
+}
@@ -28,11 +28,18 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math/unsafe"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/cli/commands/state"
+	clicfg "github.com/berachain/beacon-kit/cli/config"
+	beaconflags "github.com/berachain/beacon-kit/cli/flags"
+	"github.com/berachain/beacon-kit/config/networks"
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/encoding/json"
 	cfg "github.com/cometbft/cometbft/config"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -59,6 +66,15 @@ const (
 
 	// FlagConsensusKeyAlgo defines the algorithm to use for the consensus signing key.
 	FlagConsensusKeyAlgo = "consensus-key-algo"
+
+	// FlagNetwork defines a flag to select the built-in network to join.
+	FlagNetwork = "network"
+
+	// FlagInteractive defines a flag to configure the node through prompts.
+	FlagInteractive = "interactive"
+
+	// FlagFromBundle defines a flag to bootstrap the node from a bundle.
+	FlagFromBundle = "from-bundle"
 )
 
 type printInfo struct {
@@ -91,10 +107,12 @@ func displayInfo(dst io.Writer, info printInfo) error {
 }
 
 //nolint:funlen,gocognit,mnd // based on cosmossdk implementation
-func InitCmd(mm interface {
+func InitCmd[
+	LoggerT log.AdvancedLogger[LoggerT],
+](mm interface {
 	DefaultGenesis() map[string]json.RawMessage
 	ValidateGenesis(genesisData map[string]json.RawMessage) error
-}) *cobra.Command {
+}, cs chain.ChainSpec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "init <moniker>",
 		Short: "Initialize private validator, p2p, genesis, and application configuration files",
@@ -109,8 +127,32 @@ func InitCmd(mm interface {
 				return errors.New("failed to parse FlagChainID")
 			}
 
+			interactive, err := cmd.Flags().GetBool(FlagInteractive)
+			if err != nil {
+				return errors.New("failed to parse FlagInteractive")
+			}
+			networkName, err := cmd.Flags().GetString(FlagNetwork)
+			if err != nil {
+				return errors.New("failed to parse FlagNetwork")
+			}
+			var wizard *Wizard
+			if interactive {
+				wizard = NewWizard(cmd.InOrStdin(), cmd.OutOrStdout())
+				if networkName, err = wizard.Network(networkName); err != nil {
+					return err
+				}
+			}
+			var network *networks.Network
+			if networkName != "" {
+				if network, err = networks.Get(networkName); err != nil {
+					return err
+				}
+			}
+			// networks with a genesis file set the chain ID
+			joinNetwork := network != nil && network.Genesis != nil
+
 			switch {
-			case chainID != "":
+			case chainID != "", joinNetwork:
 			case clientCtx.ChainID != "":
 				chainID = clientCtx.ChainID
 			default:
@@ -187,6 +229,35 @@ func InitCmd(mm interface {
 			if defaultDenom != "" {
 				sdk.DefaultBondDenom = defaultDenom
 			}
+			fromBundle, err := cmd.Flags().GetString(FlagFromBundle)
+			if err != nil {
+				return errors.New("failed to parse FlagFromBundle")
+			}
+			if fromBundle != "" {
+				chainID, err = importBundle[LoggerT](cmd, cs, fromBundle, genFile)
+				if err != nil {
+					return err
+				}
+				if network != nil {
+					applyNetworkPeers(config, network)
+				}
+				return finalizeInit(cmd, config, network, wizard, newPrintInfo(
+					config.Moniker, chainID, nodeID, "", nil,
+				))
+			}
+			if joinNetwork {
+				var appGenesis *types.AppGenesis
+				appGenesis, err = writeNetworkGenesis(genFile, network, chainID)
+				if err != nil {
+					return err
+				}
+				chainID = appGenesis.ChainID
+				applyNetworkPeers(config, network)
+				return finalizeInit(cmd, config, network, wizard, newPrintInfo(
+					config.Moniker, chainID, nodeID, "", appGenesis.AppState,
+				))
+			}
+
 			appGenState := mm.DefaultGenesis()
 
 			appState, err := json.MarshalIndent(appGenState, "", " ")
@@ -222,10 +293,11 @@ func InitCmd(mm interface {
 				return errorsmod.Wrap(err, "Failed to export genesis file")
 			}
 
+			if network != nil {
+				applyNetworkPeers(config, network)
+			}
 			toPrint := newPrintInfo(config.Moniker, chainID, nodeID, "", appState)
-
-			cfg.WriteConfigFile(filepath.Join(config.RootDir, "config", "config.toml"), config)
-			return displayInfo(cmd.ErrOrStderr(), toPrint)
+			return finalizeInit(cmd, config, network, wizard, toPrint)
 		},
 	}
 
@@ -235,6 +307,75 @@ func InitCmd(mm interface {
 	cmd.Flags().String(FlagDefaultBondDenom, "", "genesis file default denomination, if left blank default value is 'stake'")
 	cmd.Flags().Int64(flags.FlagInitHeight, 1, "specify the initial block height at genesis")
 	cmd.Flags().String(FlagConsensusKeyAlgo, "ed25519", "algorithm to use for the consensus key")
+	cmd.Flags().String(FlagNetwork, "", fmt.Sprintf(
+		"built-in network to join (%s), custom chain specs are set by %s in app.toml",
+		strings.Join(networks.Names(), ", "), beaconflags.ChainSpecFile,
+	))
+	cmd.Flags().Bool(FlagInteractive, false, "walk through network, execution client, validator key and fee recipient setup")
+	cmd.Flags().String(FlagFromBundle, "", "bootstrap bundle to initialize the node state from, exported by state bundle export")
 
 	return cmd
 }
+
+// finalizeInit writes the CometBFT config, the files of the selected network
+// and runs the wizard, if any, before displaying the node info.
+func finalizeInit(
+	cmd *cobra.Command,
+	config *cfg.Config,
+	network *networks.Network,
+	wizard *Wizard,
+	toPrint printInfo,
+) error {
+	cfg.WriteConfigFile(filepath.Join(config.RootDir, "config", "config.toml"), config)
+
+	settings := make(map[string]any)
+	if network != nil {
+		chainSpecFile, err := writeNetworkChainSpec(config, network)
+		if err != nil {
+			return err
+		}
+		settings[beaconflags.ChainSpecFile] = chainSpecFile
+	}
+
+	var err error
+	switch {
+	case wizard != nil:
+		err = wizard.Run(config, settings)
+	case len(settings) > 0:
+		err = clicfg.UpdateAppConfig(
+			filepath.Join(config.RootDir, "config", "app.toml"), settings,
+		)
+	}
+	if err != nil {
+		return err
+	}
+	return displayInfo(cmd.ErrOrStderr(), toPrint)
+}
+
+// importBundle imports the bootstrap bundle of the given file into the node
+// home, writing its genesis file, and returns the chain ID of the bundle.
+func importBundle[
+	LoggerT log.AdvancedLogger[LoggerT],
+](cmd *cobra.Command, cs chain.ChainSpec, file, genFile string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	stores, err := state.OpenBundleStores[LoggerT](cmd, cs)
+	if err != nil {
+		return "", err
+	}
+	defer stores.Close()
+
+	m, err := state.ImportBundle(bufio.NewReader(f), stores, cs, genFile)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to import bootstrap bundle")
+	}
+	cmd.PrintErrf(
+		"imported bundle of %s at height %d, block root %s\n",
+		m.ChainID, m.Height, m.BlockRoot,
+	)
+	return m.ChainID, nil
+}
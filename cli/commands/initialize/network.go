@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package initialize
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/berachain/beacon-kit/config/networks"
+	"github.com/berachain/beacon-kit/errors"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	// chainSpecFileName is the name of the chain spec file written, within
+	// the config directory, for the selected network.
+	chainSpecFileName = "chain-spec.toml"
+	// genesisFilePerm is the permission of the genesis file of a network.
+	genesisFilePerm = 0o644
+)
+
+// ErrChainIDMismatch is returned when the chain ID flag does not match the
+// genesis of the selected network.
+var ErrChainIDMismatch = errors.New("chain ID does not match network genesis")
+
+// applyNetworkPeers sets the seeds and persistent peers of the network in
+// the CometBFT config.
+func applyNetworkPeers(config *cmtcfg.Config, network *networks.Network) {
+	config.P2P.Seeds = strings.Join(network.Seeds, ",")
+	config.P2P.PersistentPeers = strings.Join(network.PersistentPeers, ",")
+}
+
+// writeNetworkChainSpec writes the chain spec file of the network in the
+// config directory, returning its path.
+func writeNetworkChainSpec(
+	config *cmtcfg.Config, network *networks.Network,
+) (string, error) {
+	path := filepath.Join(
+		config.RootDir, cmtcfg.DefaultConfigDir, chainSpecFileName,
+	)
+	if err := os.WriteFile(path, network.ChainSpec, wizardFilePerm); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// writeNetworkGenesis writes the genesis file of the network, checking that
+// its chain ID matches the given one, if any, and returns the genesis.
+func writeNetworkGenesis(
+	genFile string, network *networks.Network, chainID string,
+) (*types.AppGenesis, error) {
+	appGenesis, err := types.AppGenesisFromReader(
+		bytes.NewReader(network.Genesis),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err, "failed to read genesis of network %s", network.Name,
+		)
+	}
+	if chainID != "" && chainID != appGenesis.ChainID {
+		return nil, errors.Wrapf(
+			ErrChainIDMismatch, "expected %s, got %s",
+			appGenesis.ChainID, chainID,
+		)
+	}
+	if err = os.WriteFile(
+		genFile, network.Genesis, genesisFilePerm,
+	); err != nil {
+		return nil, err
+	}
+	return appGenesis, nil
+}
@@ -0,0 +1,248 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package initialize
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/berachain/beacon-kit/cli/commands/validator"
+	clicfg "github.com/berachain/beacon-kit/cli/config"
+	"github.com/berachain/beacon-kit/cli/flags"
+	"github.com/berachain/beacon-kit/config/networks"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/berachain/beacon-kit/primitives/net/url"
+	cmtcfg "github.com/cometbft/cometbft/config"
+)
+
+const (
+	// jwtSecretFileName is the name of the JWT secret file generated, within
+	// the config directory, when none is provided.
+	jwtSecretFileName = "jwt.hex"
+	// wizardFilePerm is the permission of the files written by the wizard.
+	wizardFilePerm = 0o600
+	// defaultRPCDialURL is the suggested execution client endpoint.
+	defaultRPCDialURL = "http://localhost:8551"
+)
+
+// Wizard walks an operator through the settings needed to run a node:
+// network, execution client endpoint and JWT secret, validator key and fee
+// recipient. It writes the files the settings refer to and records the
+// settings in app.toml.
+type Wizard struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+// NewWizard returns a wizard reading the answers from in and writing the
+// prompts to out.
+func NewWizard(in io.Reader, out io.Writer) *Wizard {
+	return &Wizard{in: bufio.NewReader(in), out: out}
+}
+
+// Run configures the node home of the given config, recording the given
+// settings in app.toml along with the ones prompted for. The network is
+// prompted for separately, by Network.
+func (w *Wizard) Run(config *cmtcfg.Config, settings map[string]any) error {
+	configDir := filepath.Join(config.RootDir, cmtcfg.DefaultConfigDir)
+	if settings == nil {
+		settings = make(map[string]any)
+	}
+
+	dialURL, err := w.ask(
+		"Execution client engine API endpoint", defaultRPCDialURL,
+		func(answer string) error {
+			_, err := url.NewFromRaw(answer)
+			return err
+		},
+	)
+	if err != nil {
+		return err
+	}
+	settings[flags.RPCDialURL] = dialURL
+
+	jwtSecretPath, err := w.jwtSecret(configDir)
+	if err != nil {
+		return err
+	}
+	settings[flags.JWTSecretPath] = jwtSecretPath
+
+	if err = w.validatorKey(config); err != nil {
+		return err
+	}
+
+	feeRecipient, err := w.ask(
+		"Fee recipient address (empty to skip)", "",
+		func(answer string) error {
+			if answer == "" {
+				return nil
+			}
+			var addr common.ExecutionAddress
+			return addr.UnmarshalText([]byte(answer))
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if feeRecipient != "" {
+		settings[flags.SuggestedFeeRecipient] = feeRecipient
+	}
+
+	appCfgFile := filepath.Join(configDir, "app.toml")
+	if err = clicfg.UpdateAppConfig(appCfgFile, settings); err != nil {
+		return err
+	}
+	fmt.Fprintf(w.out, "Node configuration written to: %s\n", appCfgFile)
+	return nil
+}
+
+// Network prompts for the built-in network to join, suggesting the given
+// one, the testnet if empty.
+func (w *Wizard) Network(defaultNetwork string) (string, error) {
+	if defaultNetwork == "" {
+		defaultNetwork = networks.Testnet
+	}
+	return w.ask(
+		fmt.Sprintf("Network (%s)", strings.Join(networks.Names(), ", ")),
+		defaultNetwork,
+		func(answer string) error {
+			_, err := networks.Get(answer)
+			return err
+		},
+	)
+}
+
+// jwtSecret prompts for the JWT secret shared with the execution client,
+// generating a new one if none is provided, and returns its file path.
+func (w *Wizard) jwtSecret(configDir string) (string, error) {
+	path, err := w.ask(
+		"Path to the JWT secret shared with the execution client "+
+			"(empty to generate one)", "",
+		func(answer string) error {
+			if answer == "" {
+				return nil
+			}
+			bz, err := os.ReadFile(answer)
+			if err != nil {
+				return err
+			}
+			_, err = jwt.NewFromHex(strings.TrimSpace(string(bz)))
+			return err
+		},
+	)
+	if err != nil || path != "" {
+		return path, err
+	}
+
+	secret, err := jwt.NewRandom()
+	if err != nil {
+		return "", err
+	}
+	path = filepath.Join(configDir, jwtSecretFileName)
+	if err = os.WriteFile(
+		path, []byte(secret.Hex()), wizardFilePerm,
+	); err != nil {
+		return "", err
+	}
+	fmt.Fprintf(
+		w.out, "Generated a JWT secret to share with the execution "+
+			"client at: %s\n", path,
+	)
+	return path, nil
+}
+
+// validatorKey prompts for an EIP-2335 keystore to import as the node
+// validator key, keeping the generated key if none is provided.
+func (w *Wizard) validatorKey(config *cmtcfg.Config) error {
+	keystoreFile, err := w.ask(
+		"Path to an EIP-2335 validator keystore to import "+
+			"(empty to keep the generated key)", "",
+		func(answer string) error {
+			if answer == "" {
+				return nil
+			}
+			_, err := signer.ReadKeystoreFile(answer)
+			return err
+		},
+	)
+	if err != nil || keystoreFile == "" {
+		return err
+	}
+
+	var password string
+	if _, err = w.ask(
+		"Path to the keystore password file", "",
+		func(answer string) error {
+			var readErr error
+			password, readErr = signer.ReadPasswordFile(answer)
+			return readErr
+		},
+	); err != nil {
+		return err
+	}
+
+	// The generated key has never signed, it can safely be replaced.
+	pubkey, err := validator.ImportKeystore(
+		config, keystoreFile, password, true,
+	)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w.out, "Imported validator key %s\n", pubkey.String())
+	return nil
+}
+
+// ask prompts the question until the answer, or the default answer if none
+// is given, is valid.
+func (w *Wizard) ask(
+	question, defaultAnswer string, validate func(string) error,
+) (string, error) {
+	for {
+		if defaultAnswer != "" {
+			fmt.Fprintf(w.out, "%s [%s]: ", question, defaultAnswer)
+		} else {
+			fmt.Fprintf(w.out, "%s: ", question)
+		}
+		line, err := w.in.ReadString('\n')
+		if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+			return "", errors.Wrap(err, "failed to read answer")
+		}
+		answer := strings.TrimSpace(line)
+		if answer == "" {
+			answer = defaultAnswer
+		}
+		validationErr := validate(answer)
+		if validationErr == nil {
+			return answer, nil
+		}
+		fmt.Fprintf(w.out, "Invalid answer: %v\n", validationErr)
+		if err != nil {
+			return "", validationErr
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package initialize_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/initialize"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/primitives/common"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWizard(t *testing.T) {
+	cmtConfig := cmtcfg.DefaultConfig()
+	cmtConfig.SetRoot(t.TempDir())
+	configDir := filepath.Join(cmtConfig.RootDir, cmtcfg.DefaultConfigDir)
+	require.NoError(t, os.MkdirAll(configDir, 0o700))
+
+	feeRecipient := common.ExecutionAddress{0x01, 0x02}
+	answers := strings.Join([]string{
+		"unknown-network", // rejected, asked again
+		"devnet",
+		"http://localhost:9551",
+		"", // generate the JWT secret
+		"", // keep the generated validator key
+		feeRecipient.Hex(),
+	}, "\n") + "\n"
+
+	var out bytes.Buffer
+	wizard := initialize.NewWizard(strings.NewReader(answers), &out)
+	network, err := wizard.Network("")
+	require.NoError(t, err)
+	require.Equal(t, "devnet", network)
+	require.Contains(t, out.String(), "Invalid answer")
+
+	chainSpecFile := filepath.Join(configDir, "chain-spec.toml")
+	require.NoError(t, wizard.Run(cmtConfig, map[string]any{
+		"beacon-kit.chain-spec-file": chainSpecFile,
+	}))
+	require.FileExists(t, filepath.Join(configDir, "jwt.hex"))
+
+	v := viper.New()
+	v.SetConfigFile(filepath.Join(configDir, "app.toml"))
+	require.NoError(t, v.ReadInConfig())
+	cfg, err := config.ReadConfigFromAppOpts(v)
+	require.NoError(t, err)
+	require.Equal(t, chainSpecFile, cfg.ChainSpecFile)
+	require.Equal(t, "http://localhost:9551", cfg.Engine.RPCDialURL.String())
+	require.Equal(
+		t, filepath.Join(configDir, "jwt.hex"), cfg.Engine.JWTSecretPath,
+	)
+	require.Equal(t, feeRecipient, cfg.PayloadBuilder.SuggestedFeeRecipient)
+}
+
+func TestWizardStopsOnMissingAnswers(t *testing.T) {
+	cmtConfig := cmtcfg.DefaultConfig()
+	cmtConfig.SetRoot(t.TempDir())
+	require.NoError(t, os.MkdirAll(
+		filepath.Join(cmtConfig.RootDir, cmtcfg.DefaultConfigDir), 0o700,
+	))
+
+	err := initialize.NewWizard(
+		strings.NewReader("http://localhost:8551\n"), &bytes.Buffer{},
+	).Run(cmtConfig, nil)
+	require.ErrorIs(t, err, io.EOF)
+}
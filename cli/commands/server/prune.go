@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/store"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	types "github.com/berachain/beacon-kit/cli/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/storage/db"
+	"github.com/berachain/beacon-kit/storage/pruner"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+)
+
+// NewPruneCmd creates a command to prune the historical states of the
+// multistore on demand, keeping the most recent ones.
+func NewPruneCmd[
+	T interface {
+		Start(context.Context) error
+		CommitMultiStore() store.CommitMultiStore
+	},
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	appCreator types.AppCreator[T, LoggerT],
+) *cobra.Command {
+	var keepRecent uint64
+
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "prune historical beacon states of a stopped node",
+		Long: `
+Prune deletes the states committed before the most recent ones from the
+multistore, reclaiming the disk space of nodes that ran with a larger
+retention, or with pruning disabled. Blocks are final once committed, so
+older states are only needed to serve historical queries.
+
+The number of recent states kept defaults to 'pruning-keep-recent' and can be
+overridden with --keep-recent. The beacon state itself is left untouched: its
+block roots, state roots and execution payload header are bounded in size and
+are part of the app hash, while deposits must be kept in full.
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			v := clicontext.GetViperFromCmd(cmd)
+			logger := clicontext.GetLoggerFromCmd[LoggerT](cmd)
+			cfg := clicontext.GetConfigFromCmd(cmd)
+
+			opts, err := GetPruningOptionsFromFlags(v)
+			if err != nil {
+				return err
+			}
+			if !cmd.Flags().Changed("keep-recent") {
+				if opts.GetPruningStrategy() == pruningtypes.PruningNothing {
+					return errors.New(
+						"pruning is disabled, set --keep-recent to prune",
+					)
+				}
+				keepRecent = opts.KeepRecent
+			}
+			if keepRecent == 0 {
+				return errors.New("at least one recent state must be kept")
+			}
+
+			db, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			app := appCreator(logger, db, nil, cfg, v)
+			cms := app.CommitMultiStore()
+			if err = cms.LoadLatestVersion(); err != nil {
+				return fmt.Errorf("failed to load states: %w", err)
+			}
+			latest := cms.LastCommitID().Version
+			target, err := pruner.PruneStates(cms, latest, keepRecent)
+			if err != nil {
+				return fmt.Errorf("failed to prune states: %w", err)
+			}
+			if target == 0 {
+				logger.Info(
+					"Nothing to prune", "latest", latest, "keep_recent", keepRecent,
+				)
+				return nil
+			}
+
+			logger.Info(
+				"Pruned states", "up_to_height", target, "latest", latest,
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64Var(
+		&keepRecent, "keep-recent", 0,
+		"number of recent states to keep, pruning-keep-recent if unset",
+	)
+	return cmd
+}
@@ -25,17 +25,26 @@ import (
 	"fmt"
 
 	"cosmossdk.io/store"
+	storetypes "cosmossdk.io/store/types"
 	types "github.com/berachain/beacon-kit/cli/commands/server/types"
 	clicontext "github.com/berachain/beacon-kit/cli/context"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/storage/db"
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
+	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cobra"
 )
 
-// NewRollbackCmd creates a command to rollback CometBFT and multistore state by
-// one height.
+// StoresRollback rewinds the beacon stores kept out of the multistore to the
+// beacon state held by the given multistore, rolled back from the given
+// latest height.
+type StoresRollback func(
+	cmd *cobra.Command, ms storetypes.MultiStore, latest int64,
+) error
+
+// NewRollbackCmd creates a command to rollback CometBFT, multistore and
+// beacon stores state by one height, or to a given height.
 func NewRollbackCmd[
 	T interface {
 		Start(context.Context) error
@@ -44,12 +53,16 @@ func NewRollbackCmd[
 	LoggerT log.AdvancedLogger[LoggerT],
 ](
 	appCreator types.AppCreator[T, LoggerT],
+	rollbackStores StoresRollback,
 ) *cobra.Command {
-	var removeBlock bool
+	var (
+		removeBlock bool
+		height      int64
+	)
 
 	cmd := &cobra.Command{
 		Use:   "rollback",
-		Short: "rollback Cosmos SDK and CometBFT state by one height",
+		Short: "rollback Cosmos SDK, CometBFT and beacon state by one height",
 		Long: `
 A state rollback is performed to recover from an incorrect application state transition,
 when CometBFT has persisted an incorrect app hash and is thus unable to make
@@ -57,6 +70,16 @@ progress. Rollback overwrites a state at height n with the state at height n - 1
 The application also rolls back to height n - 1. No blocks are removed, so upon
 restarting CometBFT the transactions in block n will be re-executed against the
 application.
+
+With --height h, the state is rolled back to height h instead. Blocks above
+h + 1 are removed, so that they are fetched again from peers.
+
+The beacon stores are rewound along: the blob sidecars, state snapshots, state
+sync snapshots and frozen data of the slots above the rolled back height are
+removed, as are the deposits fetched from the execution blocks after the one
+followed by the rolled back state, and the progress following the execution
+layer is moved back to that block. The index of the finalized blocks is only
+held in memory, and rebuilt as blocks are replayed.
 `,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			v := clicontext.GetViperFromCmd(cmd)
@@ -68,20 +91,39 @@ application.
 				return err
 			}
 			app := appCreator(logger, db, nil, cfg, v)
+			latest := app.CommitMultiStore().LastCommitID().Version
+			target := latest - 1
+			if cmd.Flags().Changed("height") {
+				if height < 1 || height >= latest {
+					return fmt.Errorf(
+						"invalid rollback height %d, latest height is %d",
+						height, latest,
+					)
+				}
+				target = height
+			}
+
 			// rollback CometBFT state
-			height, hash, err := cmtcmd.RollbackState(cfg, removeBlock)
+			rolledBack, hash, err := rollbackCometBFT(
+				cfg, latest, target, removeBlock,
+			)
 			if err != nil {
 				return fmt.Errorf("failed to rollback CometBFT state: %w", err)
 			}
 			// rollback the multistore
-
-			if err = app.CommitMultiStore().RollbackToVersion(height); err != nil {
+			if err = app.CommitMultiStore().RollbackToVersion(rolledBack); err != nil {
 				return fmt.Errorf("failed to rollback to version: %w", err)
 			}
+			// rollback the beacon stores
+			if err = rollbackStores(
+				cmd, app.CommitMultiStore().CacheMultiStore(), latest,
+			); err != nil {
+				return fmt.Errorf("failed to rollback beacon stores: %w", err)
+			}
 
 			logger.Info(
 				"Rolled back state to height %d and hash %X\n",
-				height,
+				rolledBack,
 				hash,
 			)
 			return nil
@@ -90,5 +132,42 @@ application.
 
 	cmd.Flags().
 		BoolVar(&removeBlock, "hard", false, "remove last block as well as state")
+	cmd.Flags().
+		Int64Var(&height, "height", 0, "height to rollback to, latest - 1 if unset")
 	return cmd
 }
+
+// rollbackCometBFT rolls CometBFT state back from the latest height to the
+// target height, one height at a time. Blocks above the target height are
+// removed, except for the target height + 1 one unless removeBlock is set.
+func rollbackCometBFT(
+	cfg *cmtcfg.Config, latest, target int64, removeBlock bool,
+) (int64, []byte, error) {
+	var (
+		height      = latest
+		hash        []byte
+		removeAhead bool
+	)
+	for height > target {
+		remove := removeBlock || height-1 > target || removeAhead
+		rolledBack, h, err := cmtcmd.RollbackState(cfg, remove)
+		if err != nil {
+			return 0, nil, err
+		}
+		if rolledBack > height {
+			return 0, nil, fmt.Errorf(
+				"CometBFT state at height %d above the application one %d",
+				rolledBack, height,
+			)
+		}
+		// If the block store is one block ahead of the state, CometBFT only
+		// removes that block, if asked to, without rolling state back.
+		removeAhead = rolledBack == height && !remove
+		height, hash = rolledBack, h
+		if latest-1 == target {
+			// Rollback by one height, as CometBFT defines it.
+			break
+		}
+	}
+	return height, hash, nil
+}
@@ -26,9 +26,11 @@ import (
 	"errors"
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
-	types "github.com/berachain/beacon-kit/cli/commands/server/types"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
 	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/cli/flags"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/types"
 	"github.com/berachain/beacon-kit/storage/db"
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
 	dbm "github.com/cosmos/cosmos-db"
@@ -49,6 +51,8 @@ const (
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
+
+	FlagCheckpointSyncURL = "checkpoint-sync-url"
 )
 
 // StartCmdOptions defines options that can be customized in
@@ -70,7 +74,7 @@ func StartCmd[
 	},
 	LoggerT log.AdvancedLogger[LoggerT],
 ](
-	appCreator types.AppCreator[T, LoggerT],
+	appCreator servertypes.AppCreator[T, LoggerT],
 ) *cobra.Command {
 	return StartCmdWithOptions[T, LoggerT](appCreator, StartCmdOptions[T]{})
 }
@@ -84,7 +88,7 @@ func StartCmdWithOptions[
 	},
 	LoggerT log.AdvancedLogger[LoggerT],
 ](
-	appCreator types.AppCreator[T, LoggerT],
+	appCreator servertypes.AppCreator[T, LoggerT],
 	opts StartCmdOptions[T],
 ) *cobra.Command {
 	cmd := &cobra.Command{
@@ -103,6 +107,15 @@ nothing: all historic states will be saved, nothing will be deleted (i.e. archiv
 everything: 2 latest states will be kept; pruning at 10 block intervals.
 custom: allow pruning options to be manually specified through 'pruning-keep-recent', and 'pruning-interval'
 
+Nodes without state can join the network from a recent state rather than
+replaying it from genesis with '--checkpoint-sync-url', set to the node API of
+a trusted node. The state is restored from the state sync snapshots served by
+the peers, which must take them, and is checked against the app hash, commit
+and validators the trusted node serves for the snapshot height.
+
+Nodes started with the 'replica' role open all the stores read-only and only
+serve the APIs from them. The stores must not be opened by a running node.
+
 `,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			logger := clicontext.GetLoggerFromCmd[LoggerT](cmd)
@@ -117,14 +130,24 @@ custom: allow pruning options to be manually specified through 'pruning-keep-rec
 				return err
 			}
 
+			role, err := types.ParseRole(v.GetString(flags.Role))
+			if err != nil {
+				return err
+			}
+
 			// Open the Database
-			db, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			var appDB dbm.DB
+			if role.ReadOnly() {
+				appDB, err = db.OpenReadOnlyDB(cfg.RootDir)
+			} else {
+				appDB, err = db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			}
 			if err != nil {
 				return err
 			}
 
 			// Create the application.
-			return appCreator(logger, db, nil, cfg, v).
+			return appCreator(logger, appDB, nil, cfg, v).
 				Start(cmd.Context())
 		},
 	}
@@ -183,6 +206,11 @@ func addStartNodeFlags[
 			"Minimum block height offset during ABCI commit to prune CometBFT blocks")
 	cmd.Flags().
 		Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().
+		String(
+			FlagCheckpointSyncURL,
+			"",
+			"Node API URL of a trusted node to checkpoint sync from when the node has no state")
 
 	// add support for all CometBFT-specific command line options
 	cmtcmd.AddNodeFlags(cmd)
@@ -22,12 +22,18 @@ package commands
 
 import (
 	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/cli/commands/blobs"
+	"github.com/berachain/beacon-kit/cli/commands/bls"
+	"github.com/berachain/beacon-kit/cli/commands/config"
+	"github.com/berachain/beacon-kit/cli/commands/db"
 	"github.com/berachain/beacon-kit/cli/commands/deposit"
 	"github.com/berachain/beacon-kit/cli/commands/genesis"
 	"github.com/berachain/beacon-kit/cli/commands/initialize"
 	"github.com/berachain/beacon-kit/cli/commands/jwt"
 	"github.com/berachain/beacon-kit/cli/commands/server"
 	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	"github.com/berachain/beacon-kit/cli/commands/state"
+	"github.com/berachain/beacon-kit/cli/commands/validator"
 	"github.com/berachain/beacon-kit/cli/flags"
 	cmtcli "github.com/berachain/beacon-kit/consensus/cometbft/cli"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
@@ -48,18 +54,34 @@ func DefaultRootCommandSetup[
 ) {
 	// Add all the commands to the root command.
 	root.cmd.AddCommand(
+		// `blobs`
+		blobs.Commands[LoggerT](chainSpec),
+		// `bls`
+		bls.Commands(),
 		// `comet`
 		cmtcli.Commands(appCreator),
+		// `config`
+		config.Commands(),
+		// `db`
+		db.Commands(),
 		// `init`
-		initialize.InitCmd(mm),
+		initialize.InitCmd[LoggerT](mm, chainSpec),
 		// `genesis`
-		genesis.Commands(chainSpec),
+		genesis.Commands(chainSpec, genesis.CreateGenesisCmd(chainSpec, mm)),
 		// `deposit`
 		deposit.Commands(chainSpec),
 		// `jwt`
 		jwt.Commands(),
+		// `validator`
+		validator.Commands(),
+		// `prune`
+		server.NewPruneCmd(appCreator),
 		// `rollback`
-		server.NewRollbackCmd(appCreator),
+		server.NewRollbackCmd(
+			appCreator, state.NewStoresRollback[LoggerT](chainSpec),
+		),
+		// `state`
+		state.Commands[LoggerT](chainSpec),
 		// `start`
 		server.StartCmdWithOptions(appCreator, server.StartCmdOptions[T]{
 			AddFlags: flags.AddBeaconKitFlags,
@@ -0,0 +1,722 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/zlib"
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/consensus-types/registry"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	dastore "github.com/berachain/beacon-kit/da/store"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	sm "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/karalabe/ssz"
+)
+
+// BundleVersion is the version of the bootstrap bundle format.
+const BundleVersion uint64 = 1
+
+// The entries of a bootstrap bundle, a tar archive, in the order they are
+// written.
+const (
+	// bundleManifestEntry is the JSON manifest of the bundle.
+	bundleManifestEntry = "manifest.json"
+	// bundleGenesisEntry is the genesis file of the chain.
+	bundleGenesisEntry = "genesis.json"
+	// bundleBlockEntry is the SSZ beacon block at the bundle height.
+	bundleBlockEntry = "block.ssz"
+	// bundleCometStateEntry is the protobuf CometBFT state after the block.
+	bundleCometStateEntry = "cometbft/state.pb"
+	// bundleCometCommitEntry is the protobuf commit of the block.
+	bundleCometCommitEntry = "cometbft/commit.pb"
+	// bundleStateEntry is the zlib compressed multistore snapshot stream.
+	bundleStateEntry = "state.snapshot"
+	// bundleDepositsEntry is the SSZ list of the deposits the state has yet
+	// to process.
+	bundleDepositsEntry = "deposits.ssz"
+	// bundleBlobsDir holds the availability store archive of the blob
+	// sidecars of each slot within the data availability period.
+	bundleBlobsDir = "blobs/"
+)
+
+// snapshotMaxItemSize is the maximum size of an item of the multistore
+// snapshot stream, as in the state sync snapshots.
+const snapshotMaxItemSize = 64 << 20
+
+// BundleManifest describes the content of a bootstrap bundle.
+type BundleManifest struct {
+	// Version is the version of the bundle format.
+	Version uint64 `json:"version"`
+	// ChainID is the chain the bundle was exported from.
+	ChainID string `json:"chain_id"`
+	// Height is the height of the finalized state of the bundle.
+	Height uint64 `json:"height"`
+	// AppHash is the app hash of the state, which the chain commits to in
+	// the block following it.
+	AppHash cmtbytes.HexBytes `json:"app_hash"`
+	// BlockRoot is the root of the beacon block at the bundle height.
+	BlockRoot common.Root `json:"block_root"`
+	// StateFormat is the format of the multistore snapshot stream.
+	StateFormat uint32 `json:"state_format"`
+	// Deposits describes the deposits of the bundle.
+	Deposits BundleDeposits `json:"deposits"`
+	// Blobs lists the slots whose blob sidecars are in the bundle.
+	Blobs []BundleBlobs `json:"blobs"`
+}
+
+// BundleDeposits describes the deposits of a bootstrap bundle, the ones the
+// state has yet to process.
+type BundleDeposits struct {
+	// FirstIndex is the index of the first deposit, the state eth1 deposit
+	// index.
+	FirstIndex uint64 `json:"first_index"`
+	// Count is the number of deposits.
+	Count uint64 `json:"count"`
+}
+
+// BundleBlobs describes the blob sidecars of a slot of a bootstrap bundle.
+type BundleBlobs struct {
+	// Slot is the slot of the sidecars.
+	Slot uint64 `json:"slot"`
+	// Sidecars is the number of sidecars.
+	Sidecars int `json:"sidecars"`
+}
+
+// BundleStores are the stores of a node a bootstrap bundle is exported from
+// or imported into.
+type BundleStores struct {
+	// AppDB is the application database.
+	AppDB dbm.DB
+	// Deposits is the deposit store.
+	Deposits *depositstore.KVStore
+	// Blobs is the availability store of the blob sidecars.
+	Blobs *dastore.Store
+	// CometBFT is the CometBFT configuration locating its databases.
+	CometBFT *cmtcfg.Config
+}
+
+// ExportBundle writes a bootstrap bundle of the latest committed state to w.
+// The bundle holds the multistore snapshot of the state, which restores the
+// same app hash, and the CometBFT state and commit of its block, so that
+// nodes importing it follow the chain from there. The node must be stopped.
+func ExportBundle(
+	w io.Writer,
+	stores *BundleStores,
+	cs chain.ChainSpec,
+) (*BundleManifest, error) {
+	cms, err := loadMultiStore(stores.AppDB)
+	if err != nil {
+		return nil, err
+	}
+	height := cms.LatestVersion()
+	if height <= 0 {
+		return nil, errors.Wrap(ErrInvalidHeight, "no state committed")
+	}
+	//#nosec:G115 // height is positive.
+	h := uint64(height)
+
+	cmtState, commit, blockBz, err := loadCometBFT(stores.CometBFT, height)
+	if err != nil {
+		return nil, err
+	}
+	appHash := cms.LastCommitID().Hash
+	if !bytes.Equal(cmtState.AppHash, appHash) {
+		return nil, errors.Wrapf(
+			ErrAppHashMismatch, "CometBFT %X, application %X",
+			cmtState.AppHash, appHash,
+		)
+	}
+
+	ms, err := cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, err
+	}
+	st := newStateDB(ms, cs)
+	blockRoot, err := verifyBundleBlock(st, cs, h, blockBz)
+	if err != nil {
+		return nil, err
+	}
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return nil, err
+	}
+	deposits, err := stores.Deposits.GetDepositsFromIndex(depositIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	// The snapshot is staged to learn its size, which tar headers hold.
+	snapshot, err := os.CreateTemp("", "beacond-bundle-*.snapshot")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = snapshot.Close()
+		_ = os.Remove(snapshot.Name())
+	}()
+	if err = writeSnapshot(snapshot, cms, h); err != nil {
+		return nil, err
+	}
+
+	m := &BundleManifest{
+		Version:     BundleVersion,
+		ChainID:     cmtState.ChainID,
+		Height:      h,
+		AppHash:     appHash,
+		BlockRoot:   blockRoot,
+		StateFormat: snapshottypes.CurrentFormat,
+		Deposits: BundleDeposits{
+			FirstIndex: depositIndex,
+			Count:      uint64(len(deposits)),
+		},
+	}
+	for slot := firstBlobSlot(cs, h); slot <= h; slot++ {
+		var n int
+		if n, err = stores.Blobs.Export(
+			io.Discard, math.Slot(slot), math.Slot(slot),
+		); err != nil {
+			return nil, err
+		}
+		if n > 0 {
+			m.Blobs = append(m.Blobs, BundleBlobs{Slot: slot, Sidecars: n})
+		}
+	}
+
+	genesis, err := os.ReadFile(stores.CometBFT.GenesisFile())
+	if err != nil {
+		return nil, err
+	}
+	return m, writeBundle(
+		w, stores.Blobs, m, genesis, blockBz, cmtState, commit, snapshot,
+		deposits,
+	)
+}
+
+// writeBundle writes the entries of a bootstrap bundle to w.
+func writeBundle(
+	w io.Writer,
+	blobs *dastore.Store,
+	m *BundleManifest,
+	genesis []byte,
+	blockBz []byte,
+	cmtState *sm.State,
+	commit *cmttypes.Commit,
+	snapshot *os.File,
+	deposits ctypes.Deposits,
+) error {
+	manifestBz, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	statePb, err := cmtState.ToProto()
+	if err != nil {
+		return err
+	}
+	stateBz, err := proto.Marshal(statePb)
+	if err != nil {
+		return err
+	}
+	commitBz, err := proto.Marshal(commit.ToProto())
+	if err != nil {
+		return err
+	}
+	d := &bundleDeposits{Deposits: deposits}
+	depositsBz := make([]byte, ssz.Size(d))
+	if err = ssz.EncodeToBytes(depositsBz, d); err != nil {
+		return err
+	}
+
+	tw := tar.NewWriter(w)
+	for _, e := range []struct {
+		name string
+		bz   []byte
+	}{
+		{bundleManifestEntry, manifestBz},
+		{bundleGenesisEntry, genesis},
+		{bundleBlockEntry, blockBz},
+		{bundleCometStateEntry, stateBz},
+		{bundleCometCommitEntry, commitBz},
+	} {
+		err = writeBundleEntry(
+			tw, e.name, bytes.NewReader(e.bz), int64(len(e.bz)),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	size, err := snapshot.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err = snapshot.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if err = writeBundleEntry(tw, bundleStateEntry, snapshot, size); err != nil {
+		return err
+	}
+	err = writeBundleEntry(
+		tw, bundleDepositsEntry, bytes.NewReader(depositsBz),
+		int64(len(depositsBz)),
+	)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	for _, b := range m.Blobs {
+		buf.Reset()
+		if _, err = blobs.Export(
+			&buf, math.Slot(b.Slot), math.Slot(b.Slot),
+		); err != nil {
+			return err
+		}
+		name := bundleBlobsDir + strconv.FormatUint(b.Slot, 10) + ".archive"
+		if err = writeBundleEntry(
+			tw, name, &buf, int64(buf.Len()),
+		); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+// writeBundleEntry writes an entry of a bootstrap bundle, whose content of
+// the given size is read from r.
+func writeBundleEntry(
+	tw *tar.Writer, name string, r io.Reader, size int64,
+) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0o600,
+		Size: size,
+	}); err != nil {
+		return err
+	}
+	_, err := io.CopyN(tw, r, size)
+	return err
+}
+
+// ReadBundleManifest reads the manifest of the bootstrap bundle read from r,
+// checking its version.
+func ReadBundleManifest(r io.Reader) (*BundleManifest, error) {
+	tr := tar.NewReader(r)
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read bundle")
+	}
+	if hdr.Name != bundleManifestEntry {
+		return nil, errors.Wrapf(
+			ErrInvalidBundle, "first entry %s is not the manifest", hdr.Name,
+		)
+	}
+	return readBundleManifest(tr)
+}
+
+// readBundleManifest decodes the manifest entry of a bootstrap bundle.
+func readBundleManifest(r io.Reader) (*BundleManifest, error) {
+	m := &BundleManifest{}
+	if err := json.NewDecoder(r).Decode(m); err != nil {
+		return nil, errors.Wrap(err, "failed to decode bundle manifest")
+	}
+	if m.Version != BundleVersion {
+		return nil, errors.Wrapf(
+			ErrUnsupportedBundleVersion, "got %d, expected %d",
+			m.Version, BundleVersion,
+		)
+	}
+	if m.StateFormat != snapshottypes.CurrentFormat {
+		return nil, errors.Wrapf(
+			ErrInvalidBundle, "unsupported state format %d", m.StateFormat,
+		)
+	}
+	return m, nil
+}
+
+// ImportBundle imports the bootstrap bundle read from r into the stores of a
+// node without state, writing its genesis file to genesisFile. The restored
+// state is checked against the app hash of the CometBFT state and the beacon
+// block of the bundle, then CometBFT is bootstrapped on top of it, so that
+// the node syncs the blocks following it from its peers.
+//
+// NOTE: the data directory must be wiped before importing again if the
+// import fails.
+//
+//nolint:gocognit // one case per entry.
+func ImportBundle(
+	r io.Reader,
+	stores *BundleStores,
+	cs chain.ChainSpec,
+	genesisFile string,
+) (*BundleManifest, error) {
+	cms, err := loadMultiStore(stores.AppDB)
+	if err != nil {
+		return nil, err
+	}
+	if latest := cms.LatestVersion(); latest != 0 {
+		return nil, errors.Wrapf(ErrStateExists, "at height %d", latest)
+	}
+
+	var (
+		m        *BundleManifest
+		blockBz  []byte
+		cmtState *sm.State
+		commit   *cmttypes.Commit
+		restored bool
+	)
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, errors.Wrap(err, "failed to read bundle")
+		}
+		if m == nil && hdr.Name != bundleManifestEntry {
+			return nil, errors.Wrapf(
+				ErrInvalidBundle, "first entry %s is not the manifest",
+				hdr.Name,
+			)
+		}
+
+		switch name := path.Clean(hdr.Name); {
+		case name == bundleManifestEntry:
+			m, err = readBundleManifest(tr)
+		case name == bundleGenesisEntry:
+			err = writeGenesisFile(genesisFile, tr)
+		case name == bundleBlockEntry:
+			blockBz, err = io.ReadAll(tr)
+		case name == bundleCometStateEntry:
+			cmtState, err = readCometState(tr)
+		case name == bundleCometCommitEntry:
+			commit, err = readCommit(tr)
+		case name == bundleStateEntry:
+			err = restoreSnapshot(tr, cms, m)
+			restored = err == nil
+		case name == bundleDepositsEntry:
+			err = importDeposits(tr, stores.Deposits)
+		case strings.HasPrefix(name, bundleBlobsDir):
+			_, err = stores.Blobs.Import(tr)
+		default:
+			err = errors.Wrapf(ErrInvalidBundle, "unknown entry %s", name)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to import %s", hdr.Name)
+		}
+	}
+	if m == nil || blockBz == nil || cmtState == nil || commit == nil ||
+		!restored {
+		return nil, errors.Wrap(ErrInvalidBundle, "missing entries")
+	}
+
+	//#nosec:G115 // heights fit in an int64.
+	if cmtState.LastBlockHeight != int64(m.Height) ||
+		cmtState.ChainID != m.ChainID {
+		return nil, errors.Wrapf(
+			ErrInvalidBundle, "CometBFT state of %s at height %d",
+			cmtState.ChainID, cmtState.LastBlockHeight,
+		)
+	}
+	appHash := cms.LastCommitID().Hash
+	if !bytes.Equal(appHash, m.AppHash) ||
+		!bytes.Equal(appHash, cmtState.AppHash) {
+		return nil, errors.Wrapf(
+			ErrAppHashMismatch, "restored %X, expected %X", appHash, m.AppHash,
+		)
+	}
+	blockRoot, err := verifyBundleBlock(
+		newStateDB(cms.CacheMultiStore(), cs), cs, m.Height, blockBz,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if blockRoot != m.BlockRoot {
+		return nil, errors.Wrapf(
+			ErrBlockRootMismatch, "got %s, expected %s", blockRoot, m.BlockRoot,
+		)
+	}
+
+	return m, bootstrapCometBFT(stores.CometBFT, cmtState, commit)
+}
+
+// writeSnapshot writes the zlib compressed snapshot stream of the multistore
+// at the given height to w.
+func writeSnapshot(
+	w io.Writer, cms storetypes.CommitMultiStore, height uint64,
+) error {
+	zw := zlib.NewWriter(w)
+	if err := cms.Snapshot(height, protoio.NewDelimitedWriter(zw)); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// restoreSnapshot restores the multistore from the zlib compressed snapshot
+// stream read from r.
+func restoreSnapshot(
+	r io.Reader, cms storetypes.CommitMultiStore, m *BundleManifest,
+) error {
+	zr, err := zlib.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+	item, err := cms.Restore(
+		m.Height, m.StateFormat,
+		protoio.NewDelimitedReader(zr, snapshotMaxItemSize),
+	)
+	if err != nil {
+		return err
+	}
+	if item.Item != nil {
+		return errors.Wrap(ErrInvalidBundle, "unexpected snapshot item")
+	}
+	return nil
+}
+
+// verifyBundleBlock decodes the beacon block at the given height and checks
+// that the given state is the one it results in. It returns the block root.
+func verifyBundleBlock(
+	st *statedb.StateDB, cs chain.ChainSpec, height uint64, blockBz []byte,
+) (common.Root, error) {
+	blk, err := registry.Default.BeaconBlocks.Unmarshal(
+		cs.ActiveForkVersionForSlot(math.Slot(height)), blockBz,
+	)
+	if err != nil {
+		return common.Root{}, err
+	}
+	header, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return common.Root{}, err
+	}
+	// The state root of the latest block header is only filled upon the
+	// next slot.
+	if header.GetStateRoot() == (common.Root{}) {
+		header.SetStateRoot(st.HashTreeRoot())
+	}
+	blockRoot := blk.HashTreeRoot()
+	if header.HashTreeRoot() != blockRoot {
+		return common.Root{}, errors.Wrapf(
+			ErrBlockRootMismatch, "block %s, state %s",
+			blockRoot, header.HashTreeRoot(),
+		)
+	}
+	return blockRoot, nil
+}
+
+// firstBlobSlot returns the first slot whose blob sidecars are within the
+// data availability period at the given slot.
+func firstBlobSlot(cs chain.ChainSpec, slot uint64) uint64 {
+	var (
+		slotsPerEpoch = cs.SlotsPerEpoch()
+		epoch         = slot / slotsPerEpoch
+		minEpochs     = cs.MinEpochsForBlobsSidecarsRequest()
+	)
+	if epoch <= minEpochs {
+		return 0
+	}
+	return (epoch - minEpochs) * slotsPerEpoch
+}
+
+// importDeposits enqueues the SSZ deposits read from r.
+func importDeposits(r io.Reader, deposits *depositstore.KVStore) error {
+	bz, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	d := &bundleDeposits{}
+	if err = ssz.DecodeFromBytes(bz, d); err != nil {
+		return err
+	}
+	return deposits.EnqueueDeposits(d.Deposits)
+}
+
+// writeGenesisFile writes the genesis read from r to the given file.
+func writeGenesisFile(genesisFile string, r io.Reader) error {
+	bz, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(genesisFile, bz, 0o600)
+}
+
+// readCometState decodes the protobuf CometBFT state read from r.
+func readCometState(r io.Reader) (*sm.State, error) {
+	bz, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	pb := &cmtstate.State{}
+	if err = proto.Unmarshal(bz, pb); err != nil {
+		return nil, err
+	}
+	return sm.FromProto(pb)
+}
+
+// readCommit decodes the protobuf commit read from r.
+func readCommit(r io.Reader) (*cmttypes.Commit, error) {
+	bz, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	pb := &cmtproto.Commit{}
+	if err = proto.Unmarshal(bz, pb); err != nil {
+		return nil, err
+	}
+	return cmttypes.CommitFromProto(pb)
+}
+
+// openCometBFT opens the state and block stores of CometBFT.
+func openCometBFT(
+	cfg *cmtcfg.Config,
+) (sm.Store, *cmtstore.BlockStore, error) {
+	blockDB, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "blockstore", Config: cfg},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	stateDB, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "state", Config: cfg},
+	)
+	if err != nil {
+		return nil, nil, errors.Join(err, blockDB.Close())
+	}
+	return sm.NewStore(stateDB, sm.StoreOptions{
+			DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+			DBKeyLayout:          cfg.Storage.ExperimentalKeyLayout,
+		}), cmtstore.NewBlockStore(
+			blockDB, cmtstore.WithDBKeyLayout(cfg.Storage.ExperimentalKeyLayout),
+		), nil
+}
+
+// loadCometBFT loads the CometBFT state after the block at the given height,
+// along with the commit and the beacon block of the block.
+func loadCometBFT(
+	cfg *cmtcfg.Config, height int64,
+) (*sm.State, *cmttypes.Commit, []byte, error) {
+	stateStore, blockStore, err := openCometBFT(cfg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer func() {
+		_ = stateStore.Close()
+		_ = blockStore.Close()
+	}()
+
+	st, err := stateStore.Load()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if st.LastBlockHeight != height {
+		return nil, nil, nil, errors.Wrapf(
+			ErrInvalidHeight, "CometBFT at height %d, application at %d",
+			st.LastBlockHeight, height,
+		)
+	}
+	commit := blockStore.LoadSeenCommit(height)
+	blk, _ := blockStore.LoadBlock(height)
+	if commit == nil || blk == nil ||
+		uint(len(blk.Txs)) <= blockchain.BeaconBlockTxIndex {
+		return nil, nil, nil, errors.Wrapf(
+			ErrInvalidHeight, "no block stored at height %d", height,
+		)
+	}
+	return &st, commit, blk.Txs[blockchain.BeaconBlockTxIndex], nil
+}
+
+// bootstrapCometBFT bootstraps the empty CometBFT stores with the given
+// state and commit, as an offline state sync.
+func bootstrapCometBFT(
+	cfg *cmtcfg.Config, st *sm.State, commit *cmttypes.Commit,
+) error {
+	stateStore, blockStore, err := openCometBFT(cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = stateStore.Close()
+		_ = blockStore.Close()
+	}()
+
+	current, err := stateStore.Load()
+	if err != nil {
+		return err
+	}
+	if !blockStore.IsEmpty() || !current.IsEmpty() {
+		return errors.Wrap(ErrStateExists, "CometBFT state is not empty")
+	}
+	if err = stateStore.Bootstrap(*st); err != nil {
+		return err
+	}
+	if err = blockStore.SaveSeenCommit(st.LastBlockHeight, commit); err != nil {
+		return err
+	}
+	return stateStore.SetOfflineStateSyncHeight(st.LastBlockHeight)
+}
+
+// bundleDeposits is the SSZ container of the deposits of a bootstrap bundle.
+type bundleDeposits struct {
+	Deposits []*ctypes.Deposit
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the bundleDeposits
+// object.
+func (d *bundleDeposits) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	var size uint32 = 4
+
+	if fixed {
+		return size
+	}
+
+	size += ssz.SizeSliceOfStaticObjects(siz, d.Deposits)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the bundleDeposits object.
+func (d *bundleDeposits) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &d.Deposits, maxDeposits)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &d.Deposits, maxDeposits)
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// NewBundleCmd creates a new command for the bootstrap bundles, imported by
+// init --from-bundle.
+func NewBundleCmd[
+	LoggerT log.AdvancedLogger[LoggerT],
+](cs chain.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "bundle",
+		Short:                      "Bootstrap bundle subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewBundleExportCmd[LoggerT](cs),
+		NewBundleInspectCmd(),
+	)
+	return cmd
+}
+
+// NewBundleExportCmd creates a new command exporting a bootstrap bundle.
+func NewBundleExportCmd[
+	LoggerT log.AdvancedLogger[LoggerT],
+](cs chain.ChainSpec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "export [file]",
+		Short: "Exports a bootstrap bundle of the finalized state",
+		Long: `Exports a versioned bootstrap bundle of the latest finalized state,
+		along with its beacon block, the deposits it has yet to process, the
+		blob sidecars within the data availability period, the genesis file
+		and the CometBFT state and commit of the block. New nodes initialized
+		with init --from-bundle follow the chain from there, offline from this
+		node. The node must be stopped.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stores, err := OpenBundleStores[LoggerT](cmd, cs)
+			if err != nil {
+				return err
+			}
+			defer stores.Close()
+
+			f, err := os.OpenFile(
+				args[0], os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600,
+			)
+			if err != nil {
+				return err
+			}
+			w := bufio.NewWriter(f)
+			m, err := ExportBundle(w, stores, cs)
+			if err = errors.Join(err, w.Flush(), f.Close()); err != nil {
+				_ = os.Remove(args[0])
+				return err
+			}
+			cmd.Printf(
+				"exported bundle of %s at height %d to %s\n",
+				m.ChainID, m.Height, args[0],
+			)
+			return nil
+		},
+	}
+}
+
+// NewBundleInspectCmd creates a new command printing the manifest of a
+// bootstrap bundle.
+func NewBundleInspectCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "inspect [file]",
+		Short: "Prints the manifest of a bootstrap bundle",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			m, err := ReadBundleManifest(bufio.NewReader(f))
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(m, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+}
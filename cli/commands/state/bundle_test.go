@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"github.com/berachain/beacon-kit/cli/commands/state"
+	"github.com/berachain/beacon-kit/config/spec"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+// testBundle returns a bundle holding the given entries, in order.
+func testBundle(t *testing.T, entries ...string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for i := 0; i < len(entries); i += 2 {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name: entries[i],
+			Mode: 0o600,
+			Size: int64(len(entries[i+1])),
+		}))
+		_, err := tw.Write([]byte(entries[i+1]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	return buf.Bytes()
+}
+
+func testManifest(t *testing.T, version uint64) string {
+	t.Helper()
+	bz, err := json.Marshal(&state.BundleManifest{
+		Version:     version,
+		ChainID:     "test",
+		Height:      10,
+		StateFormat: snapshottypes.CurrentFormat,
+	})
+	require.NoError(t, err)
+	return string(bz)
+}
+
+func TestReadBundleManifest(t *testing.T) {
+	m, err := state.ReadBundleManifest(bytes.NewReader(testBundle(t,
+		"manifest.json", testManifest(t, state.BundleVersion),
+		"genesis.json", "{}",
+	)))
+	require.NoError(t, err)
+	require.Equal(t, "test", m.ChainID)
+	require.Equal(t, uint64(10), m.Height)
+
+	_, err = state.ReadBundleManifest(bytes.NewReader(testBundle(t,
+		"manifest.json", testManifest(t, state.BundleVersion+1),
+	)))
+	require.ErrorIs(t, err, state.ErrUnsupportedBundleVersion)
+
+	_, err = state.ReadBundleManifest(bytes.NewReader(testBundle(t,
+		"genesis.json", "{}",
+		"manifest.json", testManifest(t, state.BundleVersion),
+	)))
+	require.ErrorIs(t, err, state.ErrInvalidBundle)
+}
+
+func TestImportBundleMissingEntries(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	genesisFile := filepath.Join(t.TempDir(), "genesis.json")
+	_, err = state.ImportBundle(
+		bytes.NewReader(testBundle(t,
+			"manifest.json", testManifest(t, state.BundleVersion),
+			"genesis.json", "{}",
+		)),
+		&state.BundleStores{AppDB: dbm.NewMemDB()},
+		cs,
+		genesisFile,
+	)
+	require.ErrorIs(t, err, state.ErrInvalidBundle)
+	require.FileExists(t, genesisFile)
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"encoding/json"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// DumpVersion is the version of the state dump format.
+	DumpVersion uint64 = 1
+
+	// FormatSSZ is the SSZ state dump format.
+	FormatSSZ = "ssz"
+	// FormatJSON is the JSON state dump format.
+	FormatJSON = "json"
+
+	// maxDeposits is the maximum number of deposits in a state dump.
+	maxDeposits = 1 << 32
+)
+
+// Dump is a versioned dump of the beacon state at a height, along with the
+// deposits it has yet to process.
+type Dump struct {
+	// Version is the version of the dump format.
+	Version uint64 `json:"version"`
+	// Height is the height the state was exported at.
+	Height uint64 `json:"height"`
+	// State is the beacon state.
+	State *ctypes.BeaconState `json:"state"`
+	// Deposits are the deposits from the state eth1 deposit index on.
+	Deposits []*ctypes.Deposit `json:"deposits"`
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Dump object.
+func (d *Dump) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	var size uint32 = 24
+
+	if fixed {
+		return size
+	}
+
+	size += ssz.SizeDynamicObject(siz, d.State)
+	size += ssz.SizeSliceOfStaticObjects(siz, d.Deposits)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the Dump object.
+func (d *Dump) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &d.Version)
+	ssz.DefineUint64(codec, &d.Height)
+	ssz.DefineDynamicObjectOffset(codec, &d.State)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &d.Deposits, maxDeposits)
+
+	ssz.DefineDynamicObjectContent(codec, &d.State)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &d.Deposits, maxDeposits)
+}
+
+// Encode encodes the dump in the given format.
+func (d *Dump) Encode(format string) ([]byte, error) {
+	switch format {
+	case FormatSSZ:
+		buf := make([]byte, ssz.Size(d))
+		return buf, ssz.EncodeToBytes(buf, d)
+	case FormatJSON:
+		return json.MarshalIndent(d, "", "  ")
+	default:
+		return nil, errors.Wrap(ErrUnknownFormat, format)
+	}
+}
+
+// DecodeDump decodes a dump in the given format, checking its version.
+func DecodeDump(bz []byte, format string) (*Dump, error) {
+	d := &Dump{}
+	var err error
+	switch format {
+	case FormatSSZ:
+		err = ssz.DecodeFromBytes(bz, d)
+	case FormatJSON:
+		err = json.Unmarshal(bz, d)
+	default:
+		err = errors.Wrap(ErrUnknownFormat, format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if d.Version != DumpVersion {
+		return nil, errors.Wrapf(
+			ErrUnsupportedDumpVersion, "got %d, expected %d",
+			d.Version, DumpVersion,
+		)
+	}
+	return d, nil
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrUnsupportedDumpVersion is returned when a state dump has a format
+	// version this node does not support.
+	ErrUnsupportedDumpVersion = errors.New("unsupported state dump version")
+
+	// ErrUnknownFormat is returned when the state dump format is unknown.
+	ErrUnknownFormat = errors.New("unknown state dump format")
+
+	// ErrStateExists is returned when importing a state dump into a node
+	// which already has a state.
+	ErrStateExists = errors.New("node already has a state")
+
+	// ErrStateRootMismatch is returned when the imported state does not
+	// match the state dump.
+	ErrStateRootMismatch = errors.New("imported state root mismatch")
+
+	// ErrInvalidHeight is returned when the state dump height is invalid.
+	ErrInvalidHeight = errors.New("invalid height")
+
+	// ErrUnsupportedBundleVersion is returned when a bootstrap bundle has a
+	// format version this node does not support.
+	ErrUnsupportedBundleVersion = errors.New(
+		"unsupported bootstrap bundle version",
+	)
+
+	// ErrInvalidBundle is returned when a bootstrap bundle is malformed.
+	ErrInvalidBundle = errors.New("invalid bootstrap bundle")
+
+	// ErrAppHashMismatch is returned when the app hash of a bootstrap bundle
+	// state does not match the one CometBFT committed to.
+	ErrAppHashMismatch = errors.New("app hash mismatch")
+
+	// ErrBlockRootMismatch is returned when the beacon block of a bootstrap
+	// bundle does not match its state.
+	ErrBlockRootMismatch = errors.New("block root mismatch")
+)
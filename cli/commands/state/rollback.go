@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package state
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/config"
+	dastore "github.com/berachain/beacon-kit/da/store"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/statesync"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+// NewStoresRollback returns the rollback of the beacon stores of the node
+// home run by the rollback command, once the multistore is rolled back.
+func NewStoresRollback[
+	LoggerT log.AdvancedLogger[LoggerT],
+](cs chain.ChainSpec) func(
+	*cobra.Command, storetypes.MultiStore, int64,
+) error {
+	return func(
+		cmd *cobra.Command, ms storetypes.MultiStore, latest int64,
+	) error {
+		st := newStateDB(ms, cs)
+		slot, err := st.GetSlot()
+		if err != nil {
+			return err
+		}
+		lph, err := st.GetLatestExecutionPayloadHeader()
+		if err != nil {
+			return err
+		}
+		//#nosec:G115 // heights are positive.
+		return RollbackStores(
+			clicontext.GetViperFromCmd(cmd),
+			clicontext.GetLoggerFromCmd[LoggerT](cmd),
+			cs, math.Slot(latest), slot, lph.GetNumber(),
+		)
+	}
+}
+
+// RollbackStores rewinds the stores of the node home kept out of the
+// multistore from the given latest slot to the given one, at which the
+// multistore was rolled back, its beacon state carrying the execution
+// payload of the given number. Slots are heights.
+func RollbackStores[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	appOpts config.AppOptions,
+	logger LoggerT,
+	cs chain.ChainSpec,
+	latest, slot math.Slot,
+	payloadNum math.U64,
+) error {
+	home := cast.ToString(appOpts.Get(flags.FlagHome))
+	if err := dastore.NewIndexDB(home, logger).DeleteRange(
+		slot.Unwrap()+1, latest.Unwrap()+1,
+	); err != nil {
+		return errors.Wrap(err, "failed to rollback blob sidecars")
+	}
+	if err := statesync.Rewind(
+		statesync.Dir(home), slot.Unwrap(),
+	); err != nil {
+		return errors.Wrap(err, "failed to rollback state sync snapshots")
+	}
+
+	// The deposits of the execution blocks up to the one followed by the
+	// rolled back state are kept, the next ones are fetched again as the
+	// blocks are replayed.
+	var followed uint64
+	if follow := cs.Eth1FollowDistance(); payloadNum.Unwrap() > follow {
+		followed = payloadNum.Unwrap() - follow
+	}
+	deposits, err := components.ProvideDepositStore(
+		components.DepositStoreInput[LoggerT]{Logger: logger, AppOpts: appOpts},
+	)
+	if err != nil {
+		return err
+	}
+	if err = errors.Join(
+		deposits.Rewind(followed), deposits.Close(),
+	); err != nil {
+		return errors.Wrap(err, "failed to rollback deposits")
+	}
+
+	freezer, err := components.ProvideFreezer(
+		components.FreezerInput{AppOpts: appOpts},
+	)
+	if err != nil {
+		return err
+	}
+	snapshots, err := components.ProvideSnapshotStore(
+		components.SnapshotStoreInput[LoggerT]{
+			AppOpts: appOpts,
+			Freezer: freezer,
+			Logger:  logger,
+		},
+	)
+	if err != nil {
+		return errors.Join(err, freezer.Close())
+	}
+	if err = errors.Join(
+		snapshots.Rewind(slot), freezer.Rewind(slot),
+		snapshots.Close(), freezer.Close(),
+	); err != nil {
+		return errors.Wrap(err, "failed to rollback state snapshots")
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package state_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"github.com/berachain/beacon-kit/cli/commands/state"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	dastore "github.com/berachain/beacon-kit/da/store"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/statesync"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+// The progress markers of following the execution layer.
+const (
+	depositsScanned = "deposits_scanned"
+	forkchoiceHead  = "forkchoice_head"
+)
+
+func testSnapshotState(t *testing.T, slot math.Slot) *ctypes.BeaconState {
+	t.Helper()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	bs, err := new(ctypes.BeaconState).New(
+		0,
+		common.Root{0x01},
+		slot,
+		&ctypes.Fork{},
+		&ctypes.BeaconBlockHeader{Slot: slot},
+		make([]common.Root, cs.SlotsPerHistoricalRoot()),
+		make([]common.Root, cs.SlotsPerHistoricalRoot()),
+		&ctypes.Eth1Data{},
+		0,
+		&ctypes.ExecutionPayloadHeader{BaseFeePerGas: &math.U256{}},
+		nil,
+		nil,
+		make([]common.Bytes32, cs.EpochsPerHistoricalVector()),
+		0,
+		0,
+		[]math.Gwei{0},
+		0,
+	)
+	require.NoError(t, err)
+	return bs
+}
+
+//nolint:maintidx // populates every store.
+func TestRollbackStores(t *testing.T) {
+	var (
+		home   = t.TempDir()
+		logger = phuslu.NewLogger(io.Discard, nil)
+		v      = viper.New()
+	)
+	v.Set(flags.FlagHome, home)
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	// The node is at slot 10 and rolled back to slot 6, whose execution
+	// payload follows the execution block 100.
+	const (
+		latest   = math.Slot(10)
+		target   = math.Slot(6)
+		followed = uint64(100)
+	)
+	payloadNum := math.U64(followed + cs.Eth1FollowDistance())
+
+	// Blob sidecars are stored at every slot.
+	blobs := dastore.NewIndexDB(home, logger)
+	for slot := uint64(1); slot <= latest.Unwrap(); slot++ {
+		require.NoError(t, blobs.Set(slot, []byte("sidecar"), []byte{0x01}))
+	}
+
+	// Execution blocks 98 to 103 carry a deposit each, block 99 and 102
+	// failed to be fetched.
+	deposits, err := components.ProvideDepositStore(
+		components.DepositStoreInput[*phuslu.Logger]{
+			Logger: logger, AppOpts: v,
+		},
+	)
+	require.NoError(t, err)
+	for i, blockNum := range []uint64{98, 99, 100, 101, 102, 103} {
+		//#nosec:G115 // i is small.
+		index := uint64(i)
+		require.NoError(t, deposits.EnqueueDeposits(
+			[]*ctypes.Deposit{{Index: index}},
+		))
+		require.NoError(t, deposits.SetBlockDeposits(blockNum, index))
+	}
+	require.NoError(t, deposits.AddFailedBlock(99))
+	require.NoError(t, deposits.AddFailedBlock(102))
+	require.NoError(t, deposits.SetProgress(depositsScanned, 103))
+	require.NoError(t, deposits.SetProgress(forkchoiceHead, 90))
+	require.NoError(t, deposits.Close())
+
+	// Blocks up to slot 8 are frozen, as are the snapshots before slot 9.
+	freezer, err := components.ProvideFreezer(
+		components.FreezerInput{AppOpts: v},
+	)
+	require.NoError(t, err)
+	for slot := math.Slot(1); slot <= 8; slot++ {
+		require.NoError(t, freezer.FreezeBlock(slot, []byte{0x01}, []byte{0x02}))
+	}
+	snapshotStore, err := components.ProvideSnapshotStore(
+		components.SnapshotStoreInput[*phuslu.Logger]{
+			AppOpts: v, Freezer: freezer, Logger: logger,
+		},
+	)
+	require.NoError(t, err)
+	for _, slot := range []math.Slot{2, 4, 8, 10} {
+		require.NoError(t, snapshotStore.Set(testSnapshotState(t, slot)))
+	}
+	require.NoError(t, snapshotStore.Freeze(9))
+	require.NoError(t, snapshotStore.Close())
+	require.NoError(t, freezer.Close())
+
+	// State sync snapshots are taken every 4 slots.
+	dir := statesync.Dir(home)
+	metadata, err := dbm.NewDB("metadata", dbm.PebbleDBBackend, dir)
+	require.NoError(t, err)
+	stateSyncStore, err := snapshots.NewStore(metadata, dir)
+	require.NoError(t, err)
+	for _, height := range []uint64{4, 8} {
+		chunks := make(chan io.ReadCloser, 1)
+		chunks <- io.NopCloser(bytes.NewReader([]byte{0x01}))
+		close(chunks)
+		_, err = stateSyncStore.Save(
+			height, snapshottypes.CurrentFormat, chunks,
+		)
+		require.NoError(t, err)
+	}
+	require.NoError(t, metadata.Close())
+
+	require.NoError(t, state.RollbackStores(
+		v, logger, cs, latest, target, payloadNum,
+	))
+
+	// The blob sidecars of the slots after the target are removed.
+	for slot := uint64(1); slot <= latest.Unwrap(); slot++ {
+		has, hasErr := blobs.Has(slot, []byte("sidecar"))
+		require.NoError(t, hasErr)
+		require.Equal(t, slot <= target.Unwrap(), has, "slot %d", slot)
+	}
+
+	// The deposits of the execution blocks after the followed one are
+	// removed, and the progress markers moved back to it.
+	deposits, err = components.ProvideDepositStore(
+		components.DepositStoreInput[*phuslu.Logger]{
+			Logger: logger, AppOpts: v,
+		},
+	)
+	require.NoError(t, err)
+	pending, err := deposits.GetDepositsFromIndex(0)
+	require.NoError(t, err)
+	require.Len(t, pending, 3)
+	failed, err := deposits.GetFailedBlocks()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{99}, failed)
+	scanned, err := deposits.GetProgress(depositsScanned)
+	require.NoError(t, err)
+	require.Equal(t, followed, scanned)
+	head, err := deposits.GetProgress(forkchoiceHead)
+	require.NoError(t, err)
+	require.Equal(t, uint64(90), head)
+	require.NoError(t, deposits.Close())
+
+	// The blocks and snapshots after the target are removed from the
+	// freezer and the snapshot store.
+	freezer, err = components.ProvideFreezer(
+		components.FreezerInput{AppOpts: v},
+	)
+	require.NoError(t, err)
+	last, ok := freezer.LastBlockSlot()
+	require.True(t, ok)
+	require.Equal(t, target, last)
+	last, ok = freezer.Snapshots().Last()
+	require.True(t, ok)
+	require.Equal(t, math.Slot(4), last)
+	snapshotStore, err = components.ProvideSnapshotStore(
+		components.SnapshotStoreInput[*phuslu.Logger]{
+			AppOpts: v, Freezer: freezer, Logger: logger,
+		},
+	)
+	require.NoError(t, err)
+	bs, err := snapshotStore.Latest()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(4), bs.Slot)
+	require.NoError(t, snapshotStore.Close())
+	require.NoError(t, freezer.Close())
+
+	// The state sync snapshots after the target are removed.
+	metadata, err = dbm.NewDB("metadata", dbm.PebbleDBBackend, dir)
+	require.NoError(t, err)
+	stateSyncStore, err = snapshots.NewStore(metadata, dir)
+	require.NoError(t, err)
+	list, err := stateSyncStore.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, uint64(4), list[0].Height)
+	require.NoError(t, metadata.Close())
+}
@@ -0,0 +1,256 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/storage/db"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// height is the flag for the height to export the state at.
+	height = "height"
+	// format is the flag for the state dump format.
+	format = "format"
+	// output is the flag for the file to write the state dump to.
+	output = "output"
+)
+
+// Commands creates a new command for exporting and importing the beacon
+// state.
+func Commands[
+	LoggerT log.AdvancedLogger[LoggerT],
+](cs chain.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "state",
+		Short:                      "Beacon state subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewExportCmd[LoggerT](cs),
+		NewImportCmd[LoggerT](cs),
+		NewBundleCmd[LoggerT](cs),
+	)
+	return cmd
+}
+
+// NewExportCmd creates a new command exporting the beacon state.
+func NewExportCmd[
+	LoggerT log.AdvancedLogger[LoggerT],
+](cs chain.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports the beacon state at a height",
+		Long: `Exports the beacon state committed at the given height, or at the
+		latest height, along with the deposits it has yet to process, as a
+		versioned SSZ or JSON dump. The node must be stopped.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			h, err := cmd.Flags().GetInt64(height)
+			if err != nil {
+				return err
+			}
+			f, err := cmd.Flags().GetString(format)
+			if err != nil {
+				return err
+			}
+			out, err := cmd.Flags().GetString(output)
+			if err != nil {
+				return err
+			}
+
+			appDB, deposits, err := openStores[LoggerT](cmd)
+			if err != nil {
+				return err
+			}
+			defer closeStores(appDB, deposits)
+
+			d, err := Export(appDB, deposits, cs, h)
+			if err != nil {
+				return err
+			}
+			bz, err := d.Encode(f)
+			if err != nil {
+				return err
+			}
+			if out == "" {
+				_, err = cmd.OutOrStdout().Write(bz)
+				return err
+			}
+			if err = os.WriteFile(out, bz, 0o600); err != nil {
+				return err
+			}
+			cmd.Printf(
+				"exported state at height %d, slot %d, to %s\n",
+				d.Height, d.State.Slot, out,
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(height, 0, "height to export, latest if zero")
+	cmd.Flags().String(format, FormatSSZ, "dump format, ssz or json")
+	cmd.Flags().String(output, "", "file to write to, stdout if empty")
+	return cmd
+}
+
+// NewImportCmd creates a new command importing a beacon state dump.
+func NewImportCmd[
+	LoggerT log.AdvancedLogger[LoggerT],
+](cs chain.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [file]",
+		Short: "Imports a beacon state dump",
+		Long: `Imports a beacon state dump into a node without state, committing
+		it at the dump height, and enqueues the dumped deposits. The format is
+		inferred from the file extension unless set. The imported state cannot
+		be followed by the chain: its app hash differs from the one of the
+		chain at the same height, as the app hash commits to the history of
+		the store and not only to the beacon state.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := cmd.Flags().GetString(format)
+			if err != nil {
+				return err
+			}
+			if f == "" {
+				f = formatFromPath(args[0])
+			}
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			d, err := DecodeDump(bz, f)
+			if err != nil {
+				return err
+			}
+
+			appDB, deposits, err := openStores[LoggerT](cmd)
+			if err != nil {
+				return err
+			}
+			defer closeStores(appDB, deposits)
+
+			if err = Import(appDB, deposits, cs, d); err != nil {
+				return err
+			}
+			cmd.Printf(
+				"imported state at height %d, slot %d\n",
+				d.Height, d.State.Slot,
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(format, "", "dump format, ssz or json")
+	return cmd
+}
+
+// formatFromPath returns the dump format matching the file extension,
+// defaulting to SSZ.
+func formatFromPath(path string) string {
+	if strings.EqualFold(filepath.Ext(path), "."+FormatJSON) {
+		return FormatJSON
+	}
+	return FormatSSZ
+}
+
+// openStores opens the application database and the deposit store of the
+// node home.
+func openStores[
+	LoggerT log.AdvancedLogger[LoggerT],
+](cmd *cobra.Command) (dbm.DB, *depositstore.KVStore, error) {
+	cfg := clicontext.GetConfigFromCmd(cmd)
+	appDB, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+	if err != nil {
+		return nil, nil, err
+	}
+	deposits, err := components.ProvideDepositStore(
+		components.DepositStoreInput[LoggerT]{
+			Logger:  clicontext.GetLoggerFromCmd[LoggerT](cmd),
+			AppOpts: clicontext.GetViperFromCmd(cmd),
+		},
+	)
+	if err != nil {
+		return nil, nil, errors.Join(err, appDB.Close())
+	}
+	return appDB, deposits, nil
+}
+
+// closeStores closes the stores opened by openStores.
+func closeStores(appDB dbm.DB, deposits *depositstore.KVStore) {
+	_ = deposits.Close()
+	_ = appDB.Close()
+}
+
+// OpenBundleStores opens the stores of the node home a bootstrap bundle is
+// exported from or imported into.
+func OpenBundleStores[
+	LoggerT log.AdvancedLogger[LoggerT],
+](cmd *cobra.Command, cs chain.ChainSpec) (*BundleStores, error) {
+	v := clicontext.GetViperFromCmd(cmd)
+	cfg, err := config.ReadConfigFromAppOpts(v)
+	if err != nil {
+		return nil, err
+	}
+	blobs, err := components.ProvideAvailibilityStore(
+		components.AvailabilityStoreInput[LoggerT]{
+			AppOpts:   v,
+			ChainSpec: cs,
+			Config:    cfg,
+			Logger:    clicontext.GetLoggerFromCmd[LoggerT](cmd),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	appDB, deposits, err := openStores[LoggerT](cmd)
+	if err != nil {
+		return nil, err
+	}
+	return &BundleStores{
+		AppDB:    appDB,
+		Deposits: deposits,
+		Blobs:    blobs,
+		CometBFT: clicontext.GetConfigFromCmd(cmd),
+	}, nil
+}
+
+// Close closes the stores opened by OpenBundleStores.
+func (s *BundleStores) Close() {
+	closeStores(s.AppDB, s.Deposits)
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/cli/commands/state"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func testDump(t *testing.T, cs chain.ChainSpec) *state.Dump {
+	t.Helper()
+	blockRoots := make([]common.Root, cs.SlotsPerHistoricalRoot())
+	stateRoots := make([]common.Root, cs.SlotsPerHistoricalRoot())
+	blockRoots[1] = common.Root{0x01}
+	randaoMixes := make([]common.Bytes32, cs.EpochsPerHistoricalVector())
+	randaoMixes[0] = common.Bytes32{0x02}
+
+	validators := []*ctypes.Validator{
+		ctypes.NewValidatorFromDeposit(
+			[48]byte{0x01},
+			ctypes.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{0x01},
+			),
+			math.Gwei(cs.MaxEffectiveBalance(false)),
+			math.Gwei(cs.EffectiveBalanceIncrement()),
+			math.Gwei(cs.MaxEffectiveBalance(false)),
+		),
+	}
+	bs, err := new(ctypes.BeaconState).New(
+		0,
+		common.Root{0x03},
+		10,
+		&ctypes.Fork{},
+		&ctypes.BeaconBlockHeader{Slot: 10},
+		blockRoots,
+		stateRoots,
+		&ctypes.Eth1Data{DepositCount: 2},
+		1,
+		&ctypes.ExecutionPayloadHeader{
+			Number:        7,
+			BaseFeePerGas: &math.U256{},
+		},
+		validators,
+		[]uint64{cs.MaxEffectiveBalance(false)},
+		randaoMixes,
+		3,
+		0,
+		[]math.Gwei{0},
+		0,
+	)
+	require.NoError(t, err)
+
+	return &state.Dump{
+		Version:  state.DumpVersion,
+		Height:   10,
+		State:    bs,
+		Deposits: []*ctypes.Deposit{{Pubkey: [48]byte{0x02}, Index: 1}},
+	}
+}
+
+func TestDumpEncoding(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	d := testDump(t, cs)
+
+	for _, format := range []string{state.FormatSSZ, state.FormatJSON} {
+		bz, err := d.Encode(format)
+		require.NoError(t, err)
+		decoded, err := state.DecodeDump(bz, format)
+		require.NoError(t, err)
+		require.Equal(t, d.Height, decoded.Height)
+		require.Equal(t, d.State.HashTreeRoot(), decoded.State.HashTreeRoot())
+		require.Equal(t, d.Deposits, decoded.Deposits)
+	}
+
+	_, err = d.Encode("yaml")
+	require.ErrorIs(t, err, state.ErrUnknownFormat)
+
+	d.Version++
+	bz, err := d.Encode(state.FormatSSZ)
+	require.NoError(t, err)
+	_, err = state.DecodeDump(bz, state.FormatSSZ)
+	require.ErrorIs(t, err, state.ErrUnsupportedDumpVersion)
+}
+
+func TestImportExport(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	d := testDump(t, cs)
+
+	appDB := dbm.NewMemDB()
+	deposits := depositstore.NewStore(
+		storage.NewKVStoreProvider(dbm.NewMemDB()), noop.NewLogger[any](),
+	)
+	require.NoError(t, state.Import(appDB, deposits, cs, d))
+
+	exported, err := state.Export(appDB, deposits, cs, 0)
+	require.NoError(t, err)
+	require.Equal(t, d.Height, exported.Height)
+	require.Equal(t, d.State.HashTreeRoot(), exported.State.HashTreeRoot())
+	require.Len(t, exported.Deposits, 1)
+	require.Equal(t, d.Deposits[0].Pubkey, exported.Deposits[0].Pubkey)
+
+	// there is no state above the latest height
+	_, err = state.Export(appDB, deposits, cs, 11)
+	require.ErrorIs(t, err, state.ErrInvalidHeight)
+
+	// a state can only be imported into a node without state
+	err = state.Import(appDB, deposits, cs, d)
+	require.ErrorIs(t, err, state.ErrStateExists)
+}
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// loadMultiStore loads the latest version of the application multistore
+// backed by the given database.
+func loadMultiStore(db dbm.DB) (storetypes.CommitMultiStore, error) {
+	cms := store.NewCommitMultiStore(
+		db, log.NewNopLogger(), storemetrics.NewNoOpMetrics(),
+	)
+	cms.MountStoreWithDB(
+		components.ProvideKVStoreKey(), storetypes.StoreTypeIAVL, nil,
+	)
+	return cms, cms.LoadLatestVersion()
+}
+
+// newStateDB returns the beacon state held by the given multistore.
+func newStateDB(
+	ms storetypes.MultiStore, cs chain.ChainSpec,
+) *statedb.StateDB {
+	kv := components.ProvideKVStore(components.KVStoreInput{
+		KVStoreService: components.ProvideKVStoreService(
+			components.ProvideKVStoreKey(),
+		),
+	})
+	ctx := sdk.NewContext(ms, false, log.NewNopLogger())
+	return new(statedb.StateDB).NewFromDB(kv.WithContext(ctx), cs)
+}
+
+// Export dumps the beacon state committed at the given height, or at the
+// latest height if zero, along with the deposits it has yet to process.
+func Export(
+	db dbm.DB,
+	deposits *depositstore.KVStore,
+	cs chain.ChainSpec,
+	height int64,
+) (*Dump, error) {
+	cms, err := loadMultiStore(db)
+	if err != nil {
+		return nil, err
+	}
+	if height == 0 {
+		height = cms.LatestVersion()
+	}
+	if height <= 0 || height > cms.LatestVersion() {
+		return nil, errors.Wrapf(
+			ErrInvalidHeight, "%d, latest height is %d",
+			height, cms.LatestVersion(),
+		)
+	}
+
+	ms, err := cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, err
+	}
+	bs, err := newStateDB(ms, cs).GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+
+	// The deposit store is not versioned: the deposits are the ones it
+	// currently holds from the state deposit index on.
+	pending, err := deposits.GetDepositsFromIndex(bs.Eth1DepositIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	//#nosec:G115 // height is positive.
+	return &Dump{
+		Version:  DumpVersion,
+		Height:   uint64(height),
+		State:    bs,
+		Deposits: pending,
+	}, nil
+}
+
+// Import writes the dumped beacon state into the given empty database,
+// committing it at the dump height, and enqueues the dumped deposits.
+//
+// NOTE: the app hash committed differs from the one of the chain at the dump
+// height, as the IAVL tree hashes the version each key was written at, so
+// CometBFT cannot be bootstrapped on top of the imported state.
+func Import(
+	db dbm.DB,
+	deposits *depositstore.KVStore,
+	cs chain.ChainSpec,
+	d *Dump,
+) error {
+	cms, err := loadMultiStore(db)
+	if err != nil {
+		return err
+	}
+	if latest := cms.LatestVersion(); latest != 0 {
+		return errors.Wrapf(ErrStateExists, "at height %d", latest)
+	}
+	if d.Height == 0 {
+		return errors.Wrap(ErrInvalidHeight, "dump height is zero")
+	}
+
+	//#nosec:G115 // heights fit in an int64.
+	if err = cms.SetInitialVersion(int64(d.Height)); err != nil {
+		return err
+	}
+	ms := cms.CacheMultiStore()
+	st := newStateDB(ms, cs)
+	if err = st.SetMarshallable(d.State); err != nil {
+		return err
+	}
+	if got, want := st.HashTreeRoot(), d.State.HashTreeRoot(); got != want {
+		return errors.Wrapf(
+			ErrStateRootMismatch, "got %s, expected %s", got, want,
+		)
+	}
+	ms.Write()
+	cms.Commit()
+
+	return deposits.EnqueueDeposits(d.Deposits)
+}
@@ -0,0 +1,450 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"encoding/hex"
+	"path/filepath"
+	"slices"
+
+	"github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// ManifestVersion is the version of the manifest format.
+	ManifestVersion = 1
+	// ManifestFile is the name of the manifest written along with the
+	// keystores exported.
+	ManifestFile = "manifest.json"
+)
+
+// NewExportKeysCmd creates a new command to export the validator keys of the
+// node in batch.
+func NewExportKeysCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-keys [output-dir]",
+		Short: "Exports the validator keys into EIP-2335 keystores",
+		Long: `Exports the node validator key, along with the keys of the
+		keystores directory if the keystores password file is set, into
+		EIP-2335 keystores encrypted with the password held by the password
+		file. The keystores are written to the output directory along with
+		a manifest listing them, for them to be imported back at once with
+		import-keys. The password must be at least 12 characters long and
+		mix at least 3 of lowercase letters, uppercase letters, digits and
+		other characters.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			password, err := readPassword(cmd)
+			if err != nil {
+				return err
+			}
+			function, err := cmd.Flags().GetString(kdf)
+			if err != nil {
+				return err
+			}
+
+			var keys []BatchKey
+			pv, err := loadValidatorKey(cmd)
+			switch {
+			case errors.Is(err, ErrValidatorKeyNotFound):
+			case err != nil:
+				return err
+			case pv.Key.PrivKey.Type() != bls12381.KeyType:
+				return errors.Wrap(
+					ErrUnsupportedValidatorKey, pv.Key.PrivKey.Type(),
+				)
+			default:
+				keys = append(keys, BatchKey{
+					Key:          signer.LegacyKey(pv.Key.PrivKey.Bytes()),
+					ValidatorKey: true,
+				})
+			}
+			dirKeys, err := readKeystoresDir(cmd)
+			if err != nil {
+				return err
+			}
+			keys = append(keys, dirKeys...)
+			if len(keys) == 0 {
+				return ErrNoKeysToExport
+			}
+
+			path, err := ExportKeys(keys, args[0], password, function)
+			if err != nil {
+				return err
+			}
+			cmd.Printf(
+				"Successfully exported %d validator keys, listed by: %s\n",
+				len(keys), path,
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(passwordFile, "", exportPasswordFileMsg)
+	cmd.Flags().String(
+		keystoresPasswordFile, "", exportKeystoresPasswordFileMsg,
+	)
+	cmd.Flags().String(outputDir, "", keystoresDirMsg)
+	cmd.Flags().String(kdf, signer.KDFScrypt, kdfMsg)
+	return cmd
+}
+
+// NewImportKeysCmd creates a new command to import validator keys in batch.
+func NewImportKeysCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-keys [manifest-file]",
+		Short: "Imports the validator keys listed by a manifest",
+		Long: `Decrypts the EIP-2335 keystores listed by the given manifest, as
+		written by export-keys, with the password held by the password file.
+		The node validator key is installed as such, its signing state being
+		preserved, and the other keys are written to the keystores directory,
+		encrypted anew with the password held by the keystores password
+		file, which must be strong enough. Nothing is imported unless all
+		the keystores are decrypted, and the keys already in the keystores
+		directory are skipped.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			password, err := readPassword(cmd)
+			if err != nil {
+				return err
+			}
+			overwrite, err := cmd.Flags().GetBool(force)
+			if err != nil {
+				return err
+			}
+			keys, err := ReadKeys(args[0], password)
+			if err != nil {
+				return err
+			}
+			// The keystores password is checked before anything is
+			// imported.
+			dirPassword, err := readKeystoresPassword(cmd, keys)
+			if err != nil {
+				return err
+			}
+
+			config := context.GetConfigFromCmd(cmd)
+			for _, k := range keys {
+				if !k.ValidatorKey {
+					continue
+				}
+				var pubkey crypto.BLSPubkey
+				pubkey, err = installValidatorKey(config, k.Key, overwrite)
+				if err != nil {
+					return err
+				}
+				cmd.Printf(
+					"Imported validator key %s to: %s\n",
+					pubkey.String(), config.PrivValidatorKeyFile(),
+				)
+			}
+			return importKeystores(cmd, keys, dirPassword)
+		},
+	}
+
+	cmd.Flags().String(passwordFile, "", importPasswordFileMsg)
+	cmd.Flags().String(
+		keystoresPasswordFile, "", importKeystoresPasswordFileMsg,
+	)
+	cmd.Flags().String(outputDir, "", keystoresDirMsg)
+	cmd.Flags().String(kdf, signer.KDFScrypt, kdfMsg)
+	cmd.Flags().Bool(force, false, forceMsg)
+	return cmd
+}
+
+// readKeystoresDir decrypts the keystores of the keystores directory with
+// the password held by the keystores password file, if set.
+func readKeystoresDir(cmd *cobra.Command) ([]BatchKey, error) {
+	path, err := cmd.Flags().GetString(keystoresPasswordFile)
+	if err != nil || path == "" {
+		return nil, err
+	}
+	password, err := signer.ReadPasswordFile(path)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := keystoresDir(cmd)
+	if err != nil {
+		return nil, err
+	}
+	paths, err := afero.Glob(afero.NewOsFs(), filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]BatchKey, 0, len(paths))
+	for _, path = range paths {
+		var key signer.LegacyKey
+		if key, err = readKeystore(path, password); err != nil {
+			return nil, errors.Wrap(err, path)
+		}
+		keys = append(keys, BatchKey{Key: key})
+	}
+	return keys, nil
+}
+
+// readKeystoresPassword reads the password held by the keystores password
+// file, which must be strong enough, if any of the given keys is to be
+// imported into the keystores directory.
+func readKeystoresPassword(
+	cmd *cobra.Command,
+	keys []BatchKey,
+) (string, error) {
+	if !slices.ContainsFunc(keys, func(k BatchKey) bool {
+		return !k.ValidatorKey
+	}) {
+		return "", nil
+	}
+	path, err := cmd.Flags().GetString(keystoresPasswordFile)
+	if err != nil {
+		return "", err
+	}
+	if path == "" {
+		return "", ErrKeystoresPasswordFileRequired
+	}
+	password, err := signer.ReadPasswordFile(path)
+	if err != nil {
+		return "", err
+	}
+	return password, CheckPasswordStrength(password)
+}
+
+// importKeystores writes the given keys, other than the node validator key,
+// to the keystores directory, encrypted with the given password. The keys
+// already in the directory are skipped.
+func importKeystores(
+	cmd *cobra.Command,
+	keys []BatchKey,
+	password string,
+) error {
+	function, err := cmd.Flags().GetString(kdf)
+	if err != nil {
+		return err
+	}
+	dir, err := keystoresDir(cmd)
+	if err != nil {
+		return err
+	}
+
+	fs := afero.NewOsFs()
+	for _, k := range keys {
+		if k.ValidatorKey {
+			continue
+		}
+		var legacySigner *signer.LegacySigner
+		if legacySigner, err = signer.NewLegacySigner(k.Key); err != nil {
+			return err
+		}
+		pubkey := legacySigner.PublicKey()
+		path := filepath.Join(dir, hex.EncodeToString(pubkey[:])+".json")
+		var exists bool
+		if exists, err = afero.Exists(fs, path); err != nil {
+			return err
+		}
+		if exists {
+			cmd.Printf("Skipped key %s, already in: %s\n", pubkey, path)
+			continue
+		}
+		if err = encryptKeystoreFile(
+			k.Key, pubkey, password, function, path,
+		); err != nil {
+			return err
+		}
+		cmd.Printf("Imported key %s to: %s\n", pubkey, path)
+	}
+	return nil
+}
+
+// Manifest lists the keystores exported in batch, for them to be imported
+// back at once.
+type Manifest struct {
+	// Version is the version of the manifest format.
+	Version int `json:"version"`
+	// Keystores are the keystores exported.
+	Keystores []ManifestEntry `json:"keystores"`
+}
+
+// ManifestEntry is a keystore listed by a manifest.
+type ManifestEntry struct {
+	// Pubkey is the public key of the key held by the keystore.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+	// File is the path of the keystore, relative to the manifest.
+	File string `json:"file"`
+	// ValidatorKey is set for the node validator key, as opposed to the keys
+	// of the keystores directory.
+	ValidatorKey bool `json:"validator_key,omitempty"`
+}
+
+// BatchKey is a key exported or imported in batch.
+type BatchKey struct {
+	// Key is the secret key.
+	Key signer.LegacyKey
+	// ValidatorKey is set for the node validator key, as opposed to the keys
+	// of the keystores directory.
+	ValidatorKey bool
+}
+
+// ExportKeys encrypts the given keys into EIP-2335 keystores with the given
+// password and key derivation function, and writes them to dir along with
+// their manifest, whose path is returned. The password must be strong
+// enough, as checked by CheckPasswordStrength.
+func ExportKeys(
+	keys []BatchKey,
+	dir string,
+	password string,
+	kdf string,
+) (string, error) {
+	if err := CheckPasswordStrength(password); err != nil {
+		return "", err
+	}
+	manifest := Manifest{
+		Version:   ManifestVersion,
+		Keystores: make([]ManifestEntry, 0, len(keys)),
+	}
+	for _, k := range keys {
+		legacySigner, err := signer.NewLegacySigner(k.Key)
+		if err != nil {
+			return "", err
+		}
+		pubkey := legacySigner.PublicKey()
+		file := hex.EncodeToString(pubkey[:]) + ".json"
+		if err = encryptKeystoreFile(
+			k.Key, pubkey, password, kdf, filepath.Join(dir, file),
+		); err != nil {
+			return "", err
+		}
+		manifest.Keystores = append(manifest.Keystores, ManifestEntry{
+			Pubkey:       pubkey,
+			File:         file,
+			ValidatorKey: k.ValidatorKey,
+		})
+	}
+	bz, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, ManifestFile)
+	//nolint:mnd // file permissions.
+	if err = afero.WriteFile(afero.NewOsFs(), path, bz, 0o600); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// ReadKeys decrypts the keystores listed by the manifest at the given path
+// with the given password. All of them are decrypted, and checked to hold
+// the keys listed, before any is returned.
+func ReadKeys(manifestFile string, password string) ([]BatchKey, error) {
+	bz, err := afero.ReadFile(afero.NewOsFs(), manifestFile)
+	if err != nil {
+		return nil, err
+	}
+	var manifest Manifest
+	if err = json.Unmarshal(bz, &manifest); err != nil {
+		return nil, errors.Wrapf(err, "invalid manifest %s", manifestFile)
+	}
+	if manifest.Version != ManifestVersion {
+		return nil, errors.Wrapf(
+			ErrUnsupportedManifest, "version %d", manifest.Version,
+		)
+	}
+
+	keys := make([]BatchKey, 0, len(manifest.Keystores))
+	seen := make(map[crypto.BLSPubkey]struct{}, len(manifest.Keystores))
+	validatorKeys := 0
+	for _, entry := range manifest.Keystores {
+		if !filepath.IsLocal(entry.File) {
+			return nil, errors.Wrapf(
+				ErrInvalidManifest, "keystore %s outside of the manifest "+
+					"directory", entry.File,
+			)
+		}
+		if _, ok := seen[entry.Pubkey]; ok {
+			return nil, errors.Wrapf(
+				ErrInvalidManifest, "duplicate key %s", entry.Pubkey,
+			)
+		}
+		seen[entry.Pubkey] = struct{}{}
+		if entry.ValidatorKey {
+			validatorKeys++
+		}
+
+		var key signer.LegacyKey
+		key, err = readKeystore(
+			filepath.Join(filepath.Dir(manifestFile), entry.File), password,
+		)
+		if err != nil {
+			return nil, err
+		}
+		var legacySigner *signer.LegacySigner
+		if legacySigner, err = signer.NewLegacySigner(key); err != nil {
+			return nil, err
+		}
+		if legacySigner.PublicKey() != entry.Pubkey {
+			return nil, errors.Wrapf(
+				ErrManifestPubkeyMismatch, "%s holds %s, %s listed",
+				entry.File, legacySigner.PublicKey(), entry.Pubkey,
+			)
+		}
+		keys = append(keys, BatchKey{
+			Key:          key,
+			ValidatorKey: entry.ValidatorKey,
+		})
+	}
+	if validatorKeys > 1 {
+		return nil, errors.Wrap(
+			ErrInvalidManifest, "more than one node validator key",
+		)
+	}
+	return keys, nil
+}
+
+// readKeystore decrypts the keystore at the given path with the given
+// password.
+func readKeystore(path string, password string) (signer.LegacyKey, error) {
+	ks, err := signer.ReadKeystoreFile(path)
+	if err != nil {
+		return signer.LegacyKey{}, err
+	}
+	return ks.Decrypt(password)
+}
+
+// encryptKeystoreFile encrypts the given key, whose public key is pubkey,
+// into a keystore written to the given path.
+func encryptKeystoreFile(
+	key signer.LegacyKey,
+	pubkey crypto.BLSPubkey,
+	password string,
+	kdf string,
+	path string,
+) error {
+	ks, err := signer.EncryptKeystore(key, pubkey, password, kdf)
+	if err != nil {
+		return err
+	}
+	return writeKeystoreFile(ks, path)
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/validator"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/stretchr/testify/require"
+)
+
+const strongPassword = "correct-Horse-42"
+
+func TestCheckPasswordStrength(t *testing.T) {
+	for password, ok := range map[string]bool{
+		strongPassword:        true,
+		"Short-1":             false,
+		"alllowercaseletters": false,
+		"lowercase-and-dash":  false,
+		"UPPER-lower-1":       true,
+		"123456789012":        false,
+	} {
+		err := validator.CheckPasswordStrength(password)
+		if ok {
+			require.NoError(t, err, password)
+		} else {
+			require.ErrorIs(t, err, validator.ErrWeakPassword, password)
+		}
+	}
+}
+
+func TestExportImportKeys(t *testing.T) {
+	dir := t.TempDir()
+	keys := []validator.BatchKey{
+		{Key: signer.LegacyKey{0x01}, ValidatorKey: true},
+		{Key: signer.LegacyKey{0x02}},
+	}
+
+	// Keys are only exported with a strong password.
+	_, err := validator.ExportKeys(keys, dir, "password", signer.KDFPBKDF2)
+	require.ErrorIs(t, err, validator.ErrWeakPassword)
+
+	manifestFile, err := validator.ExportKeys(
+		keys, dir, strongPassword, signer.KDFPBKDF2,
+	)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, validator.ManifestFile), manifestFile)
+	imported, err := validator.ReadKeys(manifestFile, strongPassword)
+	require.NoError(t, err)
+	require.Equal(t, keys, imported)
+
+	_, err = validator.ReadKeys(manifestFile, "wrong-Password-1")
+	require.ErrorIs(t, err, signer.ErrInvalidKeystorePassword)
+
+	// Keystores not holding the keys listed are rejected.
+	bz, err := os.ReadFile(manifestFile)
+	require.NoError(t, err)
+	var manifest validator.Manifest
+	require.NoError(t, json.Unmarshal(bz, &manifest))
+	manifest.Keystores[0].File, manifest.Keystores[1].File =
+		manifest.Keystores[1].File, manifest.Keystores[0].File
+	bz, err = json.Marshal(manifest)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(manifestFile, bz, 0o600))
+	_, err = validator.ReadKeys(manifestFile, strongPassword)
+	require.ErrorIs(t, err, validator.ErrManifestPubkeyMismatch)
+
+	// Keystores outside of the manifest directory are rejected.
+	manifest.Keystores[0].File = "../keystore.json"
+	bz, err = json.Marshal(manifest)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(manifestFile, bz, 0o600))
+	_, err = validator.ReadKeys(manifestFile, strongPassword)
+	require.ErrorIs(t, err, validator.ErrInvalidManifest)
+}
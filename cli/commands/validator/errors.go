@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrPasswordFileRequired is returned when the password file flag is
+	// not set.
+	ErrPasswordFileRequired = errors.New("password file required")
+
+	// ErrValidatorKeyExists is returned when importing a keystore would
+	// overwrite the node validator key.
+	ErrValidatorKeyExists = errors.New(
+		"validator key already exists, use --force to overwrite it",
+	)
+
+	// ErrValidatorKeyNotFound is returned when the node validator key does
+	// not exist.
+	ErrValidatorKeyNotFound = errors.New("validator key not found")
+
+	// ErrWeakPassword is returned when encrypting a keystore with a password
+	// not strong enough.
+	ErrWeakPassword = errors.New("password too weak")
+
+	// ErrKeystoresPasswordFileRequired is returned when the keystores
+	// password file flag is not set while keystores of the keystores
+	// directory are exported or imported.
+	ErrKeystoresPasswordFileRequired = errors.New(
+		"keystores password file required",
+	)
+
+	// ErrUnsupportedValidatorKey is returned when exporting a node validator
+	// key which is not a BLS key.
+	ErrUnsupportedValidatorKey = errors.New(
+		"node validator key is not a BLS key",
+	)
+
+	// ErrNoKeysToExport is returned when exporting keys while the node has
+	// none.
+	ErrNoKeysToExport = errors.New("no validator keys to export")
+
+	// ErrUnsupportedManifest is returned when importing keys listed by a
+	// manifest of an unsupported version.
+	ErrUnsupportedManifest = errors.New("unsupported manifest")
+
+	// ErrInvalidManifest is returned when importing keys listed by an
+	// invalid manifest.
+	ErrInvalidManifest = errors.New("invalid manifest")
+
+	// ErrManifestPubkeyMismatch is returned when a keystore does not hold
+	// the key its manifest lists.
+	ErrManifestPubkeyMismatch = errors.New(
+		"keystore does not hold the key listed by the manifest",
+	)
+)
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+const (
+	// passwordFile is the flag for the file holding the keystore password.
+	passwordFile = "password-file"
+
+	// outputDir is the flag for the directory keystores are written to.
+	outputDir = "output-dir"
+
+	// outputPath is the flag for the path the exported keystore is written
+	// to.
+	outputPath = "output-path"
+
+	// kdf is the flag for the keystore key derivation function.
+	kdf = "kdf"
+
+	// force is the flag for overwriting the node validator key on import.
+	force = "force"
+
+	// keystoresPasswordFile is the flag for the file holding the password of
+	// the keystores of the keystores directory.
+	keystoresPasswordFile = "keystores-password-file"
+)
+
+const (
+	// defaultKeystoresDir is the keystores directory, relative to the node
+	// home directory.
+	defaultKeystoresDir = "config/keystores"
+)
+
+const (
+	passwordFileMsg = "file holding the keystore password"
+	outputDirMsg    = `directory the keystore is written to. Defaults to the
+	keystores directory of the node home.`
+	outputPathMsg = `path the keystore is written to. Defaults to the
+	keystores directory of the node home.`
+	kdfMsg   = `keystore key derivation function, either "scrypt" or "pbkdf2"`
+	forceMsg = "overwrite the node validator key if it already exists"
+
+	keystoresDirMsg = `keystores directory. Defaults to the keystores
+	directory of the node home.`
+	exportPasswordFileMsg = `file holding the password the exported
+	keystores are encrypted with`
+	importPasswordFileMsg = `file holding the password the imported
+	keystores are encrypted with`
+	exportKeystoresPasswordFileMsg = `file holding the password of the
+	keystores of the keystores directory, exported along with the node
+	validator key if set`
+	importKeystoresPasswordFileMsg = `file holding the password the keys
+	imported into the keystores directory are encrypted with`
+)
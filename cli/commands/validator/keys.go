@@ -0,0 +1,357 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+// NewKeygenCmd creates a new command to generate a validator key.
+func NewKeygenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keygen",
+		Short: "Generates a new validator key into an EIP-2335 keystore",
+		Long: `Generates a new BLS validator key and writes it into an EIP-2335
+		keystore encrypted with the password held by the password file.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			password, err := readPassword(cmd)
+			if err != nil {
+				return err
+			}
+
+			privKey, err := bls12381.GenPrivKey()
+			if err != nil {
+				return err
+			}
+			key := signer.LegacyKey(privKey.Bytes())
+
+			path, err := writeKeystore(cmd, key, password, "")
+			if err != nil {
+				return err
+			}
+			cmd.Printf("Successfully wrote new validator keystore to: %s\n", path)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(passwordFile, "", passwordFileMsg)
+	cmd.Flags().String(outputDir, "", outputDirMsg)
+	cmd.Flags().String(kdf, signer.KDFScrypt, kdfMsg)
+	return cmd
+}
+
+// NewImportCmd creates a new command to import a keystore as the node
+// validator key.
+func NewImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [keystore-file]",
+		Short: "Imports an EIP-2335 keystore as the node validator key",
+		Long: `Decrypts the given EIP-2335 keystore with the password held by the
+		password file and installs it as the node validator key. The signing
+		state of an existing validator key is preserved.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			password, err := readPassword(cmd)
+			if err != nil {
+				return err
+			}
+			overwrite, err := cmd.Flags().GetBool(force)
+			if err != nil {
+				return err
+			}
+
+			config := context.GetConfigFromCmd(cmd)
+			pubkey, err := ImportKeystore(config, args[0], password, overwrite)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf(
+				"Successfully imported validator key %s to: %s\n",
+				pubkey.String(), config.PrivValidatorKeyFile(),
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(passwordFile, "", passwordFileMsg)
+	cmd.Flags().Bool(force, false, forceMsg)
+	return cmd
+}
+
+// ImportKeystore decrypts the EIP-2335 keystore at the given path with the
+// given password and installs it as the node validator key, returning its
+// public key. The signing state of an existing validator key is preserved.
+func ImportKeystore(
+	config *cmtcfg.Config,
+	keystoreFile string,
+	password string,
+	overwrite bool,
+) (crypto.BLSPubkey, error) {
+	key, err := readKeystore(keystoreFile, password)
+	if err != nil {
+		return crypto.BLSPubkey{}, err
+	}
+	return installValidatorKey(config, key, overwrite)
+}
+
+// installValidatorKey installs the given key as the node validator key,
+// returning its public key. The signing state of an existing validator key
+// is preserved.
+func installValidatorKey(
+	config *cmtcfg.Config,
+	key signer.LegacyKey,
+	overwrite bool,
+) (crypto.BLSPubkey, error) {
+	privKey, err := bls12381.NewPrivateKeyFromBytes(key[:])
+	if err != nil {
+		return crypto.BLSPubkey{}, err
+	}
+
+	keyFile := config.PrivValidatorKeyFile()
+	stateFile := config.PrivValidatorStateFile()
+	fs := afero.NewOsFs()
+	exists, err := afero.Exists(fs, keyFile)
+	if err != nil {
+		return crypto.BLSPubkey{}, err
+	}
+	if exists && !overwrite {
+		return crypto.BLSPubkey{}, ErrValidatorKeyExists
+	}
+	if err = fs.MkdirAll(filepath.Dir(keyFile), os.ModePerm); err != nil {
+		return crypto.BLSPubkey{}, err
+	}
+
+	pv := privval.NewFilePV(privKey, keyFile, stateFile)
+	stateExists, err := afero.Exists(fs, stateFile)
+	if err != nil {
+		return crypto.BLSPubkey{}, err
+	}
+	if stateExists {
+		// Keep the existing signing state to prevent double signing.
+		pv.Key.Save()
+	} else {
+		if err = fs.MkdirAll(filepath.Dir(stateFile), os.ModePerm); err != nil {
+			return crypto.BLSPubkey{}, err
+		}
+		pv.Save()
+	}
+	legacySigner, err := signer.NewLegacySigner(key)
+	if err != nil {
+		return crypto.BLSPubkey{}, err
+	}
+	return legacySigner.PublicKey(), nil
+}
+
+// NewExportCmd creates a new command to export the node validator key into
+// a keystore.
+func NewExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports the node validator key into an EIP-2335 keystore",
+		Long: `Exports the node validator key into an EIP-2335 keystore encrypted
+		with the password held by the password file.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			password, err := readPassword(cmd)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(outputPath)
+			if err != nil {
+				return err
+			}
+
+			pv, err := loadValidatorKey(cmd)
+			if err != nil {
+				return err
+			}
+			key := signer.LegacyKey(pv.Key.PrivKey.Bytes())
+
+			path, err := writeKeystore(cmd, key, password, output)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("Successfully exported validator key to: %s\n", path)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(passwordFile, "", passwordFileMsg)
+	cmd.Flags().String(outputPath, "", outputPathMsg)
+	cmd.Flags().String(kdf, signer.KDFScrypt, kdfMsg)
+	return cmd
+}
+
+// NewListCmd creates a new command to list the validator keys.
+func NewListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists the node validator key and the available keystores",
+		Long: `Lists the public key of the validator key loaded by the node,
+		followed by the public keys of the keystores in the keystores
+		directory.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			pv, err := loadValidatorKey(cmd)
+			switch {
+			case errors.Is(err, ErrValidatorKeyNotFound):
+				cmd.Println("loaded: none")
+			case err != nil:
+				return err
+			default:
+				cmd.Printf(
+					"loaded: %s\n",
+					crypto.BLSPubkey(pv.Key.PubKey.Bytes()).String(),
+				)
+			}
+
+			dir, err := keystoresDir(cmd)
+			if err != nil {
+				return err
+			}
+			paths, err := afero.Glob(
+				afero.NewOsFs(), filepath.Join(dir, "*.json"),
+			)
+			if err != nil {
+				return err
+			}
+			for _, path := range paths {
+				var ks *signer.Keystore
+				if ks, err = signer.ReadKeystoreFile(path); err != nil {
+					return err
+				}
+				cmd.Printf("keystore: 0x%s %s\n", ks.Pubkey, path)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(outputDir, "", outputDirMsg)
+	return cmd
+}
+
+// readPassword reads the keystore password from the password file.
+func readPassword(cmd *cobra.Command) (string, error) {
+	path, err := cmd.Flags().GetString(passwordFile)
+	if err != nil {
+		return "", err
+	}
+	if path == "" {
+		return "", ErrPasswordFileRequired
+	}
+	return signer.ReadPasswordFile(path)
+}
+
+// keystoresDir returns the directory keystores are written to.
+func keystoresDir(cmd *cobra.Command) (string, error) {
+	dir, err := cmd.Flags().GetString(outputDir)
+	if err != nil {
+		return "", err
+	}
+	if dir != "" {
+		return dir, nil
+	}
+	return filepath.Join(
+		context.GetConfigFromCmd(cmd).RootDir, defaultKeystoresDir,
+	), nil
+}
+
+// loadValidatorKey loads the node validator key.
+func loadValidatorKey(cmd *cobra.Command) (*privval.FilePV, error) {
+	config := context.GetConfigFromCmd(cmd)
+	keyFile := config.PrivValidatorKeyFile()
+	exists, err := afero.Exists(afero.NewOsFs(), keyFile)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.Wrap(ErrValidatorKeyNotFound, keyFile)
+	}
+	return privval.LoadFilePVEmptyState(
+		keyFile, config.PrivValidatorStateFile(),
+	), nil
+}
+
+// writeKeystore encrypts the key into a keystore written to the given path,
+// or to the keystores directory if the path is empty. The password must be
+// strong enough, as checked by CheckPasswordStrength.
+func writeKeystore(
+	cmd *cobra.Command,
+	key signer.LegacyKey,
+	password string,
+	path string,
+) (string, error) {
+	if err := CheckPasswordStrength(password); err != nil {
+		return "", err
+	}
+	function, err := cmd.Flags().GetString(kdf)
+	if err != nil {
+		return "", err
+	}
+	legacySigner, err := signer.NewLegacySigner(key)
+	if err != nil {
+		return "", err
+	}
+	ks, err := signer.EncryptKeystore(
+		key, legacySigner.PublicKey(), password, function,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if path == "" {
+		var dir string
+		if dir, err = keystoresDir(cmd); err != nil {
+			return "", err
+		}
+		path = filepath.Join(dir, fmt.Sprintf("keystore-%s.json", ks.UUID))
+	}
+	return path, writeKeystoreFile(ks, path)
+}
+
+// writeKeystoreFile writes the given keystore to the given path.
+func writeKeystoreFile(ks *signer.Keystore, path string) error {
+	fs := afero.NewOsFs()
+	if err := fs.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	bz, err := json.MarshalIndent(ks, "", "  ")
+	if err != nil {
+		return err
+	}
+	//nolint:mnd // file permissions.
+	return afero.WriteFile(fs, path, bz, 0o600)
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/berachain/beacon-kit/errors"
+)
+
+const (
+	// minPasswordLength is the minimum number of characters of the
+	// passwords keystores are encrypted with.
+	minPasswordLength = 12
+	// minPasswordClasses is the minimum number of character classes, among
+	// lowercase letters, uppercase letters, digits and others, the
+	// passwords keystores are encrypted with are made of.
+	minPasswordClasses = 3
+)
+
+// CheckPasswordStrength returns ErrWeakPassword unless the given password is
+// strong enough to encrypt a keystore with, i.e. at least 12 characters long
+// and mixing at least 3 of lowercase letters, uppercase letters, digits and
+// other characters.
+func CheckPasswordStrength(password string) error {
+	if n := utf8.RuneCountInString(password); n < minPasswordLength {
+		return errors.Wrapf(
+			ErrWeakPassword, "%d characters, at least %d required",
+			n, minPasswordLength,
+		)
+	}
+	var lower, upper, digit, other bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			other = true
+		}
+	}
+	classes := 0
+	for _, ok := range []bool{lower, upper, digit, other} {
+		if ok {
+			classes++
+		}
+	}
+	if classes < minPasswordClasses {
+		return errors.Wrapf(
+			ErrWeakPassword,
+			"%d character classes, at least %d of lowercase, uppercase, "+
+				"digits and others required",
+			classes, minPasswordClasses,
+		)
+	}
+	return nil
+}
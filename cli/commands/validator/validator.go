@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"github.com/berachain/beacon-kit/cli/utils/parser"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// Commands creates a new command for validator key management.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "validator",
+		Short:                      "validator key management subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewKeygenCmd(),
+		NewImportCmd(),
+		NewExportCmd(),
+		NewListCmd(),
+		NewExportKeysCmd(),
+		NewImportKeysCmd(),
+		NewWithdrawalCredentialsCmd(),
+	)
+
+	return cmd
+}
+
+// NewWithdrawalCredentialsCmd creates a new command to show the withdrawal
+// credentials derived from a withdrawal address.
+func NewWithdrawalCredentialsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdrawal-credentials [withdrawal-address]",
+		Short: "Shows the withdrawal credentials of a withdrawal address",
+		Long: `Shows the ETH1 withdrawal credentials derived from the given
+		withdrawal address, as expected by the deposit contract.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := parser.ConvertWithdrawalAddress(args[0])
+			if err != nil {
+				return err
+			}
+			credentials := types.NewCredentialsFromExecutionAddress(address)
+			cmd.Println(credentials.String())
+			return nil
+		},
+	}
+}
@@ -22,24 +22,17 @@ package components
 
 import (
 	"os"
-	"path/filepath"
 
 	"github.com/berachain/beacon-kit/cli/config"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdkconfig "github.com/cosmos/cosmos-sdk/client/config"
 )
 
+// DefaultNodeHome is the node home used when none is configured through the
+// home flag or its environment variable.
+//
 //nolint:gochecknoglobals // todo:fix from sdk.
-var DefaultNodeHome string
-
-//nolint:gochecknoinits // annoying from sdk.
-func init() {
-	userHomeDir, err := os.UserHomeDir()
-	if err != nil {
-		panic(err)
-	}
-	DefaultNodeHome = filepath.Join(userHomeDir, ".beacond")
-}
+var DefaultNodeHome = config.DefaultNodeHome("beacond")
 
 // ProvideClientContext returns a new client context with the given options.
 func ProvideClientContext() (client.Context, error) {
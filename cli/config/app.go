@@ -26,10 +26,14 @@ import (
 	"os"
 	"path/filepath"
 
+	beaconconfig "github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/config/config"
 	"github.com/spf13/viper"
 )
 
+// backupFilePerm is the permission of the app.toml backups.
+const backupFilePerm = 0o600
+
 // handleAppConfig writes the provided <customConfig> to the file at
 // <configDirPath>/app.toml, or reads it into the provided <viper> instance
 // if it exists.
@@ -51,6 +55,13 @@ func handleAppConfig(
 		)
 	}
 
+	// upgrade the app.toml file written by a previous release
+	if err := MigrateAppConfig(
+		appCfgFilePath, customAppTemplate, appConfig,
+	); err != nil {
+		return err
+	}
+
 	// merge the app.toml file into the viper instance
 	viper.SetConfigType("toml")
 	viper.SetConfigName("app")
@@ -62,6 +73,70 @@ func handleAppConfig(
 	return nil
 }
 
+// MigrateAppConfig upgrades the app.toml file at <appCfgFilePath> written by
+// a previous release to the latest config version, rewriting it with the
+// provided <appTemplate>. The original file is backed up next to it, as
+// app.toml.v<version>.bak, before being rewritten.
+func MigrateAppConfig(
+	appCfgFilePath string,
+	appTemplate string,
+	appConfig any,
+) error {
+	fileViper := viper.New()
+	fileViper.SetConfigFile(appCfgFilePath)
+	fileViper.SetConfigType("toml")
+	if err := fileViper.ReadInConfig(); err != nil {
+		return fmt.Errorf("failed to read %s: %w", appCfgFilePath, err)
+	}
+	settings := fileViper.AllSettings()
+	version, err := beaconconfig.Version(settings)
+	if err != nil {
+		return err
+	}
+	if version == beaconconfig.LatestVersion() {
+		return nil
+	}
+	if _, err = beaconconfig.Migrate(settings); err != nil {
+		return fmt.Errorf("failed to migrate %s: %w", appCfgFilePath, err)
+	}
+
+	original, err := os.ReadFile(appCfgFilePath)
+	if err != nil {
+		return err
+	}
+	backupPath := fmt.Sprintf("%s.v%d.bak", appCfgFilePath, version)
+	if err = os.WriteFile(backupPath, original, backupFilePerm); err != nil {
+		return fmt.Errorf("failed to back up %s: %w", appCfgFilePath, err)
+	}
+
+	// settings dropped by the migrations, or unknown to this release, are
+	// not carried over, missing settings are written with their defaults.
+	migrated := viper.New()
+	if err = migrated.MergeConfigMap(settings); err != nil {
+		return fmt.Errorf("failed to load migrated settings: %w", err)
+	}
+	return writeAppConfig(migrated, appCfgFilePath, appTemplate, appConfig)
+}
+
+// UpdateAppConfig sets the given settings, keyed by their dotted path, in
+// the app.toml file at <appCfgFilePath>, keeping its other settings. The
+// file is written with the default values if it does not exist.
+func UpdateAppConfig(appCfgFilePath string, settings map[string]any) error {
+	fileViper := viper.New()
+	fileViper.SetConfigFile(appCfgFilePath)
+	fileViper.SetConfigType("toml")
+	if err := fileViper.ReadInConfig(); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read %s: %w", appCfgFilePath, err)
+	}
+	for key, value := range settings {
+		fileViper.Set(key, value)
+	}
+	return writeAppConfig(
+		fileViper, appCfgFilePath,
+		DefaultAppConfigTemplate(), DefaultAppConfig(),
+	)
+}
+
 // writeAppConfig creates a new configuration file with default
 // values at the specified file path <appCfgFilePath>.
 func writeAppConfig(
@@ -84,7 +159,9 @@ func writeAppConfig(
 		if err = config.SetConfigTemplate(appTemplate); err != nil {
 			return fmt.Errorf("failed to set config template: %w", err)
 		}
-		if err = rootViper.Unmarshal(&appConfig); err != nil {
+		if err = rootViper.Unmarshal(
+			&appConfig, beaconconfig.DecodeHook(),
+		); err != nil {
 			return fmt.Errorf("failed to unmarshal app config: %w", err)
 		}
 		writeConfig = appConfig
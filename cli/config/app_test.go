@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/config"
+	beaconconfig "github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/config/template"
+	"github.com/stretchr/testify/require"
+)
+
+// appConfig wraps the beacon-kit config as laid out in app.toml.
+type appConfig struct {
+	BeaconKit *beaconconfig.Config `mapstructure:"beacon-kit"`
+}
+
+func TestMigrateAppConfig(t *testing.T) {
+	cfg := beaconconfig.DefaultConfig()
+	cfg.Role = "full"
+	bz, err := cfg.Render()
+	require.NoError(t, err)
+
+	// an app.toml predating config versions, with an obsolete setting
+	legacy := strings.Replace(
+		string(bz), `config-version = "1"`, `obsolete-setting = "x"`, 1,
+	)
+	path := filepath.Join(t.TempDir(), "app.toml")
+	require.NoError(t, os.WriteFile(path, []byte(legacy), 0o600))
+
+	require.NoError(t, config.MigrateAppConfig(
+		path, template.TomlTemplate,
+		&appConfig{BeaconKit: beaconconfig.DefaultConfig()},
+	))
+
+	backup, err := os.ReadFile(path + ".v0.bak")
+	require.NoError(t, err)
+	require.Equal(t, legacy, string(backup))
+
+	migrated, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(bz), string(migrated))
+
+	// migrating an up to date file is a no-op
+	require.NoError(t, os.Remove(path+".v0.bak"))
+	require.NoError(t, config.MigrateAppConfig(
+		path, template.TomlTemplate,
+		&appConfig{BeaconKit: beaconconfig.DefaultConfig()},
+	))
+	require.NoFileExists(t, path+".v0.bak")
+}
+
+func TestMigrateAppConfigRejectsNewerVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.toml")
+	require.NoError(t, os.WriteFile(
+		path, []byte("[beacon-kit]\nconfig-version = \"42\"\n"), 0o600,
+	))
+
+	err := config.MigrateAppConfig(
+		path, template.TomlTemplate,
+		&appConfig{BeaconKit: beaconconfig.DefaultConfig()},
+	)
+	require.ErrorIs(t, err, beaconconfig.ErrUnsupportedVersion)
+}
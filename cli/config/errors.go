@@ -22,6 +22,15 @@ package config
 
 import "errors"
 
-// ErrFlagBind is returned when there is an error binding a flag to the viper
-// instance.
-var ErrFlagBind = errors.New("failed to bind flag")
+var (
+	// ErrFlagBind is returned when there is an error binding a flag to the
+	// viper instance.
+	ErrFlagBind = errors.New("failed to bind flag")
+
+	// ErrNoHomeDir is returned when no node home is configured.
+	ErrNoHomeDir = errors.New("node home not configured")
+
+	// ErrUnsupportedLayout is returned when the node home layout cannot be
+	// migrated to the current one.
+	ErrUnsupportedLayout = errors.New("unsupported node home layout")
+)
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/errors"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/viper"
+)
+
+const (
+	// layoutVersionFile is the file, within the config directory, recording
+	// the version of the node home layout.
+	layoutVersionFile = "layout_version"
+	// layoutFilePerm is the permission of the layout version file.
+	layoutFilePerm = 0o600
+)
+
+// homeMigrations are the node home layout migrations. The migration at
+// index i upgrades the layout from version i to version i+1, hence the
+// current layout version is len(homeMigrations).
+//
+//nolint:gochecknoglobals // static list of migrations.
+var homeMigrations = []func(rootDir string) error{
+	// Version 1 introduces the layout version file, homes created before
+	// it already have the expected layout.
+	func(string) error { return nil },
+}
+
+// DefaultNodeHome returns the default node home of the given application,
+// i.e. the .<appName> directory in the user home directory, or in the
+// working directory if the user home directory is unknown.
+func DefaultNodeHome(appName string) string {
+	userHomeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "." + appName
+	}
+	return filepath.Join(userHomeDir, "."+appName)
+}
+
+// ResolveHome returns the absolute node home configured in the given viper
+// instance, through the home flag or its environment variable, and updates
+// it accordingly.
+func ResolveHome(v *viper.Viper) (string, error) {
+	rootDir := v.GetString(flags.FlagHome)
+	if rootDir == "" {
+		return "", ErrNoHomeDir
+	}
+	homePrefix := "~" + string(os.PathSeparator)
+	if rest, ok := strings.CutPrefix(rootDir, homePrefix); ok {
+		userHomeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", errors.Wrap(err, "failed to expand node home")
+		}
+		rootDir = filepath.Join(userHomeDir, rest)
+	}
+	rootDir, err := filepath.Abs(rootDir)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to resolve node home")
+	}
+	v.Set(flags.FlagHome, rootDir)
+	return rootDir, nil
+}
+
+// PrepareHome creates the directory layout of the node home on first run,
+// and migrates the layout of homes created by previous versions.
+func PrepareHome(rootDir string) error {
+	configDir := filepath.Join(rootDir, cmtcfg.DefaultConfigDir)
+	_, err := os.Stat(configDir)
+	firstRun := os.IsNotExist(err)
+	if err != nil && !firstRun {
+		return err
+	}
+
+	// Creates the root, config and data directories if missing.
+	cmtcfg.EnsureRoot(rootDir)
+	if firstRun {
+		return writeLayoutVersion(rootDir, len(homeMigrations))
+	}
+	return migrateHome(rootDir)
+}
+
+// migrateHome applies the migrations from the recorded layout version up to
+// the current one. Each applied migration is recorded, so that an
+// interrupted migration resumes where it stopped.
+func migrateHome(rootDir string) error {
+	version, err := readLayoutVersion(rootDir)
+	if err != nil {
+		return err
+	}
+	if version > len(homeMigrations) {
+		return errors.Wrapf(
+			ErrUnsupportedLayout,
+			"layout version %d, latest supported %d",
+			version, len(homeMigrations),
+		)
+	}
+	for ; version < len(homeMigrations); version++ {
+		if err = homeMigrations[version](rootDir); err != nil {
+			return errors.Wrapf(
+				err, "failed migrating node home from version %d", version,
+			)
+		}
+		if err = writeLayoutVersion(rootDir, version+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readLayoutVersion returns the recorded layout version of the node home,
+// zero if none is recorded.
+func readLayoutVersion(rootDir string) (int, error) {
+	bz, err := os.ReadFile(layoutVersionPath(rootDir))
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	version, err := strconv.Atoi(strings.TrimSpace(string(bz)))
+	if err != nil {
+		return 0, errors.Wrapf(
+			ErrUnsupportedLayout, "invalid layout version %q", bz,
+		)
+	}
+	return version, nil
+}
+
+// writeLayoutVersion records the layout version of the node home.
+func writeLayoutVersion(rootDir string, version int) error {
+	return os.WriteFile(
+		layoutVersionPath(rootDir),
+		[]byte(strconv.Itoa(version)+"\n"),
+		layoutFilePerm,
+	)
+}
+
+// layoutVersionPath returns the path of the layout version file.
+func layoutVersionPath(rootDir string) string {
+	return filepath.Join(rootDir, cmtcfg.DefaultConfigDir, layoutVersionFile)
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/config"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrepareHomeFirstRun(t *testing.T) {
+	rootDir := filepath.Join(t.TempDir(), "home")
+	require.NoError(t, config.PrepareHome(rootDir))
+
+	for _, dir := range []string{"config", "data"} {
+		info, err := os.Stat(filepath.Join(rootDir, dir))
+		require.NoError(t, err)
+		require.True(t, info.IsDir())
+	}
+	bz, err := os.ReadFile(filepath.Join(rootDir, "config", "layout_version"))
+	require.NoError(t, err)
+	require.Equal(t, "1\n", string(bz))
+
+	// preparing an up to date home is a no-op
+	require.NoError(t, config.PrepareHome(rootDir))
+}
+
+func TestPrepareHomeMigratesLegacyLayout(t *testing.T) {
+	rootDir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(rootDir, "config"), 0o700))
+
+	require.NoError(t, config.PrepareHome(rootDir))
+	bz, err := os.ReadFile(filepath.Join(rootDir, "config", "layout_version"))
+	require.NoError(t, err)
+	require.Equal(t, "1\n", string(bz))
+}
+
+func TestPrepareHomeRejectsNewerLayout(t *testing.T) {
+	rootDir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(rootDir, "config"), 0o700))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(rootDir, "config", "layout_version"),
+		[]byte("42\n"), 0o600,
+	))
+
+	err := config.PrepareHome(rootDir)
+	require.ErrorIs(t, err, config.ErrUnsupportedLayout)
+}
+
+func TestResolveHome(t *testing.T) {
+	v := viper.New()
+	_, err := config.ResolveHome(v)
+	require.ErrorIs(t, err, config.ErrNoHomeDir)
+
+	v.Set(flags.FlagHome, "relative/home")
+	rootDir, err := config.ResolveHome(v)
+	require.NoError(t, err)
+	require.True(t, filepath.IsAbs(rootDir))
+	require.Equal(t, rootDir, v.GetString(flags.FlagHome))
+}
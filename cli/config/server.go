@@ -29,10 +29,12 @@ import (
 	"strings"
 
 	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -57,9 +59,18 @@ func SetupCommand[
 		return err
 	}
 
-	if err := handleConfigs(
-		clicontext.GetViperFromCmd(cmd),
-		appTemplate, appConfig, cmtConfig,
+	// resolve the node home, then lay it out or migrate it as needed
+	v := clicontext.GetViperFromCmd(cmd)
+	rootDir, err := ResolveHome(v)
+	if err != nil {
+		return err
+	}
+	if err = PrepareHome(rootDir); err != nil {
+		return err
+	}
+
+	if err = handleConfigs(
+		v, appTemplate, appConfig, cmtConfig,
 	); err != nil {
 		return err
 	}
@@ -84,6 +95,9 @@ func InitializeCmd[
 		return err
 	}
 	viper := newPrefixedViper(baseName)
+	if err = config.SetDefaults(viper); err != nil {
+		return err
+	}
 
 	// bind cobra flags to the viper instance
 	if err = bindFlags(baseName, cmd, viper); err != nil {
@@ -144,8 +158,7 @@ func bindFlags(
 		}
 
 		if !f.Changed && v.IsSet(f.Name) {
-			val := v.Get(f.Name)
-			err = cmd.Flags().Set(f.Name, fmt.Sprintf("%v", val))
+			err = cmd.Flags().Set(f.Name, flagValue(v.Get(f.Name)))
 			if err != nil {
 				panic(err)
 			}
@@ -155,6 +168,17 @@ func bindFlags(
 	return err
 }
 
+// flagValue formats the given config value as a flag value. Lists are
+// comma-separated, as slice flags would parse "[a b]" as a single element.
+func flagValue(val any) string {
+	switch val.(type) {
+	case []string, []any:
+		return strings.Join(cast.ToStringSlice(val), ",")
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
+
 // handleConfigs writes a new comet config file and app config file, and
 // merges them into the provided viper instance.
 func handleConfigs(
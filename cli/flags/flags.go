@@ -29,6 +29,8 @@ const (
 	// Beacon Kit Root Flag.
 	beaconKitRoot      = "beacon-kit."
 	BeaconKitAcceptTos = beaconKitRoot + "accept-tos"
+	ChainSpecFile      = beaconKitRoot + "chain-spec-file"
+	Role               = beaconKitRoot + "role"
 
 	// Builder Config.
 	builderRoot              = beaconKitRoot + "payload-builder."
@@ -37,8 +39,10 @@ const (
 	LocalBuildPayloadTimeout = builderRoot + "local-build-payload-timeout"
 
 	// Validator Config.
-	validatorRoot = beaconKitRoot + "validator."
-	Graffiti      = validatorRoot + "graffiti"
+	validatorRoot             = beaconKitRoot + "validator."
+	Graffiti                  = validatorRoot + "graffiti"
+	MetadataFile              = validatorRoot + "metadata-file"
+	MetadataFileWatchInterval = validatorRoot + "metadata-file-watch-interval"
 
 	// Engine Config.
 	engineRoot              = beaconKitRoot + "engine."
@@ -49,12 +53,19 @@ const (
 	RPCHealthCheckInteval   = engineRoot + "rpc-health-check-interval"
 	RPCJWTRefreshInterval   = engineRoot + "rpc-jwt-refresh-interval"
 	JWTSecretPath           = engineRoot + "jwt-secret-path"
+	AuditLogPath            = engineRoot + "audit-log-path"
+	AuditLogMaxSizeMiB      = engineRoot + "audit-log-max-size-mib"
+	AuditLogMaxBackups      = engineRoot + "audit-log-max-backups"
 
 	// KZG Config.
 	kzgRoot             = beaconKitRoot + "kzg."
 	KZGTrustedSetupPath = kzgRoot + "trusted-setup-path"
 	KZGImplementation   = kzgRoot + "implementation"
 
+	// BLS Config.
+	blsRoot           = beaconKitRoot + "bls."
+	BLSImplementation = blsRoot + "implementation"
+
 	// Logger Config.
 	loggerRoot = beaconKitRoot + "logger."
 	TimeFormat = loggerRoot + "time-format"
@@ -67,16 +78,160 @@ const (
 	BlockStoreServiceAvailabilityWindow = blockStoreServiceRoot +
 		"availability-window"
 
+	// Availability Store Config.
+	availabilityStoreRoot              = beaconKitRoot + "availability-store."
+	AvailabilityStoreEncryptionKeyPath = availabilityStoreRoot +
+		"encryption-key-path"
+
+	// State Snapshots Config.
+	stateSnapshotsRoot      = beaconKitRoot + "state-snapshots."
+	StateSnapshotsInterval  = stateSnapshotsRoot + "interval"
+	StateSnapshotsRetention = stateSnapshotsRoot + "retention"
+
+	// Pruning Config.
+	pruningRoot      = beaconKitRoot + "pruning."
+	PruningRetention = pruningRoot + "retention"
+
+	// State Sync Config.
+	stateSyncRoot       = beaconKitRoot + "state-sync."
+	StateSyncInterval   = stateSyncRoot + "interval"
+	StateSyncKeepRecent = stateSyncRoot + "keep-recent"
+
+	// Catch-Up Config.
+	catchUpRoot             = beaconKitRoot + "catch-up."
+	CatchUpEnabled          = catchUpRoot + "enabled"
+	CatchUpMinBlockAge      = catchUpRoot + "min-block-age"
+	CatchUpMinBlocksBehind  = catchUpRoot + "min-blocks-behind"
+	CatchUpPayloadBatchSize = catchUpRoot + "payload-batch-size"
+
+	// Freezer Config.
+	freezerRoot      = beaconKitRoot + "freezer."
+	FreezerThreshold = freezerRoot + "threshold"
+
+	// Backfill Config.
+	backfillRoot    = beaconKitRoot + "backfill."
+	BackfillDepth   = backfillRoot + "depth"
+	BackfillArchive = backfillRoot + "archive"
+	BackfillURL     = backfillRoot + "url"
+
 	// Node API Config.
-	nodeAPIRoot    = beaconKitRoot + "node-api."
-	NodeAPIEnabled = nodeAPIRoot + "enabled"
-	NodeAPIAddress = nodeAPIRoot + "address"
-	NodeAPILogging = nodeAPIRoot + "logging"
+	nodeAPIRoot            = beaconKitRoot + "node-api."
+	NodeAPIEnabled         = nodeAPIRoot + "enabled"
+	NodeAPIAddress         = nodeAPIRoot + "address"
+	NodeAPIExtraAddresses  = nodeAPIRoot + "extra-addresses"
+	NodeAPIAuthAddress     = nodeAPIRoot + "authenticated-address"
+	NodeAPIShutdownTimeout = nodeAPIRoot + "shutdown-timeout"
+	NodeAPICORSOrigins     = nodeAPIRoot + "cors-allowed-origins"
+	NodeAPITLSCertFile     = nodeAPIRoot + "tls-cert-file"
+	NodeAPITLSKeyFile      = nodeAPIRoot + "tls-key-file"
+	NodeAPITLSClientCAFile = nodeAPIRoot + "tls-client-ca-file"
+	NodeAPILogging         = nodeAPIRoot + "logging"
+	NodeAPIAuthTokenFile   = nodeAPIRoot + "auth-token-file"
+	NodeAPIRateLimit       = nodeAPIRoot + "rate-limit"
+	NodeAPITokenRateLimit  = nodeAPIRoot + "token-rate-limit"
+
+	// Diagnostics Config.
+	diagnosticsRoot                = beaconKitRoot + "diagnostics."
+	DiagnosticsEnabled             = diagnosticsRoot + "enabled"
+	DiagnosticsAddress             = diagnosticsRoot + "address"
+	DiagnosticsSlowBlockThreshold  = diagnosticsRoot + "slow-block-threshold"
+	DiagnosticsMaxSlowBlockReports = diagnosticsRoot + "max-slow-block-reports"
+
+	// Probes Config.
+	probesRoot           = beaconKitRoot + "probes."
+	ProbesEnabled        = probesRoot + "enabled"
+	ProbesAddress        = probesRoot + "address"
+	ProbesMaxSlotsBehind = probesRoot + "max-slots-behind"
+
+	// Prometheus Config.
+	prometheusRoot    = beaconKitRoot + "prometheus."
+	PrometheusEnabled = prometheusRoot + "enabled"
+	PrometheusAddress = prometheusRoot + "address"
+
+	// Metrics Config.
+	metricsRoot               = beaconKitRoot + "metrics."
+	MetricsAllowedLabels      = metricsRoot + "allowed-labels"
+	MetricsMaxSeriesPerMetric = metricsRoot + "max-series-per-metric"
+
+	// Tracing Config.
+	tracingRoot        = beaconKitRoot + "tracing."
+	TracingEnabled     = tracingRoot + "enabled"
+	TracingEndpoint    = tracingRoot + "endpoint"
+	TracingInsecure    = tracingRoot + "insecure"
+	TracingSampleRatio = tracingRoot + "sample-ratio"
+
+	// Chain Health Config.
+	chainHealthRoot             = beaconKitRoot + "chain-health."
+	ChainHealthWindow           = chainHealthRoot + "window"
+	ChainHealthMaxEmptyPayloads = chainHealthRoot + "max-empty-payloads"
+	ChainHealthMaxFinalityLag   = chainHealthRoot + "max-finality-lag"
+
+	// Validator Performance Config.
+	performanceRoot           = beaconKitRoot + "validator-performance."
+	PerformanceWindow         = performanceRoot + "window"
+	PerformanceTrackedPubkeys = performanceRoot + "tracked-pubkeys"
+
+	// Crash Report Config.
+	crashReportRoot     = beaconKitRoot + "crash-report."
+	CrashReportEnabled  = crashReportRoot + "enabled"
+	CrashReportLogLines = crashReportRoot + "log-lines"
+
+	// Remote Signer Config.
+	remoteSignerRoot           = beaconKitRoot + "remote-signer."
+	RemoteSignerURL            = remoteSignerRoot + "url"
+	RemoteSignerPubkey         = remoteSignerRoot + "pubkey"
+	RemoteSignerClientCertFile = remoteSignerRoot + "client-cert-file"
+	RemoteSignerClientKeyFile  = remoteSignerRoot + "client-key-file"
+	RemoteSignerCACertFile     = remoteSignerRoot + "ca-cert-file"
+	RemoteSignerTimeout        = remoteSignerRoot + "timeout"
+
+	// Doppelganger Config.
+	doppelgangerRoot    = beaconKitRoot + "doppelganger."
+	DoppelgangerEnabled = doppelgangerRoot + "enabled"
+	DoppelgangerEpochs  = doppelgangerRoot + "epochs"
+
+	// Keystores Config.
+	keystoresRoot         = beaconKitRoot + "keystores."
+	KeystoresEnabled      = keystoresRoot + "enabled"
+	KeystoresDir          = keystoresRoot + "dir"
+	KeystoresPasswordFile = keystoresRoot + "password-file"
+	KeystoresPollInterval = keystoresRoot + "poll-interval"
+
+	// Signer Config.
+	signerRoot            = beaconKitRoot + "signer."
+	SignerBackend         = signerRoot + "backend"
+	SignerKeystoreFile    = signerRoot + "keystore-file"
+	SignerPasswordFile    = signerRoot + "password-file"
+	SignerExternalCommand = signerRoot + "external-command"
+	SignerTimeout         = signerRoot + "timeout"
+
+	// Threshold Signer Config.
+	thresholdSignerRoot         = signerRoot + "threshold."
+	ThresholdSignerShareIndex   = thresholdSignerRoot + "share-index"
+	ThresholdSignerThreshold    = thresholdSignerRoot + "threshold"
+	ThresholdSignerPubkey       = thresholdSignerRoot + "pubkey"
+	ThresholdSignerSharePubkeys = thresholdSignerRoot + "share-pubkeys"
+	ThresholdSignerTransportURL = thresholdSignerRoot + "transport-url"
+	ThresholdSignerPollInterval = thresholdSignerRoot + "poll-interval"
+
+	// Slashing Protection Config.
+	slashingProtectionRoot      = beaconKitRoot + "slashing-protection."
+	SlashingProtectionRateLimit = slashingProtectionRoot + "rate-limit"
 )
 
 // AddBeaconKitFlags implements servertypes.ModuleInitFlags interface.
 func AddBeaconKitFlags(startCmd *cobra.Command) {
 	defaultCfg := config.DefaultConfig()
+	startCmd.Flags().String(
+		ChainSpecFile,
+		defaultCfg.ChainSpecFile,
+		"path to a YAML or TOML chain spec file",
+	)
+	startCmd.Flags().String(
+		Role,
+		defaultCfg.Role,
+		"node role, one of validator, full, rpc or replica",
+	)
 	startCmd.Flags().String(
 		JWTSecretPath,
 		defaultCfg.Engine.JWTSecretPath,
@@ -101,6 +256,21 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.RPCJWTRefreshInterval,
 		"rpc jwt refresh interval",
 	)
+	startCmd.Flags().String(
+		AuditLogPath,
+		defaultCfg.Engine.AuditLogPath,
+		"path to the engine api audit log, disabled if unset",
+	)
+	startCmd.Flags().Int(
+		AuditLogMaxSizeMiB,
+		defaultCfg.Engine.AuditLogMaxSizeMiB,
+		"size in MiB past which the engine api audit log is rotated",
+	)
+	startCmd.Flags().Int(
+		AuditLogMaxBackups,
+		defaultCfg.Engine.AuditLogMaxBackups,
+		"number of rotated engine api audit logs kept",
+	)
 	startCmd.Flags().String(
 		SuggestedFeeRecipient,
 		defaultCfg.PayloadBuilder.SuggestedFeeRecipient.Hex(),
@@ -116,6 +286,11 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.KZG.Implementation,
 		"kzg implementation",
 	)
+	startCmd.Flags().String(
+		BLSImplementation,
+		defaultCfg.BLS.Implementation,
+		"bls implementation",
+	)
 	startCmd.Flags().String(
 		TimeFormat,
 		defaultCfg.Logger.TimeFormat,
@@ -141,6 +316,76 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.BlockStoreService.AvailabilityWindow,
 		"block service availability window",
 	)
+	startCmd.Flags().String(
+		AvailabilityStoreEncryptionKeyPath,
+		defaultCfg.AvailabilityStore.EncryptionKeyPath,
+		"path to the key encrypting the blob sidecars at rest",
+	)
+	startCmd.Flags().Uint64(
+		StateSnapshotsInterval,
+		defaultCfg.StateSnapshots.Interval,
+		"epochs between the beacon state snapshots",
+	)
+	startCmd.Flags().Uint64(
+		StateSnapshotsRetention,
+		defaultCfg.StateSnapshots.Retention,
+		"epochs the beacon state snapshots are kept for",
+	)
+	startCmd.Flags().Uint64(
+		PruningRetention,
+		defaultCfg.Pruning.Retention,
+		"most recent beacon states kept, older ones pruned once finalized",
+	)
+	startCmd.Flags().Uint64(
+		StateSyncInterval,
+		defaultCfg.StateSync.Interval,
+		"blocks between the state sync snapshots",
+	)
+	startCmd.Flags().Uint32(
+		StateSyncKeepRecent,
+		defaultCfg.StateSync.KeepRecent,
+		"number of recent state sync snapshots kept",
+	)
+	startCmd.Flags().Bool(
+		CatchUpEnabled,
+		defaultCfg.CatchUp.Enabled,
+		"relax the verification of blocks replayed far behind the head",
+	)
+	startCmd.Flags().Duration(
+		CatchUpMinBlockAge,
+		defaultCfg.CatchUp.MinBlockAge,
+		"age past which blocks are replayed in catch-up mode",
+	)
+	startCmd.Flags().Uint64(
+		CatchUpMinBlocksBehind,
+		defaultCfg.CatchUp.MinBlocksBehind,
+		"blocks the node must lag behind its peers to replay in catch-up mode",
+	)
+	startCmd.Flags().Uint64(
+		CatchUpPayloadBatchSize,
+		defaultCfg.CatchUp.PayloadBatchSize,
+		"blocks whose execution payloads are submitted at once in catch-up mode",
+	)
+	startCmd.Flags().Uint64(
+		FreezerThreshold,
+		defaultCfg.Freezer.Threshold,
+		"slots after which ancient data is moved to the freezer",
+	)
+	startCmd.Flags().Uint64(
+		BackfillDepth,
+		defaultCfg.Backfill.Depth,
+		"slots below the first block held whose history is backfilled",
+	)
+	startCmd.Flags().Bool(
+		BackfillArchive,
+		defaultCfg.Backfill.Archive,
+		"backfill the history down to genesis",
+	)
+	startCmd.Flags().String(
+		BackfillURL,
+		defaultCfg.Backfill.URL,
+		"node api the history is backfilled from",
+	)
 	startCmd.Flags().Bool(
 		NodeAPIEnabled,
 		defaultCfg.NodeAPI.Enabled,
@@ -151,9 +396,299 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.NodeAPI.Address,
 		"node api address",
 	)
+	startCmd.Flags().StringSlice(
+		NodeAPIExtraAddresses,
+		defaultCfg.NodeAPI.ExtraAddresses,
+		"extra addresses the node api is bound to",
+	)
+	startCmd.Flags().String(
+		NodeAPIAuthAddress,
+		defaultCfg.NodeAPI.AuthenticatedAddress,
+		"node api address serving the authenticated routes",
+	)
+	startCmd.Flags().Duration(
+		NodeAPIShutdownTimeout,
+		defaultCfg.NodeAPI.ShutdownTimeout,
+		"node api time waited for requests to complete on shutdown",
+	)
+	startCmd.Flags().StringSlice(
+		NodeAPICORSOrigins,
+		defaultCfg.NodeAPI.CORSAllowedOrigins,
+		"node api origins allowed to make cross-origin requests",
+	)
+	startCmd.Flags().String(
+		NodeAPITLSCertFile,
+		defaultCfg.NodeAPI.TLSCertFile,
+		"node api tls certificate file",
+	)
+	startCmd.Flags().String(
+		NodeAPITLSKeyFile,
+		defaultCfg.NodeAPI.TLSKeyFile,
+		"node api tls key file",
+	)
+	startCmd.Flags().String(
+		NodeAPITLSClientCAFile,
+		defaultCfg.NodeAPI.TLSClientCAFile,
+		"node api ca file of the required client certificates",
+	)
 	startCmd.Flags().Bool(
 		NodeAPILogging,
 		defaultCfg.NodeAPI.Logging,
 		"node api logging",
 	)
+	startCmd.Flags().String(
+		NodeAPIAuthTokenFile,
+		defaultCfg.NodeAPI.AuthTokenFile,
+		"node api auth token file",
+	)
+	startCmd.Flags().Float64(
+		NodeAPIRateLimit,
+		defaultCfg.NodeAPI.RateLimit,
+		"node api requests per second per ip address",
+	)
+	startCmd.Flags().Float64(
+		NodeAPITokenRateLimit,
+		defaultCfg.NodeAPI.TokenRateLimit,
+		"node api requests per second for authenticated clients",
+	)
+	startCmd.Flags().Bool(
+		DiagnosticsEnabled,
+		defaultCfg.Diagnostics.Enabled,
+		"diagnostics server enabled",
+	)
+	startCmd.Flags().String(
+		DiagnosticsAddress,
+		defaultCfg.Diagnostics.Address,
+		"diagnostics server address",
+	)
+	startCmd.Flags().Duration(
+		DiagnosticsSlowBlockThreshold,
+		defaultCfg.Diagnostics.SlowBlockThreshold,
+		"processing time above which a slow block report is written",
+	)
+	startCmd.Flags().Int(
+		DiagnosticsMaxSlowBlockReports,
+		defaultCfg.Diagnostics.MaxSlowBlockReports,
+		"number of slow block reports kept",
+	)
+	startCmd.Flags().Bool(
+		ProbesEnabled,
+		defaultCfg.Probes.Enabled,
+		"probes server enabled",
+	)
+	startCmd.Flags().String(
+		ProbesAddress,
+		defaultCfg.Probes.Address,
+		"probes server address",
+	)
+	startCmd.Flags().Uint64(
+		ProbesMaxSlotsBehind,
+		defaultCfg.Probes.MaxSlotsBehind,
+		"slots the node may lag behind its peers while ready",
+	)
+	startCmd.Flags().Bool(
+		PrometheusEnabled,
+		defaultCfg.Prometheus.Enabled,
+		"prometheus exporter enabled",
+	)
+	startCmd.Flags().String(
+		PrometheusAddress,
+		defaultCfg.Prometheus.Address,
+		"prometheus exporter address",
+	)
+	startCmd.Flags().StringSlice(
+		MetricsAllowedLabels,
+		defaultCfg.Metrics.AllowedLabels,
+		"metric label names allowed on top of the default ones",
+	)
+	startCmd.Flags().Int(
+		MetricsMaxSeriesPerMetric,
+		defaultCfg.Metrics.MaxSeriesPerMetric,
+		"number of series a metric may have",
+	)
+	startCmd.Flags().Bool(
+		TracingEnabled,
+		defaultCfg.Tracing.Enabled,
+		"tracing enabled",
+	)
+	startCmd.Flags().String(
+		TracingEndpoint,
+		defaultCfg.Tracing.Endpoint,
+		"tracing OTLP collector endpoint",
+	)
+	startCmd.Flags().Bool(
+		TracingInsecure,
+		defaultCfg.Tracing.Insecure,
+		"tracing export without TLS",
+	)
+	startCmd.Flags().Float64(
+		TracingSampleRatio,
+		defaultCfg.Tracing.SampleRatio,
+		"tracing sample ratio",
+	)
+	startCmd.Flags().Uint64(
+		ChainHealthWindow,
+		defaultCfg.ChainHealth.Window,
+		"number of recent slots the chain health is summarized over",
+	)
+	startCmd.Flags().Uint64(
+		ChainHealthMaxEmptyPayloads,
+		defaultCfg.ChainHealth.MaxEmptyPayloads,
+		"consecutive empty payloads past which a warning is logged",
+	)
+	startCmd.Flags().Duration(
+		ChainHealthMaxFinalityLag,
+		defaultCfg.ChainHealth.MaxFinalityLag,
+		"time since the latest finalized slot past which a warning is logged",
+	)
+	startCmd.Flags().Uint64(
+		PerformanceWindow,
+		defaultCfg.Performance.Window,
+		"number of recent slots the validator performance is tracked over",
+	)
+	startCmd.Flags().StringSlice(
+		PerformanceTrackedPubkeys,
+		defaultCfg.Performance.TrackedPubkeys,
+		"pubkeys of the validators tracked on top of the one of the node",
+	)
+	startCmd.Flags().Bool(
+		CrashReportEnabled,
+		defaultCfg.CrashReport.Enabled,
+		"write a crash report when the node panics or fails",
+	)
+	startCmd.Flags().Int(
+		CrashReportLogLines,
+		defaultCfg.CrashReport.LogLines,
+		"number of latest log lines included in the crash reports",
+	)
+	startCmd.Flags().String(
+		RemoteSignerURL,
+		defaultCfg.RemoteSigner.URL,
+		"url of the Web3Signer-compatible remote signer",
+	)
+	startCmd.Flags().String(
+		RemoteSignerPubkey,
+		defaultCfg.RemoteSigner.Pubkey,
+		"pubkey of the validator key held by the remote signer",
+	)
+	startCmd.Flags().String(
+		RemoteSignerClientCertFile,
+		defaultCfg.RemoteSigner.ClientCertFile,
+		"remote signer client certificate file",
+	)
+	startCmd.Flags().String(
+		RemoteSignerClientKeyFile,
+		defaultCfg.RemoteSigner.ClientKeyFile,
+		"remote signer client key file",
+	)
+	startCmd.Flags().String(
+		RemoteSignerCACertFile,
+		defaultCfg.RemoteSigner.CACertFile,
+		"remote signer CA certificate file",
+	)
+	startCmd.Flags().Duration(
+		RemoteSignerTimeout,
+		defaultCfg.RemoteSigner.Timeout,
+		"timeout of the remote signer requests",
+	)
+	startCmd.Flags().Bool(
+		DoppelgangerEnabled,
+		defaultCfg.Doppelganger.Enabled,
+		"observe the chain for the validator key before signing with it",
+	)
+	startCmd.Flags().Uint64(
+		DoppelgangerEpochs,
+		defaultCfg.Doppelganger.Epochs,
+		"number of epochs observed before signing",
+	)
+	startCmd.Flags().Bool(
+		KeystoresEnabled,
+		defaultCfg.Keystores.Enabled,
+		"load the keystores of the keystores directory at runtime",
+	)
+	startCmd.Flags().String(
+		KeystoresDir,
+		defaultCfg.Keystores.Dir,
+		"keystores directory",
+	)
+	startCmd.Flags().String(
+		KeystoresPasswordFile,
+		defaultCfg.Keystores.PasswordFile,
+		"file holding the password of the keystores",
+	)
+	startCmd.Flags().Duration(
+		KeystoresPollInterval,
+		defaultCfg.Keystores.PollInterval,
+		"interval the keystores directory is polled for changes at",
+	)
+	startCmd.Flags().String(
+		SignerBackend,
+		defaultCfg.Signer.Backend,
+		"signer backend, one of local, keystore or external",
+	)
+	startCmd.Flags().String(
+		SignerKeystoreFile,
+		defaultCfg.Signer.KeystoreFile,
+		"keystore of the keystore signer backend",
+	)
+	startCmd.Flags().String(
+		SignerPasswordFile,
+		defaultCfg.Signer.PasswordFile,
+		"file holding the password of the keystore signer backend",
+	)
+	startCmd.Flags().StringSlice(
+		SignerExternalCommand,
+		defaultCfg.Signer.ExternalCommand,
+		"command line of the external signer backend",
+	)
+	startCmd.Flags().Duration(
+		SignerTimeout,
+		defaultCfg.Signer.Timeout,
+		"timeout of the requests to the signer backend",
+	)
+	startCmd.Flags().Uint64(
+		ThresholdSignerShareIndex,
+		defaultCfg.Signer.Threshold.ShareIndex,
+		"index of the key share of the threshold signer backend",
+	)
+	startCmd.Flags().Int(
+		ThresholdSignerThreshold,
+		defaultCfg.Signer.Threshold.Threshold,
+		"number of partial signatures aggregated by the threshold signer",
+	)
+	startCmd.Flags().String(
+		ThresholdSignerPubkey,
+		defaultCfg.Signer.Threshold.Pubkey,
+		"public key of the distributed validator",
+	)
+	startCmd.Flags().StringSlice(
+		ThresholdSignerSharePubkeys,
+		defaultCfg.Signer.Threshold.SharePubkeys,
+		"public keys of the key shares of the distributed validator",
+	)
+	startCmd.Flags().String(
+		ThresholdSignerTransportURL,
+		defaultCfg.Signer.Threshold.TransportURL,
+		"url of the middleware exchanging the partial signatures",
+	)
+	startCmd.Flags().Duration(
+		ThresholdSignerPollInterval,
+		defaultCfg.Signer.Threshold.PollInterval,
+		"interval at which the partial signatures are polled",
+	)
+	startCmd.Flags().Float64(
+		SlashingProtectionRateLimit,
+		defaultCfg.SlashingProtection.RateLimit,
+		"number of signing requests per second allowed, 0 for unlimited",
+	)
+	startCmd.Flags().String(
+		MetadataFile,
+		defaultCfg.Validator.MetadataFile,
+		"validators metadata file",
+	)
+	startCmd.Flags().Duration(
+		MetadataFileWatchInterval,
+		defaultCfg.Validator.MetadataFileWatchInterval,
+		"interval the validators metadata file is polled for changes at",
+	)
 }
@@ -27,14 +27,20 @@ import (
 func DefaultComponents() []any {
 	c := []any{
 		components.ProvideAttributesFactory[*Logger],
+		components.ProvideBackfillService[*Logger],
+		components.ProvideBackfillStore[*Logger],
 		components.ProvideAvailibilityStore[*Logger],
 		components.ProvideDepositContract,
 		components.ProvideBlockStore[*Logger],
+		components.ProvideBLSImplementation[*Logger],
 		components.ProvideBlsSigner,
 		components.ProvideBlobProcessor[
 			*AvailabilityStore, *ConsensusSidecars, *Logger,
 		],
 		components.ProvideBlobProofVerifier,
+		components.ProvideChainHealthService[*Logger],
+		components.ProvideCrashReporter[*Logger],
+		components.ProvideDoppelgangerService[*Logger],
 		components.ProvideChainService[
 			*AvailabilityStore,
 			*ConsensusBlock,
@@ -52,12 +58,23 @@ func DefaultComponents() []any {
 		// 	*BeaconStateMarshallable, *BlockStore, *KVStore, *StorageBackend,
 		// ],
 		components.ProvideDepositStore[*Logger],
+		components.ProvideDiagnosticsServer[*Logger],
 		components.ProvideEngineClient[*Logger],
+		components.ProvideEventBus,
 		components.ProvideExecutionEngine[*Logger],
+		components.ProvideFreezer,
+		components.ProvideFreezerService[*Logger],
 		components.ProvideJWTSecret,
+		components.ProvideMetadataStore[*Logger],
+		components.ProvideOperationsPool,
 		components.ProvideLocalBuilder[
 			*KVStore, *Logger,
 		],
+		components.ProvideProbesServer[*Logger],
+		components.ProvidePrometheusExporter[*Logger],
+		components.ProvideSlowBlockReporter[*Logger],
+		components.ProvideTracingProvider[*Logger],
+		components.ProvideReloadService[*Logger],
 		components.ProvideReportingService[*Logger],
 		components.ProvideCometBFTService[*Logger],
 		components.ProvideServiceRegistry[
@@ -69,13 +86,20 @@ func DefaultComponents() []any {
 			*Genesis, *KVStore, *Logger,
 			NodeAPIContext,
 		],
+		components.ProvideProposerConfigWatcher[*Logger],
+		components.ProvideKeystoreManager[*Logger],
+		components.ProvideSlashingProtection,
+		components.ProvidePrunerService[*Logger],
 		components.ProvideSidecarFactory,
+		components.ProvideSnapshotService[*Logger, *StorageBackend],
+		components.ProvideSnapshotStore[*Logger],
 		components.ProvideStateProcessor[
 			*Logger,
 			*DepositStore,
 			*KVStore,
 		],
 		components.ProvideKVStore,
+		components.ProvideSyncStatusService[*Logger],
 		components.ProvideStorageBackend[
 			*AvailabilityStore, *BlockStore,
 			*KVStore, *DepositStore,
@@ -83,6 +107,7 @@ func DefaultComponents() []any {
 		components.ProvideTelemetrySink,
 		components.ProvideTelemetryService,
 		components.ProvideTrustedSetup,
+		components.ProvideValidatorPerformanceService[*Logger],
 		components.ProvideValidatorService[
 			*AvailabilityStore,
 			*BlockStore, *DepositStore,
@@ -96,6 +121,7 @@ func DefaultComponents() []any {
 	c = append(c,
 		components.ProvideNodeAPIServer[*Logger, NodeAPIContext],
 		components.ProvideNodeAPIEngine,
+		components.ProvideNodeAPIStateRegen[*Logger],
 		components.ProvideNodeAPIBackend[
 			*AvailabilityStore,
 			*BlockStore, *DepositStore,
@@ -112,12 +138,20 @@ func DefaultComponents() []any {
 		],
 		components.ProvideNodeAPIBuilderHandler[NodeAPIContext],
 		components.ProvideNodeAPIConfigHandler[NodeAPIContext],
-		components.ProvideNodeAPIDebugHandler[NodeAPIContext],
+		components.ProvideNodeAPIDebugHandler[
+			*CometBFTService, NodeAPIContext,
+		],
 		components.ProvideNodeAPIEventsHandler[NodeAPIContext],
-		components.ProvideNodeAPINodeHandler[NodeAPIContext],
+		components.ProvideNodeAPIKeymanagerHandler[
+			*CometBFTService, NodeAPIContext,
+		],
+		components.ProvideNodeAPINodeHandler[*Logger, NodeAPIContext],
 		components.ProvideNodeAPIProofHandler[
 			*KVStore, *CometBFTService, NodeAPIContext,
 		],
+		components.ProvideNodeAPIStakingHandler[
+			*CometBFTService, NodeAPIContext,
+		],
 	)
 
 	return c
@@ -21,16 +21,35 @@
 package config
 
 import (
+	"github.com/berachain/beacon-kit/beacon/blockchain"
 	"github.com/berachain/beacon-kit/beacon/validator"
 	"github.com/berachain/beacon-kit/config/template"
 	viperlib "github.com/berachain/beacon-kit/config/viper"
 	"github.com/berachain/beacon-kit/da/kzg"
+	dastore "github.com/berachain/beacon-kit/da/store"
 	"github.com/berachain/beacon-kit/errors"
 	engineclient "github.com/berachain/beacon-kit/execution/client"
 	log "github.com/berachain/beacon-kit/log/phuslu"
 	blockstore "github.com/berachain/beacon-kit/node-api/block_store"
 	"github.com/berachain/beacon-kit/node-api/server"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/node-core/services/chainhealth"
+	"github.com/berachain/beacon-kit/node-core/services/crashreport"
+	"github.com/berachain/beacon-kit/node-core/services/doppelganger"
+	"github.com/berachain/beacon-kit/node-core/services/keystores"
+	"github.com/berachain/beacon-kit/node-core/services/performance"
+	"github.com/berachain/beacon-kit/node-core/services/slashingprotection"
+	"github.com/berachain/beacon-kit/observability/diagnostics"
+	"github.com/berachain/beacon-kit/observability/probes"
+	"github.com/berachain/beacon-kit/observability/telemetry"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/payload/builder"
+	"github.com/berachain/beacon-kit/primitives/crypto/bls"
+	"github.com/berachain/beacon-kit/storage/backfill"
+	"github.com/berachain/beacon-kit/storage/freezer"
+	"github.com/berachain/beacon-kit/storage/pruner"
+	"github.com/berachain/beacon-kit/storage/snapshot"
+	"github.com/berachain/beacon-kit/storage/statesync"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
@@ -43,32 +62,122 @@ type AppOptions interface {
 // DefaultConfig returns the default configuration for a BeaconKit chain.
 func DefaultConfig() *Config {
 	return &Config{
-		Engine:            engineclient.DefaultConfig(),
-		Logger:            log.DefaultConfig(),
-		KZG:               kzg.DefaultConfig(),
-		PayloadBuilder:    builder.DefaultConfig(),
-		Validator:         validator.DefaultConfig(),
-		BlockStoreService: blockstore.DefaultConfig(),
-		NodeAPI:           server.DefaultConfig(),
+		ConfigVersion:      LatestVersion(),
+		Engine:             engineclient.DefaultConfig(),
+		Logger:             log.DefaultConfig(),
+		KZG:                kzg.DefaultConfig(),
+		BLS:                bls.DefaultConfig(),
+		PayloadBuilder:     builder.DefaultConfig(),
+		Validator:          validator.DefaultConfig(),
+		BlockStoreService:  blockstore.DefaultConfig(),
+		AvailabilityStore:  dastore.DefaultConfig(),
+		StateSnapshots:     snapshot.DefaultConfig(),
+		Pruning:            pruner.DefaultConfig(),
+		StateSync:          statesync.DefaultConfig(),
+		CatchUp:            blockchain.DefaultCatchUpConfig(),
+		Freezer:            freezer.DefaultConfig(),
+		Backfill:           backfill.DefaultConfig(),
+		NodeAPI:            server.DefaultConfig(),
+		Diagnostics:        diagnostics.DefaultConfig(),
+		Probes:             probes.DefaultConfig(),
+		Prometheus:         telemetry.DefaultExporterConfig(),
+		Metrics:            telemetry.DefaultMetricsConfig(),
+		Tracing:            tracing.DefaultConfig(),
+		ChainHealth:        chainhealth.DefaultConfig(),
+		Performance:        performance.DefaultConfig(),
+		CrashReport:        crashreport.DefaultConfig(),
+		RemoteSigner:       signer.DefaultRemoteConfig(),
+		Doppelganger:       doppelganger.DefaultConfig(),
+		Keystores:          keystores.DefaultConfig(),
+		Signer:             signer.DefaultConfig(),
+		SlashingProtection: slashingprotection.DefaultConfig(),
 	}
 }
 
 // Config is the main configuration struct for the BeaconKit chain.
 type Config struct {
+	// ConfigVersion is the version of the settings layout, used to migrate
+	// the app.toml files written by previous releases.
+	ConfigVersion int `mapstructure:"config-version"`
+	// ChainSpecFile is the path to a YAML or TOML chain spec file. The chain
+	// spec preset selected by the CHAIN_SPEC environment variable is used if
+	// unset.
+	ChainSpecFile string `mapstructure:"chain-spec-file"`
+	// Role selects the services run by the node: "validator", "full", "rpc"
+	// or "replica". The role configured on the node builder is used if unset.
+	Role string `mapstructure:"role"`
 	// Engine is the configuration for the execution client.
 	Engine engineclient.Config `mapstructure:"engine"`
 	// Logger is the configuration for the logger.
 	Logger log.Config `mapstructure:"logger"`
 	// KZG is the configuration for the KZG blob verifier.
 	KZG kzg.Config `mapstructure:"kzg"`
+	// BLS is the configuration for the BLS signature implementation.
+	BLS bls.Config `mapstructure:"bls"`
 	// PayloadBuilder is the configuration for the local build payload timeout.
 	PayloadBuilder builder.Config `mapstructure:"payload-builder"`
 	// Validator is the configuration for the validator client.
 	Validator validator.Config `mapstructure:"validator"`
 	// BlockStoreService is the configuration for the block store service.
 	BlockStoreService blockstore.Config `mapstructure:"block-store-service"`
+	// AvailabilityStore is the configuration for the store of the blob
+	// sidecars.
+	AvailabilityStore dastore.Config `mapstructure:"availability-store"`
+	// StateSnapshots is the configuration for the epoch boundary snapshots
+	// of the beacon state.
+	StateSnapshots snapshot.Config `mapstructure:"state-snapshots"`
+	// Pruning is the configuration for the pruning of the historical beacon
+	// states once finalized.
+	Pruning pruner.Config `mapstructure:"pruning"`
+	// StateSync is the configuration for the state sync snapshots served
+	// to the nodes joining with CometBFT state sync.
+	StateSync statesync.Config `mapstructure:"state-sync"`
+	// CatchUp is the configuration of the relaxed verification of the
+	// blocks replayed far behind the head.
+	CatchUp blockchain.CatchUpConfig `mapstructure:"catch-up"`
+	// Freezer is the configuration for the cold storage of ancient data.
+	Freezer freezer.Config `mapstructure:"freezer"`
+	// Backfill is the configuration for the backfill of the history below
+	// the checkpoint the node was synced from.
+	Backfill backfill.Config `mapstructure:"backfill"`
 	// NodeAPI is the configuration for the node API.
 	NodeAPI server.Config `mapstructure:"node-api"`
+	// Diagnostics is the configuration for the diagnostics server.
+	Diagnostics diagnostics.Config `mapstructure:"diagnostics"`
+	// Probes is the configuration for the liveness and readiness probes
+	// server.
+	Probes probes.Config `mapstructure:"probes"`
+	// Prometheus is the configuration for the exporter serving the metrics
+	// of the node to Prometheus.
+	Prometheus telemetry.ExporterConfig `mapstructure:"prometheus"`
+	// Metrics is the configuration for the guards of the metrics reported
+	// by the services.
+	Metrics telemetry.MetricsConfig `mapstructure:"metrics"`
+	// Tracing is the configuration for the export of the traces of the
+	// block pipeline.
+	Tracing tracing.Config `mapstructure:"tracing"`
+	// ChainHealth is the configuration for the monitoring of the health of
+	// the chain.
+	ChainHealth chainhealth.Config `mapstructure:"chain-health"`
+	// Performance is the configuration for the tracking of the
+	// performance of the local validators.
+	Performance performance.Config `mapstructure:"validator-performance"`
+	// CrashReport is the configuration for the reports written when the
+	// node crashes.
+	CrashReport crashreport.Config `mapstructure:"crash-report"`
+	// RemoteSigner is the configuration for the remote signer the
+	// validator signs with.
+	RemoteSigner signer.RemoteConfig `mapstructure:"remote-signer"`
+	// Doppelganger is the configuration for the protection of the
+	// validator key from being used by two nodes at once.
+	Doppelganger doppelganger.Config `mapstructure:"doppelganger"`
+	// Keystores is the configuration for the keystores loaded at runtime.
+	Keystores keystores.Config `mapstructure:"keystores"`
+	// Signer is the configuration for the backend the validator signs with.
+	Signer signer.Config `mapstructure:"signer"`
+	// SlashingProtection is the configuration for the local slashing
+	// protection of the validator key.
+	SlashingProtection slashingprotection.Config `mapstructure:"slashing-protection"`
 }
 
 // GetEngine returns the execution client configuration.
@@ -108,16 +217,21 @@ func ReadConfigFromAppOpts(opts AppOptions) (*Config, error) {
 		BeaconKit Config `mapstructure:"beacon-kit"`
 	}
 	cfg := cfgUnmarshaller{}
-	if err := v.Unmarshal(&cfg,
-		viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
-			mapstructure.StringToTimeDurationHookFunc(),
-			mapstructure.StringToSliceHookFunc(","),
-			viperlib.StringToExecutionAddressFunc(),
-			viperlib.StringToDialURLFunc(),
-			viperlib.StringToConnectionURLFunc(),
-		))); err != nil {
+	if err := v.Unmarshal(&cfg, DecodeHook()); err != nil {
 		return nil, err
 	}
 
 	return &cfg.BeaconKit, nil
 }
+
+// DecodeHook returns the viper option decoding the configuration values
+// into their types.
+func DecodeHook() viper.DecoderConfigOption {
+	return viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.StringToSliceHookFunc(","),
+		viperlib.StringToExecutionAddressFunc(),
+		viperlib.StringToDialURLFunc(),
+		viperlib.StringToConnectionURLFunc(),
+	))
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"bytes"
+	"text/template"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/spf13/viper"
+)
+
+// The configuration is layered. From the highest to the lowest precedence,
+// each setting is read from:
+//  1. its command line flag, e.g. --beacon-kit.engine.rpc-dial-url,
+//  2. its environment variable, named after the setting key prefixed by
+//     the binary name, upper cased, with dots and dashes replaced by
+//     underscores, e.g. BEACOND_BEACON_KIT_ENGINE_RPC_DIAL_URL,
+//  3. the app.toml file of the node home,
+//  4. the defaults of DefaultConfig.
+
+// Render renders the configuration in the app.toml format.
+func (c Config) Render() ([]byte, error) {
+	tmpl, err := template.New("beacon-kit").Parse(c.Template())
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, struct{ BeaconKit Config }{c}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// SetDefaults registers the defaults of every setting in v, as the lowest
+// precedence layer. Settings known to v can be set through environment
+// variables even when missing from the app.toml file.
+func SetDefaults(v *viper.Viper) error {
+	bz, err := DefaultConfig().Render()
+	if err != nil {
+		return errors.Wrap(err, "failed to render default config")
+	}
+	defaults := viper.New()
+	defaults.SetConfigType("toml")
+	if err = defaults.ReadConfig(bytes.NewReader(bz)); err != nil {
+		return errors.Wrap(err, "failed to read default config")
+	}
+	for _, key := range defaults.AllKeys() {
+		v.SetDefault(key, defaults.Get(key))
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/config"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLayers(t *testing.T) {
+	v := viper.New()
+	v.SetEnvPrefix("beacond")
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_"))
+	v.AutomaticEnv()
+	require.NoError(t, config.SetDefaults(v))
+
+	// defaults
+	cfg, err := config.ReadConfigFromAppOpts(v)
+	require.NoError(t, err)
+	require.Equal(t, config.DefaultConfig(), cfg)
+
+	// file over defaults
+	v.SetConfigType("toml")
+	require.NoError(t, v.MergeConfig(strings.NewReader(`
+[beacon-kit.engine]
+rpc-timeout = "5s"
+rpc-retries = 7
+`)))
+	cfg, err = config.ReadConfigFromAppOpts(v)
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Second, cfg.Engine.RPCTimeout)
+	require.Equal(t, uint64(7), cfg.Engine.RPCRetries)
+
+	// env over file
+	t.Setenv("BEACOND_BEACON_KIT_ENGINE_RPC_TIMEOUT", "9s")
+	t.Setenv("BEACOND_BEACON_KIT_LOGGER_LOG_LEVEL", "debug")
+	cfg, err = config.ReadConfigFromAppOpts(v)
+	require.NoError(t, err)
+	require.Equal(t, 9*time.Second, cfg.Engine.RPCTimeout)
+	require.Equal(t, uint64(7), cfg.Engine.RPCRetries)
+	require.Equal(t, "debug", cfg.Logger.LogLevel)
+}
+
+func TestRenderRoundTrip(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Role = "full"
+	cfg.Engine.RPCTimeout = 3 * time.Second
+
+	bz, err := cfg.Render()
+	require.NoError(t, err)
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(string(bz))))
+	parsed, err := config.ReadConfigFromAppOpts(v)
+	require.NoError(t, err)
+	require.Equal(t, cfg, parsed)
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"strings"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/spf13/cast"
+)
+
+// versionKey is the key of the config version within the beacon-kit table.
+const versionKey = "config-version"
+
+// ErrUnsupportedVersion is returned when the config version is newer than
+// the latest one known to this binary.
+var ErrUnsupportedVersion = errors.New("unsupported config version")
+
+// Migration rewrites the settings of an app.toml file, as a nested map of
+// its TOML tables, from one config version to the next.
+type Migration func(settings map[string]any) error
+
+// migrations are the config migrations. The migration at index i upgrades
+// the settings from version i to version i+1, hence the latest config
+// version is len(migrations). Migrations renaming a setting should rely on
+// MoveSetting.
+//
+//nolint:gochecknoglobals // static list of migrations.
+var migrations = []Migration{
+	// Version 1 introduces the config version, files written before it
+	// already hold the expected settings.
+	func(map[string]any) error { return nil },
+}
+
+// LatestVersion returns the config version written by this binary.
+func LatestVersion() int {
+	return len(migrations)
+}
+
+// Version returns the config version of the given app.toml settings, zero
+// if the settings predate config versions.
+func Version(settings map[string]any) (int, error) {
+	table, ok := settings["beacon-kit"].(map[string]any)
+	if !ok {
+		return 0, nil
+	}
+	raw, ok := table[versionKey]
+	if !ok || raw == "" {
+		return 0, nil
+	}
+	version, err := cast.ToIntE(raw)
+	if err != nil {
+		return 0, errors.Wrapf(
+			ErrUnsupportedVersion, "invalid config version %v", raw,
+		)
+	}
+	return version, nil
+}
+
+// Migrate upgrades the given app.toml settings in place, from their config
+// version up to the latest one, and returns the version they were upgraded
+// from.
+func Migrate(settings map[string]any) (int, error) {
+	from, err := Version(settings)
+	if err != nil {
+		return 0, err
+	}
+	if from > LatestVersion() {
+		return 0, errors.Wrapf(
+			ErrUnsupportedVersion,
+			"config version %d, latest supported %d",
+			from, LatestVersion(),
+		)
+	}
+	for version := from; version < LatestVersion(); version++ {
+		if err = migrations[version](settings); err != nil {
+			return 0, errors.Wrapf(
+				err, "failed migrating config from version %d", version,
+			)
+		}
+	}
+	table, ok := settings["beacon-kit"].(map[string]any)
+	if !ok {
+		table = make(map[string]any)
+		settings["beacon-kit"] = table
+	}
+	table[versionKey] = LatestVersion()
+	return from, nil
+}
+
+// MoveSetting moves the setting at the dotted key from to the dotted key to,
+// e.g. "beacon-kit.engine.jwt-secret-path", creating the missing tables. It
+// is a no-op if the from setting is unset, and does not overwrite a to
+// setting already set.
+func MoveSetting(settings map[string]any, from, to string) {
+	fromPath := strings.Split(from, ".")
+	parent := lookupTable(settings, fromPath[:len(fromPath)-1], false)
+	if parent == nil {
+		return
+	}
+	fromKey := fromPath[len(fromPath)-1]
+	value, ok := parent[fromKey]
+	if !ok {
+		return
+	}
+	delete(parent, fromKey)
+
+	toPath := strings.Split(to, ".")
+	target := lookupTable(settings, toPath[:len(toPath)-1], true)
+	toKey := toPath[len(toPath)-1]
+	if _, ok = target[toKey]; !ok {
+		target[toKey] = value
+	}
+}
+
+// lookupTable returns the nested table at the given path, creating the
+// missing tables if create is set and returning nil otherwise.
+func lookupTable(
+	settings map[string]any, path []string, create bool,
+) map[string]any {
+	table := settings
+	for _, key := range path {
+		next, ok := table[key].(map[string]any)
+		if !ok {
+			if !create {
+				return nil
+			}
+			next = make(map[string]any)
+			table[key] = next
+		}
+		table = next
+	}
+	return table
+}
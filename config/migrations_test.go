@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateLegacySettings(t *testing.T) {
+	settings := map[string]any{
+		"beacon-kit": map[string]any{"role": "full"},
+	}
+	from, err := config.Migrate(settings)
+	require.NoError(t, err)
+	require.Equal(t, 0, from)
+
+	version, err := config.Version(settings)
+	require.NoError(t, err)
+	require.Equal(t, config.LatestVersion(), version)
+	require.Equal(t, config.LatestVersion(), config.DefaultConfig().ConfigVersion)
+}
+
+func TestMigrateRejectsNewerVersion(t *testing.T) {
+	settings := map[string]any{
+		"beacon-kit": map[string]any{"config-version": "42"},
+	}
+	_, err := config.Migrate(settings)
+	require.ErrorIs(t, err, config.ErrUnsupportedVersion)
+
+	settings = map[string]any{
+		"beacon-kit": map[string]any{"config-version": "latest"},
+	}
+	_, err = config.Version(settings)
+	require.ErrorIs(t, err, config.ErrUnsupportedVersion)
+}
+
+func TestMoveSetting(t *testing.T) {
+	settings := map[string]any{
+		"beacon-kit": map[string]any{
+			"engine": map[string]any{"jwt-secret-path": "jwt.hex"},
+			"role":   "full",
+		},
+	}
+	config.MoveSetting(
+		settings, "beacon-kit.engine.jwt-secret-path", "beacon-kit.auth.jwt",
+	)
+	config.MoveSetting(settings, "beacon-kit.unset", "beacon-kit.other")
+	require.Equal(t, map[string]any{
+		"beacon-kit": map[string]any{
+			"engine": map[string]any{},
+			"auth":   map[string]any{"jwt": "jwt.hex"},
+			"role":   "full",
+		},
+	}, settings)
+
+	// a setting already set at the destination is kept
+	settings["beacon-kit"].(map[string]any)["mode"] = "validator"
+	config.MoveSetting(settings, "beacon-kit.role", "beacon-kit.mode")
+	require.Equal(t, "validator", settings["beacon-kit"].(map[string]any)["mode"])
+	require.NotContains(t, settings["beacon-kit"], "role")
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package networks
+
+import (
+	"bufio"
+	"bytes"
+	"embed"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/berachain/beacon-kit/errors"
+)
+
+const (
+	// Testnet is the name of the bArtio testnet.
+	Testnet = "testnet"
+	// Devnet is the name of a local devnet, whose genesis is created
+	// locally.
+	Devnet = "devnet"
+
+	chainSpecFile       = "chain-spec.toml"
+	genesisFile         = "genesis.json"
+	seedsFile           = "seeds.txt"
+	persistentPeersFile = "persistent-peers.txt"
+)
+
+// ErrUnknownNetwork is returned when the network is not built in.
+var ErrUnknownNetwork = errors.New("unknown network")
+
+//go:embed testnet devnet
+var files embed.FS
+
+// Network holds the files needed to join a built-in network.
+type Network struct {
+	// Name is the name of the network.
+	Name string
+	// ChainSpec is the TOML chain spec file of the network.
+	ChainSpec []byte
+	// Genesis is the genesis file of the network, nil if the genesis is
+	// created locally.
+	Genesis []byte
+	// Seeds are the CometBFT seed nodes of the network.
+	Seeds []string
+	// PersistentPeers are the CometBFT peers to stay connected to.
+	PersistentPeers []string
+}
+
+// Names returns the names of the built-in networks.
+func Names() []string {
+	return []string{Testnet, Devnet}
+}
+
+// Get returns the built-in network with the given name.
+func Get(name string) (*Network, error) {
+	if !slices.Contains(Names(), name) {
+		return nil, errors.Wrap(ErrUnknownNetwork, name)
+	}
+	chainSpec, err := files.ReadFile(path.Join(name, chainSpecFile))
+	if err != nil {
+		return nil, err
+	}
+
+	n := &Network{Name: name, ChainSpec: chainSpec}
+	if n.Genesis, err = readOptional(name, genesisFile); err != nil {
+		return nil, err
+	}
+	if n.Seeds, err = readPeers(name, seedsFile); err != nil {
+		return nil, err
+	}
+	if n.PersistentPeers, err = readPeers(name, persistentPeersFile); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// readOptional returns the given file of the network, nil if missing.
+func readOptional(name, file string) ([]byte, error) {
+	bz, err := files.ReadFile(path.Join(name, file))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	return bz, err
+}
+
+// readPeers returns the peers listed, one per line, by the given file of
+// the network.
+func readPeers(name, file string) ([]string, error) {
+	bz, err := readOptional(name, file)
+	if err != nil {
+		return nil, err
+	}
+	var peers []string
+	scanner := bufio.NewScanner(bytes.NewReader(bz))
+	for scanner.Scan() {
+		if peer := strings.TrimSpace(scanner.Text()); peer != "" {
+			peers = append(peers, peer)
+		}
+	}
+	return peers, scanner.Err()
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package networks_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/networks"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	for _, name := range networks.Names() {
+		network, err := networks.Get(name)
+		require.NoError(t, err)
+		require.Equal(t, name, network.Name)
+
+		// the embedded chain spec must load
+		path := filepath.Join(t.TempDir(), "chain-spec.toml")
+		require.NoError(t, os.WriteFile(path, network.ChainSpec, 0o600))
+		_, err = spec.LoadChainSpecFile(path)
+		require.NoError(t, err)
+	}
+
+	testnet, err := networks.Get(networks.Testnet)
+	require.NoError(t, err)
+	require.NotEmpty(t, testnet.Genesis)
+	require.NotEmpty(t, testnet.Seeds)
+	for _, seed := range testnet.Seeds {
+		require.Contains(t, seed, "@")
+	}
+
+	devnet, err := networks.Get(networks.Devnet)
+	require.NoError(t, err)
+	require.Nil(t, devnet.Genesis)
+	require.Empty(t, devnet.Seeds)
+}
+
+func TestGetUnknownNetwork(t *testing.T) {
+	for _, name := range []string{"", "unknown", "../spec", "testnet/seeds.txt"} {
+		_, err := networks.Get(name)
+		require.ErrorIs(t, err, networks.ErrUnknownNetwork)
+	}
+}
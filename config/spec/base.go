@@ -93,6 +93,9 @@ func BaseSpec() chain.SpecData[
 		DomainTypeApplicationMask: common.DomainType{
 			0x00, 0x00, 0x00, 0x01,
 		},
+		DomainTypeBLSToExecutionChange: common.DomainType{
+			0x0a, 0x00, 0x00, 0x00,
+		},
 
 		// Eth1-related values.
 		DepositContractAddress: common.NewExecutionAddressFromHex(
@@ -105,6 +108,13 @@ func BaseSpec() chain.SpecData[
 		// Fork-related values.
 		DenebPlusForkEpoch: 9999999999999998,
 		ElectraForkEpoch:   9999999999999999,
+		// The min deposit amount is not enforced on the existing networks,
+		// which must replay their history as is.
+		DepositBoundsForkEpoch: 9999999999999999,
+		// Blocks of the existing networks carry a zero deposit count.
+		DepositCountForkEpoch: 9999999999999999,
+		// The existing networks ignored consensus evidence.
+		SlashingForkEpoch: 9999999999999999,
 
 		// State list length constants.
 		EpochsPerHistoricalVector: 8,
@@ -117,6 +127,7 @@ func BaseSpec() chain.SpecData[
 
 		// Slashing
 		ProportionalSlashingMultiplier: 1,
+		MinSlashingPenaltyQuotient:     128,
 
 		// Capella values.
 		MaxWithdrawalsPerPayload:                    16,
@@ -135,6 +146,8 @@ func BaseSpec() chain.SpecData[
 		CometValues: cmtConsensusParams,
 
 		// Berachain Values
-		ValidatorSetCap: 256,
+		ValidatorSetCap:        256,
+		ValidatorPowerStrategy: chain.ValidatorPowerEffectiveBalance,
+		ValidatorPowerCap:      0,
 	}
 }
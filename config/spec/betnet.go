@@ -33,7 +33,17 @@ func BetnetChainSpec() (chain.Spec[
 	math.Slot,
 	any,
 ], error) {
+	return chain.NewChainSpec(BetnetChainSpecData())
+}
+
+// BetnetChainSpecData is the chain spec data for the localnet.
+func BetnetChainSpecData() chain.SpecData[
+	common.DomainType,
+	math.Epoch,
+	math.Slot,
+	any,
+] {
 	testnetSpec := BaseSpec()
 	testnetSpec.DepositEth1ChainID = BetnetEth1ChainID
-	return chain.NewChainSpec(testnetSpec)
+	return testnetSpec
 }
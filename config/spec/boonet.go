@@ -33,6 +33,16 @@ func BoonetChainSpec() (chain.Spec[
 	math.Slot,
 	any,
 ], error) {
+	return chain.NewChainSpec(BoonetChainSpecData())
+}
+
+// BoonetChainSpecData is the chain spec data for the localnet.
+func BoonetChainSpecData() chain.SpecData[
+	common.DomainType,
+	math.Epoch,
+	math.Slot,
+	any,
+] {
 	boonetSpec := BaseSpec()
 
 	// Chain ID is 80000.
@@ -60,5 +70,5 @@ func BoonetChainSpec() (chain.Spec[
 	//nolint:mnd // ok.
 	boonetSpec.MaxEffectiveBalancePostUpgrade = 5_000_000 * 1e9
 
-	return chain.NewChainSpec(boonetSpec)
+	return boonetSpec
 }
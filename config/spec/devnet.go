@@ -43,11 +43,25 @@ func DevnetChainSpec() (chain.Spec[
 	math.Slot,
 	any,
 ], error) {
+	return chain.NewChainSpec(DevnetChainSpecData())
+}
+
+// DevnetChainSpecData is the chain spec data for the localnet. Also used for e2e tests
+// in the kurtosis network.
+func DevnetChainSpecData() chain.SpecData[
+	common.DomainType,
+	math.Epoch,
+	math.Slot,
+	any,
+] {
 	devnetSpec := BaseSpec()
 	devnetSpec.DepositEth1ChainID = DevnetEth1ChainID
 	devnetSpec.EVMInflationAddress = common.NewExecutionAddressFromHex(
 		DevnetEVMInflationAddress,
 	)
 	devnetSpec.EVMInflationPerBlock = DevnetEVMInflationPerBlock
-	return chain.NewChainSpec(devnetSpec)
+	// The localnet has no history to replay, so deposits creating a
+	// validator are checked against the min deposit amount from genesis.
+	devnetSpec.DepositBoundsForkEpoch = 0
+	return devnetSpec
 }
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec
+
+import (
+	"strings"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/cast"
+	"github.com/spf13/viper"
+)
+
+const (
+	// DevnetPreset is the name of the devnet chain spec preset.
+	DevnetPreset = "devnet"
+	// BetnetPreset is the name of the betnet chain spec preset.
+	BetnetPreset = "betnet"
+	// BoonetPreset is the name of the boonet chain spec preset.
+	BoonetPreset = "boonet"
+	// TestnetPreset is the name of the testnet chain spec preset.
+	TestnetPreset = "testnet"
+
+	// presetKey is the chain spec file key selecting the preset the file
+	// values are applied on top of.
+	presetKey = "preset"
+	// cometValuesKey is the chain spec file key of the CometBFT consensus
+	// params, which cannot be loaded from file.
+	cometValuesKey = "comet-bft-config"
+	// extensionsKey is the chain spec file key of the tables holding the
+	// values of chain spec extensions.
+	extensionsKey = "extensions"
+)
+
+// Extension is a chain spec section defined by an add-on. Its values are
+// held by the extensions.<name> table of chain spec files.
+type Extension interface {
+	// Name returns the name of the extension table.
+	Name() string
+	// Load loads the extension from the values of its table, which are
+	// empty if the table is absent or the chain spec is a preset.
+	Load(values map[string]any) error
+}
+
+var (
+	// ErrUnknownPreset is returned when the chain spec preset is unknown.
+	ErrUnknownPreset = errors.New("unknown chain spec preset")
+
+	// ErrCometValuesNotSupported is returned when the chain spec file sets
+	// the CometBFT consensus params.
+	ErrCometValuesNotSupported = errors.New(
+		"comet-bft-config cannot be set from a chain spec file",
+	)
+
+	// ErrInvalidExtension is returned when the values of a chain spec
+	// extension are invalid.
+	ErrInvalidExtension = errors.New("invalid chain spec extension")
+)
+
+// PresetChainSpecData returns the chain spec data of the given preset.
+// An empty name selects the testnet preset.
+func PresetChainSpecData(name string) (chain.SpecData[
+	common.DomainType,
+	math.Epoch,
+	math.Slot,
+	any,
+], error) {
+	switch name {
+	case DevnetPreset:
+		return DevnetChainSpecData(), nil
+	case BetnetPreset:
+		return BetnetChainSpecData(), nil
+	case BoonetPreset:
+		return BoonetChainSpecData(), nil
+	case TestnetPreset, "":
+		return TestnetChainSpecData(), nil
+	default:
+		return chain.SpecData[
+			common.DomainType,
+			math.Epoch,
+			math.Slot,
+			any,
+		]{}, errors.Wrap(ErrUnknownPreset, name)
+	}
+}
+
+// LoadChainSpecFile loads the chain spec from the YAML or TOML file at the
+// given path, the format being picked from the file extension. Values in the
+// file override the ones of the preset named by its optional preset key,
+// testnet by default. Unknown keys are rejected and the resulting chain spec
+// is validated for internal consistency. The given extensions are loaded from
+// their tables, while the tables of other extensions are ignored.
+func LoadChainSpecFile(path string, extensions ...Extension) (chain.Spec[
+	common.DomainType,
+	math.Epoch,
+	math.Slot,
+	any,
+], error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, errors.Wrapf(err, "failed reading chain spec file %s", path)
+	}
+
+	if v.InConfig(cometValuesKey) {
+		return nil, ErrCometValuesNotSupported
+	}
+
+	data, err := PresetChainSpecData(v.GetString(presetKey))
+	if err != nil {
+		return nil, err
+	}
+	values := v.AllSettings()
+	delete(values, presetKey)
+	if err = LoadExtensions(
+		cast.ToStringMap(values[extensionsKey]), extensions...,
+	); err != nil {
+		return nil, err
+	}
+	delete(values, extensionsKey)
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:  mapstructure.TextUnmarshallerHookFunc(),
+		ErrorUnused: true,
+		Result:      &data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = decoder.Decode(values); err != nil {
+		return nil, errors.Wrapf(err, "failed decoding chain spec file %s", path)
+	}
+	return chain.NewChainSpec(data)
+}
+
+// LoadExtensions loads each extension from its table of the given extension
+// tables. Table names are case insensitive.
+func LoadExtensions(tables map[string]any, extensions ...Extension) error {
+	for _, ext := range extensions {
+		table := cast.ToStringMap(tables[strings.ToLower(ext.Name())])
+		if err := ext.Load(table); err != nil {
+			return errors.Wrapf(
+				ErrInvalidExtension, "%s: %v", ext.Name(), err,
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func writeSpecFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadChainSpecFile(t *testing.T) {
+	t.Run("yaml overrides preset", func(t *testing.T) {
+		path := writeSpecFile(t, "spec.yaml", `
+preset: devnet
+slots-per-epoch: 16
+deneb-plus-fork-epoch: 10
+electra-fork-epoch: 20
+max-blobs-per-block: 3
+min-slashing-penalty-quotient: 64
+domain-type-deposit: "0x03000001"
+`)
+		cs, err := spec.LoadChainSpecFile(path)
+		require.NoError(t, err)
+		require.Equal(t, uint64(16), cs.SlotsPerEpoch())
+		require.Equal(t, uint64(10), cs.DenebPlusForkEpoch().Unwrap())
+		require.Equal(t, uint64(20), cs.ElectraForkEpoch().Unwrap())
+		require.Equal(t, uint64(3), cs.MaxBlobsPerBlock())
+		require.Equal(t, uint64(64), cs.MinSlashingPenaltyQuotient())
+		require.Equal(t, [4]byte{0x03, 0x00, 0x00, 0x01}, [4]byte(cs.DomainTypeDeposit()))
+
+		// values not in the file are the preset ones
+		devnet, err := spec.DevnetChainSpec()
+		require.NoError(t, err)
+		require.Equal(t, devnet.DepositEth1ChainID(), cs.DepositEth1ChainID())
+		require.Equal(t, devnet.EVMInflationAddress(), cs.EVMInflationAddress())
+		require.NotNil(t, cs.GetCometBFTConfigForSlot(0))
+	})
+
+	t.Run("toml defaults to testnet", func(t *testing.T) {
+		path := writeSpecFile(t, "spec.toml", `
+slots-per-epoch = 8
+deposit-contract-address = "0x1111111111111111111111111111111111111111"
+`)
+		cs, err := spec.LoadChainSpecFile(path)
+		require.NoError(t, err)
+		require.Equal(t, uint64(8), cs.SlotsPerEpoch())
+		require.Equal(t, uint64(spec.TestnetEth1ChainID), cs.DepositEth1ChainID())
+		require.Equal(t,
+			"0x1111111111111111111111111111111111111111",
+			cs.DepositContractAddress().String(),
+		)
+	})
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{
+			name:    "unknown preset",
+			content: "preset: mainnet\n",
+			wantErr: spec.ErrUnknownPreset,
+		},
+		{
+			name:    "comet values",
+			content: "comet-bft-config: {}\n",
+			wantErr: spec.ErrCometValuesNotSupported,
+		},
+		{
+			name:    "zero slots per epoch",
+			content: "slots-per-epoch: 0\n",
+			wantErr: chain.ErrZeroSpecValue,
+		},
+		{
+			name:    "forks out of order",
+			content: "deneb-plus-fork-epoch: 20\nelectra-fork-epoch: 10\n",
+			wantErr: chain.ErrInvalidForkEpochs,
+		},
+		{
+			name:    "too many blobs",
+			content: "max-blobs-per-block: 17\n",
+			wantErr: chain.ErrInvalidBlobParams,
+		},
+		{
+			name:    "ejection balance above max effective balance",
+			content: "ejection-balance: 64000000000\n",
+			wantErr: chain.ErrInvalidEjectionBalance,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeSpecFile(t, "spec.yaml", tt.content)
+			_, err := spec.LoadChainSpecFile(path)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	t.Run("unknown key", func(t *testing.T) {
+		path := writeSpecFile(t, "spec.yaml", "slots-per-epochs: 16\n")
+		_, err := spec.LoadChainSpecFile(path)
+		require.ErrorContains(t, err, "slots-per-epochs")
+	})
+}
+
+// testExtension records the values of its chain spec table.
+type testExtension struct {
+	name   string
+	values map[string]any
+	err    error
+}
+
+func (e *testExtension) Name() string { return e.name }
+
+func (e *testExtension) Load(values map[string]any) error {
+	e.values = values
+	return e.err
+}
+
+func TestLoadChainSpecFileExtensions(t *testing.T) {
+	path := writeSpecFile(t, "spec.toml", `
+slots-per-epoch = 8
+
+[extensions.oracle]
+feed = "eth-usd"
+interval = 4
+
+[extensions.other]
+foo = "bar"
+`)
+	oracle := &testExtension{name: "oracle"}
+	absent := &testExtension{name: "absent"}
+	cs, err := spec.LoadChainSpecFile(path, oracle, absent)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), cs.SlotsPerEpoch())
+	require.Equal(t, "eth-usd", oracle.values["feed"])
+	require.EqualValues(t, 4, oracle.values["interval"])
+	require.Empty(t, absent.values)
+
+	failing := &testExtension{name: "oracle", err: errors.New("bad feed")}
+	_, err = spec.LoadChainSpecFile(path, failing)
+	require.ErrorIs(t, err, spec.ErrInvalidExtension)
+}
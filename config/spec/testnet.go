@@ -33,7 +33,17 @@ func TestnetChainSpec() (chain.Spec[
 	math.Slot,
 	any,
 ], error) {
+	return chain.NewChainSpec(TestnetChainSpecData())
+}
+
+// TestnetChainSpecData is the chain spec data for the bArtio testnet.
+func TestnetChainSpecData() chain.SpecData[
+	common.DomainType,
+	math.Epoch,
+	math.Slot,
+	any,
+] {
 	testnetSpec := BaseSpec()
 	testnetSpec.DepositEth1ChainID = TestnetEth1ChainID
-	return chain.NewChainSpec(testnetSpec)
+	return testnetSpec
 }
@@ -25,6 +25,23 @@ const TomlTemplate = `
 ###                                BeaconKit                                ###
 ###############################################################################
 
+[beacon-kit]
+# Version of the settings layout of this file. It is managed by the node,
+# which migrates the file, after backing it up, when upgraded.
+config-version = "{{ .BeaconKit.ConfigVersion }}"
+
+# Path to a YAML or TOML chain spec file. Values in the file override the ones
+# of the preset named by its "preset" key. The chain spec preset selected by
+# the CHAIN_SPEC environment variable is used if unset.
+chain-spec-file = "{{ .BeaconKit.ChainSpecFile }}"
+
+# Role of the node. Validator nodes sign and propose blocks, full nodes only
+# verify them and rpc nodes follow the chain without a validator key to serve
+# APIs. Replica nodes serve APIs from stores they open read-only, such as a
+# snapshot or a shared volume no running node has open, without following the
+# chain. Defaults to validator if unset.
+role = "{{ .BeaconKit.Role }}"
+
 [beacon-kit.engine]
 # HTTP url of the execution client JSON-RPC endpoint.
 rpc-dial-url = "{{ .BeaconKit.Engine.RPCDialURL }}"
@@ -44,6 +61,17 @@ rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 # Path to the execution client JWT-secret
 jwt-secret-path = "{{.BeaconKit.Engine.JWTSecretPath}}"
 
+# Path to the audit log of the engine API calls, recording their method, a
+# digest of their params, their status and latency, without the JWT nor the
+# payloads. Disabled if unset.
+audit-log-path = "{{.BeaconKit.Engine.AuditLogPath}}"
+
+# Size in MiB past which the audit log is rotated.
+audit-log-max-size-mib = "{{.BeaconKit.Engine.AuditLogMaxSizeMiB}}"
+
+# Number of rotated audit logs kept.
+audit-log-max-backups = "{{.BeaconKit.Engine.AuditLogMaxBackups}}"
+
 [beacon-kit.logger]
 # TimeFormat is a string that defines the format of the time in the logger.
 time-format = "{{.BeaconKit.Logger.TimeFormat}}"
@@ -52,6 +80,13 @@ time-format = "{{.BeaconKit.Logger.TimeFormat}}"
 # to LogLevel.
 log-level = "{{.BeaconKit.Logger.LogLevel}}"
 
+# ModuleLogLevels overrides LogLevel for some modules, as a comma separated
+# list of module=level pairs, e.g. "engine=debug,da=info". The modules are api,
+# beacon, da, engine, node, state, storage and validator. A single service may
+# also be overridden by its name, e.g. "engine.client=warn", taking
+# precedence over its module.
+module-log-levels = "{{.BeaconKit.Logger.ModuleLogLevels}}"
+
 # Style is the style of the logger.
 style = "{{.BeaconKit.Logger.Style}}"
 
@@ -63,6 +98,13 @@ trusted-setup-path = "{{.BeaconKit.KZG.TrustedSetupPath}}"
 # Options are "crate-crypto/go-kzg-4844" or "ethereum/c-kzg-4844".
 implementation = "{{.BeaconKit.KZG.Implementation}}"
 
+[beacon-kit.bls]
+# BLS implementation to use, self-tested at startup.
+# Options are "auto", "supranational/blst" or "kilic/bls12-381". "auto" selects
+# supranational/blst when the binary is built with the bls12381 tag, and the
+# pure-Go kilic/bls12-381 otherwise. CometBFT votes always use supranational/blst.
+implementation = "{{.BeaconKit.BLS.Implementation}}"
+
 [beacon-kit.payload-builder]
 # Enabled determines if the local payload builder is enabled.
 enabled = {{ .BeaconKit.PayloadBuilder.Enabled }}
@@ -80,6 +122,15 @@ payload-timeout = "{{ .BeaconKit.PayloadBuilder.PayloadTimeout }}"
 # Graffiti string that will be included in the graffiti field of the beacon block.
 graffiti = "{{.BeaconKit.Validator.Graffiti}}"
 
+# MetadataFile is the path to a JSON proposer configuration file mapping
+# validator pubkeys to their fee recipient, graffiti, gas limit and builder
+# preference, overriding the node wide defaults.
+metadata-file = "{{.BeaconKit.Validator.MetadataFile}}"
+
+# MetadataFileWatchInterval is the interval at which the metadata file is polled
+# for changes, which are applied without restarting the node. Zero disables it.
+metadata-file-watch-interval = "{{.BeaconKit.Validator.MetadataFileWatchInterval}}"
+
 # EnableOptimisticPayloadBuilds enables building the next block's payload optimistically in
 # process-proposal to allow for the execution client to have more time to assemble the block.
 enable-optimistic-payload-builds = "{{.BeaconKit.Validator.EnableOptimisticPayloadBuilds}}"
@@ -91,6 +142,82 @@ enabled = "{{ .BeaconKit.BlockStoreService.Enabled }}"
 # AvailabilityWindow is the number of slots to keep in the store.
 availability-window = "{{ .BeaconKit.BlockStoreService.AvailabilityWindow }}"
 
+[beacon-kit.availability-store]
+# Path to the file holding the hex encoded AES-256 key encrypting the blob
+# sidecars at rest with AES-GCM, such as a secret mounted by a KMS agent. Blob
+# sidecars are stored in the clear if empty. Sidecars written before the key was
+# set remain readable.
+encryption-key-path = "{{ .BeaconKit.AvailabilityStore.EncryptionKeyPath }}"
+
+[beacon-kit.state-snapshots]
+# Interval is the number of epochs between the snapshots of the beacon state,
+# taken at epoch boundaries. The snapshots regenerate the states pruned from the
+# multistore, and the latest one is served as the finalized state to checkpoint
+# syncing nodes. States are not snapshotted if zero.
+interval = "{{ .BeaconKit.StateSnapshots.Interval }}"
+
+# Retention is the number of epochs snapshots are kept for by nodes pruning the
+# multistore. Older snapshots are compacted away in the background. Snapshots
+# are kept forever if zero.
+retention = "{{ .BeaconKit.StateSnapshots.Retention }}"
+
+[beacon-kit.pruning]
+# Retention is the number of most recent beacon states kept by nodes pruning the
+# multistore. Older states are pruned as each block is finalized, the snapshots
+# regenerating them. States are only pruned per the pruning options if zero.
+retention = "{{ .BeaconKit.Pruning.Retention }}"
+
+[beacon-kit.state-sync]
+# Interval is the number of blocks between the state sync snapshots served to the
+# nodes joining with CometBFT state sync. The snapshots hold the beacon state, the
+# deposits and the blob sidecars within the data availability period. No snapshot
+# is taken if zero.
+interval = "{{ .BeaconKit.StateSync.Interval }}"
+
+# KeepRecent is the number of recent state sync snapshots kept, all of them if
+# zero.
+keep-recent = "{{ .BeaconKit.StateSync.KeepRecent }}"
+
+[beacon-kit.catch-up]
+# Enabled relaxes the verification of the blocks replayed far behind the head,
+# already finalized by CometBFT: their RANDAO reveals are not checked again and
+# their execution payloads are submitted to the execution client in batches.
+enabled = "{{ .BeaconKit.CatchUp.Enabled }}"
+
+# MinBlockAge is the age past which blocks are replayed in catch-up mode. More
+# recent blocks are fully verified.
+min-block-age = "{{ .BeaconKit.CatchUp.MinBlockAge }}"
+
+# MinBlocksBehind is the number of blocks the node must lag behind its peers to
+# replay blocks in catch-up mode, whatever their age.
+min-blocks-behind = "{{ .BeaconKit.CatchUp.MinBlocksBehind }}"
+
+# PayloadBatchSize is the number of blocks whose execution payloads are submitted
+# at once in catch-up mode. The execution client fetches the payloads of the rest
+# of each batch from its peers.
+payload-batch-size = "{{ .BeaconKit.CatchUp.PayloadBatchSize }}"
+
+[beacon-kit.freezer]
+# Threshold is the number of slots after which the finalized beacon blocks and
+# headers, and the snapshots of the beacon state, are moved out of the hot stores
+# into the append-only flat files of the freezer. CometBFT retains the blocks
+# until they are frozen. Nothing is frozen if zero.
+threshold = "{{ .BeaconKit.Freezer.Threshold }}"
+
+[beacon-kit.backfill]
+# Depth is the number of slots below the first block held by CometBFT, e.g. once
+# checkpoint synced, whose beacon blocks, and blob sidecars within the DA period,
+# are fetched from a trusted node and verified backwards. Nothing is backfilled if
+# zero, unless archiving.
+depth = "{{ .BeaconKit.Backfill.Depth }}"
+
+# Archive backfills the beacon blocks down to genesis, whatever the depth.
+archive = "{{ .BeaconKit.Backfill.Archive }}"
+
+# URL is the node API of the trusted node the history is fetched from. The URL of
+# the node checkpoint synced from is used if empty.
+url = "{{ .BeaconKit.Backfill.URL }}"
+
 [beacon-kit.node-api]
 # Enabled determines if the node API is enabled.
 enabled = "{{ .BeaconKit.NodeAPI.Enabled }}"
@@ -98,6 +225,248 @@ enabled = "{{ .BeaconKit.NodeAPI.Enabled }}"
 # Address is the address to bind the node API to.
 address = "{{ .BeaconKit.NodeAPI.Address }}"
 
+# ExtraAddresses are the addresses the node API is also bound to, e.g. to serve
+# both an IPv4 and an IPv6 interface.
+extra-addresses = [{{ range $i, $addr := .BeaconKit.NodeAPI.ExtraAddresses }}{{ if $i }}, {{ end }}"{{ $addr }}"{{ end }}]
+
+# AuthenticatedAddress is the address the authenticated routes, e.g. the
+# keymanager API, are served at. If set, they are only served at this address,
+# and not at the addresses above.
+authenticated-address = "{{ .BeaconKit.NodeAPI.AuthenticatedAddress }}"
+
+# ShutdownTimeout bounds the time waited for the requests in flight to complete
+# when the node is stopped, after which their connections are closed.
+shutdown-timeout = "{{ .BeaconKit.NodeAPI.ShutdownTimeout }}"
+
+# CORSAllowedOrigins are the origins allowed to make cross-origin requests, e.g.
+# browser based explorers, "*" allowing any. Cross-origin requests are denied
+# if empty.
+cors-allowed-origins = [{{ range $i, $origin := .BeaconKit.NodeAPI.CORSAllowedOrigins }}{{ if $i }}, {{ end }}"{{ $origin }}"{{ end }}]
+
+# TLSCertFile and TLSKeyFile are the paths to the PEM encoded certificate and
+# private key to serve the node API over TLS with. Served over plain HTTP if
+# unset.
+tls-cert-file = "{{ .BeaconKit.NodeAPI.TLSCertFile }}"
+tls-key-file = "{{ .BeaconKit.NodeAPI.TLSKeyFile }}"
+
+# TLSClientCAFile is the path to the PEM encoded certificates of the CAs client
+# certificates must be signed by, enabling mutual TLS. Requires TLS.
+tls-client-ca-file = "{{ .BeaconKit.NodeAPI.TLSClientCAFile }}"
+
 # Logging determines if the node API logging is enabled.
 logging = "{{ .BeaconKit.NodeAPI.Logging }}"
+
+# AuthTokenFile is the path to the file holding the bearer token required by
+# authenticated routes. Authenticated routes are disabled if unset.
+auth-token-file = "{{ .BeaconKit.NodeAPI.AuthTokenFile }}"
+
+# RateLimit is the number of requests per second allowed per IP address of the
+# clients not authenticated with the bearer token. Unlimited if zero.
+rate-limit = "{{ .BeaconKit.NodeAPI.RateLimit }}"
+
+# TokenRateLimit is the number of requests per second allowed to the clients
+# authenticated with the bearer token. Unlimited if zero.
+token-rate-limit = "{{ .BeaconKit.NodeAPI.TokenRateLimit }}"
+
+[beacon-kit.diagnostics]
+# Enabled determines if the diagnostics server, exposing pprof profiles,
+# runtime statistics, goroutine dumps and services health, is enabled.
+enabled = "{{ .BeaconKit.Diagnostics.Enabled }}"
+
+# Address is the address to bind the diagnostics server to. It should not be
+# publicly reachable.
+address = "{{ .BeaconKit.Diagnostics.Address }}"
+
+# SlowBlockThreshold is the processing time of a block above which a report of
+# its processing, with the timings of its phases and of the calls to the
+# execution client along with a goroutine profile, is written to
+# data/diagnostics. Zero disables the reports.
+slow-block-threshold = "{{ .BeaconKit.Diagnostics.SlowBlockThreshold }}"
+
+# MaxSlowBlockReports is the number of slow block reports kept, the oldest ones
+# being removed.
+max-slow-block-reports = "{{ .BeaconKit.Diagnostics.MaxSlowBlockReports }}"
+
+[beacon-kit.probes]
+# Enabled determines if the probes server, serving the /healthz liveness and
+# /readyz readiness endpoints, is enabled.
+enabled = "{{ .BeaconKit.Probes.Enabled }}"
+
+# Address is the address to bind the probes server to.
+address = "{{ .BeaconKit.Probes.Address }}"
+
+# MaxSlotsBehind is the number of slots the node may lag behind the head of
+# its peers while still being ready.
+max-slots-behind = "{{ .BeaconKit.Probes.MaxSlotsBehind }}"
+
+[beacon-kit.prometheus]
+# Enabled determines if the metrics of the node are served to Prometheus.
+enabled = "{{ .BeaconKit.Prometheus.Enabled }}"
+
+# Address is the address to serve the metrics on, at /metrics.
+address = "{{ .BeaconKit.Prometheus.Address }}"
+
+[beacon-kit.metrics]
+# AllowedLabels are the metric label names allowed on top of the default ones,
+# whose values are bounded. Metrics are reported without the labels which are
+# not allowed, e.g. validator_index or slot, as each of their values would
+# create a series. Allow them with care on large validator sets.
+allowed-labels = [{{ range $i, $label := .BeaconKit.Metrics.AllowedLabels }}{{ if $i }}, {{ end }}"{{ $label }}"{{ end }}]
+
+# MaxSeriesPerMetric is the number of series, i.e. of combinations of label
+# values, a metric may have. The values of the series past it are dropped.
+max-series-per-metric = "{{ .BeaconKit.Metrics.MaxSeriesPerMetric }}"
+
+[beacon-kit.tracing]
+# Enabled determines if the traces of the block pipeline are exported.
+enabled = "{{ .BeaconKit.Tracing.Enabled }}"
+
+# Endpoint is the host and port of the OTLP HTTP collector the traces are
+# exported to.
+endpoint = "{{ .BeaconKit.Tracing.Endpoint }}"
+
+# Insecure disables TLS when exporting the traces.
+insecure = "{{ .BeaconKit.Tracing.Insecure }}"
+
+# SampleRatio is the ratio of the blocks traced, from 0 to 1.
+sample-ratio = "{{ .BeaconKit.Tracing.SampleRatio }}"
+
+[beacon-kit.chain-health]
+# Window is the number of recent slots the health of the chain, served at
+# /bkit/v1/node/chain_health, is summarized over.
+window = "{{ .BeaconKit.ChainHealth.Window }}"
+
+# MaxEmptyPayloads is the number of consecutive blocks with an empty execution
+# payload past which a warning is logged.
+max-empty-payloads = "{{ .BeaconKit.ChainHealth.MaxEmptyPayloads }}"
+
+# MaxFinalityLag is the time since the latest finalized slot past which a
+# warning is logged.
+max-finality-lag = "{{ .BeaconKit.ChainHealth.MaxFinalityLag }}"
+
+[beacon-kit.validator-performance]
+# Window is the number of recent slots the performance of the local validators,
+# served at /bkit/v1/validator/performance, is tracked over.
+window = "{{ .BeaconKit.Performance.Window }}"
+
+# TrackedPubkeys are the public keys of the validators tracked on top of the
+# one run by the node, e.g. the ones run by the other nodes of the operator.
+tracked-pubkeys = [{{ range $i, $pubkey := .BeaconKit.Performance.TrackedPubkeys }}{{ if $i }}, {{ end }}"{{ $pubkey }}"{{ end }}]
+
+[beacon-kit.crash-report]
+# Enabled determines if a crash report, holding the reason of the crash, the
+# latest log lines, the head of the chain, the config with its secrets redacted
+# and a goroutine dump, is written to data/crash-reports when the node panics
+# or fails with a fatal error.
+enabled = "{{ .BeaconKit.CrashReport.Enabled }}"
+
+# LogLines is the number of latest log lines included in the crash reports.
+log-lines = "{{ .BeaconKit.CrashReport.LogLines }}"
+
+[beacon-kit.remote-signer]
+# URL is the base URL of a Web3Signer-compatible remote signer the blocks and
+# exits of the validator are signed by, keeping its BLS key off the node host.
+# The validator key of the node is used if empty. CometBFT votes are still
+# signed by the priv validator, which can be made remote with
+# priv_validator_laddr.
+url = "{{ .BeaconKit.RemoteSigner.URL }}"
+
+# Pubkey is the public key of the validator, identifying the key the remote
+# signer signs with.
+pubkey = "{{ .BeaconKit.RemoteSigner.Pubkey }}"
+
+# ClientCertFile and ClientKeyFile are the paths to the PEM encoded certificate
+# and private key the node authenticates to the remote signer with.
+client-cert-file = "{{ .BeaconKit.RemoteSigner.ClientCertFile }}"
+client-key-file = "{{ .BeaconKit.RemoteSigner.ClientKeyFile }}"
+
+# CACertFile is the path to the PEM encoded certificate of the CA the remote
+# signer certificate is verified against. The system roots are used if empty.
+ca-cert-file = "{{ .BeaconKit.RemoteSigner.CACertFile }}"
+
+# Timeout is the timeout of the signing requests.
+timeout = "{{ .BeaconKit.RemoteSigner.Timeout }}"
+
+[beacon-kit.doppelganger]
+# Enabled determines if the chain is observed for blocks proposed with the
+# validator key of the node by another node before signing with it, signing
+# being disabled if one is found, e.g. after a failover.
+enabled = "{{ .BeaconKit.Doppelganger.Enabled }}"
+
+# Epochs is the number of epochs observed upon startup before signing.
+epochs = "{{ .BeaconKit.Doppelganger.Epochs }}"
+
+[beacon-kit.keystores]
+# Enabled determines if the EIP-2335 keystores of the keystores directory are
+# loaded, the keystores added or removed being loaded or unloaded at runtime.
+# The keys loaded are registered in the slashing protection database of the
+# node, they do not replace the validator key the node proposes blocks with.
+enabled = "{{ .BeaconKit.Keystores.Enabled }}"
+
+# Dir is the keystores directory, config/keystores in the home directory if empty.
+dir = "{{ .BeaconKit.Keystores.Dir }}"
+
+# PasswordFile is the path to the file holding the password of the keystores.
+password-file = "{{ .BeaconKit.Keystores.PasswordFile }}"
+
+# PollInterval is the interval at which the keystores directory is polled.
+poll-interval = "{{ .BeaconKit.Keystores.PollInterval }}"
+
+[beacon-kit.signer]
+# Backend is the backend the blocks and exits of the validator are signed with,
+# unless a remote signer is configured:
+#   - local signs with the validator key of the node.
+#   - keystore signs with the key of a local EIP-2335 keystore.
+#   - external signs with an external signer process, e.g. signing with a
+#     hardware security module through PKCS#11 or with a key management service.
+#   - threshold signs with the key share of a distributed validator held by the
+#     keystore, along with the other operators of the validator.
+# CometBFT votes are still signed with the validator key of the node.
+backend = "{{ .BeaconKit.Signer.Backend }}"
+
+# KeystoreFile is the EIP-2335 keystore of the keystore and threshold backends.
+keystore-file = "{{ .BeaconKit.Signer.KeystoreFile }}"
+
+# PasswordFile is the file holding the password of the keystore.
+password-file = "{{ .BeaconKit.Signer.PasswordFile }}"
+
+# ExternalCommand is the command line of the external signer, which is sent one
+# JSON request per line on its standard input and answers each on its standard
+# output.
+external-command = [{{ range $i, $arg := .BeaconKit.Signer.ExternalCommand }}{{ if $i }}, {{ end }}"{{ $arg }}"{{ end }}]
+
+# Timeout is the timeout of the requests to the backend, i.e. the time the
+# other operators are waited for by the threshold backend.
+timeout = "{{ .BeaconKit.Signer.Timeout }}"
+
+[beacon-kit.signer.threshold]
+# ShareIndex is the index of the key share of the node, from 1.
+share-index = "{{ .BeaconKit.Signer.Threshold.ShareIndex }}"
+
+# Threshold is the number of partial signatures aggregated into a signature of
+# the validator.
+threshold = "{{ .BeaconKit.Signer.Threshold.Threshold }}"
+
+# Pubkey is the public key of the distributed validator.
+pubkey = "{{ .BeaconKit.Signer.Threshold.Pubkey }}"
+
+# SharePubkeys are the public keys of the key shares of all the operators, in
+# share index order, verifying their partial signatures.
+share-pubkeys = [{{ range $i, $pk := .BeaconKit.Signer.Threshold.SharePubkeys }}{{ if $i }}, {{ end }}"{{ $pk }}"{{ end }}]
+
+# TransportURL is the base URL of the middleware the partial signatures are
+# exchanged through, unless the node is built with a plugin providing the
+# transport.
+transport-url = "{{ .BeaconKit.Signer.Threshold.TransportURL }}"
+
+# PollInterval is the interval at which the partial signatures of the other
+# operators are polled from the middleware.
+poll-interval = "{{ .BeaconKit.Signer.Threshold.PollInterval }}"
+
+[beacon-kit.slashing-protection]
+# RateLimit is the number of signing requests per second allowed to the
+# validator, in bursts of up to one second of requests, 0 for unlimited. Two
+# different blocks are never signed for the same slot, nor a block preceding
+# the last one signed, whatever the signer backend or remote signer.
+rate-limit = "{{ .BeaconKit.SlashingProtection.RateLimit }}"
 `
@@ -54,9 +54,20 @@ func StringToDialURLFunc() mapstructure.DecodeHookFunc {
 }
 
 // StringToConnectionURLFunc returns a DecodeHookFunc that converts
-// string to *beaconurl.ConnectionURL by parsing the string.
+// string to *beaconurl.ConnectionURL, or beaconurl.ConnectionURL, by parsing
+// the string.
 func StringToConnectionURLFunc() mapstructure.DecodeHookFunc {
-	return StringTo(beaconurl.NewFromRaw)
+	return mapstructure.ComposeDecodeHookFunc(
+		StringTo(beaconurl.NewFromRaw),
+		// Decoding into a non-nil pointer decodes into the pointed value.
+		StringTo(func(s string) (beaconurl.ConnectionURL, error) {
+			u, err := beaconurl.NewFromRaw(s)
+			if err != nil {
+				return beaconurl.ConnectionURL{}, err
+			}
+			return *u, nil
+		}),
+	)
 }
 
 // StringTo is a helper function for creating DecodeHookFuncs that convert
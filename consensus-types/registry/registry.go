@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package registry
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/versioned"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// Default is the registry of the types of the forks supported by the node.
+// It is used to decode the objects received over ABCI, served by the node API
+// and read from the storage.
+//
+//nolint:gochecknoglobals // read-only after initialization.
+var Default = New()
+
+// Registry maps the fork versions to the types of the versioned consensus
+// objects.
+type Registry struct {
+	// BeaconBlocks are the codecs of the beacon blocks.
+	BeaconBlocks *versioned.Codec[*ctypes.BeaconBlock]
+	// BeaconBlockBodies are the codecs of the beacon block bodies.
+	BeaconBlockBodies *versioned.Codec[*ctypes.BeaconBlockBody]
+	// ExecutionPayloads are the codecs of the execution payloads.
+	ExecutionPayloads *versioned.Codec[*ctypes.ExecutionPayload]
+	// ExecutionPayloadHeaders are the codecs of the execution payload
+	// headers.
+	ExecutionPayloadHeaders *versioned.Codec[*ctypes.ExecutionPayloadHeader]
+	// BlobSidecars are the codecs of the blob sidecars of a block.
+	BlobSidecars *versioned.Codec[*datypes.BlobSidecars]
+}
+
+// New creates a registry of the types of the supported forks. Adding a fork
+// means registering its types here.
+func New() *Registry {
+	r := &Registry{
+		BeaconBlocks: versioned.NewCodec[*ctypes.BeaconBlock](
+			"beacon block",
+		),
+		BeaconBlockBodies: versioned.NewCodec[*ctypes.BeaconBlockBody](
+			"beacon block body",
+		),
+		ExecutionPayloads: versioned.NewCodec[*ctypes.ExecutionPayload](
+			"execution payload",
+		),
+		ExecutionPayloadHeaders: versioned.
+			NewCodec[*ctypes.ExecutionPayloadHeader]("execution payload header"),
+		BlobSidecars: versioned.NewCodec[*datypes.BlobSidecars](
+			"blob sidecars",
+		),
+	}
+
+	// Deneb.
+	r.BeaconBlocks.Register(version.Deneb, newBeaconBlock)
+	r.BeaconBlockBodies.Register(version.Deneb, newBeaconBlockBody)
+	r.ExecutionPayloads.Register(version.Deneb, newExecutionPayload)
+	r.ExecutionPayloadHeaders.Register(
+		version.Deneb, newExecutionPayloadHeader,
+	)
+	r.BlobSidecars.Register(version.Deneb, newBlobSidecars)
+
+	// Deneb+ keeps the Deneb execution payloads and blob sidecars. Its blocks
+	// are not supported yet.
+	r.ExecutionPayloads.Register(version.DenebPlus, newExecutionPayload)
+	r.ExecutionPayloadHeaders.Register(
+		version.DenebPlus, newExecutionPayloadHeader,
+	)
+	r.BlobSidecars.Register(version.DenebPlus, newBlobSidecars)
+
+	return r
+}
+
+func newBeaconBlock() *ctypes.BeaconBlock {
+	return &ctypes.BeaconBlock{}
+}
+
+func newBeaconBlockBody() *ctypes.BeaconBlockBody {
+	return &ctypes.BeaconBlockBody{}
+}
+
+func newExecutionPayload() *ctypes.ExecutionPayload {
+	return &ctypes.ExecutionPayload{}
+}
+
+func newExecutionPayloadHeader() *ctypes.ExecutionPayloadHeader {
+	return &ctypes.ExecutionPayloadHeader{}
+}
+
+func newBlobSidecars() *datypes.BlobSidecars {
+	return &datypes.BlobSidecars{}
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package registry_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/registry"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/versioned"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryVersions(t *testing.T) {
+	r := registry.New()
+	require.Equal(t, []uint32{version.Deneb}, r.BeaconBlocks.Versions())
+	require.Equal(t, []uint32{version.Deneb}, r.BeaconBlockBodies.Versions())
+	for _, versions := range [][]uint32{
+		r.ExecutionPayloads.Versions(),
+		r.ExecutionPayloadHeaders.Versions(),
+		r.BlobSidecars.Versions(),
+	} {
+		require.Equal(t, []uint32{version.Deneb, version.DenebPlus}, versions)
+	}
+
+	_, err := r.BeaconBlocks.Empty(version.Electra)
+	require.ErrorIs(t, err, versioned.ErrForkVersionNotRegistered)
+}
+
+func TestRegistryRoundTrip(t *testing.T) {
+	r := registry.New()
+
+	blk, err := new(ctypes.BeaconBlock).NewWithVersion(
+		7, 3, common.Root{1}, version.Deneb,
+	)
+	require.NoError(t, err)
+	blk.Body = new(ctypes.BeaconBlockBody).Empty(version.Deneb)
+	blk.Body.ExecutionPayload.BaseFeePerGas = math.NewU256(1)
+	bz, err := r.BeaconBlocks.Marshal(version.Deneb, blk)
+	require.NoError(t, err)
+	decoded, err := r.BeaconBlocks.Unmarshal(version.Deneb, bz)
+	require.NoError(t, err)
+	require.Equal(t, blk.HashTreeRoot(), decoded.HashTreeRoot())
+
+	// Blocks are not supported yet by Deneb+.
+	_, err = r.BeaconBlocks.Unmarshal(version.DenebPlus, bz)
+	require.ErrorIs(t, err, versioned.ErrForkVersionNotRegistered)
+
+	header, err := blk.Body.ExecutionPayload.ToHeader()
+	require.NoError(t, err)
+	for _, v := range r.ExecutionPayloadHeaders.Versions() {
+		bz, err = r.ExecutionPayloadHeaders.Marshal(v, header)
+		require.NoError(t, err)
+		decodedHeader, err := r.ExecutionPayloadHeaders.Unmarshal(v, bz)
+		require.NoError(t, err)
+		require.Equal(t, header.HashTreeRoot(), decodedHeader.HashTreeRoot())
+	}
+
+	sidecars := &datypes.BlobSidecars{}
+	for _, v := range r.BlobSidecars.Versions() {
+		bz, err = r.BlobSidecars.Marshal(v, sidecars)
+		require.NoError(t, err)
+		decodedSidecars, err := r.BlobSidecars.Unmarshal(v, bz)
+		require.NoError(t, err)
+		require.Empty(t, *decodedSidecars)
+	}
+}
@@ -26,6 +26,8 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -49,7 +51,7 @@ type AttestationData struct {
 	// Index is the index of the validator.
 	Index math.U64 `json:"index"`
 	// BeaconBlockRoot is the root of the beacon block.
-	BeaconBlockRoot common.Root `json:"beaconBlockRoot"`
+	BeaconBlockRoot common.Root `json:"beacon_block_root"`
 }
 
 /* -------------------------------------------------------------------------- */
@@ -74,18 +76,6 @@ func (a *AttestationData) New(
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
 
-// SizeSSZ returns the size of the AttestationData object in SSZ encoding.
-func (*AttestationData) SizeSSZ(*ssz.Sizer) uint32 {
-	return AttestationDataSize
-}
-
-// DefineSSZ defines the SSZ encoding for the AttestationData object.
-func (a *AttestationData) DefineSSZ(codec *ssz.Codec) {
-	ssz.DefineUint64(codec, &a.Slot)
-	ssz.DefineUint64(codec, &a.Index)
-	ssz.DefineStaticBytes(codec, &a.BeaconBlockRoot)
-}
-
 // HashTreeRoot computes the SSZ hash tree root of the AttestationData object.
 func (a *AttestationData) HashTreeRoot() common.Root {
 	return ssz.HashSequential(a)
@@ -139,6 +129,38 @@ func (a *AttestationData) GetTree() (*fastssz.Node, error) {
 	return fastssz.ProofTree(a)
 }
 
+/* -------------------------------------------------------------------------- */
+/*                                    JSON                                    */
+/* -------------------------------------------------------------------------- */
+
+// attestationDataJSON is the Beacon API encoding of an AttestationData.
+type attestationDataJSON struct {
+	Slot            uint64      `json:"slot,string"`
+	Index           uint64      `json:"index,string"`
+	BeaconBlockRoot common.Root `json:"beacon_block_root"`
+}
+
+// MarshalJSON marshals the AttestationData as per the Beacon API.
+func (a AttestationData) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&attestationDataJSON{
+		Slot:            a.Slot.Unwrap(),
+		Index:           a.Index.Unwrap(),
+		BeaconBlockRoot: a.BeaconBlockRoot,
+	})
+}
+
+// UnmarshalJSON unmarshals the AttestationData from its Beacon API encoding.
+func (a *AttestationData) UnmarshalJSON(input []byte) error {
+	var dec attestationDataJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	a.Slot = math.U64(dec.Slot)
+	a.Index = math.U64(dec.Index)
+	a.BeaconBlockRoot = dec.BeaconBlockRoot
+	return nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                             Getters and Setters                            */
 /* -------------------------------------------------------------------------- */
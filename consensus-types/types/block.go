@@ -21,6 +21,7 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/berachain/beacon-kit/errors"
@@ -95,30 +96,6 @@ func (b *BeaconBlock) NewFromSSZ(
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
 
-// SizeSSZ returns the size of the BeaconBlock object in SSZ encoding.
-func (b *BeaconBlock) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
-	//nolint:mnd // todo fix.
-	var size = uint32(8 + 8 + 32 + 32 + 4)
-	if fixed {
-		return size
-	}
-	size += ssz.SizeDynamicObject(siz, b.Body)
-	return size
-}
-
-// DefineSSZ defines the SSZ encoding for the BeaconBlock object.
-func (b *BeaconBlock) DefineSSZ(codec *ssz.Codec) {
-	// Define the static data (fields and dynamic offsets)
-	ssz.DefineUint64(codec, &b.Slot)
-	ssz.DefineUint64(codec, &b.ProposerIndex)
-	ssz.DefineStaticBytes(codec, &b.ParentRoot)
-	ssz.DefineStaticBytes(codec, &b.StateRoot)
-	ssz.DefineDynamicObjectOffset(codec, &b.Body)
-
-	// Define the dynamic data (fields)
-	ssz.DefineDynamicObjectContent(codec, &b.Body)
-}
-
 // MarshalSSZ marshals the BeaconBlock object to SSZ format.
 func (b *BeaconBlock) MarshalSSZ() ([]byte, error) {
 	buf := make([]byte, ssz.Size(b))
@@ -236,3 +213,47 @@ func (b *BeaconBlock) GetHeader() *BeaconBlockHeader {
 func (b *BeaconBlock) GetTimestamp() math.U64 {
 	return b.Body.ExecutionPayload.Timestamp
 }
+
+// GetExecutionHash retrieves the hash of the execution block of the
+// BeaconBlock from the ExecutionPayload.
+func (b *BeaconBlock) GetExecutionHash() common.ExecutionHash {
+	return b.Body.ExecutionPayload.BlockHash
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                    JSON                                    */
+/* -------------------------------------------------------------------------- */
+
+// beaconBlockJSON is the Beacon API encoding of a BeaconBlock.
+type beaconBlockJSON struct {
+	Slot          uint64           `json:"slot,string"`
+	ProposerIndex uint64           `json:"proposer_index,string"`
+	ParentRoot    common.Root      `json:"parent_root"`
+	StateRoot     common.Root      `json:"state_root"`
+	Body          *BeaconBlockBody `json:"body"`
+}
+
+// MarshalJSON marshals the BeaconBlock as per the Beacon API.
+func (b BeaconBlock) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&beaconBlockJSON{
+		Slot:          b.Slot.Unwrap(),
+		ProposerIndex: b.ProposerIndex.Unwrap(),
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+		Body:          b.Body,
+	})
+}
+
+// UnmarshalJSON unmarshals the BeaconBlock from its Beacon API encoding.
+func (b *BeaconBlock) UnmarshalJSON(input []byte) error {
+	var dec beaconBlockJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	b.Slot = math.Slot(dec.Slot)
+	b.ProposerIndex = math.ValidatorIndex(dec.ProposerIndex)
+	b.ParentRoot = dec.ParentRoot
+	b.StateRoot = dec.StateRoot
+	b.Body = dec.Body
+	return nil
+}
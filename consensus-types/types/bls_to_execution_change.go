@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/karalabe/ssz"
+)
+
+// BLSToExecutionChange as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#blstoexecutionchange
+type BLSToExecutionChange struct {
+	// ValidatorIndex is the index of the validator changing its credentials.
+	ValidatorIndex math.ValidatorIndex
+	// FromBLSPubkey is the BLS withdrawal key committed to by the current
+	// credentials of the validator.
+	FromBLSPubkey crypto.BLSPubkey
+	// ToExecutionAddress is the address the validator withdraws to after the
+	// change.
+	ToExecutionAddress common.ExecutionAddress
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// HashTreeRoot computes the SSZ hash tree root of the BLSToExecutionChange
+// object.
+func (c *BLSToExecutionChange) HashTreeRoot() common.Root {
+	return ssz.HashSequential(c)
+}
+
+// MarshalSSZ marshals the BLSToExecutionChange object to SSZ format.
+func (c *BLSToExecutionChange) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(c))
+	return buf, ssz.EncodeToBytes(buf, c)
+}
+
+// UnmarshalSSZ unmarshals the BLSToExecutionChange object from SSZ format.
+func (c *BLSToExecutionChange) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, c)
+}
+
+// MatchesCredentials reports whether the given credentials commit to the
+// BLS withdrawal key of the change.
+func (c *BLSToExecutionChange) MatchesCredentials(
+	credentials WithdrawalCredentials,
+) bool {
+	hash := sha256.Hash(c.FromBLSPubkey[:])
+	return credentials[0] == BLSCredentialPrefix &&
+		[31]byte(credentials[1:]) == [31]byte(hash[1:])
+}
+
+// SignedBLSToExecutionChange as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#signedblstoexecutionchange
+type SignedBLSToExecutionChange struct {
+	// Message is the BLS to execution change.
+	Message *BLSToExecutionChange
+	// Signature is the signature of the change by the BLS withdrawal key.
+	Signature crypto.BLSSignature
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the
+// SignedBLSToExecutionChange object.
+func (c *SignedBLSToExecutionChange) HashTreeRoot() common.Root {
+	return ssz.HashSequential(c)
+}
+
+// MarshalSSZ marshals the SignedBLSToExecutionChange object to SSZ format.
+func (c *SignedBLSToExecutionChange) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(c))
+	return buf, ssz.EncodeToBytes(buf, c)
+}
+
+// UnmarshalSSZ unmarshals the SignedBLSToExecutionChange object from SSZ
+// format.
+func (c *SignedBLSToExecutionChange) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, c)
+}
+
+// VerifySignature verifies the signature of the change by its BLS withdrawal
+// key. Changes are signed within the domain of the genesis fork, so that
+// they remain valid across forks.
+func (c *SignedBLSToExecutionChange) VerifySignature(
+	genesisForkData *ForkData,
+	domainType common.DomainType,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	signingRoot := ComputeSigningRoot(
+		c.Message, genesisForkData.ComputeDomain(domainType),
+	)
+	return signatureVerificationFn(
+		c.Message.FromBLSPubkey, signingRoot[:], c.Signature,
+	)
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	types "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
+	karalabessz "github.com/karalabe/ssz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedBLSToExecutionChange_Serialization(t *testing.T) {
+	original := &types.SignedBLSToExecutionChange{
+		Message: &types.BLSToExecutionChange{
+			ValidatorIndex:     7,
+			FromBLSPubkey:      crypto.BLSPubkey{0x02},
+			ToExecutionAddress: common.ExecutionAddress{0x03},
+		},
+		Signature: crypto.BLSSignature{0x01},
+	}
+	require.Equal(t, uint32(172), karalabessz.Size(original))
+
+	data, err := original.MarshalSSZ()
+	require.NoError(t, err)
+
+	var unmarshalled types.SignedBLSToExecutionChange
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, original, &unmarshalled)
+}
+
+func TestBLSToExecutionChange_MatchesCredentials(t *testing.T) {
+	change := &types.BLSToExecutionChange{
+		FromBLSPubkey: crypto.BLSPubkey{0x02},
+	}
+	creds := types.WithdrawalCredentials(
+		sha256.Hash(change.FromBLSPubkey[:]),
+	)
+	creds[0] = types.BLSCredentialPrefix
+	require.True(t, change.MatchesCredentials(creds))
+
+	// Credentials of another key, or execution credentials, do not match.
+	change.FromBLSPubkey = crypto.BLSPubkey{0x03}
+	require.False(t, change.MatchesCredentials(creds))
+	require.False(t, change.MatchesCredentials(
+		types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{}),
+	))
+}
@@ -26,7 +26,10 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
@@ -127,48 +130,17 @@ type BeaconBlockBody struct {
 	// Graffiti is for a fun message or meme.
 	Graffiti [32]byte
 	// Deposits is the list of deposits included in the body.
-	Deposits []*Deposit
+	Deposits []*Deposit `ssz-max:"16"`
 	// ExecutionPayload is the execution payload of the body.
 	ExecutionPayload *ExecutionPayload
 	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
-	BlobKzgCommitments []eip4844.KZGCommitment
+	BlobKzgCommitments []eip4844.KZGCommitment `ssz-max:"16"`
 }
 
 /* -------------------------------------------------------------------------- */
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
 
-// SizeSSZ returns the size of the BeaconBlockBody in SSZ.
-func (b *BeaconBlockBody) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
-	var size uint32 = 96 + 72 + 32 + 4 + 4 + 4
-	if fixed {
-		return size
-	}
-
-	size += ssz.SizeSliceOfStaticObjects(siz, b.Deposits)
-	size += ssz.SizeDynamicObject(siz, b.ExecutionPayload)
-	size += ssz.SizeSliceOfStaticBytes(siz, b.BlobKzgCommitments)
-	return size
-}
-
-// DefineSSZ defines the SSZ serialization of the BeaconBlockBody.
-//
-//nolint:mnd // TODO: chainspec.
-func (b *BeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
-	// Define the static data (fields and dynamic offsets)
-	ssz.DefineStaticBytes(codec, &b.RandaoReveal)
-	ssz.DefineStaticObject(codec, &b.Eth1Data)
-	ssz.DefineStaticBytes(codec, &b.Graffiti)
-	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.Deposits, 16)
-	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionPayload)
-	ssz.DefineSliceOfStaticBytesOffset(codec, &b.BlobKzgCommitments, 16)
-
-	// Define the dynamic data (fields)
-	ssz.DefineSliceOfStaticObjectsContent(codec, &b.Deposits, 16)
-	ssz.DefineDynamicObjectContent(codec, &b.ExecutionPayload)
-	ssz.DefineSliceOfStaticBytesContent(codec, &b.BlobKzgCommitments, 16)
-}
-
 // MarshalSSZ serializes the BeaconBlockBody to SSZ-encoded bytes.
 func (b *BeaconBlockBody) MarshalSSZ() ([]byte, error) {
 	buf := make([]byte, ssz.Size(b))
@@ -375,3 +347,55 @@ func (b *BeaconBlockBody) GetDeposits() Deposits {
 func (b *BeaconBlockBody) SetDeposits(deposits Deposits) {
 	b.Deposits = deposits
 }
+
+/* -------------------------------------------------------------------------- */
+/*                                    JSON                                    */
+/* -------------------------------------------------------------------------- */
+
+// beaconBlockBodyJSON is the Beacon API encoding of a BeaconBlockBody. The
+// deposits keep the encoding of the genesis deposits, which carry their index
+// in place of the proof of the Beacon API deposits.
+type beaconBlockBodyJSON struct {
+	RandaoReveal       crypto.BLSSignature     `json:"randao_reveal"`
+	Eth1Data           *Eth1Data               `json:"eth1_data"`
+	Graffiti           bytes.B32               `json:"graffiti"`
+	Deposits           []*Deposit              `json:"deposits"`
+	ExecutionPayload   *executionPayloadJSON   `json:"execution_payload"`
+	BlobKzgCommitments []eip4844.KZGCommitment `json:"blob_kzg_commitments"`
+}
+
+// MarshalJSON marshals the BeaconBlockBody as per the Beacon API.
+func (b BeaconBlockBody) MarshalJSON() ([]byte, error) {
+	enc := &beaconBlockBodyJSON{
+		RandaoReveal:       b.RandaoReveal,
+		Eth1Data:           b.Eth1Data,
+		Graffiti:           b.Graffiti,
+		Deposits:           b.Deposits,
+		ExecutionPayload:   newExecutionPayloadJSON(b.ExecutionPayload),
+		BlobKzgCommitments: b.BlobKzgCommitments,
+	}
+	// Lists are never null in the Beacon API.
+	if enc.Deposits == nil {
+		enc.Deposits = []*Deposit{}
+	}
+	if enc.BlobKzgCommitments == nil {
+		enc.BlobKzgCommitments = []eip4844.KZGCommitment{}
+	}
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON unmarshals the BeaconBlockBody from its Beacon API encoding.
+func (b *BeaconBlockBody) UnmarshalJSON(input []byte) error {
+	var dec beaconBlockBodyJSON
+	err := json.Unmarshal(input, &dec)
+	if err != nil {
+		return err
+	}
+	b.RandaoReveal = dec.RandaoReveal
+	b.Eth1Data = dec.Eth1Data
+	b.Graffiti = dec.Graffiti
+	b.Deposits = dec.Deposits
+	b.BlobKzgCommitments = dec.BlobKzgCommitments
+	b.ExecutionPayload, err = dec.ExecutionPayload.executionPayload()
+	return err
+}
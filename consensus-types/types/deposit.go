@@ -111,15 +111,6 @@ func (d *Deposit) VerifySignature(
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
 
-// DefineSSZ defines the SSZ encoding for the Deposit object.
-func (d *Deposit) DefineSSZ(c *ssz.Codec) {
-	ssz.DefineStaticBytes(c, &d.Pubkey)
-	ssz.DefineStaticBytes(c, &d.Credentials)
-	ssz.DefineUint64(c, &d.Amount)
-	ssz.DefineStaticBytes(c, &d.Signature)
-	ssz.DefineUint64(c, &d.Index)
-}
-
 // MarshalSSZ marshals the Deposit object to SSZ format.
 func (d *Deposit) MarshalSSZ() ([]byte, error) {
 	buf := make([]byte, ssz.Size(d))
@@ -131,11 +122,6 @@ func (d *Deposit) UnmarshalSSZ(buf []byte) error {
 	return ssz.DecodeFromBytes(buf, d)
 }
 
-// SizeSSZ returns the SSZ encoded size of the Deposit object.
-func (d *Deposit) SizeSSZ(*ssz.Sizer) uint32 {
-	return DepositSize
-}
-
 // HashTreeRoot computes the Merkleization of the Deposit object.
 func (d *Deposit) HashTreeRoot() common.Root {
 	return ssz.HashSequential(d)
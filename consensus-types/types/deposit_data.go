@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/karalabe/ssz"
+)
+
+// DepositDataSize is the size of the SSZ encoding of a DepositData.
+const DepositDataSize = 184 // 48 + 32 + 8 + 96
+
+// DepositData represents the data submitted to the deposit contract as
+// defined in the Ethereum 2.0 specification. Its hash tree root is the
+// deposit data root commonly used to check a deposit before submitting it.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#depositdata
+type DepositData struct {
+	// Public key of the validator specified in the deposit.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+	// A staking credentials with
+	// 1 byte prefix + 11 bytes padding + 20 bytes address = 32 bytes.
+	Credentials WithdrawalCredentials `json:"withdrawal_credentials"`
+	// Deposit amount in gwei.
+	Amount math.Gwei `json:"amount"`
+	// Signature of the deposit message.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// NewDepositData creates a new DepositData instance.
+func NewDepositData(
+	msg *DepositMessage,
+	signature crypto.BLSSignature,
+) *DepositData {
+	return &DepositData{
+		Pubkey:      msg.Pubkey,
+		Credentials: msg.Credentials,
+		Amount:      msg.Amount,
+		Signature:   signature,
+	}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// HashTreeRoot computes the SSZ hash tree root of the DepositData object.
+func (d *DepositData) HashTreeRoot() common.Root {
+	return ssz.HashSequential(d)
+}
+
+// MarshalSSZ marshals the DepositData object to SSZ format.
+func (d *DepositData) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(d))
+	return buf, ssz.EncodeToBytes(buf, d)
+}
+
+// UnmarshalSSZ unmarshals the DepositData object from SSZ format.
+func (d *DepositData) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, d)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                    JSON                                    */
+/* -------------------------------------------------------------------------- */
+
+// depositDataJSON is the Beacon API encoding of a DepositData.
+type depositDataJSON struct {
+	Pubkey      crypto.BLSPubkey      `json:"pubkey"`
+	Credentials WithdrawalCredentials `json:"withdrawal_credentials"`
+	Amount      uint64                `json:"amount,string"`
+	Signature   crypto.BLSSignature   `json:"signature"`
+}
+
+// MarshalJSON marshals the DepositData as per the Beacon API.
+func (d DepositData) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&depositDataJSON{
+		Pubkey:      d.Pubkey,
+		Credentials: d.Credentials,
+		Amount:      d.Amount.Unwrap(),
+		Signature:   d.Signature,
+	})
+}
+
+// UnmarshalJSON unmarshals the DepositData from its Beacon API encoding.
+func (d *DepositData) UnmarshalJSON(input []byte) error {
+	var dec depositDataJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	d.Pubkey = dec.Pubkey
+	d.Credentials = dec.Credentials
+	d.Amount = math.Gwei(dec.Amount)
+	d.Signature = dec.Signature
+	return nil
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	zcommon "github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/protolambda/ztyp/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositDataHashTreeRoot(t *testing.T) {
+	data := types.NewDepositData(
+		&types.DepositMessage{
+			Pubkey: crypto.BLSPubkey{0x01, 0x02},
+			Credentials: types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{0x03},
+			),
+			Amount: math.Gwei(32e9),
+		},
+		crypto.BLSSignature{0x04, 0x05},
+	)
+
+	expected := (&zcommon.DepositData{
+		Pubkey:                zcommon.BLSPubkey(data.Pubkey),
+		WithdrawalCredentials: tree.Root(data.Credentials),
+		Amount:                zcommon.Gwei(data.Amount),
+		Signature:             zcommon.BLSSignature(data.Signature),
+	}).HashTreeRoot(tree.GetHashFn())
+	require.Equal(t, common.Root(expected), data.HashTreeRoot())
+
+	bz, err := data.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, bz, types.DepositDataSize)
+
+	decoded := new(types.DepositData)
+	require.NoError(t, decoded.UnmarshalSSZ(bz))
+	require.Equal(t, data, decoded)
+}
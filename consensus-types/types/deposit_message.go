@@ -21,6 +21,8 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
@@ -36,7 +38,7 @@ type DepositMessage struct {
 	Pubkey crypto.BLSPubkey `json:"pubkey"`
 	// A staking credentials with
 	// 1 byte prefix + 11 bytes padding + 20 bytes address = 32 bytes.
-	Credentials WithdrawalCredentials `json:"credentials"`
+	Credentials WithdrawalCredentials `json:"withdrawal_credentials"`
 	// Deposit amount in gwei.
 	Amount math.Gwei `json:"amount"`
 }
@@ -82,19 +84,6 @@ func (dm *DepositMessage) New(
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
 
-// SizeSSZ returns the size of the DepositMessage object in SSZ encoding.
-func (*DepositMessage) SizeSSZ(*ssz.Sizer) uint32 {
-	//nolint:mnd // 48 + 32 + 8 = 88.
-	return 88
-}
-
-// DefineSSZ defines the SSZ encoding for the DepositMessage object.
-func (dm *DepositMessage) DefineSSZ(codec *ssz.Codec) {
-	ssz.DefineStaticBytes(codec, &dm.Pubkey)
-	ssz.DefineStaticBytes(codec, &dm.Credentials)
-	ssz.DefineUint64(codec, &dm.Amount)
-}
-
 // HashTreeRoot computes the SSZ hash tree root of the DepositMessage object.
 func (dm *DepositMessage) HashTreeRoot() common.Root {
 	return ssz.HashSequential(dm)
@@ -138,3 +127,35 @@ func (dm *DepositMessage) VerifyCreateValidator(
 
 	return nil
 }
+
+/* -------------------------------------------------------------------------- */
+/*                                    JSON                                    */
+/* -------------------------------------------------------------------------- */
+
+// depositMessageJSON is the Beacon API encoding of a DepositMessage.
+type depositMessageJSON struct {
+	Pubkey      crypto.BLSPubkey      `json:"pubkey"`
+	Credentials WithdrawalCredentials `json:"withdrawal_credentials"`
+	Amount      uint64                `json:"amount,string"`
+}
+
+// MarshalJSON marshals the DepositMessage as per the Beacon API.
+func (d DepositMessage) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&depositMessageJSON{
+		Pubkey:      d.Pubkey,
+		Credentials: d.Credentials,
+		Amount:      d.Amount.Unwrap(),
+	})
+}
+
+// UnmarshalJSON unmarshals the DepositMessage from its Beacon API encoding.
+func (d *DepositMessage) UnmarshalJSON(input []byte) error {
+	var dec depositMessageJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	d.Pubkey = dec.Pubkey
+	d.Credentials = dec.Credentials
+	d.Amount = math.Gwei(dec.Amount)
+	return nil
+}
@@ -42,4 +42,14 @@ var (
 
 	// ErrNilPayloadHeader is an error for when the payload header is nil.
 	ErrNilPayloadHeader = errors.New("nil payload header")
+
+	// ErrBodyRootMismatch is an error for when the block body does not hash
+	// to the body root of the block header.
+	ErrBodyRootMismatch = errors.New("body root mismatch")
+
+	// ErrKZGCommitmentIndexOutOfRange is an error for when the KZG commitment
+	// to prove is not in the block body.
+	ErrKZGCommitmentIndexOutOfRange = errors.New(
+		"kzg commitment index out of range",
+	)
 )
@@ -21,6 +21,8 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -39,11 +41,11 @@ var (
 
 type Eth1Data struct {
 	// DepositRoot is the root of the deposit tree.
-	DepositRoot common.Root `json:"depositRoot"`
+	DepositRoot common.Root `json:"deposit_root"`
 	// DepositCount is the number of deposits in the deposit tree.
-	DepositCount math.U64 `json:"depositCount"`
+	DepositCount math.U64 `json:"deposit_count"`
 	// BlockHash is the hash of the block corresponding to the Eth1Data.
-	BlockHash common.ExecutionHash `json:"blockHash"`
+	BlockHash common.ExecutionHash `json:"block_hash"`
 }
 
 /* -------------------------------------------------------------------------- */
@@ -73,18 +75,6 @@ func (e *Eth1Data) New(
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
 
-// SizeSSZ returns the size of the Eth1Data object in SSZ encoding.
-func (*Eth1Data) SizeSSZ(*ssz.Sizer) uint32 {
-	return Eth1DataSize
-}
-
-// DefineSSZ defines the SSZ encoding for the Eth1Data object.
-func (e *Eth1Data) DefineSSZ(codec *ssz.Codec) {
-	ssz.DefineStaticBytes(codec, &e.DepositRoot)
-	ssz.DefineUint64(codec, &e.DepositCount)
-	ssz.DefineStaticBytes(codec, &e.BlockHash)
-}
-
 // HashTreeRoot computes the SSZ hash tree root of the Eth1Data object.
 func (e *Eth1Data) HashTreeRoot() common.Root {
 	return ssz.HashSequential(e)
@@ -140,3 +130,35 @@ func (e *Eth1Data) GetTree() (*fastssz.Node, error) {
 func (e *Eth1Data) GetDepositCount() math.U64 {
 	return e.DepositCount
 }
+
+/* -------------------------------------------------------------------------- */
+/*                                    JSON                                    */
+/* -------------------------------------------------------------------------- */
+
+// eth1DataJSON is the Beacon API encoding of an Eth1Data.
+type eth1DataJSON struct {
+	DepositRoot  common.Root          `json:"deposit_root"`
+	DepositCount uint64               `json:"deposit_count,string"`
+	BlockHash    common.ExecutionHash `json:"block_hash"`
+}
+
+// MarshalJSON marshals the Eth1Data as per the Beacon API.
+func (e Eth1Data) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&eth1DataJSON{
+		DepositRoot:  e.DepositRoot,
+		DepositCount: e.DepositCount.Unwrap(),
+		BlockHash:    e.BlockHash,
+	})
+}
+
+// UnmarshalJSON unmarshals the Eth1Data from its Beacon API encoding.
+func (e *Eth1Data) UnmarshalJSON(input []byte) error {
+	var dec eth1DataJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	e.DepositRoot = dec.DepositRoot
+	e.DepositCount = math.U64(dec.DepositCount)
+	e.BlockHash = dec.BlockHash
+	return nil
+}
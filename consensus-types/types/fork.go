@@ -21,6 +21,8 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -74,18 +76,6 @@ func NewFork(
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
 
-// SizeSSZ returns the SSZ encoded size of the Fork object in bytes.
-func (f *Fork) SizeSSZ(*ssz.Sizer) uint32 {
-	return ForkSize
-}
-
-// DefineSSZ defines the SSZ encoding for the Fork object.
-func (f *Fork) DefineSSZ(codec *ssz.Codec) {
-	ssz.DefineStaticBytes(codec, &f.PreviousVersion)
-	ssz.DefineStaticBytes(codec, &f.CurrentVersion)
-	ssz.DefineUint64(codec, &f.Epoch)
-}
-
 // MarshalSSZ marshals the Fork object to SSZ format.
 func (f *Fork) MarshalSSZ() ([]byte, error) {
 	buf := make([]byte, ssz.Size(f))
@@ -137,3 +127,35 @@ func (f *Fork) HashTreeRootWith(hh fastssz.HashWalker) error {
 func (f *Fork) GetTree() (*fastssz.Node, error) {
 	return fastssz.ProofTree(f)
 }
+
+/* -------------------------------------------------------------------------- */
+/*                                    JSON                                    */
+/* -------------------------------------------------------------------------- */
+
+// forkJSON is the Beacon API encoding of a Fork.
+type forkJSON struct {
+	PreviousVersion common.Version `json:"previous_version"`
+	CurrentVersion  common.Version `json:"current_version"`
+	Epoch           uint64         `json:"epoch,string"`
+}
+
+// MarshalJSON marshals the Fork as per the Beacon API.
+func (f Fork) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&forkJSON{
+		PreviousVersion: f.PreviousVersion,
+		CurrentVersion:  f.CurrentVersion,
+		Epoch:           f.Epoch.Unwrap(),
+	})
+}
+
+// UnmarshalJSON unmarshals the Fork from its Beacon API encoding.
+func (f *Fork) UnmarshalJSON(input []byte) error {
+	var dec forkJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	f.PreviousVersion = dec.PreviousVersion
+	f.CurrentVersion = dec.CurrentVersion
+	f.Epoch = math.Epoch(dec.Epoch)
+	return nil
+}
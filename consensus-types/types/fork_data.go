@@ -23,6 +23,7 @@ package types
 import (
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/signing"
 	"github.com/karalabe/ssz"
 )
 
@@ -45,22 +46,22 @@ func NewForkData(
 	}
 }
 
+// NewForkDataAtEpoch creates the ForkData of the fork active at the given
+// epoch in the fork schedule of the chain spec.
+func NewForkDataAtEpoch(
+	fs signing.ForkSchedule,
+	epoch math.Epoch,
+	genesisValidatorsRoot common.Root,
+) *ForkData {
+	return NewForkData(
+		signing.ForkVersionAtEpoch(fs, epoch), genesisValidatorsRoot,
+	)
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
 
-// SizeSSZ returns the size of the SigningData object in SSZ encoding.
-func (*ForkData) SizeSSZ(*ssz.Sizer) uint32 {
-	//nolint:mnd // 32+4 = 36.
-	return 36
-}
-
-// DefineSSZ defines the SSZ encoding for the ForkData object.
-func (fd *ForkData) DefineSSZ(codec *ssz.Codec) {
-	ssz.DefineStaticBytes(codec, &fd.CurrentVersion)
-	ssz.DefineStaticBytes(codec, &fd.GenesisValidatorsRoot)
-}
-
 // HashTreeRoot computes the SSZ hash tree root of the ForkData object.
 func (fd *ForkData) HashTreeRoot() common.Root {
 	return ssz.HashSequential(fd)
@@ -88,11 +89,16 @@ func (fd *ForkData) UnmarshalSSZ(buf []byte) error {
 func (fd *ForkData) ComputeDomain(
 	domainType common.DomainType,
 ) common.Domain {
-	forkDataRoot := fd.HashTreeRoot()
-	return common.Domain(
-		append(
-			domainType[:],
-			forkDataRoot[:28]...),
+	return signing.ComputeDomain(
+		domainType, fd.CurrentVersion, fd.GenesisValidatorsRoot,
+	)
+}
+
+// ComputeForkDigest as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_fork_digest
+func (fd *ForkData) ComputeForkDigest() common.ForkDigest {
+	return signing.ComputeForkDigest(
+		fd.CurrentVersion, fd.GenesisValidatorsRoot,
 	)
 }
 
@@ -87,6 +87,22 @@ func TestForkData_ComputeDomain(t *testing.T) {
 	})
 }
 
+func TestForkData_ComputeForkDigest(t *testing.T) {
+	forkData := &types.ForkData{
+		CurrentVersion:        common.Version{0x04, 0x00, 0x00, 0x00},
+		GenesisValidatorsRoot: common.Root{0x01, 0x02, 0x03},
+	}
+	root := forkData.HashTreeRoot()
+	require.Equal(
+		t, common.ForkDigest(root[:4]), forkData.ComputeForkDigest(),
+	)
+
+	domainType := common.DomainType{0x01, 0x00, 0x00, 0x00}
+	domain := forkData.ComputeDomain(domainType)
+	require.Equal(t, domainType[:], domain[:4])
+	require.Equal(t, root[:28], domain[4:])
+}
+
 func TestForkData_ComputeRandaoSigningRoot(t *testing.T) {
 	fd := &types.ForkData{
 		CurrentVersion:        common.Version{},
@@ -0,0 +1,17 @@
+// Code generated by github.com/karalabe/ssz. DO NOT EDIT.
+
+package types
+
+import "github.com/karalabe/ssz"
+
+// SizeSSZ returns the total size of the static ssz object.
+func (obj *AttestationData) SizeSSZ(sizer *ssz.Sizer) uint32 {
+	return 8 + 8 + 32
+}
+
+// DefineSSZ defines how an object is encoded/decoded.
+func (obj *AttestationData) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &obj.Slot)                 // Field  (0) -            Slot -  8 bytes
+	ssz.DefineUint64(codec, &obj.Index)                // Field  (1) -           Index -  8 bytes
+	ssz.DefineStaticBytes(codec, &obj.BeaconBlockRoot) // Field  (2) - BeaconBlockRoot - 32 bytes
+}
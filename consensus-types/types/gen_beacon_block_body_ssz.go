@@ -0,0 +1,44 @@
+// Code generated by github.com/karalabe/ssz. DO NOT EDIT.
+
+package types
+
+import "github.com/karalabe/ssz"
+
+// Cached static size computed on package init.
+var staticSizeCacheBeaconBlockBody = ssz.PrecomputeStaticSizeCache((*BeaconBlockBody)(nil))
+
+// SizeSSZ returns either the static size of the object if fixed == true, or
+// the total size otherwise.
+func (obj *BeaconBlockBody) SizeSSZ(sizer *ssz.Sizer, fixed bool) (size uint32) {
+	// Load static size if already precomputed, calculate otherwise
+	if fork := int(sizer.Fork()); fork < len(staticSizeCacheBeaconBlockBody) {
+		size = staticSizeCacheBeaconBlockBody[fork]
+	} else {
+		size = 96 + (*Eth1Data)(nil).SizeSSZ(sizer) + 32 + 4 + 4 + 4
+	}
+	// Either return the static size or accumulate the dynamic too
+	if fixed {
+		return size
+	}
+	size += ssz.SizeSliceOfStaticObjects(sizer, obj.Deposits)
+	size += ssz.SizeDynamicObject(sizer, obj.ExecutionPayload)
+	size += ssz.SizeSliceOfStaticBytes(sizer, obj.BlobKzgCommitments)
+
+	return size
+}
+
+// DefineSSZ defines how an object is encoded/decoded.
+func (obj *BeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineStaticBytes(codec, &obj.RandaoReveal)                        // Field  (0) -       RandaoReveal - 96 bytes
+	ssz.DefineStaticObject(codec, &obj.Eth1Data)                           // Field  (1) -           Eth1Data -  ? bytes (Eth1Data)
+	ssz.DefineStaticBytes(codec, &obj.Graffiti)                            // Field  (2) -           Graffiti - 32 bytes
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &obj.Deposits, 16)         // Offset (3) -           Deposits -  4 bytes
+	ssz.DefineDynamicObjectOffset(codec, &obj.ExecutionPayload)            // Offset (4) -   ExecutionPayload -  4 bytes
+	ssz.DefineSliceOfStaticBytesOffset(codec, &obj.BlobKzgCommitments, 16) // Offset (5) - BlobKzgCommitments -  4 bytes
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &obj.Deposits, 16)         // Field  (3) -           Deposits - ? bytes
+	ssz.DefineDynamicObjectContent(codec, &obj.ExecutionPayload)            // Field  (4) -   ExecutionPayload - ? bytes
+	ssz.DefineSliceOfStaticBytesContent(codec, &obj.BlobKzgCommitments, 16) // Field  (5) - BlobKzgCommitments - ? bytes
+}
@@ -0,0 +1,62 @@
+// Code generated by github.com/karalabe/ssz. DO NOT EDIT.
+
+package types
+
+import "github.com/karalabe/ssz"
+
+// Cached static size computed on package init.
+var staticSizeCacheBeaconState = ssz.PrecomputeStaticSizeCache((*BeaconState)(nil))
+
+// SizeSSZ returns either the static size of the object if fixed == true, or
+// the total size otherwise.
+func (obj *BeaconState) SizeSSZ(sizer *ssz.Sizer, fixed bool) (size uint32) {
+	// Load static size if already precomputed, calculate otherwise
+	if fork := int(sizer.Fork()); fork < len(staticSizeCacheBeaconState) {
+		size = staticSizeCacheBeaconState[fork]
+	} else {
+		size = 32 + 8 + (*Fork)(nil).SizeSSZ(sizer) + (*BeaconBlockHeader)(nil).SizeSSZ(sizer) + 4 + 4 + (*Eth1Data)(nil).SizeSSZ(sizer) + 8 + 4 + 4 + 4 + 4 + 8 + 8 + 4 + 8
+	}
+	// Either return the static size or accumulate the dynamic too
+	if fixed {
+		return size
+	}
+	size += ssz.SizeSliceOfStaticBytes(sizer, obj.BlockRoots)
+	size += ssz.SizeSliceOfStaticBytes(sizer, obj.StateRoots)
+	size += ssz.SizeDynamicObject(sizer, obj.LatestExecutionPayloadHeader)
+	size += ssz.SizeSliceOfStaticObjects(sizer, obj.Validators)
+	size += ssz.SizeSliceOfUint64s(sizer, obj.Balances)
+	size += ssz.SizeSliceOfStaticBytes(sizer, obj.RandaoMixes)
+	size += ssz.SizeSliceOfUint64s(sizer, obj.Slashings)
+
+	return size
+}
+
+// DefineSSZ defines how an object is encoded/decoded.
+func (obj *BeaconState) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineStaticBytes(codec, &obj.GenesisValidatorsRoot)                    // Field  ( 0) -        GenesisValidatorsRoot - 32 bytes
+	ssz.DefineUint64(codec, &obj.Slot)                                          // Field  ( 1) -                         Slot -  8 bytes
+	ssz.DefineStaticObject(codec, &obj.Fork)                                    // Field  ( 2) -                         Fork -  ? bytes (Fork)
+	ssz.DefineStaticObject(codec, &obj.LatestBlockHeader)                       // Field  ( 3) -            LatestBlockHeader -  ? bytes (BeaconBlockHeader)
+	ssz.DefineSliceOfStaticBytesOffset(codec, &obj.BlockRoots, 8192)            // Offset ( 4) -                   BlockRoots -  4 bytes
+	ssz.DefineSliceOfStaticBytesOffset(codec, &obj.StateRoots, 8192)            // Offset ( 5) -                   StateRoots -  4 bytes
+	ssz.DefineStaticObject(codec, &obj.Eth1Data)                                // Field  ( 6) -                     Eth1Data -  ? bytes (Eth1Data)
+	ssz.DefineUint64(codec, &obj.Eth1DepositIndex)                              // Field  ( 7) -             Eth1DepositIndex -  8 bytes
+	ssz.DefineDynamicObjectOffset(codec, &obj.LatestExecutionPayloadHeader)     // Offset ( 8) - LatestExecutionPayloadHeader -  4 bytes
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &obj.Validators, 1099511627776) // Offset ( 9) -                   Validators -  4 bytes
+	ssz.DefineSliceOfUint64sOffset(codec, &obj.Balances, 1099511627776)         // Offset (10) -                     Balances -  4 bytes
+	ssz.DefineSliceOfStaticBytesOffset(codec, &obj.RandaoMixes, 65536)          // Offset (11) -                  RandaoMixes -  4 bytes
+	ssz.DefineUint64(codec, &obj.NextWithdrawalIndex)                           // Field  (12) -          NextWithdrawalIndex -  8 bytes
+	ssz.DefineUint64(codec, &obj.NextWithdrawalValidatorIndex)                  // Field  (13) - NextWithdrawalValidatorIndex -  8 bytes
+	ssz.DefineSliceOfUint64sOffset(codec, &obj.Slashings, 1099511627776)        // Offset (14) -                    Slashings -  4 bytes
+	ssz.DefineUint64(codec, &obj.TotalSlashing)                                 // Field  (15) -                TotalSlashing -  8 bytes
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticBytesContent(codec, &obj.BlockRoots, 8192)            // Field  ( 4) -                   BlockRoots - ? bytes
+	ssz.DefineSliceOfStaticBytesContent(codec, &obj.StateRoots, 8192)            // Field  ( 5) -                   StateRoots - ? bytes
+	ssz.DefineDynamicObjectContent(codec, &obj.LatestExecutionPayloadHeader)     // Field  ( 8) - LatestExecutionPayloadHeader - ? bytes
+	ssz.DefineSliceOfStaticObjectsContent(codec, &obj.Validators, 1099511627776) // Field  ( 9) -                   Validators - ? bytes
+	ssz.DefineSliceOfUint64sContent(codec, &obj.Balances, 1099511627776)         // Field  (10) -                     Balances - ? bytes
+	ssz.DefineSliceOfStaticBytesContent(codec, &obj.RandaoMixes, 65536)          // Field  (11) -                  RandaoMixes - ? bytes
+	ssz.DefineSliceOfUint64sContent(codec, &obj.Slashings, 1099511627776)        // Field  (14) -                    Slashings - ? bytes
+}
@@ -0,0 +1,17 @@
+// Code generated by github.com/karalabe/ssz. DO NOT EDIT.
+
+package types
+
+import "github.com/karalabe/ssz"
+
+// SizeSSZ returns the total size of the static ssz object.
+func (obj *BLSToExecutionChange) SizeSSZ(sizer *ssz.Sizer) uint32 {
+	return 8 + 48 + 20
+}
+
+// DefineSSZ defines how an object is encoded/decoded.
+func (obj *BLSToExecutionChange) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &obj.ValidatorIndex)          // Field  (0) -     ValidatorIndex -  8 bytes
+	ssz.DefineStaticBytes(codec, &obj.FromBLSPubkey)      // Field  (1) -      FromBLSPubkey - 48 bytes
+	ssz.DefineStaticBytes(codec, &obj.ToExecutionAddress) // Field  (2) - ToExecutionAddress - 20 bytes
+}
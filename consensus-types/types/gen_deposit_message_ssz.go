@@ -0,0 +1,17 @@
+// Code generated by github.com/karalabe/ssz. DO NOT EDIT.
+
+package types
+
+import "github.com/karalabe/ssz"
+
+// SizeSSZ returns the total size of the static ssz object.
+func (obj *DepositMessage) SizeSSZ(sizer *ssz.Sizer) uint32 {
+	return 48 + 32 + 8
+}
+
+// DefineSSZ defines how an object is encoded/decoded.
+func (obj *DepositMessage) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &obj.Pubkey)      // Field  (0) -      Pubkey - 48 bytes
+	ssz.DefineStaticBytes(codec, &obj.Credentials) // Field  (1) - Credentials - 32 bytes
+	ssz.DefineUint64(codec, &obj.Amount)           // Field  (2) -      Amount -  8 bytes
+}
@@ -0,0 +1,16 @@
+// Code generated by github.com/karalabe/ssz. DO NOT EDIT.
+
+package types
+
+import "github.com/karalabe/ssz"
+
+// SizeSSZ returns the total size of the static ssz object.
+func (obj *ForkData) SizeSSZ(sizer *ssz.Sizer) uint32 {
+	return 4 + 32
+}
+
+// DefineSSZ defines how an object is encoded/decoded.
+func (obj *ForkData) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &obj.CurrentVersion)        // Field  (0) -        CurrentVersion -  4 bytes
+	ssz.DefineStaticBytes(codec, &obj.GenesisValidatorsRoot) // Field  (1) - GenesisValidatorsRoot - 32 bytes
+}
@@ -0,0 +1,16 @@
+// Code generated by github.com/karalabe/ssz. DO NOT EDIT.
+
+package types
+
+import "github.com/karalabe/ssz"
+
+// SizeSSZ returns the total size of the static ssz object.
+func (obj *SigningData) SizeSSZ(sizer *ssz.Sizer) uint32 {
+	return 32 + 32
+}
+
+// DefineSSZ defines how an object is encoded/decoded.
+func (obj *SigningData) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &obj.ObjectRoot) // Field  (0) - ObjectRoot - 32 bytes
+	ssz.DefineStaticBytes(codec, &obj.Domain)     // Field  (1) -     Domain - 32 bytes
+}
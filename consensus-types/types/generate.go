@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+// The SSZ encodings of the consensus types, but the ones needing custom
+// handling, are generated from their definitions with the ssz-max and
+// ssz-size struct tags. The generator does not support the type aliases
+// materialized since Go 1.23, hence the GODEBUG setting.
+//go:generate env GODEBUG=gotypesalias=0 go run github.com/karalabe/ssz/cmd/sszgen -type AttestationData -out gen_attestation_data_ssz.go
+//go:generate env GODEBUG=gotypesalias=0 go run github.com/karalabe/ssz/cmd/sszgen -type BeaconBlock -out gen_beacon_block_ssz.go
+//go:generate env GODEBUG=gotypesalias=0 go run github.com/karalabe/ssz/cmd/sszgen -type BeaconBlockBody -out gen_beacon_block_body_ssz.go
+//go:generate env GODEBUG=gotypesalias=0 go run github.com/karalabe/ssz/cmd/sszgen -type BeaconBlockHeader -out gen_beacon_block_header_ssz.go
+//go:generate env GODEBUG=gotypesalias=0 go run github.com/karalabe/ssz/cmd/sszgen -type BeaconState -out gen_beacon_state_ssz.go
+//go:generate env GODEBUG=gotypesalias=0 go run github.com/karalabe/ssz/cmd/sszgen -type BLSToExecutionChange -out gen_bls_to_execution_change_ssz.go
+//go:generate env GODEBUG=gotypesalias=0 go run github.com/karalabe/ssz/cmd/sszgen -type Deposit -out gen_deposit_ssz.go
+//go:generate env GODEBUG=gotypesalias=0 go run github.com/karalabe/ssz/cmd/sszgen -type DepositData -out gen_deposit_data_ssz.go
+//go:generate env GODEBUG=gotypesalias=0 go run github.com/karalabe/ssz/cmd/sszgen -type DepositMessage -out gen_deposit_message_ssz.go
+//go:generate env GODEBUG=gotypesalias=0 go run github.com/karalabe/ssz/cmd/sszgen -type Eth1Data -out gen_eth1_data_ssz.go
+//go:generate env GODEBUG=gotypesalias=0 go run github.com/karalabe/ssz/cmd/sszgen -type Fork -out gen_fork_ssz.go
+//go:generate env GODEBUG=gotypesalias=0 go run github.com/karalabe/ssz/cmd/sszgen -type ForkData -out gen_fork_data_ssz.go
+//go:generate env GODEBUG=gotypesalias=0 go run github.com/karalabe/ssz/cmd/sszgen -type SignedBeaconBlockHeader -out gen_signed_beacon_block_header_ssz.go
+//go:generate env GODEBUG=gotypesalias=0 go run github.com/karalabe/ssz/cmd/sszgen -type SignedBLSToExecutionChange -out gen_signed_bls_to_execution_change_ssz.go
+//go:generate env GODEBUG=gotypesalias=0 go run github.com/karalabe/ssz/cmd/sszgen -type SignedVoluntaryExit -out gen_signed_voluntary_exit_ssz.go
+//go:generate env GODEBUG=gotypesalias=0 go run github.com/karalabe/ssz/cmd/sszgen -type SigningData -out gen_signing_data_ssz.go
+//go:generate env GODEBUG=gotypesalias=0 go run github.com/karalabe/ssz/cmd/sszgen -type SlashingInfo -out gen_slashing_info_ssz.go
+//go:generate env GODEBUG=gotypesalias=0 go run github.com/karalabe/ssz/cmd/sszgen -type Validator -out gen_validator_ssz.go
+//go:generate env GODEBUG=gotypesalias=0 go run github.com/karalabe/ssz/cmd/sszgen -type VoluntaryExit -out gen_voluntary_exit_ssz.go
@@ -21,6 +21,8 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -46,7 +48,7 @@ type BeaconBlockHeader struct {
 	// ProposerIndex is the index of the validator who proposed the block.
 	ProposerIndex math.ValidatorIndex `json:"proposer_index"`
 	// ParentBlockRoot is the hash of the parent block
-	ParentBlockRoot common.Root `json:"parent_block_root"`
+	ParentBlockRoot common.Root `json:"parent_root"`
 	// StateRoot is the hash of the state at the block.
 	StateRoot common.Root `json:"state_root"`
 	// BodyRoot is the root of the block body.
@@ -96,20 +98,6 @@ func (b *BeaconBlockHeader) New(
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
 
-// SizeSSZ returns the size of the BeaconBlockHeader object in SSZ encoding.
-func (b *BeaconBlockHeader) SizeSSZ(*ssz.Sizer) uint32 {
-	return BeaconBlockHeaderSize
-}
-
-// DefineSSZ defines the SSZ encoding for the BeaconBlockHeader object.
-func (b *BeaconBlockHeader) DefineSSZ(codec *ssz.Codec) {
-	ssz.DefineUint64(codec, &b.Slot)
-	ssz.DefineUint64(codec, &b.ProposerIndex)
-	ssz.DefineStaticBytes(codec, &b.ParentBlockRoot)
-	ssz.DefineStaticBytes(codec, &b.StateRoot)
-	ssz.DefineStaticBytes(codec, &b.BodyRoot)
-}
-
 // MarshalSSZ marshals the BeaconBlockBody object to SSZ format.
 func (b *BeaconBlockHeader) MarshalSSZ() ([]byte, error) {
 	buf := make([]byte, ssz.Size(b))
@@ -170,6 +158,45 @@ func (b *BeaconBlockHeader) GetTree() (*fastssz.Node, error) {
 	return fastssz.ProofTree(b)
 }
 
+/* -------------------------------------------------------------------------- */
+/*                                    JSON                                    */
+/* -------------------------------------------------------------------------- */
+
+// beaconBlockHeaderJSON is the Beacon API encoding of a BeaconBlockHeader.
+type beaconBlockHeaderJSON struct {
+	Slot            uint64      `json:"slot,string"`
+	ProposerIndex   uint64      `json:"proposer_index,string"`
+	ParentBlockRoot common.Root `json:"parent_root"`
+	StateRoot       common.Root `json:"state_root"`
+	BodyRoot        common.Root `json:"body_root"`
+}
+
+// MarshalJSON marshals the BeaconBlockHeader as per the Beacon API.
+func (b BeaconBlockHeader) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&beaconBlockHeaderJSON{
+		Slot:            b.Slot.Unwrap(),
+		ProposerIndex:   b.ProposerIndex.Unwrap(),
+		ParentBlockRoot: b.ParentBlockRoot,
+		StateRoot:       b.StateRoot,
+		BodyRoot:        b.BodyRoot,
+	})
+}
+
+// UnmarshalJSON unmarshals the BeaconBlockHeader from its Beacon API
+// encoding.
+func (b *BeaconBlockHeader) UnmarshalJSON(input []byte) error {
+	var dec beaconBlockHeaderJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	b.Slot = math.Slot(dec.Slot)
+	b.ProposerIndex = math.ValidatorIndex(dec.ProposerIndex)
+	b.ParentBlockRoot = dec.ParentBlockRoot
+	b.StateRoot = dec.StateRoot
+	b.BodyRoot = dec.BodyRoot
+	return nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                            Getters and Setters                             */
 /* -------------------------------------------------------------------------- */
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconAPIJSON_Encoding(t *testing.T) {
+	header := &types.BeaconBlockHeader{
+		Slot:            10,
+		ProposerIndex:   5,
+		ParentBlockRoot: common.Root{1},
+	}
+	bz, err := json.Marshal(header)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"slot": "10",
+		"proposer_index": "5",
+		"parent_root": "0x0100000000000000000000000000000000000000000000000000000000000000",
+		"state_root": "0x0000000000000000000000000000000000000000000000000000000000000000",
+		"body_root": "0x0000000000000000000000000000000000000000000000000000000000000000"
+	}`, string(bz))
+
+	fork := types.NewFork(
+		common.Version{1}, common.Version{2}, math.Epoch(18446744073709551615),
+	)
+	bz, err = json.Marshal(fork)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"previous_version": "0x01000000",
+		"current_version": "0x02000000",
+		"epoch": "18446744073709551615"
+	}`, string(bz))
+
+	// Hexadecimal numbers are not part of the Beacon API.
+	require.Error(t, json.Unmarshal(
+		[]byte(`{"slot":"0xa","proposer_index":"5"}`),
+		new(types.BeaconBlockHeader),
+	))
+	require.Error(t, json.Unmarshal(
+		[]byte(`{"epoch":3}`), new(types.Fork),
+	))
+}
+
+func TestBeaconAPIJSON_BeaconBlockRoundTrip(t *testing.T) {
+	blk := generateValidBeaconBlock()
+	blk.Body.Graffiti = [32]byte{7}
+	blk.Body.ExecutionPayload.BaseFeePerGas = math.NewU256(1_000_000_007)
+	blk.Body.ExecutionPayload.Withdrawals = engineprimitives.Withdrawals{
+		{Index: 3, Validator: 4, Address: common.ExecutionAddress{5}, Amount: 6},
+	}
+
+	bz, err := json.Marshal(blk)
+	require.NoError(t, err)
+
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(bz, &fields))
+	require.Equal(t, "10", fields["slot"])
+	body, ok := fields["body"].(map[string]any)
+	require.True(t, ok)
+	payload, ok := body["execution_payload"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "1000000007", payload["base_fee_per_gas"])
+	require.Equal(t, "10", payload["timestamp"])
+	require.Equal(t, []any{map[string]any{
+		"index":           "3",
+		"validator_index": "4",
+		"address":         "0x0500000000000000000000000000000000000000",
+		"amount":          "6",
+	}}, payload["withdrawals"])
+
+	decoded := new(types.BeaconBlock)
+	require.NoError(t, json.Unmarshal(bz, decoded))
+	require.Equal(t, blk.HashTreeRoot(), decoded.HashTreeRoot())
+
+	// The Engine API encoding of the execution payload is kept.
+	bz, err = json.Marshal(blk.Body.ExecutionPayload)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"baseFeePerGas":"0x3b9aca07"`)
+}
+
+func TestBeaconAPIJSON_ValidatorRoundTrip(t *testing.T) {
+	validator := &types.Validator{
+		Pubkey:                     [48]byte{1},
+		WithdrawalCredentials:      types.WithdrawalCredentials{2},
+		EffectiveBalance:           32_000_000_000,
+		Slashed:                    true,
+		ActivationEligibilityEpoch: 1,
+		ActivationEpoch:            2,
+		ExitEpoch:                  math.Epoch(18446744073709551615),
+		WithdrawableEpoch:          4,
+	}
+	bz, err := json.Marshal(validator)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"effective_balance":"32000000000"`)
+	require.Contains(t, string(bz), `"exit_epoch":"18446744073709551615"`)
+
+	decoded := new(types.Validator)
+	require.NoError(t, json.Unmarshal(bz, decoded))
+	require.Equal(t, validator, decoded)
+}
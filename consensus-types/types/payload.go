@@ -453,6 +453,120 @@ func (p *ExecutionPayload) UnmarshalJSON(input []byte) error {
 	return nil
 }
 
+// executionPayloadJSON is the Beacon API encoding of an ExecutionPayload, as
+// found in beacon block bodies. It differs from the Engine API encoding of
+// MarshalJSON in its field names and decimal numbers.
+type executionPayloadJSON struct {
+	ParentHash    common.ExecutionHash    `json:"parent_hash"`
+	FeeRecipient  common.ExecutionAddress `json:"fee_recipient"`
+	StateRoot     bytes.B32               `json:"state_root"`
+	ReceiptsRoot  bytes.B32               `json:"receipts_root"`
+	LogsBloom     bytes.B256              `json:"logs_bloom"`
+	Random        bytes.B32               `json:"prev_randao"`
+	Number        uint64                  `json:"block_number,string"`
+	GasLimit      uint64                  `json:"gas_limit,string"`
+	GasUsed       uint64                  `json:"gas_used,string"`
+	Timestamp     uint64                  `json:"timestamp,string"`
+	ExtraData     bytes.Bytes             `json:"extra_data"`
+	BaseFeePerGas *math.U256Dec           `json:"base_fee_per_gas"`
+	BlockHash     common.ExecutionHash    `json:"block_hash"`
+	Transactions  []bytes.Bytes           `json:"transactions"`
+	Withdrawals   []*withdrawalJSON       `json:"withdrawals"`
+	BlobGasUsed   uint64                  `json:"blob_gas_used,string"`
+	ExcessBlobGas uint64                  `json:"excess_blob_gas,string"`
+}
+
+// withdrawalJSON is the Beacon API encoding of a withdrawal.
+type withdrawalJSON struct {
+	Index          uint64                  `json:"index,string"`
+	ValidatorIndex uint64                  `json:"validator_index,string"`
+	Address        common.ExecutionAddress `json:"address"`
+	Amount         uint64                  `json:"amount,string"`
+}
+
+// newExecutionPayloadJSON returns the Beacon API encoding of the given
+// execution payload, or nil if it is nil.
+func newExecutionPayloadJSON(p *ExecutionPayload) *executionPayloadJSON {
+	if p == nil {
+		return nil
+	}
+	enc := &executionPayloadJSON{
+		ParentHash:    p.ParentHash,
+		FeeRecipient:  p.FeeRecipient,
+		StateRoot:     p.StateRoot,
+		ReceiptsRoot:  p.ReceiptsRoot,
+		LogsBloom:     p.LogsBloom,
+		Random:        p.Random,
+		Number:        p.Number.Unwrap(),
+		GasLimit:      p.GasLimit.Unwrap(),
+		GasUsed:       p.GasUsed.Unwrap(),
+		Timestamp:     p.Timestamp.Unwrap(),
+		ExtraData:     p.ExtraData,
+		BaseFeePerGas: (*math.U256Dec)(p.BaseFeePerGas),
+		BlockHash:     p.BlockHash,
+		Transactions:  make([]bytes.Bytes, len(p.Transactions)),
+		Withdrawals:   make([]*withdrawalJSON, len(p.Withdrawals)),
+		BlobGasUsed:   p.BlobGasUsed.Unwrap(),
+		ExcessBlobGas: p.ExcessBlobGas.Unwrap(),
+	}
+	for k, v := range p.Transactions {
+		enc.Transactions[k] = v
+	}
+	for k, w := range p.Withdrawals {
+		enc.Withdrawals[k] = &withdrawalJSON{
+			Index:          w.Index.Unwrap(),
+			ValidatorIndex: w.Validator.Unwrap(),
+			Address:        w.Address,
+			Amount:         w.Amount.Unwrap(),
+		}
+	}
+	return enc
+}
+
+// executionPayload returns the execution payload of the Beacon API encoding,
+// or nil if it is nil.
+func (enc *executionPayloadJSON) executionPayload() (*ExecutionPayload, error) {
+	if enc == nil {
+		return nil, nil //nolint:nilnil // no payload.
+	}
+	p := &ExecutionPayload{
+		ParentHash:    enc.ParentHash,
+		FeeRecipient:  enc.FeeRecipient,
+		StateRoot:     enc.StateRoot,
+		ReceiptsRoot:  enc.ReceiptsRoot,
+		LogsBloom:     enc.LogsBloom,
+		Random:        enc.Random,
+		Number:        math.U64(enc.Number),
+		GasLimit:      math.U64(enc.GasLimit),
+		GasUsed:       math.U64(enc.GasUsed),
+		Timestamp:     math.U64(enc.Timestamp),
+		ExtraData:     enc.ExtraData,
+		BaseFeePerGas: (*math.U256)(enc.BaseFeePerGas),
+		BlockHash:     enc.BlockHash,
+		Transactions:  make(engineprimitives.Transactions, len(enc.Transactions)),
+		Withdrawals: make(
+			[]*engineprimitives.Withdrawal, len(enc.Withdrawals),
+		),
+		BlobGasUsed:   math.U64(enc.BlobGasUsed),
+		ExcessBlobGas: math.U64(enc.ExcessBlobGas),
+	}
+	for k, v := range enc.Transactions {
+		p.Transactions[k] = v
+	}
+	for k, w := range enc.Withdrawals {
+		if w == nil {
+			return nil, errors.New("null withdrawal in execution payload")
+		}
+		p.Withdrawals[k] = &engineprimitives.Withdrawal{
+			Index:     math.U64(w.Index),
+			Validator: math.ValidatorIndex(w.ValidatorIndex),
+			Address:   w.Address,
+			Amount:    math.Gwei(w.Amount),
+		}
+	}
+	return p, nil
+}
+
 // Empty returns an empty ExecutionPayload for the given fork version.
 func (p *ExecutionPayload) Empty(_ uint32) *ExecutionPayload {
 	return &ExecutionPayload{}
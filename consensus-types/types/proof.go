@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/merkle"
+	fastssz "github.com/ferranbt/fastssz"
+)
+
+const (
+	// BodyRootGIndexDenebBlockHeader is the generalized index of the body root
+	// in the beacon block header in the Deneb fork.
+	BodyRootGIndexDenebBlockHeader = 12
+
+	// ExecutionPayloadGIndexDenebBlockBody is the generalized index of the
+	// execution payload in the beacon block body in the Deneb fork.
+	ExecutionPayloadGIndexDenebBlockBody = 12
+
+	// BlobKzgCommitmentsGIndexDenebBlockBody is the generalized index of the
+	// blob KZG commitments in the beacon block body in the Deneb fork.
+	BlobKzgCommitmentsGIndexDenebBlockBody = 13
+
+	// ExecutionBlockHashGIndexDenebBlockBody is the generalized index of the
+	// block hash of the execution payload in the beacon block body in the
+	// Deneb fork. This is calculated by concatenating the
+	// (ExecutionPayloadGIndexDenebBlockBody, 44) GIndices.
+	ExecutionBlockHashGIndexDenebBlockBody = 396
+
+	// ZeroKZGCommitmentGIndexDenebBlockBody is the generalized index of the
+	// 0 blob KZG commitment in the beacon block body in the Deneb fork. This
+	// is calculated by concatenating the
+	// (BlobKzgCommitmentsGIndexDenebBlockBody, 32) GIndices. To get the GIndex
+	// of the KZG commitment at index n, the formula is:
+	// GIndex = ZeroKZGCommitmentGIndexDenebBlockBody + n
+	ZeroKZGCommitmentGIndexDenebBlockBody = 416
+)
+
+// BodyGIndexInBlock returns the generalized index, in the beacon block
+// header, of the node at the given generalized index in the beacon block
+// body. Proofs against this index verify against the beacon block root.
+func BodyGIndexInBlock(gIndex uint64) uint64 {
+	return merkle.GeneralizedIndices{
+		BodyRootGIndexDenebBlockHeader,
+		merkle.GeneralizedIndex(gIndex),
+	}.Concat().Unwrap()
+}
+
+// KZGCommitmentGIndexInBody returns the generalized index of the KZG
+// commitment at the given index in the beacon block body.
+func KZGCommitmentGIndexInBody(index uint64) uint64 {
+	return ZeroKZGCommitmentGIndexDenebBlockBody + index
+}
+
+// Prove generates a merkle proof of the node at the given generalized index
+// in the BeaconBlockHeader. Returns the proof along with the proven leaf.
+func (b *BeaconBlockHeader) Prove(
+	gIndex uint64,
+) ([]common.Root, common.Root, error) {
+	return proveNode(b, gIndex)
+}
+
+// Prove generates a merkle proof of the node at the given generalized index
+// in the BeaconBlockBody. Returns the proof along with the proven leaf.
+func (b *BeaconBlockBody) Prove(
+	gIndex uint64,
+) ([]common.Root, common.Root, error) {
+	return proveNode(b, gIndex)
+}
+
+// ProveBodyFieldInBlock generates a merkle proof of the node at the given
+// generalized index in the block body against the beacon block root, i.e.
+// the root of the block header. The proof verifies at the generalized index
+// returned by BodyGIndexInBlock. Returns the proof along with the proven leaf.
+func ProveBodyFieldInBlock(
+	header *BeaconBlockHeader,
+	body *BeaconBlockBody,
+	gIndex uint64,
+) ([]common.Root, common.Root, error) {
+	if bodyRoot := body.HashTreeRoot(); bodyRoot != header.GetBodyRoot() {
+		return nil, common.Root{}, errors.Wrapf(
+			ErrBodyRootMismatch, "expected %s, got %s",
+			header.GetBodyRoot(), bodyRoot,
+		)
+	}
+
+	bodyProof, leaf, err := body.Prove(gIndex)
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+	headerProof, _, err := header.Prove(BodyRootGIndexDenebBlockHeader)
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	// By property of the merkle tree, we can concatenate the
+	// two proofs to get the final proof.
+	return append(bodyProof, headerProof...), leaf, nil
+}
+
+// ProveExecutionBlockHashInBlock generates a merkle proof of the block hash
+// of the execution payload against the beacon block root, as exposed to the
+// execution layer by EIP-4788. Returns the proof along with the block hash.
+func ProveExecutionBlockHashInBlock(
+	header *BeaconBlockHeader,
+	body *BeaconBlockBody,
+) ([]common.Root, common.Root, error) {
+	return ProveBodyFieldInBlock(
+		header, body, ExecutionBlockHashGIndexDenebBlockBody,
+	)
+}
+
+// ProveKZGCommitmentInBlock generates a merkle proof of the KZG commitment at
+// the given index against the beacon block root. Returns the proof along with
+// the hash tree root of the commitment.
+func ProveKZGCommitmentInBlock(
+	header *BeaconBlockHeader,
+	body *BeaconBlockBody,
+	index uint64,
+) ([]common.Root, common.Root, error) {
+	numCommitments := uint64(len(body.GetBlobKzgCommitments()))
+	if index >= numCommitments {
+		return nil, common.Root{}, errors.Wrapf(
+			ErrKZGCommitmentIndexOutOfRange,
+			"index: %d, commitments: %d", index, numCommitments,
+		)
+	}
+	return ProveBodyFieldInBlock(
+		header, body, KZGCommitmentGIndexInBody(index),
+	)
+}
+
+// proveNode generates a merkle proof of the node at the given generalized
+// index in the given object. It uses the fastssz library to generate the
+// proof.
+func proveNode(
+	v fastssz.HashRootProof,
+	gIndex uint64,
+) ([]common.Root, common.Root, error) {
+	tree, err := fastssz.ProofTree(v)
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	//#nosec:G701 // generalized indices of the block fit in an int.
+	nodeProof, err := tree.Prove(int(gIndex))
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	proof := make([]common.Root, len(nodeProof.Hashes))
+	for i, hash := range nodeProof.Hashes {
+		proof[i] = common.NewRootFromBytes(hash)
+	}
+	return proof, common.NewRootFromBytes(nodeProof.Leaf), nil
+}
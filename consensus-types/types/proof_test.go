@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProofGIndices(t *testing.T) {
+	require.Equal(
+		t,
+		merkle.GeneralizedIndex(types.ExecutionBlockHashGIndexDenebBlockBody),
+		merkle.GeneralizedIndices{
+			types.ExecutionPayloadGIndexDenebBlockBody, 44,
+		}.Concat(),
+	)
+	require.Equal(
+		t,
+		merkle.GeneralizedIndex(types.ZeroKZGCommitmentGIndexDenebBlockBody),
+		merkle.GeneralizedIndices{
+			types.BlobKzgCommitmentsGIndexDenebBlockBody, 32,
+		}.Concat(),
+	)
+	require.Equal(
+		t,
+		uint64(types.KZGMerkleIndexDeneb*16),
+		uint64(types.ZeroKZGCommitmentGIndexDenebBlockBody),
+	)
+}
+
+func TestProveBodyFieldInBlock(t *testing.T) {
+	block := generateValidBeaconBlock()
+	body := block.GetBody()
+	header := block.GetHeader()
+
+	proof, leaf, err := types.ProveBodyFieldInBlock(
+		header, body, types.ExecutionPayloadGIndexDenebBlockBody,
+	)
+	require.NoError(t, err)
+	require.Equal(t, body.GetExecutionPayload().HashTreeRoot(), leaf)
+
+	verified, err := merkle.VerifyProof(
+		merkle.GeneralizedIndex(types.BodyGIndexInBlock(
+			types.ExecutionPayloadGIndexDenebBlockBody,
+		)),
+		leaf, proof, header.HashTreeRoot(),
+	)
+	require.NoError(t, err)
+	require.True(t, verified)
+
+	header.BodyRoot = common.Root{0x01}
+	_, _, err = types.ProveBodyFieldInBlock(
+		header, body, types.ExecutionPayloadGIndexDenebBlockBody,
+	)
+	require.ErrorIs(t, err, types.ErrBodyRootMismatch)
+}
+
+func TestProveExecutionBlockHashInBlock(t *testing.T) {
+	block := generateValidBeaconBlock()
+	block.Body.ExecutionPayload.BlockHash = common.ExecutionHash{0x0a, 0x0b}
+	header := block.GetHeader()
+
+	proof, leaf, err := types.ProveExecutionBlockHashInBlock(
+		header, block.GetBody(),
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, common.Root(block.Body.ExecutionPayload.BlockHash), leaf,
+	)
+
+	verified, err := merkle.VerifyProof(
+		merkle.GeneralizedIndex(types.BodyGIndexInBlock(
+			types.ExecutionBlockHashGIndexDenebBlockBody,
+		)),
+		leaf, proof, header.HashTreeRoot(),
+	)
+	require.NoError(t, err)
+	require.True(t, verified)
+}
+
+func TestProveKZGCommitmentInBlock(t *testing.T) {
+	block := generateValidBeaconBlock()
+	block.Body.BlobKzgCommitments = []eip4844.KZGCommitment{
+		{0x01}, {0x02}, {0x03},
+	}
+	header := block.GetHeader()
+
+	for i, commitment := range block.Body.BlobKzgCommitments {
+		index := uint64(i)
+		proof, leaf, err := types.ProveKZGCommitmentInBlock(
+			header, block.GetBody(), index,
+		)
+		require.NoError(t, err)
+		require.Equal(t, commitment.HashTreeRoot(), leaf)
+
+		verified, err := merkle.VerifyProof(
+			merkle.GeneralizedIndex(types.BodyGIndexInBlock(
+				types.KZGCommitmentGIndexInBody(index),
+			)),
+			leaf, proof, header.HashTreeRoot(),
+		)
+		require.NoError(t, err)
+		require.True(t, verified)
+
+		// The proof starts with the proof of the commitment in the body.
+		bodyProof, _, err := block.GetBody().Prove(
+			types.KZGCommitmentGIndexInBody(index),
+		)
+		require.NoError(t, err)
+		require.Equal(t, bodyProof, proof[:len(bodyProof)])
+		verified, err = merkle.VerifyProof(
+			merkle.GeneralizedIndex(types.KZGCommitmentGIndexInBody(index)),
+			leaf, bodyProof, block.GetBody().HashTreeRoot(),
+		)
+		require.NoError(t, err)
+		require.True(t, verified)
+	}
+
+	_, _, err := types.ProveKZGCommitmentInBlock(header, block.GetBody(), 3)
+	require.ErrorIs(t, err, types.ErrKZGCommitmentIndexOutOfRange)
+}
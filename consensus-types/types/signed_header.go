@@ -21,6 +21,8 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/crypto"
@@ -33,7 +35,7 @@ var (
 )
 
 type SignedBeaconBlockHeader struct {
-	Header    *BeaconBlockHeader  `json:"header"`
+	Header    *BeaconBlockHeader  `json:"message"`
 	Signature crypto.BLSSignature `json:"signature"`
 }
 
@@ -70,20 +72,6 @@ func (b *SignedBeaconBlockHeader) New(
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
 
-// SizeSSZ returns the size of the SignedBeaconBlockHeader object
-// in SSZ encoding. Total size: Header (112) + Signature (96).
-func (b *SignedBeaconBlockHeader) SizeSSZ(sizer *ssz.Sizer) uint32 {
-	//nolint:mnd // no magic
-	size := (*BeaconBlockHeader)(nil).SizeSSZ(sizer) + 96
-	return size
-}
-
-// DefineSSZ defines the SSZ encoding for the SignedBeaconBlockHeader object.
-func (b *SignedBeaconBlockHeader) DefineSSZ(codec *ssz.Codec) {
-	ssz.DefineStaticObject(codec, &b.Header)
-	ssz.DefineStaticBytes(codec, &b.Signature)
-}
-
 // MarshalSSZ marshals the SignedBeaconBlockHeader object to SSZ format.
 func (b *SignedBeaconBlockHeader) MarshalSSZ() ([]byte, error) {
 	buf := make([]byte, ssz.Size(b))
@@ -101,6 +89,37 @@ func (b *SignedBeaconBlockHeader) HashTreeRoot() common.Root {
 	return ssz.HashSequential(b)
 }
 
+/* -------------------------------------------------------------------------- */
+/*                                    JSON                                    */
+/* -------------------------------------------------------------------------- */
+
+// signedBeaconBlockHeaderJSON is the Beacon API encoding of a
+// SignedBeaconBlockHeader.
+type signedBeaconBlockHeaderJSON struct {
+	Header    *BeaconBlockHeader  `json:"message"`
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// MarshalJSON marshals the SignedBeaconBlockHeader as per the Beacon API.
+func (b SignedBeaconBlockHeader) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&signedBeaconBlockHeaderJSON{
+		Header:    b.Header,
+		Signature: b.Signature,
+	})
+}
+
+// UnmarshalJSON unmarshals the SignedBeaconBlockHeader from its Beacon API
+// encoding.
+func (b *SignedBeaconBlockHeader) UnmarshalJSON(input []byte) error {
+	var dec signedBeaconBlockHeaderJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	b.Header = dec.Header
+	b.Signature = dec.Signature
+	return nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                            Getters and Setters                             */
 /* -------------------------------------------------------------------------- */
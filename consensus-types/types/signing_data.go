@@ -21,10 +21,8 @@
 package types
 
 import (
-	"encoding/binary"
-
 	"github.com/berachain/beacon-kit/primitives/common"
-	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/signing"
 	"github.com/karalabe/ssz"
 )
 
@@ -41,18 +39,6 @@ type SigningData struct {
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
 
-// SizeSSZ returns the size of the SigningData object in SSZ encoding.
-func (*SigningData) SizeSSZ(_ *ssz.Sizer) uint32 {
-	//nolint:mnd // 32*2 = 64.
-	return 64
-}
-
-// DefineSSZ defines the SSZ encoding for the SigningData object.
-func (s *SigningData) DefineSSZ(codec *ssz.Codec) {
-	ssz.DefineStaticBytes(codec, &s.ObjectRoot)
-	ssz.DefineStaticBytes(codec, &s.Domain)
-}
-
 // HashTreeRoot computes the SSZ hash tree root of the SigningData object.
 func (s *SigningData) HashTreeRoot() common.Root {
 	return ssz.HashSequential(s)
@@ -81,22 +67,13 @@ func ComputeSigningRoot(
 	sszObject interface{ HashTreeRoot() common.Root },
 	domain common.Domain,
 ) common.Root {
-	return (&SigningData{
-		ObjectRoot: sszObject.HashTreeRoot(),
-		Domain:     domain,
-	}).HashTreeRoot()
+	return signing.ComputeSigningRoot(sszObject.HashTreeRoot(), domain)
 }
 
 // ComputeSigningRootUInt64 computes the signing root of a uint64 value.
 func ComputeSigningRootUInt64(
 	value uint64,
 	domain common.Domain,
-
 ) common.Root {
-	bz := make([]byte, constants.RootLength)
-	binary.LittleEndian.PutUint64(bz, value)
-	return (&SigningData{
-		ObjectRoot: common.Root(bz),
-		Domain:     domain,
-	}).HashTreeRoot()
+	return signing.ComputeSigningRootUInt64(value, domain)
 }
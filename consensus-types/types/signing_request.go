@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"strings"
+
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// NewBlockSigningRequest returns the request to sign the given block header,
+// whose signing root under the fork is root.
+func NewBlockSigningRequest(
+	forkData *ForkData,
+	header *BeaconBlockHeader,
+	root common.Root,
+) *crypto.SigningRequest {
+	req := forkData.signingRequest(
+		crypto.SigningTypeBlock, root, "beacon_block", map[string]any{
+			"version": strings.ToUpper(version.Name(
+				version.ToUint32(forkData.CurrentVersion),
+			)),
+			"block_header": map[string]string{
+				"slot":           header.GetSlot().Base10(),
+				"proposer_index": header.GetProposerIndex().Base10(),
+				"parent_root":    header.GetParentBlockRoot().Hex(),
+				"state_root":     header.GetStateRoot().Hex(),
+				"body_root":      header.GetBodyRoot().Hex(),
+			},
+		},
+	)
+	req.Slot = header.GetSlot()
+	return req
+}
+
+// NewRandaoSigningRequest returns the request to sign the randao reveal of
+// the given epoch, whose signing root under the fork is root.
+func NewRandaoSigningRequest(
+	forkData *ForkData,
+	epoch math.Epoch,
+	root common.Root,
+) *crypto.SigningRequest {
+	return forkData.signingRequest(
+		crypto.SigningTypeRandaoReveal, root, "randao_reveal",
+		map[string]string{"epoch": epoch.Base10()},
+	)
+}
+
+// NewVoluntaryExitSigningRequest returns the request to sign the given
+// voluntary exit, whose signing root under the fork is root.
+func NewVoluntaryExitSigningRequest(
+	forkData *ForkData,
+	exit *VoluntaryExit,
+	root common.Root,
+) *crypto.SigningRequest {
+	return forkData.signingRequest(
+		crypto.SigningTypeVoluntaryExit, root, "voluntary_exit",
+		map[string]string{
+			"epoch":           exit.Epoch.Base10(),
+			"validator_index": exit.ValidatorIndex.Base10(),
+		},
+	)
+}
+
+// signingRequest returns the request to sign the given message, keyed by
+// name, under the fork.
+func (fd *ForkData) signingRequest(
+	typ string,
+	root common.Root,
+	name string,
+	message any,
+) *crypto.SigningRequest {
+	return &crypto.SigningRequest{
+		Type:                  typ,
+		SigningRoot:           bytes.B32(root),
+		ForkVersion:           fd.CurrentVersion,
+		GenesisValidatorsRoot: bytes.B32(fd.GenesisValidatorsRoot),
+		Message:               map[string]any{name: message},
+	}
+}
@@ -71,17 +71,6 @@ func (s *SlashingInfo) New(slot, index math.U64) *SlashingInfo {
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
 
-// SizeSSZ returns the size of the SlashingInfo object in SSZ encoding.
-func (*SlashingInfo) SizeSSZ(*ssz.Sizer) uint32 {
-	return SlashingInfoSize
-}
-
-// DefineSSZ defines the SSZ encoding for the SlashingInfo object.
-func (s *SlashingInfo) DefineSSZ(codec *ssz.Codec) {
-	ssz.DefineUint64(codec, &s.Slot)
-	ssz.DefineUint64(codec, &s.Index)
-}
-
 // HashTreeRoot computes the SSZ hash tree root of the SlashingInfo object.
 func (s *SlashingInfo) HashTreeRoot() common.Root {
 	return ssz.HashSequential(s)
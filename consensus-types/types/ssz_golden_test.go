@@ -0,0 +1,398 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	byteslib "github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/math"
+	fastssz "github.com/ferranbt/fastssz"
+	zcommon "github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/protolambda/zrnt/eth2/beacon/phase0"
+	"github.com/protolambda/ztyp/codec"
+	"github.com/protolambda/ztyp/tree"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:gochecknoglobals // test flag.
+var update = flag.Bool("update", false, "update the SSZ golden vectors")
+
+// goldenFile holds the SSZ encoding and hash tree root of every fixture.
+const goldenFile = "ssz_golden.json"
+
+// sszObject is a consensus type with a generated SSZ encoding.
+type sszObject interface {
+	MarshalSSZ() ([]byte, error)
+	UnmarshalSSZ([]byte) error
+	HashTreeRoot() common.Root
+}
+
+// hashWalkerObject is a consensus type hashed with fastssz for the proofs.
+type hashWalkerObject interface {
+	HashTreeRootWith(hh fastssz.HashWalker) error
+}
+
+// specObject is the definition of a consensus type in the spec.
+type specObject interface {
+	Serialize(w *codec.EncodingWriter) error
+	HashTreeRoot(hFn tree.HashFn) tree.Root
+}
+
+// goldenVector is the expected encoding of a fixture.
+type goldenVector struct {
+	SSZ  byteslib.Bytes `json:"ssz"`
+	Root common.Root    `json:"root"`
+}
+
+// fixture is a consensus object under test, along with its definition in
+// the spec, if any.
+type fixture struct {
+	obj   sszObject
+	empty func() sszObject
+	spec  specObject
+}
+
+func newFixture[T any, PT interface {
+	*T
+	sszObject
+}](obj PT, spec specObject) fixture {
+	return fixture{
+		obj:   obj,
+		empty: func() sszObject { return PT(new(T)) },
+		spec:  spec,
+	}
+}
+
+//nolint:funlen // fixtures.
+func sszFixtures() map[string]fixture {
+	var (
+		root      = common.Root{0x01, 0x02, 0x03}
+		pubkey    = crypto.BLSPubkey{0x04, 0x05, 0x06}
+		signature = crypto.BLSSignature{0x07, 0x08, 0x09}
+		address   = common.ExecutionAddress{0x0a, 0x0b}
+		hash      = common.ExecutionHash{0x0c, 0x0d}
+		version   = common.Version{0x0e, 0x0f, 0x10, 0x11}
+	)
+
+	fork := &types.Fork{
+		PreviousVersion: common.Version{0x01, 0x00, 0x00, 0x00},
+		CurrentVersion:  version,
+		Epoch:           7,
+	}
+	header := &types.BeaconBlockHeader{
+		Slot:            42,
+		ProposerIndex:   3,
+		ParentBlockRoot: root,
+		StateRoot:       common.Root{0x12},
+		BodyRoot:        common.Root{0x13},
+	}
+	eth1Data := &types.Eth1Data{
+		DepositRoot:  root,
+		DepositCount: 5,
+		BlockHash:    hash,
+	}
+	credentials := types.NewCredentialsFromExecutionAddress(address)
+	depositMessage := &types.DepositMessage{
+		Pubkey:      pubkey,
+		Credentials: credentials,
+		Amount:      32e9,
+	}
+	deposit := &types.Deposit{
+		Pubkey:      pubkey,
+		Credentials: credentials,
+		Amount:      32e9,
+		Signature:   signature,
+		Index:       9,
+	}
+	validator := &types.Validator{
+		Pubkey:                     pubkey,
+		WithdrawalCredentials:      credentials,
+		EffectiveBalance:           32e9,
+		Slashed:                    true,
+		ActivationEligibilityEpoch: 1,
+		ActivationEpoch:            2,
+		ExitEpoch:                  3,
+		WithdrawableEpoch:          4,
+	}
+	blsChange := &types.BLSToExecutionChange{
+		ValidatorIndex:     6,
+		FromBLSPubkey:      pubkey,
+		ToExecutionAddress: address,
+	}
+	exit := &types.VoluntaryExit{Epoch: 8, ValidatorIndex: 6}
+	body := &types.BeaconBlockBody{
+		RandaoReveal: signature,
+		Eth1Data:     eth1Data,
+		Graffiti:     [32]byte{0x14},
+		Deposits:     []*types.Deposit{deposit},
+		ExecutionPayload: &types.ExecutionPayload{
+			ParentHash:    hash,
+			FeeRecipient:  address,
+			StateRoot:     common.Bytes32{0x15},
+			ReceiptsRoot:  common.Bytes32{0x16},
+			LogsBloom:     byteslib.B256{0x17},
+			Random:        common.Bytes32{0x18},
+			Number:        100,
+			GasLimit:      30e6,
+			GasUsed:       21000,
+			Timestamp:     1700000000,
+			ExtraData:     byteslib.Bytes{0x19, 0x1a},
+			BaseFeePerGas: math.NewU256(7),
+			BlockHash:     common.ExecutionHash{0x1b},
+			Transactions:  engineprimitives.Transactions{{0x1c, 0x1d}},
+			Withdrawals: []*engineprimitives.Withdrawal{{
+				Index:     1,
+				Validator: 6,
+				Address:   address,
+				Amount:    1e9,
+			}},
+			BlobGasUsed:   131072,
+			ExcessBlobGas: 0,
+		},
+		BlobKzgCommitments: []eip4844.KZGCommitment{{0x1e}},
+	}
+	state := &types.BeaconState{
+		GenesisValidatorsRoot: root,
+		Slot:                  42,
+		Fork:                  fork,
+		LatestBlockHeader:     header,
+		BlockRoots:            []common.Root{{0x1f}, {0x20}},
+		StateRoots:            []common.Root{{0x21}},
+		Eth1Data:              eth1Data,
+		Eth1DepositIndex:      9,
+		LatestExecutionPayloadHeader: &types.ExecutionPayloadHeader{
+			ParentHash:    hash,
+			FeeRecipient:  address,
+			ExtraData:     byteslib.Bytes{0x22},
+			BaseFeePerGas: math.NewU256(7),
+			BlockHash:     common.ExecutionHash{0x23},
+			Number:        100,
+		},
+		Validators:                   []*types.Validator{validator},
+		Balances:                     []uint64{32e9},
+		RandaoMixes:                  []common.Bytes32{{0x24}},
+		NextWithdrawalIndex:          2,
+		NextWithdrawalValidatorIndex: 6,
+		Slashings:                    []math.Gwei{1e9},
+		TotalSlashing:                1e9,
+	}
+
+	zheader := zcommon.BeaconBlockHeader{
+		Slot:          zcommon.Slot(header.Slot),
+		ProposerIndex: zcommon.ValidatorIndex(header.ProposerIndex),
+		ParentRoot:    tree.Root(header.ParentBlockRoot),
+		StateRoot:     tree.Root(header.StateRoot),
+		BodyRoot:      tree.Root(header.BodyRoot),
+	}
+	zchange := zcommon.BLSToExecutionChange{
+		ValidatorIndex:     zcommon.ValidatorIndex(blsChange.ValidatorIndex),
+		FromBLSPubKey:      zcommon.BLSPubkey(pubkey),
+		ToExecutionAddress: zcommon.Eth1Address(address),
+	}
+	zexit := phase0.VoluntaryExit{
+		Epoch:          zcommon.Epoch(exit.Epoch),
+		ValidatorIndex: zcommon.ValidatorIndex(exit.ValidatorIndex),
+	}
+
+	return map[string]fixture{
+		"AttestationData": newFixture(&types.AttestationData{
+			Slot:            42,
+			Index:           1,
+			BeaconBlockRoot: root,
+		}, nil),
+		"BeaconBlock": newFixture(&types.BeaconBlock{
+			Slot:          42,
+			ProposerIndex: 3,
+			ParentRoot:    root,
+			StateRoot:     common.Root{0x25},
+			Body:          body,
+		}, nil),
+		"BeaconBlockBody":      newFixture(body, nil),
+		"BeaconBlockHeader":    newFixture(header, &zheader),
+		"BeaconState":          newFixture(state, nil),
+		"BLSToExecutionChange": newFixture(blsChange, &zchange),
+		"Deposit":              newFixture(deposit, nil),
+		"DepositData": newFixture(
+			types.NewDepositData(depositMessage, signature),
+			&zcommon.DepositData{
+				Pubkey:                zcommon.BLSPubkey(pubkey),
+				WithdrawalCredentials: tree.Root(credentials),
+				Amount:                zcommon.Gwei(depositMessage.Amount),
+				Signature:             zcommon.BLSSignature(signature),
+			},
+		),
+		"DepositMessage": newFixture(depositMessage, &zcommon.DepositMessage{
+			Pubkey:                zcommon.BLSPubkey(pubkey),
+			WithdrawalCredentials: tree.Root(credentials),
+			Amount:                zcommon.Gwei(depositMessage.Amount),
+		}),
+		"Eth1Data": newFixture(eth1Data, &zcommon.Eth1Data{
+			DepositRoot:  tree.Root(root),
+			DepositCount: zcommon.DepositIndex(eth1Data.DepositCount),
+			BlockHash:    tree.Root(hash),
+		}),
+		"Fork": newFixture(fork, &zcommon.Fork{
+			PreviousVersion: zcommon.Version(fork.PreviousVersion),
+			CurrentVersion:  zcommon.Version(version),
+			Epoch:           zcommon.Epoch(fork.Epoch),
+		}),
+		"ForkData": newFixture(&types.ForkData{
+			CurrentVersion:        version,
+			GenesisValidatorsRoot: root,
+		}, &zcommon.ForkData{
+			CurrentVersion:        zcommon.Version(version),
+			GenesisValidatorsRoot: tree.Root(root),
+		}),
+		"SignedBeaconBlockHeader": newFixture(&types.SignedBeaconBlockHeader{
+			Header:    header,
+			Signature: signature,
+		}, &zcommon.SignedBeaconBlockHeader{
+			Message:   zheader,
+			Signature: zcommon.BLSSignature(signature),
+		}),
+		"SignedBLSToExecutionChange": newFixture(
+			&types.SignedBLSToExecutionChange{
+				Message:   blsChange,
+				Signature: signature,
+			},
+			&zcommon.SignedBLSToExecutionChange{
+				BLSToExecutionChange: zchange,
+				Signature:            zcommon.BLSSignature(signature),
+			},
+		),
+		"SignedVoluntaryExit": newFixture(&types.SignedVoluntaryExit{
+			Message:   exit,
+			Signature: signature,
+		}, &phase0.SignedVoluntaryExit{
+			Message:   zexit,
+			Signature: zcommon.BLSSignature(signature),
+		}),
+		"SigningData": newFixture(&types.SigningData{
+			ObjectRoot: root,
+			Domain:     common.Domain{0x26},
+		}, &zcommon.SigningData{
+			ObjectRoot: tree.Root(root),
+			Domain:     zcommon.BLSDomain{0x26},
+		}),
+		"SlashingInfo": newFixture(&types.SlashingInfo{
+			Slot:  42,
+			Index: 6,
+		}, nil),
+		"Validator": newFixture(validator, &phase0.Validator{
+			Pubkey:                     zcommon.BLSPubkey(pubkey),
+			WithdrawalCredentials:      tree.Root(credentials),
+			EffectiveBalance:           zcommon.Gwei(validator.EffectiveBalance),
+			Slashed:                    validator.Slashed,
+			ActivationEligibilityEpoch: 1,
+			ActivationEpoch:            2,
+			ExitEpoch:                  3,
+			WithdrawableEpoch:          4,
+		}),
+		"VoluntaryExit": newFixture(exit, &zexit),
+	}
+}
+
+// TestSSZGoldenVectors checks the generated SSZ encodings against the golden
+// vectors in testdata, which are rewritten when running with -update.
+func TestSSZGoldenVectors(t *testing.T) {
+	path := filepath.Join("testdata", goldenFile)
+	golden := make(map[string]goldenVector)
+	if !*update {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &golden))
+	}
+
+	fixtures := sszFixtures()
+	for name, f := range fixtures {
+		t.Run(name, func(t *testing.T) {
+			bz, err := f.obj.MarshalSSZ()
+			require.NoError(t, err)
+			root := f.obj.HashTreeRoot()
+
+			if *update {
+				golden[name] = goldenVector{SSZ: bz, Root: root}
+				return
+			}
+			expected, ok := golden[name]
+			require.True(t, ok, "missing golden vector")
+			require.Equal(t, expected.SSZ, byteslib.Bytes(bz))
+			require.Equal(t, expected.Root, root)
+
+			decoded := f.empty()
+			require.NoError(t, decoded.UnmarshalSSZ(expected.SSZ))
+			require.Equal(t, f.obj, decoded)
+
+			// The proofs rely on the fastssz hashing, which must agree.
+			if hw, isHashWalker := f.obj.(hashWalkerObject); isHashWalker {
+				hh := fastssz.DefaultHasherPool.Get()
+				defer fastssz.DefaultHasherPool.Put(hh)
+				require.NoError(t, hw.HashTreeRootWith(hh))
+				fastRoot, hashErr := hh.HashRoot()
+				require.NoError(t, hashErr)
+				require.Equal(t, root, common.Root(fastRoot))
+			}
+		})
+	}
+	if !*update {
+		require.Len(t, golden, len(fixtures))
+		return
+	}
+
+	data, err := json.MarshalIndent(golden, "", "  ")
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll("testdata", 0o755))
+	require.NoError(t, os.WriteFile(path, append(data, '\n'), 0o600))
+}
+
+// TestSSZSpec checks the generated SSZ encodings against the spec
+// definitions of the consensus types.
+func TestSSZSpec(t *testing.T) {
+	for name, f := range sszFixtures() {
+		if f.spec == nil {
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			bz, err := f.obj.MarshalSSZ()
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, f.spec.Serialize(codec.NewEncodingWriter(&buf)))
+			require.Equal(t, buf.Bytes(), bz)
+			require.Equal(
+				t,
+				common.Root(f.spec.HashTreeRoot(tree.GetHashFn())),
+				f.obj.HashTreeRoot(),
+			)
+		})
+	}
+}
@@ -36,8 +36,8 @@ type BeaconState struct {
 
 	// History
 	LatestBlockHeader *BeaconBlockHeader
-	BlockRoots        []common.Root
-	StateRoots        []common.Root
+	BlockRoots        []common.Root `ssz-max:"8192"`
+	StateRoots        []common.Root `ssz-max:"8192"`
 
 	// Eth1
 	Eth1Data                     *Eth1Data
@@ -45,18 +45,18 @@ type BeaconState struct {
 	LatestExecutionPayloadHeader *ExecutionPayloadHeader
 
 	// Registry
-	Validators []*Validator
-	Balances   []uint64
+	Validators []*Validator `ssz-max:"1099511627776"`
+	Balances   []uint64     `ssz-max:"1099511627776"`
 
 	// Randomness
-	RandaoMixes []common.Bytes32
+	RandaoMixes []common.Bytes32 `ssz-max:"65536"`
 
 	// Withdrawals
 	NextWithdrawalIndex          uint64
 	NextWithdrawalValidatorIndex math.ValidatorIndex
 
 	// Slashing
-	Slashings     []math.Gwei
+	Slashings     []math.Gwei `ssz-max:"1099511627776"`
 	TotalSlashing math.Gwei
 }
 
@@ -104,70 +104,6 @@ func (st *BeaconState) New(
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconState object.
-func (st *BeaconState) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
-	var size uint32 = 300
-
-	if fixed {
-		return size
-	}
-
-	// Dynamic size fields
-	size += ssz.SizeSliceOfStaticBytes(siz, st.BlockRoots)
-	size += ssz.SizeSliceOfStaticBytes(siz, st.StateRoots)
-	size += ssz.SizeDynamicObject(siz, st.LatestExecutionPayloadHeader)
-	size += ssz.SizeSliceOfStaticObjects(siz, st.Validators)
-	size += ssz.SizeSliceOfUint64s(siz, st.Balances)
-	size += ssz.SizeSliceOfStaticBytes(siz, st.RandaoMixes)
-	size += ssz.SizeSliceOfUint64s(siz, st.Slashings)
-
-	return size
-}
-
-// DefineSSZ defines the SSZ encoding for the BeaconState object.
-//
-//nolint:mnd // todo fix.
-func (st *BeaconState) DefineSSZ(codec *ssz.Codec) {
-	// Versioning
-	ssz.DefineStaticBytes(codec, &st.GenesisValidatorsRoot)
-	ssz.DefineUint64(codec, &st.Slot)
-	ssz.DefineStaticObject(codec, &st.Fork)
-
-	// History
-	ssz.DefineStaticObject(codec, &st.LatestBlockHeader)
-	ssz.DefineSliceOfStaticBytesOffset(codec, &st.BlockRoots, 8192)
-	ssz.DefineSliceOfStaticBytesOffset(codec, &st.StateRoots, 8192)
-
-	// Eth1
-	ssz.DefineStaticObject(codec, &st.Eth1Data)
-	ssz.DefineUint64(codec, &st.Eth1DepositIndex)
-	ssz.DefineDynamicObjectOffset(codec, &st.LatestExecutionPayloadHeader)
-
-	// Registry
-	ssz.DefineSliceOfStaticObjectsOffset(codec, &st.Validators, 1099511627776)
-	ssz.DefineSliceOfUint64sOffset(codec, &st.Balances, 1099511627776)
-
-	// Randomness
-	ssz.DefineSliceOfStaticBytesOffset(codec, &st.RandaoMixes, 65536)
-
-	// Withdrawals
-	ssz.DefineUint64(codec, &st.NextWithdrawalIndex)
-	ssz.DefineUint64(codec, &st.NextWithdrawalValidatorIndex)
-
-	// // Slashing
-	ssz.DefineSliceOfUint64sOffset(codec, &st.Slashings, 1099511627776)
-	ssz.DefineUint64(codec, (*uint64)(&st.TotalSlashing))
-
-	// Dynamic content
-	ssz.DefineSliceOfStaticBytesContent(codec, &st.BlockRoots, 8192)
-	ssz.DefineSliceOfStaticBytesContent(codec, &st.StateRoots, 8192)
-	ssz.DefineDynamicObjectContent(codec, &st.LatestExecutionPayloadHeader)
-	ssz.DefineSliceOfStaticObjectsContent(codec, &st.Validators, 1099511627776)
-	ssz.DefineSliceOfUint64sContent(codec, &st.Balances, 1099511627776)
-	ssz.DefineSliceOfStaticBytesContent(codec, &st.RandaoMixes, 65536)
-	ssz.DefineSliceOfUint64sContent(codec, &st.Slashings, 1099511627776)
-}
-
 // MarshalSSZ marshals the BeaconState into SSZ format.
 func (st *BeaconState) MarshalSSZ() ([]byte, error) {
 	buf := make([]byte, ssz.Size(st))
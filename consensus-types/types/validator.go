@@ -21,6 +21,8 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/constraints"
@@ -45,20 +47,20 @@ type Validator struct {
 	// Pubkey is the validator's 48-byte BLS public key.
 	Pubkey crypto.BLSPubkey `json:"pubkey"`
 	// WithdrawalCredentials are an address that controls the validator.
-	WithdrawalCredentials WithdrawalCredentials `json:"withdrawalCredentials"`
+	WithdrawalCredentials WithdrawalCredentials `json:"withdrawal_credentials"`
 	// EffectiveBalance is the validator's current effective balance in gwei.
-	EffectiveBalance math.Gwei `json:"effectiveBalance"`
+	EffectiveBalance math.Gwei `json:"effective_balance"`
 	// Slashed indicates whether the validator has been slashed.
 	Slashed bool `json:"slashed"`
 	// ActivationEligibilityEpoch is the epoch in which the validator became
 	// eligible for activation.
-	ActivationEligibilityEpoch math.Epoch `json:"activationEligibilityEpoch"`
+	ActivationEligibilityEpoch math.Epoch `json:"activation_eligibility_epoch"`
 	// ActivationEpoch is the epoch in which the validator activated.
-	ActivationEpoch math.Epoch `json:"activationEpoch"`
+	ActivationEpoch math.Epoch `json:"activation_epoch"`
 	// ExitEpoch is the epoch in which the validator exited.
-	ExitEpoch math.Epoch `json:"exitEpoch"`
+	ExitEpoch math.Epoch `json:"exit_epoch"`
 	// WithdrawableEpoch is the epoch in which the validator can withdraw.
-	WithdrawableEpoch math.Epoch `json:"withdrawableEpoch"`
+	WithdrawableEpoch math.Epoch `json:"withdrawable_epoch"`
 }
 
 /* -------------------------------------------------------------------------- */
@@ -131,23 +133,6 @@ func (v *Validator) New(
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
 
-// SizeSSZ returns the size of the Validator object in SSZ encoding.
-func (*Validator) SizeSSZ(*ssz.Sizer) uint32 {
-	return ValidatorSize
-}
-
-// DefineSSZ defines the SSZ encoding for the Validator object.
-func (v *Validator) DefineSSZ(codec *ssz.Codec) {
-	ssz.DefineStaticBytes(codec, &v.Pubkey)
-	ssz.DefineStaticBytes(codec, &v.WithdrawalCredentials)
-	ssz.DefineUint64(codec, &v.EffectiveBalance)
-	ssz.DefineBool(codec, &v.Slashed)
-	ssz.DefineUint64(codec, &v.ActivationEligibilityEpoch)
-	ssz.DefineUint64(codec, &v.ActivationEpoch)
-	ssz.DefineUint64(codec, &v.ExitEpoch)
-	ssz.DefineUint64(codec, &v.WithdrawableEpoch)
-}
-
 // HashTreeRoot computes the SSZ hash tree root of the Validator object.
 func (v *Validator) HashTreeRoot() common.Root {
 	return ssz.HashSequential(v)
@@ -216,6 +201,53 @@ func (v *Validator) GetTree() (*fastssz.Node, error) {
 	return fastssz.ProofTree(v)
 }
 
+/* -------------------------------------------------------------------------- */
+/*                                    JSON                                    */
+/* -------------------------------------------------------------------------- */
+
+// validatorJSON is the Beacon API encoding of a Validator.
+type validatorJSON struct {
+	Pubkey                     crypto.BLSPubkey      `json:"pubkey"`
+	WithdrawalCredentials      WithdrawalCredentials `json:"withdrawal_credentials"`
+	EffectiveBalance           uint64                `json:"effective_balance,string"`
+	Slashed                    bool                  `json:"slashed"`
+	ActivationEligibilityEpoch uint64                `json:"activation_eligibility_epoch,string"`
+	ActivationEpoch            uint64                `json:"activation_epoch,string"`
+	ExitEpoch                  uint64                `json:"exit_epoch,string"`
+	WithdrawableEpoch          uint64                `json:"withdrawable_epoch,string"`
+}
+
+// MarshalJSON marshals the Validator as per the Beacon API.
+func (v Validator) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&validatorJSON{
+		Pubkey:                     v.Pubkey,
+		WithdrawalCredentials:      v.WithdrawalCredentials,
+		EffectiveBalance:           v.EffectiveBalance.Unwrap(),
+		Slashed:                    v.Slashed,
+		ActivationEligibilityEpoch: v.ActivationEligibilityEpoch.Unwrap(),
+		ActivationEpoch:            v.ActivationEpoch.Unwrap(),
+		ExitEpoch:                  v.ExitEpoch.Unwrap(),
+		WithdrawableEpoch:          v.WithdrawableEpoch.Unwrap(),
+	})
+}
+
+// UnmarshalJSON unmarshals the Validator from its Beacon API encoding.
+func (v *Validator) UnmarshalJSON(input []byte) error {
+	var dec validatorJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	v.Pubkey = dec.Pubkey
+	v.WithdrawalCredentials = dec.WithdrawalCredentials
+	v.EffectiveBalance = math.Gwei(dec.EffectiveBalance)
+	v.Slashed = dec.Slashed
+	v.ActivationEligibilityEpoch = math.Epoch(dec.ActivationEligibilityEpoch)
+	v.ActivationEpoch = math.Epoch(dec.ActivationEpoch)
+	v.ExitEpoch = math.Epoch(dec.ExitEpoch)
+	v.WithdrawableEpoch = math.Epoch(dec.WithdrawableEpoch)
+	return nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                             Getters and Setters                            */
 /* -------------------------------------------------------------------------- */
@@ -265,6 +297,11 @@ func (v Validator) IsSlashed() bool {
 	return v.Slashed
 }
 
+// SetSlashed sets whether the validator has been slashed.
+func (v *Validator) SetSlashed(slashed bool) {
+	v.Slashed = slashed
+}
+
 // IsFullyWithdrawable as defined in the Ethereum 2.0 specification:
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#is_fully_withdrawable_validator
 func (v Validator) IsFullyWithdrawable(
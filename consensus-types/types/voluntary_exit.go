@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/karalabe/ssz"
+)
+
+// VoluntaryExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#voluntaryexit
+type VoluntaryExit struct {
+	// Epoch is the earliest epoch at which the exit can be processed.
+	Epoch math.Epoch
+	// ValidatorIndex is the index of the exiting validator.
+	ValidatorIndex math.ValidatorIndex
+}
+
+// CreateAndSignVoluntaryExit constructs and signs the voluntary exit of the
+// validator at the given index.
+func CreateAndSignVoluntaryExit(
+	forkData *ForkData,
+	domainType common.DomainType,
+	signer crypto.BLSSigner,
+	epoch math.Epoch,
+	validatorIndex math.ValidatorIndex,
+) (*SignedVoluntaryExit, error) {
+	exit := &VoluntaryExit{Epoch: epoch, ValidatorIndex: validatorIndex}
+	signingRoot := ComputeSigningRoot(exit, forkData.ComputeDomain(domainType))
+	signature, err := crypto.SignRequest(
+		signer, NewVoluntaryExitSigningRequest(forkData, exit, signingRoot),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &SignedVoluntaryExit{Message: exit, Signature: signature}, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// HashTreeRoot computes the SSZ hash tree root of the VoluntaryExit object.
+func (e *VoluntaryExit) HashTreeRoot() common.Root {
+	return ssz.HashSequential(e)
+}
+
+// MarshalSSZ marshals the VoluntaryExit object to SSZ format.
+func (e *VoluntaryExit) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(e))
+	return buf, ssz.EncodeToBytes(buf, e)
+}
+
+// UnmarshalSSZ unmarshals the VoluntaryExit object from SSZ format.
+func (e *VoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, e)
+}
+
+// SignedVoluntaryExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#signedvoluntaryexit
+type SignedVoluntaryExit struct {
+	// Message is the voluntary exit.
+	Message *VoluntaryExit
+	// Signature is the signature of the exit by the exiting validator.
+	Signature crypto.BLSSignature
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the SignedVoluntaryExit
+// object.
+func (e *SignedVoluntaryExit) HashTreeRoot() common.Root {
+	return ssz.HashSequential(e)
+}
+
+// MarshalSSZ marshals the SignedVoluntaryExit object to SSZ format.
+func (e *SignedVoluntaryExit) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(e))
+	return buf, ssz.EncodeToBytes(buf, e)
+}
+
+// UnmarshalSSZ unmarshals the SignedVoluntaryExit object from SSZ format.
+func (e *SignedVoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, e)
+}
+
+// VerifySignature verifies the signature of the exit by the given validator
+// pubkey, within the voluntary exit domain of the given fork.
+func (e *SignedVoluntaryExit) VerifySignature(
+	forkData *ForkData,
+	pubkey crypto.BLSPubkey,
+	domainType common.DomainType,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	signingRoot := ComputeSigningRoot(
+		e.Message, forkData.ComputeDomain(domainType),
+	)
+	return signatureVerificationFn(pubkey, signingRoot[:], e.Signature)
+}
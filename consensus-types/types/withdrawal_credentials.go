@@ -29,8 +29,13 @@ import (
 	"github.com/berachain/beacon-kit/primitives/common"
 )
 
-// EthSecp256k1CredentialPrefix is the prefix for an Ethereum secp256k1.
-const EthSecp256k1CredentialPrefix = byte(iota + 1)
+const (
+	// BLSCredentialPrefix is the prefix for credentials committing to the
+	// hash of a BLS withdrawal key.
+	BLSCredentialPrefix = byte(iota)
+	// EthSecp256k1CredentialPrefix is the prefix for an Ethereum secp256k1.
+	EthSecp256k1CredentialPrefix
+)
 
 // WithdrawalCredentials is a staking credential that is used to identify a
 // validator.
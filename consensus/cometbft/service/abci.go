@@ -34,12 +34,26 @@ import (
 var (
 	errInvalidHeight         = errors.New("invalid height")
 	errNilFinalizeBlockState = errors.New("finalizeBlockState is nil")
+	errShuttingDown          = errors.New("service is shutting down")
+	// errCheckpointSyncWithoutSnapshots is returned when checkpoint sync is
+	// requested from a node which cannot restore state sync snapshots.
+	errCheckpointSyncWithoutSnapshots = errors.New(
+		"checkpoint sync needs state sync snapshots to be restorable",
+	)
 )
 
 func (s *Service[LoggerT]) InitChain(
 	ctx context.Context,
 	req *cmtabci.InitChainRequest,
 ) (*cmtabci.InitChainResponse, error) {
+	if s.crashReporter != nil {
+		defer s.crashReporter.Recover()
+	}
+	release, err := s.beginBlockWork()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	return s.initChain(ctx, req)
 }
 
@@ -49,7 +63,15 @@ func (s *Service[LoggerT]) PrepareProposal(
 	ctx context.Context,
 	req *cmtabci.PrepareProposalRequest,
 ) (*cmtabci.PrepareProposalResponse, error) {
-	return s.prepareProposal(ctx, req)
+	if s.crashReporter != nil {
+		defer s.crashReporter.Recover()
+	}
+	release, err := s.beginBlockWork()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return s.prepareProposalHandler(ctx, req)
 }
 
 func (s *Service[LoggerT]) Info(context.Context,
@@ -80,13 +102,29 @@ func (s *Service[LoggerT]) ProcessProposal(
 	ctx context.Context,
 	req *cmtabci.ProcessProposalRequest,
 ) (*cmtabci.ProcessProposalResponse, error) {
-	return s.processProposal(ctx, req)
+	if s.crashReporter != nil {
+		defer s.crashReporter.Recover()
+	}
+	release, err := s.beginBlockWork()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return s.processProposalHandler(ctx, req)
 }
 
 func (s *Service[_]) FinalizeBlock(
 	ctx context.Context,
 	req *cmtabci.FinalizeBlockRequest,
 ) (*cmtabci.FinalizeBlockResponse, error) {
+	if s.crashReporter != nil {
+		defer s.crashReporter.Recover()
+	}
+	release, err := s.beginBlockWork()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	return s.finalizeBlock(ctx, req)
 }
 
@@ -100,6 +138,14 @@ func (s *Service[_]) FinalizeBlock(
 func (s *Service[LoggerT]) Commit(
 	ctx context.Context, req *cmtabci.CommitRequest,
 ) (*cmtabci.CommitResponse, error) {
+	if s.crashReporter != nil {
+		defer s.crashReporter.Recover()
+	}
+	release, err := s.beginBlockWork()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	return s.commit(ctx, req)
 }
 
@@ -107,49 +153,21 @@ func (s *Service[LoggerT]) Commit(
 // NOOP methods
 //
 
-func (Service[_]) Query(
+func (*Service[_]) Query(
 	context.Context,
 	*abci.QueryRequest,
 ) (*abci.QueryResponse, error) {
 	return &abci.QueryResponse{}, nil
 }
 
-func (Service[_]) ListSnapshots(
-	context.Context,
-	*abci.ListSnapshotsRequest,
-) (*abci.ListSnapshotsResponse, error) {
-	return &abci.ListSnapshotsResponse{}, nil
-}
-
-func (Service[_]) LoadSnapshotChunk(
-	context.Context,
-	*abci.LoadSnapshotChunkRequest,
-) (*abci.LoadSnapshotChunkResponse, error) {
-	return &abci.LoadSnapshotChunkResponse{}, nil
-}
-
-func (Service[_]) OfferSnapshot(
-	context.Context,
-	*abci.OfferSnapshotRequest,
-) (*abci.OfferSnapshotResponse, error) {
-	return &abci.OfferSnapshotResponse{}, nil
-}
-
-func (Service[_]) ApplySnapshotChunk(
-	context.Context,
-	*abci.ApplySnapshotChunkRequest,
-) (*abci.ApplySnapshotChunkResponse, error) {
-	return &abci.ApplySnapshotChunkResponse{}, nil
-}
-
-func (Service[_]) ExtendVote(
+func (*Service[_]) ExtendVote(
 	context.Context,
 	*abci.ExtendVoteRequest,
 ) (*abci.ExtendVoteResponse, error) {
 	return &abci.ExtendVoteResponse{}, nil
 }
 
-func (Service[_]) VerifyVoteExtension(
+func (*Service[_]) VerifyVoteExtension(
 	context.Context,
 	*abci.VerifyVoteExtensionRequest,
 ) (*abci.VerifyVoteExtensionResponse, error) {
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"errors"
+
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/cometbft/cometbft/node"
+	sm "github.com/cometbft/cometbft/state"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// BeaconBlockBytes returns the SSZ encoded beacon block committed at the
+// given height, nil if CometBFT holds no block at this height, e.g. because
+// it was pruned. It errors if CometBFT is not running.
+func (s *Service[_]) BeaconBlockBytes(height int64) ([]byte, error) {
+	s.nodeMu.RLock()
+	n := s.node
+	s.nodeMu.RUnlock()
+	if n == nil || !n.IsRunning() {
+		return nil, errNodeNotRunning
+	}
+
+	blk, _ := n.BlockStore().LoadBlock(height)
+	if blk == nil || uint(len(blk.Txs)) <= blockchain.BeaconBlockTxIndex {
+		return nil, nil
+	}
+	return blk.Txs[blockchain.BeaconBlockTxIndex], nil
+}
+
+// BlockBase returns the lowest height of the blocks held by CometBFT, those
+// below having been pruned. It errors if CometBFT is not running.
+func (s *Service[_]) BlockBase() (int64, error) {
+	n, err := s.runningNode()
+	if err != nil {
+		return 0, err
+	}
+	return n.BlockStore().Base(), nil
+}
+
+// FinalizedBlock returns the SSZ encoded beacon block committed at the given
+// height along with the consensus data it was finalized with, i.e. its
+// proposer, consensus time and reported misbehaviors, to replay it. The
+// block is nil if CometBFT holds no block at this height, and so is the
+// context if neither is held. It errors if CometBFT is not running.
+func (s *Service[_]) FinalizedBlock(
+	height int64,
+) ([]byte, *transition.Context, error) {
+	n, err := s.runningNode()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blk, _ := n.BlockStore().LoadBlock(height)
+	if blk == nil {
+		return nil, nil, nil
+	}
+	ctx := &transition.Context{
+		ProposerAddress: blk.ProposerAddress,
+		//#nosec:G115 // block times are after the epoch.
+		ConsensusTime: math.U64(blk.Time.Unix()),
+		Misbehaviors: encoding.MisbehaviorsFromABCI(
+			blk.Evidence.Evidence.ToABCI(),
+		),
+	}
+	if uint(len(blk.Txs)) <= blockchain.BeaconBlockTxIndex {
+		return nil, ctx, nil
+	}
+	return blk.Txs[blockchain.BeaconBlockTxIndex], ctx, nil
+}
+
+// LightBlock returns the CometBFT light block committed at the given height,
+// i.e. its header, the commit of the header and the validator set that signed
+// it, along with the proof of the beacon block transaction in the header. It
+// returns nil if CometBFT holds no block or validator set at this height, and
+// errors if CometBFT is not running.
+func (s *Service[_]) LightBlock(
+	height int64,
+) (*cmttypes.LightBlock, *cmttypes.TxProof, error) {
+	s.nodeMu.RLock()
+	n := s.node
+	s.nodeMu.RUnlock()
+	if n == nil || !n.IsRunning() {
+		return nil, nil, errNodeNotRunning
+	}
+
+	bs := n.BlockStore()
+	blk, _ := bs.LoadBlock(height)
+	if blk == nil || uint(len(blk.Txs)) <= blockchain.BeaconBlockTxIndex {
+		return nil, nil, nil
+	}
+	// The commit of a block is stored along with the next block, so the
+	// latest block only has the commit seen by this node.
+	commit := bs.LoadBlockCommit(height)
+	if commit == nil {
+		commit = bs.LoadSeenCommit(height)
+	}
+	if commit == nil {
+		return nil, nil, nil
+	}
+
+	stateStore, err := s.loadStateStore(n)
+	if err != nil {
+		return nil, nil, err
+	}
+	vals, err := stateStore.LoadValidators(height)
+	if errors.As(err, new(sm.ErrNoValSetForHeight)) {
+		return nil, nil, nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	//#nosec:G701 // the index is a small constant.
+	proof := blk.Txs.Proof(int(blockchain.BeaconBlockTxIndex))
+	return &cmttypes.LightBlock{
+		SignedHeader: &cmttypes.SignedHeader{
+			Header: &blk.Header,
+			Commit: commit,
+		},
+		ValidatorSet: vals,
+	}, &proof, nil
+}
+
+// loadStateStore returns the state store of the given node. CometBFT only
+// exposes it through the environment of its RPC, which is built on first use.
+func (s *Service[_]) loadStateStore(n *node.Node) (sm.Store, error) {
+	s.nodeMu.Lock()
+	defer s.nodeMu.Unlock()
+	if s.stateStore == nil {
+		env, err := n.ConfigureRPC()
+		if err != nil {
+			return nil, err
+		}
+		s.stateStore = env.StateStore
+	}
+	return s.stateStore, nil
+}
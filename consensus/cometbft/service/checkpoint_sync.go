@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	prooftypes "github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	sm "github.com/cometbft/cometbft/state"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+)
+
+const (
+	// finalityProofPath is the path of the node API serving the finality
+	// proof of the block committed at a height.
+	finalityProofPath = "/bkit/v1/proof/finality/"
+	// checkpointRequestTimeout bounds each request to the checkpoint node.
+	checkpointRequestTimeout = 30 * time.Second
+	// maxFinalityProofSize bounds the size of the finality proofs read from
+	// the checkpoint node.
+	maxFinalityProofSize = 16 << 20
+)
+
+// ErrCheckpointSync is returned when the checkpoint node does not serve the
+// data needed to restore a snapshot, or serves inconsistent data.
+var ErrCheckpointSync = errors.New("checkpoint sync failed")
+
+// checkpointStateProvider provides CometBFT state sync with the app hashes,
+// commits and states to restore the snapshots of its peers against, as
+// served by the node API of a trusted node. It stands in for the light client
+// CometBFT otherwise verifies them with from a trusted height and hash.
+//
+// The light blocks served are verified to be signed by their validator set
+// and to chain to each other, while the first one of each request is trusted.
+type checkpointStateProvider struct {
+	url     string
+	client  *http.Client
+	genesis *cmttypes.GenesisDoc
+}
+
+// newCheckpointStateProvider creates a state provider for the chain of the
+// given genesis, backed by the node API served at the given URL.
+func newCheckpointStateProvider(
+	url string, genesis *cmttypes.GenesisDoc,
+) *checkpointStateProvider {
+	return &checkpointStateProvider{
+		url:     strings.TrimSuffix(url, "/"),
+		client:  &http.Client{Timeout: checkpointRequestTimeout},
+		genesis: genesis,
+	}
+}
+
+// AppHash returns the app hash after the given height has been committed,
+// i.e. the one of the header at the next height. Since restoring a snapshot
+// needs the light blocks up to two heights above it, it also checks the
+// last one is available.
+func (p *checkpointStateProvider) AppHash(
+	ctx context.Context, height uint64,
+) ([]byte, error) {
+	lightBlocks, err := p.lightBlocks(ctx, height+1, 2)
+	if err != nil {
+		return nil, err
+	}
+	return lightBlocks[0].AppHash, nil
+}
+
+// Commit returns the commit of the block at the given height.
+func (p *checkpointStateProvider) Commit(
+	ctx context.Context, height uint64,
+) (*cmttypes.Commit, error) {
+	lightBlocks, err := p.lightBlocks(ctx, height, 1)
+	if err != nil {
+		return nil, err
+	}
+	return lightBlocks[0].Commit, nil
+}
+
+// State returns the CometBFT state after the block at the given height has
+// been committed, as CometBFT's light client state provider builds it.
+func (p *checkpointStateProvider) State(
+	ctx context.Context, height uint64,
+) (sm.State, error) {
+	lightBlocks, err := p.lightBlocks(ctx, height, 3)
+	if err != nil {
+		return sm.State{}, err
+	}
+	last, current, next := lightBlocks[0], lightBlocks[1], lightBlocks[2]
+
+	// The consensus params are set at genesis and never updated, which the
+	// hash committed to by the header makes sure of.
+	params := p.genesis.ConsensusParams
+	if params == nil ||
+		!bytes.Equal(params.Hash(), current.ConsensusHash) {
+		return sm.State{}, errors.Wrapf(
+			ErrCheckpointSync,
+			"consensus params at height %d differ from genesis",
+			current.Height,
+		)
+	}
+
+	initialHeight := p.genesis.InitialHeight
+	if initialHeight == 0 {
+		initialHeight = 1
+	}
+	return sm.State{
+		ChainID:       p.genesis.ChainID,
+		InitialHeight: initialHeight,
+		Version: cmtstate.Version{
+			Consensus: current.Version,
+			Software:  version.CMTSemVer,
+		},
+		LastBlockHeight:                  last.Height,
+		LastBlockTime:                    last.Time,
+		LastBlockID:                      last.Commit.BlockID,
+		AppHash:                          current.AppHash,
+		LastResultsHash:                  current.LastResultsHash,
+		LastValidators:                   last.ValidatorSet,
+		Validators:                       current.ValidatorSet,
+		NextValidators:                   next.ValidatorSet,
+		LastHeightValidatorsChanged:      next.Height,
+		ConsensusParams:                  *params,
+		LastHeightConsensusParamsChanged: current.Height,
+	}, nil
+}
+
+// lightBlocks returns the light blocks of the n heights from the given one,
+// checking each one is signed by its validator set, the validator set of the
+// first one being trusted, and is the child of the previous one.
+func (p *checkpointStateProvider) lightBlocks(
+	ctx context.Context, height uint64, n int,
+) ([]*cmttypes.LightBlock, error) {
+	lightBlocks := make([]*cmttypes.LightBlock, 0, n)
+	for i := range uint64(n) {
+		proof, err := p.finalityProof(ctx, height+i)
+		if err != nil {
+			return nil, err
+		}
+
+		var trustedValidatorsHash []byte
+		if i == 0 {
+			trustedValidatorsHash, err = validatorsHash(proof)
+		} else {
+			trustedValidatorsHash = lightBlocks[i-1].NextValidatorsHash
+		}
+		if err != nil {
+			return nil, err
+		}
+		lightBlock, err := proof.Verify(p.genesis.ChainID, trustedValidatorsHash)
+		if err != nil {
+			return nil, errors.Wrapf(
+				ErrCheckpointSync, "height %d: %v", height+i, err,
+			)
+		}
+		if i > 0 && !bytes.Equal(
+			lightBlock.LastBlockID.Hash, lightBlocks[i-1].Hash(),
+		) {
+			return nil, errors.Wrapf(
+				ErrCheckpointSync,
+				"block at height %d is not the child of the previous one",
+				lightBlock.Height,
+			)
+		}
+		lightBlocks = append(lightBlocks, lightBlock)
+	}
+	return lightBlocks, nil
+}
+
+// finalityProof requests the finality proof of the block at the given height
+// from the checkpoint node.
+func (p *checkpointStateProvider) finalityProof(
+	ctx context.Context, height uint64,
+) (*prooftypes.FinalityProofResponse, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		p.url+finalityProofPath+strconv.FormatUint(height, 10),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "checkpoint node")
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxFinalityProofSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "checkpoint node")
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"%w: height %d: %s: %s", ErrCheckpointSync, height, res.Status,
+			strings.TrimSpace(string(body)),
+		)
+	}
+
+	proof := &prooftypes.FinalityProofResponse{}
+	if err = json.Unmarshal(body, proof); err != nil {
+		return nil, errors.Wrapf(err, "checkpoint node: height %d", height)
+	}
+	return proof, nil
+}
+
+// validatorsHash returns the hash of the validator set of the light block
+// of the given finality proof.
+func validatorsHash(proof *prooftypes.FinalityProofResponse) ([]byte, error) {
+	var lbProto cmtproto.LightBlock
+	if err := lbProto.Unmarshal(proof.LightBlock); err != nil {
+		return nil, errors.Join(ErrCheckpointSync, err)
+	}
+	if lbProto.ValidatorSet == nil {
+		return nil, errors.Wrap(ErrCheckpointSync, "missing validator set")
+	}
+	vals, err := cmttypes.ValidatorSetFromProto(lbProto.ValidatorSet)
+	if err != nil {
+		return nil, errors.Join(ErrCheckpointSync, err)
+	}
+	return vals.Hash(), nil
+}
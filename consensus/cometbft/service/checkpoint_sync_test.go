@@ -0,0 +1,238 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	prooftypes "github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+const checkpointChainID = "beacond-checkpoint"
+
+// checkpointChain is a chain of CometBFT blocks signed by a single validator
+// set, each committing a beacon block at its height.
+type checkpointChain struct {
+	genesis *cmttypes.GenesisDoc
+	vals    *cmttypes.ValidatorSet
+	privs   []cmttypes.PrivValidator
+	blocks  map[uint64]*cmttypes.LightBlock
+	proofs  map[uint64]*prooftypes.FinalityProofResponse
+}
+
+// newCheckpointChain builds the blocks of the heights [1, n].
+func newCheckpointChain(t *testing.T, n uint64) *checkpointChain {
+	t.Helper()
+	vals, privs := cmttypes.RandValidatorSet(4, 10)
+	c := &checkpointChain{
+		genesis: &cmttypes.GenesisDoc{
+			ChainID:         checkpointChainID,
+			InitialHeight:   1,
+			ConsensusParams: cmttypes.DefaultConsensusParams(),
+		},
+		vals:   vals,
+		privs:  privs,
+		blocks: make(map[uint64]*cmttypes.LightBlock),
+		proofs: make(map[uint64]*prooftypes.FinalityProofResponse),
+	}
+	var lastBlockID cmttypes.BlockID
+	for height := uint64(1); height <= n; height++ {
+		lastBlockID = c.addBlock(t, height, lastBlockID)
+	}
+	return c
+}
+
+// addBlock signs the block at the given height, child of the given block,
+// and returns its ID.
+func (c *checkpointChain) addBlock(
+	t *testing.T, height uint64, lastBlockID cmttypes.BlockID,
+) cmttypes.BlockID {
+	t.Helper()
+	blk := &ctypes.BeaconBlock{
+		Slot: math.Slot(height),
+		Body: &ctypes.BeaconBlockBody{
+			ExecutionPayload: &ctypes.ExecutionPayload{
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: &ctypes.Eth1Data{},
+		},
+	}
+	bz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	//#nosec:G115 // small heights.
+	h := int64(height)
+	cmtBlock := cmttypes.MakeBlock(
+		h, []cmttypes.Tx{bz, []byte("sidecars")}, &cmttypes.Commit{}, nil,
+	)
+	cmtBlock.Version = cmtversion.Consensus{Block: 11}
+	cmtBlock.ChainID = checkpointChainID
+	cmtBlock.Time = time.Unix(int64(1000+height), 0).UTC()
+	cmtBlock.LastBlockID = lastBlockID
+	cmtBlock.AppHash = bytes.Repeat([]byte{byte(height)}, 32)
+	cmtBlock.LastResultsHash = bytes.Repeat([]byte{byte(height), 1}, 16)
+	cmtBlock.ConsensusHash = c.genesis.ConsensusParams.Hash()
+	cmtBlock.ValidatorsHash = c.vals.Hash()
+	cmtBlock.NextValidatorsHash = c.vals.Hash()
+	cmtBlock.ProposerAddress = c.vals.GetProposer().Address
+	parts, err := cmtBlock.MakePartSet(cmttypes.BlockPartSizeBytes)
+	require.NoError(t, err)
+	blockID := cmttypes.BlockID{
+		Hash:          cmtBlock.Hash(),
+		PartSetHeader: parts.Header(),
+	}
+
+	voteSet := cmttypes.NewVoteSet(
+		checkpointChainID, h, 0, cmttypes.PrecommitType, c.vals,
+	)
+	extCommit, err := cmttypes.MakeExtCommit(
+		blockID, h, 0, voteSet, c.privs, time.Now(), false,
+	)
+	require.NoError(t, err)
+
+	lightBlock := &cmttypes.LightBlock{
+		SignedHeader: &cmttypes.SignedHeader{
+			Header: &cmtBlock.Header,
+			Commit: extCommit.ToCommit(),
+		},
+		ValidatorSet: c.vals,
+	}
+	txProof := cmtBlock.Txs.Proof(0)
+	proof, err := prooftypes.NewFinalityProofResponse(
+		blk, lightBlock, &txProof,
+	)
+	require.NoError(t, err)
+	c.blocks[height] = lightBlock
+	c.proofs[height] = proof
+	return blockID
+}
+
+// serve serves the finality proofs of the chain as the node API does.
+func (c *checkpointChain) serve(t *testing.T) string {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			height, err := strconv.ParseUint(
+				strings.TrimPrefix(r.URL.Path, finalityProofPath), 10, 64,
+			)
+			proof, ok := c.proofs[height]
+			if err != nil || !ok {
+				http.Error(w, "not found", http.StatusNotFound)
+				return
+			}
+			require.NoError(t, json.NewEncoder(w).Encode(proof))
+		},
+	))
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+func TestCheckpointStateProvider(t *testing.T) {
+	chain := newCheckpointChain(t, 5)
+	p := newCheckpointStateProvider(chain.serve(t)+"/", chain.genesis)
+	ctx := context.Background()
+
+	appHash, err := p.AppHash(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, []byte(chain.blocks[3].AppHash), appHash)
+
+	commit, err := p.Commit(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, chain.blocks[2].Commit.BlockID, commit.BlockID)
+
+	st, err := p.State(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, checkpointChainID, st.ChainID)
+	require.Equal(t, int64(1), st.InitialHeight)
+	require.Equal(t, int64(2), st.LastBlockHeight)
+	require.Equal(t, chain.blocks[2].Time, st.LastBlockTime)
+	require.Equal(t, chain.blocks[2].Commit.BlockID, st.LastBlockID)
+	require.Equal(t, []byte(chain.blocks[3].AppHash), st.AppHash)
+	require.Equal(
+		t, []byte(chain.blocks[3].LastResultsHash), st.LastResultsHash,
+	)
+	require.Equal(t, chain.vals.Hash(), st.Validators.Hash())
+	require.Equal(t, chain.vals.Hash(), st.NextValidators.Hash())
+	require.Equal(t, *chain.genesis.ConsensusParams, st.ConsensusParams)
+}
+
+func TestCheckpointStateProviderInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		height uint64
+		tamper func(t *testing.T, c *checkpointChain)
+	}{
+		{
+			name:   "missing block above the snapshot",
+			height: 4,
+			tamper: func(*testing.T, *checkpointChain) {},
+		},
+		{
+			name:   "block not chained to the previous one",
+			height: 2,
+			tamper: func(t *testing.T, c *checkpointChain) {
+				t.Helper()
+				c.addBlock(t, 3, cmttypes.BlockID{})
+			},
+		},
+		{
+			name:   "consensus params differing from genesis",
+			height: 2,
+			tamper: func(_ *testing.T, c *checkpointChain) {
+				params := *c.genesis.ConsensusParams
+				params.Block.MaxBytes++
+				c.genesis.ConsensusParams = &params
+			},
+		},
+		{
+			name:   "block of another validator set",
+			height: 2,
+			tamper: func(t *testing.T, c *checkpointChain) {
+				t.Helper()
+				c.vals, c.privs = cmttypes.RandValidatorSet(4, 10)
+				c.addBlock(t, 3, c.blocks[2].Commit.BlockID)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := newCheckpointChain(t, 5)
+			tt.tamper(t, chain)
+			p := newCheckpointStateProvider(chain.serve(t), chain.genesis)
+
+			_, err := p.State(context.Background(), tt.height)
+			require.ErrorIs(t, err, ErrCheckpointSync)
+		})
+	}
+}
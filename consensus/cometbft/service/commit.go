@@ -26,6 +26,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 )
 
@@ -45,14 +46,48 @@ func (s *Service[LoggerT]) commit(
 		rms.SetCommitHeader(header)
 	}
 	s.sm.CommitMultiStore().Commit()
+	s.notifyCommitListeners(header.Height)
 
 	s.finalizeBlockState = nil
 
+	// Snapshots are taken in the background, from the committed version.
+	s.snapshotManager.SnapshotIfApplicable(header.Height)
+
 	return &cmtabci.CommitResponse{
 		RetainHeight: retainHeight,
 	}, nil
 }
 
+// AddCommitListener registers a listener notified, once each block is
+// committed, of the changes it made to the beacon state. Changes are only
+// recorded once a listener is registered.
+func (s *Service[_]) AddCommitListener(
+	listener func(height int64, changes []*storetypes.StoreKVPair),
+) {
+	s.commitListenersMu.Lock()
+	defer s.commitListenersMu.Unlock()
+	if len(s.commitListeners) == 0 {
+		s.sm.CommitMultiStore().AddListeners(
+			[]storetypes.StoreKey{s.storeKey},
+		)
+	}
+	s.commitListeners = append(s.commitListeners, listener)
+}
+
+// notifyCommitListeners hands the changes committed at the given height to
+// the commit listeners.
+func (s *Service[_]) notifyCommitListeners(height int64) {
+	s.commitListenersMu.RLock()
+	defer s.commitListenersMu.RUnlock()
+	if len(s.commitListeners) == 0 {
+		return
+	}
+	changes := s.sm.CommitMultiStore().PopStateCache()
+	for _, listener := range s.commitListeners {
+		listener(height, changes)
+	}
+}
+
 // GetBlockRetentionHeight returns the height for which all blocks below this
 // height
 // are pruned from CometBFT. Given a commitment height and a non-zero local
@@ -123,6 +158,19 @@ func (s *Service[_]) GetBlockRetentionHeight(commitHeight int64) int64 {
 	v := commitHeight - int64(s.minRetainBlocks)
 	retentionHeight = minNonZero(retentionHeight, v)
 
+	// Blocks since the oldest state sync snapshot are retained, for the
+	// nodes restoring it to catch up.
+	if s.snapshotManager != nil {
+		if heights := s.snapshotManager.GetSnapshotBlockRetentionHeights(); heights > 0 {
+			retentionHeight = minNonZero(retentionHeight, commitHeight-heights)
+		}
+	}
+
+	// Blocks not yet copied out of CometBFT are retained.
+	if s.retainedFrom != nil {
+		retentionHeight = minNonZero(retentionHeight, s.retainedFrom())
+	}
+
 	if retentionHeight <= 0 {
 		// prune nothing in the case of a non-positive height
 		return 0
@@ -21,6 +21,7 @@
 package encoding
 
 import (
+	"github.com/berachain/beacon-kit/consensus-types/registry"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	datypes "github.com/berachain/beacon-kit/da/types"
 )
@@ -54,6 +55,7 @@ func ExtractBlobsAndBlockFromRequest(
 	blobs, err = UnmarshalBlobSidecarsFromABCIRequest(
 		req,
 		blobSidecarsIndex,
+		forkVersion,
 	)
 	if err != nil {
 		return blk, blobs, err
@@ -92,7 +94,7 @@ func UnmarshalBeaconBlockFromABCIRequest(
 		return blk, ErrNilBeaconBlockInRequest
 	}
 
-	return blk.NewFromSSZ(blkBz, forkVersion)
+	return registry.Default.BeaconBlocks.Unmarshal(forkVersion, blkBz)
 }
 
 // UnmarshalBlobSidecarsFromABCIRequest extracts blob sidecars from an ABCI
@@ -100,6 +102,7 @@ func UnmarshalBeaconBlockFromABCIRequest(
 func UnmarshalBlobSidecarsFromABCIRequest(
 	req ABCIRequest,
 	bzIndex uint,
+	forkVersion uint32,
 ) (datypes.BlobSidecars, error) {
 	var sidecars datypes.BlobSidecars
 	if req == nil {
@@ -116,8 +119,11 @@ func UnmarshalBlobSidecarsFromABCIRequest(
 		return sidecars, ErrNilBeaconBlockInRequest
 	}
 
-	// TODO: Do some research to figure out how to make this more
-	// elegant.
-	sidecars = datypes.BlobSidecars{}
-	return sidecars, sidecars.UnmarshalSSZ(sidecarBz)
+	decoded, err := registry.Default.BlobSidecars.Unmarshal(
+		forkVersion, sidecarBz,
+	)
+	if err != nil {
+		return sidecars, err
+	}
+	return *decoded, nil
 }
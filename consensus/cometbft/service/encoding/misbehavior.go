@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encoding
+
+import (
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+)
+
+// MisbehaviorsFromABCI converts the validators misbehaviors reported by
+// CometBFT in an ABCI request into their beacon chain representation.
+// Misbehaviors of unknown type are dropped.
+func MisbehaviorsFromABCI(
+	misbehaviors []cmtabci.Misbehavior,
+) []*transition.Misbehavior {
+	res := make([]*transition.Misbehavior, 0, len(misbehaviors))
+	for _, m := range misbehaviors {
+		switch m.GetType() {
+		case cmtabci.MISBEHAVIOR_TYPE_DUPLICATE_VOTE,
+			cmtabci.MISBEHAVIOR_TYPE_LIGHT_CLIENT_ATTACK:
+			res = append(res, &transition.Misbehavior{
+				Address: m.GetValidator().Address,
+				//#nosec:G701 // heights are never negative.
+				Height: math.U64(m.GetHeight()),
+			})
+		default:
+			continue
+		}
+	}
+	return res
+}
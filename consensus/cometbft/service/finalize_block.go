@@ -24,7 +24,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/tracing"
+	"github.com/berachain/beacon-kit/primitives/math"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sourcegraph/conc/iter"
 )
 
@@ -32,7 +36,13 @@ func (s *Service[LoggerT]) finalizeBlock(
 	ctx context.Context,
 	req *cmtabci.FinalizeBlockRequest,
 ) (*cmtabci.FinalizeBlockResponse, error) {
+	ctx, span := tracing.Start(
+		ctx, "FinalizeBlock", tracing.Slot(math.Slot(req.Height)),
+	)
+	defer span.End()
+
 	res, err := s.finalizeBlockInternal(ctx, req)
+	tracing.RecordError(span, err)
 	if res != nil {
 		res.AppHash = s.workingHash()
 	}
@@ -72,8 +82,26 @@ func (s *Service[LoggerT]) finalizeBlockInternal(
 		}
 	}
 
+	// Start from an empty event manager, so that the block events do not
+	// include the ones emitted while initializing the chain. The entries
+	// logged while finalizing the block, from the PreBlockers on, share the
+	// correlation identifier of its hash.
+	s.finalizeBlockState.SetContext(withCorrelationID(
+		s.finalizeBlockState.Context().WithEventManager(
+			sdk.NewEventManager(),
+		),
+		log.BlockCorrelationID(req.Hash),
+	))
+	if err := s.runPreBlockers(
+		s.finalizeBlockState.Context(), req,
+	); err != nil {
+		return nil, err
+	}
+	// The state may have been reset while processing the proposal, in
+	// another trace.
+	sdkCtx := s.finalizeBlockState.Context()
 	finalizeBlock, err := s.Blockchain.FinalizeBlock(
-		s.finalizeBlockState.Context(),
+		sdkCtx.WithContext(tracing.WithSpanOf(sdkCtx.Context(), ctx)),
 		req,
 	)
 	if err != nil {
@@ -82,13 +110,15 @@ func (s *Service[LoggerT]) finalizeBlockInternal(
 
 	valUpdates, err := iter.MapErr(
 		finalizeBlock,
-		convertValidatorUpdate[cmtabci.ValidatorUpdate],
+		convertValidatorUpdate[cmtabci.ValidatorUpdate](s.votingPower),
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	events := s.finalizeBlockState.Context().EventManager().ABCIEvents()
 	return &cmtabci.FinalizeBlockResponse{
+		Events:                events,
 		TxResults:             txResults,
 		ValidatorUpdates:      valUpdates,
 		ConsensusParamUpdates: s.paramStore.Get(),
@@ -26,6 +26,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
@@ -281,3 +282,15 @@ func GetGenDocProvider(
 		}, nil
 	}
 }
+
+// GenesisTime returns the genesis time of the chain as set in the genesis
+// file CometBFT was started with. It errors if CometBFT is not running.
+func (s *Service[_]) GenesisTime() (time.Time, error) {
+	s.nodeMu.RLock()
+	n := s.node
+	s.nodeMu.RUnlock()
+	if n == nil || !n.IsRunning() {
+		return time.Time{}, errNodeNotRunning
+	}
+	return n.GenesisDoc().GenesisTime, nil
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PreBlocker runs upon FinalizeBlock, before the beacon block is processed.
+// State changes made through ctx are committed along with the block.
+type PreBlocker func(
+	ctx sdk.Context, req *cmtabci.FinalizeBlockRequest,
+) error
+
+// PrepareProposalHandler handles a PrepareProposal request.
+type PrepareProposalHandler func(
+	ctx context.Context, req *cmtabci.PrepareProposalRequest,
+) (*cmtabci.PrepareProposalResponse, error)
+
+// PrepareProposalWrapper wraps a PrepareProposalHandler, e.g. to amend the
+// proposal built by the wrapped handler.
+type PrepareProposalWrapper func(
+	next PrepareProposalHandler,
+) PrepareProposalHandler
+
+// ProcessProposalHandler handles a ProcessProposal request.
+type ProcessProposalHandler func(
+	ctx context.Context, req *cmtabci.ProcessProposalRequest,
+) (*cmtabci.ProcessProposalResponse, error)
+
+// ProcessProposalWrapper wraps a ProcessProposalHandler, e.g. to perform
+// additional checks on the proposal.
+type ProcessProposalWrapper func(
+	next ProcessProposalHandler,
+) ProcessProposalHandler
+
+// Handlers are the custom handlers injected in the ABCI flow by chains
+// embedding beacon-kit.
+type Handlers struct {
+	// PreBlockers run in order before each block is finalized.
+	PreBlockers []PreBlocker
+	// PrepareProposalWrappers wrap the PrepareProposal handler. The first
+	// wrapper is the outermost one.
+	PrepareProposalWrappers []PrepareProposalWrapper
+	// ProcessProposalWrappers wrap the ProcessProposal handler. The first
+	// wrapper is the outermost one.
+	ProcessProposalWrappers []ProcessProposalWrapper
+}
+
+// runPreBlockers runs the registered PreBlockers, stopping at the first
+// failing one.
+func (s *Service[_]) runPreBlockers(
+	ctx sdk.Context, req *cmtabci.FinalizeBlockRequest,
+) error {
+	for _, preBlocker := range s.preBlockers {
+		if err := preBlocker(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetHandlersWrappingOrder(t *testing.T) {
+	var calls []string
+	wrapper := func(name string) ProcessProposalWrapper {
+		return func(next ProcessProposalHandler) ProcessProposalHandler {
+			return func(
+				ctx context.Context, req *cmtabci.ProcessProposalRequest,
+			) (*cmtabci.ProcessProposalResponse, error) {
+				calls = append(calls, name)
+				return next(ctx, req)
+			}
+		}
+	}
+
+	s := &Service[*phuslu.Logger]{}
+	s.processProposalHandler = func(
+		context.Context, *cmtabci.ProcessProposalRequest,
+	) (*cmtabci.ProcessProposalResponse, error) {
+		calls = append(calls, "default")
+		return &cmtabci.ProcessProposalResponse{
+			Status: cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		}, nil
+	}
+	SetHandlers[*phuslu.Logger](Handlers{
+		ProcessProposalWrappers: []ProcessProposalWrapper{
+			wrapper("outer"), wrapper("inner"),
+		},
+	})(s)
+
+	res, err := s.ProcessProposal(
+		context.Background(), &cmtabci.ProcessProposalRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT, res.Status)
+	require.Equal(t, []string{"outer", "inner", "default"}, calls)
+}
+
+func TestRunPreBlockersStopsAtFirstError(t *testing.T) {
+	errPreBlocker := errors.New("pre-blocker failure")
+	var calls int
+	preBlocker := func(err error) PreBlocker {
+		return func(sdk.Context, *cmtabci.FinalizeBlockRequest) error {
+			calls++
+			return err
+		}
+	}
+
+	s := &Service[*phuslu.Logger]{}
+	SetHandlers[*phuslu.Logger](Handlers{
+		PreBlockers: []PreBlocker{
+			preBlocker(nil), preBlocker(errPreBlocker), preBlocker(nil),
+		},
+	})(s)
+
+	err := s.runPreBlockers(sdk.Context{}, &cmtabci.FinalizeBlockRequest{})
+	require.ErrorIs(t, err, errPreBlocker)
+	require.Equal(t, 2, calls)
+}
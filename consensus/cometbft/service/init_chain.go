@@ -148,6 +148,6 @@ func (s *Service[LoggerT]) initChainer(
 
 	return iter.MapErr(
 		valUpdates,
-		convertValidatorUpdate[cmtabci.ValidatorUpdate],
+		convertValidatorUpdate[cmtabci.ValidatorUpdate](s.votingPower),
 	)
 }
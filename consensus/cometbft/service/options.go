@@ -21,8 +21,13 @@
 package cometbft
 
 import (
+	"slices"
+
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
 	"github.com/berachain/beacon-kit/log"
 )
 
@@ -75,9 +80,89 @@ func SetInterBlockCache[
 	}
 }
 
+// SetSnapshot makes the service take state sync snapshots of the multistore,
+// along with the given extensions, into the given store, and serve them to
+// the nodes joining with CometBFT state sync. Snapshotted heights are kept
+// from being pruned until their snapshot is taken.
+func SetSnapshot[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	store *snapshots.Store,
+	opts snapshottypes.SnapshotOptions,
+	extensions ...snapshottypes.ExtensionSnapshotter,
+) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) {
+		cms := s.sm.CommitMultiStore()
+		cms.SetSnapshotInterval(opts.Interval)
+		s.snapshotManager = snapshots.NewManager(
+			store, opts, cms, nil, servercmtlog.WrapSDKLogger(s.logger),
+		)
+		if err := s.snapshotManager.RegisterExtensions(
+			extensions...,
+		); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// SetCheckpointSync makes the service checkpoint sync from the node API of
+// the trusted node at the given URL when it starts without state. The
+// snapshots are restored with the snapshot manager set by SetSnapshot.
+func SetCheckpointSync[
+	LoggerT log.AdvancedLogger[LoggerT],
+](url string) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) {
+		s.checkpointSyncURL = url
+	}
+}
+
 // SetChainID sets the chain ID in cometbft.
 func SetChainID[
 	LoggerT log.AdvancedLogger[LoggerT],
 ](chainID string) func(*Service[LoggerT]) {
 	return func(s *Service[LoggerT]) { s.chainID = chainID }
 }
+
+// SetEphemeralPrivValidator makes the service run CometBFT with a throwaway
+// in-memory validator key instead of the one persisted on disk. The key is
+// never part of the validator set, so the node never signs.
+func SetEphemeralPrivValidator[
+	LoggerT log.AdvancedLogger[LoggerT],
+]() func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.ephemeralPrivValidator = true }
+}
+
+// SetSkipProposalVerification makes the service accept every proposal without
+// verifying it. Blocks are still executed upon finalization. This is only
+// safe for nodes which never vote.
+func SetSkipProposalVerification[
+	LoggerT log.AdvancedLogger[LoggerT],
+]() func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.skipProposalVerification = true }
+}
+
+// SetCrashReporter makes the service write a crash report when the processing
+// of a block panics.
+func SetCrashReporter[
+	LoggerT log.AdvancedLogger[LoggerT],
+](reporter CrashReporter) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.crashReporter = reporter }
+}
+
+// SetHandlers injects the given custom handlers in the ABCI flow.
+func SetHandlers[
+	LoggerT log.AdvancedLogger[LoggerT],
+](handlers Handlers) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) {
+		s.preBlockers = append(s.preBlockers, handlers.PreBlockers...)
+		// Wrap in reverse so that the first wrapper is the outermost one.
+		prepare := handlers.PrepareProposalWrappers
+		for _, wrap := range slices.Backward(prepare) {
+			s.prepareProposalHandler = wrap(s.prepareProposalHandler)
+		}
+		process := handlers.ProcessProposalWrappers
+		for _, wrap := range slices.Backward(process) {
+			s.processProposalHandler = wrap(s.processProposalHandler)
+		}
+	}
+}
@@ -25,7 +25,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
 	"github.com/berachain/beacon-kit/consensus/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/math"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 )
@@ -47,15 +50,23 @@ func (s *Service[LoggerT]) prepareProposal(
 		)
 	}
 
+	ctx, span := tracing.Start(
+		ctx, "PrepareProposal", tracing.Slot(math.Slot(req.Height)),
+	)
+	defer span.End()
+
 	// Always reset state given that PrepareProposal can timeout
 	// and be called again in a subsequent round.
-	s.prepareProposalState = s.resetState(ctx)
-	s.prepareProposalState.SetContext(
+	s.prepareProposalState = s.viewState(ctx)
+	// The hash of the block is unknown until it is built, the entries
+	// logged while building it share a random correlation identifier.
+	s.prepareProposalState.SetContext(withCorrelationID(
 		s.getContextForProposal(
 			s.prepareProposalState.Context(),
 			req.Height,
 		),
-	)
+		log.NewCorrelationID(),
+	))
 
 	var slotData = types.NewSlotData(
 		math.Slot(req.GetHeight()),
@@ -63,6 +74,7 @@ func (s *Service[LoggerT]) prepareProposal(
 		nil,
 		req.GetProposerAddress(),
 		req.GetTime(),
+		encoding.MisbehaviorsFromABCI(req.GetMisbehavior()),
 	)
 
 	//nolint:contextcheck // TODO: We should look at using the passed context
@@ -71,7 +83,7 @@ func (s *Service[LoggerT]) prepareProposal(
 		*slotData,
 	)
 	if err != nil {
-		s.logger.Error(
+		log.WithContext(s.logger, s.prepareProposalState.Context()).Error(
 			"failed to prepare proposal",
 			"height",
 			req.Height,
@@ -80,6 +92,7 @@ func (s *Service[LoggerT]) prepareProposal(
 			"err",
 			err,
 		)
+		tracing.RecordError(span, err)
 		return &cmtabci.PrepareProposalResponse{Txs: req.Txs}, nil
 	}
 
@@ -25,6 +25,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/tracing"
+	"github.com/berachain/beacon-kit/primitives/math"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 )
 
@@ -45,6 +48,19 @@ func (s *Service[LoggerT]) processProposal(
 		)
 	}
 
+	ctx, span := tracing.Start(
+		ctx, "ProcessProposal", tracing.Slot(math.Slot(req.Height)),
+	)
+	defer span.End()
+
+	// Nodes which never vote have no use for verifying proposals, the block
+	// is executed anyhow upon finalization.
+	if s.skipProposalVerification {
+		return &cmtabci.ProcessProposalResponse{
+			Status: cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		}, nil
+	}
+
 	// Since the application can get access to FinalizeBlock state and write to
 	// it, we must be sure to reset it in case ProcessProposal timeouts and is
 	// called
@@ -52,24 +68,31 @@ func (s *Service[LoggerT]) processProposal(
 	// processed the first block, as we want to avoid overwriting the
 	// finalizeState
 	// after state changes during InitChain.
-	s.processProposalState = s.resetState(ctx)
+	// The proposal is verified against a view of the committed state, so
+	// that the tasks it starts can keep reading it while the block is
+	// finalized.
+	s.processProposalState = s.viewState(ctx)
 	if req.Height > s.initialHeight {
 		s.finalizeBlockState = s.resetState(ctx)
 	}
 
-	s.processProposalState.SetContext(
+	// The entries logged while verifying the block share the correlation
+	// identifier of its hash, along with the ones logged while finalizing it.
+	s.processProposalState.SetContext(withCorrelationID(
 		s.getContextForProposal(
 			s.processProposalState.Context(),
 			req.Height,
 		),
-	)
+		log.BlockCorrelationID(req.Hash),
+	))
 
 	resp, err := s.Blockchain.ProcessProposal(
 		s.processProposalState.Context(),
 		req,
 	)
 	if err != nil {
-		s.logger.Error(
+		tracing.RecordError(span, err)
+		log.WithContext(s.logger, s.processProposalState.Context()).Error(
 			"failed to process proposal",
 			"height",
 			req.Height,
@@ -24,7 +24,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/beacon/blockchain"
 	"github.com/berachain/beacon-kit/beacon/validator"
@@ -38,10 +41,12 @@ import (
 	"github.com/berachain/beacon-kit/primitives/transition"
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/node"
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -55,7 +60,11 @@ const (
 type Service[
 	LoggerT log.AdvancedLogger[LoggerT],
 ] struct {
+	// nodeMu guards node, which is set once CometBFT is started, and
+	// stateStore, which is loaded from node on first use.
+	nodeMu        sync.RWMutex
 	node          *node.Node
+	stateStore    sm.Store
 	cmtCfg        *cmtcfg.Config
 	telemetrySink TelemetrySink
 
@@ -84,12 +93,52 @@ type Service[
 
 	interBlockCache storetypes.MultiStorePersistentCache
 	paramStore      *params.ConsensusParamsStore
+	// snapshotManager takes and restores the state sync snapshots, if set.
+	snapshotManager *snapshots.Manager
+	// checkpointSyncURL is the node API of the trusted node to checkpoint
+	// sync from, if set.
+	checkpointSyncURL string
 
 	// initialHeight is the initial height at which we start the node
 	initialHeight   int64
 	minRetainBlocks uint64
+	// retainedFrom returns the lowest height whose block must be retained
+	// by CometBFT, if set.
+	retainedFrom func() int64
 
 	chainID string
+
+	// votingPower maps validators effective balance to their voting power.
+	votingPower votingPowerFn
+
+	// ephemeralPrivValidator runs CometBFT with an in-memory validator key.
+	ephemeralPrivValidator bool
+	// skipProposalVerification accepts proposals without verifying them.
+	skipProposalVerification bool
+
+	// preBlockers run before each block is finalized.
+	preBlockers []PreBlocker
+	// prepareProposalHandler handles PrepareProposal requests.
+	prepareProposalHandler PrepareProposalHandler
+	// processProposalHandler handles ProcessProposal requests.
+	processProposalHandler ProcessProposalHandler
+	// crashReporter writes a crash report when the processing of a block
+	// panics, if set.
+	crashReporter CrashReporter
+
+	// storeKey is the key of the store holding the beacon state.
+	storeKey *storetypes.KVStoreKey
+	// commitListenersMu guards commitListeners.
+	commitListenersMu sync.RWMutex
+	// commitListeners are notified of the changes to the beacon state
+	// committed by each block.
+	commitListeners []func(int64, []*storetypes.StoreKVPair)
+
+	// blockWorkMu is held for reading by in-flight block processing, and for
+	// writing by Stop to wait for it to drain.
+	blockWorkMu sync.RWMutex
+	// stopping is set once Stop drained block processing.
+	stopping bool
 }
 
 func NewService[
@@ -116,8 +165,12 @@ func NewService[
 		cmtCfg:        cmtCfg,
 		telemetrySink: telemetrySink,
 		paramStore:    params.NewConsensusParamsStore(cs),
+		votingPower:   newVotingPowerFn(cs),
+		storeKey:      storeKey,
 	}
 
+	s.prepareProposalHandler = s.prepareProposal
+	s.processProposalHandler = s.processProposal
 	s.MountStore(storeKey, storetypes.StoreTypeIAVL)
 
 	for _, option := range options {
@@ -146,16 +199,17 @@ func (s *Service[_]) Start(
 		return err
 	}
 
-	privVal, err := pvm.LoadOrGenFilePV(
-		cfg.PrivValidatorKeyFile(),
-		cfg.PrivValidatorStateFile(),
-		nil,
-	)
+	privVal, err := s.loadPrivValidator()
+	if err != nil {
+		return err
+	}
+
+	nodeOpts, err := s.checkpointSyncOptions()
 	if err != nil {
 		return err
 	}
 
-	s.node, err = node.NewNode(
+	n, err := node.NewNode(
 		ctx,
 		cfg,
 		privVal,
@@ -165,14 +219,62 @@ func (s *Service[_]) Start(
 		cmtcfg.DefaultDBProvider,
 		node.DefaultMetricsProvider(cfg.Instrumentation),
 		servercmtlog.WrapCometLogger(s.logger),
+		nodeOpts...,
 	)
 	if err != nil {
 		return err
 	}
 
-	return s.node.Start()
+	s.nodeMu.Lock()
+	s.node = n
+	s.nodeMu.Unlock()
+	return n.Start()
+}
+
+// checkpointSyncOptions returns the options making CometBFT state sync from
+// the snapshots of its peers, trusting the app hashes served by the node API
+// of the checkpoint node, if set. CometBFT only state syncs nodes without
+// state, so the options are harmless once the node has synced.
+func (s *Service[_]) checkpointSyncOptions() ([]node.Option, error) {
+	if s.checkpointSyncURL == "" {
+		return nil, nil
+	}
+	if s.snapshotManager == nil {
+		return nil, errCheckpointSyncWithoutSnapshots
+	}
+	genDoc, err := GetGenDocProvider(s.cmtCfg)()
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info(
+		"Checkpoint syncing from the snapshots of the peers",
+		"checkpoint_node", s.checkpointSyncURL,
+	)
+	s.cmtCfg.StateSync.Enable = true
+	return []node.Option{
+		node.StateProvider(newCheckpointStateProvider(
+			s.checkpointSyncURL, genDoc.GenesisDoc,
+		)),
+	}, nil
+}
+
+// loadPrivValidator returns the validator key CometBFT runs with.
+func (s *Service[_]) loadPrivValidator() (*pvm.FilePV, error) {
+	if s.ephemeralPrivValidator {
+		// The key is never persisted, so the node can never join the
+		// validator set and its key and state files are never written.
+		return pvm.NewFilePV(ed25519.GenPrivKey(), "", ""), nil
+	}
+	return pvm.LoadOrGenFilePV(
+		s.cmtCfg.PrivValidatorKeyFile(),
+		s.cmtCfg.PrivValidatorStateFile(),
+		nil,
+	)
 }
 
+// Stop stops CometBFT so that no new block is received, waits for the block
+// being processed, if any, to be finalized and committed, then closes the
+// application database.
 func (s *Service[_]) Stop() error {
 	var errs []error
 
@@ -182,6 +284,18 @@ func (s *Service[_]) Stop() error {
 		_ = s.node.Stop()
 	}
 
+	s.logger.Info("Draining in-flight block processing")
+	s.blockWorkMu.Lock()
+	s.stopping = true
+	s.blockWorkMu.Unlock()
+
+	if s.snapshotManager != nil {
+		s.logger.Info("Closing snapshots metadata")
+		if err := s.snapshotManager.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	s.logger.Info("Closing application.db")
 	if err := s.sm.Close(); err != nil {
 		errs = append(errs, err)
@@ -189,6 +303,18 @@ func (s *Service[_]) Stop() error {
 	return errors.Join(errs...)
 }
 
+// beginBlockWork marks the start of block processing, which Stop waits for.
+// The returned function must be called once processing is done. It errors
+// if the service is stopping.
+func (s *Service[_]) beginBlockWork() (func(), error) {
+	s.blockWorkMu.RLock()
+	if s.stopping {
+		s.blockWorkMu.RUnlock()
+		return nil, errShuttingDown
+	}
+	return s.blockWorkMu.RUnlock, nil
+}
+
 // Name returns the name of the cometbft.
 func (s *Service[_]) Name() string {
 	return appName
@@ -223,6 +349,27 @@ func (s *Service[_]) LastBlockHeight() int64 {
 	return s.sm.CommitMultiStore().LastCommitID().Version
 }
 
+// UpdatePruning sets the pruning options of the application store and the
+// minimum number of blocks retained by CometBFT. The update waits for the
+// block being processed, if any, so it is safe to call at runtime.
+func (s *Service[_]) UpdatePruning(
+	opts pruningtypes.PruningOptions, minRetainBlocks uint64,
+) {
+	s.blockWorkMu.Lock()
+	defer s.blockWorkMu.Unlock()
+	s.sm.CommitMultiStore().SetPruning(opts)
+	s.setMinRetainBlocks(minRetainBlocks)
+}
+
+// SetRetainedFrom sets the function returning the lowest height whose block
+// must not be pruned from CometBFT, e.g. until it is copied elsewhere. It is
+// safe to call at runtime.
+func (s *Service[_]) SetRetainedFrom(retainedFrom func() int64) {
+	s.blockWorkMu.Lock()
+	defer s.blockWorkMu.Unlock()
+	s.retainedFrom = retainedFrom
+}
+
 func (s *Service[_]) setMinRetainBlocks(minRetainBlocks uint64) {
 	s.minRetainBlocks = minRetainBlocks
 }
@@ -238,8 +385,38 @@ func (s *Service[_]) setInterBlockCache(
 // A state is explicitly returned to avoid false positives from
 // nilaway tool.
 func (s *Service[LoggerT]) resetState(ctx context.Context) *state {
-	ms := s.sm.CommitMultiStore().CacheMultiStore()
+	return s.newState(ctx, s.sm.CommitMultiStore().CacheMultiStore())
+}
 
+// viewState provides a fresh state branching off a copy-on-write view of the
+// last committed version of the stores, which can be used to reset the
+// prepareProposal/processProposal State. Unlike the state returned by
+// resetState, which reads through to the working stores, the view is not
+// affected by FinalizeBlock and Commit writing to them, so it can safely be
+// read from concurrently, for instance by the payload builds started while
+// processing a proposal. Nothing is copied: the branch only holds the writes
+// made to it. It falls back to resetState until a version is committed.
+func (s *Service[LoggerT]) viewState(ctx context.Context) *state {
+	cms := s.sm.CommitMultiStore()
+	version := cms.LatestVersion()
+	if version == 0 {
+		return s.resetState(ctx)
+	}
+	ms, err := cms.CacheMultiStoreWithVersion(version)
+	if err != nil {
+		s.logger.Warn(
+			"Failed to load committed state view, using working state",
+			"version", version, "err", err,
+		)
+		return s.resetState(ctx)
+	}
+	return s.newState(ctx, ms)
+}
+
+// newState returns a state backed by the given multistore.
+func (s *Service[LoggerT]) newState(
+	ctx context.Context, ms storetypes.CacheMultiStore,
+) *state {
 	newCtx := sdk.NewContext(
 		ms,
 		false,
@@ -253,24 +430,26 @@ func (s *Service[LoggerT]) resetState(ctx context.Context) *state {
 }
 
 // convertValidatorUpdate abstracts the conversion of a
-// transition.ValidatorUpdate to an appmodulev2.ValidatorUpdate.
+// transition.ValidatorUpdate to an appmodulev2.ValidatorUpdate, using the
+// given votingPower to compute the validator voting power.
 // TODO: this is so hood, bktypes -> sdktypes -> generic is crazy
 // maybe make this some kind of codec/func that can be passed in?
 func convertValidatorUpdate[ValidatorUpdateT any](
-	u **transition.ValidatorUpdate,
-) (ValidatorUpdateT, error) {
-	var valUpdate ValidatorUpdateT
-	update := *u
-	if update == nil {
-		return valUpdate, errors.New("undefined validator update")
+	votingPower votingPowerFn,
+) func(**transition.ValidatorUpdate) (ValidatorUpdateT, error) {
+	return func(u **transition.ValidatorUpdate) (ValidatorUpdateT, error) {
+		var valUpdate ValidatorUpdateT
+		update := *u
+		if update == nil {
+			return valUpdate, errors.New("undefined validator update")
+		}
+		//nolint:errcheck // should be safe
+		return any(abci.ValidatorUpdate{
+			PubKeyBytes: update.Pubkey[:],
+			PubKeyType:  crypto.CometBLSType,
+			Power:       votingPower(update.EffectiveBalance),
+		}).(ValidatorUpdateT), nil
 	}
-	//nolint:errcheck // should be safe
-	return any(abci.ValidatorUpdate{
-		PubKeyBytes: update.Pubkey[:],
-		PubKeyType:  crypto.CometBLSType,
-		//#nosec:G701 // this is safe.
-		Power: int64(update.EffectiveBalance.Unwrap()),
-	}).(ValidatorUpdateT), nil
 }
 
 // getContextForProposal returns the correct Context for PrepareProposal and
@@ -293,6 +472,13 @@ func (s *Service[LoggerT]) getContextForProposal(
 	return ctx
 }
 
+// withCorrelationID returns a copy of ctx carrying the given correlation
+// identifier, shared by the entries logged by all the services processing
+// the block.
+func withCorrelationID(ctx sdk.Context, id string) sdk.Context {
+	return ctx.WithContext(log.ContextWithCorrelationID(ctx.Context(), id))
+}
+
 // CreateQueryContext creates a new sdk.Context for a query, taking as args
 // the block height and whether the query needs a proof or not.
 func (s *Service[LoggerT]) CreateQueryContext(
@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"errors"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+)
+
+// ListSnapshots returns the state sync snapshots available on the node.
+func (s *Service[_]) ListSnapshots(
+	context.Context,
+	*abci.ListSnapshotsRequest,
+) (*abci.ListSnapshotsResponse, error) {
+	resp := &abci.ListSnapshotsResponse{Snapshots: []*abci.Snapshot{}}
+	if s.snapshotManager == nil {
+		return resp, nil
+	}
+
+	snapshots, err := s.snapshotManager.List()
+	if err != nil {
+		s.logger.Error("Failed to list snapshots", "error", err)
+		return nil, err
+	}
+	for _, snapshot := range snapshots {
+		abciSnapshot, err := snapshot.ToABCI()
+		if err != nil {
+			s.logger.Error("Failed to convert snapshot", "error", err)
+			return nil, err
+		}
+		resp.Snapshots = append(resp.Snapshots, &abciSnapshot)
+	}
+	return resp, nil
+}
+
+// LoadSnapshotChunk returns a chunk of a state sync snapshot of the node.
+func (s *Service[_]) LoadSnapshotChunk(
+	_ context.Context,
+	req *abci.LoadSnapshotChunkRequest,
+) (*abci.LoadSnapshotChunkResponse, error) {
+	if s.snapshotManager == nil {
+		return &abci.LoadSnapshotChunkResponse{}, nil
+	}
+
+	chunk, err := s.snapshotManager.LoadChunk(
+		req.GetHeight(), req.GetFormat(), req.GetChunk(),
+	)
+	if err != nil {
+		s.logger.Error(
+			"Failed to load snapshot chunk",
+			"height", req.GetHeight(),
+			"format", req.GetFormat(),
+			"chunk", req.GetChunk(),
+			"error", err,
+		)
+		return nil, err
+	}
+	return &abci.LoadSnapshotChunkResponse{Chunk: chunk}, nil
+}
+
+// OfferSnapshot starts restoring the given state sync snapshot.
+func (s *Service[_]) OfferSnapshot(
+	_ context.Context,
+	req *abci.OfferSnapshotRequest,
+) (*abci.OfferSnapshotResponse, error) {
+	if s.snapshotManager == nil {
+		s.logger.Error("Rejecting snapshot, state sync is not enabled")
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_ABORT,
+		}, nil
+	}
+	if req.GetSnapshot() == nil {
+		s.logger.Error("Rejecting nil snapshot")
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_REJECT,
+		}, nil
+	}
+
+	snapshot, err := snapshottypes.SnapshotFromABCI(req.GetSnapshot())
+	if err != nil {
+		s.logger.Error("Failed to decode snapshot metadata", "error", err)
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_REJECT,
+		}, nil
+	}
+
+	err = s.snapshotManager.Restore(snapshot)
+	switch {
+	case err == nil:
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_ACCEPT,
+		}, nil
+	case errors.Is(err, snapshottypes.ErrUnknownFormat):
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_REJECT_FORMAT,
+		}, nil
+	case errors.Is(err, snapshottypes.ErrInvalidMetadata):
+		s.logger.Error(
+			"Rejecting invalid snapshot",
+			"height", snapshot.Height,
+			"format", snapshot.Format,
+			"error", err,
+		)
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_REJECT,
+		}, nil
+	default:
+		// The stores cannot be reset to retry with another snapshot, hence
+		// restoring is aborted altogether.
+		s.logger.Error(
+			"Failed to restore snapshot",
+			"height", snapshot.Height,
+			"format", snapshot.Format,
+			"error", err,
+		)
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_ABORT,
+		}, nil
+	}
+}
+
+// ApplySnapshotChunk restores a chunk of the state sync snapshot being
+// restored.
+func (s *Service[_]) ApplySnapshotChunk(
+	_ context.Context,
+	req *abci.ApplySnapshotChunkRequest,
+) (*abci.ApplySnapshotChunkResponse, error) {
+	if s.snapshotManager == nil {
+		s.logger.Error("Rejecting snapshot chunk, state sync is not enabled")
+		return &abci.ApplySnapshotChunkResponse{
+			Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT,
+		}, nil
+	}
+
+	_, err := s.snapshotManager.RestoreChunk(req.GetChunk())
+	switch {
+	case err == nil:
+		return &abci.ApplySnapshotChunkResponse{
+			Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT,
+		}, nil
+	case errors.Is(err, snapshottypes.ErrChunkHashMismatch):
+		s.logger.Error(
+			"Snapshot chunk checksum mismatch, refetching it",
+			"chunk", req.GetIndex(),
+			"sender", req.GetSender(),
+			"error", err,
+		)
+		return &abci.ApplySnapshotChunkResponse{
+			Result:        abci.APPLY_SNAPSHOT_CHUNK_RESULT_RETRY,
+			RefetchChunks: []uint32{req.GetIndex()},
+			RejectSenders: []string{req.GetSender()},
+		}, nil
+	default:
+		s.logger.Error("Failed to restore snapshot chunk", "error", err)
+		return &abci.ApplySnapshotChunkResponse{
+			Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT,
+		}, nil
+	}
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
+	statem "github.com/berachain/beacon-kit/consensus/cometbft/service/state"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViewStateIsolatedFromFinalizeBlock(t *testing.T) {
+	key := storetypes.NewKVStoreKey("beacon")
+	logger := phuslu.NewLogger(io.Discard, nil)
+	s := &Service[*phuslu.Logger]{
+		logger: logger,
+		sm: statem.NewManager(
+			dbm.NewMemDB(), servercmtlog.WrapSDKLogger(logger),
+		),
+	}
+	s.MountStore(key, storetypes.StoreTypeIAVL)
+	require.NoError(t, s.sm.LoadLatestVersion())
+	ctx := context.Background()
+
+	// finalize writes a value into the working stores and commits it.
+	finalize := func(value string) {
+		st := s.resetState(ctx)
+		st.Context().KVStore(key).Set([]byte("key"), []byte(value))
+		st.ms.Write()
+	}
+	get := func(st *state) string {
+		return string(st.Context().KVStore(key).Get([]byte("key")))
+	}
+
+	// Nothing is committed yet, the view reads through to the working stores.
+	finalize("genesis")
+	require.Equal(t, "genesis", get(s.viewState(ctx)))
+	s.sm.CommitMultiStore().Commit()
+
+	view := s.viewState(ctx)
+	require.Equal(t, "genesis", get(view))
+
+	// The view is not affected by the next block being finalized and
+	// committed, unlike a state reading through to the working stores.
+	working := s.resetState(ctx)
+	finalize("block 1")
+	require.Equal(t, "genesis", get(view))
+	require.Equal(t, "block 1", get(working))
+	s.sm.CommitMultiStore().Commit()
+	require.Equal(t, "genesis", get(view))
+
+	// Writes to the view stay in its branch.
+	view.Context().KVStore(key).Set([]byte("key"), []byte("dry run"))
+	require.Equal(t, "block 1", get(s.viewState(ctx)))
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"errors"
+
+	"github.com/cometbft/cometbft/node"
+	"github.com/cometbft/cometbft/p2p"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+var errNodeNotRunning = errors.New("cometbft node is not running")
+
+// heightReporter is implemented by the consensus state CometBFT keeps for
+// each peer.
+type heightReporter interface {
+	GetHeight() int64
+}
+
+// BlocksBehind returns the number of blocks the node lags behind the highest
+// block reported by its peers. It is zero if the node has no peer, and errors
+// if CometBFT is not running.
+func (s *Service[_]) BlocksBehind() (uint64, error) {
+	n, err := s.runningNode()
+	if err != nil {
+		return 0, err
+	}
+
+	// Peers report the height they are at consensus on, i.e. the height
+	// following their latest block.
+	var head int64
+	n.Switch().Peers().ForEach(func(peer p2p.Peer) {
+		if ps, ok := peer.Get(cmttypes.PeerStateKey).(heightReporter); ok {
+			head = max(head, ps.GetHeight()-1)
+		}
+	})
+	if latest := n.BlockStore().Height(); head > latest {
+		//#nosec:G115 // head > latest.
+		return uint64(head - latest), nil
+	}
+	return 0, nil
+}
+
+// NodeInfo returns the information CometBFT advertises to its peers, such as
+// its node ID and listen address. It errors if CometBFT is not running.
+func (s *Service[_]) NodeInfo() (p2p.NodeInfo, error) {
+	n, err := s.runningNode()
+	if err != nil {
+		return nil, err
+	}
+	return n.NodeInfo(), nil
+}
+
+// Peers returns the peers CometBFT is connected to. It errors if CometBFT is
+// not running.
+func (s *Service[_]) Peers() ([]p2p.Peer, error) {
+	n, err := s.runningNode()
+	if err != nil {
+		return nil, err
+	}
+	return n.Switch().Peers().Copy(), nil
+}
+
+// runningNode returns the CometBFT node, erroring if it is not running.
+func (s *Service[_]) runningNode() (*node.Node, error) {
+	s.nodeMu.RLock()
+	n := s.node
+	s.nodeMu.RUnlock()
+	if n == nil || !n.IsRunning() {
+		return nil, errNodeNotRunning
+	}
+	return n, nil
+}
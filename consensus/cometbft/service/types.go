@@ -29,3 +29,10 @@ type TelemetrySink interface {
 	// MeasureSince measures the time since the given time.
 	MeasureSince(key string, start time.Time, args ...string)
 }
+
+// CrashReporter writes a crash report when the processing of a block panics.
+type CrashReporter interface {
+	// Recover writes a crash report if the calling goroutine panics, then
+	// resumes the panic. It must be deferred.
+	Recover()
+}
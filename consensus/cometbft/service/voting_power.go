@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// votingPowerFn maps the effective balance of a validator to its CometBFT
+// voting power.
+type votingPowerFn func(effectiveBalance math.Gwei) int64
+
+// newVotingPowerFn returns the votingPowerFn of the validator power strategy
+// selected by the chain spec.
+func newVotingPowerFn(cs chain.ChainSpec) votingPowerFn {
+	switch cs.ValidatorPowerStrategy() {
+	case chain.ValidatorPowerCapped:
+		powerCap := math.Gwei(cs.ValidatorPowerCap())
+		return func(effectiveBalance math.Gwei) int64 {
+			//#nosec:G701 // this is safe.
+			return int64(min(effectiveBalance, powerCap).Unwrap())
+		}
+	case chain.ValidatorPowerEqual:
+		return func(effectiveBalance math.Gwei) int64 {
+			if effectiveBalance == 0 {
+				return 0
+			}
+			return 1
+		}
+	default:
+		return func(effectiveBalance math.Gwei) int64 {
+			//#nosec:G701 // this is safe.
+			return int64(effectiveBalance.Unwrap())
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVotingPowerStrategies(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy string
+		powerCap uint64
+		expected []int64
+	}{
+		{
+			name:     "effective balance",
+			strategy: chain.ValidatorPowerEffectiveBalance,
+			expected: []int64{0, 16e9, 32e9, 64e9},
+		},
+		{
+			name:     "capped",
+			strategy: chain.ValidatorPowerCapped,
+			powerCap: 32e9,
+			expected: []int64{0, 16e9, 32e9, 32e9},
+		},
+		{
+			name:     "equal",
+			strategy: chain.ValidatorPowerEqual,
+			expected: []int64{0, 1, 1, 1},
+		},
+	}
+
+	balances := []math.Gwei{0, 16e9, 32e9, 64e9}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			csData := spec.BaseSpec()
+			csData.ValidatorPowerStrategy = tt.strategy
+			csData.ValidatorPowerCap = tt.powerCap
+			cs, err := chain.NewChainSpec(csData)
+			require.NoError(t, err)
+
+			votingPower := newVotingPowerFn(cs)
+			for i, balance := range balances {
+				require.Equal(t, tt.expected[i], votingPower(balance))
+			}
+		})
+	}
+}
+
+func TestVotingPowerStrategyValidation(t *testing.T) {
+	csData := spec.BaseSpec()
+	csData.ValidatorPowerStrategy = "quadratic"
+	_, err := chain.NewChainSpec(csData)
+	require.ErrorIs(t, err, chain.ErrInvalidValidatorPowerStrategy)
+
+	csData.ValidatorPowerStrategy = chain.ValidatorPowerCapped
+	_, err = chain.NewChainSpec(csData)
+	require.ErrorIs(t, err, chain.ErrInvalidValidatorPowerCap)
+}
@@ -20,7 +20,10 @@
 
 package types
 
-import "github.com/berachain/beacon-kit/primitives/math"
+import (
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+)
 
 type commonConsensusData struct {
 	// use to verify block builder
@@ -28,6 +31,9 @@ type commonConsensusData struct {
 
 	// used to build next block and validate current payload timestamp
 	consensusTime math.U64
+
+	// validators misbehaviors to be slashed along with the block
+	misbehaviors []*transition.Misbehavior
 }
 
 // GetProposerAddress returns the address of the validator
@@ -41,3 +47,9 @@ func (c *commonConsensusData) GetProposerAddress() []byte {
 func (c *commonConsensusData) GetConsensusTime() math.U64 {
 	return c.consensusTime
 }
+
+// GetMisbehaviors returns the validators misbehaviors reported by
+// consensus, to be slashed along with the block.
+func (c *commonConsensusData) GetMisbehaviors() []*transition.Misbehavior {
+	return c.misbehaviors
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
 )
 
 type ConsensusBlock struct {
@@ -39,12 +40,14 @@ func (b *ConsensusBlock) New(
 	beaconBlock *types.BeaconBlock,
 	proposerAddress []byte,
 	consensusTime time.Time,
+	misbehaviors []*transition.Misbehavior,
 ) *ConsensusBlock {
 	b = &ConsensusBlock{
 		blk: beaconBlock,
 		commonConsensusData: &commonConsensusData{
 			proposerAddress: proposerAddress,
 			consensusTime:   math.U64(consensusTime.Unix()),
+			misbehaviors:    misbehaviors,
 		},
 	}
 	return b
@@ -88,7 +88,9 @@ func (f *SidecarFactory) BuildSidecars(
 		header,
 		domain,
 	)
-	signature, err := signer.Sign(signingRoot[:])
+	signature, err := crypto.SignRequest(
+		signer, ctypes.NewBlockSigningRequest(forkData, header, signingRoot),
+	)
 	if err != nil {
 		return nil, err
 	}
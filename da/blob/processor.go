@@ -21,6 +21,7 @@
 package blob
 
 import (
+	"context"
 	"time"
 
 	"github.com/berachain/beacon-kit/chain-spec/chain"
@@ -28,8 +29,10 @@ import (
 	"github.com/berachain/beacon-kit/da/kzg"
 	datypes "github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Processor is the blob processor that handles the processing and verification
@@ -78,6 +81,7 @@ func NewProcessor[
 func (sp *Processor[
 	AvailabilityStoreT, ConsensusSidecarsT,
 ]) VerifySidecars(
+	ctx context.Context,
 	cs ConsensusSidecarsT,
 	verifierFn func(
 		blkHeader *ctypes.BeaconBlockHeader,
@@ -91,6 +95,11 @@ func (sp *Processor[
 	defer sp.metrics.measureVerifySidecarsDuration(
 		time.Now(), math.U64(len(sidecars)),
 	)
+	_, span := tracing.Start(
+		ctx, "blob.VerifySidecars",
+		attribute.Int("sidecars", len(sidecars)),
+	)
+	defer span.End()
 
 	// Abort if there are no blobs to store.
 	if len(sidecars) == 0 {
@@ -98,23 +107,43 @@ func (sp *Processor[
 	}
 
 	// Verify the blobs and ensure they match the local state.
-	return sp.verifier.verifySidecars(
+	err := sp.verifier.verifySidecars(
 		sidecars,
 		blkHeader,
 		verifierFn,
 	)
+	tracing.RecordError(span, err)
+	if err != nil {
+		log.WithContext(sp.logger, ctx).Debug(
+			"Blob sidecars verification failed",
+			"num_sidecars", len(sidecars), "error", err,
+		)
+		return err
+	}
+	sp.metrics.observeVerifiedBytes(blobBytes(sidecars))
+	log.WithContext(sp.logger, ctx).Debug(
+		"Verified blob sidecars", "num_sidecars", len(sidecars),
+	)
+	return nil
 }
 
 // ProcessSidecars processes the blobs and ensures they match the local state.
 func (sp *Processor[
 	AvailabilityStoreT, _,
 ]) ProcessSidecars(
+	ctx context.Context,
 	avs AvailabilityStoreT,
 	sidecars datypes.BlobSidecars,
 ) error {
 	defer sp.metrics.measureProcessSidecarsDuration(
 		time.Now(), math.U64(len(sidecars)),
 	)
+	sp.metrics.observeProcessedSidecars(len(sidecars))
+	_, span := tracing.Start(
+		ctx, "blob.ProcessSidecars",
+		attribute.Int("sidecars", len(sidecars)),
+	)
+	defer span.End()
 
 	// Abort if there are no blobs to store.
 	if len(sidecars) == 0 {
@@ -123,8 +152,26 @@ func (sp *Processor[
 
 	// If we have reached this point, we can safely assume that the blobs are
 	// valid and can be persisted, as well as that index 0 is filled.
-	return avs.Persist(
+	err := avs.Persist(
 		sidecars[0].GetSignedBeaconBlockHeader().GetHeader().GetSlot(),
 		sidecars,
 	)
+	tracing.RecordError(span, err)
+	if err != nil {
+		return err
+	}
+	sp.metrics.observePersistedBytes(blobBytes(sidecars))
+	log.WithContext(sp.logger, ctx).Debug(
+		"Persisted blob sidecars", "num_sidecars", len(sidecars),
+	)
+	return nil
+}
+
+// blobBytes returns the size of the blobs of the given sidecars.
+func blobBytes(sidecars datypes.BlobSidecars) int {
+	var n int
+	for _, sidecar := range sidecars {
+		n += len(sidecar.Blob)
+	}
+	return n
 }
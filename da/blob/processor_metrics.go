@@ -26,6 +26,10 @@ import (
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
+// bytesPerKiB is the number of bytes in a KiB, the unit the blob data is
+// reported in so that it fits the buckets of the histograms.
+const bytesPerKiB = 1024
+
 // processorMetrics is a struct that contains metrics for the processor.
 type processorMetrics struct {
 	// TelemetrySink is the sink for the metrics.
@@ -66,3 +70,28 @@ func (pm *processorMetrics) measureProcessSidecarsDuration(
 		numSidecars.Base10(),
 	)
 }
+
+// observeProcessedSidecars records the number of sidecars persisted along
+// with a block.
+func (pm *processorMetrics) observeProcessedSidecars(numSidecars int) {
+	pm.sink.ObserveHistogram(
+		"beacon_kit.da.blob.processor.sidecars_per_block",
+		float64(numSidecars),
+	)
+}
+
+// observeVerifiedBytes records the size of the blobs verified for a slot.
+func (pm *processorMetrics) observeVerifiedBytes(numBytes int) {
+	pm.sink.ObserveHistogram(
+		"beacon_kit.da.blob.processor.verified_kib_per_slot",
+		float64(numBytes)/bytesPerKiB,
+	)
+}
+
+// observePersistedBytes records the size of the blobs persisted for a slot.
+func (pm *processorMetrics) observePersistedBytes(numBytes int) {
+	pm.sink.ObserveHistogram(
+		"beacon_kit.da.blob.processor.persisted_kib_per_slot",
+		float64(numBytes)/bytesPerKiB,
+	)
+}
@@ -79,4 +79,7 @@ type TelemetrySink interface {
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
+	// ObserveHistogram records the given value in the histogram identified
+	// by the provided key.
+	ObserveHistogram(key string, value float64, args ...string)
 }
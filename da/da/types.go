@@ -21,6 +21,8 @@
 package da
 
 import (
+	"context"
+
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	datypes "github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/primitives/crypto"
@@ -34,11 +36,13 @@ type BlobProcessor[
 	// ProcessSidecars processes the blobs and ensures they match the local
 	// state.
 	ProcessSidecars(
+		ctx context.Context,
 		avs AvailabilityStoreT,
 		sidecars datypes.BlobSidecars,
 	) error
 	// VerifySidecars verifies the blobs and ensures they match the local state.
 	VerifySidecars(
+		ctx context.Context,
 		sidecars ConsensusSidecarsT,
 		verifierFn func(
 			blkHeader *ctypes.BeaconBlockHeader,
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/karalabe/ssz"
+)
+
+// archiveMagic starts every blob sidecar archive and identifies the version
+// of its format. It is followed by the SSZ encoded sidecars, each prefixed by
+// the big-endian uint64 slot it is stored at and its big-endian uint32
+// length.
+var archiveMagic = []byte("\x00bkblobs\x01")
+
+// Export writes the blob sidecars stored for the slots in [from, to] to w as
+// an archive that can be imported on another node, and returns the number of
+// sidecars written.
+func (s *Store) Export(w io.Writer, from, to math.Slot) (int, error) {
+	if from > to {
+		return 0, errors.Wrapf(
+			ErrInvalidSlotRange, "from %d is above to %d", from, to,
+		)
+	}
+	if _, err := w.Write(archiveMagic); err != nil {
+		return 0, err
+	}
+
+	var count int
+	for slot := from; ; slot++ {
+		keys, err := s.IndexDB.Keys(slot.Unwrap())
+		if err != nil {
+			return count, err
+		}
+		for _, key := range keys {
+			bz, err := s.IndexDB.Get(slot.Unwrap(), key)
+			if err != nil {
+				return count, err
+			}
+			if err = writeRecord(w, slot, bz); err != nil {
+				return count, err
+			}
+			count++
+		}
+		if slot == to {
+			return count, nil
+		}
+	}
+}
+
+// Import stores the blob sidecars of an archive written by Export, and returns
+// the number of sidecars stored. Sidecars are checked against the block
+// header they embed, and the ones already stored are skipped. Their KZG
+// proofs are not verified; the archive must come from a trusted node.
+func (s *Store) Import(r io.Reader) (int, error) {
+	magic := make([]byte, len(archiveMagic))
+	if _, err := io.ReadFull(r, magic); err != nil {
+		return 0, errors.Join(ErrInvalidArchive, err)
+	}
+	if !bytes.Equal(magic, archiveMagic) {
+		return 0, errors.Wrap(ErrInvalidArchive, "unknown format")
+	}
+
+	var (
+		count int
+		size  = ssz.Size(new(types.BlobSidecar))
+	)
+	for {
+		slot, bz, err := readRecord(r, size)
+		if errors.Is(err, io.EOF) {
+			return count, nil
+		} else if err != nil {
+			return count, errors.Join(ErrInvalidArchive, err)
+		}
+
+		sidecar := new(types.BlobSidecar)
+		if err = sidecar.UnmarshalSSZ(bz); err != nil {
+			return count, errors.Join(ErrInvalidArchive, err)
+		}
+		if err = s.validateSidecar(slot, sidecar); err != nil {
+			return count, errors.Join(ErrInvalidArchive, err)
+		}
+
+		key := sidecar.KzgCommitment[:]
+		exists, err := s.IndexDB.Has(slot.Unwrap(), key)
+		if err != nil {
+			return count, err
+		}
+		if exists {
+			continue
+		}
+		if err = s.IndexDB.Set(slot.Unwrap(), key, bz); err != nil {
+			return count, err
+		}
+		count++
+	}
+}
+
+// validateSidecar checks that the sidecar is a well formed one of a block of
+// the given slot.
+func (s *Store) validateSidecar(
+	slot math.Slot, sidecar *types.BlobSidecar,
+) error {
+	if sidecar.GetBeaconBlockHeader().GetSlot() != slot {
+		return fmt.Errorf(
+			"sidecar of slot %d stored at slot %d",
+			sidecar.GetBeaconBlockHeader().GetSlot(), slot,
+		)
+	}
+	if sidecar.GetIndex() >= s.chainSpec.MaxBlobsPerBlock() {
+		return fmt.Errorf("invalid sidecar index %d", sidecar.GetIndex())
+	}
+	return nil
+}
+
+// writeRecord writes the record stored at the given slot to w.
+func writeRecord(w io.Writer, slot math.Slot, record []byte) error {
+	if err := binary.Write(w, binary.BigEndian, struct {
+		Slot   uint64
+		Length uint32
+	}{
+		Slot: slot.Unwrap(),
+		//#nosec:G115 // sidecars are far smaller than 4GiB.
+		Length: uint32(len(record)),
+	}); err != nil {
+		return err
+	}
+	_, err := w.Write(record)
+	return err
+}
+
+// readRecord reads a record of the given size and the slot it is stored at
+// from r. It returns io.EOF only if r ends before the record starts.
+func readRecord(r io.Reader, size uint32) (math.Slot, []byte, error) {
+	var prefix struct {
+		Slot   uint64
+		Length uint32
+	}
+	if err := binary.Read(r, binary.BigEndian, &prefix); err != nil {
+		return 0, nil, err
+	}
+	if prefix.Length != size {
+		return 0, nil, fmt.Errorf(
+			"record of %d bytes, expected %d", prefix.Length, size,
+		)
+	}
+	record := make([]byte, prefix.Length)
+	if _, err := io.ReadFull(r, record); errors.Is(err, io.EOF) {
+		return 0, nil, io.ErrUnexpectedEOF
+	} else if err != nil {
+		return 0, nil, err
+	}
+	return math.Slot(prefix.Slot), record, nil
+}
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/da/store"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/filedb"
+	"github.com/karalabe/ssz"
+	"github.com/stretchr/testify/require"
+)
+
+func newStore(t *testing.T, cs chain.ChainSpec) *store.Store {
+	t.Helper()
+	logger := log.NewNopLogger()
+	return store.New(
+		filedb.NewRangeDB(
+			filedb.NewDB(filedb.WithRootDirectory(t.TempDir()),
+				filedb.WithFileExtension("ssz"),
+				filedb.WithDirectoryPermissions(0700),
+				filedb.WithLogger(logger),
+			),
+		),
+		logger,
+		cs,
+	)
+}
+
+// buildSidecars builds the sidecars of a block of the given slot committing
+// to the given number of blobs.
+func buildSidecars(slot math.Slot, blobs int) datypes.BlobSidecars {
+	header := &ctypes.SignedBeaconBlockHeader{
+		Header: &ctypes.BeaconBlockHeader{Slot: slot},
+	}
+	sidecars := make(datypes.BlobSidecars, 0, blobs)
+	for i := range blobs {
+		sidecars = append(sidecars, datypes.BuildBlobSidecar(
+			math.U64(i),
+			header,
+			&eip4844.Blob{byte(i)},
+			eip4844.KZGCommitment{byte(slot), byte(i + 1)},
+			eip4844.KZGProof{},
+			make([]common.Root, 8),
+		))
+	}
+	return sidecars
+}
+
+func TestExportImport(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	src := newStore(t, cs)
+	persisted := make(map[math.Slot]datypes.BlobSidecars)
+	for slot, blobs := range map[math.Slot]int{1: 2, 2: 1, 4: 3, 5: 1} {
+		persisted[slot] = buildSidecars(slot, blobs)
+		require.NoError(t, src.Persist(slot, persisted[slot]))
+	}
+
+	var archive bytes.Buffer
+	n, err := src.Export(&archive, 2, 4)
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+
+	dst := newStore(t, cs)
+	require.NoError(t, dst.Persist(4, persisted[4][:1]))
+	n, err = dst.Import(bytes.NewReader(archive.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, 3, n, "stored sidecars are skipped")
+
+	for slot, sidecars := range persisted {
+		body := &ctypes.BeaconBlockBody{}
+		for _, sc := range sidecars {
+			body.BlobKzgCommitments = append(
+				body.BlobKzgCommitments, sc.KzgCommitment,
+			)
+		}
+		got, err := dst.GetBlobSidecars(slot, body)
+		require.NoError(t, err)
+		if slot < 2 || slot > 4 {
+			require.Empty(t, got)
+			continue
+		}
+		require.Len(t, got, len(sidecars))
+		for i, sc := range got {
+			require.Equal(t, sidecars[i].HashTreeRoot(), sc.HashTreeRoot())
+		}
+	}
+
+	_, err = src.Export(&archive, 4, 2)
+	require.ErrorIs(t, err, store.ErrInvalidSlotRange)
+}
+
+func TestImportRejectsInvalidArchives(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	src := newStore(t, cs)
+	require.NoError(t, src.Persist(1, buildSidecars(1, 1)))
+	var archive bytes.Buffer
+	_, err = src.Export(&archive, 1, 1)
+	require.NoError(t, err)
+	bz := archive.Bytes()
+	size := int(ssz.Size(new(datypes.BlobSidecar)))
+
+	_, err = newStore(t, cs).Import(bytes.NewReader([]byte("not an archive")))
+	require.ErrorIs(t, err, store.ErrInvalidArchive)
+
+	_, err = newStore(t, cs).Import(bytes.NewReader(bz[:len(bz)-1]))
+	require.ErrorIs(t, err, store.ErrInvalidArchive)
+
+	// Move the sidecar to a slot other than the one of its block.
+	moved := bytes.Clone(bz)
+	moved[len(bz)-size-5]++
+	_, err = newStore(t, cs).Import(bytes.NewReader(moved))
+	require.ErrorIs(t, err, store.ErrInvalidArchive)
+}
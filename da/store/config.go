@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"os"
+	"strings"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/storage/filedb"
+)
+
+// EncryptionKeyLength is the length of the AES-256 key encrypting the blob
+// sidecars at rest.
+const EncryptionKeyLength = 32
+
+// Config is the configuration of the availability store.
+type Config struct {
+	// EncryptionKeyPath is the path to the file holding the hex encoded
+	// AES-256 key encrypting the blob sidecars at rest with AES-GCM, for
+	// instance a secret mounted by a KMS agent. Sidecars are stored in the
+	// clear if empty.
+	EncryptionKeyPath string `mapstructure:"encryption-key-path"`
+}
+
+// DefaultConfig returns the default configuration of the availability
+// store.
+func DefaultConfig() Config {
+	return Config{
+		EncryptionKeyPath: "",
+	}
+}
+
+// Encrypted reports whether the blob sidecars are encrypted at rest.
+func (c Config) Encrypted() bool {
+	return c.EncryptionKeyPath != ""
+}
+
+// LoadCipher returns the cipher encrypting the blob sidecars at rest with
+// the hex encoded key, with or without 0x prefix, held by the file at the
+// given path.
+func LoadCipher(path string) (cipher.AEAD, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := hex.DecodeString(
+		strings.TrimPrefix(strings.TrimSpace(string(bz)), "0x"),
+	)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidEncryptionKey, err)
+	}
+	if len(key) != EncryptionKeyLength {
+		return nil, errors.Wrapf(
+			ErrInvalidEncryptionKey,
+			"expected %d bytes, got %d", EncryptionKeyLength, len(key),
+		)
+	}
+	return filedb.NewAESGCM(key)
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/da/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadCipher(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+	key := strings.Repeat("ab", store.EncryptionKeyLength)
+
+	aead, err := store.LoadCipher(write("key.hex", key+"\n"))
+	require.NoError(t, err)
+	require.NotNil(t, aead)
+
+	_, err = store.LoadCipher(write("prefixed.hex", "0x"+key))
+	require.NoError(t, err)
+
+	_, err = store.LoadCipher(write("short.hex", key[2:]))
+	require.ErrorIs(t, err, store.ErrInvalidEncryptionKey)
+
+	_, err = store.LoadCipher(write("invalid.hex", "zz"))
+	require.ErrorIs(t, err, store.ErrInvalidEncryptionKey)
+
+	_, err = store.LoadCipher(filepath.Join(dir, "missing.hex"))
+	require.Error(t, err)
+}
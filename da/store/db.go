@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/storage/filedb"
+)
+
+// Dir returns the directory of the availability store in the given node
+// home directory.
+func Dir(homeDir string) string {
+	return filepath.Join(homeDir, "data", "blobs")
+}
+
+// NewIndexDB returns the database backing the availability store in the
+// given node home directory, holding the blob sidecars indexed by slot.
+func NewIndexDB(
+	homeDir string, logger log.Logger, opts ...filedb.Option,
+) *filedb.RangeDB {
+	return filedb.NewRangeDB(
+		filedb.NewDB(
+			append([]filedb.Option{
+				filedb.WithRootDirectory(Dir(homeDir)),
+				filedb.WithFileExtension("ssz"),
+				filedb.WithDirectoryPermissions(os.ModePerm),
+				filedb.WithLogger(logger),
+			}, opts...)...,
+		),
+	)
+}
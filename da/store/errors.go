@@ -34,4 +34,15 @@ var (
 	ErrAttemptedToVerifyNilSidecars = errors.New(
 		"attempted to verify nil sidecars",
 	)
+
+	// ErrInvalidEncryptionKey is returned when the encryption key of the
+	// availability store is malformed.
+	ErrInvalidEncryptionKey = errors.New("invalid encryption key")
+
+	// ErrInvalidSlotRange is returned when the first slot of a range is above
+	// its last one.
+	ErrInvalidSlotRange = errors.New("invalid slot range")
+
+	// ErrInvalidArchive is returned when a blob sidecar archive is malformed.
+	ErrInvalidArchive = errors.New("invalid blob sidecar archive")
 )
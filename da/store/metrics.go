@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// opsKey counts the operations on the availability store.
+	opsKey = "beacon_kit.da.store.ops"
+	// opDurationKey measures the latency of the operations on the
+	// availability store.
+	opDurationKey = "beacon_kit.da.store.op_duration"
+	// sizeKey is the size in bytes of the blob sidecars stored.
+	sizeKey = "beacon_kit.da.store.size_bytes"
+	// prunedUntilKey is the slot the availability store is pruned until,
+	// exclusive.
+	prunedUntilKey = "beacon_kit.da.store.pruned_until_slot"
+)
+
+// indexSizer is implemented by the databases able to report the size of the
+// values stored at each index.
+type indexSizer interface {
+	IndexSizes() (map[uint64]uint64, error)
+}
+
+// metricsIndexDB is an IndexDB reporting its operations to a sink.
+type metricsIndexDB struct {
+	IndexDB
+	sink TelemetrySink
+
+	// mu protects the fields below.
+	mu sync.Mutex
+	// sizes are the sizes in bytes of the values stored at each index.
+	sizes map[uint64]uint64
+	// size is the sum of the sizes.
+	size uint64
+}
+
+// NewMetricsIndexDB wraps the given database so that every read and write of
+// the blob sidecars is counted and timed, labeled by operation. The size of
+// the database and the progress of its pruning are reported as well, the
+// initial size being read from the database if it supports it.
+func NewMetricsIndexDB(db IndexDB, sink TelemetrySink) IndexDB {
+	m := &metricsIndexDB{
+		IndexDB: db,
+		sink:    sink,
+		sizes:   make(map[uint64]uint64),
+	}
+	if sizer, ok := db.(indexSizer); ok {
+		if sizes, err := sizer.IndexSizes(); err == nil && sizes != nil {
+			m.sizes = sizes
+		}
+	}
+	for _, n := range m.sizes {
+		m.size += n
+	}
+	m.reportSize()
+	return m
+}
+
+// Get implements IndexDB.
+func (db *metricsIndexDB) Get(index uint64, key []byte) ([]byte, error) {
+	defer db.measure("get", time.Now())
+	return db.IndexDB.Get(index, key)
+}
+
+// Has implements IndexDB.
+func (db *metricsIndexDB) Has(index uint64, key []byte) (bool, error) {
+	defer db.measure("has", time.Now())
+	return db.IndexDB.Has(index, key)
+}
+
+// Set implements IndexDB.
+func (db *metricsIndexDB) Set(index uint64, key []byte, value []byte) error {
+	defer db.measure("set", time.Now())
+	exists, err := db.IndexDB.Has(index, key)
+	if err != nil {
+		return err
+	}
+	if err = db.IndexDB.Set(index, key, value); err != nil {
+		return err
+	}
+	if !exists {
+		db.mu.Lock()
+		db.sizes[index] += uint64(len(value))
+		db.size += uint64(len(value))
+		db.reportSize()
+		db.mu.Unlock()
+	}
+	return nil
+}
+
+// Keys implements IndexDB.
+func (db *metricsIndexDB) Keys(index uint64) ([][]byte, error) {
+	defer db.measure("keys", time.Now())
+	return db.IndexDB.Keys(index)
+}
+
+// Prune implements IndexDB.
+func (db *metricsIndexDB) Prune(start uint64, end uint64) error {
+	defer db.measure("prune", time.Now())
+	if err := db.IndexDB.Prune(start, end); err != nil {
+		return err
+	}
+	db.mu.Lock()
+	for index, n := range db.sizes {
+		if index >= start && index < end {
+			db.size -= n
+			delete(db.sizes, index)
+		}
+	}
+	db.reportSize()
+	db.mu.Unlock()
+	//#nosec:G115 // slots fit in an int64.
+	db.sink.SetGauge(prunedUntilKey, int64(end))
+	return nil
+}
+
+// measure reports an operation started at the given time.
+func (db *metricsIndexDB) measure(op string, start time.Time) {
+	db.sink.IncrementCounter(opsKey, "op", op)
+	db.sink.MeasureSince(opDurationKey, start, "op", op)
+}
+
+// reportSize reports the size of the database. The lock must be held.
+func (db *metricsIndexDB) reportSize() {
+	//#nosec:G115 // the store is far smaller than 8EiB.
+	db.sink.SetGauge(sizeKey, int64(db.size))
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/da/store"
+	"github.com/berachain/beacon-kit/storage/filedb"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingSink records the labels of the metrics reported to it.
+type recordingSink struct {
+	counters  map[string][][]string
+	durations map[string][][]string
+	gauges    map[string]int64
+}
+
+func (s *recordingSink) IncrementCounter(key string, args ...string) {
+	s.counters[key] = append(s.counters[key], args)
+}
+
+func (s *recordingSink) MeasureSince(key string, _ time.Time, args ...string) {
+	s.durations[key] = append(s.durations[key], args)
+}
+
+func (s *recordingSink) SetGauge(key string, value int64, _ ...string) {
+	s.gauges[key] = value
+}
+
+func TestMetricsIndexDB(t *testing.T) {
+	sink := &recordingSink{
+		counters:  make(map[string][][]string),
+		durations: make(map[string][][]string),
+		gauges:    make(map[string]int64),
+	}
+	db := store.NewMetricsIndexDB(newRangeDB(t.TempDir()), sink)
+
+	require.NoError(t, db.Set(1, []byte("key"), []byte("value")))
+	ok, err := db.Has(1, []byte("key"))
+	require.NoError(t, err)
+	require.True(t, ok)
+	_, err = db.Get(1, []byte("key"))
+	require.NoError(t, err)
+
+	expected := [][]string{{"op", "set"}, {"op", "has"}, {"op", "get"}}
+	require.Equal(t, expected, sink.counters["beacon_kit.da.store.ops"])
+	require.Equal(t, expected, sink.durations["beacon_kit.da.store.op_duration"])
+}
+
+func TestMetricsIndexDBSize(t *testing.T) {
+	dir := t.TempDir()
+	sink := &recordingSink{
+		counters:  make(map[string][][]string),
+		durations: make(map[string][][]string),
+		gauges:    make(map[string]int64),
+	}
+	db := store.NewMetricsIndexDB(newRangeDB(dir), sink)
+	require.Zero(t, sink.gauges["beacon_kit.da.store.size_bytes"])
+
+	require.NoError(t, db.Set(1, []byte("a"), []byte("value")))
+	require.NoError(t, db.Set(2, []byte("a"), []byte("value")))
+	require.NoError(t, db.Set(2, []byte("b"), []byte("value")))
+	// Overwriting a value does not count twice.
+	require.NoError(t, db.Set(2, []byte("b"), []byte("value")))
+	require.Equal(t, int64(15), sink.gauges["beacon_kit.da.store.size_bytes"])
+
+	require.NoError(t, db.Prune(0, 2))
+	require.Equal(t, int64(10), sink.gauges["beacon_kit.da.store.size_bytes"])
+	require.Equal(
+		t, int64(2), sink.gauges["beacon_kit.da.store.pruned_until_slot"],
+	)
+
+	// The size of the values stored is read back on restart.
+	store.NewMetricsIndexDB(newRangeDB(dir), sink)
+	require.Equal(t, int64(10), sink.gauges["beacon_kit.da.store.size_bytes"])
+}
+
+func newRangeDB(dir string) *filedb.RangeDB {
+	return filedb.NewRangeDB(filedb.NewDB(
+		filedb.WithRootDirectory(dir),
+		filedb.WithFileExtension("ssz"),
+		filedb.WithDirectoryPermissions(0o700),
+		filedb.WithLogger(log.NewNopLogger()),
+	))
+}
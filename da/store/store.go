@@ -70,6 +70,36 @@ func (s *Store) IsDataAvailable(
 	return true
 }
 
+// GetBlobSidecars returns the sidecars of the blobs referenced in the block,
+// ordered by index. Sidecars that are no longer stored, e.g. because they were
+// pruned after the DA period, are omitted.
+func (s *Store) GetBlobSidecars(
+	slot math.Slot,
+	body *ctypes.BeaconBlockBody,
+) (types.BlobSidecars, error) {
+	commitments := body.GetBlobKzgCommitments()
+	sidecars := make(types.BlobSidecars, 0, len(commitments))
+	for _, commitment := range commitments {
+		ok, err := s.IndexDB.Has(slot.Unwrap(), commitment[:])
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		bz, err := s.IndexDB.Get(slot.Unwrap(), commitment[:])
+		if err != nil {
+			return nil, err
+		}
+		sidecar := new(types.BlobSidecar)
+		if err = sidecar.UnmarshalSSZ(bz); err != nil {
+			return nil, err
+		}
+		sidecars = append(sidecars, sidecar)
+	}
+	return sidecars, nil
+}
+
 // Persist ensures the sidecar data remains accessible, utilizing parallel
 // processing for efficiency.
 func (s *Store) Persist(
@@ -29,6 +29,8 @@ import (
 	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/da/store"
 	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
 	"github.com/berachain/beacon-kit/storage/filedb"
 	"github.com/stretchr/testify/require"
 )
@@ -81,3 +83,51 @@ func TestStore_PersistRace(t *testing.T) {
 	err = s.Persist(0, sidecars)
 	require.NoError(t, err)
 }
+
+func TestStore_GetBlobSidecars(t *testing.T) {
+	logger := log.NewNopLogger()
+	chainSpec, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	s := store.New(
+		filedb.NewRangeDB(
+			filedb.NewDB(filedb.WithRootDirectory(t.TempDir()),
+				filedb.WithFileExtension("ssz"),
+				filedb.WithDirectoryPermissions(0700),
+				filedb.WithLogger(logger),
+			),
+		),
+		logger,
+		chainSpec,
+	)
+
+	header := &types.SignedBeaconBlockHeader{
+		Header: &types.BeaconBlockHeader{Slot: 3},
+	}
+	commitments := eip4844.KZGCommitments[common.ExecutionHash]{
+		{0x01}, {0x02}, {0x03},
+	}
+	sidecars := make(datypes.BlobSidecars, 0, len(commitments))
+	for i, commitment := range commitments[:2] {
+		sidecars = append(sidecars, &datypes.BlobSidecar{
+			Index:                   uint64(i),
+			KzgCommitment:           commitment,
+			SignedBeaconBlockHeader: header,
+			InclusionProof:          make([]common.Root, 8),
+		})
+	}
+	require.NoError(t, s.Persist(3, sidecars))
+
+	// The sidecar of the third commitment was never stored.
+	body := &types.BeaconBlockBody{BlobKzgCommitments: commitments}
+	got, err := s.GetBlobSidecars(3, body)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	for i, sidecar := range got {
+		require.Equal(t, sidecars[i].HashTreeRoot(), sidecar.HashTreeRoot())
+	}
+
+	got, err = s.GetBlobSidecars(4, body)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
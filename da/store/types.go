@@ -20,11 +20,28 @@
 
 package store
 
+import "time"
+
 // IndexDB is a database that allows prefixing by index.
 type IndexDB interface {
+	Get(index uint64, key []byte) ([]byte, error)
 	Has(index uint64, key []byte) (bool, error)
 	Set(index uint64, key []byte, value []byte) error
+	// Keys returns the keys stored at the given index.
+	Keys(index uint64) ([][]byte, error)
 
 	// Prune returns error if start > end
 	Prune(start uint64, end uint64) error
 }
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments the counter identified by the provided
+	// key.
+	IncrementCounter(key string, args ...string)
+	// MeasureSince measures the time since the provided start time,
+	// identified by the provided key.
+	MeasureSince(key string, start time.Time, args ...string)
+	// SetGauge sets the gauge identified by the provided key.
+	SetGauge(key string, value int64, args ...string)
+}
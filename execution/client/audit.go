@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	ethclientrpc "github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	phuslu "github.com/phuslu/log"
+)
+
+const (
+	// bytesPerMiB is the number of bytes in a MiB.
+	bytesPerMiB = 1 << 20
+	// auditLogFileMode is the mode of the audit log files.
+	auditLogFileMode = 0o600
+)
+
+// The statuses of the calls which did not return a payload status.
+const (
+	auditStatusOK    = "OK"
+	auditStatusError = "ERROR"
+)
+
+// auditEntry is an entry of the audit log of the engine API calls. Neither
+// the JWT nor the bodies of the requests and responses, e.g. the execution
+// payloads, are recorded, only a digest of the params to match the calls
+// with the ones logged by the execution client.
+type auditEntry struct {
+	Time         string `json:"time"`
+	Method       string `json:"method"`
+	ParamsDigest string `json:"params_digest"`
+	HTTPStatus   int    `json:"http_status,omitempty"`
+	// Status is the status of the payload returned by the execution client
+	// for the calls returning one, e.g. VALID or SYNCING, ERROR if the call
+	// failed and OK otherwise.
+	Status          string  `json:"status"`
+	LatestValidHash string  `json:"latest_valid_hash,omitempty"`
+	ValidationError string  `json:"validation_error,omitempty"`
+	Error           string  `json:"error,omitempty"`
+	LatencyMs       float64 `json:"latency_ms"`
+	// CorrelationID matches the call with the entries logged for the block
+	// or API request it was made for.
+	CorrelationID string `json:"correlation_id,omitempty"`
+}
+
+// auditPayloadStatus is the payload status returned by engine_newPayload,
+// directly, and by engine_forkchoiceUpdated, in payloadStatus.
+type auditPayloadStatus struct {
+	Status          string  `json:"status"`
+	LatestValidHash *string `json:"latestValidHash"`
+	ValidationError *string `json:"validationError"`
+	PayloadStatus   *struct {
+		Status          string  `json:"status"`
+		LatestValidHash *string `json:"latestValidHash"`
+		ValidationError *string `json:"validationError"`
+	} `json:"payloadStatus"`
+}
+
+// auditLog records every engine API call in a file, rotated once it reaches
+// its maximum size.
+type auditLog struct {
+	logger log.Logger
+	writer *phuslu.FileWriter
+	// failed is set once writing failed, so that the failure is logged once.
+	failed sync.Once
+}
+
+// newAuditLog creates the audit log of the engine API calls as configured,
+// nil if it is disabled.
+func newAuditLog(cfg *Config, logger log.Logger) *auditLog {
+	if cfg.AuditLogPath == "" {
+		return nil
+	}
+	return &auditLog{
+		logger: logger,
+		writer: &phuslu.FileWriter{
+			Filename:     cfg.AuditLogPath,
+			MaxSize:      int64(cfg.AuditLogMaxSizeMiB) * bytesPerMiB,
+			MaxBackups:   cfg.AuditLogMaxBackups,
+			FileMode:     auditLogFileMode,
+			EnsureFolder: true,
+		},
+	}
+}
+
+// record writes the entry of a completed call to the audit log.
+func (a *auditLog) record(call *ethclientrpc.CallRecord) {
+	digest := sha256.Sum256(call.Params)
+	entry := &auditEntry{
+		Time:          time.Now().UTC().Format(time.RFC3339Nano),
+		Method:        call.Method,
+		ParamsDigest:  "0x" + hex.EncodeToString(digest[:]),
+		HTTPStatus:    call.HTTPStatus,
+		Status:        auditStatusOK,
+		LatencyMs:     float64(call.Latency.Microseconds()) / 1e3,
+		CorrelationID: call.CorrelationID,
+	}
+	switch {
+	case call.Err != nil:
+		entry.Status = auditStatusError
+		entry.Error = call.Err.Error()
+	// Only the payload statuses are decoded, not the payloads.
+	case strings.HasPrefix(call.Method, "engine_newPayload"),
+		strings.HasPrefix(call.Method, "engine_forkchoiceUpdated"):
+		entry.setPayloadStatus(call.Result)
+	}
+
+	line, err := json.Marshal(entry)
+	if err == nil {
+		_, err = a.writer.Write(append(line, '\n'))
+	}
+	if err != nil {
+		a.failed.Do(func() {
+			a.logger.Error("Failed to write engine API audit log", "error", err)
+		})
+	}
+}
+
+// Close closes the audit log file.
+func (a *auditLog) Close() error {
+	return a.writer.Close()
+}
+
+// setPayloadStatus sets the payload status of the entry from the result of
+// a call, if it returned one.
+func (e *auditEntry) setPayloadStatus(result []byte) {
+	var ps auditPayloadStatus
+	if err := json.Unmarshal(result, &ps); err != nil {
+		return
+	}
+	status, lvh, validationErr := ps.Status, ps.LatestValidHash,
+		ps.ValidationError
+	if ps.PayloadStatus != nil {
+		status, lvh, validationErr = ps.PayloadStatus.Status,
+			ps.PayloadStatus.LatestValidHash,
+			ps.PayloadStatus.ValidationError
+	}
+	if status != "" {
+		e.Status = status
+	}
+	if lvh != nil {
+		e.LatestValidHash = *lvh
+	}
+	if validationErr != nil {
+		e.ValidationError = *validationErr
+	}
+}
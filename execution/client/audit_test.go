@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client_test
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/observability/telemetry"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/net/url"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLog(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			resp := `{"jsonrpc":"2.0","id":1,"error":{"code":-32601,` +
+				`"message":"method not found"}}`
+			if strings.Contains(string(body), "engine_newPayloadV3") {
+				resp = `{"jsonrpc":"2.0","id":1,"result":{` +
+					`"status":"INVALID","latestValidHash":"0x01",` +
+					`"validationError":"invalid block hash"}}`
+			}
+			_, _ = w.Write([]byte(resp))
+		},
+	))
+	defer srv.Close()
+
+	dialURL, err := url.NewFromRaw(srv.URL)
+	require.NoError(t, err)
+	cfg := client.DefaultConfig()
+	cfg.RPCDialURL = dialURL
+	cfg.AuditLogPath = filepath.Join(t.TempDir(), "audit", "engine.log")
+	ec := client.New(
+		&cfg, noop.NewLogger[any](), nil, telemetry.NewNoOpSink(),
+		big.NewInt(1),
+	)
+
+	ctx := context.Background()
+	payload := map[string]string{"transactions": "0xsecret"}
+	require.NoError(t, ec.Call(
+		log.ContextWithCorrelationID(ctx, "0a1b2c3d4e5f6071"),
+		nil, "engine_newPayloadV3", payload,
+	))
+	require.Error(t, ec.Call(ctx, nil, "engine_unknownV1"))
+	require.NoError(t, ec.Stop())
+
+	f, err := os.Open(cfg.AuditLogPath)
+	require.NoError(t, err)
+	defer f.Close()
+	var entries []map[string]any
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// The payloads are never recorded.
+		require.NotContains(t, scanner.Text(), "0xsecret")
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, entries, 2)
+
+	require.Equal(t, "engine_newPayloadV3", entries[0]["method"])
+	require.Equal(t, "INVALID", entries[0]["status"])
+	require.Equal(t, "0x01", entries[0]["latest_valid_hash"])
+	require.Equal(t, "invalid block hash", entries[0]["validation_error"])
+	require.InDelta(t, http.StatusOK, entries[0]["http_status"], 0)
+	require.Len(t, entries[0]["params_digest"], 66)
+	require.Equal(t, "0a1b2c3d4e5f6071", entries[0]["correlation_id"])
+
+	require.Equal(t, "engine_unknownV1", entries[1]["method"])
+	require.Equal(t, "ERROR", entries[1]["status"])
+	require.Contains(t, entries[1]["error"], "method not found")
+	require.NotContains(t, entries[1], "correlation_id")
+}
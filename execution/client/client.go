@@ -25,6 +25,7 @@ import (
 	"math/big"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/berachain/beacon-kit/errors"
@@ -52,6 +53,11 @@ type EngineClient struct {
 	// to the execution client.
 	connectedMu sync.RWMutex
 	connected   bool
+	// rpcTimeout is the timeout of the engine API requests, which may be
+	// updated at runtime.
+	rpcTimeout atomic.Int64
+	// audit records the engine API calls, if enabled.
+	audit *auditLog
 }
 
 // New creates a new engine client EngineClient.
@@ -64,22 +70,34 @@ func New(
 	telemetrySink TelemetrySink,
 	eth1ChainID *big.Int,
 ) *EngineClient {
-	return &EngineClient{
+	opts := []func(*ethclientrpc.Client){
+		ethclientrpc.WithJWTSecret(jwtSecret),
+		ethclientrpc.WithJWTRefreshInterval(cfg.RPCJWTRefreshInterval),
+	}
+	audit := newAuditLog(cfg, logger)
+	if audit != nil {
+		opts = append(opts, ethclientrpc.WithCallRecorder(audit.record))
+	}
+	ec := &EngineClient{
 		cfg:    cfg,
 		logger: logger,
 		Client: ethclient.New(
-			ethclientrpc.NewClient(
-				cfg.RPCDialURL.String(),
-				ethclientrpc.WithJWTSecret(jwtSecret),
-				ethclientrpc.WithJWTRefreshInterval(
-					cfg.RPCJWTRefreshInterval,
-				),
-			)),
+			ethclientrpc.NewClient(cfg.RPCDialURL.String(), opts...),
+		),
 		capabilities: make(map[string]struct{}),
 		eth1ChainID:  eth1ChainID,
 		metrics:      newClientMetrics(telemetrySink, logger),
 		connected:    false,
+		audit:        audit,
 	}
+	ec.SetRPCTimeout(cfg.RPCTimeout)
+	return ec
+}
+
+// SetRPCTimeout sets the timeout of the engine API requests. It is safe to
+// call at runtime.
+func (s *EngineClient) SetRPCTimeout(timeout time.Duration) {
+	s.rpcTimeout.Store(int64(timeout))
 }
 
 // Name returns the name of the engine client.
@@ -131,8 +149,13 @@ func (s *EngineClient) Start(
 	}
 }
 
+// Stop closes the connection to the execution client, and the audit log.
 func (s *EngineClient) Stop() error {
-	return nil
+	err := s.Client.Close()
+	if s.audit != nil {
+		err = errors.Join(err, s.audit.Close())
+	}
+	return err
 }
 
 func (s *EngineClient) IsConnected() bool {
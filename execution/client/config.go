@@ -32,6 +32,8 @@ const (
 	defaultRPCTimeout              = 2 * time.Second
 	defaultRPCStartupCheckInterval = 3 * time.Second
 	defaultRPCJWTRefreshInterval   = 20 * time.Second
+	defaultAuditLogMaxSizeMiB      = 100
+	defaultAuditLogMaxBackups      = 10
 	//#nosec:G101 // false positive.
 	defaultJWTSecretPath = "./jwt.hex"
 )
@@ -47,6 +49,9 @@ func DefaultConfig() Config {
 		RPCStartupCheckInterval: defaultRPCStartupCheckInterval,
 		RPCJWTRefreshInterval:   defaultRPCJWTRefreshInterval,
 		JWTSecretPath:           defaultJWTSecretPath,
+		AuditLogPath:            "",
+		AuditLogMaxSizeMiB:      defaultAuditLogMaxSizeMiB,
+		AuditLogMaxBackups:      defaultAuditLogMaxBackups,
 	}
 }
 
@@ -65,4 +70,13 @@ type Config struct {
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+	// AuditLogPath is the path to the audit log of the engine API calls,
+	// recording their method, a digest of their params, their status and
+	// latency. The audit log is disabled if unset.
+	AuditLogPath string `mapstructure:"audit-log-path"`
+	// AuditLogMaxSizeMiB is the size in MiB past which the audit log is
+	// rotated.
+	AuditLogMaxSizeMiB int `mapstructure:"audit-log-max-size-mib"`
+	// AuditLogMaxBackups is the number of rotated audit logs kept.
+	AuditLogMaxBackups int `mapstructure:"audit-log-max-backups"`
 }
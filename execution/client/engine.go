@@ -29,6 +29,8 @@ import (
 	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
 	"github.com/berachain/beacon-kit/errors"
 	ethclient "github.com/berachain/beacon-kit/execution/client/ethclient"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/common"
 )
 
@@ -43,6 +45,9 @@ func (s *EngineClient) NewPayload(
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *common.Root,
 ) (*common.ExecutionHash, error) {
+	ctx, span := tracing.Start(ctx, "engine.NewPayload")
+	defer span.End()
+
 	var (
 		startTime    = time.Now()
 		cctx, cancel = s.createContextWithTimeout(ctx)
@@ -55,6 +60,7 @@ func (s *EngineClient) NewPayload(
 		cctx, payload, versionedHashes, parentBeaconBlockRoot,
 	)
 	if err != nil {
+		tracing.RecordError(span, err)
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementNewPayloadTimeout()
 		}
@@ -67,14 +73,16 @@ func (s *EngineClient) NewPayload(
 	// This case is only true when the payload is invalid, so
 	// `processPayloadStatusResult` below will return an error.
 	if validationErr := result.ValidationError; validationErr != nil {
-		s.logger.Error(
+		log.WithContext(s.logger, ctx).Error(
 			"Got a validation error in newPayload",
 			"err",
 			errors.New(*validationErr),
 		)
 	}
 
-	return processPayloadStatusResult(result)
+	hash, err := processPayloadStatusResult(result)
+	tracing.RecordError(span, err)
+	return hash, err
 }
 
 /* -------------------------------------------------------------------------- */
@@ -88,6 +96,9 @@ func (s *EngineClient) ForkchoiceUpdated(
 	attrs *engineprimitives.PayloadAttributes,
 	forkVersion uint32,
 ) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
+	ctx, span := tracing.Start(ctx, "engine.ForkchoiceUpdated")
+	defer span.End()
+
 	var (
 		startTime    = time.Now()
 		cctx, cancel = s.createContextWithTimeout(ctx)
@@ -98,7 +109,7 @@ func (s *EngineClient) ForkchoiceUpdated(
 	// If the suggested fee recipient is not set, log a warning.
 	if !attrs.IsNil() &&
 		attrs.GetSuggestedFeeRecipient() == (common.ExecutionAddress{}) {
-		s.logger.Warn(
+		log.WithContext(s.logger, ctx).Warn(
 			"Suggested fee recipient is not configured 🔆",
 			"fee-recipent", attrs.GetSuggestedFeeRecipient(),
 		)
@@ -109,6 +120,7 @@ func (s *EngineClient) ForkchoiceUpdated(
 	)
 
 	if err != nil {
+		tracing.RecordError(span, err)
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementForkchoiceUpdateTimeout()
 		}
@@ -120,6 +132,7 @@ func (s *EngineClient) ForkchoiceUpdated(
 
 	latestValidHash, err := processPayloadStatusResult(&result.PayloadStatus)
 	if err != nil {
+		tracing.RecordError(span, err)
 		return nil, latestValidHash, err
 	}
 	return result.PayloadID, latestValidHash, nil
@@ -136,6 +149,9 @@ func (s *EngineClient) GetPayload(
 	payloadID engineprimitives.PayloadID,
 	forkVersion uint32,
 ) (ctypes.BuiltExecutionPayloadEnv, error) {
+	ctx, span := tracing.Start(ctx, "engine.GetPayload")
+	defer span.End()
+
 	var (
 		startTime    = time.Now()
 		cctx, cancel = s.createContextWithTimeout(ctx)
@@ -146,6 +162,7 @@ func (s *EngineClient) GetPayload(
 	// Call and check for errors.
 	result, err := s.Client.GetPayload(cctx, payloadID, forkVersion)
 	if err != nil {
+		tracing.RecordError(span, err)
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementGetPayloadTimeout()
 		}
@@ -28,6 +28,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/encoding/json"
 	"github.com/berachain/beacon-kit/primitives/net/jwt"
 )
@@ -46,6 +47,8 @@ type Client struct {
 	// jwtRefershInterval is the interval at which the JWT token should be
 	// refreshed.
 	jwtRefreshInterval time.Duration
+	// recorder is called with the record of every call, if set.
+	recorder func(*CallRecord)
 
 	// mu protects header for concurrent access.
 	mu sync.RWMutex
@@ -124,6 +127,39 @@ func (rpc *Client) Call(
 func (rpc *Client) CallRaw(
 	ctx context.Context, method string, params ...any,
 ) (json.RawMessage, error) {
+	if rpc.recorder == nil {
+		result, _, err := rpc.callRaw(ctx, method, params, nil)
+		return result, err
+	}
+
+	// The params are encoded once, to be recorded as they were sent.
+	record := &CallRecord{
+		Method:        method,
+		CorrelationID: log.CorrelationIDFromContext(ctx),
+	}
+	encodedParams, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	record.Params = encodedParams
+
+	start := time.Now()
+	record.Result, record.HTTPStatus, record.Err = rpc.callRaw(
+		ctx, method, params, json.RawMessage(encodedParams),
+	)
+	record.Latency = time.Since(start)
+	rpc.recorder(record)
+	return record.Result, record.Err
+}
+
+// callRaw calls the given method, and returns its raw result along with the
+// status code of the HTTP response. The params are sent as encoded if given.
+func (rpc *Client) callRaw(
+	ctx context.Context,
+	method string,
+	params []any,
+	encodedParams json.RawMessage,
+) (json.RawMessage, int, error) {
 	// Pull a request from the pool, we know that it already has the correct
 	// JSONRPC version and ID set.
 	//nolint:errcheck // this is safe.
@@ -133,10 +169,13 @@ func (rpc *Client) CallRaw(
 	// Update the request with the method and params.
 	request.Method = method
 	request.Params = params
+	if encodedParams != nil {
+		request.Params = encodedParams
+	}
 
 	body, err := json.Marshal(request)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	req, err := http.NewRequestWithContext(
@@ -146,7 +185,7 @@ func (rpc *Client) CallRaw(
 		bytes.NewBuffer(body),
 	)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	rpc.mu.RLock()
@@ -155,26 +194,26 @@ func (rpc *Client) CallRaw(
 
 	response, err := rpc.client.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	if response == nil {
-		return nil, ErrNilResponse
+		return nil, 0, ErrNilResponse
 	}
 	defer response.Body.Close()
 
 	data, err := io.ReadAll(response.Body)
 	if err != nil {
-		return nil, err
+		return nil, response.StatusCode, err
 	}
 
 	resp := new(Response)
 	if err = json.Unmarshal(data, resp); err != nil {
-		return nil, err
+		return nil, response.StatusCode, err
 	}
 
 	if resp.Error != nil {
-		return nil, *resp.Error
+		return nil, response.StatusCode, *resp.Error
 	}
 
-	return resp.Result, nil
+	return resp.Result, response.StatusCode, nil
 }
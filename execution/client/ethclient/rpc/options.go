@@ -39,3 +39,11 @@ func WithJWTRefreshInterval(interval time.Duration) func(rpc *Client) {
 		rpc.jwtRefreshInterval = interval
 	}
 }
+
+// WithCallRecorder sets the function every call made by the RPC client is
+// recorded with, once completed.
+func WithCallRecorder(recorder func(*CallRecord)) func(rpc *Client) {
+	return func(rpc *Client) {
+		rpc.recorder = recorder
+	}
+}
@@ -22,6 +22,7 @@ package rpc
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/berachain/beacon-kit/primitives/encoding/json"
 )
@@ -62,3 +63,24 @@ type Error struct {
 func (err Error) Error() string {
 	return fmt.Sprintf("Error %d (%s)", err.Code, err.Message)
 }
+
+// CallRecord is the record of a call made by the client, handed to the
+// recorder of the client once the call completed.
+type CallRecord struct {
+	// Method is the RPC method called.
+	Method string
+	// Params are the JSON encoded parameters of the call.
+	Params []byte
+	// HTTPStatus is the status code of the HTTP response, zero if the
+	// request failed before a response was received.
+	HTTPStatus int
+	// Result is the raw result of the call, nil if it failed.
+	Result json.RawMessage
+	// Err is the error of the call, if any.
+	Err error
+	// Latency is the time the call took.
+	Latency time.Duration
+	// CorrelationID is the correlation identifier of the block or request
+	// the call was made for, if any.
+	CorrelationID string
+}
@@ -37,7 +37,7 @@ func (s *EngineClient) createContextWithTimeout(
 	startTime := time.Now()
 	dctx, cancel := context.WithTimeoutCause(
 		ctx,
-		s.cfg.RPCTimeout,
+		time.Duration(s.rpcTimeout.Load()),
 		engineerrors.ErrEngineAPITimeout,
 	)
 	s.metrics.measureNewPayloadDuration(startTime)
@@ -43,6 +43,9 @@ type Engine struct {
 	logger log.Logger
 	// metrics is the metrics for the engine.
 	metrics *engineMetrics
+	// optimistic tracks the blocks that the execution client answered as
+	// SYNCING or ACCEPTED and has not validated yet.
+	optimistic *optimisticBlocks
 }
 
 // New creates a new Engine.
@@ -52,9 +55,10 @@ func New(
 	telemtrySink TelemetrySink,
 ) *Engine {
 	return &Engine{
-		ec:      engineClient,
-		logger:  logger,
-		metrics: newEngineMetrics(telemtrySink, logger),
+		ec:         engineClient,
+		logger:     logger,
+		metrics:    newEngineMetrics(telemtrySink, logger),
+		optimistic: newOptimisticBlocks(),
 	}
 }
 
@@ -71,6 +75,23 @@ func (ee *Engine) Start(
 	return nil
 }
 
+// IsConnected returns whether the execution client is reachable.
+func (ee *Engine) IsConnected() bool {
+	return ee.ec.IsConnected()
+}
+
+// IsSyncing returns whether the head of the latest forkchoice update is
+// optimistic, i.e. whether the execution client is still syncing up to it.
+func (ee *Engine) IsSyncing() bool {
+	return ee.optimistic.isHeadOptimistic()
+}
+
+// IsOptimistic returns whether the given execution block was imported
+// optimistically and is not validated by the execution client yet.
+func (ee *Engine) IsOptimistic(hash common.ExecutionHash) bool {
+	return ee.optimistic.isOptimistic(hash)
+}
+
 // GetPayload returns the payload and blobs bundle for the given slot.
 func (ee *Engine) GetPayload(
 	ctx context.Context,
@@ -90,6 +111,7 @@ func (ee *Engine) NotifyForkchoiceUpdate(
 	// Log the forkchoice update attempt.
 	hasPayloadAttributes := !req.PayloadAttributes.IsNil()
 	ee.metrics.markNotifyForkchoiceUpdateCalled(hasPayloadAttributes)
+	ee.optimistic.setHead(req.State.HeadBlockHash)
 
 	// Notify the execution engine of the forkchoice update.
 	payloadID, latestValidHash, err := ee.ec.ForkchoiceUpdated(
@@ -108,6 +130,9 @@ func (ee *Engine) NotifyForkchoiceUpdate(
 		engineerrors.ErrSyncingPayloadStatus,
 	):
 		ee.metrics.markForkchoiceUpdateAcceptedSyncing(req.State, err)
+		ee.optimistic.markOptimistic(
+			req.State.HeadBlockHash, common.ExecutionHash{},
+		)
 		return payloadID, nil, nil
 
 	// If we get invalid payload status, we will need to find a valid
@@ -121,6 +146,7 @@ func (ee *Engine) NotifyForkchoiceUpdate(
 		engineerrors.ErrInvalidBlockHashPayloadStatus,
 	):
 		ee.metrics.markForkchoiceUpdateInvalid(req.State, err)
+		ee.optimistic.markInvalid(req.State.HeadBlockHash)
 		return payloadID, latestValidHash, ErrBadBlockProduced
 
 	// JSON-RPC errors are predefined and should be handled as such.
@@ -136,6 +162,7 @@ func (ee *Engine) NotifyForkchoiceUpdate(
 		ee.metrics.markForkchoiceUpdateValid(
 			req.State, hasPayloadAttributes, payloadID,
 		)
+		ee.optimistic.markValid(req.State.HeadBlockHash)
 	}
 
 	// If we reached here, and we have a nil payload ID, we should log a
@@ -199,6 +226,10 @@ func (ee *Engine) VerifyAndNotifyNewPayload(
 			req.ExecutionPayload.GetParentHash(),
 			req.Optimistic,
 		)
+		ee.optimistic.markOptimistic(
+			req.ExecutionPayload.GetBlockHash(),
+			req.ExecutionPayload.GetParentHash(),
+		)
 
 	// These two cases are semantically the same:
 	// https://github.com/ethereum/execution-apis/issues/270
@@ -211,6 +242,7 @@ func (ee *Engine) VerifyAndNotifyNewPayload(
 			req.ExecutionPayload.GetBlockHash(),
 			req.Optimistic,
 		)
+		ee.optimistic.markInvalid(req.ExecutionPayload.GetBlockHash())
 
 		// We want to return bad block irrespective of
 		// if we are running in optimistic mode or not.
@@ -244,6 +276,10 @@ func (ee *Engine) VerifyAndNotifyNewPayload(
 			req.ExecutionPayload.GetParentHash(),
 			req.Optimistic,
 		)
+		// The parent is cleared too, in case the payload itself was never
+		// tracked as optimistic.
+		ee.optimistic.markValid(req.ExecutionPayload.GetBlockHash())
+		ee.optimistic.markValid(req.ExecutionPayload.GetParentHash())
 	}
 
 	// Under the optimistic condition, we are fine ignoring the error. This
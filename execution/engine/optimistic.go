@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// optimisticBlocks tracks the execution blocks that were imported
+// optimistically, i.e. that the execution client answered as SYNCING or
+// ACCEPTED and has not validated yet. Each block is kept along with its parent
+// so that validating a block also clears its optimistic ancestors.
+type optimisticBlocks struct {
+	mu sync.RWMutex
+	// parents maps each optimistic block to its parent.
+	parents map[common.ExecutionHash]common.ExecutionHash
+	// head is the latest head sent to the execution client.
+	head common.ExecutionHash
+}
+
+// newOptimisticBlocks creates a new, empty set of optimistic blocks.
+func newOptimisticBlocks() *optimisticBlocks {
+	return &optimisticBlocks{
+		parents: make(map[common.ExecutionHash]common.ExecutionHash),
+	}
+}
+
+// setHead records the head of the latest forkchoice update.
+func (o *optimisticBlocks) setHead(head common.ExecutionHash) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.head = head
+}
+
+// markOptimistic records the given block as optimistic. A block already
+// tracked keeps its parent, so a zero parent never breaks a known chain.
+func (o *optimisticBlocks) markOptimistic(
+	hash, parent common.ExecutionHash,
+) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if _, ok := o.parents[hash]; ok && parent == (common.ExecutionHash{}) {
+		return
+	}
+	o.parents[hash] = parent
+}
+
+// markValid clears the given block and, since a block is only valid along
+// with its chain, all of its optimistic ancestors.
+func (o *optimisticBlocks) markValid(hash common.ExecutionHash) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for {
+		parent, ok := o.parents[hash]
+		if !ok {
+			return
+		}
+		delete(o.parents, hash)
+		hash = parent
+	}
+}
+
+// markInvalid drops the given block, which will never become valid.
+func (o *optimisticBlocks) markInvalid(hash common.ExecutionHash) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	delete(o.parents, hash)
+}
+
+// isOptimistic returns whether the given block is optimistic.
+func (o *optimisticBlocks) isOptimistic(hash common.ExecutionHash) bool {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	_, ok := o.parents[hash]
+	return ok
+}
+
+// isHeadOptimistic returns whether the latest head is optimistic.
+func (o *optimisticBlocks) isHeadOptimistic() bool {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	_, ok := o.parents[o.head]
+	return ok
+}
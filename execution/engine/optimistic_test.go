@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimisticBlocks(t *testing.T) {
+	t.Parallel()
+	var (
+		a = common.ExecutionHash{0x01}
+		b = common.ExecutionHash{0x02}
+		c = common.ExecutionHash{0x03}
+		o = newOptimisticBlocks()
+	)
+	require.False(t, o.isHeadOptimistic())
+
+	// A chain a <- b <- c imported while the execution client syncs.
+	o.markOptimistic(a, common.ExecutionHash{})
+	o.markOptimistic(b, a)
+	o.markOptimistic(c, b)
+	o.setHead(c)
+	require.True(t, o.isHeadOptimistic())
+
+	// A forkchoice update does not know the parent of its head, which must
+	// not break the chain.
+	o.markOptimistic(c, common.ExecutionHash{})
+
+	// Validating b validates a, but not its descendant c.
+	o.markValid(b)
+	require.False(t, o.isOptimistic(a))
+	require.False(t, o.isOptimistic(b))
+	require.True(t, o.isOptimistic(c))
+	require.True(t, o.isHeadOptimistic())
+
+	o.markInvalid(c)
+	require.False(t, o.isOptimistic(c))
+	require.False(t, o.isHeadOptimistic())
+}
@@ -30,4 +30,7 @@ type (
 )
 
 //nolint:gochecknoglobals //used an alias.
-var WaitMined = bind.WaitMined
+var (
+	WaitMined                     = bind.WaitMined
+	NewKeyedTransactorWithChainID = bind.NewKeyedTransactorWithChainID
+)
@@ -29,4 +29,5 @@ type (
 //nolint:gochecknoglobals // its okay.
 var (
 	NewClient = ethclient.NewClient
+	Dial      = ethclient.Dial
 )
@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package log
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// The keys of the structured fields shared by the entries of all the
+// services, for the entries about the same block or validator to be
+// correlated across modules.
+const (
+	// ModuleKey is the key of the module a logger belongs to, grouping
+	// services whose log level may be overridden together.
+	ModuleKey = "module"
+	// ServiceKey is the key of the service a logger belongs to.
+	ServiceKey = "service"
+	// SlotKey is the key of the slot of the block being processed.
+	SlotKey = "slot"
+	// BlockRootKey is the key of the root of the block being processed.
+	BlockRootKey = "block_root"
+	// ValidatorIndexKey is the key of the index of the validator concerned.
+	ValidatorIndexKey = "validator_index"
+	// CorrelationIDKey is the key of the identifier shared by all the
+	// entries about the same block or API request, for them to be grepped
+	// together.
+	CorrelationIDKey = "correlation_id"
+)
+
+// correlationIDLen is the length in bytes of the correlation identifiers,
+// hex encoded in the entries.
+const correlationIDLen = 8
+
+// The modules the services belong to, e.g. for "engine=debug" to enable the
+// debug entries of all the services talking to the execution client.
+const (
+	ModuleAPI       = "api"
+	ModuleBeacon    = "beacon"
+	ModuleDA        = "da"
+	ModuleEngine    = "engine"
+	ModuleNode      = "node"
+	ModuleState     = "state"
+	ModuleStorage   = "storage"
+	ModuleValidator = "validator"
+)
+
+// ForService returns the logger of the given service of the given module.
+func ForService[LoggerT AdvancedLogger[LoggerT]](
+	logger LoggerT, module string, service string,
+) LoggerT {
+	return logger.With(ModuleKey, module, ServiceKey, service)
+}
+
+// fieldsKey is the context key of the fields added to the entries logged
+// while processing a request.
+type fieldsKey struct{}
+
+// ContextWithFields returns a copy of ctx carrying the given key/value
+// pairs, along with the ones ctx already carries, for them to be added to
+// the entries logged with the context by all the services handling it.
+func ContextWithFields(ctx context.Context, keyVals ...any) context.Context {
+	parent := FieldsFromContext(ctx)
+	fields := make([]any, 0, len(parent)+len(keyVals))
+	fields = append(append(fields, parent...), keyVals...)
+	return context.WithValue(ctx, fieldsKey{}, fields)
+}
+
+// FieldsFromContext returns the key/value pairs carried by ctx.
+func FieldsFromContext(ctx context.Context) []any {
+	fields, _ := ctx.Value(fieldsKey{}).([]any)
+	return fields
+}
+
+// correlationIDKey is the context key of the correlation identifier of the
+// block or request being processed.
+type correlationIDKey struct{}
+
+// NewCorrelationID returns a random correlation identifier.
+func NewCorrelationID() string {
+	bz := make([]byte, correlationIDLen)
+	//nolint:errcheck // never returns an error.
+	_, _ = rand.Read(bz)
+	return hex.EncodeToString(bz)
+}
+
+// BlockCorrelationID returns the correlation identifier of the block of the
+// given hash, so that the entries logged while verifying and finalizing the
+// block share it. A random identifier is returned if the hash is empty.
+func BlockCorrelationID(hash []byte) string {
+	if len(hash) == 0 {
+		return NewCorrelationID()
+	}
+	return hex.EncodeToString(hash[:min(len(hash), correlationIDLen)])
+}
+
+// ContextWithCorrelationID returns a copy of ctx carrying the given
+// correlation identifier, replacing the one ctx may already carry.
+func ContextWithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationIDFromContext returns the correlation identifier carried by
+// ctx, if any.
+func CorrelationIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(correlationIDKey{}).(string)
+	return id
+}
+
+// WithContext returns a logger adding the correlation identifier and the
+// key/value pairs carried by ctx to the entries of logger. A nil ctx, e.g.
+// the one of a state built out of a block, carries none.
+func WithContext(logger Logger, ctx context.Context) Logger {
+	if ctx == nil {
+		return logger
+	}
+	fields := FieldsFromContext(ctx)
+	if id := CorrelationIDFromContext(ctx); id != "" {
+		fields = append([]any{CorrelationIDKey, id}, fields...)
+	}
+	return WithFields(logger, fields...)
+}
+
+// WithFields returns a logger adding the given key/value pairs to the
+// entries of logger.
+func WithFields(logger Logger, keyVals ...any) Logger {
+	if len(keyVals) == 0 {
+		return logger
+	}
+	return &fieldsLogger{logger: logger, fields: keyVals}
+}
+
+// fieldsLogger is a logger adding fields to the entries of another one.
+type fieldsLogger struct {
+	logger Logger
+	fields []any
+}
+
+// Info logs a message at level Info.
+func (l *fieldsLogger) Info(msg string, keyVals ...any) {
+	l.logger.Info(msg, l.withFields(keyVals)...)
+}
+
+// Warn logs a message at level Warn.
+func (l *fieldsLogger) Warn(msg string, keyVals ...any) {
+	l.logger.Warn(msg, l.withFields(keyVals)...)
+}
+
+// Error logs a message at level Error.
+func (l *fieldsLogger) Error(msg string, keyVals ...any) {
+	l.logger.Error(msg, l.withFields(keyVals)...)
+}
+
+// Debug logs a message at level Debug.
+func (l *fieldsLogger) Debug(msg string, keyVals ...any) {
+	l.logger.Debug(msg, l.withFields(keyVals)...)
+}
+
+// withFields returns the fields of the logger followed by keyVals.
+func (l *fieldsLogger) withFields(keyVals []any) []any {
+	all := make([]any, 0, len(l.fields)+len(keyVals))
+	return append(append(all, l.fields...), keyVals...)
+}
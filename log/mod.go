@@ -71,6 +71,18 @@ type AdvancedLogger[LoggerT any] interface {
 	With(keyVals ...any) LoggerT
 }
 
+// Handler receives the entries logged by a logger, e.g. to forward them to
+// an external system. keyVals hold the logger context followed by the
+// key/value pairs of the entry.
+type Handler func(level string, msg string, keyVals ...any)
+
+// Hookable extends the logger with the ability to register handlers
+// receiving its entries.
+type Hookable interface {
+	// AddHandler registers a handler receiving the logged entries.
+	AddHandler(handler Handler)
+}
+
 // Color is a string that holds the hex color code for the color.
 type Color string
 
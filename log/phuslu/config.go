@@ -27,6 +27,9 @@ type Config struct {
 	TimeFormat string `mapstructure:"time-format"`
 	// Logger will log messages with verbosity up to LogLevel.
 	LogLevel string `mapstructure:"log-level"`
+	// ModuleLogLevels overrides LogLevel for some modules or services, as a
+	// comma separated list of module=level pairs, e.g. "engine=debug,da=info".
+	ModuleLogLevels string `mapstructure:"module-log-levels"`
 	// pretty or json.
 	Style string `mapstructure:"style"`
 }
@@ -34,8 +37,9 @@ type Config struct {
 // DefaultConfig is a function that returns a new Config with default values.
 func DefaultConfig() Config {
 	return Config{
-		TimeFormat: "RFC3339",
-		LogLevel:   "info",
-		Style:      StylePretty,
+		TimeFormat:      "RFC3339",
+		LogLevel:        "info",
+		ModuleLogLevels: "",
+		Style:           StylePretty,
 	}
 }
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/log"
+)
+
+// Handler receives the entries logged by the logger.
+type Handler = log.Handler
+
+// handlers holds the handlers shared by a logger and all the loggers
+// derived from it.
+type handlers struct {
+	mu   sync.RWMutex
+	list []Handler
+}
+
+// add registers the given handler.
+func (h *handlers) add(handler Handler) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.list = append(h.list, handler)
+}
+
+// handle passes the entry to the registered handlers, if any.
+func (h *handlers) handle(
+	level string, msg string, context map[string]any, keyVals []any,
+) {
+	h.mu.RLock()
+	list := h.list
+	h.mu.RUnlock()
+	if len(list) == 0 {
+		return
+	}
+
+	all := make([]any, 0, 2*len(context)+len(keyVals))
+	for k, v := range context {
+		all = append(all, k, v)
+	}
+	all = append(all, keyVals...)
+	for _, handler := range list {
+		handler(level, msg, all...)
+	}
+}
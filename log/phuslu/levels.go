@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/phuslu/log"
+)
+
+// ErrInvalidLogLevel is returned when parsing an unknown log level.
+var ErrInvalidLogLevel = errors.New("invalid log level")
+
+// levels holds the log levels shared by a logger and all the loggers derived
+// from it. They may be updated at runtime.
+type levels struct {
+	current atomic.Pointer[levelSet]
+}
+
+// levelSet is the global log level along with the overrides of the modules
+// or services, keyed by their name.
+type levelSet struct {
+	global  log.Level
+	modules map[string]log.Level
+}
+
+// newLevels returns levels logging at info level.
+func newLevels() *levels {
+	l := &levels{}
+	l.current.Store(&levelSet{global: log.InfoLevel})
+	return l
+}
+
+// enabled returns whether messages at the given level are logged by the
+// given service of the given module. The override of the service, if any,
+// takes precedence over the one of its module.
+func (l *levels) enabled(module, service string, level log.Level) bool {
+	set := l.current.Load()
+	minLevel, ok := set.modules[service]
+	if !ok {
+		minLevel, ok = set.modules[module]
+	}
+	if !ok {
+		minLevel = set.global
+	}
+	return level >= minLevel
+}
+
+// set parses and applies the given global and module levels.
+func (l *levels) set(level string, moduleLevels string) error {
+	global, err := parseLevel(level)
+	if err != nil {
+		return err
+	}
+	modules := make(map[string]log.Level)
+	for _, pair := range strings.Split(moduleLevels, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		module, moduleLevel, found := strings.Cut(pair, "=")
+		if !found {
+			return errors.Wrapf(
+				ErrInvalidLogLevel, "expected module=level, got %q", pair,
+			)
+		}
+		if modules[strings.TrimSpace(module)], err = parseLevel(
+			moduleLevel,
+		); err != nil {
+			return err
+		}
+	}
+	l.current.Store(&levelSet{global: global, modules: modules})
+	return nil
+}
+
+// parseLevel parses the given log level name.
+func parseLevel(level string) (log.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "trace":
+		return log.TraceLevel, nil
+	case "debug":
+		return log.DebugLevel, nil
+	case "info", "":
+		return log.InfoLevel, nil
+	case "warn", "warning":
+		return log.WarnLevel, nil
+	case "error":
+		return log.ErrorLevel, nil
+	case "fatal":
+		return log.FatalLevel, nil
+	case "panic":
+		return log.PanicLevel, nil
+	default:
+		return 0, errors.Wrap(ErrInvalidLogLevel, level)
+	}
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleLogLevels(t *testing.T) {
+	var out bytes.Buffer
+	cfg := phuslu.DefaultConfig()
+	cfg.Style = phuslu.StyleJSON
+	cfg.ModuleLogLevels = "engine=debug,engine.client=warn,da=error"
+	logger := phuslu.NewLogger(&out, &cfg)
+
+	logged := func(l log.Logger, debug bool) bool {
+		out.Reset()
+		if debug {
+			l.Debug("msg")
+		} else {
+			l.Info("msg")
+		}
+		return out.Len() > 0
+	}
+
+	// The override of a module applies to all its services.
+	engine := log.ForService(logger, log.ModuleEngine, "execution-engine")
+	require.True(t, logged(engine, true))
+	da := log.ForService(logger, log.ModuleDA, "da-store")
+	require.False(t, logged(da, false))
+
+	// The override of a service takes precedence over its module.
+	client := log.ForService(logger, log.ModuleEngine, "engine.client")
+	require.False(t, logged(client, false))
+
+	// Other services log at the global level.
+	beacon := log.ForService(logger, log.ModuleBeacon, "blockchain")
+	require.True(t, logged(beacon, false))
+	require.False(t, logged(beacon, true))
+
+	// The levels are updated at runtime for the derived loggers.
+	require.NoError(t, logger.SetLogLevels("info", "beacon=debug"))
+	require.True(t, logged(beacon, true))
+	require.True(t, logged(da, false))
+
+	require.ErrorIs(
+		t, logger.SetLogLevels("info", "engine"), phuslu.ErrInvalidLogLevel,
+	)
+}
+
+func TestContextFields(t *testing.T) {
+	var out bytes.Buffer
+	cfg := phuslu.DefaultConfig()
+	cfg.Style = phuslu.StyleJSON
+	logger := phuslu.NewLogger(&out, &cfg)
+
+	ctx := log.ContextWithFields(context.Background(), log.SlotKey, 42)
+	ctx = log.ContextWithFields(ctx, log.ValidatorIndexKey, 7)
+	log.WithContext(logger, ctx).Info("msg", "key", "value")
+
+	require.Contains(t, out.String(), `"slot":42`)
+	require.Contains(t, out.String(), `"validator_index":7`)
+	require.Contains(t, out.String(), `"key":"value"`)
+
+	// A context without fields leaves the logger as is.
+	require.Same(
+		t, log.Logger(logger), log.WithContext(logger, context.Background()),
+	)
+}
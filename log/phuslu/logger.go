@@ -23,6 +23,7 @@ package phuslu
 import (
 	"io"
 
+	bklog "github.com/berachain/beacon-kit/log"
 	"github.com/phuslu/log"
 )
 
@@ -36,6 +37,14 @@ type Logger struct {
 	out io.Writer
 	// formatter is the formatter to use for the logger.
 	formatter *Formatter
+	// levels are the log levels, shared with the derived loggers.
+	levels *levels
+	// module is the module the logger belongs to, if any.
+	module string
+	// service is the service the logger belongs to, if any.
+	service string
+	// handlers receive the logged entries, shared with the derived loggers.
+	handlers *handlers
 }
 
 // NewLogger initializes a new wrapped phuslogger with the provided config.
@@ -44,10 +53,14 @@ func NewLogger(
 	cfg *Config,
 ) *Logger {
 	logger := &Logger{
-		logger:    &log.Logger{},
+		// Levels are enforced by the wrapper, so that they can be set per
+		// module and updated at runtime.
+		logger:    &log.Logger{Level: log.TraceLevel},
 		context:   make(log.Fields),
 		out:       out,
 		formatter: NewFormatter(),
+		levels:    newLevels(),
+		handlers:  &handlers{},
 	}
 	logger.WithConfig(cfg)
 	return logger
@@ -55,34 +68,34 @@ func NewLogger(
 
 // Info logs a message at level Info.
 func (l *Logger) Info(msg string, keyVals ...any) {
-	if l.logger.Level > log.InfoLevel {
+	if !l.levels.enabled(l.module, l.service, log.InfoLevel) {
 		return
 	}
-	l.msgWithContext(msg, l.logger.Info(), keyVals...)
+	l.msgWithContext("info", msg, l.logger.Info(), keyVals...)
 }
 
 // Warn logs a message at level Warn.
 func (l *Logger) Warn(msg string, keyVals ...any) {
-	if l.logger.Level > log.WarnLevel {
+	if !l.levels.enabled(l.module, l.service, log.WarnLevel) {
 		return
 	}
-	l.msgWithContext(msg, l.logger.Warn(), keyVals...)
+	l.msgWithContext("warn", msg, l.logger.Warn(), keyVals...)
 }
 
 // Error logs a message at level Error.
 func (l *Logger) Error(msg string, keyVals ...any) {
-	if l.logger.Level > log.ErrorLevel {
+	if !l.levels.enabled(l.module, l.service, log.ErrorLevel) {
 		return
 	}
-	l.msgWithContext(msg, l.logger.Error(), keyVals...)
+	l.msgWithContext("error", msg, l.logger.Error(), keyVals...)
 }
 
 // Debug logs a message at level Debug.
 func (l *Logger) Debug(msg string, keyVals ...any) {
-	if l.logger.Level > log.DebugLevel {
+	if !l.levels.enabled(l.module, l.service, log.DebugLevel) {
 		return
 	}
-	l.msgWithContext(msg, l.logger.Debug(), keyVals...)
+	l.msgWithContext("debug", msg, l.logger.Debug(), keyVals...)
 }
 
 // Impl returns the underlying logger implementation.
@@ -107,6 +120,14 @@ func (l Logger) With(keyVals ...any) *Logger {
 			continue
 		}
 		newLogger.context[key] = keyVals[i+1]
+		if name, isString := keyVals[i+1].(string); isString {
+			switch key {
+			case bklog.ModuleKey:
+				newLogger.module = name
+			case bklog.ServiceKey:
+				newLogger.service = name
+			}
+		}
 	}
 
 	return &newLogger
@@ -117,11 +138,18 @@ func (l *Logger) Writer() io.Writer {
 	return l.out
 }
 
+// AddHandler registers a handler receiving the entries logged by the logger
+// and all the loggers derived from it.
+func (l *Logger) AddHandler(handler Handler) {
+	l.handlers.add(handler)
+}
+
 // msgWithContext logs a message with keyVals and current context.
 func (l *Logger) msgWithContext(
-	msg string, e *log.Entry, keyVals ...any,
+	level string, msg string, e *log.Entry, keyVals ...any,
 ) {
 	e.Fields(l.context).KeysAndValues(keyVals...).Msg(msg)
+	l.handlers.handle(level, msg, l.context, keyVals)
 }
 
 /* -------------------------------------------------------------------------- */
@@ -138,10 +166,20 @@ func (l *Logger) WithConfig(cfg *Config) *Logger {
 	}
 	l.withTimeFormat(cfg.TimeFormat)
 	l.withStyle(cfg.Style)
-	l.withLogLevel(cfg.LogLevel)
+	if err := l.SetLogLevels(cfg.LogLevel, cfg.ModuleLogLevels); err != nil {
+		l.Error("Failed to set log levels", "error", err)
+	}
 	return l
 }
 
+// SetLogLevels sets the global log level and the per module overrides, as
+// a comma separated list of module=level pairs, where a module may also be
+// the name of a single service. It applies to the logger and all the loggers
+// derived from it, and is safe to call at runtime.
+func (l *Logger) SetLogLevels(level string, moduleLevels string) error {
+	return l.levels.set(level, moduleLevels)
+}
+
 // AddKeyColor applies a color to log entries based on their keys.
 func (l *Logger) AddKeyColor(key any, color Color) {
 	//nolint:errcheck // should be safe
@@ -164,11 +202,6 @@ func (l *Logger) withStyle(style string) {
 	}
 }
 
-// SetLevel sets the log level of the logger.
-func (l *Logger) withLogLevel(level string) {
-	l.logger.Level = log.ParseLevel(level)
-}
-
 // useConsoleWriter sets the logger to use a console writer.
 func (l *Logger) useConsoleWriter() {
 	l.setWriter(&log.ConsoleWriter{
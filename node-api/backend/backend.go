@@ -23,10 +23,12 @@ package backend
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/beacon/operations"
 	"github.com/berachain/beacon-kit/chain-spec/chain"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/hashicorp/golang-lru/v2/expirable"
 )
 
 // Backend is the db access layer for the beacon node-api.
@@ -48,6 +50,21 @@ type Backend[
 	node NodeT
 
 	sp StateProcessor
+
+	// pool holds the operations submitted to the node.
+	pool *operations.Pool
+
+	// balances caches the balances of recent states, keyed by state root.
+	balances *expirable.LRU[common.Root, *stateBalances]
+	// registry projects the validator registry of the head state.
+	registry *validatorRegistry
+	// regen regenerates the states pruned from the multistore.
+	regen *StateRegen
+	// freezer holds the ancient blocks moved out of CometBFT, if any.
+	freezer Freezer
+	// backfill holds the blocks backfilled below the first block held by
+	// CometBFT, if any.
+	backfill Freezer
 }
 
 // New creates and returns a new Backend instance.
@@ -65,6 +82,10 @@ func New[
 	storageBackend StorageBackendT,
 	cs chain.ChainSpec,
 	sp StateProcessor,
+	pool *operations.Pool,
+	regen *StateRegen,
+	freezer Freezer,
+	backfill Freezer,
 ) *Backend[
 	AvailabilityStoreT,
 	BlockStoreT,
@@ -77,9 +98,15 @@ func New[
 		ContextT, DepositStoreT,
 		NodeT, StateStoreT, StorageBackendT,
 	]{
-		sb: storageBackend,
-		cs: cs,
-		sp: sp,
+		sb:       storageBackend,
+		cs:       cs,
+		sp:       sp,
+		pool:     pool,
+		balances: newBalancesCache(),
+		registry: newValidatorRegistry(),
+		regen:    regen,
+		freezer:  freezer,
+		backfill: backfill,
 	}
 }
 
@@ -89,6 +116,7 @@ func (b *Backend[
 	_, _, _, _, NodeT, _, _,
 ]) AttachQueryBackend(node NodeT) {
 	b.node = node
+	node.AddCommitListener(b.registry.onCommit)
 }
 
 // ChainSpec returns the chain spec from the backend.
@@ -112,6 +140,14 @@ func (b *Backend[
 	return b.sb.BlockStore().GetSlotByStateRoot(root)
 }
 
+// GetSlotByExecutionHash retrieves the slot by an execution block hash from
+// the block store.
+func (b *Backend[
+	_, _, _, _, _, _, _,
+]) GetSlotByExecutionHash(hash common.ExecutionHash) (math.Slot, error) {
+	return b.sb.BlockStore().GetSlotByExecutionHash(hash)
+}
+
 // GetParentSlotByTimestamp retrieves the parent slot by a given timestamp from
 // the block store.
 func (b *Backend[
@@ -154,6 +190,10 @@ func (b *Backend[
 	//#nosec:G701 // not an issue in practice.
 	queryCtx, err := b.node.CreateQueryContext(int64(slot), false)
 	if err != nil {
+		// Regenerate the states pruned from the multistore.
+		if slot != 0 && isPruned(err) && b.regen.enabled() {
+			st, err = b.regenerateState(slot)
+		}
 		return st, slot, err
 	}
 	st = b.sb.StateFromContext(queryCtx)
@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"strconv"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+)
+
+const (
+	// balancesCacheSize is the number of states whose balances are cached.
+	balancesCacheSize = 8
+	// balancesCacheTTL is how long the balances of a state are cached. It
+	// only needs to outlive a burst of requests, e.g. a dashboard polling
+	// hundreds of validators one by one.
+	balancesCacheTTL = 30 * time.Second
+)
+
+// stateBalances are the balances of the validators of a state, along with
+// the index of each pubkey to resolve the validator ids of requests.
+type stateBalances struct {
+	balances []uint64
+	indices  map[crypto.BLSPubkey]uint64
+}
+
+// newBalancesCache returns a cache of the balances of recent states, keyed
+// by state root.
+func newBalancesCache() *expirable.LRU[common.Root, *stateBalances] {
+	return expirable.NewLRU[common.Root, *stateBalances](
+		balancesCacheSize, nil, balancesCacheTTL,
+	)
+}
+
+// ValidatorBalancesByIDs returns the balances of the validators with the
+// given indices or pubkeys at the given slot. No ids returns the balances of
+// the whole registry. Unknown validators are skipped, as per the spec.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) ValidatorBalancesByIDs(
+	slot math.Slot, ids []string,
+) ([]*beacontypes.ValidatorBalanceData, error) {
+	sb, err := b.stateBalancesAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		data := make([]*beacontypes.ValidatorBalanceData, len(sb.balances))
+		for i, balance := range sb.balances {
+			data[i] = &beacontypes.ValidatorBalanceData{
+				Index:   uint64(i),
+				Balance: balance,
+			}
+		}
+		return data, nil
+	}
+
+	data := make([]*beacontypes.ValidatorBalanceData, 0, len(ids))
+	for _, id := range ids {
+		index, found, idErr := sb.indexByID(id)
+		if idErr != nil {
+			return nil, idErr
+		}
+		if !found {
+			continue
+		}
+		data = append(data, &beacontypes.ValidatorBalanceData{
+			Index:   index,
+			Balance: sb.balances[index],
+		})
+	}
+	return data, nil
+}
+
+// newStateBalances returns the balances of the given validators, along with
+// the index of their pubkeys.
+func newStateBalances(
+	validators ctypes.Validators, balances []uint64,
+) *stateBalances {
+	sb := &stateBalances{
+		balances: make([]uint64, len(validators)),
+		indices:  make(map[crypto.BLSPubkey]uint64, len(validators)),
+	}
+	copy(sb.balances, balances)
+	for i, val := range validators {
+		sb.indices[val.GetPubkey()] = uint64(i)
+	}
+	return sb
+}
+
+// stateBalancesAtSlot returns the balances of the state at the given slot.
+// The balances of the head are served from the projection of the registry,
+// the others from the cache if the block of the slot is available and its
+// post state was queried recently.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) stateBalancesAtSlot(slot math.Slot) (*stateBalances, error) {
+	head, err := b.headRegistry(slot)
+	if err != nil {
+		return nil, err
+	}
+	if head != nil {
+		return head.stateBalances, nil
+	}
+
+	blk, err := b.BlockAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	if blk != nil {
+		if sb, ok := b.balances.Get(blk.GetStateRoot()); ok {
+			return sb, nil
+		}
+		// Load the state of the block, even if a new head was committed
+		// since the block was loaded.
+		slot = blk.GetSlot()
+	}
+
+	st, _, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := st.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+	balances, err := st.GetBalances()
+	if err != nil {
+		return nil, err
+	}
+
+	sb := newStateBalances(validators, balances)
+	if blk != nil {
+		b.balances.Add(blk.GetStateRoot(), sb)
+	}
+	return sb, nil
+}
+
+// indexByID resolves the given validator index or pubkey into an index of
+// the registry, reporting whether the validator is in the registry.
+func (sb *stateBalances) indexByID(id string) (uint64, bool, error) {
+	if index, err := strconv.ParseUint(id, 10, 64); err == nil {
+		return index, index < uint64(len(sb.balances)), nil
+	}
+	var key crypto.BLSPubkey
+	if err := key.UnmarshalText([]byte(id)); err != nil {
+		return 0, false, err
+	}
+	index, found := sb.indices[key]
+	return index, found, nil
+}
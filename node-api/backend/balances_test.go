@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-api/backend/mocks"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+	"github.com/berachain/beacon-kit/storage/db"
+	"github.com/berachain/beacon-kit/storage/encoding"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type testKVStoreService struct {
+	ctx sdk.Context
+}
+
+func (kvs *testKVStoreService) OpenKVStore(context.Context) corestore.KVStore {
+	//nolint:contextcheck // fine with tests
+	return components.NewKVStore(kvs.ctx.KVStore(testStoreKey))
+}
+
+var testStoreKey = storetypes.NewKVStoreKey("backend-tests")
+
+// newTestState returns a beacon state backed by an in-memory store.
+func newTestState(t *testing.T, cs chain.ChainSpec) *statedb.StateDB {
+	t.Helper()
+	st, _ := newTestStore(t, cs)
+	return st
+}
+
+// newTestStore returns a beacon state backed by an in-memory store, along with
+// the store.
+func newTestStore(
+	t *testing.T, cs chain.ChainSpec,
+) (*statedb.StateDB, storetypes.CommitMultiStore) {
+	t.Helper()
+	memDB, err := db.OpenDB("", dbm.MemDBBackend)
+	require.NoError(t, err)
+	cms := store.NewCommitMultiStore(
+		memDB, log.NewNopLogger(), metrics.NewNoOpMetrics(),
+	)
+	cms.MountStoreWithDB(testStoreKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	kv := beacondb.New(
+		&testKVStoreService{ctx: sdk.NewContext(cms, true, log.NewNopLogger())},
+		&encoding.SSZInterfaceCodec[*ctypes.ExecutionPayloadHeader]{},
+	)
+	return new(statedb.StateDB).NewFromDB(kv, cs), cms
+}
+
+func TestValidatorBalancesByIDs(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	st := newTestState(t, cs)
+	pubkeys := []crypto.BLSPubkey{{0x01}, {0x02}, {0x03}}
+	for i, pubkey := range pubkeys {
+		require.NoError(t, st.AddValidator(&ctypes.Validator{Pubkey: pubkey}))
+		require.NoError(t, st.SetBalance(math.ValidatorIndex(i), math.Gwei(
+			uint64(i+1)*1e9,
+		)))
+	}
+
+	blk := &ctypes.BeaconBlock{
+		Slot:      10,
+		StateRoot: common.Root{0xaa},
+		Body: &ctypes.BeaconBlockBody{
+			ExecutionPayload: &ctypes.ExecutionPayload{
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: &ctypes.Eth1Data{},
+		},
+	}
+	bz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	// The state is loaded once, later requests are served from the cache.
+	node := mocks.NewNode[context.Context](t)
+	node.EXPECT().BeaconBlockBytes(int64(10)).Return(bz, nil)
+	node.EXPECT().CreateQueryContext(int64(10), false).
+		Return(context.Background(), nil).Once()
+	sp := mocks.NewStateProcessor(t)
+	sp.EXPECT().ProcessSlots(st, math.Slot(11)).Return(nil, nil).Once()
+	sb := mocks.NewStorageBackend[
+		backend.AvailabilityStore, backend.BlockStore, backend.DepositStore,
+	](t)
+	sb.EXPECT().StateFromContext(context.Background()).Return(st).Once()
+
+	b := backend.New[
+		backend.AvailabilityStore,
+		backend.BlockStore,
+		context.Context,
+		backend.DepositStore,
+		*mocks.Node[context.Context],
+		any,
+		*mocks.StorageBackend[
+			backend.AvailabilityStore, backend.BlockStore, backend.DepositStore,
+		],
+	](sb, cs, sp, nil, nil, nil, nil)
+	node.EXPECT().AddCommitListener(mock.Anything).Return()
+	b.AttachQueryBackend(node)
+
+	got, err := b.ValidatorBalancesByIDs(10, nil)
+	require.NoError(t, err)
+	require.Equal(t, []*beacontypes.ValidatorBalanceData{
+		{Index: 0, Balance: 1e9},
+		{Index: 1, Balance: 2e9},
+		{Index: 2, Balance: 3e9},
+	}, got)
+
+	// unknown validators are skipped
+	got, err = b.ValidatorBalancesByIDs(10, []string{
+		"2", pubkeys[0].String(), "7", crypto.BLSPubkey{0xff}.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*beacontypes.ValidatorBalanceData{
+		{Index: 2, Balance: 3e9},
+		{Index: 0, Balance: 1e9},
+	}, got)
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"slices"
+
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// BlobSidecarsAtSlot returns the blob sidecars of the block at the given slot,
+// or of the latest block if the slot is 0. If any indices are given, only the
+// sidecars at those indices are returned.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) BlobSidecarsAtSlot(
+	slot math.Slot, indices []uint64,
+) (datypes.BlobSidecars, error) {
+	blk, err := b.BlockAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	if blk == nil {
+		return nil, types.ErrNotFound
+	}
+	sidecars, err := b.sb.AvailabilityStore().GetBlobSidecars(
+		blk.GetSlot(), blk.GetBody(),
+	)
+	if err != nil || len(indices) == 0 {
+		return sidecars, err
+	}
+	return slices.DeleteFunc(sidecars, func(sc *datypes.BlobSidecar) bool {
+		return !slices.Contains(indices, sc.GetIndex())
+	}), nil
+}
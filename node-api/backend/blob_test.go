@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-api/backend/mocks"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobSidecarsAtSlot(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	blk := &ctypes.BeaconBlock{
+		Slot: 10,
+		Body: &ctypes.BeaconBlockBody{
+			ExecutionPayload: &ctypes.ExecutionPayload{
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: &ctypes.Eth1Data{},
+		},
+	}
+	bz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	node := mocks.NewNode[context.Context](t)
+	node.EXPECT().BeaconBlockBytes(int64(10)).Return(bz, nil)
+	node.EXPECT().BeaconBlockBytes(int64(11)).Return(nil, nil)
+
+	sidecars := datypes.BlobSidecars{{Index: 0}, {Index: 1}, {Index: 2}}
+	avs := mocks.NewAvailabilityStore(t)
+	avs.EXPECT().GetBlobSidecars(math.Slot(10), mock.Anything).
+		RunAndReturn(func(
+			math.Slot, *ctypes.BeaconBlockBody,
+		) (datypes.BlobSidecars, error) {
+			return append(datypes.BlobSidecars{}, sidecars...), nil
+		})
+	sb := mocks.NewStorageBackend[
+		*mocks.AvailabilityStore, backend.BlockStore, backend.DepositStore,
+	](t)
+	sb.EXPECT().AvailabilityStore().Return(avs)
+
+	b := backend.New[
+		*mocks.AvailabilityStore,
+		backend.BlockStore,
+		context.Context,
+		backend.DepositStore,
+		*mocks.Node[context.Context],
+		any,
+		*mocks.StorageBackend[
+			*mocks.AvailabilityStore, backend.BlockStore, backend.DepositStore,
+		],
+	](sb, cs, nil, nil, nil, nil, nil)
+	node.EXPECT().AddCommitListener(mock.Anything).Return()
+	b.AttachQueryBackend(node)
+
+	got, err := b.BlobSidecarsAtSlot(10, nil)
+	require.NoError(t, err)
+	require.Equal(t, sidecars, got)
+
+	got, err = b.BlobSidecarsAtSlot(10, []uint64{2, 0, 7})
+	require.NoError(t, err)
+	require.Equal(t, datypes.BlobSidecars{sidecars[0], sidecars[2]}, got)
+
+	// pruned blocks are not available
+	_, err = b.BlobSidecarsAtSlot(11, nil)
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
@@ -21,8 +21,10 @@
 package backend
 
 import (
+	typesregistry "github.com/berachain/beacon-kit/consensus-types/registry"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
-	types "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
@@ -33,6 +35,22 @@ func (b Backend[
 ]) BlockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader, error) {
 	var blockHeader *ctypes.BeaconBlockHeader
 
+	// Ancient headers are read from the freezer, or from the history
+	// backfilled, their states being pruned.
+	for _, cold := range []Freezer{b.freezer, b.backfill} {
+		if slot == 0 || cold == nil {
+			continue
+		}
+		bz, err := cold.HeaderBytes(slot)
+		if err != nil {
+			return blockHeader, err
+		}
+		if bz != nil {
+			blockHeader = new(ctypes.BeaconBlockHeader)
+			return blockHeader, blockHeader.UnmarshalSSZ(bz)
+		}
+	}
+
 	st, _, err := b.stateFromSlot(slot)
 	if err != nil {
 		return blockHeader, err
@@ -42,10 +60,50 @@ func (b Backend[
 	return blockHeader, err
 }
 
+// BlockAtSlot returns the beacon block at the given slot, the latest one if
+// the slot is 0, or nil if the block is not available, neither from CometBFT
+// nor from the freezer or the history backfilled.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) BlockAtSlot(slot math.Slot) (*ctypes.BeaconBlock, error) {
+	var err error
+	if slot == 0 {
+		if _, slot, err = b.stateFromSlotRaw(slot); err != nil {
+			return nil, err
+		}
+	}
+
+	// Beacon blocks are committed at the CometBFT height of their slot.
+	//#nosec:G701 // not an issue in practice.
+	bz, err := b.node.BeaconBlockBytes(int64(slot))
+	if err == nil && bz == nil && b.freezer != nil {
+		// Ancient blocks are moved out of CometBFT to the freezer.
+		bz, err = b.freezer.BlockBytes(slot)
+	}
+	if err == nil && bz == nil && b.backfill != nil {
+		// Blocks below the checkpoint the node was synced from are
+		// backfilled.
+		bz, err = b.backfill.BlockBytes(slot)
+	}
+	if err != nil || bz == nil {
+		return nil, err
+	}
+	return typesregistry.Default.BeaconBlocks.Unmarshal(
+		b.cs.ActiveForkVersionForSlot(slot), bz,
+	)
+}
+
 // GetBlockRoot returns the root of the block at the given stateID.
 func (b Backend[
 	_, _, _, _, _, _, _,
 ]) BlockRootAtSlot(slot math.Slot) (common.Root, error) {
+	// The roots of the recently finalized blocks are indexed by slot.
+	if slot != 0 {
+		if root, err := b.sb.BlockStore().GetBlockRootBySlot(slot); err == nil {
+			return root, nil
+		}
+	}
+
 	st, slot, err := b.stateFromSlot(slot)
 	if err != nil {
 		return common.Root{}, err
@@ -56,16 +114,54 @@ func (b Backend[
 	return st.GetBlockRootAtIndex(slot.Unwrap() % b.cs.SlotsPerHistoricalRoot())
 }
 
-// TODO: Implement this.
+// BlockRewardsAtSlot returns the rewards of the proposer of the block at the
+// given slot, as the increase of its balance from the pre state of the block
+// to its post state. Blocks carry no attestations nor sync aggregates, and
+// the slashing of misbehaviors reported by consensus pays no whistleblower
+// reward, so the total is not broken down. It includes the deposits credited
+// to the proposer, net of its withdrawals, proposers being rewarded on the
+// execution layer through the fees of the payload. The first block, whose
+// pre state is the genesis state, reports no rewards.
 func (b Backend[
 	_, _, _, _, _, _, _,
-]) BlockRewardsAtSlot(math.Slot) (*types.BlockRewardsData, error) {
-	return &types.BlockRewardsData{
-		ProposerIndex:     1,
-		Total:             1,
-		Attestations:      1,
-		SyncAggregate:     1,
-		ProposerSlashings: 1,
-		AttesterSlashings: 1,
-	}, nil
+]) BlockRewardsAtSlot(slot math.Slot) (*beacontypes.BlockRewardsData, error) {
+	blk, err := b.BlockAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	if blk == nil {
+		return nil, types.ErrNotFound
+	}
+	proposer := blk.GetProposerIndex()
+	rewards := &beacontypes.BlockRewardsData{ProposerIndex: proposer.Unwrap()}
+	if slot = blk.GetSlot(); slot <= 1 {
+		return rewards, nil
+	}
+
+	pre, err := b.balanceAtSlot(slot-1, proposer)
+	if err != nil {
+		return nil, err
+	}
+	post, err := b.balanceAtSlot(slot, proposer)
+	if err != nil {
+		return nil, err
+	}
+	if post > pre {
+		rewards.Total = (post - pre).Unwrap()
+	}
+	return rewards, nil
+}
+
+// balanceAtSlot returns the balance of the given validator in the post state
+// of the block at the given slot.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) balanceAtSlot(
+	slot math.Slot, index math.ValidatorIndex,
+) (math.Gwei, error) {
+	st, _, err := b.stateFromSlotRaw(slot)
+	if err != nil {
+		return 0, err
+	}
+	return st.GetBalance(index)
 }
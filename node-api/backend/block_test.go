@@ -0,0 +1,279 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-api/backend/mocks"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/backfill"
+	"github.com/berachain/beacon-kit/storage/freezer"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockAtSlot(t *testing.T) {
+	blk, _, b := newBlockBackend(t)
+
+	got, err := b.BlockAtSlot(10)
+	require.NoError(t, err)
+	require.Equal(t, blk.HashTreeRoot(), got.HashTreeRoot())
+
+	// pruned blocks are not available
+	got, err = b.BlockAtSlot(11)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
+
+func TestFrozenBlockAtSlot(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	f, err := freezer.Open(t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+	blk := &ctypes.BeaconBlock{
+		Slot:          11,
+		ProposerIndex: 5,
+		StateRoot:     common.Root{4, 5, 6},
+		Body: &ctypes.BeaconBlockBody{
+			ExecutionPayload: &ctypes.ExecutionPayload{
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: &ctypes.Eth1Data{},
+		},
+	}
+	bz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	header, err := blk.GetHeader().MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, f.FreezeBlock(11, bz, header))
+
+	node := mocks.NewNode[context.Context](t)
+	node.EXPECT().AddCommitListener(mock.Anything).Return()
+	node.EXPECT().BeaconBlockBytes(int64(11)).Return(nil, nil)
+	b := backend.New[
+		backend.AvailabilityStore,
+		backend.BlockStore,
+		context.Context,
+		backend.DepositStore,
+		*mocks.Node[context.Context],
+		any,
+		backend.StorageBackend[
+			backend.AvailabilityStore, backend.BlockStore, backend.DepositStore,
+		],
+	](nil, cs, nil, nil, nil, f, nil)
+	b.AttachQueryBackend(node)
+
+	// Blocks pruned from CometBFT are read from the freezer, and so are
+	// their headers, without loading their state.
+	got, err := b.BlockAtSlot(11)
+	require.NoError(t, err)
+	require.Equal(t, blk.HashTreeRoot(), got.HashTreeRoot())
+	gotHeader, err := b.BlockHeaderAtSlot(11)
+	require.NoError(t, err)
+	require.Equal(t, blk.HashTreeRoot(), gotHeader.HashTreeRoot())
+}
+
+func TestBackfilledBlockAtSlot(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	store := backfill.NewStore(storage.NewKVStoreProvider(dbm.NewMemDB()))
+
+	blk := &ctypes.BeaconBlock{
+		Slot:          3,
+		ProposerIndex: 5,
+		StateRoot:     common.Root{4, 5, 6},
+		Body: &ctypes.BeaconBlockBody{
+			ExecutionPayload: &ctypes.ExecutionPayload{
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: &ctypes.Eth1Data{},
+		},
+	}
+	bz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	header, err := blk.GetHeader().MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, store.Put(3, bz, header))
+
+	node := mocks.NewNode[context.Context](t)
+	node.EXPECT().AddCommitListener(mock.Anything).Return()
+	node.EXPECT().BeaconBlockBytes(int64(3)).Return(nil, nil)
+	b := backend.New[
+		backend.AvailabilityStore,
+		backend.BlockStore,
+		context.Context,
+		backend.DepositStore,
+		*mocks.Node[context.Context],
+		any,
+		backend.StorageBackend[
+			backend.AvailabilityStore, backend.BlockStore, backend.DepositStore,
+		],
+	](nil, cs, nil, nil, nil, nil, store)
+	b.AttachQueryBackend(node)
+
+	// Blocks below the checkpoint the node was synced from are read from
+	// the history backfilled.
+	got, err := b.BlockAtSlot(3)
+	require.NoError(t, err)
+	require.Equal(t, blk.HashTreeRoot(), got.HashTreeRoot())
+	gotHeader, err := b.BlockHeaderAtSlot(3)
+	require.NoError(t, err)
+	require.Equal(t, blk.HashTreeRoot(), gotHeader.HashTreeRoot())
+}
+
+func TestBlockRewardsAtSlot(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	// The proposer balance increases by 3 gwei from the pre state of the
+	// block to its post state.
+	states := make([]*statedb.StateDB, 2)
+	contexts := make([]context.Context, 2)
+	for i, balance := range []math.Gwei{1e9, 1e9 + 3} {
+		states[i] = newTestState(t, cs)
+		for j := range 2 {
+			require.NoError(t, states[i].AddValidator(&ctypes.Validator{
+				Pubkey: crypto.BLSPubkey{byte(j)},
+			}))
+			require.NoError(t, states[i].SetBalance(
+				math.ValidatorIndex(j), balance,
+			))
+		}
+		contexts[i] = context.WithValue(context.Background(), testStoreKey, i)
+	}
+
+	blk := &ctypes.BeaconBlock{
+		Slot:          10,
+		ProposerIndex: 1,
+		Body: &ctypes.BeaconBlockBody{
+			ExecutionPayload: &ctypes.ExecutionPayload{
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: &ctypes.Eth1Data{},
+		},
+	}
+	bz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	node := mocks.NewNode[context.Context](t)
+	node.EXPECT().BeaconBlockBytes(int64(10)).Return(bz, nil)
+	node.EXPECT().BeaconBlockBytes(int64(11)).Return(nil, nil)
+	node.EXPECT().CreateQueryContext(int64(9), false).Return(contexts[0], nil)
+	node.EXPECT().CreateQueryContext(int64(10), false).Return(contexts[1], nil)
+	sb := mocks.NewStorageBackend[
+		backend.AvailabilityStore, backend.BlockStore, backend.DepositStore,
+	](t)
+	sb.EXPECT().StateFromContext(contexts[0]).Return(states[0])
+	sb.EXPECT().StateFromContext(contexts[1]).Return(states[1])
+	b := backend.New[
+		backend.AvailabilityStore,
+		backend.BlockStore,
+		context.Context,
+		backend.DepositStore,
+		*mocks.Node[context.Context],
+		any,
+		*mocks.StorageBackend[
+			backend.AvailabilityStore, backend.BlockStore, backend.DepositStore,
+		],
+	](sb, cs, nil, nil, nil, nil, nil)
+	node.EXPECT().AddCommitListener(mock.Anything).Return()
+	b.AttachQueryBackend(node)
+
+	rewards, err := b.BlockRewardsAtSlot(10)
+	require.NoError(t, err)
+	require.Equal(t, &beacontypes.BlockRewardsData{
+		ProposerIndex: 1,
+		Total:         3,
+	}, rewards)
+
+	_, err = b.BlockRewardsAtSlot(11)
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
+
+// blockBackend is the backend returned by newBlockBackend.
+type blockBackend = backend.Backend[
+	backend.AvailabilityStore,
+	backend.BlockStore,
+	context.Context,
+	backend.DepositStore,
+	*mocks.Node[context.Context],
+	any,
+	backend.StorageBackend[
+		backend.AvailabilityStore, backend.BlockStore, backend.DepositStore,
+	],
+]
+
+// newBlockBackend returns a backend serving a block at slot 10, whose
+// block at slot 11 was pruned, along with its node.
+func newBlockBackend(
+	t *testing.T,
+) (*ctypes.BeaconBlock, *mocks.Node[context.Context], *blockBackend) {
+	t.Helper()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	blk := &ctypes.BeaconBlock{
+		Slot:          10,
+		ProposerIndex: 5,
+		ParentRoot:    common.Root{1, 2, 3},
+		Body: &ctypes.BeaconBlockBody{
+			ExecutionPayload: &ctypes.ExecutionPayload{
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: &ctypes.Eth1Data{},
+		},
+	}
+	bz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	node := mocks.NewNode[context.Context](t)
+	node.EXPECT().BeaconBlockBytes(int64(10)).Return(bz, nil)
+	node.EXPECT().BeaconBlockBytes(int64(11)).Return(nil, nil)
+
+	b := backend.New[
+		backend.AvailabilityStore,
+		backend.BlockStore,
+		context.Context,
+		backend.DepositStore,
+		*mocks.Node[context.Context],
+		any,
+		backend.StorageBackend[
+			backend.AvailabilityStore, backend.BlockStore, backend.DepositStore,
+		],
+	](nil, cs, nil, nil, nil, nil, nil)
+	node.EXPECT().AddCommitListener(mock.Anything).Return()
+	b.AttachQueryBackend(node)
+	return blk, node, b
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	prooftypes "github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// FinalityProofAtHeight returns the finality proof of the beacon block
+// committed at the given height. It returns nil if the block or its commit is
+// no longer available.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) FinalityProofAtHeight(
+	height math.Slot,
+) (*prooftypes.FinalityProofResponse, error) {
+	blk, err := b.BlockAtSlot(height)
+	if err != nil || blk == nil {
+		return nil, err
+	}
+
+	//#nosec:G701 // not an issue in practice.
+	lightBlock, proof, err := b.node.LightBlock(int64(height))
+	if err != nil || lightBlock == nil {
+		return nil, err
+	}
+	return prooftypes.NewFinalityProofResponse(blk, lightBlock, proof)
+}
@@ -23,16 +23,38 @@ package backend
 import (
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 // GetGenesis returns the genesis state of the beacon chain.
 func (b Backend[
 	_, _, _, _, _, _, _,
 ]) GenesisValidatorsRoot(slot math.Slot) (common.Root, error) {
-	// needs genesis_time and gensis_fork_version
 	st, _, err := b.stateFromSlot(slot)
 	if err != nil {
 		return common.Root{}, err
 	}
 	return st.GetGenesisValidatorsRoot()
 }
+
+// GenesisTime returns the genesis time of the chain, in seconds since the
+// Unix epoch.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) GenesisTime() (uint64, error) {
+	genesisTime, err := b.node.GenesisTime()
+	if err != nil {
+		return 0, err
+	}
+	//#nosec:G115 // the genesis time is never before the Unix epoch.
+	return uint64(genesisTime.Unix()), nil
+}
+
+// GenesisForkVersion returns the fork version the chain started with.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) GenesisForkVersion() common.Version {
+	return version.FromUint32[common.Version](
+		b.cs.ActiveForkVersionForEpoch(0),
+	)
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// LightClientDataAtSlot returns the header of the beacon block at the given
+// slot, the latest one if the slot is 0, along with the CometBFT light block
+// that finalized it. It returns nil if either is no longer available.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) LightClientDataAtSlot(
+	slot math.Slot,
+) (*beacontypes.LightClientResponse, error) {
+	blk, err := b.BlockAtSlot(slot)
+	if err != nil || blk == nil {
+		return nil, err
+	}
+
+	//#nosec:G701 // not an issue in practice.
+	lightBlock, proof, err := b.node.LightBlock(int64(blk.GetSlot()))
+	if err != nil || lightBlock == nil {
+		return nil, err
+	}
+	data, err := beacontypes.LightClientDataFromCometBFT(
+		blk.GetHeader(), lightBlock, proof,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &beacontypes.LightClientResponse{
+		Version: version.Name(blk.Version()),
+		Data:    data,
+	}, nil
+}
+
+// LightClientUpdates returns the light client data of count consecutive
+// periods from the given one. As the validator set only changes on epoch
+// boundaries, a period is an epoch, whose update is the data of its last
+// slot. Periods whose data is not available are skipped.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) LightClientUpdates(
+	startPeriod math.Epoch, count uint64,
+) ([]*beacontypes.LightClientResponse, error) {
+	_, head, err := b.stateFromSlotRaw(0)
+	if err != nil {
+		return nil, err
+	}
+
+	slotsPerEpoch := math.Slot(b.cs.SlotsPerEpoch())
+	updates := make([]*beacontypes.LightClientResponse, 0, count)
+	for period := startPeriod; period < startPeriod+math.Epoch(count); period++ {
+		slot := (period+1)*slotsPerEpoch - 1
+		if slot > head {
+			break
+		}
+		data, dataErr := b.LightClientDataAtSlot(slot)
+		if dataErr != nil {
+			return nil, dataErr
+		}
+		if data != nil {
+			updates = append(updates, data)
+		}
+	}
+	return updates, nil
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"testing"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLightClientDataAtSlot(t *testing.T) {
+	blk, node, b := newBlockBackend(t)
+	node.EXPECT().LightBlock(int64(10)).Return(
+		&cmttypes.LightBlock{
+			SignedHeader: &cmttypes.SignedHeader{
+				Header: &cmttypes.Header{ChainID: "test", Height: 10},
+				Commit: &cmttypes.Commit{Height: 10},
+			},
+			ValidatorSet: cmttypes.NewValidatorSet(nil),
+		},
+		&cmttypes.TxProof{Data: cmttypes.Tx{1, 2, 3}},
+		nil,
+	)
+
+	data, err := b.LightClientDataAtSlot(10)
+	require.NoError(t, err)
+	require.Equal(t, "deneb", data.Version)
+	require.Equal(
+		t, blk.HashTreeRoot(), data.Data.Header.Beacon.HashTreeRoot(),
+	)
+	// CometBFT objects use the CometBFT JSON encoding.
+	require.Contains(t, string(data.Data.LightBlock), `"height":"10"`)
+	require.Contains(t, string(data.Data.BeaconBlockProof), `"data":"AQID"`)
+
+	// pruned blocks are not available
+	data, err = b.LightClientDataAtSlot(11)
+	require.NoError(t, err)
+	require.Nil(t, data)
+}
@@ -7,7 +7,10 @@ import (
 
 	datypes "github.com/berachain/beacon-kit/da/types"
 	math "github.com/berachain/beacon-kit/primitives/math"
+
 	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/berachain/beacon-kit/consensus-types/types"
 )
 
 // AvailabilityStore is an autogenerated mock type for the AvailabilityStore type
@@ -23,17 +26,76 @@ func (_m *AvailabilityStore) EXPECT() *AvailabilityStore_Expecter {
 	return &AvailabilityStore_Expecter{mock: &_m.Mock}
 }
 
-// IsDataAvailable provides a mock function with given fields: _a0, _a1
-func (_m *AvailabilityStore) IsDataAvailable(_a0 context.Context, _a1 math.U64) bool {
+// GetBlobSidecars provides a mock function with given fields: _a0, _a1
+func (_m *AvailabilityStore) GetBlobSidecars(_a0 math.U64, _a1 *types.BeaconBlockBody) (datypes.BlobSidecars, error) {
 	ret := _m.Called(_a0, _a1)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobSidecars")
+	}
+
+	var r0 datypes.BlobSidecars
+	var r1 error
+	if rf, ok := ret.Get(0).(func(math.U64, *types.BeaconBlockBody) (datypes.BlobSidecars, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(math.U64, *types.BeaconBlockBody) datypes.BlobSidecars); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(datypes.BlobSidecars)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(math.U64, *types.BeaconBlockBody) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AvailabilityStore_GetBlobSidecars_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlobSidecars'
+type AvailabilityStore_GetBlobSidecars_Call struct {
+	*mock.Call
+}
+
+// GetBlobSidecars is a helper method to define mock.On call
+//   - _a0 math.U64
+//   - _a1 *types.BeaconBlockBody
+func (_e *AvailabilityStore_Expecter) GetBlobSidecars(_a0 interface{}, _a1 interface{}) *AvailabilityStore_GetBlobSidecars_Call {
+	return &AvailabilityStore_GetBlobSidecars_Call{Call: _e.mock.On("GetBlobSidecars", _a0, _a1)}
+}
+
+func (_c *AvailabilityStore_GetBlobSidecars_Call) Run(run func(_a0 math.U64, _a1 *types.BeaconBlockBody)) *AvailabilityStore_GetBlobSidecars_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(math.U64), args[1].(*types.BeaconBlockBody))
+	})
+	return _c
+}
+
+func (_c *AvailabilityStore_GetBlobSidecars_Call) Return(_a0 datypes.BlobSidecars, _a1 error) *AvailabilityStore_GetBlobSidecars_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AvailabilityStore_GetBlobSidecars_Call) RunAndReturn(run func(math.U64, *types.BeaconBlockBody) (datypes.BlobSidecars, error)) *AvailabilityStore_GetBlobSidecars_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsDataAvailable provides a mock function with given fields: _a0, _a1, _a2
+func (_m *AvailabilityStore) IsDataAvailable(_a0 context.Context, _a1 math.U64, _a2 *types.BeaconBlockBody) bool {
+	ret := _m.Called(_a0, _a1, _a2)
+
 	if len(ret) == 0 {
 		panic("no return value specified for IsDataAvailable")
 	}
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(context.Context, math.U64) bool); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, math.U64, *types.BeaconBlockBody) bool); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
@@ -49,13 +111,14 @@ type AvailabilityStore_IsDataAvailable_Call struct {
 // IsDataAvailable is a helper method to define mock.On call
 //   - _a0 context.Context
 //   - _a1 math.U64
-func (_e *AvailabilityStore_Expecter) IsDataAvailable(_a0 interface{}, _a1 interface{}) *AvailabilityStore_IsDataAvailable_Call {
-	return &AvailabilityStore_IsDataAvailable_Call{Call: _e.mock.On("IsDataAvailable", _a0, _a1)}
+//   - _a2 *types.BeaconBlockBody
+func (_e *AvailabilityStore_Expecter) IsDataAvailable(_a0 interface{}, _a1 interface{}, _a2 interface{}) *AvailabilityStore_IsDataAvailable_Call {
+	return &AvailabilityStore_IsDataAvailable_Call{Call: _e.mock.On("IsDataAvailable", _a0, _a1, _a2)}
 }
 
-func (_c *AvailabilityStore_IsDataAvailable_Call) Run(run func(_a0 context.Context, _a1 math.U64)) *AvailabilityStore_IsDataAvailable_Call {
+func (_c *AvailabilityStore_IsDataAvailable_Call) Run(run func(_a0 context.Context, _a1 math.U64, _a2 *types.BeaconBlockBody)) *AvailabilityStore_IsDataAvailable_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(math.U64))
+		run(args[0].(context.Context), args[1].(math.U64), args[2].(*types.BeaconBlockBody))
 	})
 	return _c
 }
@@ -65,7 +128,7 @@ func (_c *AvailabilityStore_IsDataAvailable_Call) Return(_a0 bool) *Availability
 	return _c
 }
 
-func (_c *AvailabilityStore_IsDataAvailable_Call) RunAndReturn(run func(context.Context, math.U64) bool) *AvailabilityStore_IsDataAvailable_Call {
+func (_c *AvailabilityStore_IsDataAvailable_Call) RunAndReturn(run func(context.Context, math.U64, *types.BeaconBlockBody) bool) *AvailabilityStore_IsDataAvailable_Call {
 	_c.Call.Return(run)
 	return _c
 }
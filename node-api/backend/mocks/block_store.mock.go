@@ -22,6 +22,62 @@ func (_m *BlockStore) EXPECT() *BlockStore_Expecter {
 	return &BlockStore_Expecter{mock: &_m.Mock}
 }
 
+// GetBlockRootBySlot provides a mock function with given fields: slot
+func (_m *BlockStore) GetBlockRootBySlot(slot math.U64) (common.Root, error) {
+	ret := _m.Called(slot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockRootBySlot")
+	}
+
+	var r0 common.Root
+	var r1 error
+	if rf, ok := ret.Get(0).(func(math.U64) (common.Root, error)); ok {
+		return rf(slot)
+	}
+	if rf, ok := ret.Get(0).(func(math.U64) common.Root); ok {
+		r0 = rf(slot)
+	} else {
+		r0 = ret.Get(0).(common.Root)
+	}
+
+	if rf, ok := ret.Get(1).(func(math.U64) error); ok {
+		r1 = rf(slot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlockStore_GetBlockRootBySlot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockRootBySlot'
+type BlockStore_GetBlockRootBySlot_Call struct {
+	*mock.Call
+}
+
+// GetBlockRootBySlot is a helper method to define mock.On call
+//   - slot math.U64
+func (_e *BlockStore_Expecter) GetBlockRootBySlot(slot interface{}) *BlockStore_GetBlockRootBySlot_Call {
+	return &BlockStore_GetBlockRootBySlot_Call{Call: _e.mock.On("GetBlockRootBySlot", slot)}
+}
+
+func (_c *BlockStore_GetBlockRootBySlot_Call) Run(run func(slot math.U64)) *BlockStore_GetBlockRootBySlot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(math.U64))
+	})
+	return _c
+}
+
+func (_c *BlockStore_GetBlockRootBySlot_Call) Return(_a0 common.Root, _a1 error) *BlockStore_GetBlockRootBySlot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BlockStore_GetBlockRootBySlot_Call) RunAndReturn(run func(math.U64) (common.Root, error)) *BlockStore_GetBlockRootBySlot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetParentSlotByTimestamp provides a mock function with given fields: timestamp
 func (_m *BlockStore) GetParentSlotByTimestamp(timestamp math.U64) (math.U64, error) {
 	ret := _m.Called(timestamp)
@@ -134,6 +190,62 @@ func (_c *BlockStore_GetSlotByBlockRoot_Call) RunAndReturn(run func(common.Root)
 	return _c
 }
 
+// GetSlotByExecutionHash provides a mock function with given fields: executionHash
+func (_m *BlockStore) GetSlotByExecutionHash(executionHash common.ExecutionHash) (math.U64, error) {
+	ret := _m.Called(executionHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSlotByExecutionHash")
+	}
+
+	var r0 math.U64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(common.ExecutionHash) (math.U64, error)); ok {
+		return rf(executionHash)
+	}
+	if rf, ok := ret.Get(0).(func(common.ExecutionHash) math.U64); ok {
+		r0 = rf(executionHash)
+	} else {
+		r0 = ret.Get(0).(math.U64)
+	}
+
+	if rf, ok := ret.Get(1).(func(common.ExecutionHash) error); ok {
+		r1 = rf(executionHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlockStore_GetSlotByExecutionHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSlotByExecutionHash'
+type BlockStore_GetSlotByExecutionHash_Call struct {
+	*mock.Call
+}
+
+// GetSlotByExecutionHash is a helper method to define mock.On call
+//   - executionHash common.ExecutionHash
+func (_e *BlockStore_Expecter) GetSlotByExecutionHash(executionHash interface{}) *BlockStore_GetSlotByExecutionHash_Call {
+	return &BlockStore_GetSlotByExecutionHash_Call{Call: _e.mock.On("GetSlotByExecutionHash", executionHash)}
+}
+
+func (_c *BlockStore_GetSlotByExecutionHash_Call) Run(run func(executionHash common.ExecutionHash)) *BlockStore_GetSlotByExecutionHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(common.ExecutionHash))
+	})
+	return _c
+}
+
+func (_c *BlockStore_GetSlotByExecutionHash_Call) Return(_a0 math.U64, _a1 error) *BlockStore_GetSlotByExecutionHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BlockStore_GetSlotByExecutionHash_Call) RunAndReturn(run func(common.ExecutionHash) (math.U64, error)) *BlockStore_GetSlotByExecutionHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSlotByStateRoot provides a mock function with given fields: root
 func (_m *BlockStore) GetSlotByStateRoot(root common.Root) (math.U64, error) {
 	ret := _m.Called(root)
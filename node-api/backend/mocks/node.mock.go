@@ -2,7 +2,17 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+
+	storetypes "cosmossdk.io/store/types"
+
+	transition "github.com/berachain/beacon-kit/primitives/transition"
+
+	types "github.com/cometbft/cometbft/types"
+)
 
 // Node is an autogenerated mock type for the Node type
 type Node[ContextT any] struct {
@@ -17,6 +27,97 @@ func (_m *Node[ContextT]) EXPECT() *Node_Expecter[ContextT] {
 	return &Node_Expecter[ContextT]{mock: &_m.Mock}
 }
 
+// AddCommitListener provides a mock function with given fields: listener
+func (_m *Node[ContextT]) AddCommitListener(listener func(int64, []*storetypes.StoreKVPair)) {
+	_m.Called(listener)
+}
+
+// Node_AddCommitListener_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCommitListener'
+type Node_AddCommitListener_Call[ContextT any] struct {
+	*mock.Call
+}
+
+// AddCommitListener is a helper method to define mock.On call
+//   - listener func(int64 , []*storetypes.StoreKVPair)
+func (_e *Node_Expecter[ContextT]) AddCommitListener(listener interface{}) *Node_AddCommitListener_Call[ContextT] {
+	return &Node_AddCommitListener_Call[ContextT]{Call: _e.mock.On("AddCommitListener", listener)}
+}
+
+func (_c *Node_AddCommitListener_Call[ContextT]) Run(run func(listener func(int64, []*storetypes.StoreKVPair))) *Node_AddCommitListener_Call[ContextT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(int64, []*storetypes.StoreKVPair)))
+	})
+	return _c
+}
+
+func (_c *Node_AddCommitListener_Call[ContextT]) Return() *Node_AddCommitListener_Call[ContextT] {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Node_AddCommitListener_Call[ContextT]) RunAndReturn(run func(func(int64, []*storetypes.StoreKVPair))) *Node_AddCommitListener_Call[ContextT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BeaconBlockBytes provides a mock function with given fields: height
+func (_m *Node[ContextT]) BeaconBlockBytes(height int64) ([]byte, error) {
+	ret := _m.Called(height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeaconBlockBytes")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) ([]byte, error)); ok {
+		return rf(height)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []byte); ok {
+		r0 = rf(height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Node_BeaconBlockBytes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeaconBlockBytes'
+type Node_BeaconBlockBytes_Call[ContextT any] struct {
+	*mock.Call
+}
+
+// BeaconBlockBytes is a helper method to define mock.On call
+//   - height int64
+func (_e *Node_Expecter[ContextT]) BeaconBlockBytes(height interface{}) *Node_BeaconBlockBytes_Call[ContextT] {
+	return &Node_BeaconBlockBytes_Call[ContextT]{Call: _e.mock.On("BeaconBlockBytes", height)}
+}
+
+func (_c *Node_BeaconBlockBytes_Call[ContextT]) Run(run func(height int64)) *Node_BeaconBlockBytes_Call[ContextT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *Node_BeaconBlockBytes_Call[ContextT]) Return(_a0 []byte, _a1 error) *Node_BeaconBlockBytes_Call[ContextT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Node_BeaconBlockBytes_Call[ContextT]) RunAndReturn(run func(int64) ([]byte, error)) *Node_BeaconBlockBytes_Call[ContextT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateQueryContext provides a mock function with given fields: height, prove
 func (_m *Node[ContextT]) CreateQueryContext(height int64, prove bool) (ContextT, error) {
 	ret := _m.Called(height, prove)
@@ -76,6 +177,195 @@ func (_c *Node_CreateQueryContext_Call[ContextT]) RunAndReturn(run func(int64, b
 	return _c
 }
 
+// FinalizedBlock provides a mock function with given fields: height
+func (_m *Node[ContextT]) FinalizedBlock(height int64) ([]byte, *transition.Context, error) {
+	ret := _m.Called(height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinalizedBlock")
+	}
+
+	var r0 []byte
+	var r1 *transition.Context
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int64) ([]byte, *transition.Context, error)); ok {
+		return rf(height)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []byte); ok {
+		r0 = rf(height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) *transition.Context); ok {
+		r1 = rf(height)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*transition.Context)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(int64) error); ok {
+		r2 = rf(height)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Node_FinalizedBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinalizedBlock'
+type Node_FinalizedBlock_Call[ContextT any] struct {
+	*mock.Call
+}
+
+// FinalizedBlock is a helper method to define mock.On call
+//   - height int64
+func (_e *Node_Expecter[ContextT]) FinalizedBlock(height interface{}) *Node_FinalizedBlock_Call[ContextT] {
+	return &Node_FinalizedBlock_Call[ContextT]{Call: _e.mock.On("FinalizedBlock", height)}
+}
+
+func (_c *Node_FinalizedBlock_Call[ContextT]) Run(run func(height int64)) *Node_FinalizedBlock_Call[ContextT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *Node_FinalizedBlock_Call[ContextT]) Return(_a0 []byte, _a1 *transition.Context, _a2 error) *Node_FinalizedBlock_Call[ContextT] {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Node_FinalizedBlock_Call[ContextT]) RunAndReturn(run func(int64) ([]byte, *transition.Context, error)) *Node_FinalizedBlock_Call[ContextT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenesisTime provides a mock function with no fields
+func (_m *Node[ContextT]) GenesisTime() (time.Time, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenesisTime")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (time.Time, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() time.Time); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Node_GenesisTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenesisTime'
+type Node_GenesisTime_Call[ContextT any] struct {
+	*mock.Call
+}
+
+// GenesisTime is a helper method to define mock.On call
+func (_e *Node_Expecter[ContextT]) GenesisTime() *Node_GenesisTime_Call[ContextT] {
+	return &Node_GenesisTime_Call[ContextT]{Call: _e.mock.On("GenesisTime")}
+}
+
+func (_c *Node_GenesisTime_Call[ContextT]) Run(run func()) *Node_GenesisTime_Call[ContextT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Node_GenesisTime_Call[ContextT]) Return(_a0 time.Time, _a1 error) *Node_GenesisTime_Call[ContextT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Node_GenesisTime_Call[ContextT]) RunAndReturn(run func() (time.Time, error)) *Node_GenesisTime_Call[ContextT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LightBlock provides a mock function with given fields: height
+func (_m *Node[ContextT]) LightBlock(height int64) (*types.LightBlock, *types.TxProof, error) {
+	ret := _m.Called(height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LightBlock")
+	}
+
+	var r0 *types.LightBlock
+	var r1 *types.TxProof
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int64) (*types.LightBlock, *types.TxProof, error)); ok {
+		return rf(height)
+	}
+	if rf, ok := ret.Get(0).(func(int64) *types.LightBlock); ok {
+		r0 = rf(height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.LightBlock)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) *types.TxProof); ok {
+		r1 = rf(height)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*types.TxProof)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(int64) error); ok {
+		r2 = rf(height)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Node_LightBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LightBlock'
+type Node_LightBlock_Call[ContextT any] struct {
+	*mock.Call
+}
+
+// LightBlock is a helper method to define mock.On call
+//   - height int64
+func (_e *Node_Expecter[ContextT]) LightBlock(height interface{}) *Node_LightBlock_Call[ContextT] {
+	return &Node_LightBlock_Call[ContextT]{Call: _e.mock.On("LightBlock", height)}
+}
+
+func (_c *Node_LightBlock_Call[ContextT]) Run(run func(height int64)) *Node_LightBlock_Call[ContextT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *Node_LightBlock_Call[ContextT]) Return(_a0 *types.LightBlock, _a1 *types.TxProof, _a2 error) *Node_LightBlock_Call[ContextT] {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Node_LightBlock_Call[ContextT]) RunAndReturn(run func(int64) (*types.LightBlock, *types.TxProof, error)) *Node_LightBlock_Call[ContextT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewNode creates a new instance of Node. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewNode[ContextT any](t interface {
@@ -8,6 +8,8 @@ import (
 
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 	transition "github.com/berachain/beacon-kit/primitives/transition"
+
+	types "github.com/berachain/beacon-kit/consensus-types/types"
 )
 
 // StateProcessor is an autogenerated mock type for the StateProcessor type
@@ -82,6 +84,66 @@ func (_c *StateProcessor_ProcessSlots_Call) RunAndReturn(run func(*statedb.State
 	return _c
 }
 
+// Transition provides a mock function with given fields: _a0, _a1, _a2
+func (_m *StateProcessor) Transition(_a0 *transition.Context, _a1 *statedb.StateDB, _a2 *types.BeaconBlock) (transition.ValidatorUpdates, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transition")
+	}
+
+	var r0 transition.ValidatorUpdates
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*transition.Context, *statedb.StateDB, *types.BeaconBlock) (transition.ValidatorUpdates, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(*transition.Context, *statedb.StateDB, *types.BeaconBlock) transition.ValidatorUpdates); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(transition.ValidatorUpdates)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*transition.Context, *statedb.StateDB, *types.BeaconBlock) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateProcessor_Transition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transition'
+type StateProcessor_Transition_Call struct {
+	*mock.Call
+}
+
+// Transition is a helper method to define mock.On call
+//   - _a0 *transition.Context
+//   - _a1 *statedb.StateDB
+//   - _a2 *types.BeaconBlock
+func (_e *StateProcessor_Expecter) Transition(_a0 interface{}, _a1 interface{}, _a2 interface{}) *StateProcessor_Transition_Call {
+	return &StateProcessor_Transition_Call{Call: _e.mock.On("Transition", _a0, _a1, _a2)}
+}
+
+func (_c *StateProcessor_Transition_Call) Run(run func(_a0 *transition.Context, _a1 *statedb.StateDB, _a2 *types.BeaconBlock)) *StateProcessor_Transition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*transition.Context), args[1].(*statedb.StateDB), args[2].(*types.BeaconBlock))
+	})
+	return _c
+}
+
+func (_c *StateProcessor_Transition_Call) Return(_a0 transition.ValidatorUpdates, _a1 error) *StateProcessor_Transition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateProcessor_Transition_Call) RunAndReturn(run func(*transition.Context, *statedb.StateDB, *types.BeaconBlock) (transition.ValidatorUpdates, error)) *StateProcessor_Transition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewStateProcessor creates a new instance of StateProcessor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewStateProcessor(t interface {
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+
+// SubmitVoluntaryExit validates the exit against the head state and adds it
+// to the operations pool.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) SubmitVoluntaryExit(exit *ctypes.SignedVoluntaryExit) error {
+	// Slot 0 resolves to the head state.
+	st, _, err := b.stateFromSlot(0)
+	if err != nil {
+		return err
+	}
+	return b.pool.AddVoluntaryExit(st, exit)
+}
+
+// SubmitBLSToExecutionChange validates the change against the head state and
+// adds it to the operations pool.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) SubmitBLSToExecutionChange(
+	change *ctypes.SignedBLSToExecutionChange,
+) error {
+	// Slot 0 resolves to the head state.
+	st, _, err := b.stateFromSlot(0)
+	if err != nil {
+		return err
+	}
+	return b.pool.AddBLSToExecutionChange(st, change)
+}
+
+// VoluntaryExits returns the voluntary exits in the operations pool.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) VoluntaryExits() []*ctypes.SignedVoluntaryExit {
+	return b.pool.VoluntaryExits()
+}
+
+// BLSToExecutionChanges returns the BLS to execution changes in the
+// operations pool.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) BLSToExecutionChanges() []*ctypes.SignedBLSToExecutionChange {
+	return b.pool.BLSToExecutionChanges()
+}
@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+
+	sdklog "cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	typesregistry "github.com/berachain/beacon-kit/consensus-types/registry"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// regenCacheSize is the number of regenerated states cached. Regenerated
+// states are also the starting point of the following regenerations, so
+// walking through historical slots only replays each block once.
+const regenCacheSize = 8
+
+// errBlockUnavailable is returned when a block to replay is no longer held
+// by CometBFT.
+var errBlockUnavailable = errors.New("block to replay is not available")
+
+// StateRegen regenerates the beacon states pruned from the multistore, by
+// replaying the blocks held by CometBFT on top of the nearest epoch boundary
+// snapshot of the beacon state.
+type StateRegen struct {
+	snapshots SnapshotStore
+	// storeKey is the key of the beacon state store, mounted on the
+	// in-memory stores states are regenerated in.
+	storeKey storetypes.StoreKey
+	logger   log.Logger
+
+	// states caches the regenerated states, keyed by slot.
+	states *lru.Cache[math.Slot, *ctypes.BeaconState]
+}
+
+// NewStateRegen returns a regenerator of the states pruned from the
+// multistore, from the snapshots of the given store.
+func NewStateRegen(
+	snapshots SnapshotStore,
+	storeKey storetypes.StoreKey,
+	logger log.Logger,
+) *StateRegen {
+	states, err := lru.New[math.Slot, *ctypes.BeaconState](regenCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return &StateRegen{
+		snapshots: snapshots,
+		storeKey:  storeKey,
+		logger:    logger,
+		states:    states,
+	}
+}
+
+// enabled reports whether pruned states are regenerated.
+func (r *StateRegen) enabled() bool {
+	return r != nil && r.snapshots != nil
+}
+
+// regenerateState regenerates the state at the given slot, pruned from the
+// multistore, from the nearest snapshot or regenerated state before it.
+func (b *Backend[
+	_, _, _, _, _, _, _,
+]) regenerateState(slot math.Slot) (*statedb.StateDB, error) {
+	base, err := b.regen.snapshots.GetAtOrBefore(slot)
+	if err != nil {
+		return nil, err
+	}
+	for _, cached := range b.regen.states.Keys() {
+		if cached <= slot && cached > base.Slot {
+			if bs, ok := b.regen.states.Get(cached); ok {
+				base = bs
+			}
+		}
+	}
+
+	st, err := b.memoryState(base)
+	if err != nil {
+		return nil, err
+	}
+	for s := base.Slot + 1; s <= slot; s++ {
+		if err = b.replayBlock(st, s); err != nil {
+			return nil, errors.Wrapf(err, "failed to replay slot %d", s)
+		}
+	}
+
+	if replayed := slot - base.Slot; replayed > 0 {
+		b.regen.logger.Info("Regenerated state",
+			"slot", slot, "from", base.Slot, "replayed", replayed)
+		bs, marshalErr := st.GetMarshallable()
+		if marshalErr != nil {
+			return nil, marshalErr
+		}
+		b.regen.states.Add(slot, bs)
+	}
+	return st, nil
+}
+
+// replayBlock applies the block committed at the given slot to the state,
+// as it was when the block was finalized. Execution payloads are not sent
+// to the execution client again.
+func (b *Backend[
+	_, _, _, _, _, _, _,
+]) replayBlock(st *statedb.StateDB, slot math.Slot) error {
+	// Beacon blocks are committed at the CometBFT height of their slot.
+	//#nosec:G115 // not an issue in practice.
+	bz, ctx, err := b.node.FinalizedBlock(int64(slot))
+	if err != nil {
+		return err
+	}
+	if ctx == nil {
+		return errBlockUnavailable
+	}
+	if bz == nil {
+		// No beacon block was finalized at this height.
+		return nil
+	}
+
+	blk, err := typesregistry.Default.BeaconBlocks.Unmarshal(
+		b.cs.ActiveForkVersionForSlot(slot), bz,
+	)
+	if err != nil {
+		return err
+	}
+	ctx.Context = log.ContextWithFields(
+		context.Background(), log.SlotKey, slot,
+	)
+	ctx.SkipPayloadVerification = true
+	ctx.SkipValidateRandao = true
+	_, err = b.sp.Transition(ctx, st, blk)
+	return err
+}
+
+// memoryState returns the given beacon state, backed by an in-memory store.
+func (b *Backend[
+	_, _, _, _, _, _, _,
+]) memoryState(bs *ctypes.BeaconState) (*statedb.StateDB, error) {
+	cms := store.NewCommitMultiStore(
+		dbm.NewMemDB(), sdklog.NewNopLogger(), storemetrics.NewNoOpMetrics(),
+	)
+	cms.MountStoreWithDB(b.regen.storeKey, storetypes.StoreTypeIAVL, nil)
+	if err := cms.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+	st := b.sb.StateFromContext(
+		sdk.NewContext(cms, false, sdklog.NewNopLogger()),
+	)
+	if err := st.SetMarshallable(bs); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// isPruned reports whether the given error of a query context is due to the
+// state being pruned from the multistore.
+func isPruned(err error) bool {
+	return errors.Is(err, sdkerrors.ErrNotFound)
+}
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-api/backend/mocks"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+	"github.com/berachain/beacon-kit/storage/encoding"
+	"github.com/berachain/beacon-kit/storage/snapshot"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// testBeaconState returns a beacon state at the given slot.
+func testBeaconState(
+	t *testing.T, cs chain.ChainSpec, slot math.Slot,
+) *ctypes.BeaconState {
+	t.Helper()
+	bs, err := new(ctypes.BeaconState).New(
+		0,
+		common.Root{0x01},
+		slot,
+		&ctypes.Fork{},
+		&ctypes.BeaconBlockHeader{Slot: slot},
+		make([]common.Root, cs.SlotsPerHistoricalRoot()),
+		make([]common.Root, cs.SlotsPerHistoricalRoot()),
+		&ctypes.Eth1Data{},
+		0,
+		&ctypes.ExecutionPayloadHeader{BaseFeePerGas: &math.U256{}},
+		[]*ctypes.Validator{{Pubkey: [48]byte{0x01}}},
+		[]uint64{1e9},
+		make([]common.Bytes32, cs.EpochsPerHistoricalVector()),
+		0,
+		0,
+		[]math.Gwei{0},
+		0,
+	)
+	require.NoError(t, err)
+	return bs
+}
+
+func TestStateRegeneration(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	snapshots := snapshot.NewStore(
+		storage.NewKVStoreProvider(dbm.NewMemDB()), nil, noop.NewLogger[any](),
+	)
+
+	node := mocks.NewNode[context.Context](t)
+	node.EXPECT().AddCommitListener(mock.Anything).Return()
+	sp := mocks.NewStateProcessor(t)
+	sb := mocks.NewStorageBackend[
+		backend.AvailabilityStore, backend.BlockStore, backend.DepositStore,
+	](t)
+	sb.EXPECT().StateFromContext(mock.Anything).RunAndReturn(
+		func(ctx context.Context) *statedb.StateDB {
+			kv := beacondb.New(
+				&testKVStoreService{ctx: sdk.UnwrapSDKContext(ctx)},
+				&encoding.SSZInterfaceCodec[*ctypes.ExecutionPayloadHeader]{},
+			)
+			return new(statedb.StateDB).NewFromDB(kv, cs)
+		},
+	)
+
+	b := backend.New[
+		backend.AvailabilityStore,
+		backend.BlockStore,
+		context.Context,
+		backend.DepositStore,
+		*mocks.Node[context.Context],
+		any,
+		*mocks.StorageBackend[
+			backend.AvailabilityStore, backend.BlockStore, backend.DepositStore,
+		],
+	](sb, cs, sp, nil, backend.NewStateRegen(
+		snapshots, testStoreKey, noop.NewLogger[any](),
+	), nil, nil)
+	b.AttachQueryBackend(node)
+
+	// No state is served to checkpoint syncing nodes until snapshotted.
+	bs, err := b.CheckpointState()
+	require.NoError(t, err)
+	require.Nil(t, bs)
+	require.NoError(t, snapshots.Set(testBeaconState(t, cs, 4)))
+	bs, err = b.CheckpointState()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(4), bs.Slot)
+
+	// Pruned states are regenerated by replaying the blocks following the
+	// snapshot, the block of slot 5 missing its beacon block.
+	blk := &ctypes.BeaconBlock{
+		Slot: 6,
+		Body: &ctypes.BeaconBlockBody{
+			ExecutionPayload: &ctypes.ExecutionPayload{
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: &ctypes.Eth1Data{},
+		},
+	}
+	bz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	pruned := errorsmod.Wrap(sdkerrors.ErrNotFound, "version pruned")
+	node.EXPECT().CreateQueryContext(int64(6), false).
+		Return(context.Background(), pruned).Twice()
+	node.EXPECT().FinalizedBlock(int64(5)).
+		Return(nil, &transition.Context{}, nil).Once()
+	node.EXPECT().FinalizedBlock(int64(6)).Return(
+		bz, &transition.Context{ProposerAddress: []byte{0x01}}, nil,
+	).Once()
+	sp.EXPECT().Transition(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(
+			ctx *transition.Context,
+			st *statedb.StateDB,
+			blk *ctypes.BeaconBlock,
+		) (transition.ValidatorUpdates, error) {
+			require.True(t, ctx.SkipPayloadVerification)
+			require.Equal(t, []byte{0x01}, ctx.ProposerAddress)
+			return nil, st.SetSlot(blk.GetSlot())
+		}).Once()
+	sp.EXPECT().ProcessSlots(mock.Anything, math.Slot(7)).Return(nil, nil)
+
+	// The regenerated state is cached.
+	for range 2 {
+		got, stateErr := b.StateAtSlot(6)
+		require.NoError(t, stateErr)
+		require.Equal(t, math.Slot(6), got.Slot)
+		require.Len(t, got.Validators, 1)
+	}
+
+	// States before the first snapshot are not available.
+	node.EXPECT().CreateQueryContext(int64(3), false).
+		Return(context.Background(), pruned).Once()
+	_, err = b.StateAtSlot(3)
+	require.ErrorIs(t, err, snapshot.ErrNotFound)
+}
@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"maps"
+	"slices"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+)
+
+// errRegistryGap is returned when a block adds a validator past the end of
+// the projected registry, which can only happen if some changes were missed.
+var errRegistryGap = errors.New("validator added past the end of the registry")
+
+// registry is the validator registry of a state, along with the balances of
+// its validators.
+type registry struct {
+	slot       math.Slot
+	validators ctypes.Validators
+	*stateBalances
+}
+
+// readRegistry reads the validator registry of the given state at the given
+// slot.
+func readRegistry(st *statedb.StateDB, slot math.Slot) (*registry, error) {
+	validators, err := st.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+	balances, err := st.GetBalances()
+	if err != nil {
+		return nil, err
+	}
+	return &registry{
+		slot:          slot,
+		validators:    validators,
+		stateBalances: newStateBalances(validators, balances),
+	}, nil
+}
+
+// withChanges returns a copy of the registry, moved to the given slot, with
+// the validators at the given indices read again from the state. Validators
+// not part of the registry yet are appended to it.
+func (r *registry) withChanges(
+	st *statedb.StateDB, slot math.Slot, indices []math.ValidatorIndex,
+) (*registry, error) {
+	next := &registry{
+		slot:       slot,
+		validators: slices.Clone(r.validators),
+		stateBalances: &stateBalances{
+			balances: slices.Clone(r.balances),
+			// Pubkeys never change, the indices are only copied to add the
+			// pubkeys of new validators.
+			indices: r.indices,
+		},
+	}
+	for _, index := range indices {
+		val, err := st.ValidatorByIndex(index)
+		if err != nil {
+			return nil, err
+		}
+		balance, err := st.GetBalance(index)
+		if err != nil {
+			return nil, err
+		}
+
+		switch size := uint64(len(next.validators)); {
+		case index.Unwrap() < size:
+			next.validators[index] = val
+			next.balances[index] = balance.Unwrap()
+		case index.Unwrap() == size:
+			if len(next.indices) == len(r.indices) {
+				next.indices = make(
+					map[crypto.BLSPubkey]uint64, len(r.indices)+len(indices),
+				)
+				maps.Copy(next.indices, r.indices)
+			}
+			next.validators = append(next.validators, val)
+			next.balances = append(next.balances, balance.Unwrap())
+			next.indices[val.GetPubkey()] = index.Unwrap()
+		default:
+			return nil, errRegistryGap
+		}
+	}
+	return next, nil
+}
+
+// indicesByIDs resolves the given ids into indices of the registry, dropping
+// the ones not in the registry. No ids resolves to every index of the
+// registry.
+func (r *registry) indicesByIDs(ids []string) ([]uint64, error) {
+	if len(ids) == 0 {
+		indices := make([]uint64, len(r.validators))
+		for i := range indices {
+			indices[i] = uint64(i)
+		}
+		return indices, nil
+	}
+
+	indices := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		index, found, err := r.indexByID(id)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			indices = append(indices, index)
+		}
+	}
+	return indices, nil
+}
+
+// validatorRegistry is a read-optimized projection of the validator registry
+// of the head state. Rather than reading the whole registry from the store
+// for every request, it only reads again the validators changed by the
+// blocks committed since it was last requested.
+type validatorRegistry struct {
+	// mu serializes the updates of head.
+	mu sync.Mutex
+	// head is the projected registry, nil until first requested. It is never
+	// modified once projected, so it can be served without holding mu.
+	head *registry
+
+	// changesMu guards committed and changed.
+	changesMu sync.Mutex
+	// committed is the height of the last committed block, 0 if no block was
+	// committed since the node started.
+	committed int64
+	// changed are the indices of the validators changed since the head was
+	// projected.
+	changed map[math.ValidatorIndex]struct{}
+}
+
+// newValidatorRegistry creates a new, empty, validator registry projection.
+func newValidatorRegistry() *validatorRegistry {
+	return &validatorRegistry{
+		changed: make(map[math.ValidatorIndex]struct{}),
+	}
+}
+
+// onCommit records the validators changed by the block committed at the
+// given height.
+func (r *validatorRegistry) onCommit(
+	height int64, changes []*storetypes.StoreKVPair,
+) {
+	indices := beacondb.ChangedValidatorIndices(changes)
+	r.changesMu.Lock()
+	defer r.changesMu.Unlock()
+	r.committed = height
+	for _, index := range indices {
+		r.changed[index] = struct{}{}
+	}
+}
+
+// at returns the projected registry if the given slot, 0 being the latest,
+// is the last committed one, nil otherwise. The head is first brought up to
+// date by reading the changed validators from the state returned by
+// stateAt.
+func (r *validatorRegistry) at(
+	slot math.Slot,
+	slotsPerEpoch uint64,
+	stateAt func(math.Slot) (*statedb.StateDB, error),
+) (*registry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.changesMu.Lock()
+	committed := math.Slot(r.committed)
+	// The states served by the API process the next slot, which runs the
+	// epoch processing at the end of each epoch. Those are not projected.
+	if committed == 0 ||
+		(slot != 0 && slot != committed) ||
+		(committed.Unwrap()+1)%slotsPerEpoch == 0 {
+		r.changesMu.Unlock()
+		return nil, nil //nolint:nilnil // not the head.
+	}
+	changed := r.changed
+	r.changed = make(map[math.ValidatorIndex]struct{})
+	r.changesMu.Unlock()
+
+	if r.head != nil && r.head.slot == committed {
+		return r.head, nil
+	}
+
+	st, err := stateAt(committed)
+	if err != nil {
+		// The changes are lost, the registry is rebuilt on the next request.
+		r.head = nil
+		return nil, err
+	}
+	if r.head != nil {
+		indices := slices.Sorted(maps.Keys(changed))
+		var head *registry
+		if head, err = r.head.withChanges(st, committed, indices); err == nil {
+			r.head = head
+			return head, nil
+		}
+	}
+
+	// Project the whole registry if it was never projected or if it could
+	// not be updated, in which case it is rebuilt from scratch.
+	if r.head, err = readRegistry(st, committed); err != nil {
+		return nil, err
+	}
+	return r.head, nil
+}
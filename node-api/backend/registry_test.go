@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-api/backend/mocks"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorsByIDsProjection(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	st, cms := newTestStore(t, cs)
+	cms.AddListeners([]storetypes.StoreKey{testStoreKey})
+
+	var onCommit func(int64, []*storetypes.StoreKVPair)
+	node := mocks.NewNode[context.Context](t)
+	node.EXPECT().AddCommitListener(mock.Anything).Run(
+		func(listener func(int64, []*storetypes.StoreKVPair)) {
+			onCommit = listener
+		},
+	).Return()
+	sp := mocks.NewStateProcessor(t)
+	sb := mocks.NewStorageBackend[
+		backend.AvailabilityStore, backend.BlockStore, backend.DepositStore,
+	](t)
+	sb.EXPECT().StateFromContext(context.Background()).Return(st)
+
+	b := backend.New[
+		backend.AvailabilityStore,
+		backend.BlockStore,
+		context.Context,
+		backend.DepositStore,
+		*mocks.Node[context.Context],
+		any,
+		*mocks.StorageBackend[
+			backend.AvailabilityStore, backend.BlockStore, backend.DepositStore,
+		],
+	](sb, cs, sp, nil, nil, nil, nil)
+	b.AttachQueryBackend(node)
+
+	// commit adds the given validators and balances to the state and commits
+	// them at the given height.
+	commit := func(height int64, balances map[math.ValidatorIndex]math.Gwei) {
+		for index, balance := range balances {
+			size, sizeErr := st.GetTotalValidators()
+			require.NoError(t, sizeErr)
+			if index.Unwrap() == size {
+				require.NoError(t, st.AddValidator(&ctypes.Validator{
+					Pubkey: crypto.BLSPubkey{byte(index + 1)},
+				}))
+			}
+			require.NoError(t, st.SetBalance(index, balance))
+		}
+		onCommit(height, cms.PopStateCache())
+	}
+	balancesOf := func(slot math.Slot) []uint64 {
+		validators, valErr := b.ValidatorsByIDs(slot, nil, nil)
+		require.NoError(t, valErr)
+		balances := make([]uint64, len(validators))
+		for i, val := range validators {
+			require.Equal(t, uint64(i), val.Index)
+			balances[i] = val.Balance
+		}
+		return balances
+	}
+
+	// The registry is projected once, then kept up to date with the
+	// validators changed by each block.
+	commit(1, map[math.ValidatorIndex]math.Gwei{0: 1e9})
+	commit(2, map[math.ValidatorIndex]math.Gwei{1: 2e9})
+	node.EXPECT().CreateQueryContext(int64(2), false).
+		Return(context.Background(), nil).Once()
+	require.Equal(t, []uint64{1e9, 2e9}, balancesOf(0))
+	require.Equal(t, []uint64{1e9, 2e9}, balancesOf(2))
+
+	commit(3, map[math.ValidatorIndex]math.Gwei{0: 3e9})
+	commit(4, map[math.ValidatorIndex]math.Gwei{2: 4e9})
+	node.EXPECT().CreateQueryContext(int64(4), false).
+		Return(context.Background(), nil).Once()
+	require.Equal(t, []uint64{3e9, 2e9, 4e9}, balancesOf(0))
+
+	validator, err := b.ValidatorByID(0, crypto.BLSPubkey{0x03}.String())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), validator.Index)
+	balances, err := b.ValidatorBalancesByIDs(4, []string{"1"})
+	require.NoError(t, err)
+	require.Len(t, balances, 1)
+	require.Equal(t, uint64(2e9), balances[0].Balance)
+
+	// Other slots are read from their state.
+	node.EXPECT().CreateQueryContext(int64(3), false).
+		Return(context.Background(), nil).Once()
+	sp.EXPECT().ProcessSlots(st, math.Slot(4)).Return(nil, nil).Once()
+	require.Equal(t, []uint64{3e9, 2e9, 4e9}, balancesOf(3))
+}
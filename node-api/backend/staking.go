@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	stakingtypes "github.com/berachain/beacon-kit/node-api/handlers/staking/types"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ExitQueueAtSlot returns the validators that have been scheduled to exit
+// and whose funds are not yet withdrawable at the given slot.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) ExitQueueAtSlot(
+	slot math.Slot,
+) ([]*stakingtypes.ExitQueueData, error) {
+	st, slot, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	// Both ends of the time elapsed are consensus times, as set by CometBFT:
+	// the genesis time and the time of the block at the slot.
+	genesisTime, err := b.GenesisTime()
+	if err != nil {
+		return nil, err
+	}
+	//#nosec:G701 // not an issue in practice.
+	_, blkCtx, err := b.node.FinalizedBlock(int64(slot))
+	if err != nil {
+		return nil, err
+	}
+	var now math.U64
+	if blkCtx != nil {
+		now = blkCtx.ConsensusTime
+	}
+	var (
+		epoch = b.cs.SlotToEpoch(slot)
+		since = math.U64(genesisTime)
+		queue = make([]*stakingtypes.ExitQueueData, 0)
+	)
+	err = st.IterateValidators(
+		func(idx math.ValidatorIndex, val *ctypes.Validator) (bool, error) {
+			if val.GetExitEpoch() == math.Epoch(constants.FarFutureEpoch) ||
+				val.GetWithdrawableEpoch() <= epoch {
+				return false, nil
+			}
+			queue = append(queue, &stakingtypes.ExitQueueData{
+				Index:             idx.Unwrap(),
+				Pubkey:            val.GetPubkey(),
+				Slashed:           val.IsSlashed(),
+				ExitEpoch:         val.GetExitEpoch().Unwrap(),
+				WithdrawableEpoch: val.GetWithdrawableEpoch().Unwrap(),
+				EstimatedExitTime: b.estimateEpochTime(
+					slot, since, now, val.GetExitEpoch(),
+				).Unwrap(),
+				EstimatedWithdrawableTime: b.estimateEpochTime(
+					slot, since, now, val.GetWithdrawableEpoch(),
+				).Unwrap(),
+			})
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return queue, nil
+}
+
+// estimateEpochTime estimates the time at which the given epoch starts,
+// assuming blocks keep being produced at the average consensus block time
+// observed from the genesis time to the given slot, whose consensus time is
+// now. Epochs already started resolve to now, while the start of future
+// epochs cannot be estimated, and is zero, before any block is produced. Both
+// are zero if CometBFT no longer holds the block of the slot, whose
+// consensus time is then unknown, i.e. zero.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) estimateEpochTime(
+	slot math.Slot, genesisTime, now math.U64, epoch math.Epoch,
+) math.U64 {
+	startSlot := epoch * math.Slot(b.cs.SlotsPerEpoch())
+	if startSlot <= slot {
+		return now
+	}
+	if slot == 0 || now <= genesisTime {
+		return 0
+	}
+	// The time to the epoch is scaled from the time elapsed since genesis,
+	// not to truncate block times of a fraction of a second.
+	return now + (startSlot-slot)*(now-genesisTime)/slot
+}
+
+// PendingDepositsAtSlot returns the deposits observed on the execution layer
+// but not yet included in a beacon block as of the given slot, along with the
+// execution blocks whose deposits are yet to be fetched.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) PendingDepositsAtSlot(
+	slot math.Slot,
+) (*stakingtypes.PendingDepositsData, error) {
+	st, _, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return nil, err
+	}
+	deposits, err := b.sb.DepositStore().GetDepositsFromIndex(depositIndex)
+	if err != nil {
+		return nil, err
+	}
+	failedBlocks, err := b.sb.DepositStore().GetFailedBlocks()
+	if err != nil {
+		return nil, err
+	}
+	return &stakingtypes.PendingDepositsData{
+		Deposits:     deposits,
+		FailedBlocks: failedBlocks,
+	}, nil
+}
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package backend_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-api/backend/mocks"
+	stakingtypes "github.com/berachain/beacon-kit/node-api/handlers/staking/types"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testGenesisTime = 1_000
+	testBlockTime   = 2
+)
+
+// newExitQueueBackend returns a backend serving the given state at the given
+// slot, blocks having been produced every testBlockTime seconds of consensus
+// time since testGenesisTime. The timestamp of the execution payload, ahead
+// of the consensus time, is not used.
+func newExitQueueBackend(
+	t *testing.T, cs chain.ChainSpec, st *statedb.StateDB, slot math.Slot,
+) *backend.Backend[
+	backend.AvailabilityStore,
+	backend.BlockStore,
+	context.Context,
+	backend.DepositStore,
+	*mocks.Node[context.Context],
+	any,
+	*mocks.StorageBackend[
+		backend.AvailabilityStore, backend.BlockStore, backend.DepositStore,
+	],
+] {
+	t.Helper()
+	require.NoError(t, st.SetLatestExecutionPayloadHeader(
+		&ctypes.ExecutionPayloadHeader{
+			Timestamp:     testGenesisTime + slot*testBlockTime + 100,
+			BaseFeePerGas: math.NewU256(0),
+		},
+	))
+
+	node := mocks.NewNode[context.Context](t)
+	node.EXPECT().CreateQueryContext(int64(slot), false).
+		Return(context.Background(), nil)
+	node.EXPECT().GenesisTime().Return(time.Unix(testGenesisTime, 0), nil)
+	node.EXPECT().FinalizedBlock(int64(slot)).Return(nil, &transition.Context{
+		ConsensusTime: math.U64(testGenesisTime) + slot*testBlockTime,
+	}, nil)
+	node.EXPECT().AddCommitListener(mock.Anything).Return()
+	sp := mocks.NewStateProcessor(t)
+	sp.EXPECT().ProcessSlots(st, slot+1).Return(nil, nil)
+	sb := mocks.NewStorageBackend[
+		backend.AvailabilityStore, backend.BlockStore, backend.DepositStore,
+	](t)
+	sb.EXPECT().StateFromContext(context.Background()).Return(st)
+
+	b := backend.New[
+		backend.AvailabilityStore,
+		backend.BlockStore,
+		context.Context,
+		backend.DepositStore,
+		*mocks.Node[context.Context],
+		any,
+		*mocks.StorageBackend[
+			backend.AvailabilityStore, backend.BlockStore, backend.DepositStore,
+		],
+	](sb, cs, sp, nil, nil, nil, nil)
+	b.AttachQueryBackend(node)
+	return b
+}
+
+func TestExitQueueAtSlot(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	spe := math.Slot(cs.SlotsPerEpoch())
+	slot := 2*spe + 1
+	now := math.U64(testGenesisTime) + slot*testBlockTime
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+
+	addValidator := func(
+		st *statedb.StateDB, pubkey byte, exit, withdrawable math.Epoch,
+	) {
+		t.Helper()
+		require.NoError(t, st.AddValidator(&ctypes.Validator{
+			Pubkey:            crypto.BLSPubkey{pubkey},
+			ExitEpoch:         exit,
+			WithdrawableEpoch: withdrawable,
+		}))
+	}
+
+	t.Run("empty queue", func(t *testing.T) {
+		st := newTestState(t, cs)
+		addValidator(st, 0x01, farFuture, farFuture)
+
+		queue, err := newExitQueueBackend(t, cs, st, slot).
+			ExitQueueAtSlot(slot)
+		require.NoError(t, err)
+		require.NotNil(t, queue)
+		require.Empty(t, queue)
+	})
+
+	t.Run("exit in the past", func(t *testing.T) {
+		st := newTestState(t, cs)
+		// Validators already withdrawable are out of the queue.
+		addValidator(st, 0x01, 1, 2)
+		addValidator(st, 0x02, 1, 4)
+
+		queue, err := newExitQueueBackend(t, cs, st, slot).
+			ExitQueueAtSlot(slot)
+		require.NoError(t, err)
+		require.Equal(t, []*stakingtypes.ExitQueueData{{
+			Index:             1,
+			Pubkey:            crypto.BLSPubkey{0x02},
+			ExitEpoch:         1,
+			WithdrawableEpoch: 4,
+			// The exit already happened, it resolves to the current time.
+			EstimatedExitTime: now.Unwrap(),
+			EstimatedWithdrawableTime: (now +
+				(4*spe-slot)*testBlockTime).Unwrap(),
+		}}, queue)
+	})
+
+	t.Run("exit in the future", func(t *testing.T) {
+		st := newTestState(t, cs)
+		addValidator(st, 0x01, farFuture, farFuture)
+		addValidator(st, 0x02, 3, 5)
+
+		queue, err := newExitQueueBackend(t, cs, st, slot).
+			ExitQueueAtSlot(slot)
+		require.NoError(t, err)
+		require.Equal(t, []*stakingtypes.ExitQueueData{{
+			Index:             1,
+			Pubkey:            crypto.BLSPubkey{0x02},
+			ExitEpoch:         3,
+			WithdrawableEpoch: 5,
+			// Times are estimated from the consensus block time since
+			// genesis.
+			EstimatedExitTime: (now + (3*spe-slot)*testBlockTime).Unwrap(),
+			EstimatedWithdrawableTime: (now +
+				(5*spe-slot)*testBlockTime).Unwrap(),
+		}}, queue)
+	})
+}
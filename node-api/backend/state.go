@@ -22,9 +22,11 @@ package backend
 
 import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/snapshot"
 )
 
 // StateFromSlotForProof returns the beacon state of the version that was used
@@ -61,3 +63,30 @@ func (b Backend[
 	}
 	return st.GetFork()
 }
+
+// StateAtSlot returns the beacon state at the given slot.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) StateAtSlot(slot math.Slot) (*ctypes.BeaconState, error) {
+	st, _, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	return st.GetMarshallable()
+}
+
+// CheckpointState returns the latest epoch boundary snapshot of the beacon
+// state, served to checkpoint syncing nodes. It returns nil if the states
+// are not snapshotted or no snapshot was taken yet.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) CheckpointState() (*ctypes.BeaconState, error) {
+	if !b.regen.enabled() {
+		return nil, nil //nolint:nilnil // no snapshot.
+	}
+	st, err := b.regen.snapshots.Latest()
+	if errors.Is(err, snapshot.ErrNotFound) {
+		return nil, nil //nolint:nilnil // no snapshot.
+	}
+	return st, err
+}
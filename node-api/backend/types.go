@@ -22,13 +22,16 @@ package backend
 
 import (
 	"context"
+	"time"
 
+	storetypes "cosmossdk.io/store/types"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	datypes "github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	cmttypes "github.com/cometbft/cometbft/types"
 )
 
 // The AvailabilityStore interface is responsible for validating and storing
@@ -41,6 +44,11 @@ type AvailabilityStore interface {
 	// Persist makes sure that the sidecar remains accessible for data
 	// availability checks throughout the beacon node's operation.
 	Persist(math.Slot, datypes.BlobSidecars) error
+	// GetBlobSidecars returns the stored sidecars of the blobs referenced in
+	// the block.
+	GetBlobSidecars(
+		math.Slot, *ctypes.BeaconBlockBody,
+	) (datypes.BlobSidecars, error)
 }
 
 // BlockStore is the interface for block storage.
@@ -51,6 +59,13 @@ type BlockStore interface {
 	GetSlotByStateRoot(root common.Root) (math.Slot, error)
 	// GetParentSlotByTimestamp retrieves the parent slot by a given timestamp.
 	GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
+	// GetSlotByExecutionHash retrieves the slot by a given execution block
+	// hash.
+	GetSlotByExecutionHash(
+		executionHash common.ExecutionHash,
+	) (math.Slot, error)
+	// GetBlockRootBySlot retrieves the block root by a given slot.
+	GetBlockRootBySlot(slot math.Slot) (common.Root, error)
 }
 
 // DepositStore defines the interface for deposit storage.
@@ -61,17 +76,77 @@ type DepositStore interface {
 	Prune(start, end uint64) error
 	// EnqueueDeposits adds a list of deposits to the deposit store.
 	EnqueueDeposits(deposits []*ctypes.Deposit) error
+	// GetDepositsFromIndex returns all the deposits from the given index.
+	GetDepositsFromIndex(startIndex uint64) (ctypes.Deposits, error)
+	// AddFailedBlock records that the deposits of the given execution block
+	// failed to be fetched.
+	AddFailedBlock(blockNum uint64) error
+	// RemoveFailedBlock records that the deposits of the given execution
+	// block have been fetched.
+	RemoveFailedBlock(blockNum uint64) error
+	// GetFailedBlocks returns the execution blocks whose deposits failed to
+	// be fetched.
+	GetFailedBlocks() ([]uint64, error)
+	// GetProgress returns the execution block number recorded for the
+	// given progress marker, or zero if none is recorded.
+	GetProgress(marker string) (uint64, error)
+	// SetProgress records the execution block number reached by the given
+	// progress marker.
+	SetProgress(marker string, blockNum uint64) error
+	// SetBlockDeposits records the index of the first deposit fetched
+	// from the given execution block.
+	SetBlockDeposits(blockNum, firstIndex uint64) error
 }
 
 // Node is the interface for a node.
 type Node[ContextT any] interface {
+	// AddCommitListener registers a listener notified of the changes to the
+	// beacon state committed by each block.
+	AddCommitListener(
+		listener func(height int64, changes []*storetypes.StoreKVPair),
+	)
 	// CreateQueryContext creates a query context for a given height and proof
 	// flag.
 	CreateQueryContext(height int64, prove bool) (ContextT, error)
+	// BeaconBlockBytes returns the SSZ encoded beacon block committed at the
+	// given height, nil if it is not available.
+	BeaconBlockBytes(height int64) ([]byte, error)
+	// FinalizedBlock returns the SSZ encoded beacon block committed at the
+	// given height along with the context it was finalized with, nil if it
+	// is not available, to replay it.
+	FinalizedBlock(height int64) ([]byte, *transition.Context, error)
+	// GenesisTime returns the genesis time of the chain.
+	GenesisTime() (time.Time, error)
+	// LightBlock returns the CometBFT light block committed at the given
+	// height along with the proof of the beacon block in it, nil if it is
+	// not available.
+	LightBlock(height int64) (*cmttypes.LightBlock, *cmttypes.TxProof, error)
 }
 
 type StateProcessor interface {
 	ProcessSlots(*statedb.StateDB, math.Slot) (transition.ValidatorUpdates, error)
+	Transition(
+		*transition.Context, *statedb.StateDB, *ctypes.BeaconBlock,
+	) (transition.ValidatorUpdates, error)
+}
+
+// Freezer is the interface for the cold storage of the ancient beacon blocks.
+type Freezer interface {
+	// BlockBytes returns the SSZ encoded beacon block frozen at the given
+	// slot, nil if there is none.
+	BlockBytes(slot math.Slot) ([]byte, error)
+	// HeaderBytes returns the SSZ encoded header of the beacon block frozen
+	// at the given slot, nil if there is none.
+	HeaderBytes(slot math.Slot) ([]byte, error)
+}
+
+// SnapshotStore is the interface for the store of the beacon state snapshots.
+type SnapshotStore interface {
+	// Latest returns the latest snapshot.
+	Latest() (*ctypes.BeaconState, error)
+	// GetAtOrBefore returns the latest snapshot taken at or before the given
+	// slot.
+	GetAtOrBefore(slot math.Slot) (*ctypes.BeaconState, error)
 }
 
 // StorageBackend is the interface for the storage backend.
@@ -22,7 +22,10 @@ package utils
 
 import (
 	"strconv"
+	"strings"
 
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
@@ -41,3 +44,71 @@ func ValidatorIndexByID(st *statedb.StateDB, keyOrIndex string) (math.U64, error
 	}
 	return st.ValidatorIndexByPubkey(key)
 }
+
+// Validator statuses as defined by the Beacon Node API.
+// https://hackmd.io/ofFJ5gOmQpu1jjHilHbdQQ
+const (
+	StatusPendingInitialized = "pending_initialized"
+	StatusPendingQueued      = "pending_queued"
+	StatusActiveOngoing      = "active_ongoing"
+	StatusActiveExiting      = "active_exiting"
+	StatusActiveSlashed      = "active_slashed"
+	StatusExitedUnslashed    = "exited_unslashed"
+	StatusExitedSlashed      = "exited_slashed"
+	StatusWithdrawalPossible = "withdrawal_possible"
+	StatusWithdrawalDone     = "withdrawal_done"
+
+	// StatusSlashed matches every status of a slashed validator.
+	StatusSlashed = "slashed"
+)
+
+// ValidatorStatus returns the status of the validator at the given epoch.
+func ValidatorStatus(
+	val *ctypes.Validator, balance math.Gwei, epoch math.Epoch,
+) string {
+	farFutureEpoch := math.Epoch(constants.FarFutureEpoch)
+	switch {
+	case epoch < val.GetActivationEpoch():
+		if val.GetActivationEligibilityEpoch() == farFutureEpoch {
+			return StatusPendingInitialized
+		}
+		return StatusPendingQueued
+	case epoch < val.GetExitEpoch():
+		if val.IsSlashed() {
+			return StatusActiveSlashed
+		}
+		if val.GetExitEpoch() == farFutureEpoch {
+			return StatusActiveOngoing
+		}
+		return StatusActiveExiting
+	case epoch < val.GetWithdrawableEpoch():
+		if val.IsSlashed() {
+			return StatusExitedSlashed
+		}
+		return StatusExitedUnslashed
+	case balance > 0:
+		return StatusWithdrawalPossible
+	default:
+		return StatusWithdrawalDone
+	}
+}
+
+// MatchesStatus returns whether the status matches any of the given filters.
+// Besides the exact statuses, a filter may be one of the general statuses
+// "pending", "active", "exited" and "withdrawal", or "slashed". No filters
+// matches every status.
+func MatchesStatus(status string, filters []string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	for _, filter := range filters {
+		switch {
+		case status == filter,
+			strings.HasPrefix(status, filter+"_"),
+			filter == StatusSlashed &&
+				strings.HasSuffix(status, "_"+StatusSlashed):
+			return true
+		}
+	}
+	return false
+}
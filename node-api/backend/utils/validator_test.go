@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package utils_test
+
+import (
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/backend/utils"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorStatus(t *testing.T) {
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+	validator := func(
+		eligibility, activation, exit, withdrawable math.Epoch, slashed bool,
+	) *ctypes.Validator {
+		return &ctypes.Validator{
+			ActivationEligibilityEpoch: eligibility,
+			ActivationEpoch:            activation,
+			ExitEpoch:                  exit,
+			WithdrawableEpoch:          withdrawable,
+			Slashed:                    slashed,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		validator *ctypes.Validator
+		balance   math.Gwei
+		want      string
+	}{
+		{
+			name: "pending initialized",
+			validator: validator(
+				farFuture, farFuture, farFuture, farFuture, false,
+			),
+			want: utils.StatusPendingInitialized,
+		},
+		{
+			name:      "pending queued",
+			validator: validator(5, farFuture, farFuture, farFuture, false),
+			want:      utils.StatusPendingQueued,
+		},
+		{
+			name:      "active ongoing",
+			validator: validator(1, 2, farFuture, farFuture, false),
+			want:      utils.StatusActiveOngoing,
+		},
+		{
+			name:      "active exiting",
+			validator: validator(1, 2, 20, 30, false),
+			want:      utils.StatusActiveExiting,
+		},
+		{
+			name:      "active slashed",
+			validator: validator(1, 2, 20, 30, true),
+			want:      utils.StatusActiveSlashed,
+		},
+		{
+			name:      "exited unslashed",
+			validator: validator(1, 2, 5, 30, false),
+			want:      utils.StatusExitedUnslashed,
+		},
+		{
+			name:      "exited slashed",
+			validator: validator(1, 2, 5, 30, true),
+			want:      utils.StatusExitedSlashed,
+		},
+		{
+			name:      "withdrawal possible",
+			validator: validator(1, 2, 5, 8, false),
+			balance:   1,
+			want:      utils.StatusWithdrawalPossible,
+		},
+		{
+			name:      "withdrawal done",
+			validator: validator(1, 2, 5, 8, false),
+			want:      utils.StatusWithdrawalDone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := utils.ValidatorStatus(tt.validator, tt.balance, 10)
+			require.Equal(t, tt.want, status)
+		})
+	}
+}
+
+func TestMatchesStatus(t *testing.T) {
+	require.True(t, utils.MatchesStatus(utils.StatusActiveOngoing, nil))
+	require.True(t, utils.MatchesStatus(
+		utils.StatusActiveOngoing, []string{utils.StatusActiveOngoing},
+	))
+	require.True(t, utils.MatchesStatus(
+		utils.StatusActiveExiting, []string{"pending", "active"},
+	))
+	require.False(t, utils.MatchesStatus(
+		utils.StatusExitedUnslashed, []string{"active", "withdrawal"},
+	))
+	require.True(t, utils.MatchesStatus(
+		utils.StatusExitedSlashed, []string{utils.StatusSlashed},
+	))
+	require.False(t, utils.MatchesStatus(
+		utils.StatusExitedUnslashed, []string{utils.StatusSlashed},
+	))
+}
@@ -23,89 +23,92 @@ package backend
 import (
 	"github.com/berachain/beacon-kit/node-api/backend/utils"
 	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 )
 
+// ValidatorByID returns the validator with the given index or pubkey at the
+// given slot.
 func (b Backend[
 	_, _, _, _, _, _, _,
 ]) ValidatorByID(
 	slot math.Slot, id string,
 ) (*beacontypes.ValidatorData, error) {
-	// TODO: to adhere to the spec, this shouldn't error if the error
-	// is not found, but i can't think of a way to do that without coupling
-	// db impl to the api impl.
-	st, _, err := b.stateFromSlot(slot)
+	validators, err := b.ValidatorsByIDs(slot, []string{id}, nil)
 	if err != nil {
 		return nil, err
 	}
-	index, err := utils.ValidatorIndexByID(st, id)
-	if err != nil {
-		return nil, err
+	if len(validators) == 0 {
+		return nil, types.ErrNotFound
 	}
-	validator, err := st.ValidatorByIndex(index)
+	return validators[0], nil
+}
+
+// ValidatorsByIDs returns the validators with the given indices or pubkeys at
+// the given slot, keeping only those matching one of the statuses. No ids
+// returns the whole registry. Unknown validators are skipped, as per the spec.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) ValidatorsByIDs(
+	slot math.Slot, ids []string, statuses []string,
+) ([]*beacontypes.ValidatorData, error) {
+	reg, err := b.registryAtSlot(slot)
 	if err != nil {
 		return nil, err
 	}
-	balance, err := st.GetBalance(index)
+	indices, err := reg.indicesByIDs(ids)
 	if err != nil {
 		return nil, err
 	}
-	return &beacontypes.ValidatorData{
-		ValidatorBalanceData: beacontypes.ValidatorBalanceData{
-			Index:   index.Unwrap(),
-			Balance: balance.Unwrap(),
-		},
-		Status:    "active_ongoing", // TODO: fix
-		Validator: validator,
-	}, nil
-}
 
-// TODO: filter by status
-func (b Backend[
-	_, _, _, _, _, _, _,
-]) ValidatorsByIDs(
-	slot math.Slot, ids []string, _ []string,
-) ([]*beacontypes.ValidatorData, error) {
-	validatorsData := make([]*beacontypes.ValidatorData, 0)
-	for _, id := range ids {
-		// TODO: we can probably optimize this via a getAllValidators
-		// query and then filtering but blocked by the fact that IDs
-		// can be indices and the hard type only holds its own pubkey.
-		validatorData, err := b.ValidatorByID(slot, id)
-		if err != nil {
-			return nil, err
+	epoch := b.cs.SlotToEpoch(reg.slot)
+	validatorsData := make([]*beacontypes.ValidatorData, 0, len(indices))
+	for _, index := range indices {
+		balance := math.Gwei(reg.balances[index])
+		status := utils.ValidatorStatus(reg.validators[index], balance, epoch)
+		if !utils.MatchesStatus(status, statuses) {
+			continue
 		}
-		validatorsData = append(validatorsData, validatorData)
+		validatorsData = append(validatorsData, &beacontypes.ValidatorData{
+			ValidatorBalanceData: beacontypes.ValidatorBalanceData{
+				Index:   index,
+				Balance: balance.Unwrap(),
+			},
+			Status:    status,
+			Validator: reg.validators[index],
+		})
 	}
 	return validatorsData, nil
 }
 
+// registryAtSlot returns the validator registry of the state at the given
+// slot. The registry of the head is served from its projection, the others
+// are read from their state.
 func (b Backend[
 	_, _, _, _, _, _, _,
-]) ValidatorBalancesByIDs(
-	slot math.Slot, ids []string,
-) ([]*beacontypes.ValidatorBalanceData, error) {
-	var index math.U64
-	st, _, err := b.stateFromSlot(slot)
+]) registryAtSlot(slot math.Slot) (*registry, error) {
+	head, err := b.headRegistry(slot)
+	if err != nil || head != nil {
+		return head, err
+	}
+	st, slot, err := b.stateFromSlot(slot)
 	if err != nil {
 		return nil, err
 	}
-	balances := make([]*beacontypes.ValidatorBalanceData, 0)
-	for _, id := range ids {
-		index, err = utils.ValidatorIndexByID(st, id)
-		if err != nil {
-			return nil, err
-		}
-		var balance math.U64
-		// TODO: same issue as above, shouldn't error on not found.
-		balance, err = st.GetBalance(index)
-		if err != nil {
-			return nil, err
-		}
-		balances = append(balances, &beacontypes.ValidatorBalanceData{
-			Index:   index.Unwrap(),
-			Balance: balance.Unwrap(),
-		})
-	}
-	return balances, nil
+	return readRegistry(st, slot)
+}
+
+// headRegistry returns the projection of the validator registry if the given
+// slot is the head, nil otherwise.
+func (b Backend[
+	_, _, _, _, _, _, _,
+]) headRegistry(slot math.Slot) (*registry, error) {
+	return b.registry.at(
+		slot, b.cs.SlotsPerEpoch(),
+		func(slot math.Slot) (*statedb.StateDB, error) {
+			st, _, err := b.stateFromSlotRaw(slot)
+			return st, err
+		},
+	)
 }
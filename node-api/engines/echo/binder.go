@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/labstack/echo/v4"
+)
+
+// Binder binds requests with the default Echo binder, reporting malformed
+// requests as invalid requests.
+type Binder struct {
+	echo.DefaultBinder
+}
+
+// Bind binds the path parameters, query parameters and body of the request
+// into i.
+func (b *Binder) Bind(i interface{}, c Context) error {
+	err := b.DefaultBinder.Bind(i, c)
+	if err == nil {
+		return nil
+	}
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		return fmt.Errorf("%w: %v", types.ErrInvalidRequest, httpErr.Message)
+	}
+	return err
+}
+
+// errorHandler renders the errors raised by Echo and its middlewares, e.g.
+// unknown routes or missing credentials, in the format of error responses.
+func errorHandler(err error, c Context) {
+	if c.Response().Committed {
+		return
+	}
+	code, message := http.StatusInternalServerError, err.Error()
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		code, message = httpErr.Code, fmt.Sprint(httpErr.Message)
+	}
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(code)
+	} else {
+		err = c.JSON(code, ErrorResponse{Code: code, Message: message})
+	}
+	if err != nil {
+		c.Logger().Error(err)
+	}
+}
@@ -21,8 +21,12 @@
 package echo
 
 import (
+	"net/http"
+
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/openapi"
+	sdkversion "github.com/cosmos/cosmos-sdk/version"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 )
@@ -31,31 +35,56 @@ import (
 type Engine struct {
 	*echo.Echo
 	logger log.Logger
+	// authToken is the bearer token required by authenticated routes.
+	authToken string
+	// routeSets are the registered route sets, described by the OpenAPI
+	// spec.
+	routeSets []*handlers.RouteSet[Context]
 }
 
-// New initializes a new API engine with the given Echo instance.
-func New(e *echo.Echo) *Engine {
+// openAPIPath is the path serving the OpenAPI spec of the registered routes.
+const openAPIPath = "/openapi.json"
+
+// New initializes a new API engine with the given Echo instance and the
+// bearer token required by authenticated routes.
+func New(e *echo.Echo, authToken string) *Engine {
 	return &Engine{
-		Echo: e,
+		Echo:      e,
+		authToken: authToken,
 	}
 }
 
-// NewDefaultEngine returns a new default Echo Engine instance.
-func NewDefaultEngine() *Engine {
+// NewDefaultEngine returns a new default Echo Engine instance, limiting the
+// rate of requests of each client and serving the OpenAPI spec of its routes.
+// Cross-origin requests are allowed from the given origins only. Every
+// request is given a correlation identifier, echoed in the X-Request-ID
+// header of the response. The latency and errors of every request are
+// reported to the given sink. Malformed
+// requests and errors raised by Echo are reported as error responses.
+// Authenticated routes are disabled if authToken is empty.
+func NewDefaultEngine(
+	authToken string,
+	limits RateLimits,
+	corsOrigins []string,
+	sink TelemetrySink,
+) *Engine {
 	engine := echo.New()
-	engine.Use(middleware.CORSWithConfig(
-		middleware.DefaultCORSConfig,
-	))
+	engine.Use(correlationMiddleware(), metricsMiddleware(sink))
+	if len(corsOrigins) > 0 {
+		cors := middleware.DefaultCORSConfig
+		cors.AllowOrigins = corsOrigins
+		engine.Use(middleware.CORSWithConfig(cors))
+	}
+	engine.Use(rateLimitMiddlewares(authToken, limits)...)
+	engine.Binder = &Binder{}
 	engine.Validator = &CustomValidator{
 		Validator: ConstructValidator(),
 	}
+	engine.HTTPErrorHandler = errorHandler
 	engine.HideBanner = true
-	return New(engine)
-}
-
-// Run starts the Echo engine at the given address.
-func (e *Engine) Run(addr string) error {
-	return e.Echo.Start(addr)
+	e := New(engine, authToken)
+	engine.GET(openAPIPath, e.serveOpenAPI)
+	return e
 }
 
 // RegisterRoutes registers the given route set with the Echo engine.
@@ -64,13 +93,33 @@ func (e *Engine) RegisterRoutes(
 	logger log.Logger,
 ) {
 	e.logger = logger
+	e.routeSets = append(e.routeSets, hs)
 	group := e.Group(hs.BasePath)
 	for _, route := range hs.Routes {
 		route.DecorateWithLogs(e.logger)
+		var middlewares []echo.MiddlewareFunc
+		if route.Authenticated {
+			middlewares = append(
+				middlewares, listenerMiddleware(), authMiddleware(e.authToken),
+			)
+		}
 		group.Add(
 			route.Method,
 			route.Path,
 			responseMiddleware(route),
+			middlewares...,
 		)
 	}
 }
+
+// serveOpenAPI serves the OpenAPI spec of the registered routes.
+func (e *Engine) serveOpenAPI(c Context) error {
+	version := sdkversion.Version
+	if version == "" {
+		// The version is only set by release builds.
+		version = "dev"
+	}
+	return c.JSON(http.StatusOK, openapi.New(
+		"Beacon Node API", version, e.routeSets...,
+	))
+}
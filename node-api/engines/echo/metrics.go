@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// unmatchedRoute labels the metrics of the requests matching no route.
+const unmatchedRoute = "unmatched"
+
+// TelemetrySink is the sink the metrics of the requests are reported to.
+type TelemetrySink interface {
+	// IncrementCounter increments the counter identified by the provided
+	// key.
+	IncrementCounter(key string, args ...string)
+	// MeasureSince measures the time since the provided start time,
+	// identified by the provided keys.
+	MeasureSince(key string, start time.Time, args ...string)
+}
+
+// metricsMiddleware returns a middleware reporting the latency of every
+// request, and counting the failed ones, labelled by method, route and
+// status code. Routes are labelled by their path template, e.g.
+// /eth/v1/beacon/blocks/:block_id, to keep the number of series bounded.
+func metricsMiddleware(sink TelemetrySink) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c Context) error {
+			start := time.Now()
+			if err := next(c); err != nil {
+				// Render the error now for its status code to be reported.
+				c.Error(err)
+			}
+
+			route := c.Path()
+			if route == "" {
+				route = unmatchedRoute
+			}
+			status := c.Response().Status
+			labels := []string{
+				"method", c.Request().Method,
+				"route", route,
+				"status", strconv.Itoa(status),
+			}
+			sink.MeasureSince(
+				"beacon_kit.node_api.request_duration", start, labels...,
+			)
+			if status >= http.StatusBadRequest {
+				sink.IncrementCounter(
+					"beacon_kit.node_api.request_errors", labels...,
+				)
+			}
+			return nil
+		}
+	}
+}
@@ -21,14 +21,28 @@
 package echo
 
 import (
+	"crypto/subtle"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/handlers/types"
 	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
 )
 
+// headerConsensusVersion is the header reporting the fork of SSZ encodable
+// responses.
+const headerConsensusVersion = "Eth-Consensus-Version"
+
+// maxRequestIDLen is the maximum length of the request identifiers set by
+// clients and used as correlation identifiers.
+const maxRequestIDLen = 64
+
 // ErrorResponse is a response that is returned when an error occurs.
 type ErrorResponse struct {
 	Code    int    `json:"code"`
@@ -42,11 +56,154 @@ func responseMiddleware(
 ) echo.HandlerFunc {
 	return func(c Context) error {
 		data, err := handler.Handler(c)
+		if err == nil {
+			switch data := data.(type) {
+			case types.StatusResponse:
+				return c.NoContent(data.Code)
+			case types.EventStream:
+				return streamEvents(c, data)
+			case types.SSZResponse:
+				return negotiateSSZ(c, data)
+			}
+		}
 		code, response := responseFromError(data, err)
 		return c.JSON(code, response)
 	}
 }
 
+// streamEvents streams the events as server-sent events until the client
+// disconnects.
+func streamEvents(c Context, stream types.EventStream) error {
+	w := c.Response()
+	w.Header().Set(echo.HeaderContentType, "text/event-stream")
+	w.Header().Set(echo.HeaderCacheControl, "no-cache")
+	w.Header().Set(echo.HeaderConnection, "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+	return stream.Stream(
+		c.Request().Context(),
+		func(event string, data []byte) error {
+			if _, err := fmt.Fprintf(
+				w, "event: %s\ndata: %s\n\n", event, data,
+			); err != nil {
+				return err
+			}
+			w.Flush()
+			return nil
+		},
+	)
+}
+
+// negotiateSSZ serves the response SSZ encoded if the client prefers
+// application/octet-stream over JSON, and as JSON otherwise.
+func negotiateSSZ(c Context, resp types.SSZResponse) error {
+	if resp.Version != "" {
+		c.Response().Header().Set(headerConsensusVersion, resp.Version)
+	}
+	if !prefersSSZ(c.Request().Header.Get(echo.HeaderAccept)) {
+		return c.JSON(http.StatusOK, resp.JSON)
+	}
+	bz, err := resp.SSZ.MarshalSSZ()
+	if err != nil {
+		code, response := responseFromError(nil, err)
+		return c.JSON(code, response)
+	}
+	return c.Blob(http.StatusOK, echo.MIMEOctetStream, bz)
+}
+
+// prefersSSZ reports whether the given Accept header ranks
+// application/octet-stream strictly above JSON. Wildcards count as JSON.
+func prefersSSZ(accept string) bool {
+	var sszQ, jsonQ float64
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, _ := strings.Cut(mediaRange, ";")
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			value, ok := strings.CutPrefix(strings.TrimSpace(param), "q=")
+			if !ok {
+				continue
+			}
+			var err error
+			if q, err = strconv.ParseFloat(value, 64); err != nil {
+				q = 0
+			}
+		}
+		switch strings.TrimSpace(mediaType) {
+		case echo.MIMEOctetStream:
+			sszQ = max(sszQ, q)
+		case echo.MIMEApplicationJSON, "application/*", "*/*":
+			jsonQ = max(jsonQ, q)
+		}
+	}
+	return sszQ > jsonQ
+}
+
+// correlationMiddleware is a middleware attaching a correlation identifier
+// to the context of every request, for the entries logged while handling it
+// to be grepped together. The X-Request-ID set by the client is used if
+// valid, a random identifier otherwise. It is echoed in the response.
+func correlationMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c Context) error {
+			id := c.Request().Header.Get(echo.HeaderXRequestID)
+			if !isValidRequestID(id) {
+				id = log.NewCorrelationID()
+			}
+			c.SetRequest(c.Request().WithContext(
+				log.ContextWithCorrelationID(c.Request().Context(), id),
+			))
+			c.Response().Header().Set(echo.HeaderXRequestID, id)
+			return next(c)
+		}
+	}
+}
+
+// isValidRequestID reports whether the identifier set by a client is short
+// and only made of letters, digits, dashes and underscores, so that it can
+// be logged as is.
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLen {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z',
+			'0' <= r && r <= '9', r == '-', r == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// listenerMiddleware is a middleware answering not found to the requests
+// received at a listener the authenticated routes are not served at.
+func listenerMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c Context) error {
+			if !handlers.ServesAuthenticatedRoutes(c.Request().Context()) {
+				return echo.ErrNotFound
+			}
+			return next(c)
+		}
+	}
+}
+
+// authMiddleware is a middleware that rejects requests not carrying the given
+// bearer token. All requests are rejected if the token is empty.
+func authMiddleware(token string) echo.MiddlewareFunc {
+	return middleware.KeyAuth(func(key string, _ Context) (bool, error) {
+		return isValidToken(key, token), nil
+	})
+}
+
+// isValidToken reports whether the key is the given bearer token. No key is
+// valid if the token is empty.
+func isValidToken(key, token string) bool {
+	return token != "" &&
+		subtle.ConstantTimeCompare([]byte(key), []byte(token)) == 1
+}
+
 // responseFromErr converts an error to an HTTP status code and response. If
 // the error is nil, the response is returned as is.
 func responseFromError(data any, err error) (int, any) {
@@ -0,0 +1,325 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	echoengine "github.com/berachain/beacon-kit/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/openapi"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+type testSSZ []byte
+
+func (b testSSZ) MarshalSSZ() ([]byte, error) { return b, nil }
+
+func TestSSZNegotiation(t *testing.T) {
+	e := echoengine.New(echo.New(), "")
+	e.RegisterRoutes(handlers.NewRouteSet("", &handlers.Route[echo.Context]{
+		Method: http.MethodGet,
+		Path:   "/data",
+		Handler: func(echo.Context) (any, error) {
+			return types.SSZResponse{
+				Version: "deneb",
+				JSON:    types.Wrap("json"),
+				SSZ:     testSSZ{0x01, 0x02},
+			}, nil
+		},
+	}), noop.NewLogger[any]())
+
+	tests := []struct {
+		name   string
+		accept string
+		ssz    bool
+	}{
+		{name: "no accept header"},
+		{name: "json", accept: "application/json"},
+		{name: "ssz", accept: "application/octet-stream", ssz: true},
+		{
+			name:   "ssz preferred",
+			accept: "application/json;q=0.9,application/octet-stream",
+			ssz:    true,
+		},
+		{
+			name:   "json preferred",
+			accept: "application/octet-stream;q=0.5,application/json",
+		},
+		{
+			name:   "wildcard tie",
+			accept: "application/octet-stream,*/*",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/data", nil)
+			if tt.accept != "" {
+				req.Header.Set(echo.HeaderAccept, tt.accept)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Equal(t, "deneb", rec.Header().Get("Eth-Consensus-Version"))
+			if tt.ssz {
+				require.Equal(t,
+					echo.MIMEOctetStream,
+					rec.Header().Get(echo.HeaderContentType),
+				)
+				require.Equal(t, []byte{0x01, 0x02}, rec.Body.Bytes())
+				return
+			}
+			require.JSONEq(t, `{"data":"json"}`, rec.Body.String())
+		})
+	}
+}
+
+func TestRateLimits(t *testing.T) {
+	e := echoengine.NewDefaultEngine("secret", echoengine.RateLimits{
+		PerIP:    1,
+		PerToken: 2,
+	}, nil, &testSink{})
+	e.RegisterRoutes(handlers.NewRouteSet("", &handlers.Route[echo.Context]{
+		Method: http.MethodGet,
+		Path:   "/data",
+		Handler: func(echo.Context) (any, error) {
+			return types.Wrap("json"), nil
+		},
+	}), noop.NewLogger[any]())
+
+	get := func(remoteAddr, token string) int {
+		req := httptest.NewRequest(http.MethodGet, "/data", nil)
+		req.RemoteAddr = remoteAddr
+		if token != "" {
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// Clients are limited per IP address.
+	require.Equal(t, http.StatusOK, get("10.0.0.1:1000", ""))
+	require.Equal(t, http.StatusTooManyRequests, get("10.0.0.1:1001", ""))
+	require.Equal(t, http.StatusOK, get("10.0.0.2:1000", ""))
+
+	// Invalid tokens count towards the limit of the IP address.
+	require.Equal(t, http.StatusTooManyRequests, get("10.0.0.1:1002", "bad"))
+
+	// Authenticated clients share the limit of the token.
+	require.Equal(t, http.StatusOK, get("10.0.0.1:1003", "secret"))
+	require.Equal(t, http.StatusOK, get("10.0.0.3:1000", "secret"))
+	require.Equal(t, http.StatusTooManyRequests, get("10.0.0.4:1000", "secret"))
+}
+
+func TestErrorResponses(t *testing.T) {
+	type request struct {
+		Slot string `json:"slot" validate:"required,slot"`
+	}
+	e := echoengine.NewDefaultEngine("", echoengine.RateLimits{}, nil, &testSink{})
+	e.RegisterRoutes(handlers.NewRouteSet("", &handlers.Route[echo.Context]{
+		Method: http.MethodPost,
+		Path:   "/data",
+		Handler: func(c echo.Context) (any, error) {
+			req, err := utils.BindAndValidate[request](
+				c, noop.NewLogger[any](),
+			)
+			if err != nil {
+				return nil, err
+			}
+			return types.Wrap(req.Slot), nil
+		},
+		Request: request{},
+	}), noop.NewLogger[any]())
+
+	post := func(path, body string) (int, echoengine.ErrorResponse) {
+		req := httptest.NewRequest(
+			http.MethodPost, path, strings.NewReader(body),
+		)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		var res echoengine.ErrorResponse
+		if rec.Code != http.StatusOK {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+			require.Equal(t, rec.Code, res.Code)
+		}
+		return rec.Code, res
+	}
+
+	code, _ := post("/data", `{"slot":"1"}`)
+	require.Equal(t, http.StatusOK, code)
+
+	// Malformed bodies and invalid parameters are rejected with a 400.
+	code, res := post("/data", "{")
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Contains(t, res.Message, "invalid request")
+	code, res = post("/data", `{"slot":"x"}`)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Contains(t, res.Message, "invalid Slot")
+
+	// Errors raised by echo are rendered as error responses.
+	code, _ = post("/unknown", "{}")
+	require.Equal(t, http.StatusNotFound, code)
+
+	// The spec documents the registered routes.
+	req := httptest.NewRequest(http.MethodGet, "/openapi.json", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var doc openapi.Document
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+	require.Contains(t, doc.Paths, "/data")
+}
+
+// testSink records the labels of the reported metrics.
+type testSink struct {
+	mu        sync.Mutex
+	durations [][]string
+	errors    [][]string
+}
+
+func (s *testSink) IncrementCounter(_ string, args ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errors = append(s.errors, args)
+}
+
+func (s *testSink) MeasureSince(_ string, _ time.Time, args ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.durations = append(s.durations, args)
+}
+
+func TestRequestMetrics(t *testing.T) {
+	sink := &testSink{}
+	e := echoengine.NewDefaultEngine("", echoengine.RateLimits{}, nil, sink)
+	e.RegisterRoutes(handlers.NewRouteSet("", &handlers.Route[echo.Context]{
+		Method: http.MethodGet,
+		Path:   "/data/:id",
+		Handler: func(c echo.Context) (any, error) {
+			if c.Param("id") == "missing" {
+				return nil, types.ErrNotFound
+			}
+			return types.Wrap("json"), nil
+		},
+	}), noop.NewLogger[any]())
+
+	for _, path := range []string{"/data/1", "/data/missing", "/unknown"} {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	// Requests are labelled by route template, not by path.
+	require.Equal(t, [][]string{
+		{"method", "GET", "route", "/data/:id", "status", "200"},
+		{"method", "GET", "route", "/data/:id", "status", "404"},
+		{"method", "GET", "route", "unmatched", "status", "404"},
+	}, sink.durations)
+	require.Equal(t, sink.durations[1:], sink.errors)
+}
+
+func TestCORS(t *testing.T) {
+	route := &handlers.Route[echo.Context]{
+		Method: http.MethodGet,
+		Path:   "/data",
+		Handler: func(echo.Context) (any, error) {
+			return types.Wrap("json"), nil
+		},
+	}
+	allowedOrigin := func(e *echoengine.Engine, origin string) string {
+		req := httptest.NewRequest(http.MethodGet, "/data", nil)
+		req.Header.Set(echo.HeaderOrigin, origin)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		return rec.Header().Get(echo.HeaderAccessControlAllowOrigin)
+	}
+
+	e := echoengine.NewDefaultEngine(
+		"", echoengine.RateLimits{},
+		[]string{"https://explorer.example"}, &testSink{},
+	)
+	e.RegisterRoutes(handlers.NewRouteSet("", route), noop.NewLogger[any]())
+	require.Equal(t,
+		"https://explorer.example",
+		allowedOrigin(e, "https://explorer.example"),
+	)
+	require.Empty(t, allowedOrigin(e, "https://other.example"))
+
+	// Cross-origin requests are denied if no origin is allowed.
+	e = echoengine.NewDefaultEngine(
+		"", echoengine.RateLimits{}, nil, &testSink{},
+	)
+	e.RegisterRoutes(handlers.NewRouteSet("", route), noop.NewLogger[any]())
+	require.Empty(t, allowedOrigin(e, "https://explorer.example"))
+}
+
+func TestCorrelationID(t *testing.T) {
+	var handled []string
+	e := echoengine.NewDefaultEngine(
+		"", echoengine.RateLimits{}, nil, &testSink{},
+	)
+	e.RegisterRoutes(handlers.NewRouteSet("", &handlers.Route[echo.Context]{
+		Method: http.MethodGet,
+		Path:   "/data",
+		Handler: func(c echo.Context) (any, error) {
+			handled = append(
+				handled, log.CorrelationIDFromContext(c.Request().Context()),
+			)
+			return types.Wrap("json"), nil
+		},
+	}), noop.NewLogger[any]())
+
+	requestID := func(id string) string {
+		req := httptest.NewRequest(http.MethodGet, "/data", nil)
+		if id != "" {
+			req.Header.Set(echo.HeaderXRequestID, id)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		return rec.Header().Get(echo.HeaderXRequestID)
+	}
+
+	// The identifier set by the client is used, invalid ones are replaced.
+	require.Equal(t, "client-id_1", requestID("client-id_1"))
+	generated := requestID("")
+	require.Len(t, generated, 16)
+	require.NotEqual(t, generated, requestID(""))
+	replaced := requestID("bad id\n")
+	require.Len(t, replaced, 16)
+	require.Equal(t, "client-id_1", handled[0])
+	require.Equal(t, generated, handled[1])
+	require.Equal(t, replaced, handled[3])
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"golang.org/x/time/rate"
+)
+
+// tokenIdentifier identifies the clients authenticated with the bearer token
+// to the rate limiter.
+const tokenIdentifier = "token"
+
+// RateLimits are the number of requests per second each client is allowed to
+// make. Zero disables the limit.
+type RateLimits struct {
+	// PerIP is the rate allowed per IP address of the clients not
+	// authenticated with the bearer token.
+	PerIP float64
+	// PerToken is the rate allowed to the clients authenticated with the
+	// bearer token.
+	PerToken float64
+}
+
+// rateLimitMiddlewares returns the middlewares limiting the rate of requests,
+// per bearer token for authenticated clients and per IP address otherwise.
+func rateLimitMiddlewares(
+	token string, limits RateLimits,
+) []echo.MiddlewareFunc {
+	var middlewares []echo.MiddlewareFunc
+	if limits.PerIP > 0 {
+		middlewares = append(middlewares, rateLimitMiddleware(
+			limits.PerIP,
+			func(c Context) bool { return hasToken(c, token) },
+			func(c Context) (string, error) { return c.RealIP(), nil },
+		))
+	}
+	if limits.PerToken > 0 {
+		middlewares = append(middlewares, rateLimitMiddleware(
+			limits.PerToken,
+			func(c Context) bool { return !hasToken(c, token) },
+			func(Context) (string, error) { return tokenIdentifier, nil },
+		))
+	}
+	return middlewares
+}
+
+// rateLimitMiddleware returns a middleware limiting the rate of requests of
+// each client, as identified by identify, allowing bursts of up to one
+// second of requests. Requests are not limited if skip returns true.
+func rateLimitMiddleware(
+	limit float64,
+	skip middleware.Skipper,
+	identify middleware.Extractor,
+) echo.MiddlewareFunc {
+	return middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
+		Skipper: skip,
+		Store: middleware.NewRateLimiterMemoryStoreWithConfig(
+			middleware.RateLimiterMemoryStoreConfig{
+				Rate:  rate.Limit(limit),
+				Burst: max(int(limit), 1),
+			},
+		),
+		IdentifierExtractor: identify,
+		ErrorHandler: func(c Context, err error) error {
+			return c.JSON(http.StatusForbidden, ErrorResponse{
+				Code:    http.StatusForbidden,
+				Message: err.Error(),
+			})
+		},
+		DenyHandler: func(c Context, _ string, _ error) error {
+			return c.JSON(http.StatusTooManyRequests, ErrorResponse{
+				Code:    http.StatusTooManyRequests,
+				Message: http.StatusText(http.StatusTooManyRequests),
+			})
+		},
+	})
+}
+
+// hasToken reports whether the request carries the given bearer token.
+func hasToken(c Context, token string) bool {
+	key, ok := strings.CutPrefix(
+		c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ",
+	)
+	return ok && isValidToken(key, token)
+}
@@ -23,14 +23,13 @@ package echo
 import (
 	"errors"
 	"fmt"
-	"net/http"
 	"strconv"
 
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/utils"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/go-playground/validator/v10"
-	"github.com/labstack/echo/v4"
 )
 
 // TODO: these validators need to be un-janked to 1) not use `FieldLevel` for
@@ -44,30 +43,35 @@ type CustomValidator struct {
 
 // Validate validates the given interface.
 func (cv *CustomValidator) Validate(i interface{}) error {
-	if err := cv.Validator.Struct(i); err != nil {
-		var validationErrors validator.ValidationErrors
-		hasValidationErrors := errors.As(err, &validationErrors)
-		if !hasValidationErrors || len(validationErrors) == 0 {
-			return nil
-		}
-		firstError := validationErrors[0]
-		field := firstError.Field()
-		value := firstError.Value()
-		return echo.NewHTTPError(http.StatusBadRequest,
-			fmt.Sprintf("Invalid %s: %s", field, value))
+	err := cv.Validator.Struct(i)
+	if err == nil {
+		return nil
+	}
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) || len(validationErrors) == 0 {
+		return fmt.Errorf("%w: %w", types.ErrInvalidRequest, err)
 	}
-	return nil
+	firstError := validationErrors[0]
+	return fmt.Errorf(
+		"%w: invalid %s: %v",
+		types.ErrInvalidRequest, firstError.Field(), firstError.Value(),
+	)
 }
 
 func ConstructValidator() *validator.Validate {
 	validators := map[string](func(fl validator.FieldLevel) bool){
-		"state_id":         ValidateStateID,
-		"block_id":         ValidateBlockID,
-		"timestamp_id":     ValidateTimestampID,
-		"validator_id":     ValidateValidatorID,
-		"epoch":            ValidateUint64,
-		"slot":             ValidateUint64,
-		"validator_status": ValidateValidatorStatus,
+		"state_id":          ValidateStateID,
+		"block_id":          ValidateBlockID,
+		"timestamp_id":      ValidateTimestampID,
+		"validator_id":      ValidateValidatorID,
+		"epoch":             ValidateUint64,
+		"slot":              ValidateUint64,
+		"validator_status":  ValidateValidatorStatus,
+		"pubkey":            ValidatePubkey,
+		"execution_address": ValidateExecutionAddress,
+		"gas_limit":         ValidateUint64,
+		"uint64":            ValidateUint64,
+		"root":              ValidateOptionalRoot,
 	}
 	validate := validator.New()
 	for tag, fn := range validators {
@@ -95,7 +99,13 @@ func ValidateBlockID(fl validator.FieldLevel) bool {
 		"genesis":   true,
 		"finalized": true,
 	}
-	return validateStateBlockIDs(fl.Field().String(), allowedValues)
+
+	value := fl.Field().String()
+	if utils.IsExecutionHashIDPrefix(value) {
+		return ValidateRoot(value[1:])
+	}
+
+	return validateStateBlockIDs(value, allowedValues)
 }
 
 func ValidateTimestampID(fl validator.FieldLevel) bool {
@@ -143,6 +153,20 @@ func ValidateValidatorID(fl validator.FieldLevel) bool {
 	return false
 }
 
+// ValidatePubkey checks if the provided field is a valid hex-encoded
+// validator public key.
+func ValidatePubkey(fl validator.FieldLevel) bool {
+	var key crypto.BLSPubkey
+	return key.UnmarshalText([]byte(fl.Field().String())) == nil
+}
+
+// ValidateExecutionAddress checks if the provided field is a valid
+// hex-encoded execution address.
+func ValidateExecutionAddress(fl validator.FieldLevel) bool {
+	var addr common.ExecutionAddress
+	return addr.UnmarshalText([]byte(fl.Field().String())) == nil
+}
+
 // ValidateRoot checks if the provided field is a valid root.
 // It validates against a 32 byte hex-encoded root with "0x" prefix.
 func ValidateRoot(value string) bool {
@@ -150,6 +174,13 @@ func ValidateRoot(value string) bool {
 	return err == nil
 }
 
+// ValidateOptionalRoot checks if the provided field is either empty or a
+// valid root.
+func ValidateOptionalRoot(fl validator.FieldLevel) bool {
+	value := fl.Field().String()
+	return value == "" || ValidateRoot(value)
+}
+
 func ValidateValidatorStatus(fl validator.FieldLevel) bool {
 	// Eth Beacon Node API specs: https://hackmd.io/ofFJ5gOmQpu1jjHilHbdQQ
 	allowedStatuses := map[string]bool{
@@ -162,6 +193,12 @@ func ValidateValidatorStatus(fl validator.FieldLevel) bool {
 		"exited_slashed":      true,
 		"withdrawal_possible": true,
 		"withdrawal_done":     true,
+		// General statuses, each matching a group of the statuses above.
+		"pending":    true,
+		"active":     true,
+		"exited":     true,
+		"withdrawal": true,
+		"slashed":    true,
 	}
 	return validateAllowedStrings(fl.Field().String(), allowedStatuses)
 }
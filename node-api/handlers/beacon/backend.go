@@ -22,6 +22,7 @@ package beacon
 
 import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -35,14 +36,21 @@ type Backend interface {
 	StateBackend
 	ValidatorBackend
 	HistoricalBackend
+	PoolBackend
+	LightClientBackend
 	// GetSlotByBlockRoot retrieves the slot by a given root from the store.
 	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
 	// GetSlotByStateRoot retrieves the slot by a given root from the store.
 	GetSlotByStateRoot(root common.Root) (math.Slot, error)
+	// GetSlotByExecutionHash retrieves the slot by a given execution block
+	// hash from the store.
+	GetSlotByExecutionHash(hash common.ExecutionHash) (math.Slot, error)
 }
 
 type GenesisBackend interface {
 	GenesisValidatorsRoot(slot math.Slot) (common.Root, error)
+	GenesisTime() (uint64, error)
+	GenesisForkVersion() common.Version
 }
 
 type HistoricalBackend interface {
@@ -55,6 +63,10 @@ type RandaoBackend interface {
 }
 
 type BlockBackend interface {
+	BlockAtSlot(slot math.Slot) (*ctypes.BeaconBlock, error)
+	BlobSidecarsAtSlot(
+		slot math.Slot, indices []uint64,
+	) (datypes.BlobSidecars, error)
 	BlockRootAtSlot(slot math.Slot) (common.Root, error)
 	BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 	BlockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader, error)
@@ -79,3 +91,19 @@ type ValidatorBackend interface {
 		ids []string,
 	) ([]*types.ValidatorBalanceData, error)
 }
+
+type LightClientBackend interface {
+	LightClientDataAtSlot(slot math.Slot) (*types.LightClientResponse, error)
+	LightClientUpdates(
+		startPeriod math.Epoch, count uint64,
+	) ([]*types.LightClientResponse, error)
+}
+
+type PoolBackend interface {
+	SubmitVoluntaryExit(exit *ctypes.SignedVoluntaryExit) error
+	SubmitBLSToExecutionChange(
+		change *ctypes.SignedBLSToExecutionChange,
+	) error
+	VoluntaryExits() []*ctypes.SignedVoluntaryExit
+	BLSToExecutionChanges() []*ctypes.SignedBLSToExecutionChange
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"strconv"
+
+	datypes "github.com/berachain/beacon-kit/da/types"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// GetBlobSidecars returns the blob sidecars of the block identified by the
+// requested block ID, optionally restricted to the requested indices.
+func (h *Handler[ContextT]) GetBlobSidecars(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlobSidecarsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	indices := make([]uint64, len(req.Indices))
+	for i, index := range req.Indices {
+		if indices[i], err = strconv.ParseUint(index, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	sidecars, err := h.backend.BlobSidecarsAtSlot(slot, indices)
+	if err != nil {
+		return nil, err
+	}
+	return types.SSZResponse{
+		JSON: beacontypes.ValidatorResponse{
+			ExecutionOptimistic: false, // stubbed
+			// Blocks are final once committed by CometBFT.
+			Finalized: true,
+			Data:      blobSidecarsResponse(sidecars),
+		},
+		SSZ: beacontypes.BlobSidecarList(sidecars),
+	}, nil
+}
+
+// blobSidecarsResponse converts the sidecars to their Beacon API encoding.
+func blobSidecarsResponse(
+	sidecars datypes.BlobSidecars,
+) []*beacontypes.BlobSidecar {
+	data := make([]*beacontypes.BlobSidecar, len(sidecars))
+	for i, sc := range sidecars {
+		header := sc.GetSignedBeaconBlockHeader()
+		data[i] = &beacontypes.BlobSidecar{
+			Index:         sc.GetIndex(),
+			Blob:          sc.GetBlob(),
+			KzgCommitment: sc.GetKzgCommitment(),
+			KzgProof:      sc.GetKzgProof(),
+			SignedBlockHeader: &beacontypes.BlockHeader{
+				Message:   header.GetHeader(),
+				Signature: header.GetSignature(),
+			},
+			KzgCommitmentInclusionProof: sc.InclusionProof,
+		}
+	}
+	return data
+}
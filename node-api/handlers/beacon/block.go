@@ -22,9 +22,48 @@ package beacon
 
 import (
 	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/version"
 )
 
+// GetBlock returns the beacon block identified by the requested block ID.
+func (h *Handler[ContextT]) GetBlock(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlocksRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := h.backend.BlockAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	if blk == nil {
+		return nil, types.ErrNotFound
+	}
+	signed := &beacontypes.SignedBeaconBlock{Message: blk}
+	return types.SSZResponse{
+		Version: version.Name(blk.Version()),
+		JSON: beacontypes.BlockResponse{
+			Version: version.Name(blk.Version()),
+			ValidatorResponse: beacontypes.ValidatorResponse{
+				ExecutionOptimistic: false, // stubbed
+				// Blocks are final once committed by CometBFT.
+				Finalized: true,
+				Data:      signed,
+			},
+		},
+		SSZ: signed,
+	}, nil
+}
+
+// GetBlockRewards returns the rewards of the proposer of the block identified
+// by the requested block ID.
 func (h *Handler[ContextT]) GetBlockRewards(c ContextT) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetBlockRewardsRequest](
 		c, h.Logger(),
@@ -42,7 +81,8 @@ func (h *Handler[ContextT]) GetBlockRewards(c ContextT) (any, error) {
 	}
 	return &beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
-		Data:                rewards,
+		// Blocks are final once committed by CometBFT.
+		Finalized: true,
+		Data:      rewards,
 	}, nil
 }
@@ -34,9 +34,13 @@ func (h *Handler[ContextT]) GetGenesis(_ ContextT) (any, error) {
 	if len(genesisRoot) == 0 {
 		return nil, types.ErrNotFound
 	}
+	genesisTime, err := h.backend.GenesisTime()
+	if err != nil {
+		return nil, err
+	}
 	return types.Wrap(beacontypes.GenesisData{
-		GenesisTime:           "1590832934", // stub
+		GenesisTime:           genesisTime,
 		GenesisValidatorsRoot: genesisRoot,
-		GenesisForkVersion:    "0x00000000", // stub
+		GenesisForkVersion:    h.backend.GenesisForkVersion(),
 	}), nil
 }
@@ -21,11 +21,16 @@
 package beacon
 
 import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/utils"
-	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
 )
 
+// GetBlockHeaders returns the header of the block at the requested slot, or
+// of the child of the requested parent root, or of the head block if
+// neither is requested. As there are no forks, at most one header matches.
 func (h *Handler[ContextT]) GetBlockHeaders(c ContextT) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetBlockHeadersRequest](
 		c, h.Logger(),
@@ -33,26 +38,40 @@ func (h *Handler[ContextT]) GetBlockHeaders(c ContextT) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	slot, err := utils.U64FromString(req.Slot)
-	if err != nil {
-		return nil, err
+
+	var parentRoot common.Root
+	if req.ParentRoot != "" {
+		parentRoot, err = common.NewRootFromHex(req.ParentRoot)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	slot := utils.Head
+	switch {
+	case req.Slot != "":
+		slot, err = utils.U64FromString(req.Slot)
+		if err != nil {
+			return nil, err
+		}
+	case req.ParentRoot != "":
+		var parentSlot math.Slot
+		parentSlot, err = h.backend.GetSlotByBlockRoot(parentRoot)
+		if err != nil {
+			// The parent is unknown, so is its child.
+			return headersResponse(), nil
+		}
+		slot = parentSlot + 1
 	}
+
 	header, err := h.backend.BlockHeaderAtSlot(slot)
 	if err != nil {
 		return nil, err
 	}
-	return beacontypes.ValidatorResponse{
-		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
-		Data: &beacontypes.BlockHeaderResponse{
-			Root:      header.GetBodyRoot(),
-			Canonical: true,
-			Header: &beacontypes.BlockHeader{
-				Message:   header,
-				Signature: bytes.B48{}, // TODO: implement
-			},
-		},
-	}, nil
+	if req.ParentRoot != "" && header.GetParentBlockRoot() != parentRoot {
+		return headersResponse(), nil
+	}
+	return headersResponse(header), nil
 }
 
 func (h *Handler[ContextT]) GetBlockHeaderByID(c ContextT) (any, error) {
@@ -72,14 +91,36 @@ func (h *Handler[ContextT]) GetBlockHeaderByID(c ContextT) (any, error) {
 	}
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
-		Data: &beacontypes.BlockHeaderResponse{
-			Root:      header.GetBodyRoot(),
-			Canonical: true,
-			Header: &beacontypes.BlockHeader{
-				Message:   header,
-				Signature: bytes.B48{}, // TODO: implement
-			},
-		},
+		// Blocks are final once committed by CometBFT.
+		Finalized: true,
+		Data:      blockHeaderResponse(header),
 	}, nil
 }
+
+// headersResponse returns the response listing the given block headers.
+func headersResponse(
+	headers ...*ctypes.BeaconBlockHeader,
+) beacontypes.ValidatorResponse {
+	data := make([]*beacontypes.BlockHeaderResponse, 0, len(headers))
+	for _, header := range headers {
+		data = append(data, blockHeaderResponse(header))
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		// Blocks are final once committed by CometBFT.
+		Finalized: true,
+		Data:      data,
+	}
+}
+
+// blockHeaderResponse returns the response data of the given block header.
+// Beacon blocks are signed through CometBFT, so the signature is empty.
+func blockHeaderResponse(
+	header *ctypes.BeaconBlockHeader,
+) *beacontypes.BlockHeaderResponse {
+	return &beacontypes.BlockHeaderResponse{
+		Root:      header.HashTreeRoot(),
+		Canonical: true,
+		Header:    &beacontypes.BlockHeader{Message: header},
+	}
+}
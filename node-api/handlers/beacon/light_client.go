@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"fmt"
+
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// maxLightClientUpdates is the maximum number of light client updates served
+// by a single request, as per MAX_REQUEST_LIGHT_CLIENT_UPDATES.
+const maxLightClientUpdates = 128
+
+// GetLightClientBootstrap returns the header of the requested block along
+// with the CometBFT light block that finalized it, for light clients to start
+// following the chain from.
+func (h *Handler[ContextT]) GetLightClientBootstrap(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetLightClientBootstrapRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	root, err := common.NewRootFromHex(req.BlockRoot)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := h.backend.GetSlotByBlockRoot(root)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", types.ErrNotFound, err)
+	}
+	return h.lightClientData(slot)
+}
+
+// GetLightClientUpdates returns the light client updates of the requested
+// range of periods, a period being an epoch.
+func (h *Handler[ContextT]) GetLightClientUpdates(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetLightClientUpdatesRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	startPeriod, err := utils.U64FromString(req.StartPeriod)
+	if err != nil {
+		return nil, err
+	}
+	count, err := utils.U64FromString(req.Count)
+	if err != nil {
+		return nil, err
+	}
+	return h.backend.LightClientUpdates(
+		startPeriod, min(count.Unwrap(), maxLightClientUpdates),
+	)
+}
+
+// GetLightClientFinalityUpdate returns the header of the latest block along
+// with the CometBFT light block that finalized it. Blocks are final once
+// committed by CometBFT, so it also serves as the optimistic update.
+func (h *Handler[ContextT]) GetLightClientFinalityUpdate(
+	ContextT,
+) (any, error) {
+	return h.lightClientData(utils.Head)
+}
+
+// lightClientData returns the light client data of the block at the given
+// slot, erroring if it is not available.
+func (h *Handler[ContextT]) lightClientData(slot math.Slot) (any, error) {
+	data, err := h.backend.LightClientDataAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, types.ErrNotFound
+	}
+	return data, nil
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/berachain/beacon-kit/beacon/operations"
+	"github.com/berachain/beacon-kit/errors"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// GetVoluntaryExits returns the voluntary exits in the operations pool.
+func (h *Handler[ContextT]) GetVoluntaryExits(ContextT) (any, error) {
+	exits := h.backend.VoluntaryExits()
+	data := make([]*beacontypes.SignedVoluntaryExitData, len(exits))
+	for i, exit := range exits {
+		data[i] = beacontypes.SignedVoluntaryExitFromConsensus(exit)
+	}
+	return types.Wrap(data), nil
+}
+
+// PostVoluntaryExit adds the voluntary exit to the operations pool, once
+// validated against the head state.
+func (h *Handler[ContextT]) PostVoluntaryExit(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.PostVoluntaryExitRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = h.backend.SubmitVoluntaryExit(req.ToConsensus()); err != nil {
+		return nil, operationError(err)
+	}
+	return types.StatusResponse{Code: http.StatusOK}, nil
+}
+
+// GetBLSToExecutionChanges returns the BLS to execution changes in the
+// operations pool.
+func (h *Handler[ContextT]) GetBLSToExecutionChanges(ContextT) (any, error) {
+	changes := h.backend.BLSToExecutionChanges()
+	data := make([]*beacontypes.SignedBLSToExecutionChangeData, len(changes))
+	for i, change := range changes {
+		data[i] = beacontypes.SignedBLSToExecutionChangeFromConsensus(change)
+	}
+	return types.Wrap(data), nil
+}
+
+// PostBLSToExecutionChanges adds the BLS to execution changes to the
+// operations pool, once validated against the head state. Valid changes are
+// added even if others are rejected, and the rejected ones are reported by
+// their position in the request.
+func (h *Handler[ContextT]) PostBLSToExecutionChanges(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[
+		beacontypes.PostBLSToExecutionChangesRequest,
+	](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	var errs []error
+	for i, change := range req.Changes {
+		err = h.backend.SubmitBLSToExecutionChange(change.ToConsensus())
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, operations.ErrInvalidOperation) {
+			return nil, err
+		}
+		errs = append(errs, fmt.Errorf("change %d: %w", i, err))
+	}
+	if len(errs) > 0 {
+		return nil, operationError(errors.Join(errs...))
+	}
+	return types.StatusResponse{Code: http.StatusOK}, nil
+}
+
+// operationError reports the operations rejected by the pool as invalid
+// requests.
+func operationError(err error) error {
+	if errors.Is(err, operations.ErrInvalidOperation) {
+		return fmt.Errorf("%w: %w", types.ErrInvalidRequest, err)
+	}
+	return err
+}
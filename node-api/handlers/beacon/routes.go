@@ -25,6 +25,7 @@ import (
 
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
 )
 
 //nolint:funlen // routes are long
@@ -42,11 +43,13 @@ func (h *Handler[ContextT]) RegisterRoutes(
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/root",
 			Handler: h.GetStateRoot,
+			Request: beacontypes.GetStateRootRequest{},
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/fork",
 			Handler: h.GetStateFork,
+			Request: beacontypes.GetStateForkRequest{},
 		},
 		{
 			Method:  http.MethodGet,
@@ -57,26 +60,31 @@ func (h *Handler[ContextT]) RegisterRoutes(
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/validators",
 			Handler: h.GetStateValidators,
+			Request: beacontypes.GetStateValidatorsRequest{},
 		},
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/states/:state_id/validators",
 			Handler: h.PostStateValidators,
+			Request: beacontypes.PostStateValidatorsRequest{},
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/validators/:validator_id",
 			Handler: h.GetStateValidator,
+			Request: beacontypes.GetStateValidatorRequest{},
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/validator_balances",
 			Handler: h.GetStateValidatorBalances,
+			Request: beacontypes.GetValidatorBalancesRequest{},
 		},
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/states/:state_id/validator_balances",
 			Handler: h.PostStateValidatorBalances,
+			Request: beacontypes.PostValidatorBalancesRequest{},
 		},
 		{
 			Method:  http.MethodGet,
@@ -92,16 +100,19 @@ func (h *Handler[ContextT]) RegisterRoutes(
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/randao",
 			Handler: h.GetRandao,
+			Request: beacontypes.GetRandaoRequest{},
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/headers",
 			Handler: h.GetBlockHeaders,
+			Request: beacontypes.GetBlockHeadersRequest{},
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/headers/:block_id",
 			Handler: h.GetBlockHeaderByID,
+			Request: beacontypes.GetBlockHeaderRequest{},
 		},
 		{
 			Method:  http.MethodPost,
@@ -125,8 +136,9 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		},
 		{
 			Method:  http.MethodGet,
-			Path:    "eth/v2/beacon/blocks/:block_id",
-			Handler: h.NotImplemented,
+			Path:    "/eth/v2/beacon/blocks/:block_id",
+			Handler: h.GetBlock,
+			Request: beacontypes.GetBlocksRequest{},
 		},
 		{
 			Method:  http.MethodGet,
@@ -141,7 +153,14 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/blob_sidecars/:block_id",
-			Handler: h.NotImplemented,
+			Handler: h.GetBlobSidecars,
+			Request: beacontypes.GetBlobSidecarsRequest{},
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/eth/v1/beacon/rewards/blocks/:block_id",
+			Handler: h.GetBlockRewards,
+			Request: beacontypes.GetBlockRewardsRequest{},
 		},
 		{
 			Method:  http.MethodPost,
@@ -166,22 +185,26 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/bootstrap/:block_root",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientBootstrap,
+			Request: beacontypes.GetLightClientBootstrapRequest{},
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/updates",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientUpdates,
+			Request: beacontypes.GetLightClientUpdatesRequest{},
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/finality_update",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientFinalityUpdate,
+			Request: beacontypes.GetLightClientFinalityUpdateRequest{},
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/optimistic_update",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientFinalityUpdate,
+			Request: beacontypes.GetLightClientFinalityUpdateRequest{},
 		},
 		{
 			Method:  http.MethodGet,
@@ -221,22 +244,26 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/pool/voluntary_exits",
-			Handler: h.NotImplemented,
+			Handler: h.GetVoluntaryExits,
 		},
 		{
-			Method:  http.MethodPost,
-			Path:    "/eth/v1/beacon/pool/voluntary_exits",
-			Handler: h.NotImplemented,
+			Method:        http.MethodPost,
+			Path:          "/eth/v1/beacon/pool/voluntary_exits",
+			Handler:       h.PostVoluntaryExit,
+			Request:       beacontypes.PostVoluntaryExitRequest{},
+			Authenticated: true,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/pool/bls_to_execution_changes",
-			Handler: h.NotImplemented,
+			Handler: h.GetBLSToExecutionChanges,
 		},
 		{
-			Method:  http.MethodPost,
-			Path:    "/eth/v1/beacon/pool/bls_to_execution_changes",
-			Handler: h.NotImplemented,
+			Method:        http.MethodPost,
+			Path:          "/eth/v1/beacon/pool/bls_to_execution_changes",
+			Handler:       h.PostBLSToExecutionChanges,
+			Request:       beacontypes.PostBLSToExecutionChangesRequest{},
+			Authenticated: true,
 		},
 	})
 }
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"encoding/json"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// LightClientHeader is a beacon block header followed by light clients.
+type LightClientHeader struct {
+	Beacon *ctypes.BeaconBlockHeader `json:"beacon"`
+}
+
+// LightClientData is a beacon block header along with the CometBFT light
+// block that finalized it. Light clients follow the chain as CometBFT light
+// clients do, verifying the commit of the light block against a validator set
+// they trust, then the inclusion of the beacon block in the light block.
+type LightClientData struct {
+	Header LightClientHeader `json:"header"`
+	// LightBlock is the CometBFT signed header and the validator set that
+	// signed it, in the CometBFT JSON encoding.
+	LightBlock json.RawMessage `json:"light_block"`
+	// BeaconBlockProof is the proof of the beacon block transaction in the
+	// data hash of the CometBFT header, in the CometBFT JSON encoding.
+	BeaconBlockProof json.RawMessage `json:"beacon_block_proof"`
+}
+
+// LightClientDataFromCometBFT returns the light client data of the given
+// beacon block header, committed in the given CometBFT light block.
+func LightClientDataFromCometBFT(
+	header *ctypes.BeaconBlockHeader,
+	lightBlock *cmttypes.LightBlock,
+	beaconBlockProof *cmttypes.TxProof,
+) (*LightClientData, error) {
+	lb, err := cmtjson.Marshal(lightBlock)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := cmtjson.Marshal(beaconBlockProof)
+	if err != nil {
+		return nil, err
+	}
+	return &LightClientData{
+		Header:           LightClientHeader{Beacon: header},
+		LightBlock:       lb,
+		BeaconBlockProof: proof,
+	}, nil
+}
+
+// LightClientResponse is a light client data along with the name of the
+// fork of its beacon block.
+type LightClientResponse struct {
+	Version string           `json:"version"`
+	Data    *LightClientData `json:"data"`
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+type VoluntaryExitData struct {
+	Epoch          uint64 `json:"epoch,string"`
+	ValidatorIndex uint64 `json:"validator_index,string"`
+}
+
+type SignedVoluntaryExitData struct {
+	Message   *VoluntaryExitData  `json:"message"   validate:"required"`
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// SignedVoluntaryExitFromConsensus converts the exit from its consensus type.
+func SignedVoluntaryExitFromConsensus(
+	exit *ctypes.SignedVoluntaryExit,
+) *SignedVoluntaryExitData {
+	return &SignedVoluntaryExitData{
+		Message: &VoluntaryExitData{
+			Epoch:          exit.Message.Epoch.Unwrap(),
+			ValidatorIndex: exit.Message.ValidatorIndex.Unwrap(),
+		},
+		Signature: exit.Signature,
+	}
+}
+
+// ToConsensus converts the exit to its consensus type.
+func (d *SignedVoluntaryExitData) ToConsensus() *ctypes.SignedVoluntaryExit {
+	return &ctypes.SignedVoluntaryExit{
+		Message: &ctypes.VoluntaryExit{
+			Epoch:          math.Epoch(d.Message.Epoch),
+			ValidatorIndex: math.ValidatorIndex(d.Message.ValidatorIndex),
+		},
+		Signature: d.Signature,
+	}
+}
+
+type BLSToExecutionChangeData struct {
+	ValidatorIndex     uint64                  `json:"validator_index,string"`
+	FromBLSPubkey      crypto.BLSPubkey        `json:"from_bls_pubkey"`
+	ToExecutionAddress common.ExecutionAddress `json:"to_execution_address"`
+}
+
+type SignedBLSToExecutionChangeData struct {
+	Message   *BLSToExecutionChangeData `json:"message"   validate:"required"`
+	Signature crypto.BLSSignature       `json:"signature"`
+}
+
+// SignedBLSToExecutionChangeFromConsensus converts the change from its
+// consensus type.
+func SignedBLSToExecutionChangeFromConsensus(
+	change *ctypes.SignedBLSToExecutionChange,
+) *SignedBLSToExecutionChangeData {
+	return &SignedBLSToExecutionChangeData{
+		Message: &BLSToExecutionChangeData{
+			ValidatorIndex:     change.Message.ValidatorIndex.Unwrap(),
+			FromBLSPubkey:      change.Message.FromBLSPubkey,
+			ToExecutionAddress: change.Message.ToExecutionAddress,
+		},
+		Signature: change.Signature,
+	}
+}
+
+// ToConsensus converts the change to its consensus type.
+func (
+	d *SignedBLSToExecutionChangeData,
+) ToConsensus() *ctypes.SignedBLSToExecutionChange {
+	return &ctypes.SignedBLSToExecutionChange{
+		Message: &ctypes.BLSToExecutionChange{
+			ValidatorIndex:     math.ValidatorIndex(d.Message.ValidatorIndex),
+			FromBLSPubkey:      d.Message.FromBLSPubkey,
+			ToExecutionAddress: d.Message.ToExecutionAddress,
+		},
+		Signature: d.Signature,
+	}
+}
@@ -23,6 +23,7 @@ package types
 import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
 )
 
 type GetGenesisRequest struct{}
@@ -43,17 +44,19 @@ type GetStateValidatorsRequest struct {
 	types.StateIDRequest
 	IDs      []string `query:"id"     validate:"dive,validator_id"`
 	Statuses []string `query:"status" validate:"dive,validator_status"`
+	types.PaginationRequest
 }
 
 type PostStateValidatorsRequest struct {
 	types.StateIDRequest
 	IDs      []string `json:"ids"      validate:"dive,validator_id"`
 	Statuses []string `json:"statuses" validate:"dive,validator_status"`
+	types.PaginationRequest
 }
 
 type GetStateValidatorRequest struct {
 	types.StateIDRequest
-	ValidatorID string `query:"validator_id" validate:"required,validator_id"`
+	ValidatorID string `param:"validator_id" validate:"required,validator_id"`
 }
 
 type GetValidatorBalancesRequest struct {
@@ -66,6 +69,26 @@ type PostValidatorBalancesRequest struct {
 	IDs []string `validate:"dive,validator_id"`
 }
 
+// UnmarshalJSON decodes the request body, which is a bare array of validator
+// ids.
+func (r *PostValidatorBalancesRequest) UnmarshalJSON(bz []byte) error {
+	return json.Unmarshal(bz, &r.IDs)
+}
+
+type PostVoluntaryExitRequest struct {
+	SignedVoluntaryExitData
+}
+
+type PostBLSToExecutionChangesRequest struct {
+	Changes []*SignedBLSToExecutionChangeData `validate:"required,dive,required"`
+}
+
+// UnmarshalJSON decodes the request body, which is a bare array of signed
+// changes.
+func (r *PostBLSToExecutionChangesRequest) UnmarshalJSON(bz []byte) error {
+	return json.Unmarshal(bz, &r.Changes)
+}
+
 type GetStateCommitteesRequest struct {
 	types.StateIDRequest
 	EpochOptionalRequest
@@ -85,7 +108,7 @@ type GetRandaoRequest struct {
 
 type GetBlockHeadersRequest struct {
 	SlotRequest
-	ParentRoot string `query:"parent_root" validate:"hex"`
+	ParentRoot string `query:"parent_root" validate:"root"`
 }
 
 type GetBlockHeaderRequest struct {
@@ -128,6 +151,17 @@ type GetBlobSidecarsRequest struct {
 	Indices []string `query:"indices" validate:"dive,uint64"`
 }
 
+type GetLightClientBootstrapRequest struct {
+	BlockRoot string `param:"block_root" validate:"required,root"`
+}
+
+type GetLightClientUpdatesRequest struct {
+	StartPeriod string `query:"start_period" validate:"required,uint64"`
+	Count       string `query:"count"        validate:"required,uint64"`
+}
+
+type GetLightClientFinalityUpdateRequest struct{}
+
 type PostRewardsSyncCommitteeRequest struct {
 	types.BlockIDRequest
 	IDs []string `validate:"dive,validator_id"`
@@ -166,7 +200,7 @@ type SlotRequest struct {
 
 type HeadersRequest struct {
 	SlotRequest
-	ParentRoot string `query:"parent_root" validate:"hex"`
+	ParentRoot string `query:"parent_root" validate:"root"`
 }
 
 type BlobSidecarRequest struct {
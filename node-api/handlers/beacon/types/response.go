@@ -22,8 +22,9 @@ package types
 
 import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
-	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
 )
 
 type ValidatorResponse struct {
@@ -32,6 +33,17 @@ type ValidatorResponse struct {
 	Data                any  `json:"data"`
 }
 
+// PaginatedResponse is a ValidatorResponse holding a single page of the data.
+type PaginatedResponse struct {
+	ValidatorResponse
+	Meta PaginationMeta `json:"meta"`
+}
+
+// PaginationMeta describes the full list a page was taken from.
+type PaginationMeta struct {
+	Total uint64 `json:"total,string"`
+}
+
 type BlockResponse struct {
 	Version string `json:"version"`
 	ValidatorResponse
@@ -45,13 +57,30 @@ type BlockHeaderResponse struct {
 
 type BlockHeader struct {
 	Message   *ctypes.BeaconBlockHeader `json:"message"`
-	Signature bytes.B48                 `json:"signature"`
+	Signature crypto.BLSSignature       `json:"signature"`
+}
+
+// SignedBeaconBlock is a beacon block along with its proposer signature.
+// Beacon blocks are signed through CometBFT, so the signature is empty.
+type SignedBeaconBlock struct {
+	Message   *ctypes.BeaconBlock `json:"message"`
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// BlobSidecar is a blob sidecar in its Beacon API encoding.
+type BlobSidecar struct {
+	Index                       uint64                `json:"index,string"`
+	Blob                        eip4844.Blob          `json:"blob"`
+	KzgCommitment               eip4844.KZGCommitment `json:"kzg_commitment"`
+	KzgProof                    eip4844.KZGProof      `json:"kzg_proof"`
+	SignedBlockHeader           *BlockHeader          `json:"signed_block_header"`
+	KzgCommitmentInclusionProof []common.Root         `json:"kzg_commitment_inclusion_proof"`
 }
 
 type GenesisData struct {
-	GenesisTime           string      `json:"genesis_time"`
-	GenesisValidatorsRoot common.Root `json:"genesis_validators_root"`
-	GenesisForkVersion    string      `json:"genesis_fork_version"`
+	GenesisTime           uint64         `json:"genesis_time,string"`
+	GenesisValidatorsRoot common.Root    `json:"genesis_validators_root"`
+	GenesisForkVersion    common.Version `json:"genesis_fork_version"`
 }
 
 type RootData struct {
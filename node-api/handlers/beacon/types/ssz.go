@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/karalabe/ssz"
+)
+
+// SizeSSZ returns the size of the SignedBeaconBlock object in SSZ encoding.
+func (b *SignedBeaconBlock) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	//nolint:mnd // message offset and signature.
+	var size = uint32(4 + 96)
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(siz, b.Message)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the SignedBeaconBlock object.
+func (b *SignedBeaconBlock) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineDynamicObjectOffset(codec, &b.Message)
+	ssz.DefineStaticBytes(codec, &b.Signature)
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicObjectContent(codec, &b.Message)
+}
+
+// MarshalSSZ marshals the SignedBeaconBlock object to SSZ format.
+func (b *SignedBeaconBlock) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(b))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+// BlobSidecarList is a list of blob sidecars in its Beacon API SSZ encoding.
+// Unlike datypes.BlobSidecars, which is a container holding the list, the
+// sidecars are encoded back to back.
+type BlobSidecarList datypes.BlobSidecars
+
+// MarshalSSZ marshals the BlobSidecarList object to SSZ format.
+func (l BlobSidecarList) MarshalSSZ() ([]byte, error) {
+	var buf []byte
+	for _, sc := range l {
+		bz, err := sc.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, bz...)
+	}
+	return buf, nil
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedBeaconBlock_MarshalSSZ(t *testing.T) {
+	blk := &ctypes.BeaconBlock{
+		Slot:          10,
+		ProposerIndex: 5,
+		ParentRoot:    common.Root{1, 2, 3},
+		Body: &ctypes.BeaconBlockBody{
+			ExecutionPayload: &ctypes.ExecutionPayload{
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: &ctypes.Eth1Data{},
+		},
+	}
+	signed := &beacontypes.SignedBeaconBlock{
+		Message:   blk,
+		Signature: crypto.BLSSignature{0xff},
+	}
+	bz, err := signed.MarshalSSZ()
+	require.NoError(t, err)
+
+	blkBz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, bz, 4+96+len(blkBz))
+	require.Equal(t, uint32(4+96), binary.LittleEndian.Uint32(bz))
+	require.Equal(t, signed.Signature[:], bz[4:100])
+	require.Equal(t, blkBz, bz[100:])
+}
+
+func TestBlobSidecarList_MarshalSSZ(t *testing.T) {
+	sidecars := datypes.BlobSidecars{
+		{Index: 0, SignedBeaconBlockHeader: &ctypes.SignedBeaconBlockHeader{
+			Header: &ctypes.BeaconBlockHeader{},
+		}},
+		{Index: 1, SignedBeaconBlockHeader: &ctypes.SignedBeaconBlockHeader{
+			Header: &ctypes.BeaconBlockHeader{},
+		}},
+	}
+	bz, err := beacontypes.BlobSidecarList(sidecars).MarshalSSZ()
+	require.NoError(t, err)
+
+	first, err := sidecars[0].MarshalSSZ()
+	require.NoError(t, err)
+	second, err := sidecars[1].MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, append(first, second...), bz)
+}
@@ -35,10 +35,6 @@ func (h *Handler[ContextT]) GetStateValidators(
 	if err != nil {
 		return nil, err
 	}
-	// TODO: remove this once status filter is implemented.
-	if len(req.Statuses) > 0 {
-		return nil, types.ErrNotImplemented
-	}
 	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
 	if err != nil {
 		return nil, err
@@ -51,14 +47,7 @@ func (h *Handler[ContextT]) GetStateValidators(
 	if err != nil {
 		return nil, err
 	}
-	if len(validators) == 0 {
-		return nil, types.ErrNotFound
-	}
-	return beacontypes.ValidatorResponse{
-		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
-		Data:                validators,
-	}, nil
+	return validatorsResponse(validators, req.PaginationRequest), nil
 }
 
 func (h *Handler[ContextT]) PostStateValidators(
@@ -70,10 +59,6 @@ func (h *Handler[ContextT]) PostStateValidators(
 	if err != nil {
 		return nil, err
 	}
-	// TODO: remove this once status filter is implemented.
-	if len(req.Statuses) > 0 {
-		return nil, types.ErrNotImplemented
-	}
 	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
 	if err != nil {
 		return nil, err
@@ -86,11 +71,7 @@ func (h *Handler[ContextT]) PostStateValidators(
 	if err != nil {
 		return nil, err
 	}
-	return beacontypes.ValidatorResponse{
-		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
-		Data:                validators,
-	}, nil
+	return validatorsResponse(validators, req.PaginationRequest), nil
 }
 
 func (h *Handler[ContextT]) GetStateValidator(
@@ -113,7 +94,11 @@ func (h *Handler[ContextT]) GetStateValidator(
 	if err != nil {
 		return nil, err
 	}
-	return validator, nil
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           true,
+		Data:                validator,
+	}, nil
 }
 
 func (h *Handler[ContextT]) GetStateValidatorBalances(
@@ -129,16 +114,13 @@ func (h *Handler[ContextT]) GetStateValidatorBalances(
 	if err != nil {
 		return nil, err
 	}
-	balances, err := h.backend.ValidatorBalancesByIDs(
-		slot,
-		req.IDs,
-	)
+	balances, err := h.backend.ValidatorBalancesByIDs(slot, req.IDs)
 	if err != nil {
 		return nil, err
 	}
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
+		Finalized:           true,
 		Data:                balances,
 	}, nil
 }
@@ -156,16 +138,32 @@ func (h *Handler[ContextT]) PostStateValidatorBalances(
 	if err != nil {
 		return nil, err
 	}
-	balances, err := h.backend.ValidatorBalancesByIDs(
-		slot,
-		req.IDs,
-	)
+	balances, err := h.backend.ValidatorBalancesByIDs(slot, req.IDs)
 	if err != nil {
 		return nil, err
 	}
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
+		Finalized:           true,
 		Data:                balances,
 	}, nil
 }
+
+// validatorsResponse returns the requested page of the validators.
+func validatorsResponse(
+	validators []*beacontypes.ValidatorData,
+	page types.PaginationRequest,
+) beacontypes.PaginatedResponse {
+	return beacontypes.PaginatedResponse{
+		ValidatorResponse: beacontypes.ValidatorResponse{
+			ExecutionOptimistic: false, // stubbed
+			Finalized:           true,
+			Data: utils.Paginate(
+				validators, page.Offset, page.Limit,
+			),
+		},
+		Meta: beacontypes.PaginationMeta{
+			Total: uint64(len(validators)),
+		},
+	}
+}
@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/node-api/handlers/config/types"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// GetForkSchedule returns the forks of the chain, past and scheduled. Forks
+// activating at genesis are folded into the genesis fork.
+func (h *Handler[ContextT]) GetForkSchedule(ContextT) (any, error) {
+	genesisVersion := h.forkVersion(0)
+	forks := []types.ForkData{{
+		PreviousVersion: genesisVersion,
+		CurrentVersion:  genesisVersion,
+		Epoch:           0,
+	}}
+	for _, epoch := range []math.Epoch{
+		h.chainSpec.DenebPlusForkEpoch(),
+		h.chainSpec.ElectraForkEpoch(),
+	} {
+		if epoch == 0 {
+			continue
+		}
+		forks = append(forks, types.ForkData{
+			PreviousVersion: forks[len(forks)-1].CurrentVersion,
+			CurrentVersion:  h.forkVersion(epoch),
+			Epoch:           epoch.Unwrap(),
+		})
+	}
+	return handlertypes.Wrap(forks), nil
+}
+
+// GetSpec returns the chain spec the node is running with.
+func (h *Handler[ContextT]) GetSpec(ContextT) (any, error) {
+	cs := h.chainSpec
+	spec := map[string]string{
+		// Gwei values.
+		"MIN_DEPOSIT_AMOUNT":             u64(cs.MinDepositAmount()),
+		"MAX_EFFECTIVE_BALANCE":          u64(cs.MaxEffectiveBalance(false)),
+		"EJECTION_BALANCE":               u64(cs.EjectionBalance()),
+		"EFFECTIVE_BALANCE_INCREMENT":    u64(cs.EffectiveBalanceIncrement()),
+		"HYSTERESIS_QUOTIENT":            u64(cs.HysteresisQuotient()),
+		"HYSTERESIS_DOWNWARD_MULTIPLIER": u64(cs.HysteresisDownwardMultiplier()),
+		"HYSTERESIS_UPWARD_MULTIPLIER":   u64(cs.HysteresisUpwardMultiplier()),
+		"SLOTS_PER_EPOCH":                u64(cs.SlotsPerEpoch()),
+		"SLOTS_PER_HISTORICAL_ROOT":      u64(cs.SlotsPerHistoricalRoot()),
+		"MIN_EPOCHS_TO_INACTIVITY_PENALTY": u64(
+			cs.MinEpochsToInactivityPenalty(),
+		),
+
+		// Signature domains.
+		"DOMAIN_BEACON_PROPOSER":         cs.DomainTypeProposer().String(),
+		"DOMAIN_BEACON_ATTESTER":         cs.DomainTypeAttester().String(),
+		"DOMAIN_RANDAO":                  cs.DomainTypeRandao().String(),
+		"DOMAIN_DEPOSIT":                 cs.DomainTypeDeposit().String(),
+		"DOMAIN_VOLUNTARY_EXIT":          cs.DomainTypeVoluntaryExit().String(),
+		"DOMAIN_SELECTION_PROOF":         cs.DomainTypeSelectionProof().String(),
+		"DOMAIN_AGGREGATE_AND_PROOF":     cs.DomainTypeAggregateAndProof().String(),
+		"DOMAIN_APPLICATION_MASK":        cs.DomainTypeApplicationMask().String(),
+		"DOMAIN_BLS_TO_EXECUTION_CHANGE": cs.DomainTypeBLSToExecutionChange().String(),
+
+		// Eth1-related values.
+		"DEPOSIT_CONTRACT_ADDRESS": cs.DepositContractAddress().Hex(),
+		"DEPOSIT_CHAIN_ID":         u64(cs.DepositEth1ChainID()),
+		"DEPOSIT_NETWORK_ID":       u64(cs.DepositEth1ChainID()),
+		"MAX_DEPOSITS":             u64(cs.MaxDepositsPerBlock()),
+		"ETH1_FOLLOW_DISTANCE":     u64(cs.Eth1FollowDistance()),
+		"SECONDS_PER_ETH1_BLOCK":   u64(cs.TargetSecondsPerEth1Block()),
+
+		// Fork-related values.
+		"GENESIS_FORK_VERSION":    h.forkVersion(0).String(),
+		"DENEB_FORK_VERSION":      versionOf(version.Deneb).String(),
+		"DENEB_FORK_EPOCH":        u64(0),
+		"DENEB_PLUS_FORK_VERSION": versionOf(version.DenebPlus).String(),
+		"DENEB_PLUS_FORK_EPOCH":   u64(cs.DenebPlusForkEpoch().Unwrap()),
+		"ELECTRA_FORK_VERSION":    versionOf(version.Electra).String(),
+		"ELECTRA_FORK_EPOCH":      u64(cs.ElectraForkEpoch().Unwrap()),
+
+		// State list lengths.
+		"EPOCHS_PER_HISTORICAL_VECTOR": u64(cs.EpochsPerHistoricalVector()),
+		"EPOCHS_PER_SLASHINGS_VECTOR":  u64(cs.EpochsPerSlashingsVector()),
+		"HISTORICAL_ROOTS_LIMIT":       u64(cs.HistoricalRootsLimit()),
+		"VALIDATOR_REGISTRY_LIMIT":     u64(cs.ValidatorRegistryLimit()),
+
+		// Rewards and penalties.
+		"INACTIVITY_PENALTY_QUOTIENT": u64(cs.InactivityPenaltyQuotient()),
+		"PROPORTIONAL_SLASHING_MULTIPLIER": u64(
+			cs.ProportionalSlashingMultiplier(),
+		),
+		"MIN_SLASHING_PENALTY_QUOTIENT": u64(cs.MinSlashingPenaltyQuotient()),
+
+		// Capella values.
+		"MAX_WITHDRAWALS_PER_PAYLOAD": u64(cs.MaxWithdrawalsPerPayload()),
+		"MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP": u64(
+			cs.MaxValidatorsPerWithdrawalsSweep(false),
+		),
+
+		// Deneb values.
+		"MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS": u64(
+			cs.MinEpochsForBlobsSidecarsRequest(),
+		),
+		"MAX_BLOB_COMMITMENTS_PER_BLOCK": u64(cs.MaxBlobCommitmentsPerBlock()),
+		"MAX_BLOBS_PER_BLOCK":            u64(cs.MaxBlobsPerBlock()),
+		"FIELD_ELEMENTS_PER_BLOB":        u64(cs.FieldElementsPerBlob()),
+		"BYTES_PER_BLOB":                 u64(cs.BytesPerBlob()),
+
+		// Berachain values.
+		"VALIDATOR_SET_CAP":        u64(cs.ValidatorSetCap()),
+		"VALIDATOR_POWER_STRATEGY": cs.ValidatorPowerStrategy(),
+		"VALIDATOR_POWER_CAP":      u64(cs.ValidatorPowerCap()),
+		"EVM_INFLATION_ADDRESS":    cs.EVMInflationAddress().Hex(),
+		"EVM_INFLATION_PER_BLOCK":  u64(cs.EVMInflationPerBlock()),
+	}
+	return handlertypes.Wrap(spec), nil
+}
+
+// GetDepositContract returns the deposit contract the chain is following.
+func (h *Handler[ContextT]) GetDepositContract(ContextT) (any, error) {
+	return handlertypes.Wrap(types.DepositContractData{
+		ChainID: h.chainSpec.DepositEth1ChainID(),
+		Address: h.chainSpec.DepositContractAddress(),
+	}), nil
+}
+
+// forkVersion returns the fork version active at the given epoch.
+func (h *Handler[ContextT]) forkVersion(epoch math.Epoch) common.Version {
+	return versionOf(h.chainSpec.ActiveForkVersionForEpoch(epoch))
+}
+
+// versionOf returns the fork version of the given fork.
+func versionOf(v uint32) common.Version {
+	return version.FromUint32[common.Version](v)
+}
+
+// u64 renders a spec value the way the Beacon API expects it, as a decimal
+// string.
+func u64(v uint64) string {
+	return strconv.FormatUint(v, 10)
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/node-api/handlers/config"
+	configtypes "github.com/berachain/beacon-kit/node-api/handlers/config/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetForkSchedule(t *testing.T) {
+	data := spec.DevnetChainSpecData()
+	data.DenebPlusForkEpoch = 0
+	data.ElectraForkEpoch = 10
+	cs, err := chain.NewChainSpec(data)
+	require.NoError(t, err)
+
+	res, err := config.NewHandler[echo.Context](cs).GetForkSchedule(nil)
+	require.NoError(t, err)
+
+	denebPlus := version.FromUint32[common.Version](version.DenebPlus)
+	electra := version.FromUint32[common.Version](version.Electra)
+	require.Equal(t, types.Wrap([]configtypes.ForkData{
+		{PreviousVersion: denebPlus, CurrentVersion: denebPlus, Epoch: 0},
+		{PreviousVersion: denebPlus, CurrentVersion: electra, Epoch: 10},
+	}), res)
+}
+
+func TestGetSpec(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	res, err := config.NewHandler[echo.Context](cs).GetSpec(nil)
+	require.NoError(t, err)
+
+	wrapped, ok := res.(types.DataResponse)
+	require.True(t, ok)
+	values, ok := wrapped.Data.(map[string]string)
+	require.True(t, ok)
+	require.Equal(t, "32", values["SLOTS_PER_EPOCH"])
+	require.Equal(t, "0x00000000", values["DOMAIN_BEACON_PROPOSER"])
+	require.Equal(t,
+		cs.DepositContractAddress().Hex(), values["DEPOSIT_CONTRACT_ADDRESS"],
+	)
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers"
+	debugtypes "github.com/berachain/beacon-kit/node-api/handlers/debug/types"
 )
 
 func (h *Handler[ContextT]) RegisterRoutes(
@@ -35,7 +36,8 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v2/debug/beacon/states/:state_id",
-			Handler: h.NotImplemented,
+			Handler: h.GetState,
+			Request: debugtypes.GetStateRequest{},
 		},
 		{
 			Method:  http.MethodGet,
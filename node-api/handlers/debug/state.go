@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	debugtypes "github.com/berachain/beacon-kit/node-api/handlers/debug/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// GetState returns the beacon state identified by the requested state ID.
+func (h *Handler[ContextT]) GetState(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[debugtypes.GetStateRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	st, err := h.stateFromID(req.StateID)
+	if err != nil {
+		return nil, err
+	}
+	forkVersion := version.Name(version.ToUint32(st.Fork.CurrentVersion))
+	return types.SSZResponse{
+		Version: forkVersion,
+		JSON: debugtypes.StateResponse{
+			Version:             forkVersion,
+			ExecutionOptimistic: false, // stubbed
+			// States are final once their block is committed by CometBFT.
+			Finalized: true,
+			Data:      st,
+		},
+		SSZ: st,
+	}, nil
+}
+
+// stateFromID returns the beacon state identified by the given state ID.
+// The finalized state is the latest epoch boundary snapshot, when states
+// are snapshotted, so that checkpoint syncing nodes start from an epoch
+// boundary.
+func (h *Handler[_]) stateFromID(stateID string) (*ctypes.BeaconState, error) {
+	if stateID == utils.StateIDFinalized {
+		st, err := h.backend.CheckpointState()
+		if err != nil || st != nil {
+			return st, err
+		}
+	}
+	slot, err := utils.SlotFromStateID(stateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	return h.backend.StateAtSlot(slot)
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/berachain/beacon-kit/beacon/events"
+	"github.com/berachain/beacon-kit/errors"
+	eventstypes "github.com/berachain/beacon-kit/node-api/handlers/events/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// Events streams the events published on the requested topics.
+func (h *Handler[ContextT]) Events(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[eventstypes.EventsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var topics []events.Topic
+	for _, names := range req.Topics {
+		for _, name := range strings.Split(names, ",") {
+			topic, parseErr := events.ParseTopic(name)
+			if parseErr != nil {
+				return nil, errors.Join(types.ErrInvalidRequest, parseErr)
+			}
+			topics = append(topics, topic)
+		}
+	}
+	return types.EventStream{
+		Stream: func(
+			ctx context.Context,
+			send func(event string, data []byte) error,
+		) error {
+			sub := h.bus.Subscribe(topics...)
+			defer sub.Unsubscribe()
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case event := <-sub.Events():
+					bz, err := json.Marshal(event.Data)
+					if err != nil {
+						return err
+					}
+					if err = send(string(event.Topic), bz); err != nil {
+						return err
+					}
+				}
+			}
+		},
+	}, nil
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/beacon/events"
+	"github.com/berachain/beacon-kit/log/noop"
+	echoengine "github.com/berachain/beacon-kit/node-api/engines/echo"
+	eventsapi "github.com/berachain/beacon-kit/node-api/handlers/events"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func newContext(target string) echo.Context {
+	e := echo.New()
+	e.Validator = &echoengine.CustomValidator{
+		Validator: echoengine.ConstructValidator(),
+	}
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	return e.NewContext(req, httptest.NewRecorder())
+}
+
+func TestEvents(t *testing.T) {
+	bus := events.NewBus()
+	h := eventsapi.NewHandler[echo.Context](bus)
+	h.SetLogger(noop.NewLogger[any]())
+
+	_, err := h.Events(newContext("/eth/v1/events?topics=head,chain_reorg"))
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	res, err := h.Events(
+		newContext("/eth/v1/events?topics=head,block&topics=blob_sidecar"),
+	)
+	require.NoError(t, err)
+	stream, ok := res.(types.EventStream)
+	require.True(t, ok)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	type sent struct{ event, data string }
+	sentCh := make(chan sent)
+	done := make(chan error)
+	go func() {
+		done <- stream.Stream(ctx, func(event string, data []byte) error {
+			sentCh <- sent{event, string(data)}
+			return nil
+		})
+	}()
+
+	// Events are published until the stream subscribed to the bus.
+	require.Eventually(t, func() bool {
+		bus.Publish(
+			events.TopicFinalizedCheckpoint, &events.FinalizedCheckpoint{},
+		)
+		bus.Publish(events.TopicBlock, &events.Block{Slot: 7})
+		select {
+		case got := <-sentCh:
+			require.Equal(t, "block", got.event)
+			require.JSONEq(t, `{
+				"slot": "7",
+				"block": "0x0000000000000000000000000000000000000000000000000000000000000000",
+				"execution_optimistic": false
+			}`, got.data)
+			return true
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	// Drain the events published before the stream was stopped.
+	for {
+		select {
+		case <-sentCh:
+			continue
+		case err = <-done:
+			require.NoError(t, err)
+			return
+		}
+	}
+}
@@ -21,19 +21,27 @@
 package events
 
 import (
+	"github.com/berachain/beacon-kit/beacon/events"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/server/context"
 )
 
+// Handler is the handler for the events API.
 type Handler[ContextT context.Context] struct {
 	*handlers.BaseHandler[ContextT]
+	bus *events.Bus
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
+// NewHandler creates a new handler for the events API, streaming the events
+// published on the given bus.
+func NewHandler[ContextT context.Context](
+	bus *events.Bus,
+) *Handler[ContextT] {
 	h := &Handler[ContextT]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
+		bus: bus,
 	}
 	return h
 }
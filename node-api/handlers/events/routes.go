@@ -25,6 +25,7 @@ import (
 
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers"
+	eventstypes "github.com/berachain/beacon-kit/node-api/handlers/events/types"
 )
 
 func (h *Handler[ContextT]) RegisterRoutes(
@@ -35,7 +36,8 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/events",
-			Handler: h.NotImplemented,
+			Handler: h.Events,
+			Request: eventstypes.EventsRequest{},
 		},
 	})
 }
@@ -35,6 +35,16 @@ type Handlers[ContextT any] interface {
 	RouteSet() *RouteSet[ContextT]
 }
 
+// Extension holds handlers contributed by add-ons. The routes of the
+// Extension values provided to the node are served by the node API.
+type Extension[ContextT any] struct {
+	Handlers[ContextT]
+}
+
+// IsManyPerContainerType marks Extension as a type which can be provided
+// multiple times.
+func (Extension[ContextT]) IsManyPerContainerType() {}
+
 // BaseHandler is a base handler for all handlers. It abstracts the route set
 // and logger from the handler.
 type BaseHandler[ContextT any] struct {
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keymanager
+
+import (
+	kmtypes "github.com/berachain/beacon-kit/node-api/handlers/keymanager/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/metadata"
+)
+
+// Backend is the interface for backend of the keymanager API.
+type Backend interface {
+	// Get returns the metadata of the given validator.
+	Get(pubkey crypto.BLSPubkey) (*metadata.Metadata, error)
+	// Update applies fn to the metadata of the given validator and stores
+	// the result.
+	Update(pubkey crypto.BLSPubkey, fn func(*metadata.Metadata)) error
+}
+
+// Performance reports on the performance of the local validators.
+type Performance interface {
+	// Summary returns the performance of the local validators over the
+	// recent slots.
+	Summary() *kmtypes.ValidatorPerformanceData
+}
+
+type Keystores interface {
+	// Pubkeys returns the public keys of the keys loaded from keystores.
+	Pubkeys() []crypto.BLSPubkey
+	// Import decrypts the given JSON encoded keystore with the given
+	// password and loads its key, returning its public key.
+	Import(keystore []byte, password string) (crypto.BLSPubkey, error)
+	// Delete unloads the given key and removes its keystore.
+	Delete(pubkey crypto.BLSPubkey) error
+}
+
+type SlashingProtection interface {
+	// Registered reports whether the given key has a history.
+	Registered(pubkey crypto.BLSPubkey) bool
+	// ImportInterchange imports the given JSON encoded EIP-3076
+	// interchange of the chain with the given genesis validators root.
+	ImportInterchange(genesisValidatorsRoot common.Root, bz []byte) error
+	// ExportInterchange exports the history of the given keys as a JSON
+	// encoded EIP-3076 interchange of the chain with the given genesis
+	// validators root.
+	ExportInterchange(
+		genesisValidatorsRoot common.Root,
+		pubkeys []crypto.BLSPubkey,
+	) ([]byte, error)
+}
+
+type Genesis interface {
+	// GenesisValidatorsRoot returns the genesis validators root of the
+	// beacon state at the given slot.
+	GenesisValidatorsRoot(slot math.Slot) (common.Root, error)
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keymanager
+
+import (
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/server/context"
+)
+
+// Handler is the handler for the keymanager API, which manages the
+// metadata of the validators run by the node.
+type Handler[
+	ContextT context.Context,
+] struct {
+	*handlers.BaseHandler[ContextT]
+	backend Backend
+	// performance reports on the performance of the local validators.
+	performance Performance
+	// keystores manages the keystores of the local validators.
+	keystores Keystores
+	// protection is the slashing protection database of the local
+	// validators.
+	protection SlashingProtection
+	// genesis identifies the chain the slashing protection history is
+	// exchanged for.
+	genesis Genesis
+}
+
+// NewHandler creates a new handler for the keymanager API.
+func NewHandler[
+	ContextT context.Context,
+](
+	backend Backend,
+	performance Performance,
+	keystores Keystores,
+	protection SlashingProtection,
+	genesis Genesis,
+) *Handler[ContextT] {
+	h := &Handler[ContextT]{
+		BaseHandler: handlers.NewBaseHandler(
+			handlers.NewRouteSet[ContextT](""),
+		),
+		backend:     backend,
+		performance: performance,
+		keystores:   keystores,
+		protection:  protection,
+		genesis:     genesis,
+	}
+	return h
+}
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keymanager
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	kmtypes "github.com/berachain/beacon-kit/node-api/handlers/keymanager/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/node-core/services/keystores"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// ListKeystores returns the keys loaded from keystores.
+func (h *Handler[ContextT]) ListKeystores(ContextT) (any, error) {
+	pubkeys := h.keystores.Pubkeys()
+	data := make([]kmtypes.KeystoreData, 0, len(pubkeys))
+	for _, pubkey := range pubkeys {
+		data = append(data, kmtypes.KeystoreData{ValidatingPubkey: pubkey})
+	}
+	return types.Wrap(data), nil
+}
+
+// ImportKeystores imports the slashing protection history of the request,
+// then the keystores, reporting the status of each. No keystore is imported
+// if the slashing protection history fails to be.
+func (h *Handler[ContextT]) ImportKeystores(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[kmtypes.ImportKeystoresRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Keystores) != len(req.Passwords) {
+		return nil, errors.Wrap(
+			types.ErrInvalidRequest, "one password is required per keystore",
+		)
+	}
+
+	statuses := make([]kmtypes.KeystoreStatus, len(req.Keystores))
+	if req.SlashingProtection != "" {
+		if err = h.importSlashingProtection(
+			req.SlashingProtection,
+		); err != nil {
+			for i := range statuses {
+				statuses[i] = kmtypes.KeystoreStatus{
+					Status:  kmtypes.StatusError,
+					Message: err.Error(),
+				}
+			}
+			return types.Wrap(statuses), nil
+		}
+	}
+	for i, keystore := range req.Keystores {
+		_, err = h.keystores.Import([]byte(keystore), req.Passwords[i])
+		switch {
+		case err == nil:
+			statuses[i].Status = kmtypes.StatusImported
+		case errors.Is(err, keystores.ErrDuplicateKey):
+			statuses[i].Status = kmtypes.StatusDuplicate
+		default:
+			statuses[i] = kmtypes.KeystoreStatus{
+				Status:  kmtypes.StatusError,
+				Message: err.Error(),
+			}
+		}
+	}
+	return types.Wrap(statuses), nil
+}
+
+// DeleteKeystores deletes the keystores of the keys of the request,
+// reporting the status of each along with the slashing protection history
+// of the keys deleted or not loaded.
+func (h *Handler[ContextT]) DeleteKeystores(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[kmtypes.DeleteKeystoresRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]kmtypes.KeystoreStatus, len(req.Pubkeys))
+	exported := make([]crypto.BLSPubkey, 0, len(req.Pubkeys))
+	for i, s := range req.Pubkeys {
+		var pubkey crypto.BLSPubkey
+		if pubkey, err = pubkeyFromString(s); err != nil {
+			return nil, err
+		}
+		err = h.keystores.Delete(pubkey)
+		switch {
+		case err == nil:
+			statuses[i].Status = kmtypes.StatusDeleted
+		case !errors.Is(err, keystores.ErrKeyNotFound):
+			statuses[i] = kmtypes.KeystoreStatus{
+				Status:  kmtypes.StatusError,
+				Message: err.Error(),
+			}
+			continue
+		case h.protection.Registered(pubkey):
+			statuses[i].Status = kmtypes.StatusNotActive
+		default:
+			statuses[i].Status = kmtypes.StatusNotFound
+			continue
+		}
+		exported = append(exported, pubkey)
+	}
+
+	genesisValidatorsRoot, err := h.genesis.GenesisValidatorsRoot(
+		utils.Genesis,
+	)
+	if err != nil {
+		return nil, err
+	}
+	interchange, err := h.protection.ExportInterchange(
+		genesisValidatorsRoot, exported,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return kmtypes.DeleteKeystoresResponse{
+		Data:               statuses,
+		SlashingProtection: string(interchange),
+	}, nil
+}
+
+// importSlashingProtection imports the given EIP-3076 interchange.
+func (h *Handler[ContextT]) importSlashingProtection(
+	interchange string,
+) error {
+	genesisValidatorsRoot, err := h.genesis.GenesisValidatorsRoot(
+		utils.Genesis,
+	)
+	if err != nil {
+		return err
+	}
+	return h.protection.ImportInterchange(
+		genesisValidatorsRoot, []byte(interchange),
+	)
+}
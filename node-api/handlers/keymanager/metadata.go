@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keymanager
+
+import (
+	stdbytes "bytes"
+
+	"github.com/berachain/beacon-kit/errors"
+	kmtypes "github.com/berachain/beacon-kit/node-api/handlers/keymanager/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/storage/metadata"
+)
+
+func (h *Handler[ContextT]) GetFeeRecipient(c ContextT) (any, error) {
+	pubkey, md, err := h.getMetadata(c)
+	if err != nil {
+		return nil, err
+	}
+	return types.Wrap(&kmtypes.FeeRecipientData{
+		Pubkey:     pubkey,
+		EthAddress: md.FeeRecipient,
+	}), nil
+}
+
+func (h *Handler[ContextT]) SetFeeRecipient(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[kmtypes.SetFeeRecipientRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	pubkey, err := pubkeyFromString(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	feeRecipient := common.NewExecutionAddressFromHex(req.EthAddress)
+	return nil, h.backend.Update(pubkey, func(md *metadata.Metadata) {
+		md.FeeRecipient = feeRecipient
+	})
+}
+
+func (h *Handler[ContextT]) DeleteFeeRecipient(c ContextT) (any, error) {
+	return nil, h.updateMetadata(c, func(md *metadata.Metadata) {
+		md.FeeRecipient = common.ExecutionAddress{}
+	})
+}
+
+func (h *Handler[ContextT]) GetGraffiti(c ContextT) (any, error) {
+	pubkey, md, err := h.getMetadata(c)
+	if err != nil {
+		return nil, err
+	}
+	return types.Wrap(&kmtypes.GraffitiData{
+		Pubkey:   pubkey,
+		Graffiti: string(stdbytes.TrimRight(md.Graffiti[:], "\x00")),
+	}), nil
+}
+
+func (h *Handler[ContextT]) SetGraffiti(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[kmtypes.SetGraffitiRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	pubkey, err := pubkeyFromString(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	graffiti, err := metadata.NewGraffiti(req.Graffiti)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+	return nil, h.backend.Update(pubkey, func(md *metadata.Metadata) {
+		md.Graffiti = graffiti
+	})
+}
+
+func (h *Handler[ContextT]) DeleteGraffiti(c ContextT) (any, error) {
+	return nil, h.updateMetadata(c, func(md *metadata.Metadata) {
+		md.Graffiti = common.Bytes32{}
+	})
+}
+
+func (h *Handler[ContextT]) GetGasLimit(c ContextT) (any, error) {
+	pubkey, md, err := h.getMetadata(c)
+	if err != nil {
+		return nil, err
+	}
+	return types.Wrap(&kmtypes.GasLimitData{
+		Pubkey:   pubkey,
+		GasLimit: md.GasLimit.Base10(),
+	}), nil
+}
+
+func (h *Handler[ContextT]) SetGasLimit(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[kmtypes.SetGasLimitRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	pubkey, err := pubkeyFromString(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	gasLimit, err := utils.U64FromString(req.GasLimit)
+	if err != nil {
+		return nil, types.ErrInvalidRequest
+	}
+	return nil, h.backend.Update(pubkey, func(md *metadata.Metadata) {
+		md.GasLimit = gasLimit
+	})
+}
+
+func (h *Handler[ContextT]) DeleteGasLimit(c ContextT) (any, error) {
+	return nil, h.updateMetadata(c, func(md *metadata.Metadata) {
+		md.GasLimit = 0
+	})
+}
+
+// getMetadata returns the metadata of the validator in the request path.
+func (h *Handler[ContextT]) getMetadata(
+	c ContextT,
+) (crypto.BLSPubkey, *metadata.Metadata, error) {
+	req, err := utils.BindAndValidate[kmtypes.PubkeyRequest](c, h.Logger())
+	if err != nil {
+		return crypto.BLSPubkey{}, nil, err
+	}
+	pubkey, err := pubkeyFromString(req.Pubkey)
+	if err != nil {
+		return crypto.BLSPubkey{}, nil, err
+	}
+	md, err := h.backend.Get(pubkey)
+	return pubkey, md, err
+}
+
+// updateMetadata applies fn to the metadata of the validator in the request
+// path.
+func (h *Handler[ContextT]) updateMetadata(
+	c ContextT,
+	fn func(*metadata.Metadata),
+) error {
+	req, err := utils.BindAndValidate[kmtypes.PubkeyRequest](c, h.Logger())
+	if err != nil {
+		return err
+	}
+	pubkey, err := pubkeyFromString(req.Pubkey)
+	if err != nil {
+		return err
+	}
+	return h.backend.Update(pubkey, fn)
+}
+
+func pubkeyFromString(s string) (crypto.BLSPubkey, error) {
+	var pubkey crypto.BLSPubkey
+	if err := pubkey.UnmarshalText([]byte(s)); err != nil {
+		return pubkey, types.ErrInvalidRequest
+	}
+	return pubkey, nil
+}
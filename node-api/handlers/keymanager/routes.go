@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keymanager
+
+import (
+	"net/http"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	kmtypes "github.com/berachain/beacon-kit/node-api/handlers/keymanager/types"
+)
+
+func (h *Handler[ContextT]) RegisterRoutes(
+	logger log.Logger,
+) {
+	h.SetLogger(logger)
+	h.BaseHandler.AddRoutes([]*handlers.Route[ContextT]{
+		{
+			Method:        http.MethodGet,
+			Path:          "/eth/v1/keystores",
+			Handler:       h.ListKeystores,
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodPost,
+			Path:          "/eth/v1/keystores",
+			Handler:       h.ImportKeystores,
+			Request:       kmtypes.ImportKeystoresRequest{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodDelete,
+			Path:          "/eth/v1/keystores",
+			Handler:       h.DeleteKeystores,
+			Request:       kmtypes.DeleteKeystoresRequest{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodGet,
+			Path:          "/eth/v1/validator/:pubkey/feerecipient",
+			Handler:       h.GetFeeRecipient,
+			Request:       kmtypes.PubkeyRequest{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodPost,
+			Path:          "/eth/v1/validator/:pubkey/feerecipient",
+			Handler:       h.SetFeeRecipient,
+			Request:       kmtypes.SetFeeRecipientRequest{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodDelete,
+			Path:          "/eth/v1/validator/:pubkey/feerecipient",
+			Handler:       h.DeleteFeeRecipient,
+			Request:       kmtypes.PubkeyRequest{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodGet,
+			Path:          "/eth/v1/validator/:pubkey/graffiti",
+			Handler:       h.GetGraffiti,
+			Request:       kmtypes.PubkeyRequest{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodPost,
+			Path:          "/eth/v1/validator/:pubkey/graffiti",
+			Handler:       h.SetGraffiti,
+			Request:       kmtypes.SetGraffitiRequest{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodDelete,
+			Path:          "/eth/v1/validator/:pubkey/graffiti",
+			Handler:       h.DeleteGraffiti,
+			Request:       kmtypes.PubkeyRequest{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodGet,
+			Path:          "/eth/v1/validator/:pubkey/gas_limit",
+			Handler:       h.GetGasLimit,
+			Request:       kmtypes.PubkeyRequest{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodPost,
+			Path:          "/eth/v1/validator/:pubkey/gas_limit",
+			Handler:       h.SetGasLimit,
+			Request:       kmtypes.SetGasLimitRequest{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodDelete,
+			Path:          "/eth/v1/validator/:pubkey/gas_limit",
+			Handler:       h.DeleteGasLimit,
+			Request:       kmtypes.PubkeyRequest{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodGet,
+			Path:          "/bkit/v1/validator/performance",
+			Handler:       h.Performance,
+			Authenticated: true,
+		},
+	})
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+type PubkeyRequest struct {
+	Pubkey string `param:"pubkey" validate:"required,pubkey"`
+}
+
+type SetFeeRecipientRequest struct {
+	PubkeyRequest
+	EthAddress string `json:"ethaddress" validate:"required,execution_address"`
+}
+
+type SetGraffitiRequest struct {
+	PubkeyRequest
+	Graffiti string `json:"graffiti"`
+}
+
+type SetGasLimitRequest struct {
+	PubkeyRequest
+	GasLimit string `json:"gas_limit" validate:"required,gas_limit"`
+}
+
+// ImportKeystoresRequest imports the EIP-2335 keystores, each decrypted with
+// the password of the same index, along with their optional EIP-3076
+// slashing protection history.
+type ImportKeystoresRequest struct {
+	Keystores          []string `json:"keystores"           validate:"required,dive,required"`
+	Passwords          []string `json:"passwords"           validate:"required"`
+	SlashingProtection string   `json:"slashing_protection"`
+}
+
+type DeleteKeystoresRequest struct {
+	Pubkeys []string `json:"pubkeys" validate:"required,dive,pubkey"`
+}
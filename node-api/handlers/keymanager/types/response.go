@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+type FeeRecipientData struct {
+	Pubkey     crypto.BLSPubkey        `json:"pubkey"`
+	EthAddress common.ExecutionAddress `json:"ethaddress"`
+}
+
+type GraffitiData struct {
+	Pubkey   crypto.BLSPubkey `json:"pubkey"`
+	Graffiti string           `json:"graffiti"`
+}
+
+type GasLimitData struct {
+	Pubkey   crypto.BLSPubkey `json:"pubkey"`
+	GasLimit string           `json:"gas_limit"`
+}
+
+// ValidatorPerformanceData is the performance of the local validators over
+// the recent slots.
+type ValidatorPerformanceData struct {
+	// Window is the number of recent slots the performance is computed over.
+	Window     uint64                 `json:"window,string"`
+	Validators []ValidatorPerformance `json:"validators"`
+}
+
+// ValidatorPerformance is the performance of a local validator, i.e. the
+// slots it was the proposer of.
+type ValidatorPerformance struct {
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+	// ValidatorIndex is unset until the validator was the proposer of a
+	// slot.
+	ValidatorIndex    *uint64 `json:"validator_index,omitempty,string"`
+	ProposalsExpected uint64  `json:"proposals_expected,string"`
+	ProposalsMade     uint64  `json:"proposals_made,string"`
+	ProposalsMissed   uint64  `json:"proposals_missed,string"`
+	// PayloadValueGwei is the total value of the payloads of the blocks
+	// made, as reported by the execution client which built them.
+	PayloadValueGwei uint64   `json:"payload_value_gwei,string"`
+	MissedSlots      []uint64 `json:"missed_slots"`
+	// LastProposalSlot is zero if the validator made no block.
+	LastProposalSlot uint64 `json:"last_proposal_slot,string"`
+}
+
+// KeystoreData is a key loaded from a keystore.
+type KeystoreData struct {
+	ValidatingPubkey crypto.BLSPubkey `json:"validating_pubkey"`
+	// DerivationPath is empty, it is not kept once loaded.
+	DerivationPath string `json:"derivation_path"`
+	ReadOnly       bool   `json:"readonly"`
+}
+
+// Statuses of the keystores imported and deleted.
+const (
+	StatusImported  = "imported"
+	StatusDuplicate = "duplicate"
+	StatusDeleted   = "deleted"
+	StatusNotActive = "not_active"
+	StatusNotFound  = "not_found"
+	StatusError     = "error"
+)
+
+// KeystoreStatus is the status of a keystore imported or deleted, with a
+// message explaining errors.
+type KeystoreStatus struct {
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// DeleteKeystoresResponse is the status of the keystores deleted, and the
+// EIP-3076 slashing protection history of their keys.
+type DeleteKeystoresResponse struct {
+	Data               []KeystoreStatus `json:"data"`
+	SlashingProtection string           `json:"slashing_protection"`
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	nodetypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+// Backend is the interface for the consensus backend of the node API.
+type Backend interface {
+	// LastBlockHeight returns the height of the latest committed block.
+	LastBlockHeight() int64
+	// BlocksBehind returns the number of blocks the node lags behind the
+	// highest block reported by its peers.
+	BlocksBehind() (uint64, error)
+	// NodeInfo returns the information the node advertises to its peers.
+	NodeInfo() (p2p.NodeInfo, error)
+	// Peers returns the peers the node is connected to.
+	Peers() ([]p2p.Peer, error)
+}
+
+// ExecutionEngine is the interface for the execution engine, reporting on
+// the status of the execution client.
+type ExecutionEngine interface {
+	// IsConnected returns whether the execution client is reachable.
+	IsConnected() bool
+	// IsSyncing returns whether the execution head is optimistic, i.e.
+	// whether the execution client is still syncing up to it.
+	IsSyncing() bool
+}
+
+// SyncStatus reports on the sync status of each part of the node.
+type SyncStatus interface {
+	// Status returns the sync status of the node.
+	Status() (*nodetypes.SyncStatusData, error)
+}
+
+// ChainHealth reports on the health of the chain.
+type ChainHealth interface {
+	// Summary returns the health of the chain over the recent slots.
+	Summary() *nodetypes.ChainHealthData
+}
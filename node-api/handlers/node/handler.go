@@ -25,15 +25,41 @@ import (
 	"github.com/berachain/beacon-kit/node-api/server/context"
 )
 
+// Handler is the handler for the node API.
 type Handler[ContextT context.Context] struct {
 	*handlers.BaseHandler[ContextT]
+	backend Backend
+	engine  ExecutionEngine
+	// syncStatus reports on the sync status of each part of the node.
+	syncStatus SyncStatus
+	// chainHealth reports on the health of the chain.
+	chainHealth ChainHealth
+	// version is the version of the node reported by the API.
+	version string
+	// maxBlocksBehind is the number of blocks the node may lag behind its
+	// peers without being considered syncing.
+	maxBlocksBehind uint64
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
+// NewHandler creates a new handler for the node API.
+func NewHandler[ContextT context.Context](
+	backend Backend,
+	engine ExecutionEngine,
+	syncStatus SyncStatus,
+	chainHealth ChainHealth,
+	version string,
+	maxBlocksBehind uint64,
+) *Handler[ContextT] {
 	h := &Handler[ContextT]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
+		backend:         backend,
+		engine:          engine,
+		syncStatus:      syncStatus,
+		chainHealth:     chainHealth,
+		version:         version,
+		maxBlocksBehind: maxBlocksBehind,
 	}
 	return h
 }
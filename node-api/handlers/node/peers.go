@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	nodetypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+const (
+	// peerStateConnected is the state of the peers of CometBFT, which only
+	// reports the peers it is connected to.
+	peerStateConnected = "connected"
+	peerDirectionIn    = "inbound"
+	peerDirectionOut   = "outbound"
+
+	// emptyAttnets and emptySyncnets are the bitvectors of the attestation
+	// and sync committee subnets, which CometBFT does not have.
+	emptyAttnets  = "0x0000000000000000"
+	emptySyncnets = "0x00"
+)
+
+// Identity returns the network identity of the node, as advertised by
+// CometBFT to its peers.
+func (h *Handler[ContextT]) Identity(ContextT) (any, error) {
+	info, err := h.backend.NodeInfo()
+	if err != nil {
+		return nil, err
+	}
+	var addresses []string
+	if addr := multiaddr(info.ID(), nodeListenAddr(info)); addr != "" {
+		addresses = append(addresses, addr)
+	}
+	return types.Wrap(&nodetypes.IdentityData{
+		PeerID:       string(info.ID()),
+		P2PAddresses: addresses,
+		// CometBFT discovers peers over its p2p connections.
+		DiscoveryAddresses: addresses,
+		Metadata: nodetypes.IdentityMetadata{
+			Attnets:  emptyAttnets,
+			Syncnets: emptySyncnets,
+		},
+	}), nil
+}
+
+// Peers returns the peers of the node matching the requested states and
+// directions.
+func (h *Handler[ContextT]) Peers(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[nodetypes.PeersRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	peers, err := h.backend.Peers()
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*nodetypes.PeerData, 0, len(peers))
+	for _, peer := range peers {
+		pd := peerData(peer)
+		if len(req.States) > 0 && !slices.Contains(req.States, pd.State) {
+			continue
+		}
+		if len(req.Directions) > 0 &&
+			!slices.Contains(req.Directions, pd.Direction) {
+			continue
+		}
+		data = append(data, pd)
+	}
+	return nodetypes.PeersResponse{
+		Data: data,
+		Meta: nodetypes.PeersMeta{Count: len(data)},
+	}, nil
+}
+
+// Peer returns the peer of the node with the given ID.
+func (h *Handler[ContextT]) Peer(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[nodetypes.PeerRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	peers, err := h.backend.Peers()
+	if err != nil {
+		return nil, err
+	}
+	for _, peer := range peers {
+		if string(peer.ID()) == req.PeerID {
+			return types.Wrap(peerData(peer)), nil
+		}
+	}
+	return nil, types.ErrNotFound
+}
+
+// PeerCount returns the number of peers of the node in each state.
+func (h *Handler[ContextT]) PeerCount(ContextT) (any, error) {
+	peers, err := h.backend.Peers()
+	if err != nil {
+		return nil, err
+	}
+	return types.Wrap(&nodetypes.PeerCountData{
+		Connected: uint64(len(peers)),
+	}), nil
+}
+
+// peerData translates the given CometBFT peer into a Beacon API peer.
+func peerData(peer p2p.Peer) *nodetypes.PeerData {
+	direction := peerDirectionIn
+	if peer.IsOutbound() {
+		direction = peerDirectionOut
+	}
+	var address string
+	if addr := peer.SocketAddr(); addr != nil {
+		address = multiaddr(peer.ID(), addr.DialString())
+	}
+	return &nodetypes.PeerData{
+		PeerID:             string(peer.ID()),
+		LastSeenP2PAddress: address,
+		State:              peerStateConnected,
+		Direction:          direction,
+	}
+}
+
+// nodeListenAddr returns the address the node advertises to its peers.
+func nodeListenAddr(info p2p.NodeInfo) string {
+	if defaultInfo, ok := info.(p2p.DefaultNodeInfo); ok {
+		return defaultInfo.ListenAddr
+	}
+	return ""
+}
+
+// multiaddr formats the given CometBFT address, e.g. tcp://1.2.3.4:26656, as
+// the multiaddr of the node with the given ID, the address format of the
+// Beacon API. It returns an empty string if the address is malformed.
+func multiaddr(id p2p.ID, addr string) string {
+	if _, rest, found := strings.Cut(addr, "://"); found {
+		addr = rest
+	}
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return ""
+	}
+	protocol := "dns"
+	if ip := net.ParseIP(host); ip != nil {
+		protocol = "ip6"
+		if ip.To4() != nil {
+			protocol = "ip4"
+		}
+	}
+	return fmt.Sprintf("/%s/%s/tcp/%s/p2p/%s", protocol, host, port, id)
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	echoengine "github.com/berachain/beacon-kit/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/node-api/handlers/node"
+	nodetypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// newPeersContext returns a request context with the given path and query,
+// the peer ID being the only path parameter.
+func newPeersContext(path, peerID string) echo.Context {
+	e := echo.New()
+	e.Validator = &echoengine.CustomValidator{
+		Validator: echoengine.ConstructValidator(),
+	}
+	c := e.NewContext(
+		httptest.NewRequest(http.MethodGet, path, nil),
+		httptest.NewRecorder(),
+	)
+	if peerID != "" {
+		c.SetParamNames("peer_id")
+		c.SetParamValues(peerID)
+	}
+	return c
+}
+
+func TestIdentity(t *testing.T) {
+	h := node.NewHandler[echo.Context](testBackend{
+		info: p2p.DefaultNodeInfo{
+			DefaultNodeID: "f00d",
+			ListenAddr:    "tcp://1.2.3.4:26656",
+		},
+	}, testEngine{}, nil, nil, "v1.0.0", 10)
+
+	got, err := h.Identity(nil)
+	require.NoError(t, err)
+	require.Equal(t, types.Wrap(&nodetypes.IdentityData{
+		PeerID:             "f00d",
+		P2PAddresses:       []string{"/ip4/1.2.3.4/tcp/26656/p2p/f00d"},
+		DiscoveryAddresses: []string{"/ip4/1.2.3.4/tcp/26656/p2p/f00d"},
+		Metadata: nodetypes.IdentityMetadata{
+			Attnets:  "0x0000000000000000",
+			Syncnets: "0x00",
+		},
+	}), got)
+}
+
+func TestPeers(t *testing.T) {
+	inbound := mock.NewPeer(net.IPv4(10, 0, 0, 1))
+	outbound := mock.NewPeer(net.IPv4(10, 0, 0, 2))
+	outbound.Outbound = true
+	h := node.NewHandler[echo.Context](testBackend{
+		peers: []p2p.Peer{inbound, outbound},
+	}, testEngine{}, nil, nil, "v1.0.0", 10)
+	h.SetLogger(noop.NewLogger[any]())
+
+	outboundData := &nodetypes.PeerData{
+		PeerID:             string(outbound.ID()),
+		LastSeenP2PAddress: "/ip4/10.0.0.2/tcp/26656/p2p/" + string(outbound.ID()),
+		State:              "connected",
+		Direction:          "outbound",
+	}
+
+	got, err := h.Peers(newPeersContext("/eth/v1/node/peers", ""))
+	require.NoError(t, err)
+	require.Len(t, got.(nodetypes.PeersResponse).Data, 2)
+
+	got, err = h.Peers(newPeersContext(
+		"/eth/v1/node/peers?state=connected&direction=outbound", "",
+	))
+	require.NoError(t, err)
+	require.Equal(t, nodetypes.PeersResponse{
+		Data: []*nodetypes.PeerData{outboundData},
+		Meta: nodetypes.PeersMeta{Count: 1},
+	}, got)
+
+	got, err = h.Peers(newPeersContext("/eth/v1/node/peers?state=connecting", ""))
+	require.NoError(t, err)
+	require.Empty(t, got.(nodetypes.PeersResponse).Data)
+
+	_, err = h.Peers(newPeersContext("/eth/v1/node/peers?direction=up", ""))
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	got, err = h.Peer(newPeersContext(
+		"/eth/v1/node/peers/"+string(outbound.ID()), string(outbound.ID()),
+	))
+	require.NoError(t, err)
+	require.Equal(t, types.Wrap(outboundData), got)
+
+	_, err = h.Peer(newPeersContext("/eth/v1/node/peers/unknown", "unknown"))
+	require.ErrorIs(t, err, types.ErrNotFound)
+
+	got, err = h.PeerCount(nil)
+	require.NoError(t, err)
+	require.Equal(t, types.Wrap(&nodetypes.PeerCountData{Connected: 2}), got)
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers"
+	nodetypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
 )
 
 func (h *Handler[ContextT]) RegisterRoutes(
@@ -35,22 +36,24 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/identity",
-			Handler: h.NotImplemented,
+			Handler: h.Identity,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/peers",
-			Handler: h.NotImplemented,
+			Handler: h.Peers,
+			Request: nodetypes.PeersRequest{},
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/peers/:peer_id",
-			Handler: h.NotImplemented,
+			Handler: h.Peer,
+			Request: nodetypes.PeerRequest{},
 		},
 		{
 			Method:  http.MethodGet,
-			Path:    "/eth/v1/node/peers/peer_count",
-			Handler: h.NotImplemented,
+			Path:    "/eth/v1/node/peer_count",
+			Handler: h.PeerCount,
 		},
 		{
 			Method:  http.MethodGet,
@@ -65,7 +68,18 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/health",
-			Handler: h.NotImplemented,
+			Handler: h.Health,
+			Request: nodetypes.HealthRequest{},
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/bkit/v1/node/sync_status",
+			Handler: h.SyncStatus,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/bkit/v1/node/chain_health",
+			Handler: h.ChainHealth,
 		},
 	})
 }
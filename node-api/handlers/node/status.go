@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"fmt"
+	"net/http"
+	"runtime"
+
+	nodetypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// Syncing returns the sync status of the consensus and execution layers.
+func (h *Handler[ContextT]) Syncing(ContextT) (any, error) {
+	data, err := h.syncingData()
+	if err != nil {
+		return nil, err
+	}
+	return types.Wrap(data), nil
+}
+
+// SyncStatus returns the detailed sync status of the node, telling which of
+// its parts are still catching up.
+func (h *Handler[ContextT]) SyncStatus(ContextT) (any, error) {
+	data, err := h.syncStatus.Status()
+	if err != nil {
+		return nil, err
+	}
+	return types.Wrap(data), nil
+}
+
+// ChainHealth returns the health of the chain over the recent slots, i.e.
+// the missed slots and their proposers, the empty payloads, the blobs and
+// the finality lag.
+func (h *Handler[ContextT]) ChainHealth(ContextT) (any, error) {
+	return types.Wrap(h.chainHealth.Summary()), nil
+}
+
+// Health returns the health of the node as a status code: 200 once synced,
+// 206 (or the requested syncing status) while syncing, and 503 if the node
+// or its execution client is down.
+func (h *Handler[ContextT]) Health(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[nodetypes.HealthRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	data, err := h.syncingData()
+	switch {
+	case err != nil, data.ELOffline:
+		return types.StatusResponse{Code: http.StatusServiceUnavailable}, nil
+	case data.IsSyncing && req.SyncingStatus != 0:
+		return types.StatusResponse{Code: req.SyncingStatus}, nil
+	case data.IsSyncing:
+		return types.StatusResponse{Code: http.StatusPartialContent}, nil
+	default:
+		return types.StatusResponse{Code: http.StatusOK}, nil
+	}
+}
+
+// Version returns the version of the node.
+func (h *Handler[ContextT]) Version(ContextT) (any, error) {
+	return types.Wrap(nodetypes.VersionData{
+		Version: fmt.Sprintf(
+			"beacon-kit/%s (%s %s)", h.version, runtime.GOOS, runtime.GOARCH,
+		),
+	}), nil
+}
+
+// syncingData returns the sync status of the node. The node is syncing while
+// it lags too far behind its peers, or while its execution head is optimistic,
+// i.e. not validated by the syncing execution client yet.
+func (h *Handler[ContextT]) syncingData() (*nodetypes.SyncingData, error) {
+	behind, err := h.backend.BlocksBehind()
+	if err != nil {
+		return nil, err
+	}
+	optimistic := h.engine.IsSyncing()
+	return &nodetypes.SyncingData{
+		//#nosec:G115 // heights are never negative.
+		HeadSlot:     uint64(h.backend.LastBlockHeight()),
+		SyncDistance: behind,
+		IsSyncing:    behind > h.maxBlocksBehind || optimistic,
+		IsOptimistic: optimistic,
+		ELOffline:    !h.engine.IsConnected(),
+	}, nil
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log/noop"
+	echoengine "github.com/berachain/beacon-kit/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/node-api/handlers/node"
+	nodetypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+type testBackend struct {
+	height int64
+	behind uint64
+	err    error
+	info   p2p.NodeInfo
+	peers  []p2p.Peer
+}
+
+func (b testBackend) LastBlockHeight() int64 { return b.height }
+
+func (b testBackend) BlocksBehind() (uint64, error) { return b.behind, b.err }
+
+func (b testBackend) NodeInfo() (p2p.NodeInfo, error) { return b.info, b.err }
+
+func (b testBackend) Peers() ([]p2p.Peer, error) { return b.peers, b.err }
+
+type testEngine struct {
+	connected bool
+	syncing   bool
+}
+
+func (e testEngine) IsConnected() bool { return e.connected }
+
+func (e testEngine) IsSyncing() bool { return e.syncing }
+
+func TestSyncing(t *testing.T) {
+	h := node.NewHandler[echo.Context](
+		testBackend{height: 42, behind: 5},
+		testEngine{connected: true, syncing: true},
+		nil,
+		nil,
+		"v1.0.0", 10,
+	)
+	got, err := h.Syncing(nil)
+	require.NoError(t, err)
+	require.Equal(t, types.Wrap(&nodetypes.SyncingData{
+		HeadSlot:     42,
+		SyncDistance: 5,
+		IsSyncing:    true,
+		IsOptimistic: true,
+		ELOffline:    false,
+	}), got)
+}
+
+func TestHealth(t *testing.T) {
+	tests := []struct {
+		name    string
+		backend testBackend
+		engine  testEngine
+		query   string
+		want    int
+	}{
+		{
+			name:   "synced",
+			engine: testEngine{connected: true},
+			want:   http.StatusOK,
+		},
+		{
+			name:    "behind peers",
+			backend: testBackend{behind: 11},
+			engine:  testEngine{connected: true},
+			want:    http.StatusPartialContent,
+		},
+		{
+			name:   "execution client syncing",
+			engine: testEngine{connected: true, syncing: true},
+			query:  "?syncing_status=200",
+			want:   http.StatusOK,
+		},
+		{
+			name:   "execution client offline",
+			engine: testEngine{},
+			want:   http.StatusServiceUnavailable,
+		},
+		{
+			name:    "node not running",
+			backend: testBackend{err: errors.New("not running")},
+			engine:  testEngine{connected: true},
+			want:    http.StatusServiceUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := node.NewHandler[echo.Context](
+				tt.backend, tt.engine, nil, nil, "v1.0.0", 10,
+			)
+			h.SetLogger(noop.NewLogger[any]())
+
+			e := echo.New()
+			e.Validator = &echoengine.CustomValidator{
+				Validator: echoengine.ConstructValidator(),
+			}
+			req := httptest.NewRequest(
+				http.MethodGet, "/eth/v1/node/health"+tt.query, nil,
+			)
+			c := e.NewContext(req, httptest.NewRecorder())
+
+			got, err := h.Health(c)
+			require.NoError(t, err)
+			require.Equal(t, types.StatusResponse{Code: tt.want}, got)
+		})
+	}
+}
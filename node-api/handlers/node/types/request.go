@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+// HealthRequest is the request for the `/eth/v1/node/health` endpoint.
+type HealthRequest struct {
+	// SyncingStatus overrides the status code returned while syncing.
+	SyncingStatus int `query:"syncing_status" validate:"omitempty,min=100,max=599"`
+}
+
+// PeersRequest is the request for the `/eth/v1/node/peers` endpoint.
+type PeersRequest struct {
+	//nolint:lll // struct tags.
+	States     []string `query:"state"     validate:"dive,oneof=disconnected connecting connected disconnecting"`
+	Directions []string `query:"direction" validate:"dive,oneof=inbound outbound"`
+}
+
+// PeerRequest is the request for the `/eth/v1/node/peers/{peer_id}` endpoint.
+type PeerRequest struct {
+	PeerID string `param:"peer_id" validate:"required"`
+}
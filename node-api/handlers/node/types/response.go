@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+// SyncingData is the sync status of the node.
+type SyncingData struct {
+	HeadSlot     uint64 `json:"head_slot,string"`
+	SyncDistance uint64 `json:"sync_distance,string"`
+	IsSyncing    bool   `json:"is_syncing"`
+	IsOptimistic bool   `json:"is_optimistic"`
+	ELOffline    bool   `json:"el_offline"`
+}
+
+// SyncStatusData is the detailed sync status of the node, telling which of
+// its parts are still catching up.
+type SyncStatusData struct {
+	// Synced is set once no part of the node is catching up.
+	Synced    bool                `json:"synced"`
+	Consensus ConsensusSyncStatus `json:"consensus"`
+	Execution ExecutionSyncStatus `json:"execution"`
+	Deposits  DepositsSyncStatus  `json:"deposits"`
+}
+
+// ConsensusSyncStatus is the progress of the CometBFT block sync.
+type ConsensusSyncStatus struct {
+	HeadSlot     uint64 `json:"head_slot,string"`
+	SyncDistance uint64 `json:"sync_distance,string"`
+	IsSyncing    bool   `json:"is_syncing"`
+}
+
+// ExecutionSyncStatus is the sync status of the execution client.
+type ExecutionSyncStatus struct {
+	ELOffline    bool `json:"el_offline"`
+	IsOptimistic bool `json:"is_optimistic"`
+}
+
+// DepositsSyncStatus is the progress of the deposits backfill, i.e. of the
+// execution blocks whose deposits failed to be fetched and are retried.
+type DepositsSyncStatus struct {
+	PendingBlocks uint64 `json:"pending_blocks,string"`
+	// OldestPendingBlock is zero if no block is pending.
+	OldestPendingBlock uint64 `json:"oldest_pending_block,string"`
+}
+
+// ChainHealthData is the health of the chain over the recent slots.
+type ChainHealthData struct {
+	// HeadSlot is the latest slot finalized, with or without a block.
+	HeadSlot uint64 `json:"head_slot,string"`
+	// Window is the number of recent slots the health is computed over.
+	Window        uint64 `json:"window,string"`
+	MissedSlots   uint64 `json:"missed_slots,string"`
+	EmptyPayloads uint64 `json:"empty_payloads,string"`
+	// BlobCounts are the number of blobs of the blocks within the window,
+	// oldest first.
+	BlobCounts []uint64 `json:"blob_counts"`
+	// FinalityLagMs is the time since the latest slot was finalized.
+	FinalityLagMs uint64 `json:"finality_lag_ms,string"`
+	// ProposerMisses are the validators which missed slots within the
+	// window, by decreasing number of misses.
+	ProposerMisses []ProposerMisses `json:"proposer_misses"`
+}
+
+// ProposerMisses is the number of slots a validator missed.
+type ProposerMisses struct {
+	ValidatorIndex uint64 `json:"validator_index,string"`
+	MissedSlots    uint64 `json:"missed_slots,string"`
+}
+
+// VersionData is the version of the node.
+type VersionData struct {
+	Version string `json:"version"`
+}
+
+// IdentityData is the network identity of the node. CometBFT has no ENR nor
+// attestation subnets, hence those are left empty.
+type IdentityData struct {
+	PeerID             string           `json:"peer_id"`
+	ENR                string           `json:"enr"`
+	P2PAddresses       []string         `json:"p2p_addresses"`
+	DiscoveryAddresses []string         `json:"discovery_addresses"`
+	Metadata           IdentityMetadata `json:"metadata"`
+}
+
+// IdentityMetadata is the metadata the node advertises to its peers.
+type IdentityMetadata struct {
+	SeqNumber uint64 `json:"seq_number,string"`
+	Attnets   string `json:"attnets"`
+	Syncnets  string `json:"syncnets"`
+}
+
+// PeerData is a peer of the node.
+type PeerData struct {
+	PeerID             string `json:"peer_id"`
+	ENR                string `json:"enr"`
+	LastSeenP2PAddress string `json:"last_seen_p2p_address"`
+	State              string `json:"state"`
+	Direction          string `json:"direction"`
+}
+
+// PeersResponse is the response for the `/eth/v1/node/peers` endpoint.
+type PeersResponse struct {
+	Data []*PeerData `json:"data"`
+	Meta PeersMeta   `json:"meta"`
+}
+
+// PeersMeta describes the peers of a PeersResponse.
+type PeersMeta struct {
+	Count int `json:"count"`
+}
+
+// PeerCountData is the number of peers of the node in each state.
+type PeerCountData struct {
+	Disconnected  uint64 `json:"disconnected,string"`
+	Connecting    uint64 `json:"connecting,string"`
+	Connected     uint64 `json:"connected,string"`
+	Disconnecting uint64 `json:"disconnecting,string"`
+}
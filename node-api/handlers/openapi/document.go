@@ -0,0 +1,261 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package openapi
+
+import (
+	"encoding"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+)
+
+const (
+	// errorSchema is the name of the schema of error responses.
+	errorSchema = "ErrorResponse"
+	// bearerAuth is the name of the security scheme of authenticated
+	// routes.
+	bearerAuth = "bearerAuth"
+	// mimeJSON is the media type of request and response bodies.
+	mimeJSON = "application/json"
+)
+
+var (
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+	jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+)
+
+// New returns the OpenAPI document describing the routes of the given route
+// sets. The parameters of a route are documented if it sets its request
+// type.
+func New[ContextT any](
+	title, version string, sets ...*handlers.RouteSet[ContextT],
+) *Document {
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    Info{Title: title, Version: version},
+		Paths:   make(map[string]map[string]*Operation),
+		Components: Components{
+			Schemas: map[string]*Schema{
+				errorSchema: {
+					Type: "object",
+					Properties: map[string]*Schema{
+						"code":    {Type: "integer"},
+						"message": {Type: "string"},
+					},
+					Required: []string{"code", "message"},
+				},
+			},
+			SecuritySchemes: map[string]*SecurityScheme{
+				bearerAuth: {Type: "http", Scheme: "bearer"},
+			},
+		},
+	}
+	for _, set := range sets {
+		for _, route := range set.Routes {
+			path, params := pathTemplate(set.BasePath + route.Path)
+			if doc.Paths[path] == nil {
+				doc.Paths[path] = make(map[string]*Operation)
+			}
+			doc.Paths[path][strings.ToLower(route.Method)] = newOperation(
+				route.Method, params, route.Request, route.Authenticated,
+			)
+		}
+	}
+	return doc
+}
+
+// pathTemplate converts the echo path into an OpenAPI path template,
+// returning the names of its parameters.
+func pathTemplate(path string) (string, []string) {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	var params []string
+	for i, segment := range segments {
+		if name, ok := strings.CutPrefix(segment, ":"); ok {
+			segments[i] = "{" + name + "}"
+			params = append(params, name)
+		}
+	}
+	return "/" + strings.Join(segments, "/"), params
+}
+
+// newOperation returns the operation of a route with the given method, path
+// parameters and request type.
+func newOperation(
+	method string, params []string, request any, authenticated bool,
+) *Operation {
+	op := &Operation{
+		Responses: map[string]*Response{
+			"200": {Description: "Success"},
+			"400": errorResponse("Invalid request"),
+			"404": errorResponse("Not found"),
+			"500": errorResponse("Internal error"),
+		},
+	}
+	for _, name := range params {
+		op.Parameters = append(op.Parameters, &Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+	if authenticated {
+		op.Security = []map[string][]string{{bearerAuth: {}}}
+		op.Responses["401"] = errorResponse("Unauthorized")
+	}
+	if request != nil {
+		addRequest(op, method, reflect.TypeOf(request))
+	}
+	return op
+}
+
+// addRequest documents the query parameters and the body of the request
+// type on the operation.
+func addRequest(op *Operation, method string, t reflect.Type) {
+	body := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	walkFields(t, func(f reflect.StructField) {
+		required := slices.Contains(
+			strings.Split(f.Tag.Get("validate"), ","), "required",
+		)
+		if name := f.Tag.Get("query"); name != "" {
+			op.Parameters = append(op.Parameters, &Parameter{
+				Name:     name,
+				In:       "query",
+				Required: required,
+				Schema:   querySchema(f.Type),
+			})
+		}
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			return
+		}
+		body.Properties[name] = schemaOf(f.Type, opts == "string")
+		if required {
+			body.Required = append(body.Required, name)
+		}
+	})
+
+	// Request bodies are only bound for methods other than GET.
+	switch {
+	case method == http.MethodGet:
+	case reflect.PointerTo(t).Implements(jsonUnmarshalerType):
+		// The body has a custom encoding.
+		op.RequestBody = jsonBody(&Schema{})
+	case len(body.Properties) > 0:
+		op.RequestBody = jsonBody(body)
+	}
+}
+
+// walkFields calls fn on the fields of the struct type, including the fields
+// of embedded structs.
+func walkFields(t reflect.Type, fn func(reflect.StructField)) {
+	if t.Kind() != reflect.Struct {
+		return
+	}
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			walkFields(f.Type, fn)
+			continue
+		}
+		fn(f)
+	}
+}
+
+// querySchema returns the schema of a query parameter of the given type.
+// Repeated parameters are arrays, all values are strings.
+func querySchema(t reflect.Type) *Schema {
+	if t.Kind() == reflect.Slice {
+		return &Schema{Type: "array", Items: &Schema{Type: "string"}}
+	}
+	return &Schema{Type: "string"}
+}
+
+// schemaOf returns the schema of the JSON encoding of the given type.
+// Numbers are encoded as strings if asString is set.
+func schemaOf(t reflect.Type, asString bool) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Implements(textMarshalerType) {
+		return &Schema{Type: "string"}
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16,
+		reflect.Uint32, reflect.Uint64:
+		if asString {
+			return &Schema{Type: "string"}
+		}
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string"}
+		}
+		return &Schema{Type: "array", Items: schemaOf(t.Elem(), false)}
+	case reflect.Struct:
+		schema := &Schema{
+			Type:       "object",
+			Properties: make(map[string]*Schema),
+		}
+		walkFields(t, func(f reflect.StructField) {
+			name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if name != "" && name != "-" {
+				schema.Properties[name] = schemaOf(f.Type, opts == "string")
+			}
+		})
+		return schema
+	case reflect.Map:
+		return &Schema{Type: "object"}
+	default:
+		return &Schema{}
+	}
+}
+
+// errorResponse returns a response carrying an error.
+func errorResponse(description string) *Response {
+	return &Response{
+		Description: description,
+		Content: map[string]*MediaType{
+			mimeJSON: {Schema: &Schema{
+				Ref: "#/components/schemas/" + errorSchema,
+			}},
+		},
+	}
+}
+
+// jsonBody returns a required JSON request body of the given schema.
+func jsonBody(schema *Schema) *RequestBody {
+	return &RequestBody{
+		Required: true,
+		Content:  map[string]*MediaType{mimeJSON: {Schema: schema}},
+	}
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package openapi_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+type testRequest struct {
+	StateID string   `param:"state_id" validate:"required"`
+	IDs     []string `query:"id"`
+}
+
+type testBody struct {
+	Pubkey   string `json:"pubkey"           param:"pubkey"`
+	GasLimit uint64 `json:"gas_limit,string" validate:"required"`
+}
+
+func TestNew(t *testing.T) {
+	doc := openapi.New("test", "v1.0.0", handlers.NewRouteSet("",
+		&handlers.Route[any]{
+			Method:  http.MethodGet,
+			Path:    "/states/:state_id/validators",
+			Request: testRequest{},
+		},
+		&handlers.Route[any]{
+			Method:        http.MethodPost,
+			Path:          "keys/:pubkey/gas_limit",
+			Request:       testBody{},
+			Authenticated: true,
+		},
+	))
+	require.Equal(t, openapi.Version, doc.OpenAPI)
+	require.Len(t, doc.Paths, 2)
+
+	get := doc.Paths["/states/{state_id}/validators"]["get"]
+	require.NotNil(t, get)
+	require.Nil(t, get.RequestBody)
+	require.Nil(t, get.Security)
+	require.Len(t, get.Parameters, 2)
+	require.Equal(t, &openapi.Parameter{
+		Name:     "state_id",
+		In:       "path",
+		Required: true,
+		Schema:   &openapi.Schema{Type: "string"},
+	}, get.Parameters[0])
+	require.Equal(t, "id", get.Parameters[1].Name)
+	require.Equal(t, "query", get.Parameters[1].In)
+	require.Equal(t, "array", get.Parameters[1].Schema.Type)
+	require.Contains(t, get.Responses, "400")
+	require.NotContains(t, get.Responses, "401")
+
+	post := doc.Paths["/keys/{pubkey}/gas_limit"]["post"]
+	require.NotNil(t, post)
+	require.Len(t, post.Parameters, 1)
+	require.Contains(t, post.Responses, "401")
+	require.Len(t, post.Security, 1)
+	body := post.RequestBody.Content["application/json"].Schema
+	require.Equal(t, "object", body.Type)
+	require.Equal(t, []string{"gas_limit"}, body.Required)
+	require.Equal(t, "string", body.Properties["pubkey"].Type)
+	// Numbers encoded as strings are documented as strings.
+	require.Equal(t, "string", body.Properties["gas_limit"].Type)
+}
@@ -22,6 +22,7 @@ package proof
 
 import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
 	"github.com/berachain/beacon-kit/primitives/math"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 )
@@ -30,6 +31,7 @@ import (
 type Backend interface {
 	BlockBackend
 	StateBackend
+	FinalityBackend
 	GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
 }
 
@@ -37,6 +39,15 @@ type BlockBackend interface {
 	BlockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader, error)
 }
 
+// FinalityBackend is the interface for the backend of the finality proofs.
+type FinalityBackend interface {
+	// FinalityProofAtHeight returns the finality proof of the beacon block
+	// committed at the given height, or nil if it is no longer available.
+	FinalityProofAtHeight(
+		height math.Slot,
+	) (*types.FinalityProofResponse, error)
+}
+
 type StateBackend interface {
 	StateFromSlotForProof(slot math.Slot) (*statedb.StateDB, math.Slot, error)
 }
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proof
+
+import (
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// GetFinalityProof returns the proof that the beacon block at the given height
+// was finalized by CometBFT, for external systems to trust its root without
+// running a node. See types.FinalityProofResponse for how to verify it.
+func (h *Handler[ContextT]) GetFinalityProof(c ContextT) (any, error) {
+	params, err := utils.BindAndValidate[types.FinalityProofRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	height, err := utils.U64FromString(params.Height)
+	if err != nil {
+		return nil, err
+	}
+	if height == 0 {
+		// Genesis is not committed by CometBFT.
+		return nil, handlertypes.ErrNotFound
+	}
+
+	proof, err := h.backend.FinalityProofAtHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	if proof == nil {
+		return nil, handlertypes.ErrNotFound
+	}
+	return proof, nil
+}
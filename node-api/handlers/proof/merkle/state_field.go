@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"math"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/merkle"
+)
+
+// ErrGIndexNotInState is returned when the requested generalized index does
+// not point to a node of the beacon state tree.
+var ErrGIndexNotInState = errors.New(
+	"generalized index is not in the beacon state",
+)
+
+// ProveStateFieldInBlock generates a proof for the node at the given
+// generalized index of the beacon state in the beacon block. The proof is then
+// verified against the beacon block root as a sanity check. Returns the proof
+// of the node in the state, the combined proof of the node in the block, the
+// node itself and the beacon block root. It uses the fastssz library to
+// generate the proofs.
+func ProveStateFieldInBlock[
+	BeaconStateMarshallableT types.BeaconStateMarshallable,
+](
+	bbh *ctypes.BeaconBlockHeader,
+	bs types.BeaconState[BeaconStateMarshallableT],
+	gIndex merkle.GeneralizedIndex,
+) ([]common.Root, []common.Root, common.Root, common.Root, error) {
+	// Get the proof of the node in the beacon state.
+	fieldInStateProof, leaf, err := ProveStateFieldInState(bs, gIndex)
+	if err != nil {
+		return nil, nil, common.Root{}, common.Root{}, err
+	}
+
+	// Then get the proof of the beacon state in the beacon block.
+	stateInBlockProof, err := ProveBeaconStateInBlock(bbh, false)
+	if err != nil {
+		return nil, nil, common.Root{}, common.Root{}, err
+	}
+
+	// Sanity check that the combined proof verifies against our beacon root.
+	combinedProof := make(
+		[]common.Root, 0, len(fieldInStateProof)+len(stateInBlockProof),
+	)
+	combinedProof = append(combinedProof, fieldInStateProof...)
+	combinedProof = append(combinedProof, stateInBlockProof...)
+	beaconRoot, err := verifyStateFieldInBlock(
+		bbh, StateFieldGIndexInBlock(gIndex), combinedProof, leaf,
+	)
+	if err != nil {
+		return nil, nil, common.Root{}, common.Root{}, err
+	}
+
+	return fieldInStateProof, combinedProof, leaf, beaconRoot, nil
+}
+
+// ProveStateFieldInState generates a proof for the node at the given
+// generalized index of the beacon state, returning the proof along with the
+// node. It uses the fastssz library to generate the proof.
+func ProveStateFieldInState[
+	BeaconStateMarshallableT types.BeaconStateMarshallable,
+](
+	bs types.BeaconState[BeaconStateMarshallableT],
+	gIndex merkle.GeneralizedIndex,
+) ([]common.Root, common.Root, error) {
+	if gIndex == 0 || gIndex.Unwrap() > math.MaxInt64 {
+		return nil, common.Root{}, ErrGIndexNotInState
+	}
+
+	bsm, err := bs.GetMarshallable()
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+	stateProofTree, err := bsm.GetTree()
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	// Proving an index below a leaf of the tree panics, unlike looking it up.
+	//
+	//#nosec:G701 // checked against math.MaxInt64 above.
+	index := int(gIndex)
+	if _, err = stateProofTree.Get(index); err != nil {
+		return nil, common.Root{}, ErrGIndexNotInState
+	}
+	fieldInStateProof, err := stateProofTree.Prove(index)
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	proof := make([]common.Root, len(fieldInStateProof.Hashes))
+	for i, hash := range fieldInStateProof.Hashes {
+		proof[i] = common.NewRootFromBytes(hash)
+	}
+	return proof, common.NewRootFromBytes(fieldInStateProof.Leaf), nil
+}
+
+// StateFieldGIndexInBlock returns the generalized index in the beacon block of
+// the node at the given generalized index of the beacon state.
+func StateFieldGIndexInBlock(
+	gIndex merkle.GeneralizedIndex,
+) merkle.GeneralizedIndex {
+	return merkle.GeneralizedIndices{StateGIndexDenebBlock, gIndex}.Concat()
+}
+
+// verifyStateFieldInBlock verifies the beacon state node in the beacon
+// block, returning the beacon block root used to verify against.
+func verifyStateFieldInBlock(
+	bbh *ctypes.BeaconBlockHeader,
+	gIndex merkle.GeneralizedIndex,
+	proof []common.Root,
+	leaf common.Root,
+) (common.Root, error) {
+	beaconRoot := bbh.HashTreeRoot()
+	if beaconRootVerified, err := merkle.VerifyProof(
+		gIndex, leaf, proof, beaconRoot,
+	); err != nil {
+		return common.Root{}, err
+	} else if !beaconRootVerified {
+		return common.Root{}, errors.Wrapf(
+			errors.New("proof failed to verify against beacon root"),
+			"beacon root: 0x%x", beaconRoot[:],
+		)
+	}
+
+	return beaconRoot, nil
+}
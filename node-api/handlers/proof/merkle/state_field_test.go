@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle/mock"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	sszmerkle "github.com/berachain/beacon-kit/primitives/encoding/ssz/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStateFieldProof tests the ProveStateFieldInBlock function against the
+// proofs of the fields that have a dedicated proof.
+func TestStateFieldProof(t *testing.T) {
+	vals := make(types.Validators, 100)
+	for i := range vals {
+		vals[i] = &types.Validator{}
+	}
+	vals[95] = &types.Validator{Pubkey: crypto.BLSPubkey{9, 8, 7, 6, 5}}
+	bs, err := mock.NewBeaconState(5, vals, 69420, common.ExecutionAddress{})
+	require.NoError(t, err)
+	bbh := (&types.BeaconBlockHeader{}).New(
+		5, 95, common.Root{1, 2, 3}, bs.HashTreeRoot(), common.Root{3, 2, 1},
+	)
+
+	testCases := []struct {
+		name           string
+		gIndex         sszmerkle.GeneralizedIndex
+		expectedGIndex sszmerkle.GeneralizedIndex
+		expectedProof  func() ([]common.Root, common.Root, error)
+	}{
+		{
+			name:           "Execution Number",
+			gIndex:         merkle.ExecutionNumberGIndexDenebState,
+			expectedGIndex: merkle.ExecutionNumberGIndexDenebBlock,
+			expectedProof: func() ([]common.Root, common.Root, error) {
+				return merkle.ProveExecutionNumberInBlock(bbh, bs)
+			},
+		},
+		{
+			name: "Validator Pubkey",
+			gIndex: merkle.ZeroValidatorPubkeyGIndexDenebState +
+				merkle.ValidatorPubkeyGIndexOffset*95,
+			expectedGIndex: merkle.ZeroValidatorPubkeyGIndexDenebBlock +
+				merkle.ValidatorPubkeyGIndexOffset*95,
+			expectedProof: func() ([]common.Root, common.Root, error) {
+				return merkle.ProveProposerPubkeyInBlock(bbh, bs)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stateProof, blockProof, leaf, beaconRoot, err :=
+				merkle.ProveStateFieldInBlock(bbh, bs, tc.gIndex)
+			require.NoError(t, err)
+
+			expectedProof, expectedRoot, err := tc.expectedProof()
+			require.NoError(t, err)
+			require.Equal(t, expectedProof, blockProof)
+			require.Equal(t, expectedRoot, beaconRoot)
+			require.Equal(
+				t, tc.expectedGIndex, merkle.StateFieldGIndexInBlock(tc.gIndex),
+			)
+
+			verified, err := sszmerkle.VerifyProof(
+				tc.gIndex, leaf, stateProof, bs.HashTreeRoot(),
+			)
+			require.NoError(t, err)
+			require.True(t, verified)
+		})
+	}
+
+	// Indices below the leaves of the state tree are not part of it.
+	_, _, _, _, err = merkle.ProveStateFieldInBlock(
+		bbh, bs, merkle.ExecutionNumberGIndexDenebState<<1,
+	)
+	require.ErrorIs(t, err, merkle.ErrGIndexNotInState)
+	_, _, _, _, err = merkle.ProveStateFieldInBlock(bbh, bs, 0)
+	require.ErrorIs(t, err, merkle.ErrGIndexNotInState)
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
 )
 
 func (h *Handler[ContextT]) RegisterRoutes(logger log.Logger) {
@@ -34,16 +35,31 @@ func (h *Handler[ContextT]) RegisterRoutes(logger log.Logger) {
 			Method:  http.MethodGet,
 			Path:    "bkit/v1/proof/block_proposer/:timestamp_id",
 			Handler: h.GetBlockProposer,
+			Request: types.BlockProposerRequest{},
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "bkit/v1/proof/execution_number/:timestamp_id",
 			Handler: h.GetExecutionNumber,
+			Request: types.ExecutionNumberRequest{},
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "bkit/v1/proof/execution_fee_recipient/:timestamp_id",
 			Handler: h.GetExecutionFeeRecipient,
+			Request: types.ExecutionFeeRecipientRequest{},
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/state/:timestamp_id/:gindex",
+			Handler: h.GetStateField,
+			Request: types.StateFieldRequest{},
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/finality/:height",
+			Handler: h.GetFinalityProof,
+			Request: types.FinalityProofRequest{},
 		},
 	})
 }
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proof
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	sszmerkle "github.com/berachain/beacon-kit/primitives/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// GetStateField returns the node at the given generalized index of the beacon
+// state for the given timestamp id, e.g. a validator record or a balance,
+// along with merkle proofs that can be verified against the state root and
+// the beacon block root.
+func (h *Handler[ContextT]) GetStateField(c ContextT) (any, error) {
+	params, err := utils.BindAndValidate[types.StateFieldRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	index, err := strconv.ParseUint(params.GIndex, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	gIndex := sszmerkle.GeneralizedIndex(index)
+	slot, beaconState, blockHeader, err := h.resolveTimestampID(
+		params.TimestampID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	h.Logger().Info(
+		"Generating state field proofs", "slot", slot, "gindex", gIndex,
+	)
+
+	stateProof, blockProof, leaf, beaconBlockRoot, err :=
+		merkle.ProveStateFieldInBlock(blockHeader, beaconState, gIndex)
+	if errors.Is(err, merkle.ErrGIndexNotInState) {
+		return nil, fmt.Errorf("%w: %w", handlertypes.ErrInvalidRequest, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return types.StateFieldResponse{
+		BeaconBlockHeader: blockHeader,
+		BeaconBlockRoot:   beaconBlockRoot,
+		StateRoot:         blockHeader.GetStateRoot(),
+		Leaf:              leaf,
+		StateProof:        stateProof,
+		BeaconBlockGIndex: math.U64(merkle.StateFieldGIndexInBlock(gIndex)),
+		BeaconBlockProof:  blockProof,
+	}, nil
+}
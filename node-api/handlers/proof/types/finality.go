@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"bytes"
+
+	"github.com/berachain/beacon-kit/consensus-types/registry"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	bkbytes "github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+var (
+	// ErrUntrustedValidatorSet is returned when the validator set of a
+	// finality proof is not the one trusted by the verifier.
+	ErrUntrustedValidatorSet = errors.New("untrusted validator set")
+	// ErrInvalidFinalityProof is returned when a finality proof does not
+	// bind the beacon block to the CometBFT commit.
+	ErrInvalidFinalityProof = errors.New("invalid finality proof")
+)
+
+// FinalityProofResponse is the response for the `/proof/finality/{height}`
+// endpoint. It is a self-contained package for external systems, such as
+// bridges, to trust the root of the beacon block finalized at a height
+// without running a node.
+//
+// The CometBFT light block and the proof of the beacon block are encoded as
+// the cometbft.types.v1.LightBlock and cometbft.types.v1.TxProof protobuf
+// messages. Verifying the package amounts to:
+//  1. checking the validator set of the light block is a trusted one;
+//  2. checking the commit of the light block is signed by more than 2/3 of
+//     the voting power of the validator set;
+//  3. checking the beacon block proof against the data hash of the header;
+//  4. checking the hash tree root of the beacon block, the data of the
+//     proof, is BeaconBlockRoot.
+type FinalityProofResponse struct {
+	// Height is the CometBFT height, i.e. the slot, of the beacon block.
+	Height math.U64 `json:"height"`
+
+	// ForkVersion is the fork version to decode the beacon block with.
+	ForkVersion common.Version `json:"fork_version"`
+
+	// BeaconBlockHeader is the block header of which the hash tree root is the
+	// beacon block root.
+	BeaconBlockHeader *ctypes.BeaconBlockHeader `json:"beacon_block_header"`
+
+	// BeaconBlockRoot is the root of the finalized beacon block.
+	BeaconBlockRoot common.Root `json:"beacon_block_root"`
+
+	// LightBlock is the CometBFT signed header and the validator set that
+	// signed it.
+	LightBlock bkbytes.Bytes `json:"light_block"`
+
+	// BeaconBlockProof is the proof of the beacon block transaction in the
+	// data hash of the CometBFT header.
+	BeaconBlockProof bkbytes.Bytes `json:"beacon_block_proof"`
+}
+
+// NewFinalityProofResponse returns the finality proof of the given beacon
+// block, committed in the given CometBFT light block.
+func NewFinalityProofResponse(
+	blk *ctypes.BeaconBlock,
+	lightBlock *cmttypes.LightBlock,
+	beaconBlockProof *cmttypes.TxProof,
+) (*FinalityProofResponse, error) {
+	lbProto, err := lightBlock.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	lb, err := lbProto.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	proofProto := beaconBlockProof.ToProto()
+	proof, err := proofProto.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return &FinalityProofResponse{
+		Height:            blk.GetSlot(),
+		ForkVersion:       version.FromUint32[common.Version](blk.Version()),
+		BeaconBlockHeader: blk.GetHeader(),
+		BeaconBlockRoot:   blk.HashTreeRoot(),
+		LightBlock:        lb,
+		BeaconBlockProof:  proof,
+	}, nil
+}
+
+// Verify verifies the finality proof for the given CometBFT chain ID, the
+// validator set of the light block having the given trusted hash. It returns
+// the verified light block.
+func (r *FinalityProofResponse) Verify(
+	chainID string,
+	trustedValidatorsHash []byte,
+) (*cmttypes.LightBlock, error) {
+	lightBlock, err := r.decodeLightBlock(chainID)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(lightBlock.ValidatorsHash, trustedValidatorsHash) {
+		return nil, ErrUntrustedValidatorSet
+	}
+
+	commit := lightBlock.Commit
+	if err = lightBlock.ValidatorSet.VerifyCommitLight(
+		chainID, commit.BlockID, lightBlock.Height, commit,
+	); err != nil {
+		return nil, errors.Join(ErrInvalidFinalityProof, err)
+	}
+
+	var proofProto cmtproto.TxProof
+	if err = proofProto.Unmarshal(r.BeaconBlockProof); err != nil {
+		return nil, errors.Join(ErrInvalidFinalityProof, err)
+	}
+	proof, err := cmttypes.TxProofFromProto(proofProto)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidFinalityProof, err)
+	}
+	if err = proof.Validate(lightBlock.DataHash); err != nil {
+		return nil, errors.Join(ErrInvalidFinalityProof, err)
+	}
+
+	// Any transaction decoding to a beacon block of the expected root binds
+	// it, whatever its index in the block.
+	blk, err := registry.Default.BeaconBlocks.Unmarshal(
+		version.ToUint32(r.ForkVersion), proof.Data,
+	)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidFinalityProof, err)
+	}
+	root := blk.HashTreeRoot()
+	if root != r.BeaconBlockRoot ||
+		r.BeaconBlockHeader.HashTreeRoot() != root {
+		return nil, errors.Wrap(
+			ErrInvalidFinalityProof, "beacon block root mismatch",
+		)
+	}
+	return lightBlock, nil
+}
+
+// decodeLightBlock decodes the light block of the proof, checking it is a
+// well formed light block of the given chain at the height of the proof.
+func (r *FinalityProofResponse) decodeLightBlock(
+	chainID string,
+) (*cmttypes.LightBlock, error) {
+	var lbProto cmtproto.LightBlock
+	if err := lbProto.Unmarshal(r.LightBlock); err != nil {
+		return nil, errors.Join(ErrInvalidFinalityProof, err)
+	}
+	lightBlock, err := cmttypes.LightBlockFromProto(&lbProto)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidFinalityProof, err)
+	}
+	if err = lightBlock.ValidateBasic(chainID); err != nil {
+		return nil, errors.Join(ErrInvalidFinalityProof, err)
+	}
+	//#nosec:G701 // not an issue in practice.
+	if lightBlock.Height != int64(r.Height) {
+		return nil, errors.Wrap(
+			ErrInvalidFinalityProof, "light block height mismatch",
+		)
+	}
+	return lightBlock, nil
+}
@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = "beacond-test"
+
+// finalizedBlock commits a beacon block at its slot in a CometBFT block signed
+// by a random validator set, returning its finality proof and the validator
+// set.
+func finalizedBlock(
+	t *testing.T,
+) (*types.FinalityProofResponse, *cmttypes.ValidatorSet) {
+	t.Helper()
+	blk := &ctypes.BeaconBlock{
+		Slot:          7,
+		ProposerIndex: 1,
+		ParentRoot:    common.Root{1, 2, 3},
+		StateRoot:     common.Root{3, 2, 1},
+		Body: &ctypes.BeaconBlockBody{
+			ExecutionPayload: &ctypes.ExecutionPayload{
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: &ctypes.Eth1Data{},
+		},
+	}
+	bz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	vals, privVals := cmttypes.RandValidatorSet(4, 10)
+	height := int64(blk.GetSlot())
+	cmtBlock := cmttypes.MakeBlock(
+		height, []cmttypes.Tx{bz, []byte("sidecars")}, &cmttypes.Commit{}, nil,
+	)
+	cmtBlock.Version = cmtversion.Consensus{Block: 11}
+	cmtBlock.ChainID = testChainID
+	cmtBlock.Time = time.Now()
+	cmtBlock.ValidatorsHash = vals.Hash()
+	cmtBlock.NextValidatorsHash = vals.Hash()
+	cmtBlock.ProposerAddress = vals.GetProposer().Address
+	parts, err := cmtBlock.MakePartSet(cmttypes.BlockPartSizeBytes)
+	require.NoError(t, err)
+	blockID := cmttypes.BlockID{
+		Hash:          cmtBlock.Hash(),
+		PartSetHeader: parts.Header(),
+	}
+
+	voteSet := cmttypes.NewVoteSet(
+		testChainID, height, 0, cmttypes.PrecommitType, vals,
+	)
+	extCommit, err := cmttypes.MakeExtCommit(
+		blockID, height, 0, voteSet, privVals, time.Now(), false,
+	)
+	require.NoError(t, err)
+
+	lightBlock := &cmttypes.LightBlock{
+		SignedHeader: &cmttypes.SignedHeader{
+			Header: &cmtBlock.Header,
+			Commit: extCommit.ToCommit(),
+		},
+		ValidatorSet: vals,
+	}
+	txProof := cmtBlock.Txs.Proof(0)
+	proof, err := types.NewFinalityProofResponse(blk, lightBlock, &txProof)
+	require.NoError(t, err)
+	return proof, vals
+}
+
+func TestFinalityProofVerify(t *testing.T) {
+	proof, vals := finalizedBlock(t)
+	lightBlock, err := proof.Verify(testChainID, vals.Hash())
+	require.NoError(t, err)
+	require.Equal(t, int64(proof.Height), lightBlock.Height)
+	require.Equal(t, proof.BeaconBlockHeader.HashTreeRoot(), proof.BeaconBlockRoot)
+}
+
+func TestFinalityProofVerifyUntrustedValidatorSet(t *testing.T) {
+	proof, _ := finalizedBlock(t)
+	other, _ := cmttypes.RandValidatorSet(4, 10)
+	_, err := proof.Verify(testChainID, other.Hash())
+	require.ErrorIs(t, err, types.ErrUntrustedValidatorSet)
+}
+
+func TestFinalityProofVerifyInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		chainID string
+		tamper  func(*types.FinalityProofResponse)
+	}{
+		{
+			name:    "wrong chain",
+			chainID: "other-chain",
+			tamper:  func(*types.FinalityProofResponse) {},
+		},
+		{
+			name: "wrong root",
+			tamper: func(p *types.FinalityProofResponse) {
+				p.BeaconBlockRoot = common.Root{0xff}
+			},
+		},
+		{
+			name: "wrong height",
+			tamper: func(p *types.FinalityProofResponse) {
+				p.Height++
+			},
+		},
+		{
+			name: "corrupted light block",
+			tamper: func(p *types.FinalityProofResponse) {
+				p.LightBlock = p.LightBlock[:len(p.LightBlock)/2]
+			},
+		},
+		{
+			name: "missing beacon block proof",
+			tamper: func(p *types.FinalityProofResponse) {
+				p.BeaconBlockProof = nil
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proof, vals := finalizedBlock(t)
+			tt.tamper(proof)
+			chainID := testChainID
+			if tt.chainID != "" {
+				chainID = tt.chainID
+			}
+			_, err := proof.Verify(chainID, vals.Hash())
+			require.ErrorIs(t, err, types.ErrInvalidFinalityProof)
+		})
+	}
+}
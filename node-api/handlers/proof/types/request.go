@@ -39,3 +39,16 @@ type ExecutionNumberRequest struct {
 type ExecutionFeeRecipientRequest struct {
 	types.TimestampIDRequest
 }
+
+// StateFieldRequest is the request for the
+// `/proof/state/{timestamp_id}/{gindex}` endpoint.
+type StateFieldRequest struct {
+	types.TimestampIDRequest
+	GIndex string `param:"gindex" validate:"required,uint64"`
+}
+
+// FinalityProofRequest is the request for the `/proof/finality/{height}`
+// endpoint.
+type FinalityProofRequest struct {
+	Height string `param:"height" validate:"required,uint64"`
+}
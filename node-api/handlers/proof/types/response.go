@@ -88,3 +88,33 @@ type ExecutionFeeRecipientResponse struct {
 	// using a Generalized Index of 5894 in the Deneb fork.
 	ExecutionFeeRecipientProof []common.Root `json:"execution_fee_recipient_proof"`
 }
+
+// StateFieldResponse is the response for the
+// `/proof/state/{timestamp_id}/{gindex}` endpoint.
+type StateFieldResponse struct {
+	// BeaconBlockHeader is the block header of which the hash tree root is the
+	// beacon block root to verify against.
+	BeaconBlockHeader *ctypes.BeaconBlockHeader `json:"beacon_block_header"`
+
+	// BeaconBlockRoot is the beacon block root for this slot.
+	BeaconBlockRoot common.Root `json:"beacon_block_root"`
+
+	// StateRoot is the root of the beacon state the proofs are generated from.
+	StateRoot common.Root `json:"state_root"`
+
+	// Leaf is the node at the requested Generalized Index of the beacon state.
+	Leaf common.Root `json:"leaf"`
+
+	// StateProof can be verified against the state root using the requested
+	// Generalized Index.
+	StateProof []common.Root `json:"state_proof"`
+
+	// BeaconBlockGIndex is the Generalized Index of the leaf in the beacon
+	// block, i.e. the requested Generalized Index concatenated to the one of
+	// the beacon state in the beacon block.
+	BeaconBlockGIndex math.U64 `json:"beacon_block_gindex"`
+
+	// BeaconBlockProof can be verified against the beacon block root using
+	// BeaconBlockGIndex.
+	BeaconBlockProof []common.Root `json:"beacon_block_proof"`
+}
@@ -21,6 +21,9 @@
 package handlers
 
 import (
+	"context"
+	"net/http"
+
 	"github.com/berachain/beacon-kit/log"
 )
 
@@ -29,6 +32,12 @@ type Route[ContextT any] struct {
 	Method  string
 	Path    string
 	Handler handlerFn[ContextT]
+	// Authenticated routes require the bearer token configured for the
+	// node API.
+	Authenticated bool
+	// Request is a zero value of the request type bound by the handler,
+	// documenting the parameters of the route in the OpenAPI spec.
+	Request any
 }
 
 // DecorateWithLogs adds logging to the route's handler function as soon as
@@ -36,16 +45,26 @@ type Route[ContextT any] struct {
 func (r *Route[ContextT]) DecorateWithLogs(logger log.Logger) {
 	handler := r.Handler
 	r.Handler = func(ctx ContextT) (any, error) {
-		logger.Info("received request", "method", r.Method, "path", r.Path)
+		reqLogger := log.WithContext(logger, requestContext(ctx))
+		reqLogger.Info("received request", "method", r.Method, "path", r.Path)
 		res, err := handler(ctx)
 		if err != nil {
-			logger.Error("error handling request", "error", err)
+			reqLogger.Error("error handling request", "error", err)
 		}
-		logger.Info("request handled", "response", res)
+		reqLogger.Info("request handled", "response", res)
 		return res, err
 	}
 }
 
+// requestContext returns the context of the HTTP request handled, carrying
+// its correlation identifier, if the handler context exposes it.
+func requestContext(ctx any) context.Context {
+	if c, ok := ctx.(interface{ Request() *http.Request }); ok {
+		return c.Request().Context()
+	}
+	return context.Background()
+}
+
 // RouteSet is a set of routes for the node API.
 type RouteSet[ContextT any] struct {
 	BasePath string
@@ -61,3 +80,21 @@ func NewRouteSet[ContextT any](
 		Routes:   routes,
 	}
 }
+
+// unauthenticatedListenerKey marks the context of the requests received at a
+// listener the authenticated routes are not served at.
+type unauthenticatedListenerKey struct{}
+
+// WithoutAuthenticatedRoutes returns a copy of ctx, the base context of the
+// requests received at a listener, denying these requests the authenticated
+// routes.
+func WithoutAuthenticatedRoutes(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unauthenticatedListenerKey{}, true)
+}
+
+// ServesAuthenticatedRoutes reports whether the authenticated routes are
+// served to the request with the given context.
+func ServesAuthenticatedRoutes(ctx context.Context) bool {
+	denied, _ := ctx.Value(unauthenticatedListenerKey{}).(bool)
+	return !denied
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// ExitQueueData is a validator pending exit, as returned by the
+// `/staking/states/{state_id}/exit_queue` endpoint. Estimated times are unix
+// timestamps in seconds. They are approximations, assuming blocks keep being
+// produced at the average consensus block time observed since genesis, and
+// are zero if no block was produced yet, or if the node no longer holds the
+// consensus block of the state.
+type ExitQueueData struct {
+	Index                     uint64           `json:"index,string"`
+	Pubkey                    crypto.BLSPubkey `json:"pubkey"`
+	Slashed                   bool             `json:"slashed"`
+	ExitEpoch                 uint64           `json:"exit_epoch,string"`
+	WithdrawableEpoch         uint64           `json:"withdrawable_epoch,string"`
+	EstimatedExitTime         uint64           `json:"estimated_exit_time,string"`
+	EstimatedWithdrawableTime uint64           `json:"estimated_withdrawable_time,string"`
+}
+
+// PendingDepositsData is the queue of deposits returned by the
+// `/staking/states/{state_id}/pending_deposits` endpoint. Deposits are
+// ordered by index, while FailedBlocks lists the execution blocks whose
+// deposits failed to be fetched and are being retried.
+type PendingDepositsData struct {
+	Deposits     ctypes.Deposits `json:"deposits"`
+	FailedBlocks []uint64        `json:"failed_blocks"`
+}
@@ -31,3 +31,10 @@ type BlockIDRequest struct {
 type TimestampIDRequest struct {
 	TimestampID string `param:"timestamp_id" validate:"required,timestamp_id"`
 }
+
+// PaginationRequest selects a page of a list response. A zero limit returns
+// every item from the offset onwards.
+type PaginationRequest struct {
+	Offset uint64 `json:"offset" query:"offset"`
+	Limit  uint64 `json:"limit"  query:"limit"`
+}
@@ -20,6 +20,8 @@
 
 package types
 
+import "context"
+
 type DataResponse struct {
 	Data any `json:"data"`
 }
@@ -29,3 +31,34 @@ func Wrap(data any) DataResponse {
 		Data: data,
 	}
 }
+
+// StatusResponse is a response carrying no data, only an HTTP status code.
+type StatusResponse struct {
+	Code int
+}
+
+// EventStream is a response streamed to the client as server-sent events.
+// Stream writes events through send until the context is done.
+type EventStream struct {
+	Stream func(
+		ctx context.Context,
+		send func(event string, data []byte) error,
+	) error
+}
+
+// SSZMarshaler is implemented by data that can be SSZ encoded.
+type SSZMarshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+// SSZResponse is a response served SSZ encoded to clients preferring
+// application/octet-stream, and as JSON otherwise.
+type SSZResponse struct {
+	// Version is the name of the fork the data belongs to, reported in the
+	// Eth-Consensus-Version header.
+	Version string
+	// JSON is the response served to JSON clients.
+	JSON any
+	// SSZ is the data served to SSZ clients.
+	SSZ SSZMarshaler
+}
@@ -28,6 +28,9 @@ const (
 	StateIDJustified  = "justified"
 	StateIDHead       = "head"
 	TimestampIDPrefix = "t"
+	// ExecutionHashIDPrefix prefixes the execution block hashes identifying
+	// the beacon blocks carrying them.
+	ExecutionHashIDPrefix = "e"
 )
 
 const (
@@ -21,6 +21,7 @@
 package utils
 
 import (
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/node-api/server/context"
@@ -33,11 +34,20 @@ func BindAndValidate[RequestT any, ContextT context.Context](
 ) (RequestT, error) {
 	var req RequestT
 	if err := c.Bind(&req); err != nil {
-		return req, types.ErrInvalidRequest
+		return req, invalidRequest(err)
 	}
 	if err := c.Validate(&req); err != nil {
-		return req, types.ErrInvalidRequest
+		return req, invalidRequest(err)
 	}
 	logger.Info("Request validation successful", "params", req)
 	return req, nil
 }
+
+// invalidRequest marks the given bind or validation error as an invalid
+// request, keeping its message for the error response.
+func invalidRequest(err error) error {
+	if errors.Is(err, types.ErrInvalidRequest) {
+		return err
+	}
+	return errors.Wrap(types.ErrInvalidRequest, err.Error())
+}
@@ -55,14 +55,27 @@ func SlotFromStateID[StorageBackendT interface {
 // SlotFromBlockID returns a slot from the block ID.
 //
 // NOTE: `blockID` shares the same semantics as `stateID`, with the modification
-// of being able to query by beacon <blockRoot> instead of <stateRoot>.
+// of being able to query by beacon <blockRoot> instead of <stateRoot>. Blocks
+// can also be queried by the <executionHash> of their payload, prefixed by
+// 'e', e.g. 'e0x1f...'.
 func SlotFromBlockID[StorageBackendT interface {
 	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
+	GetSlotByExecutionHash(hash common.ExecutionHash) (math.Slot, error)
 }](blockID string, storage StorageBackendT) (math.Slot, error) {
 	if slot, err := slotFromStateID(blockID); err == nil {
 		return slot, nil
 	}
 
+	if IsExecutionHashIDPrefix(blockID) {
+		var hash common.ExecutionHash
+		if err := hash.UnmarshalText([]byte(blockID[1:])); err != nil {
+			return 0, errors.Wrapf(
+				err, "failed to parse execution hash from blockID: %s", blockID,
+			)
+		}
+		return storage.GetSlotByExecutionHash(hash)
+	}
+
 	// We assume that the block ID is a block hash.
 	root, err := common.NewRootFromHex(blockID)
 	if err != nil {
@@ -106,6 +119,12 @@ func IsTimestampIDPrefix(timestampID string) bool {
 	return strings.HasPrefix(timestampID, TimestampIDPrefix)
 }
 
+// IsExecutionHashIDPrefix checks if the given blockID is prefixed with the
+// execution hash prefix 'e'.
+func IsExecutionHashIDPrefix(blockID string) bool {
+	return strings.HasPrefix(blockID, ExecutionHashIDPrefix)
+}
+
 // U64FromString returns a math.U64 from the given string. Errors if the given
 // string is not in proper decimal notation.
 func U64FromString(id string) (math.U64, error) {
@@ -20,8 +20,11 @@
 
 package server
 
+import "time"
+
 const (
-	defaultAddress = "127.0.0.1:3500"
+	defaultAddress         = "127.0.0.1:3500"
+	defaultShutdownTimeout = 10 * time.Second
 )
 
 // Config is the configuration for the node API server.
@@ -30,15 +33,61 @@ type Config struct {
 	Enabled bool `mapstructure:"enabled"`
 	// Address is the address to bind the node API server to.
 	Address string `mapstructure:"address"`
+	// ExtraAddresses are the addresses the node API server is also bound
+	// to, e.g. to serve both an IPv4 and an IPv6 interface.
+	ExtraAddresses []string `mapstructure:"extra-addresses"`
+	// AuthenticatedAddress is the address the authenticated routes, e.g.
+	// the keymanager API, are served at. If set, they are only served at
+	// this address, and not at Address and ExtraAddresses.
+	AuthenticatedAddress string `mapstructure:"authenticated-address"`
+	// ShutdownTimeout bounds the time waited for the requests in flight to
+	// complete when the node API server is stopped.
+	ShutdownTimeout time.Duration `mapstructure:"shutdown-timeout"`
+	// CORSAllowedOrigins are the origins allowed to make cross-origin
+	// requests, "*" allowing any. Cross-origin requests are denied if empty.
+	CORSAllowedOrigins []string `mapstructure:"cors-allowed-origins"`
+	// TLSCertFile and TLSKeyFile are the paths to the PEM encoded
+	// certificate and private key the node API server is served with over
+	// TLS. The node API server is served over plain HTTP if unset.
+	TLSCertFile string `mapstructure:"tls-cert-file"`
+	TLSKeyFile  string `mapstructure:"tls-key-file"`
+	// TLSClientCAFile is the path to the PEM encoded certificates of the
+	// CAs client certificates must be signed by. Clients are not required
+	// to present a certificate if unset.
+	TLSClientCAFile string `mapstructure:"tls-client-ca-file"`
 	// Logging is the flag to enable API logging.
 	Logging bool `mapstructure:"logging"`
+	// AuthTokenFile is the path to the file holding the bearer token
+	// required by authenticated routes. Authenticated routes are
+	// disabled if unset.
+	AuthTokenFile string `mapstructure:"auth-token-file"`
+	// RateLimit is the number of requests per second allowed per IP
+	// address of the clients not authenticated with the bearer token.
+	// Requests are not limited if zero.
+	RateLimit float64 `mapstructure:"rate-limit"`
+	// TokenRateLimit is the number of requests per second allowed to the
+	// clients authenticated with the bearer token. Requests are not
+	// limited if zero.
+	TokenRateLimit float64 `mapstructure:"token-rate-limit"`
 }
 
 // DefaultConfig returns the default configuration for the node API server.
 func DefaultConfig() Config {
 	return Config{
-		Enabled: false,
-		Address: defaultAddress,
-		Logging: false,
+		Enabled:              false,
+		Address:              defaultAddress,
+		ExtraAddresses:       []string{},
+		AuthenticatedAddress: "",
+		ShutdownTimeout:      defaultShutdownTimeout,
+		// Any origin is allowed, for browser based explorers to query
+		// public nodes.
+		CORSAllowedOrigins: []string{"*"},
+		TLSCertFile:        "",
+		TLSKeyFile:         "",
+		TLSClientCAFile:    "",
+		Logging:            false,
+		AuthTokenFile:      "",
+		RateLimit:          0,
+		TokenRateLimit:     0,
 	}
 }
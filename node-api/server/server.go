@@ -22,6 +22,11 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"time"
 
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/log/noop"
@@ -29,6 +34,9 @@ import (
 	apicontext "github.com/berachain/beacon-kit/node-api/server/context"
 )
 
+// readHeaderTimeout bounds the time to read request headers.
+const readHeaderTimeout = 10 * time.Second
+
 // Server is the API Server service.
 type Server[
 	ContextT apicontext.Context,
@@ -36,6 +44,11 @@ type Server[
 	engine Engine[ContextT]
 	config Config
 	logger log.Logger
+
+	// servers serve the engine at each of the configured addresses.
+	servers []*http.Server
+	// cancelRequests cancels the context of the requests being served.
+	cancelRequests context.CancelFunc
 }
 
 // New initializes a new API Server with the given config, engine, and logger.
@@ -64,32 +77,106 @@ func New[
 	}
 }
 
-// Start starts the API Server at the configured address.
+// Start binds the API Server to the configured addresses and serves the
+// engine at each of them, over TLS if configured. The authenticated routes
+// are only served at the authenticated address if one is configured. It
+// errors if any of the addresses cannot be bound.
 func (s *Server[_]) Start(ctx context.Context) error {
 	if !s.config.Enabled {
 		return nil
 	}
-	go s.start(ctx)
+	tlsConfig, err := s.config.tlsConfig()
+	if err != nil {
+		return err
+	}
+
+	// The context of requests outlives ctx, it is cancelled on Stop.
+	var requestsCtx context.Context
+	requestsCtx, s.cancelRequests = context.WithCancel(context.Background())
+	publicCtx := requestsCtx
+	if s.config.AuthenticatedAddress != "" {
+		publicCtx = handlers.WithoutAuthenticatedRoutes(requestsCtx)
+		if err = s.serve(
+			ctx, s.config.AuthenticatedAddress, tlsConfig, requestsCtx,
+		); err != nil {
+			return errors.Join(err, s.Stop())
+		}
+	}
+	addrs := append([]string{s.config.Address}, s.config.ExtraAddresses...)
+	for _, addr := range addrs {
+		if err = s.serve(ctx, addr, tlsConfig, publicCtx); err != nil {
+			return errors.Join(err, s.Stop())
+		}
+	}
 	return nil
 }
 
-func (s *Server[_]) start(ctx context.Context) {
-	errCh := make(chan error)
+// serve binds the given address and serves the engine at it, the requests
+// received having the given base context.
+func (s *Server[_]) serve(
+	ctx context.Context,
+	addr string,
+	tlsConfig *tls.Config,
+	baseCtx context.Context,
+) error {
+	var lc net.ListenConfig
+	ln, err := lc.Listen(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	if tlsConfig != nil {
+		ln = tls.NewListener(ln, tlsConfig)
+	}
+
+	srv := &http.Server{
+		Handler:           s.engine,
+		ReadHeaderTimeout: readHeaderTimeout,
+		BaseContext: func(net.Listener) context.Context {
+			return baseCtx
+		},
+	}
+	s.servers = append(s.servers, srv)
 	go func() {
-		errCh <- s.engine.Run(s.config.Address)
-	}()
-	for {
-		select {
-		case err := <-errCh:
-			s.logger.Error(err.Error())
-		case <-ctx.Done():
-			return
+		serveErr := srv.Serve(ln)
+		if !errors.Is(serveErr, http.ErrServerClosed) {
+			s.logger.Error(
+				"Node API server failed",
+				"address", ln.Addr(), "err", serveErr,
+			)
 		}
-	}
+	}()
+	s.logger.Info("Node API server started", "address", ln.Addr())
+	return nil
 }
 
+// Stop stops accepting connections and waits for the requests in flight to
+// complete, up to the configured shutdown timeout, before closing the
+// connections left. The context of the requests is cancelled first, for event
+// streams, which never complete, to end.
 func (s *Server[_]) Stop() error {
-	return nil
+	if s.cancelRequests == nil {
+		return nil
+	}
+	s.cancelRequests()
+	s.cancelRequests = nil
+	ctx, cancel := context.WithTimeout(
+		context.Background(), s.config.ShutdownTimeout,
+	)
+	defer cancel()
+
+	var errs []error
+	for _, srv := range s.servers {
+		if err := srv.Shutdown(ctx); err != nil {
+			s.logger.Warn(
+				"Node API requests did not complete in time, "+
+					"closing their connections",
+				"err", err,
+			)
+			errs = append(errs, srv.Close())
+		}
+	}
+	s.servers = nil
+	return errors.Join(errs...)
 }
 
 // Name returns the name of the API server service.
@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/server"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// testEngine serves its handler, ignoring the registered routes.
+type testEngine struct {
+	http.HandlerFunc
+}
+
+func (testEngine) RegisterRoutes(*handlers.RouteSet[echo.Context], log.Logger) {}
+
+// freeAddress returns a loopback address no one listens to.
+func freeAddress(t *testing.T) string {
+	t.Helper()
+	var lc net.ListenConfig
+	ln, err := lc.Listen(context.Background(), "tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+	return addr
+}
+
+func getAt(addr, path string) (string, error) {
+	req, err := http.NewRequestWithContext(
+		context.Background(), http.MethodGet, "http://"+addr+path, nil,
+	)
+	if err != nil {
+		return "", err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	return string(body), err
+}
+
+func TestServerLifecycle(t *testing.T) {
+	entered := make(chan struct{}, 2)
+	release := make(chan struct{})
+	engine := testEngine{func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/slow":
+			entered <- struct{}{}
+			<-release
+		case "/stream":
+			entered <- struct{}{}
+			<-r.Context().Done()
+		}
+		_, _ = w.Write([]byte("done"))
+	}}
+
+	cfg := server.DefaultConfig()
+	cfg.Enabled = true
+	cfg.Address = freeAddress(t)
+	cfg.ExtraAddresses = []string{freeAddress(t)}
+	s := server.New[echo.Context](cfg, engine, noop.NewLogger[any]())
+	require.NoError(t, s.Start(context.Background()))
+
+	// The engine is served at every address.
+	for _, addr := range append(cfg.ExtraAddresses, cfg.Address) {
+		body, err := getAt(addr, "/")
+		require.NoError(t, err)
+		require.Equal(t, "done", body)
+	}
+
+	slow := make(chan string)
+	go func() {
+		body, _ := getAt(cfg.Address, "/slow")
+		slow <- body
+	}()
+	stream := make(chan string)
+	go func() {
+		body, _ := getAt(cfg.Address, "/stream")
+		stream <- body
+	}()
+	<-entered
+	<-entered
+
+	stopped := make(chan error)
+	go func() { stopped <- s.Stop() }()
+
+	// Streams end as soon as the server stops, while requests in flight
+	// are waited for.
+	require.Equal(t, "done", <-stream)
+	select {
+	case <-stopped:
+		t.Fatal("stopped before the requests in flight completed")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+	require.Equal(t, "done", <-slow)
+	require.NoError(t, <-stopped)
+
+	_, err := getAt(cfg.Address, "/")
+	require.Error(t, err)
+}
+
+func TestServerShutdownTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	entered := make(chan struct{})
+	engine := testEngine{func(http.ResponseWriter, *http.Request) {
+		close(entered)
+		<-release
+	}}
+
+	cfg := server.DefaultConfig()
+	cfg.Enabled = true
+	cfg.Address = freeAddress(t)
+	cfg.ShutdownTimeout = 50 * time.Millisecond
+	s := server.New[echo.Context](cfg, engine, noop.NewLogger[any]())
+	require.NoError(t, s.Start(context.Background()))
+
+	failed := make(chan error)
+	go func() {
+		_, err := getAt(cfg.Address, "/")
+		failed <- err
+	}()
+	<-entered
+
+	// The connections of requests not completed in time are closed.
+	require.NoError(t, s.Stop())
+	require.Error(t, <-failed)
+}
+
+func TestServerAuthenticatedAddress(t *testing.T) {
+	engine := testEngine{func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(strconv.FormatBool(
+			handlers.ServesAuthenticatedRoutes(r.Context()),
+		)))
+	}}
+
+	cfg := server.DefaultConfig()
+	cfg.Enabled = true
+	cfg.Address = freeAddress(t)
+	cfg.ExtraAddresses = []string{freeAddress(t)}
+	cfg.AuthenticatedAddress = freeAddress(t)
+	s := server.New[echo.Context](cfg, engine, noop.NewLogger[any]())
+	require.NoError(t, s.Start(context.Background()))
+	defer func() { require.NoError(t, s.Stop()) }()
+
+	// The authenticated routes are only served at the authenticated
+	// address.
+	for addr, served := range map[string]string{
+		cfg.Address:              "false",
+		cfg.ExtraAddresses[0]:    "false",
+		cfg.AuthenticatedAddress: "true",
+	} {
+		body, err := getAt(addr, "/")
+		require.NoError(t, err)
+		require.Equal(t, served, body)
+	}
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"os"
+)
+
+var (
+	// ErrIncompleteTLSConfig is returned when only one of the TLS
+	// certificate and key files is set.
+	ErrIncompleteTLSConfig = errors.New(
+		"tls cert and key files must be set together",
+	)
+	// ErrClientCAWithoutTLS is returned when client certificates are
+	// required while TLS is disabled.
+	ErrClientCAWithoutTLS = errors.New(
+		"tls client ca file requires tls cert and key files",
+	)
+	// ErrNoClientCA is returned when the TLS client CA file holds no
+	// certificate.
+	ErrNoClientCA = errors.New("no certificate in tls client ca file")
+)
+
+// tlsConfig returns the TLS configuration the node API server is served
+// with, nil if it is served over plain HTTP. Clients must present a
+// certificate signed by one of the configured CAs, if any.
+func (c Config) tlsConfig() (*tls.Config, error) {
+	switch {
+	case c.TLSCertFile == "" && c.TLSKeyFile == "":
+		if c.TLSClientCAFile != "" {
+			return nil, ErrClientCAWithoutTLS
+		}
+		return nil, nil //nolint:nilnil // TLS is disabled.
+	case c.TLSCertFile == "" || c.TLSKeyFile == "":
+		return nil, ErrIncompleteTLSConfig
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.TLSCertFile, c.TLSKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if c.TLSClientCAFile == "" {
+		return cfg, nil
+	}
+
+	pem, err := os.ReadFile(c.TLSClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	cfg.ClientCAs = x509.NewCertPool()
+	if !cfg.ClientCAs.AppendCertsFromPEM(pem) {
+		return nil, ErrNoClientCA
+	}
+	cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	return cfg, nil
+}
@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/server"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// testCert is a certificate along with its key, signed by parent if any
+// and self signed otherwise.
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCert(
+	t *testing.T, serial int64, parent *testCert, usage x509.ExtKeyUsage,
+) *testCert {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	signer, signerKey := tmpl, key
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(
+		rand.Reader, tmpl, signer, &key.PublicKey, signerKey,
+	)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCert{cert: cert, key: key}
+}
+
+// write writes the PEM encoded certificate and key into dir, returning
+// their paths.
+func (c *testCert) write(t *testing.T, dir, name string) (string, string) {
+	t.Helper()
+	keyDER, err := x509.MarshalECPrivateKey(c.key)
+	require.NoError(t, err)
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(
+		&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw},
+	), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(
+		&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER},
+	), 0o600))
+	return certFile, keyFile
+}
+
+func (c *testCert) tlsCert() tls.Certificate {
+	return tls.Certificate{
+		Certificate: [][]byte{c.cert.Raw},
+		PrivateKey:  c.key,
+	}
+}
+
+func TestServerMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, 1, nil, x509.ExtKeyUsageAny)
+	caFile, _ := ca.write(t, dir, "ca")
+	serverCert := newTestCert(t, 2, ca, x509.ExtKeyUsageServerAuth)
+	clientCert := newTestCert(t, 3, ca, x509.ExtKeyUsageClientAuth)
+
+	cfg := server.DefaultConfig()
+	cfg.Enabled = true
+	cfg.Address = freeAddress(t)
+	cfg.TLSCertFile, cfg.TLSKeyFile = serverCert.write(t, dir, "server")
+	cfg.TLSClientCAFile = caFile
+	engine := testEngine{func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("done"))
+	}}
+	s := server.New[echo.Context](cfg, engine, noop.NewLogger[any]())
+	require.NoError(t, s.Start(context.Background()))
+	t.Cleanup(func() { require.NoError(t, s.Stop()) })
+
+	get := func(certs ...tls.Certificate) (string, error) {
+		roots := x509.NewCertPool()
+		roots.AddCert(ca.cert)
+		client := &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs:      roots,
+				Certificates: certs,
+				MinVersion:   tls.VersionTLS12,
+			},
+		}}
+		req, err := http.NewRequestWithContext(
+			context.Background(),
+			http.MethodGet, "https://"+cfg.Address, nil,
+		)
+		require.NoError(t, err)
+		res, err := client.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		return string(body), err
+	}
+
+	body, err := get(clientCert.tlsCert())
+	require.NoError(t, err)
+	require.Equal(t, "done", body)
+
+	// Clients must present a certificate signed by the CA.
+	_, err = get()
+	require.Error(t, err)
+	_, err = get(newTestCert(t, 4, nil, x509.ExtKeyUsageClientAuth).tlsCert())
+	require.Error(t, err)
+
+	// Plain HTTP is not served.
+	body, err = getAt(cfg.Address, "/")
+	require.NoError(t, err)
+	require.NotEqual(t, "done", body)
+}
+
+func TestServerTLSConfigErrors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		certFile, keyFile, caFile string
+		err                       error
+	}{
+		"cert without key": {
+			certFile: "server.crt",
+			err:      server.ErrIncompleteTLSConfig,
+		},
+		"client ca without tls": {
+			caFile: "ca.crt",
+			err:    server.ErrClientCAWithoutTLS,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := server.DefaultConfig()
+			cfg.Enabled = true
+			cfg.Address = freeAddress(t)
+			cfg.TLSCertFile = tc.certFile
+			cfg.TLSKeyFile = tc.keyFile
+			cfg.TLSClientCAFile = tc.caFile
+			s := server.New[echo.Context](
+				cfg, testEngine{}, noop.NewLogger[any](),
+			)
+			require.ErrorIs(t, s.Start(context.Background()), tc.err)
+		})
+	}
+}
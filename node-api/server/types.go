@@ -21,13 +21,16 @@
 package server
 
 import (
+	"net/http"
+
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/server/context"
 )
 
-// Engine is a generic interface for an API engine.
+// Engine is a generic interface for an API engine, serving the requests to
+// its registered routes.
 type Engine[ContextT context.Context] interface {
-	Run(addr string) error
+	http.Handler
 	RegisterRoutes(*handlers.RouteSet[ContextT], log.Logger)
 }
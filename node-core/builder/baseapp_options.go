@@ -70,6 +70,9 @@ func DefaultServiceOptions[
 
 	return []func(*cometbft.Service[LoggerT]){
 		cometbft.SetPruning[LoggerT](pruningOpts),
+		cometbft.SetCheckpointSync[LoggerT](
+			cast.ToString(appOpts.Get(server.FlagCheckpointSyncURL)),
+		),
 		cometbft.SetMinRetainBlocks[LoggerT](
 			cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks)),
 		),
@@ -22,15 +22,21 @@ package builder
 
 import (
 	"io"
+	"slices"
 
 	"cosmossdk.io/depinject"
 	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	"github.com/berachain/beacon-kit/cli/flags"
 	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/config/spec"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
 	"github.com/berachain/beacon-kit/log"
+	service "github.com/berachain/beacon-kit/node-core/services/registry"
 	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/state-transition/core"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cast"
 )
 
 // NodeBuilder is a construction helper for creating nodes that implement
@@ -48,6 +54,23 @@ type NodeBuilder[
 ] struct {
 	// components is a list of components to provide.
 	components []any
+	// stakingHooks are notified of validator lifecycle events by the
+	// state processor.
+	stakingHooks []core.StakingHooks
+	// role is the node role used when none is configured.
+	role types.Role
+	// handlers are the custom handlers injected in the ABCI flow.
+	handlers cometbft.Handlers
+	// extensions are the services registered on top of the default ones.
+	extensions []service.Extension
+	// plugins holds the names of the registered plugins.
+	plugins map[string]struct{}
+	// pluginComponents are the components provided by plugins.
+	pluginComponents []any
+	// logHandlers receive the entries of the node logger.
+	logHandlers []log.Handler
+	// chainSpecExtensions load custom tables of the chain spec file.
+	chainSpecExtensions []spec.Extension
 }
 
 // New returns a new NodeBuilder.
@@ -88,17 +111,41 @@ func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) Build(
 		config     *config.Config
 	)
 
+	role, err := nb.resolveRole(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	components := append(
+		slices.Clone(nb.components), nb.pluginComponents...,
+	)
+	if len(nb.stakingHooks) > 0 {
+		components = append(components, func() core.StakingHooks {
+			return core.NewMultiStakingHooks(nb.stakingHooks...)
+		})
+	}
+
+	if hookable, ok := any(logger).(log.Hookable); ok {
+		for _, handler := range nb.logHandlers {
+			hookable.AddHandler(handler)
+		}
+	}
+
 	// build all node components using depinject
-	if err := depinject.Inject(
+	if err = depinject.Inject(
 		depinject.Configs(
 			depinject.Provide(
-				nb.components...,
+				components...,
 			),
 			depinject.Supply(
 				appOpts,
 				logger,
 				db,
 				cmtCfg,
+				role,
+				nb.handlers,
+				nb.extensions,
+				nb.chainSpecExtensions,
 			),
 		),
 		&apiBackend,
@@ -121,3 +168,14 @@ func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) Build(
 	apiBackend.AttachQueryBackend(cmtService)
 	return beaconNode
 }
+
+// resolveRole returns the node role configured through the application
+// options, falling back to the role set on the builder.
+func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) resolveRole(
+	appOpts servertypes.AppOptions,
+) (types.Role, error) {
+	if name := cast.ToString(appOpts.Get(flags.Role)); name != "" {
+		return types.ParseRole(name)
+	}
+	return types.ParseRole(string(nb.role))
+}
@@ -21,8 +21,11 @@
 package builder
 
 import (
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
 	"github.com/berachain/beacon-kit/log"
+	service "github.com/berachain/beacon-kit/node-core/services/registry"
 	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/state-transition/core"
 )
 
 // Opt is a type that defines a function that modifies NodeBuilder.
@@ -48,3 +51,130 @@ func WithComponents[
 		nb.components = components
 	}
 }
+
+// WithStakingHooks is a function that registers hooks notified of validator
+// lifecycle events (deposits, activations, exits and slashings). It can be
+// passed multiple times; hooks are invoked in registration order.
+func WithStakingHooks[
+	NodeT types.Node,
+	LoggerT interface {
+		log.AdvancedLogger[LoggerT]
+		log.Configurable[LoggerT, LoggerConfigT]
+	},
+	LoggerConfigT any,
+](hooks ...core.StakingHooks) Opt[NodeT, LoggerT, LoggerConfigT] {
+	return func(nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) {
+		nb.stakingHooks = append(nb.stakingHooks, hooks...)
+	}
+}
+
+// WithRole is a function that sets the default role of the node, which
+// determines the services it runs. The role configured through the
+// application options takes precedence. The replica role must be configured
+// through the application options, which the start command reads to open the
+// application database read-only.
+func WithRole[
+	NodeT types.Node,
+	LoggerT interface {
+		log.AdvancedLogger[LoggerT]
+		log.Configurable[LoggerT, LoggerConfigT]
+	},
+	LoggerConfigT any,
+](role types.Role) Opt[NodeT, LoggerT, LoggerConfigT] {
+	return func(nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) {
+		nb.role = role
+	}
+}
+
+// WithPreBlockers is a function that registers PreBlockers, which run in
+// registration order before each block is finalized. It can be passed
+// multiple times.
+func WithPreBlockers[
+	NodeT types.Node,
+	LoggerT interface {
+		log.AdvancedLogger[LoggerT]
+		log.Configurable[LoggerT, LoggerConfigT]
+	},
+	LoggerConfigT any,
+](preBlockers ...cometbft.PreBlocker) Opt[NodeT, LoggerT, LoggerConfigT] {
+	return func(nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) {
+		nb.handlers.PreBlockers = append(
+			nb.handlers.PreBlockers, preBlockers...,
+		)
+	}
+}
+
+// WithPrepareProposalWrappers is a function that registers wrappers of the
+// PrepareProposal handler. The first registered wrapper is the outermost one.
+func WithPrepareProposalWrappers[
+	NodeT types.Node,
+	LoggerT interface {
+		log.AdvancedLogger[LoggerT]
+		log.Configurable[LoggerT, LoggerConfigT]
+	},
+	LoggerConfigT any,
+](
+	wrappers ...cometbft.PrepareProposalWrapper,
+) Opt[NodeT, LoggerT, LoggerConfigT] {
+	return func(nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) {
+		nb.handlers.PrepareProposalWrappers = append(
+			nb.handlers.PrepareProposalWrappers, wrappers...,
+		)
+	}
+}
+
+// WithProcessProposalWrappers is a function that registers wrappers of the
+// ProcessProposal handler. The first registered wrapper is the outermost one.
+func WithProcessProposalWrappers[
+	NodeT types.Node,
+	LoggerT interface {
+		log.AdvancedLogger[LoggerT]
+		log.Configurable[LoggerT, LoggerConfigT]
+	},
+	LoggerConfigT any,
+](
+	wrappers ...cometbft.ProcessProposalWrapper,
+) Opt[NodeT, LoggerT, LoggerConfigT] {
+	return func(nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) {
+		nb.handlers.ProcessProposalWrappers = append(
+			nb.handlers.ProcessProposalWrappers, wrappers...,
+		)
+	}
+}
+
+// WithService is a function that registers an extra service, started after
+// the default services. The options configure its lifecycle, e.g. the
+// services it depends on. It can be passed multiple times.
+func WithService[
+	NodeT types.Node,
+	LoggerT interface {
+		log.AdvancedLogger[LoggerT]
+		log.Configurable[LoggerT, LoggerConfigT]
+	},
+	LoggerConfigT any,
+](
+	svc service.Basic, opts ...service.ServiceOption,
+) Opt[NodeT, LoggerT, LoggerConfigT] {
+	return func(nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) {
+		nb.extensions = append(
+			nb.extensions, service.Extension{Service: svc, Options: opts},
+		)
+	}
+}
+
+// WithPlugins is a function that registers the given plugins, as per
+// NodeBuilder.Register.
+func WithPlugins[
+	NodeT types.Node,
+	LoggerT interface {
+		log.AdvancedLogger[LoggerT]
+		log.Configurable[LoggerT, LoggerConfigT]
+	},
+	LoggerConfigT any,
+](plugins ...Plugin) Opt[NodeT, LoggerT, LoggerConfigT] {
+	return func(nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) {
+		for _, p := range plugins {
+			nb.Register(p)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"github.com/berachain/beacon-kit/config/spec"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	service "github.com/berachain/beacon-kit/node-core/services/registry"
+	"github.com/berachain/beacon-kit/state-transition/core"
+)
+
+// ErrDuplicatePlugin is returned when a plugin is registered twice.
+var ErrDuplicatePlugin = errors.New("plugin already registered")
+
+// Plugin is a module contributing functionality to the node, e.g. services,
+// log handlers, API routes or chain spec extensions.
+type Plugin interface {
+	// Name returns the unique name of the plugin.
+	Name() string
+	// Register registers the plugin contributions through the registrar.
+	Register(r *Registrar)
+}
+
+// Registrar collects the contributions of a plugin.
+type Registrar struct {
+	components          []any
+	stakingHooks        []core.StakingHooks
+	handlers            cometbft.Handlers
+	extensions          []service.Extension
+	logHandlers         []log.Handler
+	chainSpecExtensions []spec.Extension
+}
+
+// ProvideComponents registers depinject providers. API routes are
+// contributed by providing handlers.Extension values.
+func (r *Registrar) ProvideComponents(components ...any) {
+	r.components = append(r.components, components...)
+}
+
+// AddService registers a service started after the default services.
+func (r *Registrar) AddService(
+	svc service.Basic, opts ...service.ServiceOption,
+) {
+	r.extensions = append(
+		r.extensions, service.Extension{Service: svc, Options: opts},
+	)
+}
+
+// AddStakingHooks registers hooks notified of validator lifecycle events.
+func (r *Registrar) AddStakingHooks(hooks ...core.StakingHooks) {
+	r.stakingHooks = append(r.stakingHooks, hooks...)
+}
+
+// AddPreBlockers registers PreBlockers run before each block is finalized.
+func (r *Registrar) AddPreBlockers(preBlockers ...cometbft.PreBlocker) {
+	r.handlers.PreBlockers = append(r.handlers.PreBlockers, preBlockers...)
+}
+
+// AddPrepareProposalWrappers registers wrappers of the PrepareProposal
+// handler.
+func (r *Registrar) AddPrepareProposalWrappers(
+	wrappers ...cometbft.PrepareProposalWrapper,
+) {
+	r.handlers.PrepareProposalWrappers = append(
+		r.handlers.PrepareProposalWrappers, wrappers...,
+	)
+}
+
+// AddProcessProposalWrappers registers wrappers of the ProcessProposal
+// handler.
+func (r *Registrar) AddProcessProposalWrappers(
+	wrappers ...cometbft.ProcessProposalWrapper,
+) {
+	r.handlers.ProcessProposalWrappers = append(
+		r.handlers.ProcessProposalWrappers, wrappers...,
+	)
+}
+
+// AddLogHandlers registers handlers receiving the entries of the node logger.
+func (r *Registrar) AddLogHandlers(handlers ...log.Handler) {
+	r.logHandlers = append(r.logHandlers, handlers...)
+}
+
+// AddChainSpecExtensions registers extensions loading the plugin's own
+// table of the chain spec file.
+func (r *Registrar) AddChainSpecExtensions(extensions ...spec.Extension) {
+	r.chainSpecExtensions = append(r.chainSpecExtensions, extensions...)
+}
+
+// Register registers the contributions of the given plugin. It panics if a
+// plugin with the same name was already registered.
+func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) Register(p Plugin) {
+	if _, ok := nb.plugins[p.Name()]; ok {
+		panic(errors.Wrap(ErrDuplicatePlugin, p.Name()))
+	}
+	if nb.plugins == nil {
+		nb.plugins = make(map[string]struct{})
+	}
+	nb.plugins[p.Name()] = struct{}{}
+
+	r := &Registrar{}
+	p.Register(r)
+	nb.pluginComponents = append(nb.pluginComponents, r.components...)
+	nb.stakingHooks = append(nb.stakingHooks, r.stakingHooks...)
+	nb.handlers.PreBlockers = append(
+		nb.handlers.PreBlockers, r.handlers.PreBlockers...,
+	)
+	nb.handlers.PrepareProposalWrappers = append(
+		nb.handlers.PrepareProposalWrappers,
+		r.handlers.PrepareProposalWrappers...,
+	)
+	nb.handlers.ProcessProposalWrappers = append(
+		nb.handlers.ProcessProposalWrappers,
+		r.handlers.ProcessProposalWrappers...,
+	)
+	nb.extensions = append(nb.extensions, r.extensions...)
+	nb.logHandlers = append(nb.logHandlers, r.logHandlers...)
+	nb.chainSpecExtensions = append(
+		nb.chainSpecExtensions, r.chainSpecExtensions...,
+	)
+}
@@ -21,7 +21,12 @@
 package components
 
 import (
+	"strings"
+	"time"
+
 	"cosmossdk.io/depinject"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/beacon/operations"
 	"github.com/berachain/beacon-kit/chain-spec/chain"
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/log"
@@ -29,12 +34,37 @@ import (
 	"github.com/berachain/beacon-kit/node-api/engines/echo"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/server"
+	"github.com/berachain/beacon-kit/observability/telemetry"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/storage/backfill"
+	"github.com/berachain/beacon-kit/storage/freezer"
+	"github.com/berachain/beacon-kit/storage/snapshot"
+	cmttypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/afero"
 )
 
+type NodeAPIEngineInput struct {
+	depinject.In
+
+	Config        *config.Config
+	TelemetrySink *telemetry.PrometheusSink
+}
+
 // TODO: we could make engine type configurable
-func ProvideNodeAPIEngine() *echo.Engine {
-	return echo.NewDefaultEngine()
+func ProvideNodeAPIEngine(in NodeAPIEngineInput) (*echo.Engine, error) {
+	var authToken string
+	if path := in.Config.NodeAPI.AuthTokenFile; path != "" {
+		data, err := afero.ReadFile(afero.NewOsFs(), path)
+		if err != nil {
+			return nil, err
+		}
+		authToken = strings.TrimSpace(string(data))
+	}
+	return echo.NewDefaultEngine(authToken, echo.RateLimits{
+		PerIP:    in.Config.NodeAPI.RateLimit,
+		PerToken: in.Config.NodeAPI.TokenRateLimit,
+	}, in.Config.NodeAPI.CORSAllowedOrigins, in.TelemetrySink), nil
 }
 
 type NodeAPIBackendInput[
@@ -42,8 +72,12 @@ type NodeAPIBackendInput[
 ] struct {
 	depinject.In
 
+	BackfillStore  *backfill.Store
 	ChainSpec      chain.ChainSpec
+	Freezer        *freezer.Freezer
+	OperationsPool *operations.Pool
 	StateProcessor StateProcessor[*Context]
+	StateRegen     *backend.StateRegen
 	StorageBackend StorageBackendT
 }
 
@@ -53,7 +87,18 @@ func ProvideNodeAPIBackend[
 	DepositStoreT DepositStore,
 	KVStoreT any,
 	NodeT interface {
+		AddCommitListener(
+			listener func(height int64, changes []*storetypes.StoreKVPair),
+		)
 		CreateQueryContext(height int64, prove bool) (sdk.Context, error)
+		BeaconBlockBytes(height int64) ([]byte, error)
+		FinalizedBlock(
+			height int64,
+		) ([]byte, *transition.Context, error)
+		GenesisTime() (time.Time, error)
+		LightBlock(
+			height int64,
+		) (*cmttypes.LightBlock, *cmttypes.TxProof, error)
 	},
 	StorageBackendT StorageBackend[
 		AvailabilityStoreT, BeaconBlockStoreT, DepositStoreT,
@@ -78,6 +123,41 @@ func ProvideNodeAPIBackend[
 		in.StorageBackend,
 		in.ChainSpec,
 		in.StateProcessor,
+		in.OperationsPool,
+		in.StateRegen,
+		in.Freezer,
+		in.BackfillStore,
+	)
+}
+
+type NodeAPIStateRegenInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+
+	Config        *config.Config
+	Logger        LoggerT
+	SnapshotStore *snapshot.KVStore
+	StoreKey      *storetypes.KVStoreKey
+}
+
+// ProvideNodeAPIStateRegen provides the regenerator of the beacon states
+// pruned from the multistore, served by the node API.
+func ProvideNodeAPIStateRegen[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in NodeAPIStateRegenInput[LoggerT],
+) *backend.StateRegen {
+	// Pruned states are not regenerated if the beacon state is not
+	// snapshotted.
+	var snapshots backend.SnapshotStore
+	if in.Config.StateSnapshots.Enabled() {
+		snapshots = in.SnapshotStore
+	}
+	return backend.NewStateRegen(
+		snapshots,
+		in.StoreKey,
+		log.ForService(in.Logger, log.ModuleAPI, "state-regen"),
 	)
 }
 
@@ -99,12 +179,12 @@ func ProvideNodeAPIServer[
 ](
 	in NodeAPIServerInput[LoggerT, NodeAPIContextT],
 ) *server.Server[NodeAPIContextT] {
-	in.Logger.AddKeyValColor("service", "node-api-server",
+	in.Logger.AddKeyValColor(log.ServiceKey, "node-api-server",
 		log.Blue)
 	return server.New[NodeAPIContextT](
 		in.Config.NodeAPI,
 		in.Engine,
-		in.Logger.With("service", "node-api-server"),
+		log.ForService(in.Logger, log.ModuleAPI, "node-api-server"),
 		in.Handlers...,
 	)
 }
@@ -22,14 +22,29 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/beacon/events"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/execution/engine"
+	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	beaconapi "github.com/berachain/beacon-kit/node-api/handlers/beacon"
 	builderapi "github.com/berachain/beacon-kit/node-api/handlers/builder"
 	configapi "github.com/berachain/beacon-kit/node-api/handlers/config"
 	debugapi "github.com/berachain/beacon-kit/node-api/handlers/debug"
 	eventsapi "github.com/berachain/beacon-kit/node-api/handlers/events"
+	keymanagerapi "github.com/berachain/beacon-kit/node-api/handlers/keymanager"
 	nodeapi "github.com/berachain/beacon-kit/node-api/handlers/node"
 	proofapi "github.com/berachain/beacon-kit/node-api/handlers/proof"
+	stakingapi "github.com/berachain/beacon-kit/node-api/handlers/staking"
+	"github.com/berachain/beacon-kit/node-core/services/chainhealth"
+	"github.com/berachain/beacon-kit/node-core/services/keystores"
+	"github.com/berachain/beacon-kit/node-core/services/performance"
+	"github.com/berachain/beacon-kit/node-core/services/slashingprotection"
+	"github.com/berachain/beacon-kit/node-core/services/syncstatus"
+	"github.com/berachain/beacon-kit/storage/metadata"
+	sdkversion "github.com/cosmos/cosmos-sdk/version"
 )
 
 type NodeAPIHandlersInput[
@@ -37,13 +52,17 @@ type NodeAPIHandlersInput[
 	NodeAPIContextT NodeAPIContext,
 ] struct {
 	depinject.In
-	BeaconAPIHandler  *beaconapi.Handler[NodeAPIContextT]
-	BuilderAPIHandler *builderapi.Handler[NodeAPIContextT]
-	ConfigAPIHandler  *configapi.Handler[NodeAPIContextT]
-	DebugAPIHandler   *debugapi.Handler[NodeAPIContextT]
-	EventsAPIHandler  *eventsapi.Handler[NodeAPIContextT]
-	NodeAPIHandler    *nodeapi.Handler[NodeAPIContextT]
-	ProofAPIHandler   *proofapi.Handler[NodeAPIContextT]
+	BeaconAPIHandler     *beaconapi.Handler[NodeAPIContextT]
+	BuilderAPIHandler    *builderapi.Handler[NodeAPIContextT]
+	ConfigAPIHandler     *configapi.Handler[NodeAPIContextT]
+	DebugAPIHandler      *debugapi.Handler[NodeAPIContextT]
+	EventsAPIHandler     *eventsapi.Handler[NodeAPIContextT]
+	KeymanagerAPIHandler *keymanagerapi.Handler[NodeAPIContextT]
+	NodeAPIHandler       *nodeapi.Handler[NodeAPIContextT]
+	ProofAPIHandler      *proofapi.Handler[NodeAPIContextT]
+	StakingAPIHandler    *stakingapi.Handler[NodeAPIContextT]
+	// Extensions are the handlers contributed by plugins.
+	Extensions []handlers.Extension[NodeAPIContextT]
 }
 
 func ProvideNodeAPIHandlers[
@@ -55,15 +74,21 @@ func ProvideNodeAPIHandlers[
 		NodeAPIContextT,
 	],
 ) []handlers.Handlers[NodeAPIContextT] {
-	return []handlers.Handlers[NodeAPIContextT]{
+	apiHandlers := []handlers.Handlers[NodeAPIContextT]{
 		in.BeaconAPIHandler,
 		in.BuilderAPIHandler,
 		in.ConfigAPIHandler,
 		in.DebugAPIHandler,
 		in.EventsAPIHandler,
+		in.KeymanagerAPIHandler,
 		in.NodeAPIHandler,
 		in.ProofAPIHandler,
+		in.StakingAPIHandler,
 	}
+	for _, ext := range in.Extensions {
+		apiHandlers = append(apiHandlers, ext.Handlers)
+	}
+	return apiHandlers
 }
 
 func ProvideNodeAPIBeaconHandler[
@@ -81,26 +106,64 @@ func ProvideNodeAPIBuilderHandler[
 
 func ProvideNodeAPIConfigHandler[
 	NodeAPIContextT NodeAPIContext,
-]() *configapi.Handler[NodeAPIContextT] {
-	return configapi.NewHandler[NodeAPIContextT]()
+](chainSpec chain.ChainSpec) *configapi.Handler[NodeAPIContextT] {
+	return configapi.NewHandler[NodeAPIContextT](chainSpec)
 }
 
 func ProvideNodeAPIDebugHandler[
+	NodeT any,
 	NodeAPIContextT NodeAPIContext,
-]() *debugapi.Handler[NodeAPIContextT] {
-	return debugapi.NewHandler[NodeAPIContextT]()
+](b NodeAPIBackend[NodeT]) *debugapi.Handler[NodeAPIContextT] {
+	return debugapi.NewHandler[NodeAPIContextT](b)
 }
 
 func ProvideNodeAPIEventsHandler[
 	NodeAPIContextT NodeAPIContext,
-]() *eventsapi.Handler[NodeAPIContextT] {
-	return eventsapi.NewHandler[NodeAPIContextT]()
+](bus *events.Bus) *eventsapi.Handler[NodeAPIContextT] {
+	return eventsapi.NewHandler[NodeAPIContextT](bus)
+}
+
+func ProvideNodeAPIKeymanagerHandler[
+	NodeT any,
+	NodeAPIContextT NodeAPIContext,
+](
+	b NodeAPIBackend[NodeT],
+	store *metadata.KVStore,
+	perf *performance.Service,
+	keystoreManager *keystores.Manager,
+	protection *slashingprotection.Store,
+) *keymanagerapi.Handler[NodeAPIContextT] {
+	return keymanagerapi.NewHandler[NodeAPIContextT](
+		store, perf, keystoreManager, protection, b,
+	)
 }
 
+// NodeAPINodeHandlerInput is the input for the node API handler provider.
+type NodeAPINodeHandlerInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	CometBFTService *cometbft.Service[LoggerT]
+	Config          *config.Config
+	ExecutionEngine *engine.Engine
+	SyncStatus      *syncstatus.Service
+	ChainHealth     *chainhealth.Service
+}
+
+// ProvideNodeAPINodeHandler provides the node API handler. The node is
+// considered syncing past the lag tolerated by the readiness probe.
 func ProvideNodeAPINodeHandler[
+	LoggerT log.AdvancedLogger[LoggerT],
 	NodeAPIContextT NodeAPIContext,
-]() *nodeapi.Handler[NodeAPIContextT] {
-	return nodeapi.NewHandler[NodeAPIContextT]()
+](in NodeAPINodeHandlerInput[LoggerT]) *nodeapi.Handler[NodeAPIContextT] {
+	return nodeapi.NewHandler[NodeAPIContextT](
+		in.CometBFTService,
+		in.ExecutionEngine,
+		in.SyncStatus,
+		in.ChainHealth,
+		sdkversion.Version,
+		in.Config.Probes.MaxSlotsBehind,
+	)
 }
 
 func ProvideNodeAPIProofHandler[
@@ -110,3 +173,10 @@ func ProvideNodeAPIProofHandler[
 ](b NodeAPIBackend[NodeT]) *proofapi.Handler[NodeAPIContextT] {
 	return proofapi.NewHandler[NodeAPIContextT](b)
 }
+
+func ProvideNodeAPIStakingHandler[
+	NodeT any,
+	NodeAPIContextT NodeAPIContext,
+](b NodeAPIBackend[NodeT]) *stakingapi.Handler[NodeAPIContextT] {
+	return stakingapi.NewHandler[NodeAPIContextT](b)
+}
@@ -26,14 +26,18 @@ import (
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/payload/attributes"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/storage/metadata"
 )
 
 type AttributesFactoryInput[LoggerT any] struct {
 	depinject.In
 
-	ChainSpec chain.ChainSpec
-	Config    *config.Config
-	Logger    LoggerT
+	ChainSpec     chain.ChainSpec
+	Config        *config.Config
+	Logger        LoggerT
+	MetadataStore *metadata.KVStore
+	Signer        crypto.BLSSigner
 }
 
 // ProvideAttributesFactory provides an AttributesFactory for the client.
@@ -46,5 +50,7 @@ func ProvideAttributesFactory[
 		in.ChainSpec,
 		in.Logger,
 		in.Config.PayloadBuilder.SuggestedFeeRecipient,
+		in.MetadataStore,
+		in.Signer.PublicKey(),
 	), nil
 }
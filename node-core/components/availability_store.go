@@ -21,13 +21,14 @@
 package components
 
 import (
-	"os"
-
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/chain-spec/chain"
 	"github.com/berachain/beacon-kit/config"
 	dastore "github.com/berachain/beacon-kit/da/store"
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/observability/telemetry"
 	"github.com/berachain/beacon-kit/storage/filedb"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cast"
@@ -37,31 +38,49 @@ import (
 // function for the depinject framework.
 type AvailabilityStoreInput[LoggerT any] struct {
 	depinject.In
-	AppOpts   config.AppOptions
-	ChainSpec chain.ChainSpec
-	Logger    LoggerT
+	AppOpts       config.AppOptions
+	ChainSpec     chain.ChainSpec
+	Config        *config.Config
+	Logger        LoggerT
+	Role          types.Role                `optional:"true"`
+	TelemetrySink *telemetry.PrometheusSink `optional:"true"`
 }
 
-// ProvideAvailibilityStore provides the availability store.
+// ProvideAvailibilityStore provides the availability store. Its operations
+// are reported to the telemetry sink, if any.
 func ProvideAvailibilityStore[
 	LoggerT log.AdvancedLogger[LoggerT],
 ](
 	in AvailabilityStoreInput[LoggerT],
 ) (*dastore.Store, error) {
+	var opts []filedb.Option
+	if cfg := in.Config.AvailabilityStore; cfg.Encrypted() {
+		aead, err := dastore.LoadCipher(cfg.EncryptionKeyPath)
+		if err != nil {
+			return nil, errors.Wrap(
+				err, "failed to load the availability store encryption key",
+			)
+		}
+		opts = append(opts, filedb.WithCipher(aead))
+	}
+	if in.Role.ReadOnly() {
+		opts = append(opts, filedb.WithReadOnly())
+	}
+
+	var db dastore.IndexDB = dastore.NewIndexDB(
+		homeDirectory(in.AppOpts), in.Logger, opts...,
+	)
+	if in.TelemetrySink != nil {
+		db = dastore.NewMetricsIndexDB(db, in.TelemetrySink)
+	}
 	return dastore.New(
-		filedb.NewRangeDB(
-			filedb.NewDB(
-				filedb.WithRootDirectory(
-					cast.ToString(
-						in.AppOpts.Get(flags.FlagHome),
-					)+"/data/blobs",
-				),
-				filedb.WithFileExtension("ssz"),
-				filedb.WithDirectoryPermissions(os.ModePerm),
-				filedb.WithLogger(in.Logger),
-			),
-		),
-		in.Logger.With("service", "da-store"),
+		db,
+		log.ForService(in.Logger, log.ModuleDA, "da-store"),
 		in.ChainSpec,
 	), nil
 }
+
+// homeDirectory returns the node home directory.
+func homeDirectory(appOpts config.AppOptions) string {
+	return cast.ToString(appOpts.Get(flags.FlagHome))
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package components
+
+import (
+	"os"
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	server "github.com/berachain/beacon-kit/cli/commands/server"
+	"github.com/berachain/beacon-kit/config"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	consensustypes "github.com/berachain/beacon-kit/consensus/types"
+	dablob "github.com/berachain/beacon-kit/da/blob"
+	dastore "github.com/berachain/beacon-kit/da/store"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/storage/backfill"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// BackfillStoreInput is the input for the dep inject framework.
+type BackfillStoreInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	AppOpts config.AppOptions
+	Logger  LoggerT
+	Role    types.Role `optional:"true"`
+}
+
+// ProvideBackfillStore is a function that provides the store of the beacon
+// blocks backfilled below the checkpoint the node was synced from.
+func ProvideBackfillStore[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in BackfillStoreInput[LoggerT],
+) (*backfill.Store, error) {
+	name := "backfill"
+	dir := cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data"
+
+	// Read-only nodes over a home written before the backfill was
+	// introduced have no history backfilled.
+	if _, err := os.Stat(filepath.Join(dir, name+".db")); in.Role.ReadOnly() &&
+		errors.Is(err, os.ErrNotExist) {
+		return backfill.NewStore(storage.NewKVStoreProvider(dbm.NewMemDB())), nil
+	}
+
+	logger := log.ForService(in.Logger, log.ModuleStorage, "backfill-store")
+	pdb, err := openStoreDB(name, dir, in.Role, logger, backfill.Schemas()...)
+	if err != nil {
+		return nil, err
+	}
+	return backfill.NewStore(storage.NewKVStoreProvider(pdb)), nil
+}
+
+// BackfillServiceInput is the input for the dep inject framework.
+type BackfillServiceInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	AppOpts           config.AppOptions
+	AvailabilityStore *dastore.Store
+	BackfillStore     *backfill.Store
+	BlobProcessor     *dablob.Processor[
+		*dastore.Store, *consensustypes.ConsensusSidecars,
+	]
+	ChainSpec       chain.ChainSpec
+	CometBFTService *cometbft.Service[LoggerT]
+	Config          *config.Config
+	Logger          LoggerT
+}
+
+// ProvideBackfillService is a function that provides the service
+// backfilling the history below the checkpoint the node was synced from. The
+// history is fetched from the node checkpoint synced from, unless
+// configured otherwise.
+func ProvideBackfillService[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in BackfillServiceInput[LoggerT],
+) *backfill.Service {
+	return backfill.NewService(
+		in.Config.Backfill,
+		cast.ToString(in.AppOpts.Get(server.FlagCheckpointSyncURL)),
+		in.ChainSpec,
+		in.CometBFTService,
+		in.BackfillStore,
+		in.AvailabilityStore,
+		in.BlobProcessor,
+		log.ForService(in.Logger, log.ModuleStorage, "backfill"),
+	)
+}
@@ -23,21 +23,19 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/chain-spec/chain"
-	"github.com/berachain/beacon-kit/cli/flags"
 	"github.com/berachain/beacon-kit/config"
 	dablob "github.com/berachain/beacon-kit/da/blob"
 	"github.com/berachain/beacon-kit/da/kzg"
 	"github.com/berachain/beacon-kit/log"
-	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/observability/telemetry"
 	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
-	"github.com/spf13/cast"
 )
 
 // BlobProofVerifierInput is the input for the
 // dep inject framework.
 type BlobProofVerifierInput struct {
 	depinject.In
-	AppOpts          config.AppOptions
+	Config           *config.Config
 	JSONTrustedSetup *gokzg4844.JSONTrustedSetup
 }
 
@@ -47,7 +45,7 @@ func ProvideBlobProofVerifier(
 	in BlobProofVerifierInput,
 ) (kzg.BlobProofVerifier, error) {
 	return kzg.NewBlobProofVerifier(
-		cast.ToString(in.AppOpts.Get(flags.KZGImplementation)),
+		in.Config.KZG.Implementation,
 		in.JSONTrustedSetup,
 	)
 }
@@ -61,7 +59,7 @@ type BlobProcessorIn[
 	BlobProofVerifier kzg.BlobProofVerifier
 	ChainSpec         chain.ChainSpec
 	Logger            LoggerT
-	TelemetrySink     *metrics.TelemetrySink
+	TelemetrySink     *telemetry.PrometheusSink
 }
 
 // ProvideBlobProcessor is a function that provides the BlobProcessor to the
@@ -80,7 +78,7 @@ func ProvideBlobProcessor[
 		AvailabilityStoreT,
 		ConsensusSidecarsT,
 	](
-		in.Logger.With("service", "blob-processor"),
+		log.ForService(in.Logger, log.ModuleDA, "blob-processor"),
 		in.ChainSpec,
 		in.BlobProofVerifier,
 		in.TelemetrySink,
@@ -46,7 +46,7 @@ func ProvideBlockStore[
 	in BlockStoreInput[LoggerT],
 ) (*block.KVStore[*ctypes.BeaconBlock], error) {
 	return block.NewStore[*ctypes.BeaconBlock](
-		in.Logger.With("service", "block-store"),
+		log.ForService(in.Logger, log.ModuleStorage, "block-store"),
 		in.Config.BlockStoreService.AvailabilityWindow,
 	), nil
 }
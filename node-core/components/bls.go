@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/crypto/bls"
+)
+
+// BLSImplementationInput is the input for the BLS implementation provider.
+type BLSImplementationInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	Config *config.Config
+	Logger LoggerT
+}
+
+// ProvideBLSImplementation selects the configured BLS implementation, which
+// the signers use, after checking it against a known answer test.
+func ProvideBLSImplementation[
+	LoggerT log.AdvancedLogger[LoggerT],
+](in BLSImplementationInput[LoggerT]) (bls.Implementation, error) {
+	impl, err := bls.Select(in.Config.BLS.Implementation)
+	if err != nil {
+		return nil, err
+	}
+	log.ForService(in.Logger, log.ModuleNode, "bls").Info(
+		"Selected BLS implementation", "implementation", impl.Name(),
+	)
+	return impl, nil
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/services/chainhealth"
+	"github.com/berachain/beacon-kit/observability/telemetry"
+)
+
+// ChainHealthServiceInput is the input for the chain health service provider.
+type ChainHealthServiceInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	Config        *config.Config
+	Logger        LoggerT
+	TelemetrySink *telemetry.PrometheusSink
+}
+
+// ProvideChainHealthService provides the service tracking the health of the
+// chain from the slots finalized by the blockchain service.
+func ProvideChainHealthService[
+	LoggerT log.AdvancedLogger[LoggerT],
+](in ChainHealthServiceInput[LoggerT]) *chainhealth.Service {
+	return chainhealth.NewService(
+		log.ForService(in.Logger, log.ModuleBeacon, "chain-health"),
+		in.TelemetrySink,
+		in.Config.ChainHealth,
+	)
+}
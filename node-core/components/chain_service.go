@@ -23,6 +23,7 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/beacon/events"
 	"github.com/berachain/beacon-kit/chain-spec/chain"
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/da/da"
@@ -30,7 +31,13 @@ import (
 	"github.com/berachain/beacon-kit/execution/deposit"
 	"github.com/berachain/beacon-kit/execution/engine"
 	"github.com/berachain/beacon-kit/log"
-	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/services/chainhealth"
+	"github.com/berachain/beacon-kit/node-core/services/crashreport"
+	"github.com/berachain/beacon-kit/node-core/services/doppelganger"
+	"github.com/berachain/beacon-kit/node-core/services/keystores"
+	"github.com/berachain/beacon-kit/node-core/services/performance"
+	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/observability/telemetry"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -50,20 +57,27 @@ type ChainServiceInput[
 	depinject.In
 
 	AppOpts         config.AppOptions
+	ChainHealth     *chainhealth.Service
 	ChainSpec       chain.ChainSpec
 	Cfg             *config.Config
 	EngineClient    *client.EngineClient
+	EventBus        *events.Bus
 	ExecutionEngine *engine.Engine
 	LocalBuilder    LocalBuilder
 	Logger          LoggerT
+	Role            types.Role
 	Signer          crypto.BLSSigner
 	StateProcessor  StateProcessor[*Context]
 	StorageBackend  StorageBackendT
 	BlobProcessor   BlobProcessor[
 		AvailabilityStoreT, ConsensusSidecarsT,
 	]
-	TelemetrySink         *metrics.TelemetrySink
+	TelemetrySink         *telemetry.PrometheusSink
 	BeaconDepositContract DepositContractT
+	ValidatorPerformance  *performance.Service
+	CrashReporter         *crashreport.Reporter
+	Doppelganger          *doppelganger.Service
+	KeystoreManager       *keystores.Manager
 }
 
 // ProvideChainService is a depinject provider for the blockchain service.
@@ -100,13 +114,21 @@ func ProvideChainService[
 		in.BlobProcessor,
 		in.BeaconDepositContract,
 		math.U64(in.ChainSpec.Eth1FollowDistance()),
-		in.Logger.With("service", "blockchain"),
+		log.ForService(in.Logger, log.ModuleBeacon, "blockchain"),
 		in.ChainSpec,
 		in.ExecutionEngine,
 		in.LocalBuilder,
 		in.StateProcessor,
 		in.TelemetrySink,
+		in.EventBus,
+		[]blockchain.SlotObserver{
+			in.ChainHealth, in.ValidatorPerformance, in.CrashReporter,
+			in.Doppelganger, in.KeystoreManager,
+		},
 		// If optimistic is enabled, we want to skip post finalization FCUs.
-		in.Cfg.Validator.EnableOptimisticPayloadBuilds,
+		// Nodes which do not propose blocks never build payloads.
+		in.Cfg.Validator.EnableOptimisticPayloadBuilds &&
+			in.Role.ProposesBlocks(),
+		in.Cfg.CatchUp,
 	)
 }
@@ -23,37 +23,54 @@ package components
 import (
 	"os"
 
+	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/cli/flags"
+	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/spf13/cast"
 )
 
 const (
 	ChainSpecTypeEnvVar  = "CHAIN_SPEC"
-	DevnetChainSpecType  = "devnet"
-	BetnetChainSpecType  = "betnet"
-	BoonetChainSpecType  = "boonet"
-	TestnetChainSpecType = "testnet"
+	ChainSpecFileEnvVar  = "CHAIN_SPEC_FILE"
+	DevnetChainSpecType  = spec.DevnetPreset
+	BetnetChainSpecType  = spec.BetnetPreset
+	BoonetChainSpecType  = spec.BoonetPreset
+	TestnetChainSpecType = spec.TestnetPreset
 )
 
-// ProvideChainSpec provides the chain spec based on the environment variable.
-func ProvideChainSpec() (chain.ChainSpec, error) {
-	// TODO: This is hood as fuck needs to be improved
-	// but for now we ball to get CI unblocked.
-	var (
-		chainSpec chain.ChainSpec
-		err       error
-	)
-	switch os.Getenv(ChainSpecTypeEnvVar) {
-	case DevnetChainSpecType:
-		chainSpec, err = spec.DevnetChainSpec()
-	case BetnetChainSpecType:
-		chainSpec, err = spec.BetnetChainSpec()
-	case BoonetChainSpecType:
-		chainSpec, err = spec.BoonetChainSpec()
-	case TestnetChainSpecType:
-		fallthrough
-	default:
-		chainSpec, err = spec.TestnetChainSpec()
+// ChainSpecInput is the input for the dep inject framework.
+type ChainSpecInput struct {
+	depinject.In
+	// AppOpts is not populated when called from CLI.
+	AppOpts config.AppOptions `optional:"true"`
+	// Extensions are the chain spec extensions registered by plugins.
+	Extensions []spec.Extension `optional:"true"`
+}
+
+// ProvideChainSpec provides the chain spec. The chain spec is loaded from
+// the file set by the chain spec file flag, or by the CHAIN_SPEC_FILE
+// environment variable, if any. Otherwise the preset named by the
+// CHAIN_SPEC environment variable is used.
+func ProvideChainSpec(in ChainSpecInput) (chain.ChainSpec, error) {
+	var path string
+	if in.AppOpts != nil {
+		path = cast.ToString(in.AppOpts.Get(flags.ChainSpecFile))
+	}
+	if path == "" {
+		path = os.Getenv(ChainSpecFileEnvVar)
+	}
+	if path != "" {
+		return spec.LoadChainSpecFile(path, in.Extensions...)
+	}
+
+	data, err := spec.PresetChainSpecData(os.Getenv(ChainSpecTypeEnvVar))
+	if err != nil {
+		return nil, err
+	}
+	if err = spec.LoadExtensions(nil, in.Extensions...); err != nil {
+		return nil, err
 	}
-	return chainSpec, err
+	return chain.NewChainSpec(data)
 }
@@ -21,42 +21,104 @@
 package components
 
 import (
+	"cosmossdk.io/depinject"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/beacon/blockchain"
 	"github.com/berachain/beacon-kit/beacon/validator"
 	"github.com/berachain/beacon-kit/chain-spec/chain"
+	server "github.com/berachain/beacon-kit/cli/commands/server"
 	"github.com/berachain/beacon-kit/config"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	dastore "github.com/berachain/beacon-kit/da/store"
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-core/builder"
-	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/services/crashreport"
+	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/observability/telemetry"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/berachain/beacon-kit/storage/statesync"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cast"
 )
 
-// ProvideCometBFTService provides the CometBFT service component.
+// CometBFTServiceInput is the input for the CometBFT service provider.
+type CometBFTServiceInput[
+	LoggerT any,
+] struct {
+	depinject.In
+	AppOpts           config.AppOptions
+	AvailabilityStore *dastore.Store
+	Blockchain        blockchain.BlockchainI
+	BlockBuilder      validator.BlockBuilderI
+	ChainSpec         chain.ChainSpec
+	CmtCfg            *cmtcfg.Config
+	Config            *config.Config
+	CrashReporter     *crashreport.Reporter
+	DB                dbm.DB
+	DepositStore      *depositstore.KVStore
+	Handlers          cometbft.Handlers
+	Logger            LoggerT
+	Role              types.Role
+	StoreKey          *storetypes.KVStoreKey
+	TelemetrySink     *telemetry.PrometheusSink
+}
+
+// ProvideCometBFTService provides the CometBFT service component. Nodes
+// which write to their stores take the configured state sync snapshots, and
+// restore them when checkpoint syncing.
 func ProvideCometBFTService[
 	LoggerT log.AdvancedLogger[LoggerT],
 ](
-	logger LoggerT,
-	storeKey *storetypes.KVStoreKey,
-	blockchain blockchain.BlockchainI,
-	blockBuilder validator.BlockBuilderI,
-	db dbm.DB,
-	cmtCfg *cmtcfg.Config,
-	appOpts config.AppOptions,
-	chainSpec chain.ChainSpec,
-	telemetrySink *metrics.TelemetrySink,
-) *cometbft.Service[LoggerT] {
-	return cometbft.NewService(
-		storeKey,
-		logger,
-		db,
-		blockchain,
-		blockBuilder,
-		cmtCfg,
-		chainSpec,
-		telemetrySink,
-		builder.DefaultServiceOptions[LoggerT](appOpts)...,
+	in CometBFTServiceInput[LoggerT],
+) (*cometbft.Service[LoggerT], error) {
+	opts := builder.DefaultServiceOptions[LoggerT](in.AppOpts)
+	opts = append(
+		opts,
+		cometbft.SetHandlers[LoggerT](in.Handlers),
+		cometbft.SetCrashReporter[LoggerT](in.CrashReporter),
 	)
+	if !in.Role.HasValidatorKey() {
+		opts = append(opts, cometbft.SetEphemeralPrivValidator[LoggerT]())
+	}
+	if !in.Role.VerifiesProposals() {
+		opts = append(opts, cometbft.SetSkipProposalVerification[LoggerT]())
+	}
+	// Nodes checkpoint syncing restore the snapshots even if they take none.
+	checkpointSync := cast.ToString(
+		in.AppOpts.Get(server.FlagCheckpointSyncURL),
+	) != ""
+	if checkpointSync && in.Role.ReadOnly() {
+		return nil, errors.New(
+			"read-only roles cannot checkpoint sync",
+		)
+	}
+	cfg := in.Config.StateSync
+	if (cfg.Enabled() || checkpointSync) && !in.Role.ReadOnly() {
+		store, err := statesync.OpenStore(
+			statesync.Dir(homeDirectory(in.AppOpts)),
+		)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, cometbft.SetSnapshot[LoggerT](
+			store,
+			snapshottypes.NewSnapshotOptions(cfg.Interval, cfg.KeepRecent),
+			statesync.NewDepositsSnapshotter(in.DepositStore),
+			statesync.NewBlobsSnapshotter(in.AvailabilityStore, in.ChainSpec),
+		))
+	}
+	return cometbft.NewService(
+		in.StoreKey,
+		in.Logger,
+		in.DB,
+		in.Blockchain,
+		in.BlockBuilder,
+		in.CmtCfg,
+		in.ChainSpec,
+		in.TelemetrySink,
+		opts...,
+	), nil
 }
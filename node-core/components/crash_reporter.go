@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/services/crashreport"
+)
+
+// CrashReporterInput is the input for the crash reporter provider.
+type CrashReporterInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	AppOpts config.AppOptions
+	Config  *config.Config
+	Logger  LoggerT
+}
+
+// ProvideCrashReporter provides the reporter of the crashes of the node,
+// writing its reports to the data directory of the node. It keeps the latest
+// entries of the node logger for the reports.
+func ProvideCrashReporter[
+	LoggerT log.AdvancedLogger[LoggerT],
+](in CrashReporterInput[LoggerT]) *crashreport.Reporter {
+	reporter := crashreport.NewReporter(
+		in.Config.CrashReport,
+		filepath.Join(homeDirectory(in.AppOpts), "data", "crash-reports"),
+		in.Config,
+		log.ForService(in.Logger, log.ModuleNode, "crash-reporter"),
+	)
+	if hookable, ok := any(in.Logger).(log.Hookable); ok {
+		hookable.AddHandler(reporter.HandleLog)
+	}
+	return reporter
+}
@@ -21,12 +21,16 @@
 package components
 
 import (
+	"path/filepath"
+
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/node-core/types"
 	depositstore "github.com/berachain/beacon-kit/storage/deposit"
-	dbm "github.com/cosmos/cosmos-db"
+	"github.com/berachain/beacon-kit/storage/wal"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cast"
 )
@@ -38,6 +42,7 @@ type DepositStoreInput[
 	depinject.In
 	Logger  LoggerT
 	AppOpts config.AppOptions
+	Role    types.Role `optional:"true"`
 }
 
 // ProvideDepositStore is a function that provides the module to the
@@ -50,13 +55,26 @@ func ProvideDepositStore[
 	name := "deposits"
 	dir := cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data"
 
-	pdb, err := dbm.NewDB(name, dbm.PebbleDBBackend, dir)
+	logger := log.ForService(in.Logger, log.ModuleStorage, "deposit-store")
+	pdb, err := openStoreDB(
+		name, dir, in.Role, logger, depositstore.Schemas()...,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	return depositstore.NewStore(
-		storage.NewKVStoreProvider(pdb),
-		in.Logger.With("service", "deposit-store"),
-	), nil
+	store := depositstore.NewStore(storage.NewKVStoreProvider(pdb), logger)
+	if in.Role.ReadOnly() {
+		// The write-ahead log cannot be replayed into a read-only store, the
+		// writes it holds are only visible once the writing node restarts.
+		return store, nil
+	}
+	w, err := wal.Open(filepath.Join(dir, name+".wal"))
+	if err != nil {
+		return nil, errors.Join(err, store.Close())
+	}
+	if err = store.UseWAL(w); err != nil {
+		return nil, errors.Join(err, w.Close(), store.Close())
+	}
+	return store, nil
 }
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/diagnostics"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// DiagnosticsServerInput is the input for the diagnostics server provider.
+type DiagnosticsServerInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	Config *config.Config
+	Logger LoggerT
+}
+
+// ProvideDiagnosticsServer is a depinject provider for the diagnostics
+// server.
+func ProvideDiagnosticsServer[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in DiagnosticsServerInput[LoggerT],
+) *diagnostics.Server {
+	return diagnostics.NewServer(
+		in.Config.Diagnostics,
+		log.ForService(in.Logger, log.ModuleNode, "diagnostics"),
+	)
+}
+
+// SlowBlockReporterInput is the input for the slow block reporter provider.
+type SlowBlockReporterInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	AppOpts config.AppOptions
+	Config  *config.Config
+	Logger  LoggerT
+}
+
+// ProvideSlowBlockReporter is a depinject provider for the reporter of the
+// slow blocks, writing its reports to the data directory of the node.
+func ProvideSlowBlockReporter[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in SlowBlockReporterInput[LoggerT],
+) *diagnostics.SlowBlockReporter {
+	return diagnostics.NewSlowBlockReporter(
+		in.Config.Diagnostics,
+		filepath.Join(
+			cast.ToString(in.AppOpts.Get(flags.FlagHome)),
+			"data", "diagnostics",
+		),
+		log.ForService(in.Logger, log.ModuleNode, "slow-block-reporter"),
+	)
+}
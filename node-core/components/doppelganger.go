@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/services/doppelganger"
+	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/observability/telemetry"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// DoppelgangerServiceInput is the input for the doppelganger protection
+// provider.
+type DoppelgangerServiceInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	ChainSpec     chain.ChainSpec
+	Config        *config.Config
+	Logger        LoggerT
+	Role          types.Role
+	Signer        crypto.BLSSigner
+	TelemetrySink *telemetry.PrometheusSink
+}
+
+// ProvideDoppelgangerService provides the service protecting the validator
+// key of the node from being used by another node. It is only enabled on
+// the nodes proposing blocks.
+func ProvideDoppelgangerService[
+	LoggerT log.AdvancedLogger[LoggerT],
+](in DoppelgangerServiceInput[LoggerT]) (*doppelganger.Service, error) {
+	cfg := in.Config.Doppelganger
+	var address []byte
+	if cfg.Enabled && in.Role.ProposesBlocks() {
+		var err error
+		if address, err = crypto.GetAddressFromPubKey(
+			in.Signer.PublicKey(),
+		); err != nil {
+			return nil, err
+		}
+	} else {
+		cfg.Enabled = false
+	}
+	return doppelganger.NewService(
+		log.ForService(in.Logger, log.ModuleValidator, "doppelganger"),
+		in.TelemetrySink,
+		cfg,
+		in.ChainSpec.SlotsPerEpoch(),
+		address,
+	), nil
+}
@@ -29,7 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/execution/client"
 	"github.com/berachain/beacon-kit/execution/engine"
 	"github.com/berachain/beacon-kit/log"
-	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/observability/telemetry"
 	"github.com/berachain/beacon-kit/primitives/net/jwt"
 )
 
@@ -41,7 +41,7 @@ type EngineClientInputs[LoggerT any] struct {
 	// TODO: this feels like a hood way to handle it.
 	JWTSecret     *jwt.Secret `optional:"true"`
 	Logger        LoggerT
-	TelemetrySink *metrics.TelemetrySink
+	TelemetrySink *telemetry.PrometheusSink
 }
 
 // ProvideEngineClient creates a new EngineClient.
@@ -52,7 +52,7 @@ func ProvideEngineClient[
 ) *client.EngineClient {
 	return client.New(
 		in.Config.GetEngine(),
-		in.Logger.With("service", "engine.client"),
+		log.ForService(in.Logger, log.ModuleEngine, "engine.client"),
 		in.JWTSecret,
 		in.TelemetrySink,
 		new(big.Int).SetUint64(in.ChainSpec.DepositEth1ChainID()),
@@ -66,7 +66,7 @@ type ExecutionEngineInputs[
 	depinject.In
 	EngineClient  *client.EngineClient
 	Logger        LoggerT
-	TelemetrySink *metrics.TelemetrySink
+	TelemetrySink *telemetry.PrometheusSink
 }
 
 // ProvideExecutionEngine provides the execution engine to the depinject
@@ -78,7 +78,7 @@ func ProvideExecutionEngine[
 ) *engine.Engine {
 	return engine.New(
 		in.EngineClient,
-		in.Logger.With("service", "execution-engine"),
+		log.ForService(in.Logger, log.ModuleEngine, "execution-engine"),
 		in.TelemetrySink,
 	)
 }
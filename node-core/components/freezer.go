@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/storage/freezer"
+	"github.com/berachain/beacon-kit/storage/snapshot"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// FreezerInput is the input for the dep inject framework.
+type FreezerInput struct {
+	depinject.In
+	AppOpts config.AppOptions
+	Role    types.Role `optional:"true"`
+}
+
+// ProvideFreezer is a function that provides the cold storage of the
+// ancient blocks, headers and beacon state snapshots.
+func ProvideFreezer(in FreezerInput) (*freezer.Freezer, error) {
+	dir := filepath.Join(
+		cast.ToString(in.AppOpts.Get(flags.FlagHome)), "data", "freezer",
+	)
+	if in.Role.ReadOnly() {
+		return freezer.OpenReadOnly(dir)
+	}
+	return freezer.Open(dir)
+}
+
+// FreezerServiceInput is the input for the dep inject framework.
+type FreezerServiceInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	ChainSpec       chain.ChainSpec
+	CometBFTService *cometbft.Service[LoggerT]
+	Config          *config.Config
+	Freezer         *freezer.Freezer
+	Logger          LoggerT
+	SnapshotStore   *snapshot.KVStore
+}
+
+// ProvideFreezerService is a function that provides the service moving the
+// ancient data to the freezer.
+func ProvideFreezerService[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in FreezerServiceInput[LoggerT],
+) *freezer.Service {
+	return freezer.NewService(
+		in.Config.Freezer,
+		in.ChainSpec,
+		in.CometBFTService,
+		in.Freezer,
+		in.SnapshotStore,
+		log.ForService(in.Logger, log.ModuleStorage, "freezer"),
+	)
+}
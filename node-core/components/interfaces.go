@@ -23,6 +23,7 @@ package components
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 
 	"github.com/berachain/beacon-kit/chain-spec/chain"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
@@ -31,6 +32,8 @@ import (
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	prooftypes "github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	stakingtypes "github.com/berachain/beacon-kit/node-api/handlers/staking/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/crypto"
@@ -38,6 +41,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/metadata"
 	v1 "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	fastssz "github.com/ferranbt/fastssz"
@@ -52,6 +56,12 @@ type (
 			timestamp uint64,
 			prevHeadRoot [32]byte,
 		) (*engineprimitives.PayloadAttributes, error)
+		// SuggestedFeeRecipient returns the fee recipient of the payloads
+		// built for the validator run by the node.
+		SuggestedFeeRecipient() common.ExecutionAddress
+		// Preferences returns the preferences of the validator run by the
+		// node.
+		Preferences() *metadata.Metadata
 	}
 
 	// AvailabilityStore is the interface for the availability store.
@@ -63,6 +73,11 @@ type (
 		// Persist makes sure that the sidecar remains accessible for data
 		// availability checks throughout the beacon node's operation.
 		Persist(math.Slot, datypes.BlobSidecars) error
+		// GetBlobSidecars returns the stored sidecars of the blobs referenced
+		// in the block.
+		GetBlobSidecars(
+			math.Slot, *ctypes.BeaconBlockBody,
+		) (datypes.BlobSidecars, error)
 	}
 
 	ConsensusBlock interface {
@@ -75,6 +90,10 @@ type (
 		// GetConsensusTime returns the timestamp of current consensus request.
 		// It is used to build next payload and to validate currentpayload.
 		GetConsensusTime() math.U64
+
+		// GetMisbehaviors returns the validators misbehaviors reported by
+		// consensus, to be slashed along with the block.
+		GetMisbehaviors() []*transition.Misbehavior
 	}
 
 	// BeaconBlock represents a generic interface for a beacon block.
@@ -186,12 +205,14 @@ type (
 		// ProcessSidecars processes the blobs and ensures they match the local
 		// state.
 		ProcessSidecars(
+			ctx context.Context,
 			avs AvailabilityStoreT,
 			sidecars datypes.BlobSidecars,
 		) error
 		// VerifySidecars verifies the blobs and ensures they match the local
 		// state.
 		VerifySidecars(
+			ctx context.Context,
 			sidecars ConsensusSidecarsT,
 			verifierFn func(
 				blkHeader *ctypes.BeaconBlockHeader,
@@ -215,6 +236,17 @@ type (
 		// GetParentSlotByTimestamp retrieves the parent slot by a given
 		// timestamp from the store.
 		GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
+		// GetSlotByExecutionHash retrieves the slot by a given execution
+		// block hash from the store.
+		GetSlotByExecutionHash(
+			executionHash common.ExecutionHash,
+		) (math.Slot, error)
+		// GetBlockRootBySlot retrieves the block root by a given slot from
+		// the store.
+		GetBlockRootBySlot(slot math.Slot) (common.Root, error)
+		// GetExecutionHashBySlot retrieves the execution block hash by a
+		// given slot from the store.
+		GetExecutionHashBySlot(slot math.Slot) (common.ExecutionHash, error)
 	}
 
 	ConsensusEngine interface {
@@ -307,6 +339,28 @@ type (
 		Prune(start, end uint64) error
 		// EnqueueDeposits adds a list of deposits to the deposit store.
 		EnqueueDeposits(deposits []*ctypes.Deposit) error
+		// GetDepositsFromIndex returns all the deposits from the given index.
+		GetDepositsFromIndex(startIndex uint64) (ctypes.Deposits, error)
+		// AddFailedBlock records that the deposits of the given execution block
+		// failed to be fetched.
+		AddFailedBlock(blockNum uint64) error
+		// RemoveFailedBlock records that the deposits of the given execution
+		// block have been fetched.
+		RemoveFailedBlock(blockNum uint64) error
+		// GetFailedBlocks returns the execution blocks whose deposits failed to
+		// be fetched.
+		GetFailedBlocks() ([]uint64, error)
+		// GetProgress returns the execution block number recorded for the
+		// given progress marker, or zero if none is recorded.
+		GetProgress(marker string) (uint64, error)
+		// SetProgress records the execution block number reached by the given
+		// progress marker.
+		SetProgress(marker string, blockNum uint64) error
+		// SetBlockDeposits records the index of the first deposit fetched
+		// from the given execution block.
+		SetBlockDeposits(blockNum, firstIndex uint64) error
+		// Close flushes and closes the deposit store.
+		Close() error
 	}
 
 	// Genesis is the interface for the genesis.
@@ -322,8 +376,10 @@ type (
 
 	// IndexDB is the interface for the range DB.
 	IndexDB interface {
+		Get(index uint64, key []byte) ([]byte, error)
 		Has(index uint64, key []byte) (bool, error)
 		Set(index uint64, key []byte, value []byte) error
+		Keys(index uint64) ([][]byte, error)
 		Prune(start uint64, end uint64) error
 	}
 
@@ -605,6 +661,16 @@ type (
 		GetValidators() (ctypes.Validators, error)
 		// GetBalances retrieves all balances.
 		GetBalances() ([]uint64, error)
+		// IterateValidators calls the given function with every validator
+		// until it returns true or an error.
+		IterateValidators(
+			fn func(math.ValidatorIndex, *ctypes.Validator) (bool, error),
+		) error
+		// IterateBalances calls the given function with every balance until
+		// it returns true or an error.
+		IterateBalances(
+			fn func(math.ValidatorIndex, math.Gwei) (bool, error),
+		) error
 		// GetNextWithdrawalIndex retrieves the next withdrawal index.
 		GetNextWithdrawalIndex() (uint64, error)
 		// SetNextWithdrawalIndex sets the next withdrawal index.
@@ -759,6 +825,10 @@ type (
 		ValidatorByIndex(
 			math.ValidatorIndex,
 		) (*ctypes.Validator, error)
+
+		IterateValidators(
+			func(math.ValidatorIndex, *ctypes.Validator) (bool, error),
+		) error
 	}
 
 	// WriteOnlyEth1Data has write access to eth1 data.
@@ -795,7 +865,7 @@ type (
 
 	// Engine is a generic interface for an API engine.
 	NodeAPIEngine[ContextT NodeAPIContext] interface {
-		Run(addr string) error
+		http.Handler
 		RegisterRoutes(*handlers.RouteSet[ContextT], log.Logger)
 	}
 
@@ -810,6 +880,7 @@ type (
 
 		NodeAPIBeaconBackend
 		NodeAPIProofBackend
+		NodeAPIStakingBackend
 	}
 
 	// NodeAPIBackend is the interface for backend of the beacon API.
@@ -820,21 +891,37 @@ type (
 		StateBackend
 		ValidatorBackend
 		HistoricalBackend
+		PoolBackend
+		LightClientBackend
 		// GetSlotByBlockRoot retrieves the slot by a given root from the store.
 		GetSlotByBlockRoot(root common.Root) (math.Slot, error)
 		// GetSlotByStateRoot retrieves the slot by a given root from the store.
 		GetSlotByStateRoot(root common.Root) (math.Slot, error)
+		// GetSlotByExecutionHash retrieves the slot by a given execution
+		// block hash from the store.
+		GetSlotByExecutionHash(hash common.ExecutionHash) (math.Slot, error)
 	}
 
 	// NodeAPIProofBackend is the interface for backend of the proof API.
 	NodeAPIProofBackend interface {
 		BlockBackend
 		StateBackend
+		FinalityBackend
 		GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
 	}
 
+	// NodeAPIStakingBackend is the interface for backend of the staking API.
+	NodeAPIStakingBackend interface {
+		ExitQueueBackend
+		PendingDepositsBackend
+		// GetSlotByStateRoot retrieves the slot by a given root from the store.
+		GetSlotByStateRoot(root common.Root) (math.Slot, error)
+	}
+
 	GenesisBackend interface {
 		GenesisValidatorsRoot(slot math.Slot) (common.Root, error)
+		GenesisTime() (uint64, error)
+		GenesisForkVersion() common.Version
 	}
 
 	HistoricalBackend interface {
@@ -842,11 +929,27 @@ type (
 		StateForkAtSlot(slot math.Slot) (*ctypes.Fork, error)
 	}
 
+	ExitQueueBackend interface {
+		ExitQueueAtSlot(
+			slot math.Slot,
+		) ([]*stakingtypes.ExitQueueData, error)
+	}
+
+	PendingDepositsBackend interface {
+		PendingDepositsAtSlot(
+			slot math.Slot,
+		) (*stakingtypes.PendingDepositsData, error)
+	}
+
 	RandaoBackend interface {
 		RandaoAtEpoch(slot math.Slot, epoch math.Epoch) (common.Bytes32, error)
 	}
 
 	BlockBackend interface {
+		BlockAtSlot(slot math.Slot) (*ctypes.BeaconBlock, error)
+		BlobSidecarsAtSlot(
+			slot math.Slot, indices []uint64,
+		) (datypes.BlobSidecars, error)
 		BlockRootAtSlot(slot math.Slot) (common.Root, error)
 		BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 		BlockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader, error)
@@ -856,6 +959,30 @@ type (
 		StateRootAtSlot(slot math.Slot) (common.Root, error)
 		StateForkAtSlot(slot math.Slot) (*ctypes.Fork, error)
 		StateFromSlotForProof(slot math.Slot) (*statedb.StateDB, math.Slot, error)
+		StateAtSlot(slot math.Slot) (*ctypes.BeaconState, error)
+		CheckpointState() (*ctypes.BeaconState, error)
+	}
+
+	FinalityBackend interface {
+		FinalityProofAtHeight(
+			height math.Slot,
+		) (*prooftypes.FinalityProofResponse, error)
+	}
+
+	LightClientBackend interface {
+		LightClientDataAtSlot(slot math.Slot) (*types.LightClientResponse, error)
+		LightClientUpdates(
+			startPeriod math.Epoch, count uint64,
+		) ([]*types.LightClientResponse, error)
+	}
+
+	PoolBackend interface {
+		SubmitVoluntaryExit(exit *ctypes.SignedVoluntaryExit) error
+		SubmitBLSToExecutionChange(
+			change *ctypes.SignedBLSToExecutionChange,
+		) error
+		VoluntaryExits() []*ctypes.SignedVoluntaryExit
+		BLSToExecutionChanges() []*ctypes.SignedBLSToExecutionChange
 	}
 
 	ValidatorBackend interface {
@@ -24,22 +24,20 @@ import (
 	"strings"
 
 	"cosmossdk.io/depinject"
-	"github.com/berachain/beacon-kit/cli/flags"
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/primitives/net/jwt"
 	"github.com/spf13/afero"
-	"github.com/spf13/cast"
 )
 
 // JWTSecretInput is the input for the dep inject framework.
 type JWTSecretInput struct {
 	depinject.In
-	AppOpts config.AppOptions
+	Config *config.Config
 }
 
 // ProvideJWTSecret is a function that provides the module to the application.
 func ProvideJWTSecret(in JWTSecretInput) (*jwt.Secret, error) {
-	return LoadJWTFromFile(cast.ToString(in.AppOpts.Get(flags.JWTSecretPath)))
+	return LoadJWTFromFile(in.Config.GetEngine().JWTSecretPath)
 }
 
 // LoadJWTFromFile reads the JWT secret from a file and returns it.
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/beacon/events"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/node-core/services/keystores"
+	"github.com/berachain/beacon-kit/node-core/services/slashingprotection"
+	"github.com/berachain/beacon-kit/observability/telemetry"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// KeystoreManagerInput is the input for the keystore manager provider.
+type KeystoreManagerInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	AppOpts            config.AppOptions
+	Config             *config.Config
+	EventBus           *events.Bus
+	Logger             LoggerT
+	SlashingProtection *slashingprotection.Store
+	TelemetrySink      *telemetry.PrometheusSink
+}
+
+// ProvideKeystoreManager provides the manager loading the keystores of the
+// keystores directory at runtime, config/keystores in the home directory of
+// the node unless configured otherwise.
+func ProvideKeystoreManager[
+	LoggerT log.AdvancedLogger[LoggerT],
+](in KeystoreManagerInput[LoggerT]) *keystores.Manager {
+	cfg := in.Config.Keystores
+	if cfg.Dir == "" {
+		cfg.Dir = filepath.Join(
+			cast.ToString(in.AppOpts.Get(flags.FlagHome)),
+			"config", "keystores",
+		)
+	}
+	return keystores.NewManager(
+		log.ForService(in.Logger, log.ModuleValidator, "keystores"),
+		in.TelemetrySink,
+		in.EventBus,
+		in.SlashingProtection,
+		cfg,
+		func(key signer.LegacyKey) (crypto.BLSSigner, error) {
+			return signer.NewLegacySigner(key)
+		},
+	)
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/storage/metadata"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// MetadataStoreInput is the input for the dep inject framework.
+type MetadataStoreInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	AppOpts config.AppOptions
+	Config  *config.Config
+	Logger  LoggerT
+	Role    types.Role `optional:"true"`
+}
+
+// ProvideMetadataStore is a function that provides the validators metadata
+// registry, seeded with the metadata file if one is configured.
+func ProvideMetadataStore[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in MetadataStoreInput[LoggerT],
+) (*metadata.KVStore, error) {
+	name := "validator-metadata"
+	dir := cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data"
+
+	logger := log.ForService(in.Logger, log.ModuleStorage, "metadata-store")
+	pdb, err := openStoreDB(name, dir, in.Role, logger, metadata.Schemas()...)
+	if err != nil {
+		return nil, err
+	}
+
+	store := metadata.NewStore(storage.NewKVStoreProvider(pdb), logger)
+	if in.Role.ReadOnly() {
+		return store, nil
+	}
+
+	if err = importMetadataFile(
+		store, in.Config.Validator.MetadataFile,
+	); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// importMetadataFile imports the metadata file at the given path, if any,
+// into the metadata store.
+func importMetadataFile(store *metadata.KVStore, path string) error {
+	if path == "" {
+		return nil
+	}
+	entries, err := metadata.LoadFile(path)
+	if err != nil {
+		return err
+	}
+	return store.Import(entries)
+}
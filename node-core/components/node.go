@@ -21,8 +21,10 @@
 package components
 
 import (
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-core/node"
+	"github.com/berachain/beacon-kit/node-core/services/crashreport"
 	service "github.com/berachain/beacon-kit/node-core/services/registry"
 	"github.com/berachain/beacon-kit/node-core/types"
 )
@@ -31,6 +33,10 @@ import (
 func ProvideNode(
 	registry *service.Registry,
 	logger *phuslu.Logger,
+	cmtService *cometbft.Service[*phuslu.Logger],
+	crashReporter *crashreport.Reporter,
 ) types.Node {
-	return node.New[types.Node](registry, logger)
+	return node.New[types.Node](
+		registry, logger, cmtService.CommitMultiStore(), crashReporter,
+	)
 }
@@ -54,7 +54,7 @@ func ProvideLocalBuilder[
 	return payloadbuilder.New(
 		&in.Cfg.PayloadBuilder,
 		in.ChainSpec,
-		in.Logger.With("service", "payload-builder"),
+		log.ForService(in.Logger, log.ModuleValidator, "payload-builder"),
 		in.ExecutionEngine,
 		cache.NewPayloadIDCache[
 			[32]byte, math.Slot,
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	dastore "github.com/berachain/beacon-kit/da/store"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/observability/probes"
+)
+
+// ProbesServerInput is the input for the probes server provider.
+type ProbesServerInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	AppOpts         config.AppOptions
+	CometBFTService *cometbft.Service[LoggerT]
+	Config          *config.Config
+	EngineClient    *client.EngineClient
+	Logger          LoggerT
+	Role            types.Role `optional:"true"`
+}
+
+// ProvideProbesServer is a depinject provider for the probes server. The
+// node is ready once the execution client is reachable, the node is within
+// the configured number of slots of the head of its peers, and the
+// availability store is writable. Read-only nodes, which neither follow the
+// chain nor write to the stores, are ready as soon as they are started.
+func ProvideProbesServer[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in ProbesServerInput[LoggerT],
+) *probes.Server {
+	cfg := in.Config.Probes
+	s := probes.NewServer(
+		cfg, log.ForService(in.Logger, log.ModuleNode, "probes"),
+	)
+	if in.Role.ReadOnly() {
+		return s
+	}
+	s.AddReadinessCheck(
+		"execution-client",
+		func(ctx context.Context) error {
+			_, err := in.EngineClient.ChainID(ctx)
+			return err
+		},
+	)
+	s.AddReadinessCheck(
+		"sync",
+		func(context.Context) error {
+			behind, err := in.CometBFTService.BlocksBehind()
+			if err != nil {
+				return err
+			}
+			if behind > cfg.MaxSlotsBehind {
+				return fmt.Errorf(
+					"%d slots behind head, at most %d allowed",
+					behind, cfg.MaxSlotsBehind,
+				)
+			}
+			return nil
+		},
+	)
+	s.AddReadinessCheck(
+		"availability-store",
+		probes.WritableDir(
+			dastore.Dir(homeDirectory(in.AppOpts)),
+		),
+	)
+	return s
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/services/filewatch"
+	"github.com/berachain/beacon-kit/storage/metadata"
+)
+
+// ProposerConfigWatcherInput is the input for the proposer configuration
+// watcher provider.
+type ProposerConfigWatcherInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	Config        *config.Config
+	Logger        LoggerT
+	MetadataStore *metadata.KVStore
+}
+
+// ProvideProposerConfigWatcher provides the service applying the changes of
+// the validators metadata file, i.e. the proposer configuration, at runtime.
+func ProvideProposerConfigWatcher[
+	LoggerT log.AdvancedLogger[LoggerT],
+](in ProposerConfigWatcherInput[LoggerT]) *filewatch.Watcher {
+	path := in.Config.Validator.MetadataFile
+	return filewatch.NewWatcher(
+		log.ForService(in.Logger, log.ModuleValidator, "proposer-config"),
+		"proposer-config-watcher",
+		path,
+		in.Config.Validator.MetadataFileWatchInterval,
+		func() error {
+			return importMetadataFile(in.MetadataStore, path)
+		},
+	)
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	server "github.com/berachain/beacon-kit/cli/commands/server"
+	"github.com/berachain/beacon-kit/config"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/services/reload"
+	"github.com/berachain/beacon-kit/observability/diagnostics"
+	"github.com/berachain/beacon-kit/payload/attributes"
+	"github.com/berachain/beacon-kit/storage/metadata"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// ReloadServiceInput is the input for the reload service provider.
+type ReloadServiceInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	AppOpts           config.AppOptions
+	AttributesFactory *attributes.Factory
+	CometBFTService   *cometbft.Service[LoggerT]
+	DiagnosticsServer *diagnostics.Server
+	EngineClient      *client.EngineClient
+	Logger            LoggerT
+	MetadataStore     *metadata.KVStore
+}
+
+// ProvideReloadService is a depinject provider for the service reloading the
+// log levels, engine timeouts, fee recipients and pruning settings from the
+// application configuration file, upon SIGHUP or through the diagnostics
+// server.
+func ProvideReloadService[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in ReloadServiceInput[LoggerT],
+) *reload.Service {
+	svc := reload.NewService(
+		log.ForService(in.Logger, log.ModuleNode, "config-reloader"),
+		filepath.Join(
+			cast.ToString(in.AppOpts.Get(flags.FlagHome)),
+			"config", "app.toml",
+		),
+	)
+
+	if leveler, ok := any(in.Logger).(interface {
+		SetLogLevels(level string, moduleLevels string) error
+	}); ok {
+		svc.Register("log-levels", func(
+			_ config.AppOptions, cfg *config.Config,
+		) error {
+			return leveler.SetLogLevels(
+				cfg.GetLogger().LogLevel, cfg.GetLogger().ModuleLogLevels,
+			)
+		})
+	}
+	svc.Register("engine-timeouts", func(
+		_ config.AppOptions, cfg *config.Config,
+	) error {
+		in.EngineClient.SetRPCTimeout(cfg.GetEngine().RPCTimeout)
+		return nil
+	})
+	svc.Register("fee-recipient", func(
+		_ config.AppOptions, cfg *config.Config,
+	) error {
+		in.AttributesFactory.SetSuggestedFeeRecipient(
+			cfg.PayloadBuilder.SuggestedFeeRecipient,
+		)
+		return importMetadataFile(
+			in.MetadataStore, cfg.Validator.MetadataFile,
+		)
+	})
+	svc.Register("pruning", func(
+		appOpts config.AppOptions, _ *config.Config,
+	) error {
+		opts, err := server.GetPruningOptionsFromFlags(appOpts)
+		if err != nil {
+			return err
+		}
+		in.CometBFTService.UpdatePruning(
+			opts, cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks)),
+		)
+		return nil
+	})
+
+	in.DiagnosticsServer.SetReloader(svc)
+	return svc
+}
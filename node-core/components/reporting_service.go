@@ -24,8 +24,8 @@ import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/execution/client"
 	"github.com/berachain/beacon-kit/log"
-	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/node-core/services/version"
+	"github.com/berachain/beacon-kit/observability/telemetry"
 	sdkversion "github.com/cosmos/cosmos-sdk/version"
 )
 
@@ -34,7 +34,7 @@ type ReportingServiceInput[
 ] struct {
 	depinject.In
 	Logger        LoggerT
-	TelemetrySink *metrics.TelemetrySink
+	TelemetrySink *telemetry.PrometheusSink
 	EngineClient  *client.EngineClient
 }
 
@@ -44,7 +44,7 @@ func ProvideReportingService[
 	in ReportingServiceInput[LoggerT],
 ) *version.ReportingService {
 	return version.NewReportingService(
-		in.Logger.With("service", "reporting"),
+		log.ForService(in.Logger, log.ModuleNode, "reporting"),
 		in.TelemetrySink,
 		sdkversion.Version,
 		in.EngineClient,
@@ -28,10 +28,24 @@ import (
 	"github.com/berachain/beacon-kit/execution/client"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/server"
-	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/node-core/services/chainhealth"
+	"github.com/berachain/beacon-kit/node-core/services/filewatch"
+	"github.com/berachain/beacon-kit/node-core/services/keystores"
+	"github.com/berachain/beacon-kit/node-core/services/performance"
 	service "github.com/berachain/beacon-kit/node-core/services/registry"
+	"github.com/berachain/beacon-kit/node-core/services/reload"
+	"github.com/berachain/beacon-kit/node-core/services/syncstatus"
 	"github.com/berachain/beacon-kit/node-core/services/version"
+	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/observability/diagnostics"
+	"github.com/berachain/beacon-kit/observability/probes"
 	"github.com/berachain/beacon-kit/observability/telemetry"
+	"github.com/berachain/beacon-kit/observability/tracing"
+	"github.com/berachain/beacon-kit/storage/backfill"
+	"github.com/berachain/beacon-kit/storage/freezer"
+	"github.com/berachain/beacon-kit/storage/pruner"
+	"github.com/berachain/beacon-kit/storage/snapshot"
 )
 
 // ServiceRegistryInput is the input for the service registry provider.
@@ -47,21 +61,41 @@ type ServiceRegistryInput[
 	NodeAPIContextT NodeAPIContext,
 ] struct {
 	depinject.In
-	ChainService *blockchain.Service[
+	BackfillService    *backfill.Service
+	BackfillStore      *backfill.Store
+	ChainHealthService *chainhealth.Service
+	ChainService       *blockchain.Service[
 		AvailabilityStoreT, DepositStoreT,
 		ConsensusBlockT,
 		BeaconBlockStoreT,
 		GenesisT,
 		ConsensusSidecarsT,
 	]
-	EngineClient     *client.EngineClient
-	Logger           LoggerT
-	NodeAPIServer    *server.Server[NodeAPIContextT]
-	ReportingService *version.ReportingService
-	TelemetrySink    *metrics.TelemetrySink
-	TelemetryService *telemetry.Service
-	ValidatorService *validator.Service[DepositStoreT]
-	CometBFTService  *cometbft.Service[LoggerT]
+	DepositStore       DepositStoreT
+	DiagnosticsServer  *diagnostics.Server
+	EngineClient       *client.EngineClient
+	Extensions         []service.Extension `optional:"true"`
+	Freezer            *freezer.Freezer
+	FreezerService     *freezer.Service
+	Logger             LoggerT
+	NodeAPIServer      *server.Server[NodeAPIContextT]
+	ProbesServer       *probes.Server
+	PrometheusExporter *telemetry.Exporter
+	ReloadService      *reload.Service
+	ReportingService   *version.ReportingService
+	Role               types.Role
+	SnapshotService    *snapshot.Service
+	SnapshotStore      *snapshot.KVStore
+	SyncStatusService  *syncstatus.Service
+	TelemetrySink      *telemetry.PrometheusSink
+	TelemetryService   *telemetry.Service
+	TracingProvider    *tracing.Provider
+	ValidatorService   *validator.Service[DepositStoreT]
+	PerformanceService *performance.Service
+	ProposerConfig     *filewatch.Watcher
+	PrunerService      *pruner.Service
+	KeystoreManager    *keystores.Manager
+	CometBFTService    *cometbft.Service[LoggerT]
 }
 
 // ProvideServiceRegistry is the depinject provider for the service registry.
@@ -85,14 +119,89 @@ func ProvideServiceRegistry[
 		GenesisT, KVStoreT, LoggerT, NodeAPIContextT,
 	],
 ) *service.Registry {
-	return service.NewRegistry(
+	// The stores are closed once all the services using them are stopped.
+	storageService := storage.NewService(
+		log.ForService(in.Logger, log.ModuleStorage, "storage"),
+		in.DepositStore, in.SnapshotStore, in.Freezer, in.BackfillStore,
+	)
+	// The probes server is started first so that the node is reported live
+	// while waiting for the execution client.
+	opts := []service.RegistryOption{
 		service.WithLogger(in.Logger),
-		service.WithService(in.ValidatorService),
-		service.WithService(in.NodeAPIServer),
+		service.WithService(in.ProbesServer),
+		service.WithService(in.TracingProvider),
+		service.WithService(storageService),
+	}
+	// Only validator nodes run the validator service.
+	if in.Role.ProposesBlocks() {
+		opts = append(
+			opts,
+			service.WithService(in.ValidatorService),
+			service.WithService(in.ProposerConfig),
+			service.WithService(in.KeystoreManager),
+		)
+	}
+	opts = append(
+		opts,
+		service.WithService(
+			in.NodeAPIServer,
+			service.DependsOn(storageService.Name()),
+		),
 		service.WithService(in.ReportingService),
-		service.WithService(in.EngineClient),
 		service.WithService(in.TelemetryService),
-		service.WithService(in.ChainService),
-		service.WithService(in.CometBFTService),
+		service.WithService(in.PrometheusExporter),
+		service.WithService(in.DiagnosticsServer),
 	)
+	// Read-only nodes serve the state loaded from the stores, they neither
+	// follow the chain nor run the services writing to the stores.
+	if !in.Role.ReadOnly() {
+		// The catch-up mode is bounded by how far CometBFT lags behind
+		// its peers.
+		in.ChainService.SetSyncReporter(in.CometBFTService)
+		opts = append(
+			opts,
+			service.WithService(in.EngineClient),
+			service.WithService(
+				in.ChainService,
+				service.DependsOn(
+					in.EngineClient.Name(), storageService.Name(),
+				),
+			),
+			service.WithService(
+				in.CometBFTService,
+				service.DependsOn(in.ChainService.Name()),
+			),
+			service.WithService(
+				in.SnapshotService,
+				service.DependsOn(storageService.Name()),
+			),
+			service.WithService(
+				in.FreezerService,
+				service.DependsOn(storageService.Name()),
+			),
+			service.WithService(
+				in.BackfillService,
+				service.DependsOn(storageService.Name()),
+			),
+			service.WithService(in.PrunerService),
+			service.WithService(
+				in.ReloadService,
+				service.DependsOn(in.CometBFTService.Name()),
+			),
+			service.WithService(
+				in.SyncStatusService,
+				service.DependsOn(in.CometBFTService.Name()),
+			),
+			service.WithService(in.ChainHealthService),
+			service.WithService(in.PerformanceService),
+		)
+	}
+	// Extra services are registered last, they declare the services they
+	// must be started after.
+	for _, ext := range in.Extensions {
+		opts = append(opts, service.WithService(ext.Service, ext.Options...))
+	}
+	registry := service.NewRegistry(opts...)
+	in.DiagnosticsServer.SetHealthReporter(registry)
+	return registry
 }
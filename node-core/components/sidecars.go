@@ -24,13 +24,13 @@ import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/chain-spec/chain"
 	dablob "github.com/berachain/beacon-kit/da/blob"
-	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/observability/telemetry"
 )
 
 type SidecarFactoryInput struct {
 	depinject.In
 	ChainSpec     chain.ChainSpec
-	TelemetrySink *metrics.TelemetrySink
+	TelemetrySink *telemetry.PrometheusSink
 }
 
 func ProvideSidecarFactory(in SidecarFactoryInput) *dablob.SidecarFactory {
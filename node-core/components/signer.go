@@ -26,22 +26,51 @@ import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/node-core/types"
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/bls"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cast"
 )
 
-// BlsSignerInput is the input for the dep inject framework.
+// BlsSignerInput is the input for the dep inject framework. The signers use
+// the selected BLS implementation, which is thus provided first.
 type BlsSignerInput struct {
 	depinject.In
 	AppOpts config.AppOptions
-	PrivKey LegacyKey `optional:"true"`
+	BLS     bls.Implementation
+	Config  *config.Config
+	PrivKey LegacyKey  `optional:"true"`
+	Role    types.Role `optional:"true"`
+	// ThresholdTransport is the transport of the threshold signer provided
+	// by a plugin, if any, the configured middleware being used otherwise.
+	ThresholdTransport signer.Transport `optional:"true"`
 }
 
 // ProvideBlsSigner is a function that provides the module to the application.
+// Validators sign with the remote signer if one is configured, and otherwise
+// with the configured signer backend.
 func ProvideBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
+	if in.Config.RemoteSigner.Enabled() && in.Role.HasValidatorKey() {
+		return signer.NewRemoteSigner(in.Config.RemoteSigner)
+	}
+	if in.Role.HasValidatorKey() {
+		backend, err := signer.NewBackend(
+			in.Config.Signer, in.ThresholdTransport,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if backend != nil {
+			return signer.NewBackendSigner(backend, in.Config.Signer.Timeout)
+		}
+	}
 	if in.PrivKey == [constants.BLSSecretKeyLength]byte{} {
+		// nodes running without a validator key can only verify signatures
+		if !in.Role.HasValidatorKey() {
+			return signer.NewVerifier(), nil
+		}
 		// if no private key is provided, use privval signer
 		homeDir := cast.ToString(in.AppOpts.Get(flags.FlagHome))
 		privValKeyFile := cast.ToString(
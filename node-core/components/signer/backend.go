@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// Backend holds the BLS key of the validator outside of the signing path,
+// e.g. in a local keystore, a hardware security module reached through
+// PKCS#11 or a key management service. Backends are handed the messages
+// signed along with their signing roots, for them to enforce their own
+// policies.
+type Backend interface {
+	// PublicKey returns the public key of the key signed with.
+	PublicKey(ctx context.Context) (crypto.BLSPubkey, error)
+	// Sign signs the signing root of the given request.
+	Sign(
+		ctx context.Context, req *crypto.SigningRequest,
+	) (crypto.BLSSignature, error)
+}
+
+// BackendSigner is a BLS signer signing with a Backend, verifying the
+// signatures it returns.
+type BackendSigner struct {
+	backend Backend
+	pubkey  crypto.BLSPubkey
+	timeout time.Duration
+}
+
+// NewBackendSigner creates a new BackendSigner, the requests to the backend
+// timing out after the given timeout.
+func NewBackendSigner(
+	backend Backend,
+	timeout time.Duration,
+) (*BackendSigner, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	pubkey, err := backend.PublicKey(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "signer backend")
+	}
+	return &BackendSigner{
+		backend: backend,
+		pubkey:  pubkey,
+		timeout: timeout,
+	}, nil
+}
+
+// PublicKey returns the public key of the backend key.
+func (s *BackendSigner) PublicKey() crypto.BLSPubkey {
+	return s.pubkey
+}
+
+// Sign signs a bare signing root, which backends may refuse to sign.
+func (s *BackendSigner) Sign(msg []byte) (crypto.BLSSignature, error) {
+	req := &crypto.SigningRequest{}
+	if len(msg) != len(req.SigningRoot) {
+		return crypto.BLSSignature{}, ErrUntypedSigningRequest
+	}
+	copy(req.SigningRoot[:], msg)
+	return s.SignRequest(req)
+}
+
+// SignRequest signs the given request with the backend, and verifies the
+// signature returned.
+func (s *BackendSigner) SignRequest(
+	req *crypto.SigningRequest,
+) (crypto.BLSSignature, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	sig, err := s.backend.Sign(ctx, req)
+	if err != nil {
+		return crypto.BLSSignature{}, errors.Wrap(err, "signer backend")
+	}
+	// Do not trust the backend to sign with the expected key.
+	if err = s.VerifySignature(s.pubkey, req.SigningRoot[:], sig); err != nil {
+		return crypto.BLSSignature{}, errors.Wrap(err, "signer backend")
+	}
+	return sig, nil
+}
+
+// VerifySignature verifies a signature against a message and a public key.
+func (*BackendSigner) VerifySignature(
+	pubKey crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	return BLSSigner{}.VerifySignature(pubKey, msg, signature)
+}
+
+// VerifySignatures verifies all the signature sets at once.
+func (*BackendSigner) VerifySignatures(sets []crypto.SignatureSet) error {
+	return BLSSigner{}.VerifySignatures(sets)
+}
+
+// KeystoreBackend is a Backend signing with the key of a local EIP-2335
+// keystore, decrypted once when loaded.
+type KeystoreBackend struct {
+	signer *LegacySigner
+}
+
+// NewKeystoreBackend creates a new KeystoreBackend signing with the key of
+// the given keystore, decrypted with the password held by the given file.
+func NewKeystoreBackend(
+	keystoreFile string,
+	passwordFile string,
+) (*KeystoreBackend, error) {
+	ks, err := ReadKeystoreFile(keystoreFile)
+	if err != nil {
+		return nil, err
+	}
+	password, err := ReadPasswordFile(passwordFile)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ks.Decrypt(password)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := NewLegacySigner(key)
+	if err != nil {
+		return nil, err
+	}
+	return &KeystoreBackend{signer: signer}, nil
+}
+
+// PublicKey returns the public key of the keystore.
+func (b *KeystoreBackend) PublicKey(
+	context.Context,
+) (crypto.BLSPubkey, error) {
+	return b.signer.PublicKey(), nil
+}
+
+// Sign signs the signing root of the given request.
+func (b *KeystoreBackend) Sign(
+	_ context.Context, req *crypto.SigningRequest,
+) (crypto.BLSSignature, error) {
+	return b.signer.Sign(req.SigningRoot[:])
+}
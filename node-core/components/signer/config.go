@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+)
+
+// defaultRemoteTimeout is the default timeout of the requests to the remote
+// signer.
+const defaultRemoteTimeout = 2 * time.Second
+
+// RemoteConfig is the configuration of the Web3Signer-compatible remote
+// signer the blocks and exits of the validator are signed by, for its BLS
+// key to be kept off the node host.
+type RemoteConfig struct {
+	// URL is the base URL of the remote signer. The validator key of the
+	// node is used if empty.
+	URL string `mapstructure:"url"`
+	// Pubkey is the public key of the validator, identifying the key the
+	// remote signer signs with.
+	Pubkey string `mapstructure:"pubkey"`
+	// ClientCertFile is the PEM encoded certificate the node authenticates
+	// to the remote signer with.
+	ClientCertFile string `mapstructure:"client-cert-file"`
+	// ClientKeyFile is the PEM encoded key of the client certificate.
+	ClientKeyFile string `mapstructure:"client-key-file"`
+	// CACertFile is the PEM encoded CA certificate the certificate of the
+	// remote signer is verified against, the system roots being used if
+	// empty.
+	CACertFile string `mapstructure:"ca-cert-file"`
+	// Timeout is the timeout of the signing requests.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// DefaultRemoteConfig returns the default configuration of the remote
+// signer, which is disabled.
+func DefaultRemoteConfig() RemoteConfig {
+	return RemoteConfig{
+		Timeout: defaultRemoteTimeout,
+	}
+}
+
+// Enabled reports whether the validator signs with a remote signer.
+func (c RemoteConfig) Enabled() bool {
+	return c.URL != ""
+}
+
+// The signer backends.
+const (
+	// BackendLocal signs with the validator key of the node.
+	BackendLocal = "local"
+	// BackendKeystore signs with the key of a local EIP-2335 keystore.
+	BackendKeystore = "keystore"
+	// BackendExternal signs with an external signer process.
+	BackendExternal = "external"
+	// BackendThreshold signs with a key share of a distributed validator,
+	// along with the other operators of the validator.
+	BackendThreshold = "threshold"
+)
+
+// defaultBackendTimeout is the default timeout of the requests to the signer
+// backend.
+const defaultBackendTimeout = 2 * time.Second
+
+// defaultThresholdPollInterval is the default interval at which the partial
+// signatures of the other operators are polled from the transport.
+const defaultThresholdPollInterval = 50 * time.Millisecond
+
+// ThresholdConfig is the configuration of the threshold backend, signing with
+// a key share of a distributed validator along with the other operators of
+// the validator. The key share is held by the keystore of the backend.
+type ThresholdConfig struct {
+	// ShareIndex is the index of the key share of the node, from 1.
+	ShareIndex uint64 `mapstructure:"share-index"`
+	// Threshold is the number of partial signatures aggregated into a
+	// signature of the validator.
+	Threshold int `mapstructure:"threshold"`
+	// Pubkey is the public key of the distributed validator.
+	Pubkey string `mapstructure:"pubkey"`
+	// SharePubkeys are the public keys of the key shares of all the
+	// operators, in share index order, verifying their partial signatures.
+	SharePubkeys []string `mapstructure:"share-pubkeys"`
+	// TransportURL is the base URL of the middleware the partial signatures
+	// are exchanged through, unless the node is built with a plugin
+	// providing the transport.
+	TransportURL string `mapstructure:"transport-url"`
+	// PollInterval is the interval at which the partial signatures of the
+	// other operators are polled from the middleware.
+	PollInterval time.Duration `mapstructure:"poll-interval"`
+}
+
+// Config is the configuration of the backend the blocks and exits of the
+// validator are signed with, unless a remote signer is configured.
+type Config struct {
+	// Backend is the signer backend, one of local, keystore, external or
+	// threshold.
+	Backend string `mapstructure:"backend"`
+	// KeystoreFile is the EIP-2335 keystore of the keystore and threshold
+	// backends.
+	KeystoreFile string `mapstructure:"keystore-file"`
+	// PasswordFile is the file holding the password of the keystore.
+	PasswordFile string `mapstructure:"password-file"`
+	// ExternalCommand is the command line of the external signer, e.g. a
+	// program signing with a hardware security module through PKCS#11 or
+	// with a key management service.
+	ExternalCommand []string `mapstructure:"external-command"`
+	// Threshold is the configuration of the threshold backend.
+	Threshold ThresholdConfig `mapstructure:"threshold"`
+	// Timeout is the timeout of the requests to the backend, i.e. the time
+	// the other operators are waited for by the threshold backend.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// DefaultConfig returns the default configuration of the signer backend,
+// signing with the validator key of the node.
+func DefaultConfig() Config {
+	return Config{
+		Backend:         BackendLocal,
+		ExternalCommand: []string{},
+		Threshold: ThresholdConfig{
+			SharePubkeys: []string{},
+			PollInterval: defaultThresholdPollInterval,
+		},
+		Timeout: defaultBackendTimeout,
+	}
+}
+
+// NewBackend creates the configured backend, or nil if the validator key of
+// the node is signed with. The threshold backend exchanges the partial
+// signatures over the given transport, or over the configured middleware if
+// nil.
+func NewBackend(cfg Config, transport Transport) (Backend, error) {
+	switch cfg.Backend {
+	case BackendLocal, "":
+		return nil, nil //nolint:nilnil // the validator key is signed with.
+	case BackendKeystore:
+		return NewKeystoreBackend(cfg.KeystoreFile, cfg.PasswordFile)
+	case BackendExternal:
+		return NewExternalBackend(cfg.ExternalCommand)
+	case BackendThreshold:
+		if transport == nil {
+			var err error
+			if transport, err = NewHTTPTransport(cfg.Threshold); err != nil {
+				return nil, err
+			}
+		}
+		return NewThresholdBackend(cfg, transport)
+	default:
+		return nil, errors.Wrap(ErrUnknownBackend, cfg.Backend)
+	}
+}
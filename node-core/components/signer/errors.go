@@ -38,4 +38,77 @@ var (
 	ErrInvalidValidatorPrivateKeyLength = errors.New(
 		"invalid validator private key length",
 	)
+
+	// ErrNoSigningKey is returned when signing with a verify-only signer.
+	ErrNoSigningKey = errors.New("signer has no signing key")
+
+	// ErrInvalidKeystorePassword is returned when a keystore cannot be
+	// decrypted with the given password.
+	ErrInvalidKeystorePassword = errors.New("invalid keystore password")
+
+	// ErrUnsupportedKeystore is returned when a keystore uses an unsupported
+	// version, crypto module or parameter.
+	ErrUnsupportedKeystore = errors.New("unsupported keystore")
+
+	// ErrUntypedSigningRequest is returned when the remote signer is asked
+	// to sign a bare signing root.
+	ErrUntypedSigningRequest = errors.New(
+		"remote signer only signs typed signing requests",
+	)
+
+	// ErrRemoteSigner is returned when the remote signer refuses to sign.
+	ErrRemoteSigner = errors.New("remote signer refused to sign")
+
+	// ErrIncompleteRemoteTLSConfig is returned when only one of the client
+	// certificate and key files of the remote signer is set.
+	ErrIncompleteRemoteTLSConfig = errors.New(
+		"remote signer client cert and key files must be set together",
+	)
+
+	// ErrNoRemoteSignerCA is returned when the CA file of the remote signer
+	// holds no certificate.
+	ErrNoRemoteSignerCA = errors.New("no certificate in remote signer ca file")
+
+	// ErrUnknownBackend is returned when the configured signer backend is
+	// unknown.
+	ErrUnknownBackend = errors.New("unknown signer backend")
+
+	// ErrNoExternalSignerCommand is returned when the external signer
+	// backend is configured without a command.
+	ErrNoExternalSignerCommand = errors.New("external signer command required")
+
+	// ErrExternalSigner is returned when the external signer fails a
+	// request.
+	ErrExternalSigner = errors.New("external signer failed")
+
+	// ErrExternalSignerExited is returned when the external signer exits
+	// while answering a request.
+	ErrExternalSignerExited = errors.New("external signer exited")
+
+	// ErrInvalidThresholdConfig is returned when the threshold backend is
+	// configured with a threshold or share index out of the range of its
+	// key shares.
+	ErrInvalidThresholdConfig = errors.New("invalid threshold signer config")
+
+	// ErrShareMismatch is returned when the key share of the threshold
+	// backend does not match its public key share.
+	ErrShareMismatch = errors.New(
+		"key share does not match its configured public key",
+	)
+
+	// ErrNoThresholdTransport is returned when the threshold backend is
+	// configured without a transport.
+	ErrNoThresholdTransport = errors.New(
+		"threshold signer transport url required",
+	)
+
+	// ErrThresholdNotReached is returned when the threshold backend does not
+	// receive enough valid partial signatures in time.
+	ErrThresholdNotReached = errors.New(
+		"not enough partial signatures received",
+	)
+
+	// ErrThresholdTransport is returned when the transport of the threshold
+	// backend fails a request.
+	ErrThresholdTransport = errors.New("threshold signer transport failed")
 )
@@ -0,0 +1,246 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+)
+
+// The methods of the external signer protocol.
+const (
+	externalMethodPublicKey = "public_key"
+	externalMethodSign      = "sign"
+)
+
+// externalRequest is a request sent to the external signer.
+type externalRequest struct {
+	ID     uint64 `json:"id"`
+	Method string `json:"method"`
+	Params any    `json:"params,omitempty"`
+}
+
+// externalSignParams are the parameters of the sign method.
+type externalSignParams struct {
+	Type                  string         `json:"type"`
+	SigningRoot           string         `json:"signing_root"`
+	ForkVersion           string         `json:"fork_version"`
+	GenesisValidatorsRoot string         `json:"genesis_validators_root"`
+	Message               map[string]any `json:"message,omitempty"`
+}
+
+// externalResponse is a response of the external signer.
+type externalResponse struct {
+	ID     uint64          `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  string          `json:"error"`
+}
+
+// externalProcess is a running external signer.
+type externalProcess struct {
+	cmd       *exec.Cmd
+	stdin     io.WriteCloser
+	responses chan externalResponse
+}
+
+// ExternalBackend is a Backend delegating signing to an external signer
+// process, which integrates the node with the signing devices or services
+// it has no native support for, e.g. hardware security modules reached
+// through a PKCS#11 module or key management services.
+//
+// The external signer is started on first use and restarted if it exits. It
+// is sent one JSON request per line on its standard input, and answers each
+// of them with one JSON response per line on its standard output, e.g.
+//
+//	{"id":1,"method":"public_key"}
+//	{"id":1,"result":"0x..."}
+//	{"id":2,"method":"sign","params":{"type":"BLOCK_V2","signing_root":"0x...",...}}
+//	{"id":2,"result":"0x..."}
+//	{"id":3,"method":"sign","params":{...}}
+//	{"id":3,"error":"refused"}
+//
+// It must exit once its standard input is closed. Its standard error is
+// forwarded to the one of the node.
+type ExternalBackend struct {
+	command []string
+
+	// mu serializes the requests.
+	mu     sync.Mutex
+	proc   *externalProcess
+	nextID uint64
+}
+
+// NewExternalBackend creates a new ExternalBackend running the given
+// command line.
+func NewExternalBackend(command []string) (*ExternalBackend, error) {
+	if len(command) == 0 {
+		return nil, ErrNoExternalSignerCommand
+	}
+	return &ExternalBackend{command: command}, nil
+}
+
+// PublicKey returns the public key of the key of the external signer.
+func (b *ExternalBackend) PublicKey(
+	ctx context.Context,
+) (crypto.BLSPubkey, error) {
+	var pubkey crypto.BLSPubkey
+	err := b.call(ctx, externalMethodPublicKey, nil, &pubkey)
+	return pubkey, err
+}
+
+// Sign requests the external signer to sign the given request.
+func (b *ExternalBackend) Sign(
+	ctx context.Context, req *crypto.SigningRequest,
+) (crypto.BLSSignature, error) {
+	var sig crypto.BLSSignature
+	err := b.call(ctx, externalMethodSign, &externalSignParams{
+		Type:                  req.Type,
+		SigningRoot:           req.SigningRoot.String(),
+		ForkVersion:           req.ForkVersion.String(),
+		GenesisValidatorsRoot: req.GenesisValidatorsRoot.String(),
+		Message:               req.Message,
+	}, &sig)
+	return sig, err
+}
+
+// Close stops the external signer, if running.
+func (b *ExternalBackend) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.stop()
+	return nil
+}
+
+// call sends a request to the external signer, starting it if needed, and
+// decodes its result into res. The external signer is stopped if it fails
+// to answer in time, for the next request not to read a stale response.
+func (b *ExternalBackend) call(
+	ctx context.Context, method string, params any, res any,
+) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.proc == nil {
+		if err := b.start(); err != nil {
+			return err
+		}
+	}
+
+	b.nextID++
+	bz, err := json.Marshal(&externalRequest{
+		ID: b.nextID, Method: method, Params: params,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err = b.proc.stdin.Write(append(bz, '\n')); err != nil {
+		b.stop()
+		return errors.Wrap(err, "external signer")
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			b.stop()
+			return errors.Wrap(ctx.Err(), "external signer")
+		case resp, ok := <-b.proc.responses:
+			if !ok {
+				b.stop()
+				return ErrExternalSignerExited
+			}
+			if resp.ID != b.nextID {
+				// A response to a request which timed out.
+				continue
+			}
+			if resp.Error != "" {
+				return fmt.Errorf(
+					"%w: %s: %s", ErrExternalSigner, method, resp.Error,
+				)
+			}
+			return errors.Wrap(
+				json.Unmarshal(resp.Result, res), "external signer",
+			)
+		}
+	}
+}
+
+// start starts the external signer. The lock must be held.
+func (b *ExternalBackend) start() error {
+	//#nosec:G204 // the command is configured by the operator.
+	cmd := exec.Command(b.command[0], b.command[1:]...)
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err = cmd.Start(); err != nil {
+		return errors.Wrap(err, "external signer")
+	}
+
+	responses := make(chan externalResponse)
+	go func() {
+		defer close(responses)
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			var resp externalResponse
+			if json.Unmarshal(scanner.Bytes(), &resp) != nil {
+				continue
+			}
+			responses <- resp
+		}
+	}()
+	b.proc = &externalProcess{
+		cmd: cmd, stdin: stdin, responses: responses,
+	}
+	return nil
+}
+
+// stop kills the external signer, if running. The lock must be held.
+func (b *ExternalBackend) stop() {
+	if b.proc == nil {
+		return
+	}
+	proc := b.proc
+	b.proc = nil
+	//#nosec:G104 // the process is killed anyway.
+	_ = proc.stdin.Close()
+	//#nosec:G104 // the process may have exited already.
+	_ = proc.cmd.Process.Kill()
+	go func() {
+		// Drain the responses for the reader to exit, and reap the process.
+		for range proc.responses {
+		}
+		//#nosec:G104 // the process was killed.
+		_ = proc.cmd.Wait()
+	}()
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/stretchr/testify/require"
+)
+
+// externalSignerEnv makes the test binary run as an external signer.
+const externalSignerEnv = "BEACON_KIT_TEST_EXTERNAL_SIGNER"
+
+// TestMain runs the test binary as an external signer if requested, which
+// signs the blocks with a fixed signature, refuses to sign anything else,
+// and hangs on the blocks of slot 0.
+func TestMain(m *testing.M) {
+	if os.Getenv(externalSignerEnv) == "" {
+		os.Exit(m.Run())
+	}
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		var req struct {
+			ID     uint64 `json:"id"`
+			Method string `json:"method"`
+			Params struct {
+				Type    string         `json:"type"`
+				Message map[string]any `json:"message"`
+			} `json:"params"`
+		}
+		if json.Unmarshal(scanner.Bytes(), &req) != nil {
+			os.Exit(1)
+		}
+		switch {
+		case req.Method == "public_key":
+			fmt.Printf(
+				`{"id":%d,"result":"%s"}`+"\n", req.ID,
+				crypto.BLSPubkey{0x01}.String(),
+			)
+		case req.Params.Type != crypto.SigningTypeBlock:
+			fmt.Printf(`{"id":%d,"error":"refused"}`+"\n", req.ID)
+		case req.Params.Message["slot"] == "0":
+			time.Sleep(time.Minute)
+		default:
+			sig := crypto.BLSSignature{0x02}
+			fmt.Printf(`{"id":%d,"result":"%s"}`+"\n", req.ID, sig.String())
+		}
+	}
+}
+
+func TestExternalBackend(t *testing.T) {
+	_, err := signer.NewExternalBackend(nil)
+	require.ErrorIs(t, err, signer.ErrNoExternalSignerCommand)
+
+	t.Setenv(externalSignerEnv, "1")
+	b, err := signer.NewExternalBackend([]string{os.Args[0]})
+	require.NoError(t, err)
+	defer b.Close()
+	ctx := context.Background()
+
+	pubkey, err := b.PublicKey(ctx)
+	require.NoError(t, err)
+	require.Equal(t, crypto.BLSPubkey{0x01}, pubkey)
+
+	sig, err := b.Sign(ctx, &crypto.SigningRequest{
+		Type:    crypto.SigningTypeBlock,
+		Message: map[string]any{"slot": "1"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, crypto.BLSSignature{0x02}, sig)
+
+	_, err = b.Sign(ctx, &crypto.SigningRequest{
+		Type: crypto.SigningTypeVoluntaryExit,
+	})
+	require.ErrorIs(t, err, signer.ErrExternalSigner)
+
+	// The external signer is restarted once it failed to answer in time.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	_, err = b.Sign(timeoutCtx, &crypto.SigningRequest{
+		Type:    crypto.SigningTypeBlock,
+		Message: map[string]any{"slot": "0"},
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	pubkey, err = b.PublicKey(ctx)
+	require.NoError(t, err)
+	require.Equal(t, crypto.BLSPubkey{0x01}, pubkey)
+
+	// The signatures of the backend are verified.
+	s, err := signer.NewBackendSigner(b, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, crypto.BLSPubkey{0x01}, s.PublicKey())
+	_, err = s.SignRequest(&crypto.SigningRequest{
+		Type:    crypto.SigningTypeBlock,
+		Message: map[string]any{"slot": "1"},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,307 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"unicode"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// KeystoreVersion is the EIP-2335 keystore version.
+	KeystoreVersion = 4
+
+	// KDFScrypt is the scrypt key derivation function.
+	KDFScrypt = "scrypt"
+	// KDFPBKDF2 is the PBKDF2 key derivation function.
+	KDFPBKDF2 = "pbkdf2"
+
+	checksumSHA256  = "sha256"
+	cipherAES128CTR = "aes-128-ctr"
+	prfHMACSHA256   = "hmac-sha256"
+
+	// Key derivation parameters recommended by EIP-2335.
+	keystoreDKLen   = 32
+	keystoreSaltLen = 32
+	scryptN         = 262144
+	scryptR         = 8
+	scryptP         = 1
+	pbkdf2C         = 262144
+)
+
+// Keystore is an EIP-2335 keystore holding an encrypted BLS secret key.
+// https://eips.ethereum.org/EIPS/eip-2335
+type Keystore struct {
+	Crypto      KeystoreCrypto `json:"crypto"`
+	Description string         `json:"description"`
+	Pubkey      string         `json:"pubkey"`
+	Path        string         `json:"path"`
+	UUID        string         `json:"uuid"`
+	Version     uint           `json:"version"`
+}
+
+// KeystoreCrypto holds the modules used to encrypt the secret key.
+type KeystoreCrypto struct {
+	KDF      KeystoreModule `json:"kdf"`
+	Checksum KeystoreModule `json:"checksum"`
+	Cipher   KeystoreModule `json:"cipher"`
+}
+
+// KeystoreModule is a keystore crypto module.
+type KeystoreModule struct {
+	Function string         `json:"function"`
+	Params   KeystoreParams `json:"params"`
+	Message  string         `json:"message"`
+}
+
+// KeystoreParams holds the parameters of all keystore crypto modules, only
+// the ones relevant to the module function being set.
+type KeystoreParams struct {
+	DKLen int    `json:"dklen,omitempty"`
+	N     int    `json:"n,omitempty"`
+	R     int    `json:"r,omitempty"`
+	P     int    `json:"p,omitempty"`
+	C     int    `json:"c,omitempty"`
+	PRF   string `json:"prf,omitempty"`
+	Salt  string `json:"salt,omitempty"`
+	IV    string `json:"iv,omitempty"`
+}
+
+// EncryptKeystore encrypts the given secret key, whose public key is pubkey,
+// into an EIP-2335 keystore using the given key derivation function.
+func EncryptKeystore(
+	key LegacyKey,
+	pubkey crypto.BLSPubkey,
+	password string,
+	kdf string,
+) (*Keystore, error) {
+	salt := make([]byte, keystoreSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+
+	kdfModule := KeystoreModule{
+		Function: kdf,
+		Params: KeystoreParams{
+			DKLen: keystoreDKLen,
+			Salt:  hex.EncodeToString(salt),
+		},
+	}
+	switch kdf {
+	case KDFScrypt:
+		kdfModule.Params.N = scryptN
+		kdfModule.Params.R = scryptR
+		kdfModule.Params.P = scryptP
+	case KDFPBKDF2:
+		kdfModule.Params.C = pbkdf2C
+		kdfModule.Params.PRF = prfHMACSHA256
+	default:
+		return nil, errors.Wrap(ErrUnsupportedKeystore, kdf)
+	}
+
+	dk, err := deriveKeystoreKey(kdfModule, password)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := aes128CTR(dk[:16], iv, key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	return &Keystore{
+		Crypto: KeystoreCrypto{
+			KDF: kdfModule,
+			Checksum: KeystoreModule{
+				Function: checksumSHA256,
+				Message:  hex.EncodeToString(keystoreChecksum(dk, cipherText)),
+			},
+			Cipher: KeystoreModule{
+				Function: cipherAES128CTR,
+				Params:   KeystoreParams{IV: hex.EncodeToString(iv)},
+				Message:  hex.EncodeToString(cipherText),
+			},
+		},
+		Pubkey:  hex.EncodeToString(pubkey[:]),
+		UUID:    id.String(),
+		Version: KeystoreVersion,
+	}, nil
+}
+
+// ReadKeystoreFile reads the keystore at the given path.
+func ReadKeystoreFile(path string) (*Keystore, error) {
+	bz, err := afero.ReadFile(afero.NewOsFs(), path)
+	if err != nil {
+		return nil, err
+	}
+	ks := new(Keystore)
+	if err = json.Unmarshal(bz, ks); err != nil {
+		return nil, errors.Wrapf(err, "invalid keystore %s", path)
+	}
+	return ks, nil
+}
+
+// ReadPasswordFile reads a keystore password from the given file, trimming
+// the trailing line break.
+func ReadPasswordFile(path string) (string, error) {
+	bz, err := afero.ReadFile(afero.NewOsFs(), path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(bz), "\r\n"), nil
+}
+
+// Decrypt decrypts the secret key held by the keystore.
+func (ks *Keystore) Decrypt(password string) (LegacyKey, error) {
+	if ks.Version != KeystoreVersion {
+		return LegacyKey{}, errors.Wrapf(
+			ErrUnsupportedKeystore, "version %d", ks.Version,
+		)
+	}
+	if ks.Crypto.Checksum.Function != checksumSHA256 {
+		return LegacyKey{}, errors.Wrap(
+			ErrUnsupportedKeystore, ks.Crypto.Checksum.Function,
+		)
+	}
+	if ks.Crypto.Cipher.Function != cipherAES128CTR {
+		return LegacyKey{}, errors.Wrap(
+			ErrUnsupportedKeystore, ks.Crypto.Cipher.Function,
+		)
+	}
+
+	dk, err := deriveKeystoreKey(ks.Crypto.KDF, password)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	cipherText, err := hex.DecodeString(ks.Crypto.Cipher.Message)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	checksum, err := hex.DecodeString(ks.Crypto.Checksum.Message)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	if !bytes.Equal(keystoreChecksum(dk, cipherText), checksum) {
+		return LegacyKey{}, ErrInvalidKeystorePassword
+	}
+
+	iv, err := hex.DecodeString(ks.Crypto.Cipher.Params.IV)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	secret, err := aes128CTR(dk[:16], iv, cipherText)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	if len(secret) != len(LegacyKey{}) {
+		return LegacyKey{}, ErrInvalidValidatorPrivateKeyLength
+	}
+	return LegacyKey(secret), nil
+}
+
+// deriveKeystoreKey derives the decryption key from the password as
+// specified by the keystore kdf module.
+func deriveKeystoreKey(
+	kdf KeystoreModule,
+	password string,
+) ([]byte, error) {
+	salt, err := hex.DecodeString(kdf.Params.Salt)
+	if err != nil {
+		return nil, err
+	}
+	if kdf.Params.DKLen < keystoreDKLen {
+		return nil, errors.Wrapf(
+			ErrUnsupportedKeystore, "dklen %d", kdf.Params.DKLen,
+		)
+	}
+
+	pw := processKeystorePassword(password)
+	switch kdf.Function {
+	case KDFScrypt:
+		return scrypt.Key(
+			pw, salt, kdf.Params.N, kdf.Params.R, kdf.Params.P, kdf.Params.DKLen,
+		)
+	case KDFPBKDF2:
+		if kdf.Params.PRF != prfHMACSHA256 {
+			return nil, errors.Wrap(ErrUnsupportedKeystore, kdf.Params.PRF)
+		}
+		return pbkdf2.Key(
+			pw, salt, kdf.Params.C, kdf.Params.DKLen, sha256.New,
+		), nil
+	default:
+		return nil, errors.Wrap(ErrUnsupportedKeystore, kdf.Function)
+	}
+}
+
+// processKeystorePassword normalizes the password to NFKD and strips the
+// control codes from it, as required by EIP-2335.
+func processKeystorePassword(password string) []byte {
+	var buf bytes.Buffer
+	for _, r := range norm.NFKD.String(password) {
+		if unicode.IsControl(r) {
+			continue
+		}
+		buf.WriteRune(r)
+	}
+	return buf.Bytes()
+}
+
+// keystoreChecksum computes the checksum of the cipher text.
+func keystoreChecksum(dk, cipherText []byte) []byte {
+	sum := sha256.Sum256(append(bytes.Clone(dk[16:32]), cipherText...))
+	return sum[:]
+}
+
+// aes128CTR encrypts or decrypts the given text using AES-128-CTR.
+func aes128CTR(key, iv, text []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, errors.Wrapf(ErrUnsupportedKeystore, "iv length %d", len(iv))
+	}
+	out := make([]byte, len(text))
+	cipher.NewCTR(block, iv).XORKeyStream(out, text)
+	return out, nil
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeystoreRoundTrip(t *testing.T) {
+	key := signer.LegacyKey{0x01, 0x02, 0x03}
+	pubkey := crypto.BLSPubkey{0x0a}
+	password := "testpassword\u007f"
+
+	ks, err := signer.EncryptKeystore(key, pubkey, password, signer.KDFPBKDF2)
+	require.NoError(t, err)
+	require.Equal(t, uint(signer.KeystoreVersion), ks.Version)
+	require.Equal(t, signer.KDFPBKDF2, ks.Crypto.KDF.Function)
+
+	// keystores must survive a JSON round trip
+	bz, err := json.Marshal(ks)
+	require.NoError(t, err)
+	decoded := new(signer.Keystore)
+	require.NoError(t, json.Unmarshal(bz, decoded))
+
+	got, err := decoded.Decrypt(password)
+	require.NoError(t, err)
+	require.Equal(t, key, got)
+
+	// control codes are stripped from passwords
+	got, err = decoded.Decrypt("testpassword")
+	require.NoError(t, err)
+	require.Equal(t, key, got)
+
+	_, err = decoded.Decrypt("wrongpassword")
+	require.ErrorIs(t, err, signer.ErrInvalidKeystorePassword)
+}
+
+func TestKeystoreUnsupported(t *testing.T) {
+	_, err := signer.EncryptKeystore(
+		signer.LegacyKey{}, crypto.BLSPubkey{}, "password", "argon2",
+	)
+	require.ErrorIs(t, err, signer.ErrUnsupportedKeystore)
+
+	ks, err := signer.EncryptKeystore(
+		signer.LegacyKey{}, crypto.BLSPubkey{}, "password", signer.KDFPBKDF2,
+	)
+	require.NoError(t, err)
+	ks.Version = 3
+	_, err = ks.Decrypt("password")
+	require.ErrorIs(t, err, signer.ErrUnsupportedKeystore)
+}
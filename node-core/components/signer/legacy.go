@@ -25,39 +25,37 @@ import (
 
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
-	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/berachain/beacon-kit/primitives/crypto/bls"
 )
 
-// LegacySigner is a BLS12-381 signer that uses a bls.PrivKey for signing.
+// LegacySigner is a BLS12-381 signer that signs with a secret key held in
+// memory, using the selected BLS implementation.
 type LegacySigner struct {
-	bls12381.PrivKey
+	key    LegacyKey
+	pubkey crypto.BLSPubkey
 }
 
 // NewLegacySigner creates a new Signer instance given a secret key.
 func NewLegacySigner(
 	keyBz LegacyKey,
 ) (*LegacySigner, error) {
-	pk, err := bls12381.NewPrivateKeyFromBytes(keyBz[:])
+	pubkey, err := bls.SecretToPubkey(keyBz[:])
 	if err != nil {
 		return nil, err
 	}
-	return &LegacySigner{PrivKey: *pk}, nil
+	return &LegacySigner{key: keyBz, pubkey: pubkey}, nil
 }
 
 // PublicKey returns the public key of the signer.
 func (b *LegacySigner) PublicKey() crypto.BLSPubkey {
-	return crypto.BLSPubkey(b.PubKey().Bytes())
+	return b.pubkey
 }
 
 // Sign generates a signature for a given message using the signer's secret key.
 // It returns the signature and any error encountered during the signing
 // process.
 func (b *LegacySigner) Sign(msg []byte) (crypto.BLSSignature, error) {
-	sig, err := b.PrivKey.Sign(msg)
-	if err != nil {
-		return crypto.BLSSignature{}, err
-	}
-	return crypto.BLSSignature(sig), nil
+	return bls.Sign(b.key[:], msg)
 }
 
 // VerifySignature verifies a signature against a message and public key.
@@ -66,16 +64,17 @@ func (LegacySigner) VerifySignature(
 	msg []byte,
 	signature crypto.BLSSignature,
 ) error {
-	pk, err := bls12381.NewPublicKeyFromBytes(pubKey[:])
-	if err != nil {
-		return err
-	}
-	if !pk.VerifySignature(msg, signature[:]) {
+	if !bls.Verify(pubKey, msg, signature) {
 		return ErrInvalidSignature
 	}
 	return nil
 }
 
+// VerifySignatures verifies all the signature sets at once.
+func (LegacySigner) VerifySignatures(sets []crypto.SignatureSet) error {
+	return BLSSigner{}.VerifySignatures(sets)
+}
+
 // LegacyKey is a byte array that represents a BLS12-381 secret key.
 type LegacyKey [constants.BLSSecretKeyLength]byte
 
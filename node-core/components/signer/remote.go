@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+)
+
+// signPath is the path of the Web3Signer endpoint signing with the key of
+// the given public key.
+const signPath = "/api/v1/eth2/sign/"
+
+// maxResponseSize bounds the size of the responses read from the remote
+// signer.
+const maxResponseSize = 1 << 16
+
+// RemoteSigner is a BLS signer sending the signing requests to a
+// Web3Signer-compatible remote signer over mutually authenticated TLS. The
+// remote signer is handed the messages signed along with their signing
+// roots, for it to enforce its own slashing protection.
+type RemoteSigner struct {
+	url    string
+	pubkey crypto.BLSPubkey
+	client *http.Client
+}
+
+// NewRemoteSigner creates a new RemoteSigner as configured.
+func NewRemoteSigner(cfg RemoteConfig) (*RemoteSigner, error) {
+	var pubkey crypto.BLSPubkey
+	if err := pubkey.UnmarshalText([]byte(cfg.Pubkey)); err != nil {
+		return nil, errors.Wrapf(err, "remote signer pubkey %s", cfg.Pubkey)
+	}
+	tlsCfg, err := cfg.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	return &RemoteSigner{
+		url:    strings.TrimSuffix(cfg.URL, "/"),
+		pubkey: pubkey,
+		client: &http.Client{
+			Timeout:   cfg.Timeout,
+			Transport: &http.Transport{TLSClientConfig: tlsCfg},
+		},
+	}, nil
+}
+
+// PublicKey returns the public key of the validator signed for.
+func (s *RemoteSigner) PublicKey() crypto.BLSPubkey {
+	return s.pubkey
+}
+
+// Sign always fails, since the remote signer refuses to sign bare signing
+// roots without the message they are the root of.
+func (*RemoteSigner) Sign([]byte) (crypto.BLSSignature, error) {
+	return crypto.BLSSignature{}, ErrUntypedSigningRequest
+}
+
+// SignRequest requests the remote signer to sign the given request, and
+// verifies the signature returned.
+func (s *RemoteSigner) SignRequest(
+	req *crypto.SigningRequest,
+) (crypto.BLSSignature, error) {
+	body, err := remoteRequestBody(req)
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	httpReq, err := http.NewRequestWithContext(
+		context.Background(),
+		http.MethodPost,
+		s.url+signPath+s.pubkey.String(),
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	res, err := s.client.Do(httpReq)
+	if err != nil {
+		return crypto.BLSSignature{}, errors.Wrap(err, "remote signer")
+	}
+	defer res.Body.Close()
+	resBody, err := io.ReadAll(io.LimitReader(res.Body, maxResponseSize))
+	if err != nil {
+		return crypto.BLSSignature{}, errors.Wrap(err, "remote signer")
+	}
+	if res.StatusCode != http.StatusOK {
+		return crypto.BLSSignature{}, fmt.Errorf(
+			"%w: %s %s: %s", ErrRemoteSigner, req.Type, res.Status,
+			strings.TrimSpace(string(resBody)),
+		)
+	}
+
+	var signed struct {
+		Signature crypto.BLSSignature `json:"signature"`
+	}
+	if err = json.Unmarshal(resBody, &signed); err != nil {
+		return crypto.BLSSignature{}, errors.Wrap(err, "remote signer")
+	}
+	// Do not trust the remote signer to sign with the expected key.
+	if err = s.VerifySignature(
+		s.pubkey, req.SigningRoot[:], signed.Signature,
+	); err != nil {
+		return crypto.BLSSignature{}, errors.Wrap(err, "remote signer")
+	}
+	return signed.Signature, nil
+}
+
+// VerifySignature verifies a signature against a message and a public key.
+func (*RemoteSigner) VerifySignature(
+	pubKey crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	return BLSSigner{}.VerifySignature(pubKey, msg, signature)
+}
+
+// VerifySignatures verifies all the signature sets at once.
+func (*RemoteSigner) VerifySignatures(sets []crypto.SignatureSet) error {
+	return BLSSigner{}.VerifySignatures(sets)
+}
+
+// remoteRequestBody returns the JSON encoded body of the Web3Signer request
+// signing the given request. The fork is reported as having always been
+// active, for the domain to be computed from its current version only.
+func remoteRequestBody(req *crypto.SigningRequest) ([]byte, error) {
+	body := map[string]any{
+		"type":        req.Type,
+		"signingRoot": req.SigningRoot.String(),
+		"fork_info": map[string]any{
+			"fork": map[string]string{
+				"previous_version": req.ForkVersion.String(),
+				"current_version":  req.ForkVersion.String(),
+				"epoch":            "0",
+			},
+			"genesis_validators_root": req.GenesisValidatorsRoot.String(),
+		},
+	}
+	for name, msg := range req.Message {
+		body[name] = msg
+	}
+	return json.Marshal(body)
+}
+
+// tlsConfig returns the TLS configuration the remote signer is requested
+// with, presenting the client certificate if configured.
+func (c RemoteConfig) tlsConfig() (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	switch {
+	case c.ClientCertFile == "" && c.ClientKeyFile == "":
+	case c.ClientCertFile == "" || c.ClientKeyFile == "":
+		return nil, ErrIncompleteRemoteTLSConfig
+	default:
+		cert, err := tls.LoadX509KeyPair(c.ClientCertFile, c.ClientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	if c.CACertFile == "" {
+		return cfg, nil
+	}
+
+	pem, err := os.ReadFile(c.CACertFile)
+	if err != nil {
+		return nil, err
+	}
+	cfg.RootCAs = x509.NewCertPool()
+	if !cfg.RootCAs.AppendCertsFromPEM(pem) {
+		return nil, ErrNoRemoteSignerCA
+	}
+	return cfg, nil
+}
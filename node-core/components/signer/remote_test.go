@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/stretchr/testify/require"
+)
+
+// testCert is a certificate along with its key, signed by parent if any
+// and self signed otherwise.
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCert(
+	t *testing.T, serial int64, parent *testCert, usage x509.ExtKeyUsage,
+) *testCert {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	signer, signerKey := tmpl, key
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(
+		rand.Reader, tmpl, signer, &key.PublicKey, signerKey,
+	)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCert{cert: cert, key: key}
+}
+
+// write writes the PEM encoded certificate and key into dir, returning
+// their paths.
+func (c *testCert) write(t *testing.T, dir, name string) (string, string) {
+	t.Helper()
+	keyDER, err := x509.MarshalECPrivateKey(c.key)
+	require.NoError(t, err)
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(
+		&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw},
+	), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(
+		&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER},
+	), 0o600))
+	return certFile, keyFile
+}
+
+func TestRemoteSigner(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, 1, nil, x509.ExtKeyUsageAny)
+	caFile, _ := ca.write(t, dir, "ca")
+	serverCert := newTestCert(t, 2, ca, x509.ExtKeyUsageServerAuth)
+	clientCert := newTestCert(t, 3, ca, x509.ExtKeyUsageClientAuth)
+
+	signature := crypto.BLSSignature{0x01}
+	var (
+		paths  []string
+		bodies []map[string]any
+		status = http.StatusOK
+	)
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			bz, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var body map[string]any
+			require.NoError(t, json.Unmarshal(bz, &body))
+			paths = append(paths, r.URL.Path)
+			bodies = append(bodies, body)
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(
+				`{"signature":"` + signature.String() + `"}`,
+			))
+		},
+	))
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca.cert)
+	srv.TLS = &tls.Config{
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{serverCert.cert.Raw},
+			PrivateKey:  serverCert.key,
+		}},
+		ClientCAs:  clientCAs,
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		MinVersion: tls.VersionTLS12,
+	}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	pubkey := crypto.BLSPubkey{0x0a}
+	cfg := signer.DefaultRemoteConfig()
+	cfg.URL = srv.URL + "/"
+	cfg.Pubkey = pubkey.String()
+	cfg.CACertFile = caFile
+	cfg.ClientCertFile, cfg.ClientKeyFile = clientCert.write(t, dir, "client")
+	s, err := signer.NewRemoteSigner(cfg)
+	require.NoError(t, err)
+	require.Equal(t, pubkey, s.PublicKey())
+
+	// Bare signing roots are not signed.
+	_, err = s.Sign([]byte{0x01})
+	require.ErrorIs(t, err, signer.ErrUntypedSigningRequest)
+
+	forkData := ctypes.NewForkData(common.Version{0x04}, common.Root{0x0b})
+	header := ctypes.NewBeaconBlockHeader(
+		7, 3, common.Root{0x01}, common.Root{0x02}, common.Root{0x03},
+	)
+	root := common.Root{0x0c}
+	_, err = crypto.SignRequest(
+		s, ctypes.NewBlockSigningRequest(forkData, header, root),
+	)
+	// The signature returned does not verify against the pubkey.
+	require.Error(t, err)
+	require.Equal(t, []string{"/api/v1/eth2/sign/" + pubkey.String()}, paths)
+	require.Equal(t, map[string]any{
+		"type":        "BLOCK_V2",
+		"signingRoot": root.Hex(),
+		"fork_info": map[string]any{
+			"fork": map[string]any{
+				"previous_version": "0x04000000",
+				"current_version":  "0x04000000",
+				"epoch":            "0",
+			},
+			"genesis_validators_root": common.Root{0x0b}.Hex(),
+		},
+		"beacon_block": map[string]any{
+			"version": "DENEB",
+			"block_header": map[string]any{
+				"slot":           "7",
+				"proposer_index": "3",
+				"parent_root":    common.Root{0x01}.Hex(),
+				"state_root":     common.Root{0x02}.Hex(),
+				"body_root":      common.Root{0x03}.Hex(),
+			},
+		},
+	}, bodies[0])
+
+	status = http.StatusPreconditionFailed
+	exit := &ctypes.VoluntaryExit{Epoch: 5, ValidatorIndex: 3}
+	_, err = crypto.SignRequest(
+		s, ctypes.NewVoluntaryExitSigningRequest(forkData, exit, root),
+	)
+	require.ErrorIs(t, err, signer.ErrRemoteSigner)
+	require.Equal(t, "VOLUNTARY_EXIT", bodies[1]["type"])
+	require.Equal(t, map[string]any{
+		"epoch": "5", "validator_index": "3",
+	}, bodies[1]["voluntary_exit"])
+
+	// The remote signer requires a client certificate.
+	cfg.ClientCertFile, cfg.ClientKeyFile = "", ""
+	s, err = signer.NewRemoteSigner(cfg)
+	require.NoError(t, err)
+	_, err = crypto.SignRequest(
+		s, ctypes.NewVoluntaryExitSigningRequest(forkData, exit, root),
+	)
+	require.Error(t, err)
+	require.Len(t, bodies, 2)
+}
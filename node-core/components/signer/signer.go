@@ -21,11 +21,10 @@
 package signer
 
 import (
-	"fmt"
-
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/bls"
 	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/types"
@@ -83,11 +82,15 @@ func (f BLSSigner) VerifySignature(
 	msg []byte,
 	signature crypto.BLSSignature,
 ) error {
-	pk, err := bls12381.NewPublicKeyFromCompressedBytes(pubKey[:])
-	if err != nil {
-		return fmt.Errorf("verifying signature: %w", err)
+	if !bls.Verify(pubKey, msg, signature) {
+		return ErrInvalidSignature
 	}
-	if !pk.VerifySignature(msg, signature[:]) {
+	return nil
+}
+
+// VerifySignatures verifies all the signature sets at once.
+func (f BLSSigner) VerifySignatures(sets []crypto.SignatureSet) error {
+	if !bls.VerifyBatch(sets) {
 		return ErrInvalidSignature
 	}
 	return nil
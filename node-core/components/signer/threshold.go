@@ -0,0 +1,321 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	pbytes "github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/bls"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+)
+
+// partialSignaturesPath is the path of the middleware endpoint the partial
+// signatures of the operators are exchanged through.
+const partialSignaturesPath = "/v1/partial_signatures"
+
+// PartialSignature is a signature made with the key share of an operator of
+// a distributed validator.
+type PartialSignature struct {
+	// ShareIndex is the index of the key share signed with, from 1.
+	ShareIndex uint64 `json:"share_index,string"`
+	// SigningRoot is the signing root signed.
+	SigningRoot pbytes.B32 `json:"signing_root"`
+	// Signature is the partial signature.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// Transport exchanges the partial signatures of the operators of a
+// distributed validator, e.g. through a DVT middleware. Nodes may be built
+// with a plugin providing their own transport.
+type Transport interface {
+	// Exchange sends the partial signature of the local operator of the
+	// given validator to the other operators, and returns the partial
+	// signatures of the same signing root received from them until ctx is
+	// done. The partial signatures received are verified by the caller.
+	Exchange(
+		ctx context.Context,
+		pubkey crypto.BLSPubkey,
+		partial PartialSignature,
+	) (<-chan PartialSignature, error)
+}
+
+// ThresholdBackend is a Backend of a distributed validator whose key is
+// split among operators, any threshold of which sign together. It signs with
+// the key share of the local operator, and aggregates its partial signature
+// with the ones of the other operators, exchanged over a Transport.
+type ThresholdBackend struct {
+	share        *LegacySigner
+	shareIndex   uint64
+	threshold    int
+	pubkey       crypto.BLSPubkey
+	sharePubkeys map[uint64]crypto.BLSPubkey
+	transport    Transport
+}
+
+// NewThresholdBackend creates a new ThresholdBackend signing with the key
+// share of the configured keystore, and exchanging partial signatures over
+// the given transport.
+func NewThresholdBackend(
+	cfg Config,
+	transport Transport,
+) (*ThresholdBackend, error) {
+	tc := cfg.Threshold
+	if tc.Threshold < 1 || tc.Threshold > len(tc.SharePubkeys) ||
+		tc.ShareIndex < 1 || tc.ShareIndex > uint64(len(tc.SharePubkeys)) {
+		return nil, errors.Wrapf(
+			ErrInvalidThresholdConfig,
+			"threshold %d, share index %d, %d key shares",
+			tc.Threshold, tc.ShareIndex, len(tc.SharePubkeys),
+		)
+	}
+	var pubkey crypto.BLSPubkey
+	if err := pubkey.UnmarshalText([]byte(tc.Pubkey)); err != nil {
+		return nil, errors.Wrapf(err, "threshold signer pubkey %s", tc.Pubkey)
+	}
+	sharePubkeys := make(map[uint64]crypto.BLSPubkey, len(tc.SharePubkeys))
+	for i, raw := range tc.SharePubkeys {
+		var sharePubkey crypto.BLSPubkey
+		if err := sharePubkey.UnmarshalText([]byte(raw)); err != nil {
+			return nil, errors.Wrapf(err, "threshold signer share %d", i+1)
+		}
+		sharePubkeys[uint64(i+1)] = sharePubkey
+	}
+
+	keystore, err := NewKeystoreBackend(cfg.KeystoreFile, cfg.PasswordFile)
+	if err != nil {
+		return nil, err
+	}
+	if keystore.signer.PublicKey() != sharePubkeys[tc.ShareIndex] {
+		return nil, errors.Wrapf(ErrShareMismatch, "share %d", tc.ShareIndex)
+	}
+	return &ThresholdBackend{
+		share:        keystore.signer,
+		shareIndex:   tc.ShareIndex,
+		threshold:    tc.Threshold,
+		pubkey:       pubkey,
+		sharePubkeys: sharePubkeys,
+		transport:    transport,
+	}, nil
+}
+
+// PublicKey returns the public key of the distributed validator.
+func (b *ThresholdBackend) PublicKey(
+	context.Context,
+) (crypto.BLSPubkey, error) {
+	return b.pubkey, nil
+}
+
+// Sign signs the signing root of the given request with the key share of
+// the local operator, and aggregates the partial signature with the ones of
+// the other operators received until ctx is done.
+func (b *ThresholdBackend) Sign(
+	ctx context.Context, req *crypto.SigningRequest,
+) (crypto.BLSSignature, error) {
+	partial, err := b.share.Sign(req.SigningRoot[:])
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	partials := map[uint64]crypto.BLSSignature{b.shareIndex: partial}
+	if b.threshold == 1 {
+		return bls.AggregatePartials(partials)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	received, err := b.transport.Exchange(ctx, b.pubkey, PartialSignature{
+		ShareIndex:  b.shareIndex,
+		SigningRoot: req.SigningRoot,
+		Signature:   partial,
+	})
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	for len(partials) < b.threshold {
+		select {
+		case p, ok := <-received:
+			if !ok {
+				return crypto.BLSSignature{}, errors.Wrapf(
+					ErrThresholdNotReached, "%d of %d",
+					len(partials), b.threshold,
+				)
+			}
+			if _, dup := partials[p.ShareIndex]; dup ||
+				!b.isValidPartial(req.SigningRoot, p) {
+				continue
+			}
+			partials[p.ShareIndex] = p.Signature
+		case <-ctx.Done():
+			return crypto.BLSSignature{}, errors.Wrapf(
+				ErrThresholdNotReached, "%d of %d: %v",
+				len(partials), b.threshold, ctx.Err(),
+			)
+		}
+	}
+	return bls.AggregatePartials(partials)
+}
+
+// isValidPartial reports whether the given partial signature is a valid
+// signature of the signing root with one of the key shares.
+func (b *ThresholdBackend) isValidPartial(
+	signingRoot pbytes.B32,
+	p PartialSignature,
+) bool {
+	sharePubkey, ok := b.sharePubkeys[p.ShareIndex]
+	return ok && p.SigningRoot == signingRoot &&
+		bls.Verify(sharePubkey, signingRoot[:], p.Signature)
+}
+
+// HTTPTransport is a Transport exchanging the partial signatures through an
+// HTTP middleware shared by the operators. The partial signature of the
+// local operator is posted to the middleware, which is then polled for the
+// ones of the others.
+type HTTPTransport struct {
+	url      string
+	interval time.Duration
+	client   *http.Client
+}
+
+// NewHTTPTransport creates a new HTTPTransport exchanging the partial
+// signatures through the configured middleware.
+func NewHTTPTransport(cfg ThresholdConfig) (*HTTPTransport, error) {
+	if cfg.TransportURL == "" {
+		return nil, ErrNoThresholdTransport
+	}
+	interval := cfg.PollInterval
+	if interval <= 0 {
+		interval = defaultThresholdPollInterval
+	}
+	return &HTTPTransport{
+		url:      strings.TrimSuffix(cfg.TransportURL, "/"),
+		interval: interval,
+		client:   &http.Client{},
+	}, nil
+}
+
+// Exchange posts the partial signature of the local operator to the
+// middleware, and polls it for the partial signatures of the same signing
+// root until ctx is done.
+func (t *HTTPTransport) Exchange(
+	ctx context.Context,
+	pubkey crypto.BLSPubkey,
+	partial PartialSignature,
+) (<-chan PartialSignature, error) {
+	body, err := json.Marshal(struct {
+		Pubkey crypto.BLSPubkey `json:"pubkey"`
+		PartialSignature
+	}{pubkey, partial})
+	if err != nil {
+		return nil, err
+	}
+	if _, err = t.do(
+		ctx, http.MethodPost, t.url+partialSignaturesPath, body,
+	); err != nil {
+		return nil, err
+	}
+
+	received := make(chan PartialSignature)
+	url := fmt.Sprintf(
+		"%s%s/%s/%s", t.url, partialSignaturesPath, pubkey, partial.SigningRoot,
+	)
+	go func() {
+		defer close(received)
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+		for {
+			// Failed polls are retried until ctx is done.
+			if partials, pollErr := t.poll(ctx, url); pollErr == nil {
+				for _, p := range partials {
+					select {
+					case received <- p:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return received, nil
+}
+
+// poll returns the partial signatures held by the middleware at the given
+// URL.
+func (t *HTTPTransport) poll(
+	ctx context.Context,
+	url string,
+) ([]PartialSignature, error) {
+	resBody, err := t.do(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	var res struct {
+		Data []PartialSignature `json:"data"`
+	}
+	if err = json.Unmarshal(resBody, &res); err != nil {
+		return nil, errors.Wrap(ErrThresholdTransport, err.Error())
+	}
+	return res.Data, nil
+}
+
+// do sends a request to the middleware, returning the body of the response.
+func (t *HTTPTransport) do(
+	ctx context.Context,
+	method, url string,
+	body []byte,
+) ([]byte, error) {
+	req, err := http.NewRequestWithContext(
+		ctx, method, url, bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	res, err := t.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(ErrThresholdTransport, err.Error())
+	}
+	defer res.Body.Close()
+	resBody, err := io.ReadAll(io.LimitReader(res.Body, maxResponseSize))
+	if err != nil {
+		return nil, errors.Wrap(ErrThresholdTransport, err.Error())
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"%w: %s %s: %s", ErrThresholdTransport, method, res.Status,
+			strings.TrimSpace(string(resBody)),
+		)
+	}
+	return resBody, nil
+}
@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/bls"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/stretchr/testify/require"
+)
+
+// testMiddleware is a middleware holding the partial signatures posted by
+// the operators, by path.
+type testMiddleware struct {
+	mu       sync.Mutex
+	partials map[string][]signer.PartialSignature
+}
+
+func (m *testMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if r.Method == http.MethodPost {
+		var p struct {
+			Pubkey crypto.BLSPubkey `json:"pubkey"`
+			signer.PartialSignature
+		}
+		bz, err := io.ReadAll(r.Body)
+		if err == nil {
+			err = json.Unmarshal(bz, &p)
+		}
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		path := r.URL.Path + "/" + p.Pubkey.String() + "/" +
+			p.SigningRoot.String()
+		m.partials[path] = append(m.partials[path], p.PartialSignature)
+		return
+	}
+	bz, _ := json.Marshal(map[string]any{"data": m.partials[r.URL.Path]})
+	_, _ = w.Write(bz)
+}
+
+func TestThresholdBackend(t *testing.T) {
+	secret := signer.LegacyKey{31: 7}
+	pubkey, err := bls.SecretToPubkey(secret[:])
+	require.NoError(t, err)
+	shares, err := bls.SplitSecret(secret[:], 2, 3)
+	require.NoError(t, err)
+	sharePubkeys := make([]string, len(shares))
+	for i, share := range shares {
+		var sharePubkey crypto.BLSPubkey
+		sharePubkey, err = bls.SecretToPubkey(share)
+		require.NoError(t, err)
+		sharePubkeys[i-1] = sharePubkey.String()
+	}
+
+	middleware := httptest.NewServer(&testMiddleware{
+		partials: make(map[string][]signer.PartialSignature),
+	})
+	defer middleware.Close()
+	dir := t.TempDir()
+	passwordFile := filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(passwordFile, []byte("pw"), 0o600))
+	newBackend := func(index uint64) signer.Backend {
+		share := signer.LegacyKey(shares[index])
+		ks, encErr := signer.EncryptKeystore(
+			share, crypto.BLSPubkey{}, "pw", signer.KDFPBKDF2,
+		)
+		require.NoError(t, encErr)
+		ks.Pubkey = strings.TrimPrefix(sharePubkeys[index-1], "0x")
+		bz, encErr := json.Marshal(ks)
+		require.NoError(t, encErr)
+		keystoreFile := filepath.Join(dir, ks.UUID+".json")
+		require.NoError(t, os.WriteFile(keystoreFile, bz, 0o600))
+
+		cfg := signer.DefaultConfig()
+		cfg.Backend = signer.BackendThreshold
+		cfg.KeystoreFile = keystoreFile
+		cfg.PasswordFile = passwordFile
+		cfg.Threshold = signer.ThresholdConfig{
+			ShareIndex:   index,
+			Threshold:    2,
+			Pubkey:       pubkey.String(),
+			SharePubkeys: sharePubkeys,
+			TransportURL: middleware.URL,
+			PollInterval: 10 * time.Millisecond,
+		}
+		backend, newErr := signer.NewBackend(cfg, nil)
+		require.NoError(t, newErr)
+		return backend
+	}
+
+	// Operators sign the same signing root with the key of the validator,
+	// once the threshold of them signed it.
+	req := &crypto.SigningRequest{SigningRoot: [32]byte{1}}
+	var wg sync.WaitGroup
+	for _, index := range []uint64{1, 3} {
+		backend := newBackend(index)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(
+				context.Background(), 5*time.Second,
+			)
+			defer cancel()
+			sig, signErr := backend.Sign(ctx, req)
+			require.NoError(t, signErr)
+			require.True(t, bls.Verify(pubkey, req.SigningRoot[:], sig))
+		}()
+	}
+	wg.Wait()
+
+	// Operators not joined by enough others time out.
+	backend := newBackend(2)
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 100*time.Millisecond,
+	)
+	defer cancel()
+	_, err = backend.Sign(
+		ctx, &crypto.SigningRequest{SigningRoot: [32]byte{2}},
+	)
+	require.ErrorIs(t, err, signer.ErrThresholdNotReached)
+}
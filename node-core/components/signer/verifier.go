@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import "github.com/berachain/beacon-kit/primitives/crypto"
+
+// Verifier is a BLS signer holding no key. It verifies signatures but fails
+// to sign, and is used by nodes running without a validator key.
+type Verifier struct{}
+
+// NewVerifier creates a new Verifier instance.
+func NewVerifier() *Verifier {
+	return &Verifier{}
+}
+
+// PublicKey returns an empty public key, since the verifier holds no key.
+func (Verifier) PublicKey() crypto.BLSPubkey {
+	return crypto.BLSPubkey{}
+}
+
+// Sign always fails, since the verifier holds no key.
+func (Verifier) Sign([]byte) (crypto.BLSSignature, error) {
+	return crypto.BLSSignature{}, ErrNoSigningKey
+}
+
+// VerifySignature verifies a signature against a message and a public key.
+func (Verifier) VerifySignature(
+	pubKey crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	return BLSSigner{}.VerifySignature(pubKey, msg, signature)
+}
+
+// VerifySignatures verifies all the signature sets at once.
+func (Verifier) VerifySignatures(sets []crypto.SignatureSet) error {
+	return BLSSigner{}.VerifySignatures(sets)
+}
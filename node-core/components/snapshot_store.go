@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/storage/freezer"
+	"github.com/berachain/beacon-kit/storage/snapshot"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// SnapshotStoreInput is the input for the dep inject framework.
+type SnapshotStoreInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	AppOpts config.AppOptions
+	Freezer *freezer.Freezer
+	Logger  LoggerT
+	Role    types.Role `optional:"true"`
+}
+
+// ProvideSnapshotStore is a function that provides the store of the epoch
+// boundary snapshots of the beacon state.
+func ProvideSnapshotStore[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in SnapshotStoreInput[LoggerT],
+) (*snapshot.KVStore, error) {
+	name := "state-snapshots"
+	dir := cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data"
+
+	logger := log.ForService(in.Logger, log.ModuleStorage, "snapshot-store")
+	pdb, err := openStoreDB(name, dir, in.Role, logger, snapshot.Schemas()...)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot.NewStore(
+		storage.NewKVStoreProvider(pdb), in.Freezer.Snapshots(), logger,
+	), nil
+}
+
+// SnapshotServiceInput is the input for the dep inject framework.
+type SnapshotServiceInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+	StorageBackendT any,
+] struct {
+	depinject.In
+	ChainSpec       chain.ChainSpec
+	CometBFTService *cometbft.Service[LoggerT]
+	Config          *config.Config
+	Logger          LoggerT
+	SnapshotStore   *snapshot.KVStore
+	StorageBackend  StorageBackendT
+}
+
+// ProvideSnapshotService is a function that provides the service
+// snapshotting the beacon state at epoch boundaries.
+func ProvideSnapshotService[
+	LoggerT log.AdvancedLogger[LoggerT],
+	StorageBackendT snapshot.StorageBackend,
+](
+	in SnapshotServiceInput[LoggerT, StorageBackendT],
+) *snapshot.Service {
+	return snapshot.NewService(
+		in.Config.StateSnapshots,
+		in.ChainSpec,
+		in.CometBFTService,
+		in.StorageBackend,
+		in.SnapshotStore,
+		log.ForService(in.Logger, log.ModuleState, "state-snapshots"),
+	)
+}
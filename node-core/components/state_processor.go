@@ -25,7 +25,7 @@ import (
 	"github.com/berachain/beacon-kit/chain-spec/chain"
 	"github.com/berachain/beacon-kit/execution/engine"
 	"github.com/berachain/beacon-kit/log"
-	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/observability/telemetry"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/state-transition/core"
 )
@@ -41,7 +41,8 @@ type StateProcessorInput[
 	ExecutionEngine *engine.Engine
 	DepositStore    DepositStore
 	Signer          crypto.BLSSigner
-	TelemetrySink   *metrics.TelemetrySink
+	TelemetrySink   *telemetry.PrometheusSink
+	StakingHooks    core.StakingHooks `optional:"true"`
 }
 
 // ProvideStateProcessor provides the state processor to the depinject
@@ -56,11 +57,11 @@ func ProvideStateProcessor[
 	*Context,
 	KVStoreT,
 ] {
-	return core.NewStateProcessor[
+	sp := core.NewStateProcessor[
 		*Context,
 		KVStoreT,
 	](
-		in.Logger.With("service", "state-processor"),
+		log.ForService(in.Logger, log.ModuleState, "state-processor"),
 		in.ChainSpec,
 		in.ExecutionEngine,
 		in.DepositStore,
@@ -68,4 +69,10 @@ func ProvideStateProcessor[
 		crypto.GetAddressFromPubKey,
 		in.TelemetrySink,
 	)
+	hooks := []core.StakingHooks{core.NewEventHooks()}
+	if in.StakingHooks != nil {
+		hooks = append(hooks, in.StakingHooks)
+	}
+	sp.SetHooks(hooks...)
+	return sp
 }
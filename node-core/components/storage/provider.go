@@ -22,6 +22,7 @@ package storage
 
 import (
 	"context"
+	"io"
 
 	"cosmossdk.io/core/store"
 )
@@ -42,3 +43,11 @@ func NewKVStoreProvider(kvsb store.KVStoreWithBatch) *KVStoreProvider {
 func (p *KVStoreProvider) OpenKVStore(context.Context) store.KVStore {
 	return p.KVStoreWithBatch
 }
+
+// Close closes the underlying KV store, if it can be closed.
+func (p *KVStoreProvider) Close() error {
+	if closer, ok := p.KVStoreWithBatch.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
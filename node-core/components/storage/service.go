@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package storage
+
+import (
+	"context"
+	"io"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+)
+
+// Service flushes and closes the node stores on shutdown. It must be stopped
+// after the services using the stores.
+type Service struct {
+	logger log.Logger
+	stores []io.Closer
+}
+
+// NewService creates a new storage service closing the given stores.
+func NewService(logger log.Logger, stores ...io.Closer) *Service {
+	return &Service{logger: logger, stores: stores}
+}
+
+// Name returns the name of the service.
+func (*Service) Name() string {
+	return "storage"
+}
+
+// Start is a no-op, the stores are opened upon construction.
+func (*Service) Start(context.Context) error {
+	return nil
+}
+
+// Stop closes all the stores, even if some of them fail to.
+func (s *Service) Stop() error {
+	var errs []error
+	for _, store := range s.stores {
+		if err := store.Close(); err != nil {
+			s.logger.Error("failed to close store", "err", err)
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -23,19 +23,65 @@ package components
 import (
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
-	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/consensus-types/registry"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/observability/telemetry"
 	"github.com/berachain/beacon-kit/storage/beacondb"
+	"github.com/berachain/beacon-kit/storage/db"
 	"github.com/berachain/beacon-kit/storage/encoding"
+	"github.com/berachain/beacon-kit/storage/migration"
+	dbm "github.com/cosmos/cosmos-db"
 )
 
 // KVStoreInput is the input for the ProvideKVStore function.
 type KVStoreInput struct {
 	depinject.In
 	KVStoreService store.KVStoreService
+	TelemetrySink  *telemetry.PrometheusSink `optional:"true"`
 }
 
 // ProvideKVStore is the depinject provider that returns a beacon KV store.
+// Its operations are reported to the telemetry sink, if any.
 func ProvideKVStore(in KVStoreInput) *beacondb.KVStore {
-	payloadCodec := &encoding.SSZInterfaceCodec[*ctypes.ExecutionPayloadHeader]{}
-	return beacondb.New(in.KVStoreService, payloadCodec)
+	payloadCodec := encoding.NewSSZInterfaceCodec(
+		registry.Default.ExecutionPayloadHeaders,
+	)
+	service := in.KVStoreService
+	if in.TelemetrySink != nil {
+		service = beacondb.NewMetricsKVStoreService(service, in.TelemetrySink)
+	}
+	return beacondb.New(service, payloadCodec)
+}
+
+// openStoreDB opens the pebble database of the node-local store of the given
+// name in the given directory, upgrading it to the latest schema versions.
+// Nodes of read-only roles open it read-only and only check that it needs no
+// upgrade.
+func openStoreDB(
+	name, dir string,
+	role types.Role,
+	logger log.Logger,
+	schemas ...migration.Schema,
+) (dbm.DB, error) {
+	if role.ReadOnly() {
+		pdb, err := db.OpenReadOnly(name, dir)
+		if err != nil {
+			return nil, err
+		}
+		if err = migration.Check(pdb, schemas...); err != nil {
+			return nil, errors.Join(err, pdb.Close())
+		}
+		return pdb, nil
+	}
+
+	pdb, err := dbm.NewDB(name, dbm.PebbleDBBackend, dir)
+	if err != nil {
+		return nil, err
+	}
+	if err = migration.Run(pdb, logger, schemas...); err != nil {
+		return nil, errors.Join(err, pdb.Close())
+	}
+	return pdb, nil
 }
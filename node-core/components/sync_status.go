@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/execution/engine"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/services/syncstatus"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+)
+
+// SyncStatusServiceInput is the input for the sync status service provider.
+type SyncStatusServiceInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	CometBFTService *cometbft.Service[LoggerT]
+	Config          *config.Config
+	DepositStore    *depositstore.KVStore
+	ExecutionEngine *engine.Engine
+	Logger          LoggerT
+}
+
+// ProvideSyncStatusService provides the service combining the sync status of
+// the parts of the node. The node is considered behind its peers past the lag
+// tolerated by the readiness probe.
+func ProvideSyncStatusService[
+	LoggerT log.AdvancedLogger[LoggerT],
+](in SyncStatusServiceInput[LoggerT]) *syncstatus.Service {
+	return syncstatus.NewService(
+		log.ForService(in.Logger, log.ModuleBeacon, "sync-status"),
+		in.CometBFTService,
+		in.ExecutionEngine,
+		in.DepositStore,
+		in.Config.Probes.MaxSlotsBehind,
+	)
+}
@@ -20,9 +20,39 @@
 
 package components
 
-import "github.com/berachain/beacon-kit/node-core/components/metrics"
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/telemetry"
+)
 
-// ProvideTelemetrySink is a function that provides a TelemetrySink.
-func ProvideTelemetrySink() *metrics.TelemetrySink {
-	return &metrics.TelemetrySink{}
+// ProvideTelemetrySink is a function that provides the sink every service
+// reports its metrics to, guarded as configured.
+func ProvideTelemetrySink(cfg *config.Config) *telemetry.PrometheusSink {
+	return telemetry.NewPrometheusSink(cfg.Metrics)
+}
+
+// PrometheusExporterInput is the input for the Prometheus exporter provider.
+type PrometheusExporterInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	Config        *config.Config
+	Logger        LoggerT
+	TelemetrySink *telemetry.PrometheusSink
+}
+
+// ProvidePrometheusExporter is a depinject provider for the exporter serving
+// the metrics of the telemetry sink to Prometheus.
+func ProvidePrometheusExporter[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in PrometheusExporterInput[LoggerT],
+) *telemetry.Exporter {
+	return telemetry.NewExporter(
+		in.Config.Prometheus,
+		in.TelemetrySink,
+		log.ForService(in.Logger, log.ModuleNode, "prometheus-exporter"),
+	)
 }
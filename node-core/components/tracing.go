@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/diagnostics"
+	"github.com/berachain/beacon-kit/observability/tracing"
+	sdkversion "github.com/cosmos/cosmos-sdk/version"
+)
+
+// TracingProviderInput is the input for the tracing provider.
+type TracingProviderInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	Config            *config.Config
+	Logger            LoggerT
+	SlowBlockReporter *diagnostics.SlowBlockReporter
+}
+
+// ProvideTracingProvider is a depinject provider for the service exporting
+// the traces of the block pipeline. The traces are also fed to the slow block
+// reporter, if enabled.
+func ProvideTracingProvider[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in TracingProviderInput[LoggerT],
+) *tracing.Provider {
+	provider := tracing.NewProvider(
+		in.Config.Tracing,
+		sdkversion.Version,
+		log.ForService(in.Logger, log.ModuleNode, "tracing"),
+	)
+	if in.SlowBlockReporter.Enabled() {
+		provider.AddSpanProcessor(in.SlowBlockReporter)
+	}
+	return provider
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/services/performance"
+	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/observability/telemetry"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// ValidatorPerformanceServiceInput is the input for the validator performance
+// service provider.
+type ValidatorPerformanceServiceInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	AppOpts       config.AppOptions
+	Config        *config.Config
+	Logger        LoggerT
+	Role          types.Role
+	Signer        crypto.BLSSigner
+	TelemetrySink *telemetry.PrometheusSink
+}
+
+// ProvideValidatorPerformanceService provides the service tracking the
+// performance of the local validators, i.e. the one run by the node if it
+// proposes blocks and the configured ones, persisted to the data directory of
+// the node.
+func ProvideValidatorPerformanceService[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in ValidatorPerformanceServiceInput[LoggerT],
+) (*performance.Service, error) {
+	cfg := in.Config.Performance
+	var pubkeys []crypto.BLSPubkey
+	if in.Role.ProposesBlocks() {
+		pubkeys = append(pubkeys, in.Signer.PublicKey())
+	}
+	for _, raw := range cfg.TrackedPubkeys {
+		var pubkey crypto.BLSPubkey
+		if err := pubkey.UnmarshalText([]byte(raw)); err != nil {
+			return nil, errors.Wrapf(err, "tracked pubkey %s", raw)
+		}
+		pubkeys = append(pubkeys, pubkey)
+	}
+	validators := make([]performance.Validator, len(pubkeys))
+	for i, pubkey := range pubkeys {
+		address, err := crypto.GetAddressFromPubKey(pubkey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "tracked pubkey %s", pubkey)
+		}
+		validators[i] = performance.Validator{
+			Pubkey: pubkey, Address: address,
+		}
+	}
+	return performance.NewService(
+		log.ForService(in.Logger, log.ModuleValidator, "performance"),
+		in.TelemetrySink,
+		cfg.Window,
+		filepath.Join(
+			cast.ToString(in.AppOpts.Get(flags.FlagHome)),
+			"data", "validator-performance.json",
+		),
+		validators,
+	)
+}
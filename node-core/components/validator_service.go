@@ -25,9 +25,14 @@ import (
 	"github.com/berachain/beacon-kit/beacon/validator"
 	"github.com/berachain/beacon-kit/chain-spec/chain"
 	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/execution/engine"
 	"github.com/berachain/beacon-kit/log"
-	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/services/doppelganger"
+	"github.com/berachain/beacon-kit/node-core/services/performance"
+	"github.com/berachain/beacon-kit/node-core/services/slashingprotection"
+	"github.com/berachain/beacon-kit/observability/telemetry"
 	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/storage/metadata"
 )
 
 // ValidatorServiceInput is the input for the validator service provider.
@@ -37,15 +42,21 @@ type ValidatorServiceInput[
 	StorageBackendT any,
 ] struct {
 	depinject.In
-	Cfg            *config.Config
-	ChainSpec      chain.ChainSpec
-	LocalBuilder   LocalBuilder
-	Logger         LoggerT
-	StateProcessor StateProcessor[*Context]
-	StorageBackend StorageBackendT
-	Signer         crypto.BLSSigner
-	SidecarFactory SidecarFactory
-	TelemetrySink  *metrics.TelemetrySink
+	Cfg             *config.Config
+	ChainSpec       chain.ChainSpec
+	ExecutionEngine *engine.Engine
+	LocalBuilder    LocalBuilder
+	Logger          LoggerT
+	StateProcessor  StateProcessor[*Context]
+	StorageBackend  StorageBackendT
+	Signer          crypto.BLSSigner
+	MetadataStore   *metadata.KVStore
+	SidecarFactory  SidecarFactory
+	TelemetrySink   *telemetry.PrometheusSink
+	Performance     *performance.Service
+	Doppelganger    *doppelganger.Service
+	// SlashingProtection gates the blocks signed by the validator.
+	SlashingProtection *slashingprotection.Store
 }
 
 // ProvideValidatorService is a depinject provider for the validator service.
@@ -67,16 +78,21 @@ func ProvideValidatorService[
 	// Build the builder service.
 	return validator.NewService[DepositStoreT](
 		&in.Cfg.Validator,
-		in.Logger.With("service", "validator"),
+		log.ForService(in.Logger, log.ModuleValidator, "validator"),
 		in.ChainSpec,
 		in.StorageBackend,
 		in.StateProcessor,
-		in.Signer,
+		in.Doppelganger.Guard(in.SlashingProtection.Gate(
+			in.Signer, in.Cfg.SlashingProtection,
+		)),
+		in.MetadataStore,
 		in.SidecarFactory,
+		in.ExecutionEngine,
 		in.LocalBuilder,
 		[]validator.PayloadBuilder{
 			in.LocalBuilder,
 		},
 		in.TelemetrySink,
+		in.Performance,
 	), nil
 }
@@ -26,6 +26,7 @@ import (
 	"os/signal"
 	"syscall"
 
+	"cosmossdk.io/store"
 	"github.com/berachain/beacon-kit/log"
 	service "github.com/berachain/beacon-kit/node-core/services/registry"
 	"github.com/berachain/beacon-kit/node-core/types"
@@ -35,29 +36,67 @@ import (
 // Compile-time assertion that node implements the NodeI interface.
 var _ types.Node = (*node)(nil)
 
+// CrashReporter writes a crash report when the node panics or fails with a
+// fatal error.
+type CrashReporter interface {
+	// Recover writes a crash report if the calling goroutine panics, then
+	// resumes the panic. It must be deferred.
+	Recover()
+	// ReportError writes a crash report of the given fatal error.
+	ReportError(err error)
+}
+
 // node is the hard-type representation of the beacon-kit node.
 type node struct {
 	// logger is the node's logger.
 	logger log.Logger
 	// registry is the node's service registry.
 	registry *service.Registry
-
-	// TODO: FIX, HACK TO MAKE CLI HAPPY FOR NOW.
-	// THIS SHOULD BE REMOVED EVENTUALLY.
-	types.Node
+	// cms is the multistore holding the state of the node, used by the
+	// offline commands.
+	cms store.CommitMultiStore
+	// crashReporter reports the panics and the fatal errors of the node.
+	crashReporter CrashReporter
 }
 
 // New returns a new node.
 func New[NodeT types.Node](
-	registry *service.Registry, logger log.Logger) NodeT {
+	registry *service.Registry,
+	logger log.Logger,
+	cms store.CommitMultiStore,
+	crashReporter CrashReporter,
+) NodeT {
 	//nolint:errcheck // should be safe
-	return types.Node(&node{registry: registry, logger: logger}).(NodeT)
+	return types.Node(
+		&node{
+			registry:      registry,
+			logger:        logger,
+			cms:           cms,
+			crashReporter: crashReporter,
+		},
+	).(NodeT)
+}
+
+// CommitMultiStore returns the multistore holding the state of the node.
+func (n *node) CommitMultiStore() store.CommitMultiStore {
+	return n.cms
 }
 
-// Start starts the node.
+// Start starts the node, reporting the panics and the fatal errors it fails
+// with.
 func (n *node) Start(
 	ctx context.Context,
 ) error {
+	defer n.crashReporter.Recover()
+	err := n.start(ctx)
+	if err != nil {
+		n.crashReporter.ReportError(err)
+	}
+	return err
+}
+
+// start starts the registered services and waits for an exit signal.
+func (n *node) start(ctx context.Context) error {
 	// Make the context cancellable.
 	cctx, cancelFn := context.WithCancel(ctx)
 
@@ -68,12 +107,8 @@ func (n *node) Start(
 	n.listenForQuitSignals(g, true, cancelFn)
 
 	// Start all the registered services.
+	// The registry stops the services it started if any of them fails.
 	if err := n.registry.StartAll(gctx); err != nil {
-		// Make sure the services that were successfully started are stopped
-		// before exiting. We assume that it is safe to call Stop on a
-		// service that was never started so we can call StopAll here
-		//#nosec:G703 // ok to ignore this
-		_ = n.registry.StopAll()
 		return err
 	}
 
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chainhealth
+
+import "time"
+
+const (
+	defaultWindow           = 128
+	defaultMaxEmptyPayloads = 16
+	defaultMaxFinalityLag   = 30 * time.Second
+)
+
+// Config is the configuration for the monitoring of the chain health.
+type Config struct {
+	// Window is the number of recent slots the health of the chain is
+	// summarized over.
+	Window uint64 `mapstructure:"window"`
+	// MaxEmptyPayloads is the number of consecutive blocks with an empty
+	// execution payload past which a warning is logged.
+	MaxEmptyPayloads uint64 `mapstructure:"max-empty-payloads"`
+	// MaxFinalityLag is the time since the latest finalized block past
+	// which a warning is logged.
+	MaxFinalityLag time.Duration `mapstructure:"max-finality-lag"`
+}
+
+// DefaultConfig returns the default configuration for the monitoring of the
+// chain health.
+func DefaultConfig() Config {
+	return Config{
+		Window:           defaultWindow,
+		MaxEmptyPayloads: defaultMaxEmptyPayloads,
+		MaxFinalityLag:   defaultMaxFinalityLag,
+	}
+}
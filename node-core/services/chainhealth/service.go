@@ -0,0 +1,298 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chainhealth
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log"
+	nodetypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// defaultCheckInterval is the interval at which the finality lag is checked,
+// so that a stalled chain is reported even though no block is finalized.
+const defaultCheckInterval = 5 * time.Second
+
+// slotRecord is the outcome of a finalized slot.
+type slotRecord struct {
+	slot     math.Slot
+	proposer math.ValidatorIndex
+	// missed is set if the slot was finalized without a beacon block.
+	missed bool
+	// empty is set if the execution payload of the block has no
+	// transactions.
+	empty bool
+	blobs uint64
+}
+
+// Service tracks the health of the chain from the finalized slots: the
+// missed slots and their proposers, the empty payloads, the blobs and the
+// finality lag. It reports them as metrics, and logs a warning when they
+// regress.
+type Service struct {
+	// logger is used to log the regressions of the chain health.
+	logger log.Logger
+	// sink is the sink the chain health is reported to.
+	sink TelemetrySink
+	// cfg is the configuration of the monitoring.
+	cfg Config
+	// checkInterval is the interval at which the finality lag is checked.
+	checkInterval time.Duration
+	// now returns the current time.
+	now func() time.Time
+
+	// mu protects the fields below. Slots are finalized one at a time, but
+	// the finality lag is checked from the goroutine started by Start and
+	// the API requests for the summary are served from other goroutines.
+	mu sync.Mutex
+	// slots are the outcomes of the slots within the window, oldest first.
+	slots []slotRecord
+	// finalizedAt is the consensus time of the latest finalized slot, zero
+	// until a slot is finalized.
+	finalizedAt time.Time
+	// emptyStreak is the number of consecutive blocks with an empty
+	// execution payload.
+	emptyStreak uint64
+	// lagging is set while the finality lag exceeds its maximum.
+	lagging bool
+}
+
+// NewService creates a new chain health service.
+func NewService(logger log.Logger, sink TelemetrySink, cfg Config) *Service {
+	return &Service{
+		logger:        logger,
+		sink:          sink,
+		cfg:           cfg,
+		checkInterval: defaultCheckInterval,
+		now:           time.Now,
+	}
+}
+
+// Name returns the name of the service.
+func (*Service) Name() string {
+	return "chain-health"
+}
+
+// Start begins checking the finality lag periodically.
+func (s *Service) Start(ctx context.Context) error {
+	go func() {
+		ticker := time.NewTicker(s.checkInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.checkFinalityLag()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop is a no-op, checking stops with the context given to Start.
+func (*Service) Stop() error {
+	return nil
+}
+
+// OnBlockFinalized records a finalized beacon block, decided by consensus at
+// the given time in seconds.
+func (s *Service) OnBlockFinalized(
+	blk *ctypes.BeaconBlock,
+	_ []byte,
+	consensusTime math.U64,
+) {
+	body := blk.GetBody()
+	record := slotRecord{
+		slot:     blk.GetSlot(),
+		proposer: blk.GetProposerIndex(),
+		empty: len(
+			body.GetExecutionPayload().GetTransactions(),
+		) == 0,
+		blobs: uint64(len(body.GetBlobKzgCommitments())),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.record(record, consensusTime)
+
+	if !record.empty {
+		s.emptyStreak = 0
+	} else {
+		s.emptyStreak++
+		s.sink.IncrementCounter("beacon_kit.chain_health.empty_payloads")
+		if s.emptyStreak == s.cfg.MaxEmptyPayloads+1 {
+			s.logger.Warn(
+				"Blocks are being finalized with empty payloads",
+				"slot", record.slot.Base10(),
+				"consecutive", s.emptyStreak,
+			)
+		}
+	}
+}
+
+// OnSlotMissed records a slot finalized without a beacon block, decided by
+// consensus at the given time in seconds, and attributes the miss to its
+// proposer.
+func (s *Service) OnSlotMissed(
+	slot math.Slot,
+	proposer math.ValidatorIndex,
+	_ []byte,
+	consensusTime math.U64,
+) {
+	s.sink.IncrementCounter(
+		"beacon_kit.chain_health.missed_slots",
+		"validator_index", proposer.Base10(),
+	)
+	s.logger.Warn(
+		"Slot was missed by its proposer",
+		"slot", slot.Base10(),
+		"proposer", proposer.Base10(),
+	)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.record(
+		slotRecord{slot: slot, proposer: proposer, missed: true},
+		consensusTime,
+	)
+}
+
+// Summary returns the health of the chain over the slots within the window.
+func (s *Service) Summary() *nodetypes.ChainHealthData {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data := &nodetypes.ChainHealthData{
+		Window:         s.cfg.Window,
+		BlobCounts:     make([]uint64, 0, len(s.slots)),
+		FinalityLagMs:  uint64(s.finalityLag().Milliseconds()),
+		ProposerMisses: make([]nodetypes.ProposerMisses, 0),
+	}
+	if len(s.slots) > 0 {
+		data.HeadSlot = s.slots[len(s.slots)-1].slot.Unwrap()
+	}
+	misses := make(map[math.ValidatorIndex]uint64)
+	for _, r := range s.slots {
+		if r.missed {
+			data.MissedSlots++
+			misses[r.proposer]++
+			continue
+		}
+		if r.empty {
+			data.EmptyPayloads++
+		}
+		data.BlobCounts = append(data.BlobCounts, r.blobs)
+	}
+	for idx, n := range misses {
+		data.ProposerMisses = append(
+			data.ProposerMisses,
+			nodetypes.ProposerMisses{
+				ValidatorIndex: idx.Unwrap(),
+				MissedSlots:    n,
+			},
+		)
+	}
+	slices.SortFunc(
+		data.ProposerMisses,
+		func(a, b nodetypes.ProposerMisses) int {
+			return cmp.Or(
+				cmp.Compare(b.MissedSlots, a.MissedSlots),
+				cmp.Compare(a.ValidatorIndex, b.ValidatorIndex),
+			)
+		},
+	)
+	return data
+}
+
+// record appends the outcome of a slot finalized at the given consensus time
+// to the window, dropping the oldest ones past its size, and reports the
+// window and the finality lag. The lock must be held.
+func (s *Service) record(r slotRecord, consensusTime math.U64) {
+	//#nosec:G115 // consensus times fit in an int64.
+	s.finalizedAt = time.Unix(int64(consensusTime.Unwrap()), 0)
+	s.slots = append(s.slots, r)
+	if excess := len(s.slots) - int(s.cfg.Window); excess > 0 {
+		s.slots = slices.Delete(s.slots, 0, excess)
+	}
+
+	var missed, blobs int64
+	for _, r := range s.slots {
+		if r.missed {
+			missed++
+		}
+		//#nosec:G115 // a block carries a handful of blobs.
+		blobs += int64(r.blobs)
+	}
+	s.sink.SetGauge("beacon_kit.chain_health.missed_slots_in_window", missed)
+	s.sink.SetGauge("beacon_kit.chain_health.blobs_in_window", blobs)
+	s.checkFinalityLagLocked()
+}
+
+// checkFinalityLag reports the finality lag, logging a warning when it
+// exceeds its maximum.
+func (s *Service) checkFinalityLag() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkFinalityLagLocked()
+}
+
+// checkFinalityLagLocked is checkFinalityLag with the lock held.
+func (s *Service) checkFinalityLagLocked() {
+	if s.finalizedAt.IsZero() {
+		return
+	}
+	lag := s.finalityLag()
+	s.sink.SetGauge(
+		"beacon_kit.chain_health.finality_lag_ms", lag.Milliseconds(),
+	)
+
+	lagging := s.cfg.MaxFinalityLag > 0 && lag > s.cfg.MaxFinalityLag
+	switch {
+	case lagging && !s.lagging:
+		s.logger.Warn(
+			"No slot finalized recently",
+			"finality_lag", lag.Truncate(time.Millisecond).String(),
+			"max_finality_lag", s.cfg.MaxFinalityLag.String(),
+		)
+	case !lagging && s.lagging:
+		s.logger.Info(
+			"Slots are finalized again",
+			"finality_lag", lag.Truncate(time.Millisecond).String(),
+		)
+	}
+	s.lagging = lagging
+}
+
+// finalityLag returns the time since the latest finalized slot, zero if no
+// slot was finalized yet. The lock must be held.
+func (s *Service) finalityLag() time.Duration {
+	if s.finalizedAt.IsZero() {
+		return 0
+	}
+	return max(s.now().Sub(s.finalizedAt), 0)
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chainhealth_test
+
+import (
+	"sync"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	nodetypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/node-core/services/chainhealth"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+type testSink struct {
+	mu       sync.Mutex
+	counters map[string]int
+	gauges   map[string]int64
+}
+
+func newTestSink() *testSink {
+	return &testSink{
+		counters: make(map[string]int),
+		gauges:   make(map[string]int64),
+	}
+}
+
+func (s *testSink) IncrementCounter(key string, args ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, arg := range args {
+		key += "/" + arg
+	}
+	s.counters[key]++
+}
+
+func (s *testSink) SetGauge(key string, value int64, _ ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gauges[key] = value
+}
+
+func newBlock(
+	slot, proposer uint64, txs, blobs int,
+) *ctypes.BeaconBlock {
+	return &ctypes.BeaconBlock{
+		Slot:          math.Slot(slot),
+		ProposerIndex: math.ValidatorIndex(proposer),
+		Body: &ctypes.BeaconBlockBody{
+			ExecutionPayload: &ctypes.ExecutionPayload{
+				Transactions: make([][]byte, txs),
+			},
+			BlobKzgCommitments: make([]eip4844.KZGCommitment, blobs),
+		},
+	}
+}
+
+func TestSummary(t *testing.T) {
+	sink := newTestSink()
+	cfg := chainhealth.DefaultConfig()
+	cfg.Window = 4
+	s := chainhealth.NewService(noop.NewLogger[any](), sink, cfg)
+
+	s.OnBlockFinalized(newBlock(1, 0, 3, 1), nil, 1)
+	s.OnSlotMissed(2, 1, nil, 2)
+	s.OnBlockFinalized(newBlock(3, 2, 0, 0), nil, 3)
+	s.OnSlotMissed(4, 1, nil, 4)
+	s.OnSlotMissed(5, 3, nil, 5)
+	s.OnBlockFinalized(newBlock(6, 0, 1, 6), nil, 6)
+
+	// The slots before the window are dropped from the summary, but not
+	// from the metrics.
+	got := s.Summary()
+	require.Positive(t, got.FinalityLagMs)
+	got.FinalityLagMs = 0
+	require.Equal(t, &nodetypes.ChainHealthData{
+		HeadSlot:      6,
+		Window:        4,
+		MissedSlots:   2,
+		EmptyPayloads: 1,
+		BlobCounts:    []uint64{0, 6},
+		ProposerMisses: []nodetypes.ProposerMisses{
+			{ValidatorIndex: 1, MissedSlots: 1},
+			{ValidatorIndex: 3, MissedSlots: 1},
+		},
+	}, got)
+
+	require.Equal(t, map[string]int{
+		"beacon_kit.chain_health.missed_slots/validator_index/1": 2,
+		"beacon_kit.chain_health.missed_slots/validator_index/3": 1,
+		"beacon_kit.chain_health.empty_payloads":                 1,
+	}, sink.counters)
+	require.Equal(
+		t, int64(2),
+		sink.gauges["beacon_kit.chain_health.missed_slots_in_window"],
+	)
+	require.Equal(
+		t, int64(6), sink.gauges["beacon_kit.chain_health.blobs_in_window"],
+	)
+}
+
+func TestSummaryEmpty(t *testing.T) {
+	s := chainhealth.NewService(
+		noop.NewLogger[any](), newTestSink(), chainhealth.DefaultConfig(),
+	)
+	require.Equal(t, &nodetypes.ChainHealthData{
+		Window:         chainhealth.DefaultConfig().Window,
+		BlobCounts:     []uint64{},
+		ProposerMisses: []nodetypes.ProposerMisses{},
+	}, s.Summary())
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package crashreport
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+// redacted replaces the values of the secrets.
+const redacted = "[REDACTED]"
+
+// secretKeys are the substrings of the names of the fields holding secrets,
+// or the paths to them.
+//
+//nolint:gochecknoglobals // list of constants.
+var secretKeys = []string{
+	"secret", "token", "password", "passphrase", "credential", "private",
+}
+
+// redact returns the given configuration as indented JSON, with the values of
+// the fields named after secrets and the credentials of the URLs redacted.
+func redact(cfg any) ([]byte, error) {
+	bz, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	var v any
+	if err = json.Unmarshal(bz, &v); err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(redactValue(v), "", "  ")
+}
+
+// redactValue redacts the secrets of the given decoded JSON value.
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, val := range v {
+			if isSecretKey(key) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(val)
+		}
+		return v
+	case []any:
+		for i, val := range v {
+			v[i] = redactValue(val)
+		}
+		return v
+	case string:
+		return redactURL(v)
+	default:
+		return v
+	}
+}
+
+// isSecretKey returns whether the field of the given name holds a secret.
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, secret := range secretKeys {
+		if strings.Contains(key, secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactURL redacts the credentials of the given string if it is a URL.
+func redactURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil || u.User == nil {
+		return s
+	}
+	return u.Redacted()
+}
@@ -0,0 +1,291 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package crashreport
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	runtimepprof "runtime/pprof"
+	"strings"
+	"sync"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+const (
+	// reportPrefix is the prefix of the names of the report directories.
+	reportPrefix = "crash-"
+	// reportDirPerm is the permission of the report directories.
+	reportDirPerm = 0o750
+	// reportFilePerm is the permission of the report files.
+	reportFilePerm = 0o600
+)
+
+// Head is the head of the chain when the node crashed.
+type Head struct {
+	// Slot is the slot of the latest finalized block.
+	Slot math.Slot `json:"slot"`
+	// BlockRoot is the root of the latest finalized block.
+	BlockRoot string `json:"block_root"`
+	// ParentBlockRoot is the root of the parent of the latest finalized
+	// block.
+	ParentBlockRoot string `json:"parent_block_root"`
+	// StateRoot is the state root of the latest finalized block.
+	StateRoot string `json:"state_root"`
+	// ExecutionBlockNumber is the number of the execution payload of the
+	// latest finalized block.
+	ExecutionBlockNumber math.U64 `json:"execution_block_number"`
+	// ExecutionBlockHash is the hash of the execution payload of the latest
+	// finalized block.
+	ExecutionBlockHash string `json:"execution_block_hash"`
+	// LastMissedSlot is the latest slot finalized without a beacon block,
+	// if any.
+	LastMissedSlot math.Slot `json:"last_missed_slot,omitempty"`
+}
+
+// Reporter writes a crash report when the node panics or fails with a fatal
+// error, so that bug reports carry what the node was doing. A report is a
+// directory holding the reason of the crash, the latest log lines, the head
+// of the chain, the configuration of the node with its secrets redacted and
+// a dump of the goroutines.
+type Reporter struct {
+	// cfg is the configuration of the crash reports.
+	cfg Config
+	// dir is the directory the reports are written to.
+	dir string
+	// nodeConfig is the redacted configuration of the node, as JSON.
+	nodeConfig []byte
+	// logger is used to log the reports written.
+	logger log.Logger
+	// now returns the current time.
+	now func() time.Time
+
+	// mu protects the fields below, as log lines and blocks are recorded
+	// concurrently with the reports.
+	mu sync.Mutex
+	// lines are the latest log lines, a ring buffer whose oldest line is
+	// at next once full.
+	lines []string
+	// next is the index of the next line written to the ring buffer.
+	next int
+	// block is the latest finalized block, if any.
+	block *ctypes.BeaconBlock
+	// lastMissedSlot is the latest slot finalized without a beacon block.
+	lastMissedSlot math.Slot
+}
+
+// NewReporter creates a new crash reporter writing its reports to the given
+// directory. The given configuration of the node is included in the reports,
+// with its secrets redacted.
+func NewReporter(
+	cfg Config, dir string, nodeConfig any, logger log.Logger,
+) *Reporter {
+	r := &Reporter{
+		cfg:    cfg,
+		dir:    dir,
+		logger: logger,
+		now:    time.Now,
+		lines:  make([]string, 0, max(cfg.LogLines, 0)),
+	}
+	bz, err := redact(nodeConfig)
+	if err != nil {
+		bz = []byte(fmt.Sprintf("failed to encode the config: %v", err))
+	}
+	r.nodeConfig = bz
+	return r
+}
+
+// Enabled returns whether the crash reports are written.
+func (r *Reporter) Enabled() bool {
+	return r.cfg.Enabled
+}
+
+// HandleLog records a log entry, keeping the latest ones for the reports. It
+// is a handler of the logger of the node.
+func (r *Reporter) HandleLog(level string, msg string, keyVals ...any) {
+	if !r.Enabled() || r.cfg.LogLines <= 0 {
+		return
+	}
+	var sb strings.Builder
+	sb.WriteString(r.now().UTC().Format(time.RFC3339Nano))
+	sb.WriteString(" ")
+	sb.WriteString(strings.ToUpper(level))
+	sb.WriteString(" ")
+	sb.WriteString(msg)
+	for i := 0; i+1 < len(keyVals); i += 2 {
+		fmt.Fprintf(&sb, " %v=%v", keyVals[i], keyVals[i+1])
+	}
+	line := sb.String()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.lines) < r.cfg.LogLines {
+		r.lines = append(r.lines, line)
+		return
+	}
+	r.lines[r.next] = line
+	r.next = (r.next + 1) % len(r.lines)
+}
+
+// OnBlockFinalized records the block as the head of the chain.
+func (r *Reporter) OnBlockFinalized(
+	blk *ctypes.BeaconBlock, _ []byte, _ math.U64,
+) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.block = blk
+}
+
+// OnSlotMissed records the latest slot finalized without a beacon block.
+func (r *Reporter) OnSlotMissed(
+	slot math.Slot, _ math.ValidatorIndex, _ []byte, _ math.U64,
+) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastMissedSlot = slot
+}
+
+// Recover writes a crash report if the calling goroutine panics, then resumes
+// the panic, whose output the Go runtime then adds to the report. It must be
+// deferred.
+func (r *Reporter) Recover() {
+	if !r.Enabled() {
+		return
+	}
+	v := recover()
+	if v == nil {
+		return
+	}
+	path, err := r.Report(fmt.Sprintf("panic: %v", v), debug.Stack())
+	if err == nil {
+		r.redirectCrashOutput(path)
+	}
+	panic(v)
+}
+
+// ReportError writes a crash report of the fatal error the node failed with.
+func (r *Reporter) ReportError(err error) {
+	if !r.Enabled() || err == nil {
+		return
+	}
+	//nolint:errcheck // failures are logged.
+	_, _ = r.Report(fmt.Sprintf("fatal error: %v", err), debug.Stack())
+}
+
+// Report writes a crash report of the given reason and stack trace, prints
+// its path and returns it.
+func (r *Reporter) Report(reason string, stack []byte) (string, error) {
+	path := filepath.Join(
+		r.dir, reportPrefix+r.now().UTC().Format("20060102T150405.000Z"),
+	)
+	if err := r.write(path, reason, stack); err != nil {
+		r.logger.Error("Failed to write crash report", "error", err)
+		return "", err
+	}
+	r.logger.Error("Wrote crash report", "path", path)
+	// The logger may be the one failing, the path is printed as well.
+	fmt.Fprintf(os.Stderr, "Crash report written to %s\n", path)
+	return path, nil
+}
+
+// write writes the files of the report to the given directory.
+func (r *Reporter) write(path, reason string, stack []byte) error {
+	if err := os.MkdirAll(path, reportDirPerm); err != nil {
+		return err
+	}
+	head, err := json.MarshalIndent(r.head(), "", "  ")
+	if err != nil {
+		return err
+	}
+	var goroutines bytes.Buffer
+	// Debug level 2 prints the stack of every goroutine, as a panic does.
+	if err = runtimepprof.Lookup("goroutine").WriteTo(&goroutines, 2); err != nil {
+		return err
+	}
+
+	for name, bz := range map[string][]byte{
+		"reason.txt":     []byte(reason + "\n\n" + string(stack)),
+		"logs.txt":       []byte(strings.Join(r.logLines(), "\n") + "\n"),
+		"head.json":      head,
+		"config.json":    r.nodeConfig,
+		"goroutines.txt": goroutines.Bytes(),
+	} {
+		if err = os.WriteFile(
+			filepath.Join(path, name), bz, reportFilePerm,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// redirectCrashOutput makes the Go runtime write the output of the crash
+// being resumed to the report of the given directory.
+func (r *Reporter) redirectCrashOutput(path string) {
+	f, err := os.OpenFile(
+		filepath.Join(path, "runtime.txt"),
+		os.O_CREATE|os.O_WRONLY|os.O_TRUNC,
+		reportFilePerm,
+	)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	//nolint:errcheck // best effort, the output still goes to stderr.
+	_ = debug.SetCrashOutput(f, debug.CrashOptions{})
+}
+
+// head returns the head of the chain.
+func (r *Reporter) head() *Head {
+	r.mu.Lock()
+	blk, lastMissedSlot := r.block, r.lastMissedSlot
+	r.mu.Unlock()
+
+	head := &Head{LastMissedSlot: lastMissedSlot}
+	if blk == nil {
+		return head
+	}
+	head.Slot = blk.GetSlot()
+	head.BlockRoot = blk.HashTreeRoot().String()
+	head.ParentBlockRoot = blk.GetParentBlockRoot().String()
+	head.StateRoot = blk.GetStateRoot().String()
+	if payload := blk.GetBody().GetExecutionPayload(); payload != nil {
+		head.ExecutionBlockNumber = payload.GetNumber()
+		head.ExecutionBlockHash = payload.GetBlockHash().Hex()
+	}
+	return head
+}
+
+// logLines returns the latest log lines, oldest first.
+func (r *Reporter) logLines() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	lines := make([]string, 0, len(r.lines))
+	lines = append(lines, r.lines[r.next:]...)
+	return append(lines, r.lines[:r.next]...)
+}
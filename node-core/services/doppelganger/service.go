@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package doppelganger
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// The states of the doppelganger protection, as reported by the
+// beacon_kit.validator.doppelganger.state gauge.
+const (
+	stateObserving int64 = iota
+	stateSigning
+	stateDetected
+)
+
+// Service protects the validator key of the node from being used by two
+// nodes at once, e.g. after a failover. Upon startup, it observes the slots
+// finalized for a number of epochs, signing being refused until then. The
+// key is used by another node if a block proposed by it is finalized in the
+// meantime, in which case signing is refused until the node is restarted.
+//
+// Only the slots decided by consensus after the node started are observed,
+// so that the blocks of the key replayed while catching up are not mistaken
+// for the ones of a doppelganger.
+type Service struct {
+	// logger is used to log the state of the protection.
+	logger log.Logger
+	// sink is the sink the state of the protection is reported to.
+	sink TelemetrySink
+	// slots is the number of slots observed before signing.
+	slots uint64
+	// address is the CometBFT address of the validator key of the node.
+	address []byte
+	// startTime is the time in seconds the node started at.
+	startTime uint64
+
+	// mu protects the fields below. Slots are finalized one at a time, but
+	// the guarded signers check the state from the goroutines signing.
+	mu sync.Mutex
+	// state is the state of the protection.
+	state int64
+	// until is the slot up to which the chain is observed, zero until the
+	// first slot decided after the node started is finalized.
+	until math.Slot
+}
+
+// NewService creates a new service observing the chain for the configured
+// number of epochs of the given number of slots, for blocks proposed by the
+// validator key of the given CometBFT address. Signing is allowed right
+// away if the protection is disabled.
+func NewService(
+	logger log.Logger,
+	sink TelemetrySink,
+	cfg Config,
+	slotsPerEpoch uint64,
+	address []byte,
+) *Service {
+	s := &Service{
+		logger:  logger,
+		sink:    sink,
+		slots:   cfg.Epochs * slotsPerEpoch,
+		address: address,
+		//#nosec:G115 // the time is past 1970.
+		startTime: uint64(time.Now().Unix()),
+	}
+	if !cfg.Enabled {
+		s.setState(stateSigning)
+		return s
+	}
+	s.setState(stateObserving)
+	return s
+}
+
+// Guard returns a signer refusing to sign with the given one until no
+// doppelganger has been detected over the observed slots.
+func (s *Service) Guard(signer crypto.BLSSigner) crypto.BLSSigner {
+	if s.checkSigning() == nil {
+		// The protection is disabled.
+		return signer
+	}
+	return &guardedSigner{BLSSigner: signer, service: s}
+}
+
+// OnBlockFinalized observes a finalized beacon block, decided by consensus
+// at the given time in seconds.
+func (s *Service) OnBlockFinalized(
+	blk *ctypes.BeaconBlock,
+	proposerAddress []byte,
+	consensusTime math.U64,
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.observe(blk.GetSlot(), consensusTime) {
+		return
+	}
+	if !bytes.Equal(proposerAddress, s.address) {
+		s.maybeStartSigning(blk.GetSlot())
+		return
+	}
+
+	s.logger.Error(
+		"Doppelganger detected, a block was proposed with the validator "+
+			"key of the node by another node, signing is disabled",
+		"slot", blk.GetSlot().Base10(),
+		"validator_index", blk.GetProposerIndex().Base10(),
+	)
+	s.setState(stateDetected)
+}
+
+// OnSlotMissed observes a slot finalized without a beacon block, decided by
+// consensus at the given time in seconds.
+func (s *Service) OnSlotMissed(
+	slot math.Slot,
+	_ math.ValidatorIndex,
+	_ []byte,
+	consensusTime math.U64,
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.observe(slot, consensusTime) {
+		s.maybeStartSigning(slot)
+	}
+}
+
+// checkSigning returns an error if signing is not allowed yet.
+func (s *Service) checkSigning() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch s.state {
+	case stateSigning:
+		return nil
+	case stateDetected:
+		return ErrDetected
+	default:
+		return ErrObserving
+	}
+}
+
+// observe starts the observation at the first slot decided after the node
+// started, and returns whether the given slot is observed. The lock must be
+// held.
+func (s *Service) observe(slot math.Slot, consensusTime math.U64) bool {
+	if s.state != stateObserving || consensusTime.Unwrap() < s.startTime {
+		return false
+	}
+	if s.until == 0 {
+		s.until = slot + math.Slot(s.slots)
+		s.logger.Info(
+			"Observing the chain for doppelgangers before signing",
+			"from_slot", slot.Base10(),
+			"until_slot", s.until.Base10(),
+		)
+	}
+	return true
+}
+
+// maybeStartSigning allows signing once the observed slots are over. The
+// lock must be held.
+func (s *Service) maybeStartSigning(slot math.Slot) {
+	if slot < s.until {
+		return
+	}
+	s.logger.Info(
+		"No doppelganger detected, signing is enabled",
+		"slot", slot.Base10(),
+	)
+	s.setState(stateSigning)
+}
+
+// setState sets the state of the protection. The lock must be held.
+func (s *Service) setState(state int64) {
+	s.state = state
+	s.sink.SetGauge("beacon_kit.validator.doppelganger.state", state)
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package doppelganger_test
+
+import (
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/services/doppelganger"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+const stateGauge = "beacon_kit.validator.doppelganger.state"
+
+type testSink struct {
+	gauges map[string]int64
+}
+
+func (s *testSink) SetGauge(key string, value int64, _ ...string) {
+	s.gauges[key] = value
+}
+
+// testSigner is a signer returning a fixed signature.
+type testSigner struct {
+	crypto.BLSSigner
+}
+
+func (testSigner) Sign([]byte) (crypto.BLSSignature, error) {
+	return crypto.BLSSignature{0x01}, nil
+}
+
+func newBlock(slot uint64) *ctypes.BeaconBlock {
+	return &ctypes.BeaconBlock{Slot: math.Slot(slot)}
+}
+
+func newService(
+	t *testing.T,
+	enabled bool,
+) (*doppelganger.Service, *testSink, crypto.BLSSigner) {
+	t.Helper()
+	sink := &testSink{gauges: make(map[string]int64)}
+	s := doppelganger.NewService(
+		noop.NewLogger[any](),
+		sink,
+		doppelganger.Config{Enabled: enabled, Epochs: 2},
+		2,
+		[]byte("local"),
+	)
+	return s, sink, s.Guard(testSigner{})
+}
+
+func TestDisabled(t *testing.T) {
+	_, sink, signer := newService(t, false)
+	_, err := signer.Sign(nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), sink.gauges[stateGauge])
+}
+
+func TestNoDoppelganger(t *testing.T) {
+	s, sink, signer := newService(t, true)
+	//#nosec:G115 // the time is past 1970.
+	now := math.U64(time.Now().Unix() + 1)
+
+	// The slots decided before the node started are not observed, even if
+	// proposed by the key of the node.
+	s.OnBlockFinalized(newBlock(1), []byte("local"), 1)
+	s.OnSlotMissed(2, 0, []byte("other"), 2)
+	_, err := signer.Sign(nil)
+	require.ErrorIs(t, err, doppelganger.ErrObserving)
+	require.Equal(t, int64(0), sink.gauges[stateGauge])
+
+	// Two epochs of two slots are observed from slot 10.
+	for slot := uint64(10); slot < 14; slot++ {
+		s.OnBlockFinalized(newBlock(slot), []byte("other"), now)
+		_, err = signer.Sign(nil)
+		require.ErrorIs(t, err, doppelganger.ErrObserving)
+	}
+	s.OnSlotMissed(14, 0, []byte("other"), now)
+	_, err = signer.Sign(nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), sink.gauges[stateGauge])
+
+	// The blocks of the node are no longer mistaken for a doppelganger.
+	s.OnBlockFinalized(newBlock(15), []byte("local"), now)
+	_, err = signer.Sign(nil)
+	require.NoError(t, err)
+}
+
+func TestDoppelgangerDetected(t *testing.T) {
+	s, sink, signer := newService(t, true)
+	//#nosec:G115 // the time is past 1970.
+	now := math.U64(time.Now().Unix() + 1)
+
+	s.OnBlockFinalized(newBlock(10), []byte("other"), now)
+	s.OnBlockFinalized(newBlock(11), []byte("local"), now)
+	for slot := uint64(12); slot < 20; slot++ {
+		s.OnBlockFinalized(newBlock(slot), []byte("other"), now)
+	}
+	_, err := signer.Sign(nil)
+	require.ErrorIs(t, err, doppelganger.ErrDetected)
+	_, err = crypto.SignRequest(signer, &crypto.SigningRequest{})
+	require.ErrorIs(t, err, doppelganger.ErrDetected)
+	require.Equal(t, int64(2), sink.gauges[stateGauge])
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package doppelganger
+
+import "github.com/berachain/beacon-kit/primitives/crypto"
+
+// guardedSigner is a signer refusing to sign until the doppelganger
+// protection allows it.
+type guardedSigner struct {
+	crypto.BLSSigner
+	service *Service
+}
+
+// Sign signs the given message, if allowed.
+func (g *guardedSigner) Sign(msg []byte) (crypto.BLSSignature, error) {
+	if err := g.service.checkSigning(); err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	return g.BLSSigner.Sign(msg)
+}
+
+// SignRequest signs the given request, if allowed.
+func (g *guardedSigner) SignRequest(
+	req *crypto.SigningRequest,
+) (crypto.BLSSignature, error) {
+	if err := g.service.checkSigning(); err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	return crypto.SignRequest(g.BLSSigner, req)
+}
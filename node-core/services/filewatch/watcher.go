@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filewatch
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+)
+
+// stamp identifies a version of the watched file.
+type stamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// Watcher polls a file for changes and applies it whenever it changes. The
+// file is polled rather than watched through the file system notifications,
+// so that it is still followed when replaced by editors or mounted from a
+// volume.
+type Watcher struct {
+	// logger is used to log the changes applied.
+	logger log.Logger
+	// name is the name of the service.
+	name string
+	// path is the path of the watched file.
+	path string
+	// interval is the interval at which the file is polled.
+	interval time.Duration
+	// apply applies the file once changed.
+	apply func() error
+	// last is the version of the file last seen.
+	last stamp
+}
+
+// NewWatcher creates a new service named after the given name, polling the
+// file at the given path at the given interval and calling apply whenever it
+// changes. The file is expected to have been applied already when the
+// service starts.
+func NewWatcher(
+	logger log.Logger,
+	name string,
+	path string,
+	interval time.Duration,
+	apply func() error,
+) *Watcher {
+	return &Watcher{
+		logger:   logger,
+		name:     name,
+		path:     path,
+		interval: interval,
+		apply:    apply,
+	}
+}
+
+// Name returns the name of the service.
+func (w *Watcher) Name() string {
+	return w.name
+}
+
+// Start begins polling the file, unless no file or no interval is
+// configured.
+func (w *Watcher) Start(ctx context.Context) error {
+	if w.path == "" || w.interval <= 0 {
+		return nil
+	}
+	w.last, _ = w.stamp()
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				w.poll()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop is a no-op, polling stops with the context given to Start.
+func (*Watcher) Stop() error {
+	return nil
+}
+
+// poll applies the file if it changed since last seen. A file failing to be
+// applied is retried once it changes again.
+func (w *Watcher) poll() {
+	current, err := w.stamp()
+	if err != nil {
+		w.logger.Debug("Failed to stat watched file", "error", err)
+		return
+	}
+	if current == w.last {
+		return
+	}
+	w.last = current
+	if err = w.apply(); err != nil {
+		w.logger.Error(
+			"Failed to apply changed file", "path", w.path, "error", err,
+		)
+		return
+	}
+	w.logger.Info("Applied changed file", "path", w.path)
+}
+
+// stamp returns the current version of the file.
+func (w *Watcher) stamp() (stamp, error) {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		return stamp{}, err
+	}
+	return stamp{modTime: info.ModTime(), size: info.Size()}, nil
+}
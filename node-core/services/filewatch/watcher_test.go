@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filewatch_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/services/filewatch"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcher(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "watched.json")
+	require.NoError(t, os.WriteFile(path, []byte("{}"), 0o600))
+
+	var applied atomic.Int32
+	w := filewatch.NewWatcher(
+		noop.NewLogger[any](), "watcher", path, 10*time.Millisecond,
+		func() error {
+			if applied.Add(1) == 1 {
+				return errors.New("invalid file")
+			}
+			return nil
+		},
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, w.Start(ctx))
+
+	// The file is not applied until it changes.
+	time.Sleep(50 * time.Millisecond)
+	require.Zero(t, applied.Load())
+
+	// A file failing to be applied is retried once changed again.
+	require.NoError(t, os.WriteFile(path, []byte(`{"a": 1}`), 0o600))
+	require.Eventually(t, func() bool {
+		return applied.Load() == 1
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, os.WriteFile(path, []byte(`{"a": 12}`), 0o600))
+	require.Eventually(t, func() bool {
+		return applied.Load() == 2
+	}, time.Second, 10*time.Millisecond)
+}
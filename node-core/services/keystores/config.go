@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keystores
+
+import "time"
+
+const defaultPollInterval = 5 * time.Second
+
+// Config is the configuration for the keystores loaded at runtime.
+type Config struct {
+	// Enabled is the flag to load the keystores of the keystores directory,
+	// following its changes at runtime.
+	Enabled bool `mapstructure:"enabled"`
+	// Dir is the keystores directory, config/keystores in the home
+	// directory of the node if empty.
+	Dir string `mapstructure:"dir"`
+	// PasswordFile is the path to the file holding the password of the
+	// keystores.
+	PasswordFile string `mapstructure:"password-file"`
+	// PollInterval is the interval at which the directory is polled for
+	// added, changed or removed keystores.
+	PollInterval time.Duration `mapstructure:"poll-interval"`
+}
+
+// DefaultConfig returns the default configuration for the keystores, which
+// are not loaded.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:      false,
+		Dir:          "",
+		PasswordFile: "",
+		PollInterval: defaultPollInterval,
+	}
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keystores
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrPubkeyMismatch is returned when the public key of a keystore does
+	// not match the one of its secret key.
+	ErrPubkeyMismatch = errors.New("keystore pubkey does not match its key")
+	// ErrDuplicateKey is returned when a keystore holds a key already loaded
+	// from another keystore.
+	ErrDuplicateKey = errors.New("validator key already loaded")
+	// ErrKeyNotFound is returned when deleting a key not loaded.
+	ErrKeyNotFound = errors.New("validator key not loaded")
+	// ErrKeystoresDisabled is returned when importing a keystore while the
+	// keystores are not loaded.
+	ErrKeystoresDisabled = errors.New("keystores are not loaded")
+)
@@ -0,0 +1,442 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keystores
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/berachain/beacon-kit/beacon/events"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// keystoreFileMode is the mode of the keystores imported.
+const keystoreFileMode = 0o600
+
+// stamp identifies a version of a keystore file.
+type stamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// key is a validator key loaded from a keystore.
+type key struct {
+	stamp  stamp
+	signer crypto.BLSSigner
+}
+
+// Manager loads the EIP-2335 keystores of a directory, following the
+// keystores added, changed or removed at runtime without restarting the
+// node. Every key loaded is registered in the slashing protection database
+// before being made available, and the keys loaded and unloaded are
+// published on the event bus.
+type Manager struct {
+	// logger is used to log the keys loaded and unloaded.
+	logger log.Logger
+	// sink is the sink the number of keys loaded is reported to.
+	sink TelemetrySink
+	// bus is the bus the keys loaded and unloaded are published on.
+	bus EventBus
+	// protection is the database the keys loaded are registered in.
+	protection SlashingProtection
+	// enabled is set if the keystores are loaded.
+	enabled bool
+	// dir is the keystores directory.
+	dir string
+	// passwordFile is the file holding the password of the keystores, read
+	// anew on every change so that it may be rotated along with them.
+	passwordFile string
+	// interval is the interval at which the directory is polled.
+	interval time.Duration
+	// newSigner creates the signer of a decrypted key.
+	newSigner func(signer.LegacyKey) (crypto.BLSSigner, error)
+	// head is the last slot finalized, the keys loaded may only sign the
+	// blocks of the following ones.
+	head atomic.Uint64
+
+	// mu protects the fields below, as keys are looked up concurrently with
+	// the directory being polled.
+	mu sync.RWMutex
+	// keys are the keys loaded, by keystore path.
+	keys map[string]*key
+	// failed are the versions of the keystores which failed to be loaded,
+	// by path, retried once changed.
+	failed map[string]stamp
+}
+
+// NewManager creates a new manager of the keystores of the given directory,
+// decrypted with the password held by the given file.
+func NewManager(
+	logger log.Logger,
+	sink TelemetrySink,
+	bus EventBus,
+	protection SlashingProtection,
+	cfg Config,
+	newSigner func(signer.LegacyKey) (crypto.BLSSigner, error),
+) *Manager {
+	return &Manager{
+		logger:       logger,
+		sink:         sink,
+		bus:          bus,
+		protection:   protection,
+		enabled:      cfg.Enabled,
+		dir:          cfg.Dir,
+		passwordFile: cfg.PasswordFile,
+		interval:     cfg.PollInterval,
+		newSigner:    newSigner,
+		keys:         make(map[string]*key),
+		failed:       make(map[string]stamp),
+	}
+}
+
+// Name returns the name of the service.
+func (*Manager) Name() string {
+	return "keystores"
+}
+
+// Start loads the keystores and begins polling the directory for changes,
+// unless the keystores are not loaded.
+func (m *Manager) Start(ctx context.Context) error {
+	if !m.enabled {
+		return nil
+	}
+	//#nosec:G104 // errors are logged by Reload.
+	_ = m.Reload()
+	if m.interval <= 0 {
+		return nil
+	}
+	go func() {
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				//#nosec:G104 // errors are logged by Reload.
+				_ = m.Reload()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop is a no-op, polling stops with the context given to Start.
+func (*Manager) Stop() error {
+	return nil
+}
+
+// OnBlockFinalized records the slot finalized.
+func (m *Manager) OnBlockFinalized(
+	blk *ctypes.BeaconBlock,
+	_ []byte,
+	_ math.U64,
+) {
+	m.head.Store(blk.GetSlot().Unwrap())
+}
+
+// OnSlotMissed records the slot finalized.
+func (m *Manager) OnSlotMissed(
+	slot math.Slot,
+	_ math.ValidatorIndex,
+	_ []byte,
+	_ math.U64,
+) {
+	m.head.Store(slot.Unwrap())
+}
+
+// Pubkeys returns the public keys of the keys loaded, in order.
+func (m *Manager) Pubkeys() []crypto.BLSPubkey {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	pubkeys := make([]crypto.BLSPubkey, 0, len(m.keys))
+	for _, k := range m.keys {
+		pubkeys = append(pubkeys, k.signer.PublicKey())
+	}
+	slices.SortFunc(pubkeys, func(a, b crypto.BLSPubkey) int {
+		return slices.Compare(a[:], b[:])
+	})
+	return pubkeys
+}
+
+// Signer returns the signer of the given key, if loaded.
+func (m *Manager) Signer(pubkey crypto.BLSPubkey) (crypto.BLSSigner, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, k := range m.keys {
+		if k.signer.PublicKey() == pubkey {
+			return k.signer, true
+		}
+	}
+	return nil, false
+}
+
+// Import decrypts the given JSON encoded keystore with the given password,
+// and loads its key once written to the keystores directory, encrypted anew
+// with the password of the keystores. It returns the public key of the key
+// imported, and ErrDuplicateKey if it is already loaded.
+func (m *Manager) Import(
+	bz []byte,
+	password string,
+) (crypto.BLSPubkey, error) {
+	if !m.enabled {
+		return crypto.BLSPubkey{}, ErrKeystoresDisabled
+	}
+	ks := new(signer.Keystore)
+	if err := json.Unmarshal(bz, ks); err != nil {
+		return crypto.BLSPubkey{}, errors.Wrap(err, "invalid keystore")
+	}
+	secret, err := ks.Decrypt(password)
+	if err != nil {
+		return crypto.BLSPubkey{}, err
+	}
+	sgn, err := m.newSigner(secret)
+	if err != nil {
+		return crypto.BLSPubkey{}, err
+	}
+	pubkey := sgn.PublicKey()
+	if !matchesPubkey(ks, pubkey) {
+		return pubkey, ErrPubkeyMismatch
+	}
+	if _, ok := m.Signer(pubkey); ok {
+		return pubkey, errors.Wrap(ErrDuplicateKey, pubkey.String())
+	}
+
+	dirPassword, err := signer.ReadPasswordFile(m.passwordFile)
+	if err != nil {
+		return pubkey, err
+	}
+	// The keystore is encrypted anew before the lock is taken, key
+	// derivation being slow by design.
+	imported, err := signer.EncryptKeystore(
+		secret, pubkey, dirPassword, ks.Crypto.KDF.Function,
+	)
+	if err != nil {
+		return pubkey, err
+	}
+	imported.Path = ks.Path
+	imported.Description = ks.Description
+	if bz, err = json.Marshal(imported); err != nil {
+		return pubkey, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	path := filepath.Join(m.dir, hex.EncodeToString(pubkey[:])+".json")
+	if err = writeKeystoreFile(path, bz); err != nil {
+		return pubkey, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return pubkey, err
+	}
+	err = m.add(path, stamp{modTime: info.ModTime(), size: info.Size()}, sgn)
+	if err != nil {
+		//#nosec:G104 // the keystore is not loaded either way.
+		_ = os.Remove(path)
+		return pubkey, err
+	}
+	m.reportLoaded()
+	return pubkey, nil
+}
+
+// Delete unloads the given key and removes its keystore from the keystores
+// directory. It returns ErrKeyNotFound if the key is not loaded.
+func (m *Manager) Delete(pubkey crypto.BLSPubkey) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for path, k := range m.keys {
+		if k.signer.PublicKey() != pubkey {
+			continue
+		}
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+		m.unload(path)
+		m.reportLoaded()
+		return nil
+	}
+	return errors.Wrap(ErrKeyNotFound, pubkey.String())
+}
+
+// Reload applies the changes of the keystores directory: the keys of the
+// keystores removed are unloaded, and the ones of the keystores added or
+// changed are loaded. All keystores are applied even if some of them fail,
+// in which case the errors are joined.
+func (m *Manager) Reload() error {
+	paths, err := filepath.Glob(filepath.Join(m.dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	stamps := make(map[string]stamp, len(paths))
+	for _, path := range paths {
+		var info os.FileInfo
+		if info, err = os.Stat(path); err != nil {
+			continue
+		}
+		stamps[path] = stamp{modTime: info.ModTime(), size: info.Size()}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for path, k := range m.keys {
+		if s, ok := stamps[path]; !ok || s != k.stamp {
+			m.unload(path)
+		}
+	}
+	for path := range m.failed {
+		if _, ok := stamps[path]; !ok {
+			delete(m.failed, path)
+		}
+	}
+
+	var errs []error
+	for _, path := range paths {
+		s, ok := stamps[path]
+		if !ok {
+			continue
+		}
+		if _, loaded := m.keys[path]; loaded || m.failed[path] == s {
+			continue
+		}
+		if err = m.load(path, s); err != nil {
+			m.failed[path] = s
+			m.logger.Error(
+				"Failed to load validator keystore", "path", path,
+				"error", err,
+			)
+			errs = append(errs, errors.Wrap(err, path))
+			continue
+		}
+		delete(m.failed, path)
+	}
+	m.reportLoaded()
+	return errors.Join(errs...)
+}
+
+// load loads the key of the keystore at the given path. The lock must be
+// held.
+func (m *Manager) load(path string, s stamp) error {
+	ks, err := signer.ReadKeystoreFile(path)
+	if err != nil {
+		return err
+	}
+	password, err := signer.ReadPasswordFile(m.passwordFile)
+	if err != nil {
+		return err
+	}
+	secret, err := ks.Decrypt(password)
+	if err != nil {
+		return err
+	}
+	sgn, err := m.newSigner(secret)
+	if err != nil {
+		return err
+	}
+	if !matchesPubkey(ks, sgn.PublicKey()) {
+		return ErrPubkeyMismatch
+	}
+	return m.add(path, s, sgn)
+}
+
+// add makes the key of the keystore at the given path available, once
+// registered in the slashing protection database. The lock must be held.
+func (m *Manager) add(path string, s stamp, sgn crypto.BLSSigner) error {
+	pubkey := sgn.PublicKey()
+	for _, k := range m.keys {
+		if k.signer.PublicKey() == pubkey {
+			return errors.Wrap(ErrDuplicateKey, pubkey.String())
+		}
+	}
+	if err := m.protection.Register(
+		pubkey, math.Slot(m.head.Load()+1),
+	); err != nil {
+		return err
+	}
+
+	m.keys[path] = &key{stamp: s, signer: sgn}
+	m.logger.Info(
+		"Loaded validator key", "pubkey", pubkey.String(), "path", path,
+	)
+	m.bus.Publish(events.TopicValidatorKey, &events.ValidatorKey{
+		Pubkey: pubkey,
+		Path:   path,
+		Action: events.ValidatorKeyAdded,
+	})
+	return nil
+}
+
+// unload unloads the key of the keystore at the given path. The lock must be
+// held.
+func (m *Manager) unload(path string) {
+	pubkey := m.keys[path].signer.PublicKey()
+	delete(m.keys, path)
+	m.logger.Info(
+		"Unloaded validator key", "pubkey", pubkey.String(), "path", path,
+	)
+	m.bus.Publish(events.TopicValidatorKey, &events.ValidatorKey{
+		Pubkey: pubkey,
+		Path:   path,
+		Action: events.ValidatorKeyRemoved,
+	})
+}
+
+// reportLoaded reports the number of keys loaded. The lock must be held.
+func (m *Manager) reportLoaded() {
+	m.sink.SetGauge(
+		"beacon_kit.validator.keystores.loaded", int64(len(m.keys)),
+	)
+}
+
+// matchesPubkey reports whether the public key of the given keystore is the
+// given one.
+func matchesPubkey(ks *signer.Keystore, pubkey crypto.BLSPubkey) bool {
+	return strings.EqualFold(
+		strings.TrimPrefix(ks.Pubkey, "0x"), hex.EncodeToString(pubkey[:]),
+	)
+}
+
+// writeKeystoreFile atomically writes the given keystore to the given path,
+// so that the directory is never polled while the keystore is partially
+// written.
+func writeKeystoreFile(path string, bz []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, bz, keystoreFileMode); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
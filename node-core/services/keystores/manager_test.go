@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keystores_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/beacon/events"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/node-core/services/keystores"
+	"github.com/berachain/beacon-kit/node-core/services/slashingprotection"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+const password = "password"
+
+type testSink struct{}
+
+func (testSink) SetGauge(string, int64, ...string) {}
+
+// testSigner is a signer whose public key is derived from the first byte of
+// its secret key.
+type testSigner struct {
+	crypto.BLSSigner
+	pubkey crypto.BLSPubkey
+}
+
+func (s testSigner) PublicKey() crypto.BLSPubkey {
+	return s.pubkey
+}
+
+func newTestSigner(key signer.LegacyKey) (crypto.BLSSigner, error) {
+	return testSigner{pubkey: crypto.BLSPubkey{key[0]}}, nil
+}
+
+func writeKeystore(t *testing.T, path string, seed byte, pw string) {
+	t.Helper()
+	ks, err := signer.EncryptKeystore(
+		signer.LegacyKey{seed}, crypto.BLSPubkey{seed}, pw, signer.KDFPBKDF2,
+	)
+	require.NoError(t, err)
+	bz, err := json.Marshal(ks)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bz, 0o600))
+}
+
+func TestReload(t *testing.T) {
+	dir := t.TempDir()
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, os.WriteFile(passwordFile, []byte(password), 0o600))
+	protection, err := slashingprotection.NewStore(
+		filepath.Join(t.TempDir(), "slashing-protection.json"),
+	)
+	require.NoError(t, err)
+	bus := events.NewBus()
+	sub := bus.Subscribe(events.TopicValidatorKey)
+	defer sub.Unsubscribe()
+
+	m := keystores.NewManager(
+		noop.NewLogger[any](), testSink{}, bus, protection,
+		keystores.Config{
+			Enabled: true, Dir: dir, PasswordFile: passwordFile,
+		},
+		newTestSigner,
+	)
+	pathA, pathB := filepath.Join(dir, "a.json"), filepath.Join(dir, "b.json")
+	writeKeystore(t, pathA, 1, password)
+	require.NoError(t, m.Reload())
+	require.Equal(t, []crypto.BLSPubkey{{1}}, m.Pubkeys())
+	require.Equal(t, &events.ValidatorKey{
+		Pubkey: crypto.BLSPubkey{1},
+		Path:   pathA,
+		Action: events.ValidatorKeyAdded,
+	}, (<-sub.Events()).Data)
+	entry, ok := protection.Get(crypto.BLSPubkey{1})
+	require.True(t, ok)
+	require.Equal(t, math.Slot(1), entry.MinSlot)
+
+	// Keys added at runtime may only sign past the head of the chain.
+	m.OnSlotMissed(41, 0, nil, 0)
+	writeKeystore(t, pathB, 2, password)
+	require.NoError(t, m.Reload())
+	require.Equal(t, []crypto.BLSPubkey{{1}, {2}}, m.Pubkeys())
+	<-sub.Events()
+	entry, ok = protection.Get(crypto.BLSPubkey{2})
+	require.True(t, ok)
+	require.Equal(t, math.Slot(42), entry.MinSlot)
+	_, ok = m.Signer(crypto.BLSPubkey{2})
+	require.True(t, ok)
+
+	// Removed keystores are unloaded.
+	require.NoError(t, os.Remove(pathA))
+	require.NoError(t, m.Reload())
+	require.Equal(t, []crypto.BLSPubkey{{2}}, m.Pubkeys())
+	require.Equal(t, events.ValidatorKeyRemoved,
+		(<-sub.Events()).Data.(*events.ValidatorKey).Action)
+
+	// Keystores failing to be loaded are reported, and retried once
+	// changed.
+	writeKeystore(t, pathA, 1, "wrong password")
+	require.ErrorIs(t, m.Reload(), signer.ErrInvalidKeystorePassword)
+	require.NoError(t, m.Reload())
+	writeKeystore(t, pathA, 1, password)
+	require.NoError(t, m.Reload())
+	require.Equal(t, []crypto.BLSPubkey{{1}, {2}}, m.Pubkeys())
+}
+
+func TestImportDelete(t *testing.T) {
+	dir := t.TempDir()
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, os.WriteFile(passwordFile, []byte(password), 0o600))
+	protection, err := slashingprotection.NewStore(
+		filepath.Join(t.TempDir(), "slashing-protection.json"),
+	)
+	require.NoError(t, err)
+
+	m := keystores.NewManager(
+		noop.NewLogger[any](), testSink{}, events.NewBus(), protection,
+		keystores.Config{
+			Enabled: true, Dir: dir, PasswordFile: passwordFile,
+		},
+		newTestSigner,
+	)
+	ks, err := signer.EncryptKeystore(
+		signer.LegacyKey{1}, crypto.BLSPubkey{1}, "other", signer.KDFPBKDF2,
+	)
+	require.NoError(t, err)
+	bz, err := json.Marshal(ks)
+	require.NoError(t, err)
+
+	_, err = m.Import(bz, "wrong password")
+	require.ErrorIs(t, err, signer.ErrInvalidKeystorePassword)
+	pubkey, err := m.Import(bz, "other")
+	require.NoError(t, err)
+	require.Equal(t, crypto.BLSPubkey{1}, pubkey)
+	require.Equal(t, []crypto.BLSPubkey{{1}}, m.Pubkeys())
+	_, err = m.Import(bz, "other")
+	require.ErrorIs(t, err, keystores.ErrDuplicateKey)
+
+	// The keystore imported is encrypted with the password of the
+	// keystores, and left as is on reload.
+	require.NoError(t, m.Reload())
+	require.Equal(t, []crypto.BLSPubkey{{1}}, m.Pubkeys())
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	imported, err := signer.ReadKeystoreFile(paths[0])
+	require.NoError(t, err)
+	_, err = imported.Decrypt(password)
+	require.NoError(t, err)
+
+	// Deleted keys are unloaded and their keystore removed.
+	require.NoError(t, m.Delete(crypto.BLSPubkey{1}))
+	require.Empty(t, m.Pubkeys())
+	require.NoFileExists(t, paths[0])
+	require.ErrorIs(t, m.Delete(crypto.BLSPubkey{1}), keystores.ErrKeyNotFound)
+}
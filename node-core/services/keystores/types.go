@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keystores
+
+import (
+	"github.com/berachain/beacon-kit/beacon/events"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// EventBus is the bus the keys loaded and unloaded are published on.
+type EventBus interface {
+	// Publish sends the event to every subscription to the topic.
+	Publish(topic events.Topic, data any)
+}
+
+// SlashingProtection is the slashing protection database the keys loaded are
+// registered in.
+type SlashingProtection interface {
+	// Register initializes the entry of the given key, which may not sign a
+	// block before the given slot.
+	Register(pubkey crypto.BLSPubkey, minSlot math.Slot) error
+}
+
+// TelemetrySink is the sink the number of keys loaded is reported to.
+type TelemetrySink interface {
+	// SetGauge sets the gauge identified by the provided key.
+	SetGauge(key string, value int64, args ...string)
+}
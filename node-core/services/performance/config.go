@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package performance
+
+const defaultWindow = 100_000
+
+// Config is the configuration for the tracking of the performance of the
+// local validators.
+type Config struct {
+	// Window is the number of recent slots the performance is tracked over.
+	Window uint64 `mapstructure:"window"`
+	// TrackedPubkeys are the public keys of the validators tracked on top of
+	// the one run by the node, e.g. the ones run by the other nodes of the
+	// operator.
+	TrackedPubkeys []string `mapstructure:"tracked-pubkeys"`
+}
+
+// DefaultConfig returns the default configuration for the tracking of the
+// performance of the local validators.
+func DefaultConfig() Config {
+	return Config{
+		Window:         defaultWindow,
+		TrackedPubkeys: []string{},
+	}
+}
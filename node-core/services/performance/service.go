@@ -0,0 +1,313 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package performance
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	kmtypes "github.com/berachain/beacon-kit/node-api/handlers/keymanager/types"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+const (
+	// weiPerGwei is the number of Wei in a Gwei.
+	weiPerGwei = 1e9
+	// fileMode is the mode of the file the duties are persisted to.
+	fileMode = 0o600
+)
+
+// duty is a slot a tracked validator was the proposer of.
+type duty struct {
+	Slot           math.Slot           `json:"slot"`
+	Pubkey         crypto.BLSPubkey    `json:"pubkey"`
+	ValidatorIndex math.ValidatorIndex `json:"validator_index"`
+	// Missed is set if the slot was finalized without a beacon block.
+	Missed bool `json:"missed"`
+	// PayloadValueGwei is the value of the payload of the block, if it was
+	// built by the node.
+	PayloadValueGwei uint64 `json:"payload_value_gwei"`
+}
+
+// Service tracks the proposals expected from the local validators, i.e. the
+// slots they were the proposer of, along with the ones they made or missed
+// and the value of their payloads. The proposals within the window are
+// persisted, so that the performance survives restarts.
+type Service struct {
+	// logger is used to log the proposals missed.
+	logger log.Logger
+	// sink is the sink the performance is reported to.
+	sink TelemetrySink
+	// window is the number of recent slots the performance is tracked over.
+	window uint64
+	// path is the path of the file the duties are persisted to.
+	path string
+	// pubkeys are the public keys of the tracked validators, in order.
+	pubkeys []crypto.BLSPubkey
+	// addresses are the public keys of the tracked validators by CometBFT
+	// address.
+	addresses map[string]crypto.BLSPubkey
+
+	// mu protects the fields below. Slots are finalized one at a time, but
+	// the payloads built and the API requests for the summary are recorded
+	// and served from other goroutines.
+	mu sync.Mutex
+	// duties are the duties of the tracked validators within the window,
+	// oldest first.
+	duties []duty
+	// payloadValues are the values in Gwei of the payloads built by the
+	// node, by slot, until the slot is finalized.
+	payloadValues map[math.Slot]uint64
+}
+
+// NewService creates a new service tracking the performance of the given
+// validators, loading the duties persisted at the given path.
+func NewService(
+	logger log.Logger,
+	sink TelemetrySink,
+	window uint64,
+	path string,
+	validators []Validator,
+) (*Service, error) {
+	s := &Service{
+		logger:        logger,
+		sink:          sink,
+		window:        window,
+		path:          path,
+		addresses:     make(map[string]crypto.BLSPubkey),
+		payloadValues: make(map[math.Slot]uint64),
+	}
+	for _, v := range validators {
+		if slices.Contains(s.pubkeys, v.Pubkey) {
+			continue
+		}
+		s.pubkeys = append(s.pubkeys, v.Pubkey)
+		s.addresses[string(v.Address)] = v.Pubkey
+	}
+
+	bz, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, err
+	default:
+		if err = json.Unmarshal(bz, &s.duties); err != nil {
+			return nil, errors.Wrapf(err, "failed to load %s", path)
+		}
+	}
+	return s, nil
+}
+
+// Name returns the name of the service.
+func (*Service) Name() string {
+	return "validator-performance"
+}
+
+// Start is a no-op, the performance is tracked as slots are finalized.
+func (*Service) Start(context.Context) error {
+	return nil
+}
+
+// Stop is a no-op, the duties are persisted as they are tracked.
+func (*Service) Stop() error {
+	return nil
+}
+
+// OnPayloadBuilt records the value, in Wei, of the payload of a block built
+// by the node, credited to its proposer once the block is finalized.
+func (s *Service) OnPayloadBuilt(
+	slot math.Slot,
+	proposer crypto.BLSPubkey,
+	value *math.U256,
+) {
+	if !slices.Contains(s.pubkeys, proposer) || value == nil {
+		return
+	}
+	gwei := new(math.U256).Div(value, math.NewU256(weiPerGwei))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.payloadValues[slot] = gwei.Uint64()
+}
+
+// OnBlockFinalized records the block made, if proposed by a tracked
+// validator.
+func (s *Service) OnBlockFinalized(
+	blk *ctypes.BeaconBlock,
+	proposerAddress []byte,
+	_ math.U64,
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pubkey, ok := s.addresses[string(proposerAddress)]
+	if !ok {
+		s.prunePayloadValues(blk.GetSlot())
+		return
+	}
+
+	d := duty{
+		Slot:             blk.GetSlot(),
+		Pubkey:           pubkey,
+		ValidatorIndex:   blk.GetProposerIndex(),
+		PayloadValueGwei: s.payloadValues[blk.GetSlot()],
+	}
+	s.prunePayloadValues(blk.GetSlot())
+	s.sink.IncrementCounter("beacon_kit.validator.performance.proposals_made")
+	//#nosec:G115 // payload values fit in an int64 of Gwei.
+	s.sink.SetGauge(
+		"beacon_kit.validator.performance.payload_value_gwei",
+		int64(d.PayloadValueGwei),
+	)
+	s.record(d)
+}
+
+// OnSlotMissed records the slot missed, if proposed by a tracked validator.
+func (s *Service) OnSlotMissed(
+	slot math.Slot,
+	proposer math.ValidatorIndex,
+	proposerAddress []byte,
+	_ math.U64,
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.prunePayloadValues(slot)
+	pubkey, ok := s.addresses[string(proposerAddress)]
+	if !ok {
+		return
+	}
+
+	s.logger.Warn(
+		"Local validator missed its proposal",
+		"slot", slot.Base10(),
+		"validator_index", proposer.Base10(),
+		"pubkey", pubkey.String(),
+	)
+	s.sink.IncrementCounter(
+		"beacon_kit.validator.performance.proposals_missed",
+	)
+	s.record(duty{
+		Slot:           slot,
+		Pubkey:         pubkey,
+		ValidatorIndex: proposer,
+		Missed:         true,
+	})
+}
+
+// Summary returns the performance of the tracked validators over the slots
+// within the window.
+func (s *Service) Summary() *kmtypes.ValidatorPerformanceData {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	perfs := make(map[crypto.BLSPubkey]*kmtypes.ValidatorPerformance)
+	data := &kmtypes.ValidatorPerformanceData{
+		Window:     s.window,
+		Validators: make([]kmtypes.ValidatorPerformance, len(s.pubkeys)),
+	}
+	for i, pubkey := range s.pubkeys {
+		data.Validators[i] = kmtypes.ValidatorPerformance{
+			Pubkey:      pubkey,
+			MissedSlots: make([]uint64, 0),
+		}
+		perfs[pubkey] = &data.Validators[i]
+	}
+	for _, d := range s.duties {
+		perf, ok := perfs[d.Pubkey]
+		if !ok {
+			// The validator is no longer tracked.
+			continue
+		}
+		index := d.ValidatorIndex.Unwrap()
+		perf.ValidatorIndex = &index
+		perf.ProposalsExpected++
+		if d.Missed {
+			perf.ProposalsMissed++
+			perf.MissedSlots = append(perf.MissedSlots, d.Slot.Unwrap())
+			continue
+		}
+		perf.ProposalsMade++
+		perf.PayloadValueGwei += d.PayloadValueGwei
+		perf.LastProposalSlot = d.Slot.Unwrap()
+	}
+	return data
+}
+
+// record appends a duty to the window, dropping the ones out of it, and
+// persists the window. The lock must be held.
+func (s *Service) record(d duty) {
+	s.duties = append(s.duties, d)
+	if d.Slot.Unwrap() >= s.window {
+		oldest := d.Slot.Unwrap() - s.window
+		s.duties = slices.DeleteFunc(s.duties, func(d duty) bool {
+			return d.Slot.Unwrap() <= oldest
+		})
+	}
+
+	var missed int64
+	for _, d := range s.duties {
+		if d.Missed {
+			missed++
+		}
+	}
+	s.sink.SetGauge(
+		"beacon_kit.validator.performance.proposals_missed_in_window", missed,
+	)
+
+	if err := s.persist(); err != nil {
+		s.logger.Error(
+			"Failed to persist the validator performance", "error", err,
+		)
+	}
+}
+
+// persist atomically writes the duties to the file. The lock must be held.
+func (s *Service) persist() error {
+	bz, err := json.Marshal(s.duties)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(s.path), os.ModePerm); err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err = os.WriteFile(tmp, bz, fileMode); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
+
+// prunePayloadValues drops the values of the payloads built for the slots up
+// to the given finalized one. The lock must be held.
+func (s *Service) prunePayloadValues(finalized math.Slot) {
+	for slot := range s.payloadValues {
+		if slot <= finalized {
+			delete(s.payloadValues, slot)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package performance_test
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	kmtypes "github.com/berachain/beacon-kit/node-api/handlers/keymanager/types"
+	"github.com/berachain/beacon-kit/node-core/services/performance"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+type testSink struct {
+	mu       sync.Mutex
+	counters map[string]int
+	gauges   map[string]int64
+}
+
+func newTestSink() *testSink {
+	return &testSink{
+		counters: make(map[string]int),
+		gauges:   make(map[string]int64),
+	}
+}
+
+func (s *testSink) IncrementCounter(key string, _ ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counters[key]++
+}
+
+func (s *testSink) SetGauge(key string, value int64, _ ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gauges[key] = value
+}
+
+func newValidator(seed byte) performance.Validator {
+	return performance.Validator{
+		Pubkey:  crypto.BLSPubkey{seed},
+		Address: []byte{seed},
+	}
+}
+
+func newBlock(slot, proposer uint64) *ctypes.BeaconBlock {
+	return &ctypes.BeaconBlock{
+		Slot:          math.Slot(slot),
+		ProposerIndex: math.ValidatorIndex(proposer),
+	}
+}
+
+func TestSummary(t *testing.T) {
+	a, b, c := newValidator(1), newValidator(2), newValidator(3)
+	pubkeyA, addressA := a.Pubkey, a.Address
+	pubkeyB, addressB := b.Pubkey, b.Address
+	path := filepath.Join(t.TempDir(), "validator-performance.json")
+	sink := newTestSink()
+	s, err := performance.NewService(
+		noop.NewLogger[any](), sink, 4, path,
+		[]performance.Validator{a, b, c},
+	)
+	require.NoError(t, err)
+
+	s.OnPayloadBuilt(1, pubkeyA, math.NewU256(3e9))
+	s.OnBlockFinalized(newBlock(1, 0), addressA, 1)
+	s.OnSlotMissed(2, 1, addressB, 2)
+	s.OnBlockFinalized(newBlock(3, 7), []byte("untracked"), 3)
+	s.OnSlotMissed(4, 0, addressA, 4)
+	s.OnPayloadBuilt(5, pubkeyA, math.NewU256(2e9))
+	s.OnBlockFinalized(newBlock(5, 0), addressA, 5)
+	s.OnSlotMissed(6, 1, addressB, 6)
+
+	// The slots before the window are dropped from the summary, but not
+	// from the metrics.
+	indexA, indexB := uint64(0), uint64(1)
+	want := &kmtypes.ValidatorPerformanceData{
+		Window: 4,
+		Validators: []kmtypes.ValidatorPerformance{
+			{
+				Pubkey:            pubkeyA,
+				ValidatorIndex:    &indexA,
+				ProposalsExpected: 2,
+				ProposalsMade:     1,
+				ProposalsMissed:   1,
+				PayloadValueGwei:  2,
+				MissedSlots:       []uint64{4},
+				LastProposalSlot:  5,
+			},
+			{
+				Pubkey:            pubkeyB,
+				ValidatorIndex:    &indexB,
+				ProposalsExpected: 1,
+				ProposalsMissed:   1,
+				MissedSlots:       []uint64{6},
+			},
+			{
+				Pubkey:      c.Pubkey,
+				MissedSlots: []uint64{},
+			},
+		},
+	}
+	require.Equal(t, want, s.Summary())
+	require.Equal(t, map[string]int{
+		"beacon_kit.validator.performance.proposals_made":   2,
+		"beacon_kit.validator.performance.proposals_missed": 3,
+	}, sink.counters)
+	require.Equal(
+		t, int64(2),
+		sink.gauges["beacon_kit.validator.performance.proposals_missed_in_window"],
+	)
+
+	// The window survives restarts.
+	s, err = performance.NewService(
+		noop.NewLogger[any](), newTestSink(), 4, path,
+		[]performance.Validator{a, b, c},
+	)
+	require.NoError(t, err)
+	require.Equal(t, want, s.Summary())
+}
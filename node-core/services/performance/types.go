@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package performance
+
+import "github.com/berachain/beacon-kit/primitives/crypto"
+
+// Validator is a validator whose performance is tracked.
+type Validator struct {
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey
+	// Address is the CometBFT address of the validator, which the proposers
+	// of the finalized slots are identified by.
+	Address []byte
+}
+
+// TelemetrySink is the sink the performance of the local validators is
+// reported to.
+type TelemetrySink interface {
+	// IncrementCounter increments the counter identified by the provided
+	// key.
+	IncrementCounter(key string, args ...string)
+	// SetGauge sets the gauge identified by the provided key.
+	SetGauge(key string, value int64, args ...string)
+}
@@ -44,4 +44,19 @@ var (
 		errors.New("unknown service"),
 		"%T",
 	)
+
+	// errUnknownDependency is returned when a service depends on a service
+	// which is not registered.
+	errUnknownDependency = errors.New("unknown service dependency")
+
+	// errDependencyCycle is returned when services depend on each other.
+	errDependencyCycle = errors.New("service dependency cycle")
+
+	// errServiceUnhealthy is returned when a service does not pass its
+	// health gate in time.
+	errServiceUnhealthy = errors.New("service not healthy")
+
+	// errServiceNotRunning is reported as the health of services which are
+	// not started.
+	errServiceNotRunning = errors.New("service not running")
 )
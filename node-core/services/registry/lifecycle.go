@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+)
+
+// healthPollInterval is the interval at which health gates are checked.
+const healthPollInterval = 100 * time.Millisecond
+
+// ServiceOption is a functional option configuring the lifecycle of a
+// registered service.
+type ServiceOption func(*serviceConfig)
+
+// RestartPolicy defines how many times a service failing to start, or to
+// pass its health gate, is restarted before the registry gives up.
+type RestartPolicy struct {
+	// MaxRestarts is the maximum number of restarts. Zero disables restarts.
+	MaxRestarts int
+	// Backoff is the delay before each restart.
+	Backoff time.Duration
+}
+
+// Extension is a service registered on top of the default services, along
+// with its lifecycle options.
+type Extension struct {
+	// Service is the registered service.
+	Service Basic
+	// Options configure the lifecycle of the service, e.g. the services it
+	// is started after.
+	Options []ServiceOption
+}
+
+// serviceConfig is the lifecycle configuration of a registered service.
+type serviceConfig struct {
+	// dependencies are the names of the services started before this one.
+	dependencies []string
+	// healthCheck returns nil once the started service is healthy.
+	healthCheck func(context.Context) error
+	// healthTimeout bounds the time waited for healthCheck to succeed.
+	healthTimeout time.Duration
+	// restart is the restart policy of the service.
+	restart RestartPolicy
+}
+
+// DependsOn declares the names of the services which must be started before
+// the service, and stopped after it.
+func DependsOn(names ...string) ServiceOption {
+	return func(c *serviceConfig) {
+		c.dependencies = append(c.dependencies, names...)
+	}
+}
+
+// WithHealthGate makes the registry wait, once the service is started, for
+// check to succeed before starting the next services. The service is deemed
+// failed if check does not succeed within timeout.
+func WithHealthGate(
+	check func(context.Context) error, timeout time.Duration,
+) ServiceOption {
+	return func(c *serviceConfig) {
+		c.healthCheck = check
+		c.healthTimeout = timeout
+	}
+}
+
+// WithRestartPolicy sets the restart policy of the service.
+func WithRestartPolicy(policy RestartPolicy) ServiceOption {
+	return func(c *serviceConfig) {
+		c.restart = policy
+	}
+}
+
+// awaitHealthy polls the health gate until it succeeds or times out.
+func (c *serviceConfig) awaitHealthy(ctx context.Context) error {
+	if c.healthCheck == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.healthTimeout)
+	defer cancel()
+	ticker := time.NewTicker(healthPollInterval)
+	defer ticker.Stop()
+	for {
+		err := c.healthCheck(ctx)
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Join(errServiceUnhealthy, err)
+		case <-ticker.C:
+		}
+	}
+}
@@ -36,8 +36,8 @@ func WithLogger(logger log.Logger) RegistryOption {
 }
 
 // WithService is an Option that registers a service with the Registry.
-func WithService(svc Basic) RegistryOption {
+func WithService(svc Basic, opts ...ServiceOption) RegistryOption {
 	return func(r *Registry) error {
-		return r.RegisterService(svc)
+		return r.RegisterService(svc, opts...)
 	}
 }
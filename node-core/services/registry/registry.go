@@ -23,7 +23,11 @@ package service
 import (
 	"context"
 	"reflect"
+	"slices"
+	"sync"
+	"time"
 
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
 )
 
@@ -45,20 +49,32 @@ type Dispatcher interface {
 // Registry provides a useful pattern for managing services.
 // It allows for ease of dependency management and ensures services
 // dependent on others use the same references in memory.
+// Services are started after the services they depend on and stopped in
+// the reverse order.
 type Registry struct {
 	// logger is the logger for the Registry.
 	logger log.Logger
 	// services is a map of service type -> service instance.
-	services map[string]Basic
+	services map[string]*entry
 	// serviceTypes is an ordered slice of registered service types.
 	serviceTypes []string
+	// started is the ordered slice of started service types.
+	started []string
+	// mu protects started.
+	mu sync.RWMutex
+}
+
+// entry is a registered service along with its lifecycle configuration.
+type entry struct {
+	svc Basic
+	cfg serviceConfig
 }
 
 // NewRegistry starts a registry instance for convenience.
 func NewRegistry(
 	opts ...RegistryOption) *Registry {
 	r := &Registry{
-		services: make(map[string]Basic),
+		services: make(map[string]*entry),
 	}
 
 	for _, opt := range opts {
@@ -69,50 +85,91 @@ func NewRegistry(
 	return r
 }
 
-// StartAll initialized each service in order of registration.
+// StartAll starts each service after the services it depends on, in order
+// of registration otherwise. If a service fails to start, the services
+// started so far are stopped and the error is returned.
 func (s *Registry) StartAll(ctx context.Context) error {
-	// start all services
-	s.logger.Info("Starting services", "num", len(s.serviceTypes))
-	for _, typeName := range s.serviceTypes {
-		s.logger.Info("Starting service", "type", typeName)
-		svc := s.services[typeName]
-		if svc == nil {
-			s.logger.Error("service not found", "type", typeName)
-			continue
-		}
+	order, err := s.startOrder()
+	if err != nil {
+		return err
+	}
 
-		if err := svc.Start(ctx); err != nil {
+	s.logger.Info("Starting services", "num", len(order))
+	for _, typeName := range order {
+		s.logger.Info("Starting service", "type", typeName)
+		if err = s.startService(ctx, s.services[typeName]); err != nil {
+			err = errors.Wrapf(err, "failed to start service %s", typeName)
+			if stopErr := s.StopAll(); stopErr != nil {
+				err = errors.Join(err, stopErr)
+			}
 			return err
 		}
+		s.mu.Lock()
+		s.started = append(s.started, typeName)
+		s.mu.Unlock()
 	}
 	return nil
 }
 
+// StopAll stops the started services in the reverse order they were
+// started. All services are stopped even if some of them fail to, in which
+// case the errors are joined.
 func (s *Registry) StopAll() error {
-	s.logger.Info("Stopping services", "num", len(s.serviceTypes))
-	for _, typeName := range s.serviceTypes {
+	s.mu.Lock()
+	started := s.started
+	s.started = nil
+	s.mu.Unlock()
+
+	s.logger.Info("Stopping services", "num", len(started))
+	var errs []error
+	for _, typeName := range slices.Backward(started) {
 		s.logger.Info("Stopping service", "type", typeName)
-		svc := s.services[typeName]
-		if svc == nil {
-			s.logger.Error("service not found", "type", typeName)
-			continue
+		if err := s.services[typeName].svc.Stop(); err != nil {
+			s.logger.Error(
+				"failed to stop service", "type", typeName, "err", err,
+			)
+			errs = append(errs, err)
 		}
+	}
+	return errors.Join(errs...)
+}
 
-		if err := svc.Stop(); err != nil {
-			return err
+// Health returns the health of every registered service, keyed by service
+// type. A nil error denotes a healthy service: one which is started and
+// passes its health gate, if any.
+func (s *Registry) Health(ctx context.Context) map[string]error {
+	s.mu.RLock()
+	started := slices.Clone(s.started)
+	s.mu.RUnlock()
+
+	health := make(map[string]error, len(s.serviceTypes))
+	for _, typeName := range s.serviceTypes {
+		health[typeName] = errServiceNotRunning
+	}
+	for _, typeName := range started {
+		var err error
+		if check := s.services[typeName].cfg.healthCheck; check != nil {
+			err = check(ctx)
 		}
+		health[typeName] = err
 	}
-	return nil
+	return health
 }
 
 // RegisterService appends a service constructor function to the service
 // registry.
-func (s *Registry) RegisterService(service Basic) error {
+func (s *Registry) RegisterService(
+	service Basic, opts ...ServiceOption,
+) error {
 	typeName := service.Name()
 	if _, exists := s.services[typeName]; exists {
 		return errServiceAlreadyExists
 	}
-	s.services[typeName] = service
+	e := &entry{svc: service}
+	for _, opt := range opts {
+		opt(&e.cfg)
+	}
+	s.services[typeName] = e
 	s.serviceTypes = append(s.serviceTypes, typeName)
 	return nil
 }
@@ -131,8 +188,8 @@ func (s *Registry) FetchService(service interface{}) error {
 	element := reflect.ValueOf(service).Elem()
 
 	typeName := ""
-	for name, svc := range s.services {
-		svcType := reflect.TypeOf(svc)
+	for name, e := range s.services {
+		svcType := reflect.TypeOf(e.svc)
 		if svcType.AssignableTo(serviceType.Elem()) {
 			typeName = name
 			break
@@ -144,8 +201,82 @@ func (s *Registry) FetchService(service interface{}) error {
 	}
 
 	if running, ok := s.services[typeName]; ok {
-		element.Set(reflect.ValueOf(running))
+		element.Set(reflect.ValueOf(running.svc))
 		return nil
 	}
 	return errUnknownService
 }
+
+// startOrder returns the registered service types sorted such that every
+// service comes after its dependencies. Ties are broken by registration
+// order.
+func (s *Registry) startOrder() ([]string, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	var (
+		order = make([]string, 0, len(s.serviceTypes))
+		state = make(map[string]int, len(s.serviceTypes))
+		visit func(string) error
+	)
+	visit = func(typeName string) error {
+		switch state[typeName] {
+		case visited:
+			return nil
+		case visiting:
+			return errors.Wrap(errDependencyCycle, typeName)
+		}
+		state[typeName] = visiting
+		for _, dep := range s.services[typeName].cfg.dependencies {
+			if _, ok := s.services[dep]; !ok {
+				return errors.Wrapf(
+					errUnknownDependency, "%s depends on %s", typeName, dep,
+				)
+			}
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		state[typeName] = visited
+		order = append(order, typeName)
+		return nil
+	}
+
+	for _, typeName := range s.serviceTypes {
+		if err := visit(typeName); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// startService starts the service and waits for it to pass its health gate,
+// restarting it according to its restart policy upon failure.
+func (s *Registry) startService(ctx context.Context, e *entry) error {
+	var err error
+	for attempt := 0; ; attempt++ {
+		if err = e.svc.Start(ctx); err == nil {
+			if err = e.cfg.awaitHealthy(ctx); err == nil {
+				return nil
+			}
+			if stopErr := e.svc.Stop(); stopErr != nil {
+				err = errors.Join(err, stopErr)
+			}
+		}
+		if attempt >= e.cfg.restart.MaxRestarts {
+			return err
+		}
+
+		s.logger.Warn(
+			"Restarting service",
+			"type", e.svc.Name(), "attempt", attempt+1, "err", err,
+		)
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(e.cfg.restart.Backoff):
+		}
+	}
+}
@@ -22,6 +22,7 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -81,3 +82,177 @@ func TestRegistry_FetchService(t *testing.T) {
 		t.Errorf("Fetched service type mismatch")
 	}
 }
+
+// recordingService is a service recording its starts and stops. It fails to
+// start with the given errors before succeeding.
+type recordingService struct {
+	name      string
+	events    *[]string
+	startErrs []error
+}
+
+func newRecordingService(
+	name string, events *[]string, startErrs ...error,
+) *recordingService {
+	return &recordingService{name: name, events: events, startErrs: startErrs}
+}
+
+func (s *recordingService) Start(context.Context) error {
+	if len(s.startErrs) > 0 {
+		err := s.startErrs[0]
+		s.startErrs = s.startErrs[1:]
+		return err
+	}
+	*s.events = append(*s.events, "start "+s.name)
+	return nil
+}
+
+func (s *recordingService) Stop() error {
+	*s.events = append(*s.events, "stop "+s.name)
+	return nil
+}
+
+func (s *recordingService) Name() string {
+	return s.name
+}
+
+func TestRegistry_DependencyOrder(t *testing.T) {
+	var events []string
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(
+			newRecordingService("A", &events), service.DependsOn("C"),
+		),
+		service.WithService(newRecordingService("B", &events)),
+		service.WithService(
+			newRecordingService("C", &events), service.DependsOn("B"),
+		),
+	)
+
+	require.NoError(t, registry.StartAll(context.Background()))
+	require.NoError(t, registry.StopAll())
+	require.Equal(t, []string{
+		"start B", "start C", "start A", "stop A", "stop C", "stop B",
+	}, events)
+}
+
+func TestRegistry_InvalidDependencies(t *testing.T) {
+	var events []string
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(
+			newRecordingService("A", &events), service.DependsOn("B"),
+		),
+		service.WithService(
+			newRecordingService("B", &events), service.DependsOn("A"),
+		),
+	)
+	require.ErrorContains(t, registry.StartAll(context.Background()), "cycle")
+
+	registry = service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(
+			newRecordingService("A", &events), service.DependsOn("B"),
+		),
+	)
+	require.ErrorContains(
+		t, registry.StartAll(context.Background()), "unknown",
+	)
+	require.Empty(t, events)
+}
+
+func TestRegistry_StartFailureStopsStarted(t *testing.T) {
+	var events []string
+	errStart := errors.New("start failure")
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(newRecordingService("A", &events)),
+		service.WithService(newRecordingService("B", &events)),
+		service.WithService(newRecordingService("C", &events, errStart)),
+	)
+
+	require.ErrorIs(t, registry.StartAll(context.Background()), errStart)
+	require.Equal(t, []string{
+		"start A", "start B", "stop B", "stop A",
+	}, events)
+}
+
+func TestRegistry_RestartPolicy(t *testing.T) {
+	var events []string
+	errStart := errors.New("start failure")
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(
+			newRecordingService("A", &events, errStart, errStart),
+			service.WithRestartPolicy(service.RestartPolicy{MaxRestarts: 2}),
+		),
+	)
+
+	require.NoError(t, registry.StartAll(context.Background()))
+	require.Equal(t, []string{"start A"}, events)
+}
+
+func TestRegistry_HealthGate(t *testing.T) {
+	var (
+		events     []string
+		errHealthy = errors.New("not healthy")
+		checks     int
+	)
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(
+			newRecordingService("A", &events),
+			service.WithHealthGate(func(context.Context) error {
+				checks++
+				if checks < 2 {
+					return errHealthy
+				}
+				return nil
+			}, time.Second),
+		),
+		service.WithService(
+			newRecordingService("B", &events),
+			service.WithHealthGate(func(context.Context) error {
+				return errHealthy
+			}, 10*time.Millisecond),
+		),
+	)
+
+	require.ErrorIs(t, registry.StartAll(context.Background()), errHealthy)
+	require.Equal(t, 2, checks)
+	require.Equal(t, []string{
+		"start A", "start B", "stop B", "stop A",
+	}, events)
+}
+
+func TestRegistry_Health(t *testing.T) {
+	var (
+		events     []string
+		errHealthy = errors.New("not healthy")
+		healthy    = true
+	)
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(
+			newRecordingService("A", &events),
+			service.WithHealthGate(func(context.Context) error {
+				if !healthy {
+					return errHealthy
+				}
+				return nil
+			}, time.Second),
+		),
+		service.WithService(newRecordingService("B", &events)),
+	)
+
+	health := registry.Health(context.Background())
+	require.Len(t, health, 2)
+	require.Error(t, health["A"])
+	require.Error(t, health["B"])
+
+	require.NoError(t, registry.StartAll(context.Background()))
+	healthy = false
+	health = registry.Health(context.Background())
+	require.ErrorIs(t, health["A"], errHealthy)
+	require.NoError(t, health["B"])
+}
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package reload
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/spf13/viper"
+)
+
+// Func applies the reloadable subset of the given configuration. appOpts
+// holds the raw values of the reloaded configuration file.
+type Func func(appOpts config.AppOptions, cfg *config.Config) error
+
+// target is a named reload function.
+type target struct {
+	name string
+	fn   Func
+}
+
+// Service reloads a subset of the node configuration from the application
+// configuration file, upon SIGHUP or when requested, without restarting the
+// node. Only the values of the file are reloaded, command line flags
+// overriding them at startup are not taken into account.
+type Service struct {
+	logger     log.Logger
+	configFile string
+	targets    []target
+
+	// mu serializes reloads.
+	mu     sync.Mutex
+	sigCh  chan os.Signal
+	stopCh chan struct{}
+}
+
+// NewService creates a new reload service reading the given application
+// configuration file.
+func NewService(logger log.Logger, configFile string) *Service {
+	return &Service{
+		logger:     logger,
+		configFile: configFile,
+		sigCh:      make(chan os.Signal, 1),
+		stopCh:     make(chan struct{}),
+	}
+}
+
+// Register registers a reload function under the given name. Functions are
+// applied in registration order.
+func (s *Service) Register(name string, fn Func) {
+	s.targets = append(s.targets, target{name: name, fn: fn})
+}
+
+// Name returns the name of the service.
+func (s *Service) Name() string {
+	return "config-reloader"
+}
+
+// Start listens for SIGHUP to reload the configuration.
+func (s *Service) Start(ctx context.Context) error {
+	signal.Notify(s.sigCh, syscall.SIGHUP)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.stopCh:
+				return
+			case <-s.sigCh:
+				s.logger.Info("Caught SIGHUP, reloading configuration")
+				//#nosec:G104 // errors are logged by Reload.
+				_ = s.Reload()
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops listening for SIGHUP.
+func (s *Service) Stop() error {
+	signal.Stop(s.sigCh)
+	select {
+	case <-s.stopCh:
+	default:
+		close(s.stopCh)
+	}
+	return nil
+}
+
+// Reload reads the configuration file and applies every registered reload
+// function. All functions are applied even if some of them fail, in which
+// case the errors are joined.
+func (s *Service) Reload() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v := viper.New()
+	v.SetConfigFile(s.configFile)
+	if err := v.ReadInConfig(); err != nil {
+		s.logger.Error("Failed to read configuration", "error", err)
+		return err
+	}
+	cfg, err := config.ReadConfigFromAppOpts(v)
+	if err != nil {
+		s.logger.Error("Failed to parse configuration", "error", err)
+		return err
+	}
+
+	var errs []error
+	for _, t := range s.targets {
+		if err = t.fn(v, cfg); err != nil {
+			s.logger.Error(
+				"Failed to reload configuration", "target", t.name,
+				"error", err,
+			)
+			errs = append(errs, errors.Wrap(err, t.name))
+			continue
+		}
+		s.logger.Info("Reloaded configuration", "target", t.name)
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashingprotection
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrUnsupportedInterchange is returned when importing an interchange
+	// of a format version other than the one supported.
+	ErrUnsupportedInterchange = errors.New(
+		"unsupported slashing protection interchange format version",
+	)
+	// ErrGenesisValidatorsRootMismatch is returned when importing an
+	// interchange of another chain.
+	ErrGenesisValidatorsRootMismatch = errors.New(
+		"slashing protection interchange genesis validators root mismatch",
+	)
+	// ErrDoubleBlock is returned when signing a block for a slot another
+	// block was signed for.
+	ErrDoubleBlock = errors.New(
+		"refusing to sign a second block for the same slot",
+	)
+	// ErrSlotNotMonotonic is returned when signing a block for a slot
+	// preceding the one of the last block signed.
+	ErrSlotNotMonotonic = errors.New(
+		"refusing to sign a block preceding the last one signed",
+	)
+	// ErrSlotTooLow is returned when signing a block for a slot preceding the
+	// lowest one the key may sign.
+	ErrSlotTooLow = errors.New(
+		"refusing to sign a block before the lowest slot allowed",
+	)
+	// ErrRateLimited is returned when signing beyond the rate allowed.
+	ErrRateLimited = errors.New("signing rate limit exceeded")
+)
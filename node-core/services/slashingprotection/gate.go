@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashingprotection
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"golang.org/x/time/rate"
+)
+
+// gatedSigner is a signer refusing to sign what could get its key slashed,
+// whatever the protections of the signer it wraps, and to sign beyond the
+// configured rate.
+type gatedSigner struct {
+	crypto.BLSSigner
+	store *Store
+	// limiter limits the rate of signing requests, nil if unlimited.
+	limiter *rate.Limiter
+}
+
+// Gate returns a signer refusing to sign with the given one two different
+// blocks for the same slot or a block preceding the last one signed, and to
+// sign beyond the rate allowed by the configuration.
+func (s *Store) Gate(signer crypto.BLSSigner, cfg Config) crypto.BLSSigner {
+	g := &gatedSigner{BLSSigner: signer, store: s}
+	if cfg.RateLimit > 0 {
+		g.limiter = rate.NewLimiter(
+			rate.Limit(cfg.RateLimit), max(int(cfg.RateLimit), 1),
+		)
+	}
+	return g
+}
+
+// Sign signs the given message, if allowed by the rate limit. Blocks are
+// only checked when signed through SignRequest.
+func (g *gatedSigner) Sign(msg []byte) (crypto.BLSSignature, error) {
+	if err := g.allow(); err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	return g.BLSSigner.Sign(msg)
+}
+
+// SignRequest signs the given request, if allowed, recording the blocks
+// signed.
+func (g *gatedSigner) SignRequest(
+	req *crypto.SigningRequest,
+) (crypto.BLSSignature, error) {
+	if err := g.allow(); err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	if req.Type == crypto.SigningTypeBlock {
+		if err := g.store.RecordBlock(
+			g.PublicKey(), req.Slot, common.Root(req.SigningRoot),
+		); err != nil {
+			return crypto.BLSSignature{}, err
+		}
+	}
+	return crypto.SignRequest(g.BLSSigner, req)
+}
+
+// allow returns an error if signing now exceeds the rate limit.
+func (g *gatedSigner) allow() error {
+	if g.limiter != nil && !g.limiter.Allow() {
+		return ErrRateLimited
+	}
+	return nil
+}
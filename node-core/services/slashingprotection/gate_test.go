@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashingprotection_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/node-core/services/slashingprotection"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+// stubSigner counts the messages it signs.
+type stubSigner struct {
+	crypto.BLSSigner
+	signed int
+}
+
+func (s *stubSigner) PublicKey() crypto.BLSPubkey {
+	return crypto.BLSPubkey{0x01}
+}
+
+func (s *stubSigner) Sign([]byte) (crypto.BLSSignature, error) {
+	s.signed++
+	return crypto.BLSSignature{}, nil
+}
+
+func TestGate(t *testing.T) {
+	s, err := slashingprotection.NewStore(
+		filepath.Join(t.TempDir(), "slashing-protection.json"),
+	)
+	require.NoError(t, err)
+	inner := &stubSigner{}
+	signer := s.Gate(inner, slashingprotection.Config{})
+
+	block := func(slot math.Slot, root byte) *crypto.SigningRequest {
+		return &crypto.SigningRequest{
+			Type:        crypto.SigningTypeBlock,
+			SigningRoot: bytes.B32{root},
+			Slot:        slot,
+		}
+	}
+	_, err = crypto.SignRequest(signer, block(5, 0x01))
+	require.NoError(t, err)
+	_, err = crypto.SignRequest(signer, block(5, 0x02))
+	require.ErrorIs(t, err, slashingprotection.ErrDoubleBlock)
+	_, err = crypto.SignRequest(signer, block(4, 0x03))
+	require.ErrorIs(t, err, slashingprotection.ErrSlotNotMonotonic)
+	require.Equal(t, 1, inner.signed)
+
+	// Messages other than blocks are not checked.
+	_, err = crypto.SignRequest(signer, &crypto.SigningRequest{
+		Type: crypto.SigningTypeRandaoReveal,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, inner.signed)
+}
+
+func TestGateRateLimit(t *testing.T) {
+	s, err := slashingprotection.NewStore(
+		filepath.Join(t.TempDir(), "slashing-protection.json"),
+	)
+	require.NoError(t, err)
+	inner := &stubSigner{}
+	signer := s.Gate(inner, slashingprotection.Config{RateLimit: 2})
+
+	// Bursts of up to one second of requests are allowed.
+	for range 2 {
+		_, err = signer.Sign([]byte{0x01})
+		require.NoError(t, err)
+	}
+	_, err = signer.Sign([]byte{0x01})
+	require.ErrorIs(t, err, slashingprotection.ErrRateLimited)
+	require.Equal(t, 2, inner.signed)
+}
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashingprotection
+
+import (
+	"maps"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// InterchangeVersion is the supported version of the interchange format.
+const InterchangeVersion = "5"
+
+// Interchange is the slashing protection history of validator keys, in the
+// format exchanged between validator clients.
+// https://eips.ethereum.org/EIPS/eip-3076
+type Interchange struct {
+	Metadata InterchangeMetadata `json:"metadata"`
+	Data     []InterchangeData   `json:"data"`
+}
+
+// InterchangeMetadata identifies the format and chain of an interchange.
+type InterchangeMetadata struct {
+	InterchangeFormatVersion string      `json:"interchange_format_version"`
+	GenesisValidatorsRoot    common.Root `json:"genesis_validators_root"`
+}
+
+// InterchangeData is the signing history of a validator key. Beacon-kit has
+// no attestations, only the blocks signed are relevant.
+type InterchangeData struct {
+	Pubkey             crypto.BLSPubkey         `json:"pubkey"`
+	SignedBlocks       []InterchangeBlock       `json:"signed_blocks"`
+	SignedAttestations []InterchangeAttestation `json:"signed_attestations"`
+}
+
+// InterchangeBlock is a block signed by a validator key.
+type InterchangeBlock struct {
+	Slot        uint64       `json:"slot,string"`
+	SigningRoot *common.Root `json:"signing_root,omitempty"`
+}
+
+// InterchangeAttestation is an attestation signed by a validator key.
+type InterchangeAttestation struct {
+	SourceEpoch uint64       `json:"source_epoch,string"`
+	TargetEpoch uint64       `json:"target_epoch,string"`
+	SigningRoot *common.Root `json:"signing_root,omitempty"`
+}
+
+// ImportInterchange imports the JSON encoded interchange of the chain with
+// the given genesis validators root. The keys of the interchange may not
+// sign a block before the slot following the last one they signed, the
+// history of the keys already registered only being raised.
+func (s *Store) ImportInterchange(
+	genesisValidatorsRoot common.Root,
+	bz []byte,
+) error {
+	var interchange Interchange
+	if err := json.Unmarshal(bz, &interchange); err != nil {
+		return errors.Wrap(err, "invalid slashing protection interchange")
+	}
+	if v := interchange.Metadata.InterchangeFormatVersion; v !=
+		InterchangeVersion {
+		return errors.Wrap(ErrUnsupportedInterchange, v)
+	}
+	if interchange.Metadata.GenesisValidatorsRoot != genesisValidatorsRoot {
+		return ErrGenesisValidatorsRootMismatch
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// The entries raised are replaced rather than updated in place, for the
+	// previous ones to be restored if they fail to be persisted.
+	prev := maps.Clone(s.entries)
+	for _, data := range interchange.Data {
+		var minSlot math.Slot
+		for _, blk := range data.SignedBlocks {
+			minSlot = max(minSlot, math.Slot(blk.Slot+1))
+		}
+		e, ok := s.entries[data.Pubkey]
+		if minSlot == 0 || ok && e.MinSlot >= minSlot {
+			continue
+		}
+		raised := Entry{Pubkey: data.Pubkey, MinSlot: minSlot}
+		if ok {
+			raised.LastBlock = e.LastBlock
+		}
+		s.entries[data.Pubkey] = &raised
+	}
+	if err := s.persist(); err != nil {
+		s.entries = prev
+		return err
+	}
+	return nil
+}
+
+// ExportInterchange exports the history of the given keys, the ones not
+// registered being omitted, as a JSON encoded interchange of the chain with
+// the given genesis validators root. The history of a key is exported as
+// the block of the slot preceding the lowest one it may sign, if any, and
+// the last block it signed past it.
+func (s *Store) ExportInterchange(
+	genesisValidatorsRoot common.Root,
+	pubkeys []crypto.BLSPubkey,
+) ([]byte, error) {
+	interchange := Interchange{
+		Metadata: InterchangeMetadata{
+			InterchangeFormatVersion: InterchangeVersion,
+			GenesisValidatorsRoot:    genesisValidatorsRoot,
+		},
+		Data: make([]InterchangeData, 0, len(pubkeys)),
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, pubkey := range pubkeys {
+		e, ok := s.entries[pubkey]
+		if !ok {
+			continue
+		}
+		data := InterchangeData{
+			Pubkey:             pubkey,
+			SignedBlocks:       []InterchangeBlock{},
+			SignedAttestations: []InterchangeAttestation{},
+		}
+		if e.MinSlot > 0 {
+			data.SignedBlocks = append(data.SignedBlocks, InterchangeBlock{
+				Slot: e.MinSlot.Unwrap() - 1,
+			})
+		}
+		if last := e.LastBlock; last != nil && last.Slot >= e.MinSlot {
+			data.SignedBlocks = append(data.SignedBlocks, InterchangeBlock{
+				Slot:        last.Slot.Unwrap(),
+				SigningRoot: &last.SigningRoot,
+			})
+		}
+		interchange.Data = append(interchange.Data, data)
+	}
+	return json.Marshal(interchange)
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashingprotection_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/node-core/services/slashingprotection"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterchange(t *testing.T) {
+	s, err := slashingprotection.NewStore(
+		filepath.Join(t.TempDir(), "slashing-protection.json"),
+	)
+	require.NoError(t, err)
+	root := common.Root{0x01}
+	a, b, c := crypto.BLSPubkey{0x0a}, crypto.BLSPubkey{0x0b},
+		crypto.BLSPubkey{0x0c}
+	require.NoError(t, s.Register(a, 10))
+	require.NoError(t, s.Register(b, 10))
+
+	// Histories are only raised, past the last block signed.
+	interchange := `{
+		"metadata": {
+			"interchange_format_version": "5",
+			"genesis_validators_root": "` + root.String() + `"
+		},
+		"data": [
+			{
+				"pubkey": "` + a.String() + `",
+				"signed_blocks": [{"slot": "20"}, {"slot": "15"}],
+				"signed_attestations": []
+			},
+			{
+				"pubkey": "` + b.String() + `",
+				"signed_blocks": [{"slot": "5"}],
+				"signed_attestations": []
+			},
+			{
+				"pubkey": "` + c.String() + `",
+				"signed_blocks": [{"slot": "30"}],
+				"signed_attestations": []
+			}
+		]
+	}`
+	require.NoError(t, s.ImportInterchange(root, []byte(interchange)))
+	for pubkey, minSlot := range map[crypto.BLSPubkey]math.Slot{
+		a: 21, b: 10, c: 31,
+	} {
+		e, ok := s.Get(pubkey)
+		require.True(t, ok)
+		require.Equal(t, minSlot, e.MinSlot)
+	}
+
+	// Interchanges of other chains are rejected.
+	require.ErrorIs(t,
+		s.ImportInterchange(common.Root{0x02}, []byte(interchange)),
+		slashingprotection.ErrGenesisValidatorsRootMismatch,
+	)
+
+	// The exported history of a key round trips.
+	bz, err := s.ExportInterchange(root, []crypto.BLSPubkey{
+		a, crypto.BLSPubkey{0x0d},
+	})
+	require.NoError(t, err)
+	other, err := slashingprotection.NewStore(
+		filepath.Join(t.TempDir(), "slashing-protection.json"),
+	)
+	require.NoError(t, err)
+	require.NoError(t, other.ImportInterchange(root, bz))
+	e, ok := other.Get(a)
+	require.True(t, ok)
+	require.Equal(t, math.Slot(21), e.MinSlot)
+	require.False(t, other.Registered(crypto.BLSPubkey{0x0d}))
+}
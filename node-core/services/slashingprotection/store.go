@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashingprotection
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// fileMode is the mode of the file the entries are persisted to.
+const fileMode = 0o600
+
+// Entry is the signing history of a validator key.
+type Entry struct {
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+	// MinSlot is the lowest slot the key may sign a block for, i.e. the
+	// slot following the head of the chain when the key was registered.
+	MinSlot math.Slot `json:"min_slot"`
+	// LastBlock is the last block signed with the key, if any.
+	LastBlock *SignedBlock `json:"last_block,omitempty"`
+}
+
+// SignedBlock is a block signed with a validator key.
+type SignedBlock struct {
+	// Slot is the slot of the block.
+	Slot math.Slot `json:"slot"`
+	// SigningRoot is the signing root of the block.
+	SigningRoot common.Root `json:"signing_root"`
+}
+
+// Store is the local slashing protection database of the validator keys
+// loaded by the node, persisted so that it survives restarts. The entries of
+// the keys removed from the node are kept, so that their history applies
+// again if they are loaded anew.
+type Store struct {
+	// path is the path of the file the entries are persisted to.
+	path string
+
+	// mu protects entries.
+	mu sync.Mutex
+	// entries are the entries by public key.
+	entries map[crypto.BLSPubkey]*Entry
+}
+
+// NewStore creates a new slashing protection store, loading the entries
+// persisted at the given path.
+func NewStore(path string) (*Store, error) {
+	s := &Store{
+		path:    path,
+		entries: make(map[crypto.BLSPubkey]*Entry),
+	}
+	bz, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return s, nil
+	case err != nil:
+		return nil, err
+	}
+	var entries []*Entry
+	if err = json.Unmarshal(bz, &entries); err != nil {
+		return nil, errors.Wrapf(err, "failed to load %s", path)
+	}
+	for _, e := range entries {
+		s.entries[e.Pubkey] = e
+	}
+	return s, nil
+}
+
+// Register initializes the entry of the given key, which may not sign a
+// block before the given slot. It is a no-op if the key is already
+// registered, its history being kept.
+func (s *Store) Register(pubkey crypto.BLSPubkey, minSlot math.Slot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.entries[pubkey]; ok {
+		return nil
+	}
+	s.entries[pubkey] = &Entry{Pubkey: pubkey, MinSlot: minSlot}
+	if err := s.persist(); err != nil {
+		delete(s.entries, pubkey)
+		return err
+	}
+	return nil
+}
+
+// RecordBlock records that the given key is about to sign the block of the
+// given slot and signing root, failing if signing it could get the key
+// slashed: the slot may not precede the lowest one the key may sign nor the
+// one of the last block signed, and another block may not have been signed
+// for the same slot. Signing the last block again is allowed. The key is
+// registered if it is not already.
+func (s *Store) RecordBlock(
+	pubkey crypto.BLSPubkey,
+	slot math.Slot,
+	signingRoot common.Root,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	prev, ok := s.entries[pubkey]
+	if !ok {
+		prev = &Entry{Pubkey: pubkey}
+	}
+	switch last := prev.LastBlock; {
+	case last != nil && last.Slot == slot:
+		if last.SigningRoot != signingRoot {
+			return errors.Wrapf(ErrDoubleBlock, "slot %d", slot)
+		}
+		return nil
+	case last != nil && slot < last.Slot:
+		return errors.Wrapf(
+			ErrSlotNotMonotonic, "slot %d, last signed %d", slot, last.Slot,
+		)
+	case slot < prev.MinSlot:
+		return errors.Wrapf(
+			ErrSlotTooLow, "slot %d, lowest allowed %d", slot, prev.MinSlot,
+		)
+	}
+	// The entry is replaced rather than updated in place, for the previous
+	// one to be restored if it fails to be persisted.
+	e := *prev
+	e.LastBlock = &SignedBlock{Slot: slot, SigningRoot: signingRoot}
+	s.entries[pubkey] = &e
+	if err := s.persist(); err != nil {
+		if ok {
+			s.entries[pubkey] = prev
+		} else {
+			delete(s.entries, pubkey)
+		}
+		return err
+	}
+	return nil
+}
+
+// Get returns a copy of the entry of the given key, if registered.
+func (s *Store) Get(pubkey crypto.BLSPubkey) (Entry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[pubkey]
+	if !ok {
+		return Entry{}, false
+	}
+	return *e, true
+}
+
+// Registered reports whether the given key is registered.
+func (s *Store) Registered(pubkey crypto.BLSPubkey) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.entries[pubkey]
+	return ok
+}
+
+// persist atomically writes the entries to the file, ordered by public key.
+// The lock must be held.
+func (s *Store) persist() error {
+	entries := make([]*Entry, 0, len(s.entries))
+	for _, e := range s.entries {
+		entries = append(entries, e)
+	}
+	slices.SortFunc(entries, func(a, b *Entry) int {
+		return slices.Compare(a.Pubkey[:], b.Pubkey[:])
+	})
+	bz, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(s.path), os.ModePerm); err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err = os.WriteFile(tmp, bz, fileMode); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
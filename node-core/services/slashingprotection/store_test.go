@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashingprotection_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/node-core/services/slashingprotection"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegister(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slashing-protection.json")
+	s, err := slashingprotection.NewStore(path)
+	require.NoError(t, err)
+
+	pubkey := crypto.BLSPubkey{0x01}
+	_, ok := s.Get(pubkey)
+	require.False(t, ok)
+
+	// The history of a registered key is kept.
+	require.NoError(t, s.Register(pubkey, 10))
+	require.NoError(t, s.Register(pubkey, 20))
+	want := slashingprotection.Entry{Pubkey: pubkey, MinSlot: 10}
+	e, ok := s.Get(pubkey)
+	require.True(t, ok)
+	require.Equal(t, want, e)
+
+	// The entries survive restarts.
+	s, err = slashingprotection.NewStore(path)
+	require.NoError(t, err)
+	e, ok = s.Get(pubkey)
+	require.True(t, ok)
+	require.Equal(t, want, e)
+}
+
+func TestRecordBlock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slashing-protection.json")
+	s, err := slashingprotection.NewStore(path)
+	require.NoError(t, err)
+	pubkey := crypto.BLSPubkey{0x01}
+	require.NoError(t, s.Register(pubkey, 10))
+
+	require.ErrorIs(t,
+		s.RecordBlock(pubkey, 9, common.Root{0x01}),
+		slashingprotection.ErrSlotTooLow,
+	)
+	require.NoError(t, s.RecordBlock(pubkey, 12, common.Root{0x01}))
+	// The last block may be signed again, but no other for its slot.
+	require.NoError(t, s.RecordBlock(pubkey, 12, common.Root{0x01}))
+	require.ErrorIs(t,
+		s.RecordBlock(pubkey, 12, common.Root{0x02}),
+		slashingprotection.ErrDoubleBlock,
+	)
+	require.ErrorIs(t,
+		s.RecordBlock(pubkey, 11, common.Root{0x02}),
+		slashingprotection.ErrSlotNotMonotonic,
+	)
+
+	// The keys not registered are registered, and the blocks recorded
+	// survive restarts.
+	other := crypto.BLSPubkey{0x02}
+	require.NoError(t, s.RecordBlock(other, 3, common.Root{0x03}))
+	s, err = slashingprotection.NewStore(path)
+	require.NoError(t, err)
+	e, ok := s.Get(other)
+	require.True(t, ok)
+	require.Equal(t, slashingprotection.Entry{
+		Pubkey: other,
+		LastBlock: &slashingprotection.SignedBlock{
+			Slot: 3, SigningRoot: common.Root{0x03},
+		},
+	}, e)
+	require.ErrorIs(t,
+		s.RecordBlock(pubkey, 12, common.Root{0x02}),
+		slashingprotection.ErrDoubleBlock,
+	)
+}
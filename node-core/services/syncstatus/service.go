@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package syncstatus
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+	nodetypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
+)
+
+// defaultReportingInterval is the interval at which the sync status is
+// logged while the node is catching up.
+const defaultReportingInterval = 30 * time.Second
+
+// Service combines the sync status of the parts of the node, and logs a
+// summary of the ones still catching up.
+type Service struct {
+	// logger is used to log the sync status.
+	logger log.Logger
+	// consensus reports on the CometBFT block sync.
+	consensus Consensus
+	// engine reports on the execution client.
+	engine ExecutionEngine
+	// deposits reports on the deposits backfill.
+	deposits DepositStore
+	// maxBlocksBehind is the number of blocks the node may lag behind its
+	// peers without being considered syncing.
+	maxBlocksBehind uint64
+	// reportingInterval is the interval at which the status is logged.
+	reportingInterval time.Duration
+}
+
+// NewService creates a new sync status service.
+func NewService(
+	logger log.Logger,
+	consensus Consensus,
+	engine ExecutionEngine,
+	deposits DepositStore,
+	maxBlocksBehind uint64,
+) *Service {
+	return &Service{
+		logger:            logger,
+		consensus:         consensus,
+		engine:            engine,
+		deposits:          deposits,
+		maxBlocksBehind:   maxBlocksBehind,
+		reportingInterval: defaultReportingInterval,
+	}
+}
+
+// Name returns the name of the service.
+func (*Service) Name() string {
+	return "sync-status"
+}
+
+// Start begins logging the sync status periodically, while the node is
+// catching up and once when it caught up.
+func (s *Service) Start(ctx context.Context) error {
+	go func() {
+		ticker := time.NewTicker(s.reportingInterval)
+		defer ticker.Stop()
+		synced := false
+		for {
+			select {
+			case <-ticker.C:
+				synced = s.report(synced)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop is a no-op, logging stops with the context given to Start.
+func (*Service) Stop() error {
+	return nil
+}
+
+// Status returns the sync status of the node. It errors if CometBFT is not
+// running or the deposit store cannot be read.
+func (s *Service) Status() (*nodetypes.SyncStatusData, error) {
+	behind, err := s.consensus.BlocksBehind()
+	if err != nil {
+		return nil, err
+	}
+	failed, err := s.deposits.GetFailedBlocks()
+	if err != nil {
+		return nil, err
+	}
+
+	status := &nodetypes.SyncStatusData{
+		Consensus: nodetypes.ConsensusSyncStatus{
+			//#nosec:G115 // heights are never negative.
+			HeadSlot:     uint64(s.consensus.LastBlockHeight()),
+			SyncDistance: behind,
+			IsSyncing:    behind > s.maxBlocksBehind,
+		},
+		Execution: nodetypes.ExecutionSyncStatus{
+			ELOffline:    !s.engine.IsConnected(),
+			IsOptimistic: s.engine.IsSyncing(),
+		},
+		Deposits: nodetypes.DepositsSyncStatus{
+			PendingBlocks: uint64(len(failed)),
+		},
+	}
+	if len(failed) > 0 {
+		status.Deposits.OldestPendingBlock = slices.Min(failed)
+	}
+	status.Synced = !status.Consensus.IsSyncing &&
+		!status.Execution.ELOffline &&
+		!status.Execution.IsOptimistic &&
+		status.Deposits.PendingBlocks == 0
+	return status, nil
+}
+
+// report logs the sync status if the node is catching up, or if it just
+// caught up given whether it was synced, and returns whether it is synced.
+func (s *Service) report(synced bool) bool {
+	status, err := s.Status()
+	if err != nil {
+		s.logger.Debug("Failed to get sync status", "error", err)
+		return synced
+	}
+	switch {
+	case !status.Synced:
+		s.logger.Info(
+			"Node is catching up",
+			"head_slot", status.Consensus.HeadSlot,
+			"sync_distance", status.Consensus.SyncDistance,
+			"block_syncing", status.Consensus.IsSyncing,
+			"el_offline", status.Execution.ELOffline,
+			"el_optimistic", status.Execution.IsOptimistic,
+			"pending_deposit_blocks", status.Deposits.PendingBlocks,
+		)
+	case !synced:
+		s.logger.Info(
+			"Node is synced", "head_slot", status.Consensus.HeadSlot,
+		)
+	}
+	return status.Synced
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package syncstatus_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	nodetypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/node-core/services/syncstatus"
+	"github.com/stretchr/testify/require"
+)
+
+type testConsensus struct {
+	height int64
+	behind uint64
+}
+
+func (c testConsensus) LastBlockHeight() int64 { return c.height }
+
+func (c testConsensus) BlocksBehind() (uint64, error) { return c.behind, nil }
+
+type testEngine struct {
+	connected bool
+	syncing   bool
+}
+
+func (e testEngine) IsConnected() bool { return e.connected }
+
+func (e testEngine) IsSyncing() bool { return e.syncing }
+
+type testDeposits []uint64
+
+func (d testDeposits) GetFailedBlocks() ([]uint64, error) { return d, nil }
+
+func TestStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		consensus testConsensus
+		engine    testEngine
+		deposits  testDeposits
+		want      *nodetypes.SyncStatusData
+	}{
+		{
+			name:      "synced",
+			consensus: testConsensus{height: 42, behind: 2},
+			engine:    testEngine{connected: true},
+			want: &nodetypes.SyncStatusData{
+				Synced: true,
+				Consensus: nodetypes.ConsensusSyncStatus{
+					HeadSlot: 42, SyncDistance: 2,
+				},
+			},
+		},
+		{
+			name:      "catching up",
+			consensus: testConsensus{height: 42, behind: 20},
+			engine:    testEngine{syncing: true},
+			deposits:  testDeposits{12, 7},
+			want: &nodetypes.SyncStatusData{
+				Consensus: nodetypes.ConsensusSyncStatus{
+					HeadSlot: 42, SyncDistance: 20, IsSyncing: true,
+				},
+				Execution: nodetypes.ExecutionSyncStatus{
+					ELOffline: true, IsOptimistic: true,
+				},
+				Deposits: nodetypes.DepositsSyncStatus{
+					PendingBlocks: 2, OldestPendingBlock: 7,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := syncstatus.NewService(
+				noop.NewLogger[any](), tt.consensus, tt.engine, tt.deposits, 10,
+			)
+			got, err := s.Status()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package syncstatus
+
+// Consensus reports on the CometBFT block sync.
+type Consensus interface {
+	// LastBlockHeight returns the height of the latest committed block.
+	LastBlockHeight() int64
+	// BlocksBehind returns the number of blocks the node lags behind the
+	// highest block reported by its peers.
+	BlocksBehind() (uint64, error)
+}
+
+// DepositStore reports on the deposits backfill.
+type DepositStore interface {
+	// GetFailedBlocks returns the execution blocks whose deposits failed to
+	// be fetched, which are being retried.
+	GetFailedBlocks() ([]uint64, error)
+}
+
+// ExecutionEngine reports on the execution client.
+type ExecutionEngine interface {
+	// IsConnected returns whether the execution client is reachable.
+	IsConnected() bool
+	// IsSyncing returns whether the execution head is optimistic, i.e.
+	// whether the execution client is still syncing up to it.
+	IsSyncing() bool
+}
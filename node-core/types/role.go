@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import "github.com/berachain/beacon-kit/errors"
+
+// ErrUnknownRole is returned when parsing an unsupported node role.
+var ErrUnknownRole = errors.New("unknown node role")
+
+// Role determines the set of services a node runs.
+type Role string
+
+const (
+	// RoleValidator nodes sign and propose blocks. This is the default role.
+	RoleValidator Role = "validator"
+	// RoleFull nodes fully verify and execute blocks but never propose them.
+	RoleFull Role = "full"
+	// RoleRPC nodes only follow the chain to serve APIs. They hold no
+	// validator key and accept proposals without verifying them, relying on
+	// block finalization to execute the chain.
+	RoleRPC Role = "rpc"
+	// RoleReplica nodes serve APIs from the stores of another node, a
+	// snapshot or a shared volume, which they open read-only. They neither
+	// follow the chain nor write to the stores.
+	RoleReplica Role = "replica"
+)
+
+// ParseRole returns the role with the given name. An empty name maps to
+// RoleValidator.
+func ParseRole(name string) (Role, error) {
+	switch role := Role(name); role {
+	case "":
+		return RoleValidator, nil
+	case RoleValidator, RoleFull, RoleRPC, RoleReplica:
+		return role, nil
+	default:
+		return "", errors.Wrap(ErrUnknownRole, name)
+	}
+}
+
+// ProposesBlocks returns true if nodes of this role build and propose blocks.
+func (r Role) ProposesBlocks() bool {
+	return r == RoleValidator
+}
+
+// HasValidatorKey returns true if nodes of this role load a validator key.
+func (r Role) HasValidatorKey() bool {
+	return r != RoleRPC && r != RoleReplica
+}
+
+// VerifiesProposals returns true if nodes of this role verify proposals
+// before voting on them.
+func (r Role) VerifiesProposals() bool {
+	return r != RoleRPC && r != RoleReplica
+}
+
+// ReadOnly returns true if nodes of this role open the stores read-only and
+// run none of the services writing to them.
+func (r Role) ReadOnly() bool {
+	return r == RoleReplica
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/node-core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRole(t *testing.T) {
+	role, err := types.ParseRole("")
+	require.NoError(t, err)
+	require.Equal(t, types.RoleValidator, role)
+
+	for _, r := range []types.Role{
+		types.RoleValidator, types.RoleFull, types.RoleRPC, types.RoleReplica,
+	} {
+		role, err = types.ParseRole(string(r))
+		require.NoError(t, err)
+		require.Equal(t, r, role)
+	}
+
+	_, err = types.ParseRole("archive")
+	require.ErrorIs(t, err, types.ErrUnknownRole)
+}
+
+func TestRoleServices(t *testing.T) {
+	require.True(t, types.RoleValidator.ProposesBlocks())
+	require.True(t, types.RoleValidator.HasValidatorKey())
+	require.True(t, types.RoleValidator.VerifiesProposals())
+
+	require.False(t, types.RoleFull.ProposesBlocks())
+	require.True(t, types.RoleFull.HasValidatorKey())
+	require.True(t, types.RoleFull.VerifiesProposals())
+
+	require.False(t, types.RoleRPC.ProposesBlocks())
+	require.False(t, types.RoleRPC.HasValidatorKey())
+	require.False(t, types.RoleRPC.VerifiesProposals())
+	require.False(t, types.RoleRPC.ReadOnly())
+
+	require.False(t, types.RoleReplica.ProposesBlocks())
+	require.False(t, types.RoleReplica.HasValidatorKey())
+	require.False(t, types.RoleReplica.VerifiesProposals())
+	require.True(t, types.RoleReplica.ReadOnly())
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package diagnostics
+
+import "time"
+
+const (
+	defaultAddress             = "127.0.0.1:6060"
+	defaultMaxSlowBlockReports = 100
+)
+
+// Config is the configuration for the diagnostics server and the reports of
+// the slow blocks.
+type Config struct {
+	// Enabled is the flag to enable the diagnostics server.
+	Enabled bool `mapstructure:"enabled"`
+	// Address is the address to bind the diagnostics server to. It should
+	// not be publicly reachable.
+	Address string `mapstructure:"address"`
+	// SlowBlockThreshold is the processing time of a block above which a
+	// report of its processing is written. Zero disables the reports.
+	SlowBlockThreshold time.Duration `mapstructure:"slow-block-threshold"`
+	// MaxSlowBlockReports is the number of slow block reports kept, the
+	// oldest ones being removed.
+	MaxSlowBlockReports int `mapstructure:"max-slow-block-reports"`
+}
+
+// DefaultConfig returns the default configuration for the diagnostics
+// server.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:             false,
+		Address:             defaultAddress,
+		SlowBlockThreshold:  0,
+		MaxSlowBlockReports: defaultMaxSlowBlockReports,
+	}
+}
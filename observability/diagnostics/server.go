@@ -0,0 +1,247 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package diagnostics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	runtimepprof "runtime/pprof"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+)
+
+const (
+	// readHeaderTimeout bounds the time to read request headers.
+	readHeaderTimeout = 10 * time.Second
+	// shutdownTimeout bounds the time waited for requests to complete on
+	// Stop.
+	shutdownTimeout = 5 * time.Second
+)
+
+// HealthReporter reports the health of the node services, keyed by service
+// name. A nil error denotes a healthy service.
+type HealthReporter interface {
+	Health(ctx context.Context) map[string]error
+}
+
+// Reloader reloads the node configuration.
+type Reloader interface {
+	Reload() error
+}
+
+// Server is the diagnostics server service. It exposes pprof profiles,
+// runtime statistics, goroutine dumps and the health of the node services
+// on a dedicated address. It also serves as admin endpoint to reload the
+// node configuration.
+type Server struct {
+	config    Config
+	logger    log.Logger
+	startTime time.Time
+
+	mu       sync.RWMutex
+	health   HealthReporter
+	reloader Reloader
+	srv      *http.Server
+}
+
+// NewServer creates a new diagnostics server.
+func NewServer(config Config, logger log.Logger) *Server {
+	return &Server{
+		config:    config,
+		logger:    logger,
+		startTime: time.Now(),
+	}
+}
+
+// SetHealthReporter sets the source of the services health.
+func (s *Server) SetHealthReporter(health HealthReporter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.health = health
+}
+
+// SetReloader sets the reloader of the node configuration.
+func (s *Server) SetReloader(reloader Reloader) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reloader = reloader
+}
+
+// Name returns the name of the diagnostics server service.
+func (s *Server) Name() string {
+	return "diagnostics-server"
+}
+
+// Start starts the diagnostics server at the configured address.
+func (s *Server) Start(context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+
+	var lc net.ListenConfig
+	ln, err := lc.Listen(context.Background(), "tcp", s.config.Address)
+	if err != nil {
+		return err
+	}
+
+	s.srv = &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		serveErr := s.srv.Serve(ln)
+		if !errors.Is(serveErr, http.ErrServerClosed) {
+			s.logger.Error("Diagnostics server failed", "err", serveErr)
+		}
+	}()
+	s.logger.Info("Diagnostics server started", "address", ln.Addr())
+	return nil
+}
+
+// Stop gracefully shuts the diagnostics server down.
+func (s *Server) Stop() error {
+	if s.srv == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return s.srv.Shutdown(ctx)
+}
+
+// Handler returns the HTTP handler serving the diagnostics routes.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.HandleFunc("/debug/goroutines", s.handleGoroutines)
+	mux.HandleFunc("/debug/runtime", s.handleRuntime)
+	mux.HandleFunc("/health", s.handleHealth)
+	mux.HandleFunc("POST /admin/reload", s.handleReload)
+	return mux
+}
+
+// handleGoroutines writes the stack traces of all goroutines.
+func (s *Server) handleGoroutines(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	//nolint:mnd // debug level 2 prints stacks in the panic format.
+	if err := runtimepprof.Lookup("goroutine").WriteTo(w, 2); err != nil {
+		s.logger.Error("Failed to dump goroutines", "err", err)
+	}
+}
+
+// RuntimeStats are the runtime statistics of the node process.
+type RuntimeStats struct {
+	GoVersion    string `json:"go_version"`
+	Uptime       string `json:"uptime"`
+	NumCPU       int    `json:"num_cpu"`
+	GOMAXPROCS   int    `json:"gomaxprocs"`
+	NumGoroutine int    `json:"num_goroutine"`
+	HeapAlloc    uint64 `json:"heap_alloc"`
+	HeapInuse    uint64 `json:"heap_inuse"`
+	HeapObjects  uint64 `json:"heap_objects"`
+	TotalAlloc   uint64 `json:"total_alloc"`
+	Sys          uint64 `json:"sys"`
+	NumGC        uint32 `json:"num_gc"`
+	PauseTotalNs uint64 `json:"pause_total_ns"`
+}
+
+// handleRuntime writes the runtime statistics of the node process.
+func (s *Server) handleRuntime(w http.ResponseWriter, _ *http.Request) {
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	s.writeJSON(w, http.StatusOK, RuntimeStats{
+		GoVersion:    runtime.Version(),
+		Uptime:       time.Since(s.startTime).Round(time.Second).String(),
+		NumCPU:       runtime.NumCPU(),
+		GOMAXPROCS:   runtime.GOMAXPROCS(0),
+		NumGoroutine: runtime.NumGoroutine(),
+		HeapAlloc:    ms.HeapAlloc,
+		HeapInuse:    ms.HeapInuse,
+		HeapObjects:  ms.HeapObjects,
+		TotalAlloc:   ms.TotalAlloc,
+		Sys:          ms.Sys,
+		NumGC:        ms.NumGC,
+		PauseTotalNs: ms.PauseTotalNs,
+	})
+}
+
+// ServiceHealth is the health of a node service.
+type ServiceHealth struct {
+	Healthy bool   `json:"healthy"`
+	Error   string `json:"error,omitempty"`
+}
+
+// handleHealth writes the health of the node services. It responds with
+// 503 if any of them is unhealthy.
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	health := s.health
+	s.mu.RUnlock()
+
+	services := make(map[string]ServiceHealth)
+	status := http.StatusOK
+	if health != nil {
+		for name, err := range health.Health(r.Context()) {
+			services[name] = ServiceHealth{Healthy: err == nil}
+			if err != nil {
+				services[name] = ServiceHealth{Error: err.Error()}
+				status = http.StatusServiceUnavailable
+			}
+		}
+	}
+	s.writeJSON(w, status, services)
+}
+
+// handleReload reloads the node configuration.
+func (s *Server) handleReload(w http.ResponseWriter, _ *http.Request) {
+	s.mu.RLock()
+	reloader := s.reloader
+	s.mu.RUnlock()
+
+	if reloader == nil {
+		http.Error(w, "reload not supported", http.StatusNotImplemented)
+		return
+	}
+	if err := reloader.Reload(); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeJSON writes v as the JSON response body.
+func (s *Server) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		s.logger.Error("Failed to write diagnostics response", "err", err)
+	}
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package diagnostics_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/observability/diagnostics"
+	"github.com/stretchr/testify/require"
+)
+
+type healthReporter map[string]error
+
+func (h healthReporter) Health(context.Context) map[string]error {
+	return h
+}
+
+func serve(
+	t *testing.T, s *diagnostics.Server, path string,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	s.Handler().ServeHTTP(rec, req)
+	return rec
+}
+
+func TestServerHealth(t *testing.T) {
+	s := diagnostics.NewServer(
+		diagnostics.DefaultConfig(), noop.NewLogger[any](),
+	)
+	s.SetHealthReporter(healthReporter{"a": nil})
+
+	rec := serve(t, s, "/health")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var services map[string]diagnostics.ServiceHealth
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &services))
+	require.Equal(t, map[string]diagnostics.ServiceHealth{
+		"a": {Healthy: true},
+	}, services)
+
+	s.SetHealthReporter(healthReporter{"a": nil, "b": errors.New("down")})
+	rec = serve(t, s, "/health")
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &services))
+	require.Equal(t, diagnostics.ServiceHealth{Error: "down"}, services["b"])
+}
+
+func TestServerRuntime(t *testing.T) {
+	s := diagnostics.NewServer(
+		diagnostics.DefaultConfig(), noop.NewLogger[any](),
+	)
+
+	rec := serve(t, s, "/debug/runtime")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var stats diagnostics.RuntimeStats
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &stats))
+	require.Positive(t, stats.NumGoroutine)
+	require.NotEmpty(t, stats.GoVersion)
+
+	rec = serve(t, s, "/debug/goroutines")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "goroutine")
+}
+
+type reloader struct {
+	calls int
+	err   error
+}
+
+func (r *reloader) Reload() error {
+	r.calls++
+	return r.err
+}
+
+func TestServerReload(t *testing.T) {
+	s := diagnostics.NewServer(
+		diagnostics.DefaultConfig(), noop.NewLogger[any](),
+	)
+	reload := func() *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/admin/reload", nil)
+		s.Handler().ServeHTTP(rec, req)
+		return rec
+	}
+	require.Equal(t, http.StatusNotImplemented, reload().Code)
+
+	r := &reloader{}
+	s.SetReloader(r)
+	require.Equal(t, http.StatusNoContent, reload().Code)
+
+	r.err = errors.New("bad config")
+	rec := reload()
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	require.Contains(t, rec.Body.String(), "bad config")
+	require.Equal(t, 2, r.calls)
+
+	// reloading requires a POST request
+	require.Equal(
+		t, http.StatusMethodNotAllowed, serve(t, s, "/admin/reload").Code,
+	)
+}
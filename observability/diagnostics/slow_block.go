@@ -0,0 +1,323 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package diagnostics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	runtimepprof "runtime/pprof"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// reportPrefix is the prefix of the names of the report files.
+	reportPrefix = "slow-block-"
+	// maxPhases bounds the number of phases recorded per block.
+	maxPhases = 256
+	// maxGoroutinesSize bounds the size of the goroutine profile snippet.
+	maxGoroutinesSize = 64 << 10
+	// reportDirPerm is the permission of the reports directory.
+	reportDirPerm = 0o750
+	// reportFilePerm is the permission of the report files.
+	reportFilePerm = 0o600
+)
+
+// watchedRequests are the names of the root spans of the processing of a
+// block, one per ABCI request.
+//
+//nolint:gochecknoglobals // set of constants.
+var watchedRequests = map[string]struct{}{
+	"PrepareProposal": {},
+	"ProcessProposal": {},
+	"FinalizeBlock":   {},
+}
+
+// SlowBlockReport is the report of the processing of a block which took
+// longer than the threshold.
+type SlowBlockReport struct {
+	// Request is the ABCI request processing the block.
+	Request string `json:"request"`
+	// Slot is the slot of the block.
+	Slot int64 `json:"slot"`
+	// StartTime is the time the processing of the block started.
+	StartTime time.Time `json:"start_time"`
+	// Duration is the time the processing of the block took.
+	Duration string `json:"duration"`
+	// Threshold is the threshold the duration exceeded.
+	Threshold string `json:"threshold"`
+	// Error is the error the processing of the block failed with, if any.
+	Error string `json:"error,omitempty"`
+	// Phases are the timings of the phases of the processing, including the
+	// calls to the execution client, in the order they ended.
+	Phases []PhaseTiming `json:"phases"`
+	// Goroutines is the goroutine profile captured once the processing
+	// exceeded the threshold, truncated.
+	Goroutines string `json:"goroutines,omitempty"`
+}
+
+// PhaseTiming is the timing of a phase of the processing of a block.
+type PhaseTiming struct {
+	// Name is the name of the phase, e.g. "engine.NewPayload".
+	Name string `json:"name"`
+	// Offset is the time elapsed since the start of the processing when the
+	// phase started.
+	Offset string `json:"offset"`
+	// Duration is the time the phase took.
+	Duration string `json:"duration"`
+	// Error is the error the phase failed with, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// blockWatch is the processing of a block being watched.
+type blockWatch struct {
+	start      time.Time
+	timer      *time.Timer
+	phases     []PhaseTiming
+	goroutines []byte
+}
+
+// SlowBlockReporter watches the processing of the blocks through their
+// traces and, when a block takes longer than the threshold to process,
+// writes a report of it to the reports directory. While a block is still
+// processing past the threshold, the goroutine profile is captured for the
+// report to show what the node was busy with.
+//
+// It is a span processor of the tracer provider, so that the phases it
+// reports are the spans of the block pipeline.
+type SlowBlockReporter struct {
+	threshold  time.Duration
+	maxReports int
+	dir        string
+	logger     log.Logger
+
+	mu      sync.Mutex
+	watches map[trace.TraceID]*blockWatch
+}
+
+// NewSlowBlockReporter creates a new reporter of the slow blocks, writing
+// its reports to the given directory.
+func NewSlowBlockReporter(
+	config Config, dir string, logger log.Logger,
+) *SlowBlockReporter {
+	return &SlowBlockReporter{
+		threshold:  config.SlowBlockThreshold,
+		maxReports: config.MaxSlowBlockReports,
+		dir:        dir,
+		logger:     logger,
+		watches:    make(map[trace.TraceID]*blockWatch),
+	}
+}
+
+// Enabled returns whether the slow blocks are reported.
+func (r *SlowBlockReporter) Enabled() bool {
+	return r.threshold > 0
+}
+
+// OnStart starts watching the processing of a block when the root span of
+// an ABCI request starts.
+func (r *SlowBlockReporter) OnStart(
+	_ context.Context, s sdktrace.ReadWriteSpan,
+) {
+	if s.Parent().IsValid() {
+		return
+	}
+	if _, ok := watchedRequests[s.Name()]; !ok {
+		return
+	}
+
+	traceID := s.SpanContext().TraceID()
+	watch := &blockWatch{start: s.StartTime()}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	watch.timer = time.AfterFunc(r.threshold, func() {
+		goroutines := captureGoroutines()
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		watch.goroutines = goroutines
+	})
+	r.watches[traceID] = watch
+}
+
+// OnEnd records the timing of the phases of the watched blocks, and reports
+// the blocks whose processing exceeded the threshold once it ends.
+func (r *SlowBlockReporter) OnEnd(s sdktrace.ReadOnlySpan) {
+	traceID := s.SpanContext().TraceID()
+	r.mu.Lock()
+	watch, ok := r.watches[traceID]
+	if !ok {
+		r.mu.Unlock()
+		return
+	}
+
+	if s.Parent().IsValid() {
+		if len(watch.phases) < maxPhases {
+			watch.phases = append(watch.phases, phaseTiming(s, watch))
+		}
+		r.mu.Unlock()
+		return
+	}
+
+	watch.timer.Stop()
+	delete(r.watches, traceID)
+	r.mu.Unlock()
+
+	duration := s.EndTime().Sub(s.StartTime())
+	if duration < r.threshold {
+		return
+	}
+	report := &SlowBlockReport{
+		Request:    s.Name(),
+		Slot:       slotOf(s.Attributes()),
+		StartTime:  s.StartTime(),
+		Duration:   duration.String(),
+		Threshold:  r.threshold.String(),
+		Error:      errorOf(s),
+		Phases:     watch.phases,
+		Goroutines: string(watch.goroutines),
+	}
+	r.logger.Warn(
+		"Slow block processing",
+		"request", report.Request,
+		log.SlotKey, report.Slot,
+		"duration", report.Duration,
+	)
+	if err := r.write(report); err != nil {
+		r.logger.Error("Failed to write slow block report", "err", err)
+	}
+}
+
+// Shutdown stops watching the blocks being processed.
+func (r *SlowBlockReporter) Shutdown(context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for traceID, watch := range r.watches {
+		watch.timer.Stop()
+		delete(r.watches, traceID)
+	}
+	return nil
+}
+
+// ForceFlush is a no-op, the reports being written as the blocks end.
+func (r *SlowBlockReporter) ForceFlush(context.Context) error {
+	return nil
+}
+
+// write writes the report to the reports directory, removing the oldest
+// reports beyond the maximum kept.
+func (r *SlowBlockReporter) write(report *SlowBlockReport) error {
+	if err := os.MkdirAll(r.dir, reportDirPerm); err != nil {
+		return err
+	}
+	bz, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	// The start time leads the name, for the names to sort chronologically.
+	name := fmt.Sprintf(
+		"%s%s-%d-%s.json",
+		reportPrefix,
+		report.StartTime.UTC().Format("20060102T150405.000Z"),
+		report.Slot,
+		strings.ToLower(report.Request),
+	)
+	path := filepath.Join(r.dir, name)
+	if err = os.WriteFile(path, bz, reportFilePerm); err != nil {
+		return err
+	}
+	r.logger.Info("Wrote slow block report", "path", path)
+	return r.prune()
+}
+
+// prune removes the oldest reports beyond the maximum kept.
+func (r *SlowBlockReporter) prune() error {
+	if r.maxReports <= 0 {
+		return nil
+	}
+	reports, err := filepath.Glob(filepath.Join(r.dir, reportPrefix+"*.json"))
+	if err != nil {
+		return err
+	}
+	if len(reports) <= r.maxReports {
+		return nil
+	}
+	sort.Strings(reports)
+	for _, path := range reports[:len(reports)-r.maxReports] {
+		if err = os.Remove(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// phaseTiming returns the timing of the phase of the given span.
+func phaseTiming(s sdktrace.ReadOnlySpan, watch *blockWatch) PhaseTiming {
+	return PhaseTiming{
+		Name:     s.Name(),
+		Offset:   s.StartTime().Sub(watch.start).String(),
+		Duration: s.EndTime().Sub(s.StartTime()).String(),
+		Error:    errorOf(s),
+	}
+}
+
+// slotOf returns the slot among the given span attributes, if any.
+func slotOf(attrs []attribute.KeyValue) int64 {
+	for _, attr := range attrs {
+		if attr.Key == log.SlotKey {
+			return attr.Value.AsInt64()
+		}
+	}
+	return 0
+}
+
+// errorOf returns the error the span failed with, if any.
+func errorOf(s sdktrace.ReadOnlySpan) string {
+	if s.Status().Code != codes.Error {
+		return ""
+	}
+	return s.Status().Description
+}
+
+// captureGoroutines returns the goroutine profile, truncated.
+func captureGoroutines() []byte {
+	var buf bytes.Buffer
+	// Debug level 1 aggregates the goroutines sharing the same stack.
+	if err := runtimepprof.Lookup("goroutine").WriteTo(&buf, 1); err != nil {
+		return nil
+	}
+	bz := buf.Bytes()
+	if len(bz) > maxGoroutinesSize {
+		bz = bz[:maxGoroutinesSize]
+	}
+	return bz
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package diagnostics_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/observability/diagnostics"
+	"github.com/berachain/beacon-kit/observability/tracing"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func newSlowBlockReporter(
+	t *testing.T, threshold time.Duration, maxReports int,
+) (*diagnostics.SlowBlockReporter, string) {
+	t.Helper()
+	dir := t.TempDir()
+	cfg := diagnostics.DefaultConfig()
+	cfg.SlowBlockThreshold = threshold
+	cfg.MaxSlowBlockReports = maxReports
+	reporter := diagnostics.NewSlowBlockReporter(
+		cfg, dir, noop.NewLogger[any](),
+	)
+
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(reporter))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prev)
+		require.NoError(t, tp.Shutdown(context.Background()))
+	})
+	return reporter, dir
+}
+
+// processBlock traces the processing of a block taking the given time.
+func processBlock(slot math.Slot, duration time.Duration) {
+	ctx, root := tracing.Start(
+		context.Background(), "FinalizeBlock", tracing.Slot(slot),
+	)
+	_, call := tracing.Start(ctx, "engine.NewPayload")
+	time.Sleep(duration)
+	tracing.RecordError(call, errors.New("timeout"))
+	call.End()
+	root.End()
+}
+
+func reports(t *testing.T, dir string) []string {
+	t.Helper()
+	paths, err := filepath.Glob(filepath.Join(dir, "slow-block-*.json"))
+	require.NoError(t, err)
+	return paths
+}
+
+func TestSlowBlockReport(t *testing.T) {
+	threshold := 20 * time.Millisecond
+	reporter, dir := newSlowBlockReporter(t, threshold, 10)
+	require.True(t, reporter.Enabled())
+
+	// Blocks processed in time are not reported.
+	processBlock(1, 0)
+	require.Empty(t, reports(t, dir))
+
+	processBlock(2, 2*threshold)
+	paths := reports(t, dir)
+	require.Len(t, paths, 1)
+
+	bz, err := os.ReadFile(paths[0])
+	require.NoError(t, err)
+	var report diagnostics.SlowBlockReport
+	require.NoError(t, json.Unmarshal(bz, &report))
+	require.Equal(t, "FinalizeBlock", report.Request)
+	require.Equal(t, int64(2), report.Slot)
+	require.Len(t, report.Phases, 1)
+	require.Equal(t, "engine.NewPayload", report.Phases[0].Name)
+	require.Equal(t, "timeout", report.Phases[0].Error)
+	// The goroutines were captured while the block was processing.
+	require.Contains(t, report.Goroutines, "goroutine profile")
+}
+
+func TestSlowBlockReportsPruned(t *testing.T) {
+	threshold := 5 * time.Millisecond
+	_, dir := newSlowBlockReporter(t, threshold, 2)
+
+	for slot := range math.Slot(3) {
+		processBlock(slot, 2*threshold)
+	}
+	paths := reports(t, dir)
+	require.Len(t, paths, 2)
+	// The oldest report was removed.
+	require.Contains(t, filepath.Base(paths[0]), "-1-finalizeblock")
+	require.Contains(t, filepath.Base(paths[1]), "-2-finalizeblock")
+}
+
+func TestSlowBlockReporterDisabled(t *testing.T) {
+	reporter := diagnostics.NewSlowBlockReporter(
+		diagnostics.DefaultConfig(), t.TempDir(), noop.NewLogger[any](),
+	)
+	require.False(t, reporter.Enabled())
+}
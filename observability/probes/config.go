@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package probes
+
+const (
+	defaultAddress        = "0.0.0.0:3501"
+	defaultMaxSlotsBehind = 10
+)
+
+// Config is the configuration for the probes server.
+type Config struct {
+	// Enabled is the flag to enable the probes server.
+	Enabled bool `mapstructure:"enabled"`
+	// Address is the address to bind the probes server to.
+	Address string `mapstructure:"address"`
+	// MaxSlotsBehind is the number of slots the node may lag behind the
+	// head of its peers while still being ready.
+	MaxSlotsBehind uint64 `mapstructure:"max-slots-behind"`
+}
+
+// DefaultConfig returns the default configuration for the probes server.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:        false,
+		Address:        defaultAddress,
+		MaxSlotsBehind: defaultMaxSlotsBehind,
+	}
+}
@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package probes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+)
+
+const (
+	// readHeaderTimeout bounds the time to read request headers.
+	readHeaderTimeout = 10 * time.Second
+	// shutdownTimeout bounds the time waited for requests to complete on
+	// Stop.
+	shutdownTimeout = 5 * time.Second
+	// checkTimeout bounds the time each readiness check may take.
+	checkTimeout = 2 * time.Second
+)
+
+// Check returns nil if the node passes the check.
+type Check func(ctx context.Context) error
+
+// Server is the probes server service. It serves the liveness (/healthz)
+// and readiness (/readyz) endpoints polled by orchestrators and load
+// balancers. The node is live as long as the server responds, and ready
+// once all its readiness checks pass.
+type Server struct {
+	config Config
+	logger log.Logger
+
+	mu     sync.RWMutex
+	names  []string
+	checks map[string]Check
+	srv    *http.Server
+}
+
+// NewServer creates a new probes server.
+func NewServer(config Config, logger log.Logger) *Server {
+	return &Server{
+		config: config,
+		logger: logger,
+		checks: make(map[string]Check),
+	}
+}
+
+// AddReadinessCheck registers a check the node must pass to be ready,
+// replacing the check registered under the same name, if any.
+func (s *Server) AddReadinessCheck(name string, check Check) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.checks[name]; !ok {
+		s.names = append(s.names, name)
+	}
+	s.checks[name] = check
+}
+
+// Name returns the name of the probes server service.
+func (s *Server) Name() string {
+	return "probes-server"
+}
+
+// Start starts the probes server at the configured address.
+func (s *Server) Start(context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+
+	var lc net.ListenConfig
+	ln, err := lc.Listen(context.Background(), "tcp", s.config.Address)
+	if err != nil {
+		return err
+	}
+
+	s.srv = &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		serveErr := s.srv.Serve(ln)
+		if !errors.Is(serveErr, http.ErrServerClosed) {
+			s.logger.Error("Probes server failed", "err", serveErr)
+		}
+	}()
+	s.logger.Info("Probes server started", "address", ln.Addr())
+	return nil
+}
+
+// Stop gracefully shuts the probes server down.
+func (s *Server) Stop() error {
+	if s.srv == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return s.srv.Shutdown(ctx)
+}
+
+// Handler returns the HTTP handler serving the probes routes.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", s.handleHealthz)
+	mux.HandleFunc("GET /readyz", s.handleReadyz)
+	return mux
+}
+
+// handleHealthz reports the node as live.
+func (s *Server) handleHealthz(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if _, err := w.Write([]byte("ok\n")); err != nil {
+		s.logger.Error("Failed to write probe response", "err", err)
+	}
+}
+
+// CheckResult is the result of a readiness check.
+type CheckResult struct {
+	Ready bool   `json:"ready"`
+	Error string `json:"error,omitempty"`
+}
+
+// handleReadyz runs the readiness checks and writes their results. It
+// responds with 503 if any of them fails.
+func (s *Server) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	names := slices.Clone(s.names)
+	checks := maps.Clone(s.checks)
+	s.mu.RUnlock()
+
+	results := make(map[string]CheckResult, len(names))
+	status := http.StatusOK
+	for _, name := range names {
+		ctx, cancel := context.WithTimeout(r.Context(), checkTimeout)
+		err := checks[name](ctx)
+		cancel()
+		results[name] = CheckResult{Ready: err == nil}
+		if err != nil {
+			results[name] = CheckResult{Error: err.Error()}
+			status = http.StatusServiceUnavailable
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(results); err != nil {
+		s.logger.Error("Failed to write probe response", "err", err)
+	}
+}
+
+// WritableDir returns a check creating then removing a file in dir, which
+// is created if missing.
+func WritableDir(dir string) Check {
+	return func(context.Context) error {
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			return err
+		}
+		f, err := os.CreateTemp(dir, ".probe-*")
+		if err != nil {
+			return err
+		}
+		return errors.Join(f.Close(), os.Remove(f.Name()))
+	}
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package probes_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/observability/probes"
+	"github.com/stretchr/testify/require"
+)
+
+func serve(
+	t *testing.T, s *probes.Server, path string,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	s.Handler().ServeHTTP(rec, req)
+	return rec
+}
+
+func TestServerHealthz(t *testing.T) {
+	s := probes.NewServer(probes.DefaultConfig(), noop.NewLogger[any]())
+	s.AddReadinessCheck("down", func(context.Context) error {
+		return errors.New("down")
+	})
+
+	// liveness does not depend on readiness
+	rec := serve(t, s, "/healthz")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "ok\n", rec.Body.String())
+}
+
+func TestServerReadyz(t *testing.T) {
+	s := probes.NewServer(probes.DefaultConfig(), noop.NewLogger[any]())
+	rec := serve(t, s, "/readyz")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var syncErr error
+	s.AddReadinessCheck("el", func(context.Context) error { return nil })
+	s.AddReadinessCheck("sync", func(context.Context) error {
+		return syncErr
+	})
+
+	rec = serve(t, s, "/readyz")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var results map[string]probes.CheckResult
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &results))
+	require.Equal(t, map[string]probes.CheckResult{
+		"el":   {Ready: true},
+		"sync": {Ready: true},
+	}, results)
+
+	syncErr = errors.New("20 slots behind head")
+	rec = serve(t, s, "/readyz")
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &results))
+	require.Equal(t, probes.CheckResult{Error: syncErr.Error()}, results["sync"])
+	require.True(t, results["el"].Ready)
+}
+
+func TestWritableDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "blobs")
+	check := probes.WritableDir(dir)
+	require.NoError(t, check(context.Background()))
+
+	// the probe file is removed
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	// dir cannot be created below a file
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+	require.Error(t, probes.WritableDir(file+"/blobs")(context.Background()))
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package telemetry
+
+const (
+	defaultExporterAddress    = "0.0.0.0:9464"
+	defaultMaxSeriesPerMetric = 1000
+)
+
+// ExporterConfig is the configuration for the Prometheus exporter.
+type ExporterConfig struct {
+	// Enabled is the flag to enable the Prometheus exporter.
+	Enabled bool `mapstructure:"enabled"`
+	// Address is the address to serve the metrics on, at /metrics.
+	Address string `mapstructure:"address"`
+}
+
+// DefaultExporterConfig returns the default configuration for the
+// Prometheus exporter.
+func DefaultExporterConfig() ExporterConfig {
+	return ExporterConfig{
+		Enabled: false,
+		Address: defaultExporterAddress,
+	}
+}
+
+// MetricsConfig is the configuration for the guards of the metrics reported
+// to the telemetry sink.
+type MetricsConfig struct {
+	// AllowedLabels are the label names allowed on top of the default ones,
+	// whose values are bounded. Metrics are reported without the labels
+	// which are not allowed, e.g. validator indexes or slots, as each of
+	// their values would create a series.
+	AllowedLabels []string `mapstructure:"allowed-labels"`
+	// MaxSeriesPerMetric is the number of series, i.e. of combinations of
+	// label values, a metric may have. The values of the series past it are
+	// dropped.
+	MaxSeriesPerMetric int `mapstructure:"max-series-per-metric"`
+}
+
+// DefaultMetricsConfig returns the default configuration for the guards of
+// the metrics.
+func DefaultMetricsConfig() MetricsConfig {
+	return MetricsConfig{
+		AllowedLabels:      []string{},
+		MaxSeriesPerMetric: defaultMaxSeriesPerMetric,
+	}
+}
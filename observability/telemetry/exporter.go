@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	// readHeaderTimeout bounds the time to read request headers.
+	readHeaderTimeout = 10 * time.Second
+	// shutdownTimeout bounds the time waited for scrapes to complete on Stop.
+	shutdownTimeout = 5 * time.Second
+)
+
+// Exporter is the service serving the metrics of a PrometheusSink to
+// Prometheus, at /metrics.
+type Exporter struct {
+	config ExporterConfig
+	sink   *PrometheusSink
+	logger log.Logger
+	srv    *http.Server
+}
+
+// NewExporter creates a new Exporter.
+func NewExporter(
+	config ExporterConfig, sink *PrometheusSink, logger log.Logger,
+) *Exporter {
+	return &Exporter{
+		config: config,
+		sink:   sink,
+		logger: logger,
+	}
+}
+
+// Name returns the name of the exporter service.
+func (e *Exporter) Name() string {
+	return "prometheus-exporter"
+}
+
+// Start starts serving the metrics at the configured address.
+func (e *Exporter) Start(context.Context) error {
+	if !e.config.Enabled {
+		return nil
+	}
+
+	var lc net.ListenConfig
+	ln, err := lc.Listen(context.Background(), "tcp", e.config.Address)
+	if err != nil {
+		return err
+	}
+
+	e.srv = &http.Server{
+		Handler:           e.Handler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		serveErr := e.srv.Serve(ln)
+		if !errors.Is(serveErr, http.ErrServerClosed) {
+			e.logger.Error("Prometheus exporter failed", "err", serveErr)
+		}
+	}()
+	e.logger.Info("Prometheus exporter started", "address", ln.Addr())
+	return nil
+}
+
+// Stop gracefully shuts the exporter down.
+func (e *Exporter) Stop() error {
+	if e.srv == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return e.srv.Shutdown(ctx)
+}
+
+// Handler returns the HTTP handler serving the metrics.
+func (e *Exporter) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(
+		"GET /metrics",
+		promhttp.HandlerFor(e.sink.Gatherer(), promhttp.HandlerOpts{}),
+	)
+	return mux
+}
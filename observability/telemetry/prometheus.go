@@ -0,0 +1,301 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package telemetry
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// durationSuffix is appended to the names of the histograms recorded by
+// MeasureSince, as per the Prometheus naming conventions.
+const durationSuffix = "_seconds"
+
+// The reasons a metric value is dropped for.
+const (
+	dropInvalidKey    = "invalid_key"
+	dropLabelMismatch = "label_mismatch"
+	dropMaxSeries     = "max_series"
+)
+
+// seriesSeparator separates the label values of a series in its identifier,
+// it is not valid UTF-8 hence never part of a value.
+const seriesSeparator = "\xff"
+
+//nolint:gochecknoglobals // read-only.
+var (
+	// nameReplacer turns metric keys into Prometheus metric names.
+	nameReplacer = strings.NewReplacer(".", "_", "-", "_")
+	// valueBuckets are the buckets of the histograms recorded by
+	// ObserveHistogram, which mostly record counts and sizes.
+	//
+	//nolint:mnd // 1 to 32768.
+	valueBuckets = prometheus.ExponentialBuckets(1, 2, 16)
+	// keyPattern is the naming convention of the metric keys, i.e.
+	// beacon_kit.<module>[.<component>].<name> in snake case.
+	keyPattern = regexp.MustCompile(`^beacon_kit(\.[a-z][a-z0-9_]*)+$`)
+	// defaultAllowedLabels are the label names allowed by default, whose
+	// values are bounded.
+	defaultAllowedLabels = []string{
+		"collection",
+		"eth_name",
+		"eth_version",
+		"has_payload_attributes",
+		"is_optimistic",
+		"kzg_implementation",
+		"method",
+		"num_sidecars",
+		"op",
+		"route",
+		"status",
+		"system",
+		"version",
+	}
+)
+
+// PrometheusSink is a sink recording the metrics in a Prometheus registry,
+// along with the metrics of the Go runtime and of the process. The sink is
+// shared by every service, it guards the registry against the metrics which
+// would make it grow unbounded, as reporting metrics must never fail the
+// node:
+//   - metrics whose key does not follow the naming convention, i.e.
+//     beacon_kit.<module>[.<component>].<name> in snake case, are dropped.
+//   - labels which are not allowed, e.g. validator indexes, slots, hashes or
+//     errors, are stripped, the metric being reported over all their values.
+//   - the values of the series of a metric past the maximum are dropped.
+//
+// The metrics are created on first use, their label names being the keys of
+// the allowed labels they are first reported with. A metric reported with
+// other label names, or with the key of a metric of another type, is dropped.
+// The dropped values and stripped labels are counted by the metrics of the
+// sink itself.
+type PrometheusSink struct {
+	registry *prometheus.Registry
+	// allowedLabels are the label names metrics may be reported with.
+	allowedLabels map[string]struct{}
+	// maxSeries is the number of series a metric may have.
+	maxSeries int
+	// dropped counts the values dropped, by reason.
+	dropped *prometheus.CounterVec
+	// stripped counts the labels stripped, by label name.
+	stripped *prometheus.CounterVec
+
+	mu         sync.Mutex
+	counters   map[string]metric[*prometheus.CounterVec]
+	gauges     map[string]metric[*prometheus.GaugeVec]
+	histograms map[string]metric[*prometheus.HistogramVec]
+}
+
+// metric is a metric along with the names of its labels and its series.
+type metric[VecT prometheus.Collector] struct {
+	vec        VecT
+	labelNames []string
+	series     map[string]struct{}
+}
+
+// NewPrometheusSink creates a new PrometheusSink with the given guards.
+func NewPrometheusSink(cfg MetricsConfig) *PrometheusSink {
+	allowed := make(map[string]struct{})
+	for _, name := range slices.Concat(defaultAllowedLabels, cfg.AllowedLabels) {
+		allowed[name] = struct{}{}
+	}
+	s := &PrometheusSink{
+		registry:      prometheus.NewRegistry(),
+		allowedLabels: allowed,
+		maxSeries:     cfg.MaxSeriesPerMetric,
+		dropped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "beacon_kit_telemetry_dropped_values",
+			Help: "Metric values dropped by the guards of the sink.",
+		}, []string{"reason"}),
+		stripped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "beacon_kit_telemetry_stripped_labels",
+			Help: "Labels stripped from metrics as not allowed.",
+		}, []string{"label"}),
+		counters:   make(map[string]metric[*prometheus.CounterVec]),
+		gauges:     make(map[string]metric[*prometheus.GaugeVec]),
+		histograms: make(map[string]metric[*prometheus.HistogramVec]),
+	}
+	s.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		s.dropped,
+		s.stripped,
+	)
+	return s
+}
+
+// Gatherer returns the gatherer of the metrics of the sink.
+func (s *PrometheusSink) Gatherer() prometheus.Gatherer {
+	return s.registry
+}
+
+// IncrementCounter increments the counter identified by the provided key.
+func (s *PrometheusSink) IncrementCounter(key string, args ...string) {
+	vec, values, ok := metricVec(
+		s, s.counters, nameReplacer.Replace(key), key, args,
+		func(opts prometheus.Opts, names []string) *prometheus.CounterVec {
+			return prometheus.NewCounterVec(prometheus.CounterOpts(opts), names)
+		},
+	)
+	if !ok {
+		return
+	}
+	if c, err := vec.GetMetricWithLabelValues(values...); err == nil {
+		c.Inc()
+	}
+}
+
+// SetGauge sets the gauge identified by the provided key to the given value.
+func (s *PrometheusSink) SetGauge(key string, value int64, args ...string) {
+	vec, values, ok := metricVec(
+		s, s.gauges, nameReplacer.Replace(key), key, args,
+		func(opts prometheus.Opts, names []string) *prometheus.GaugeVec {
+			return prometheus.NewGaugeVec(prometheus.GaugeOpts(opts), names)
+		},
+	)
+	if !ok {
+		return
+	}
+	if g, err := vec.GetMetricWithLabelValues(values...); err == nil {
+		g.Set(float64(value))
+	}
+}
+
+// ObserveHistogram records the given value in the histogram identified by the
+// provided key.
+func (s *PrometheusSink) ObserveHistogram(
+	key string, value float64, args ...string,
+) {
+	s.observe(key, nameReplacer.Replace(key), valueBuckets, value, args)
+}
+
+// MeasureSince records the time elapsed since the provided start time, in
+// seconds, in the histogram identified by the provided key.
+func (s *PrometheusSink) MeasureSince(
+	key string, start time.Time, args ...string,
+) {
+	s.observe(
+		key,
+		nameReplacer.Replace(key)+durationSuffix,
+		prometheus.DefBuckets,
+		time.Since(start).Seconds(),
+		args,
+	)
+}
+
+// observe records the given value in the histogram of the given name.
+func (s *PrometheusSink) observe(
+	key, name string, buckets []float64, value float64, args []string,
+) {
+	vec, values, ok := metricVec(
+		s, s.histograms, name, key, args,
+		func(opts prometheus.Opts, names []string) *prometheus.HistogramVec {
+			return prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Name:    opts.Name,
+					Help:    opts.Help,
+					Buckets: buckets,
+				},
+				names,
+			)
+		},
+	)
+	if !ok {
+		return
+	}
+	if h, err := vec.GetMetricWithLabelValues(values...); err == nil {
+		h.Observe(value)
+	}
+}
+
+// metricVec returns the metric of the given name, creating and registering it
+// if it does not exist yet, along with the values of its allowed labels among
+// the given key value pairs. It returns false if the value must be dropped,
+// i.e. if the key does not follow the naming convention, if the labels do not
+// match the ones of the metric or if the series would exceed the maximum. A
+// metric failing to be registered is still returned, its values are then just
+// not gathered.
+func metricVec[VecT prometheus.Collector](
+	s *PrometheusSink,
+	metrics map[string]metric[VecT],
+	name, key string,
+	args []string,
+	newVec func(prometheus.Opts, []string) VecT,
+) (VecT, []string, bool) {
+	var zero VecT
+	if !keyPattern.MatchString(key) {
+		s.dropped.WithLabelValues(dropInvalidKey).Inc()
+		return zero, nil, false
+	}
+	names, values := s.allowed(args)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, ok := metrics[name]
+	if !ok {
+		m = metric[VecT]{
+			vec:        newVec(prometheus.Opts{Name: name, Help: key}, names),
+			labelNames: names,
+			series:     make(map[string]struct{}),
+		}
+		//nolint:errcheck // see above.
+		_ = s.registry.Register(m.vec)
+		metrics[name] = m
+	}
+	if !slices.Equal(m.labelNames, names) {
+		s.dropped.WithLabelValues(dropLabelMismatch).Inc()
+		return zero, nil, false
+	}
+	id := strings.Join(values, seriesSeparator)
+	if _, ok = m.series[id]; !ok {
+		if len(m.series) >= s.maxSeries {
+			s.dropped.WithLabelValues(dropMaxSeries).Inc()
+			return zero, nil, false
+		}
+		m.series[id] = struct{}{}
+	}
+	return m.vec, values, true
+}
+
+// allowed splits the given key value pairs into the names and values of the
+// allowed labels, stripping the other ones. A trailing key with no value is
+// ignored.
+func (s *PrometheusSink) allowed(args []string) ([]string, []string) {
+	//nolint:mnd // pairs.
+	n := len(args) / 2
+	names := make([]string, 0, n)
+	values := make([]string, 0, n)
+	for i := range n {
+		if _, ok := s.allowedLabels[args[2*i]]; !ok {
+			s.stripped.WithLabelValues(args[2*i]).Inc()
+			continue
+		}
+		names = append(names, args[2*i])
+		values = append(values, args[2*i+1])
+	}
+	return names, values
+}
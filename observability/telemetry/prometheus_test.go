@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package telemetry_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/observability/telemetry"
+	"github.com/stretchr/testify/require"
+)
+
+func scrape(t *testing.T, sink *telemetry.PrometheusSink) string {
+	t.Helper()
+	e := telemetry.NewExporter(
+		telemetry.DefaultExporterConfig(), sink, noop.NewLogger[any](),
+	)
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	e.Handler().ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	return rec.Body.String()
+}
+
+func TestPrometheusSink(t *testing.T) {
+	sink := telemetry.NewPrometheusSink(telemetry.DefaultMetricsConfig())
+	sink.IncrementCounter("beacon_kit.test.requests", "status", "ok")
+	sink.IncrementCounter("beacon_kit.test.requests", "status", "ok")
+	sink.SetGauge("beacon_kit.test.head", 42)
+	sink.ObserveHistogram("beacon_kit.test.size", 3)
+	sink.MeasureSince("beacon_kit.test.duration", time.Now(), "op", "get")
+
+	body := scrape(t, sink)
+	require.Contains(t, body, `beacon_kit_test_requests{status="ok"} 2`)
+	require.Contains(t, body, "beacon_kit_test_head 42")
+	require.Contains(t, body, "beacon_kit_test_size_count 1")
+	require.Contains(t, body, `beacon_kit_test_duration_seconds_count{op="get"} 1`)
+	require.Contains(t, body, "go_goroutines")
+}
+
+func TestPrometheusSinkDropsMismatchingMetrics(t *testing.T) {
+	sink := telemetry.NewPrometheusSink(telemetry.DefaultMetricsConfig())
+	sink.IncrementCounter("beacon_kit.test.requests", "status", "ok")
+	// other label names are dropped
+	sink.IncrementCounter("beacon_kit.test.requests", "route", "/")
+	sink.IncrementCounter("beacon_kit.test.requests")
+	// the name of a metric of another type is dropped
+	sink.SetGauge("beacon_kit.test.requests", 1)
+
+	body := scrape(t, sink)
+	require.Contains(t, body, `beacon_kit_test_requests{status="ok"} 1`)
+	require.NotContains(t, body, `route="/"`)
+	require.Contains(t, body, "# TYPE beacon_kit_test_requests counter")
+}
+
+func TestPrometheusSinkGuards(t *testing.T) {
+	sink := telemetry.NewPrometheusSink(telemetry.MetricsConfig{
+		AllowedLabels:      []string{"peer"},
+		MaxSeriesPerMetric: 2,
+	})
+	// keys not following the naming convention are dropped
+	sink.IncrementCounter("BeaconKit.Test.Requests")
+	sink.IncrementCounter("beacon_kit.test.requests.")
+	// labels not allowed are stripped
+	sink.IncrementCounter(
+		"beacon_kit.test.misses", "validator_index", "1", "status", "ok",
+	)
+	sink.IncrementCounter(
+		"beacon_kit.test.misses", "validator_index", "2", "status", "ok",
+	)
+	// series past the maximum are dropped
+	sink.SetGauge("beacon_kit.test.peers", 1, "peer", "a")
+	sink.SetGauge("beacon_kit.test.peers", 2, "peer", "b")
+	sink.SetGauge("beacon_kit.test.peers", 3, "peer", "c")
+	sink.SetGauge("beacon_kit.test.peers", 4, "peer", "a")
+
+	body := scrape(t, sink)
+	require.NotContains(t, body, "Requests")
+	require.Contains(t, body, `beacon_kit_test_misses{status="ok"} 2`)
+	require.NotContains(t, body, `validator_index="`)
+	require.Contains(t, body, `beacon_kit_test_peers{peer="a"} 4`)
+	require.Contains(t, body, `beacon_kit_test_peers{peer="b"} 2`)
+	require.NotContains(t, body, `peer="c"`)
+	require.Contains(t, body,
+		`beacon_kit_telemetry_dropped_values{reason="invalid_key"} 2`)
+	require.Contains(t, body,
+		`beacon_kit_telemetry_dropped_values{reason="max_series"} 1`)
+	require.Contains(t, body,
+		`beacon_kit_telemetry_stripped_labels{label="validator_index"} 2`)
+}
+
+func TestExporterDisabled(t *testing.T) {
+	e := telemetry.NewExporter(
+		telemetry.DefaultExporterConfig(),
+		telemetry.NewPrometheusSink(telemetry.DefaultMetricsConfig()),
+		noop.NewLogger[any](),
+	)
+	require.NoError(t, e.Start(context.Background()))
+	require.NoError(t, e.Stop())
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package telemetry
+
+import "time"
+
+// Sink is the sink the services report their metrics to. Metrics are
+// identified by a dot separated key, e.g. beacon_kit.state.deposits, and
+// labelled by the given key value pairs of args, e.g. "status", "ok".
+type Sink interface {
+	// IncrementCounter increments the counter identified by the provided
+	// key.
+	IncrementCounter(key string, args ...string)
+	// SetGauge sets the gauge identified by the provided key to the given
+	// value.
+	SetGauge(key string, value int64, args ...string)
+	// ObserveHistogram records the given value in the histogram identified
+	// by the provided key.
+	ObserveHistogram(key string, value float64, args ...string)
+	// MeasureSince records the time elapsed since the provided start time,
+	// in seconds, in the histogram identified by the provided key.
+	MeasureSince(key string, start time.Time, args ...string)
+}
+
+// NoOpSink is a sink discarding every metric.
+type NoOpSink struct{}
+
+// NewNoOpSink creates a new NoOpSink.
+func NewNoOpSink() NoOpSink {
+	return NoOpSink{}
+}
+
+// IncrementCounter is a no-op.
+func (NoOpSink) IncrementCounter(string, ...string) {}
+
+// SetGauge is a no-op.
+func (NoOpSink) SetGauge(string, int64, ...string) {}
+
+// ObserveHistogram is a no-op.
+func (NoOpSink) ObserveHistogram(string, float64, ...string) {}
+
+// MeasureSince is a no-op.
+func (NoOpSink) MeasureSince(string, time.Time, ...string) {}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing
+
+const (
+	defaultEndpoint    = "localhost:4318"
+	defaultSampleRatio = 1.0
+)
+
+// Config is the configuration for the tracing of the block pipeline.
+type Config struct {
+	// Enabled is the flag to enable exporting traces.
+	Enabled bool `mapstructure:"enabled"`
+	// Endpoint is the host and port of the OTLP HTTP collector the traces
+	// are exported to, e.g. a Jaeger or Tempo instance.
+	Endpoint string `mapstructure:"endpoint"`
+	// Insecure disables TLS when exporting the traces.
+	Insecure bool `mapstructure:"insecure"`
+	// SampleRatio is the ratio of the blocks traced, from 0 to 1.
+	SampleRatio float64 `mapstructure:"sample-ratio"`
+}
+
+// DefaultConfig returns the default configuration for the tracing.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:     false,
+		Endpoint:    defaultEndpoint,
+		Insecure:    true,
+		SampleRatio: defaultSampleRatio,
+	}
+}
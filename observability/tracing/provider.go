@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+const (
+	// serviceName is the name the traces are reported under.
+	serviceName = "beacond"
+	// shutdownTimeout bounds the time waited for the pending spans to be
+	// exported on Stop.
+	shutdownTimeout = 5 * time.Second
+)
+
+// Provider is the service exporting the traces to the configured OTLP
+// collector. Once started, it is the global tracer provider spans are
+// started from.
+type Provider struct {
+	config  Config
+	version string
+	logger  log.Logger
+	tp      *sdktrace.TracerProvider
+	// processors are the span processors fed along with the export.
+	processors []sdktrace.SpanProcessor
+}
+
+// NewProvider creates a new Provider, reporting the traces of the given
+// version of the node.
+func NewProvider(config Config, version string, logger log.Logger) *Provider {
+	return &Provider{
+		config:  config,
+		version: version,
+		logger:  logger,
+	}
+}
+
+// Name returns the name of the tracing service.
+func (p *Provider) Name() string {
+	return "tracing"
+}
+
+// AddSpanProcessor adds a processor of the spans of the node, fed whether
+// the traces are exported or not. It must be called before Start.
+func (p *Provider) AddSpanProcessor(processor sdktrace.SpanProcessor) {
+	p.processors = append(p.processors, processor)
+}
+
+// Start installs the tracer provider exporting the traces, if enabled, and
+// feeding the added span processors.
+func (p *Provider) Start(ctx context.Context) error {
+	if !p.config.Enabled && len(p.processors) == 0 {
+		return nil
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewSchemaless(
+			semconv.ServiceName(serviceName),
+			semconv.ServiceVersion(p.version),
+		)),
+	}
+	for _, processor := range p.processors {
+		opts = append(opts, sdktrace.WithSpanProcessor(processor))
+	}
+
+	// Traces are sampled per block, the spans of a block following the
+	// decision taken for its root span. The span processors see all the
+	// blocks, in which case the sample is only applied to the export.
+	sampler := sdktrace.TraceIDRatioBased(p.config.SampleRatio)
+	if len(p.processors) == 0 {
+		opts = append(opts, sdktrace.WithSampler(sdktrace.ParentBased(sampler)))
+	} else {
+		opts = append(opts, sdktrace.WithSampler(sdktrace.AlwaysSample()))
+	}
+
+	if p.config.Enabled {
+		exporter, err := p.newExporter(ctx)
+		if err != nil {
+			return err
+		}
+		var batcher sdktrace.SpanProcessor = sdktrace.NewBatchSpanProcessor(
+			exporter,
+		)
+		if len(p.processors) > 0 {
+			batcher = &sampledProcessor{
+				SpanProcessor: batcher,
+				sampler:       sampler,
+			}
+		}
+		opts = append(opts, sdktrace.WithSpanProcessor(batcher))
+		p.logger.Info(
+			"Exporting traces",
+			"endpoint", p.config.Endpoint,
+			"sample_ratio", p.config.SampleRatio,
+		)
+	}
+
+	p.tp = sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(p.tp)
+	return nil
+}
+
+// newExporter returns the exporter of the traces to the OTLP collector.
+func (p *Provider) newExporter(
+	ctx context.Context,
+) (*otlptrace.Exporter, error) {
+	opts := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(p.config.Endpoint),
+	}
+	if p.config.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	return otlptracehttp.New(ctx, opts...)
+}
+
+// Stop exports the pending spans and shuts the tracer provider down.
+func (p *Provider) Stop() error {
+	if p.tp == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return p.tp.Shutdown(ctx)
+}
+
+// sampledProcessor forwards the spans of the sampled traces only.
+type sampledProcessor struct {
+	sdktrace.SpanProcessor
+	sampler sdktrace.Sampler
+}
+
+// OnEnd forwards the span if its trace is sampled.
+func (p *sampledProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	res := p.sampler.ShouldSample(sdktrace.SamplingParameters{
+		TraceID: s.SpanContext().TraceID(),
+	})
+	if res.Decision == sdktrace.RecordAndSample {
+		p.SpanProcessor.OnEnd(s)
+	}
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package tracing traces the processing of the blocks, from the ABCI
+// requests of CometBFT down to the calls to the execution client, and exports
+// the traces to an OpenTelemetry collector.
+//
+// Spans are started from the global tracer provider, so that every package
+// can trace its work without the provider being threaded through. Until the
+// Provider service is started, or if tracing is disabled, spans are no-ops.
+package tracing
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/primitives/math"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the name of the tracer of the spans of beacon-kit.
+const tracerName = "github.com/berachain/beacon-kit"
+
+// Start starts a span with the given name and attributes, child of the span
+// of ctx if any, and returns the context holding it.
+func Start(
+	ctx context.Context, name string, attrs ...attribute.KeyValue,
+) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(
+		ctx, name, trace.WithAttributes(attrs...),
+	)
+}
+
+// RecordError marks the given span as failed with err, if not nil.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// WithSpanOf returns a copy of ctx carrying the span of from, for the spans
+// started from ctx to be children of it.
+func WithSpanOf(ctx, from context.Context) context.Context {
+	return trace.ContextWithSpan(ctx, trace.SpanFromContext(from))
+}
+
+// Slot returns the attribute of the slot, i.e. the height, of a block.
+func Slot(slot math.Slot) attribute.KeyValue {
+	//#nosec:G701 // not an issue in practice.
+	return attribute.Int64("slot", int64(slot))
+}
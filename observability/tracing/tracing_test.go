@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/observability/tracing"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	ctx, parent := tracing.Start(
+		context.Background(), "parent", tracing.Slot(math.Slot(7)),
+	)
+	// A span started from another context carrying the span of the parent
+	// is a child of it.
+	_, child := tracing.Start(
+		tracing.WithSpanOf(context.Background(), ctx), "child",
+	)
+	tracing.RecordError(child, errors.New("boom"))
+	child.End()
+	tracing.RecordError(parent, nil)
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	childSpan, parentSpan := spans[0], spans[1]
+
+	require.Equal(t, "parent", parentSpan.Name())
+	require.Contains(
+		t, parentSpan.Attributes(), attribute.Int64("slot", 7),
+	)
+	require.Equal(t, codes.Unset, parentSpan.Status().Code)
+
+	require.Equal(t, "child", childSpan.Name())
+	require.Equal(
+		t, parentSpan.SpanContext().SpanID(), childSpan.Parent().SpanID(),
+	)
+	require.Equal(t, codes.Error, childSpan.Status().Code)
+	require.Equal(t, "boom", childSpan.Status().Description)
+}
+
+func TestProviderDisabled(t *testing.T) {
+	p := tracing.NewProvider(tracing.DefaultConfig(), "v0.0.0", nil)
+	require.NoError(t, p.Start(context.Background()))
+	require.NoError(t, p.Stop())
+}
+
+func TestProviderSpanProcessors(t *testing.T) {
+	prev := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	// The span processors are fed even though the traces are not exported.
+	recorder := tracetest.NewSpanRecorder()
+	p := tracing.NewProvider(tracing.DefaultConfig(), "v0.0.0", nil)
+	p.AddSpanProcessor(recorder)
+	require.NoError(t, p.Start(context.Background()))
+
+	_, span := tracing.Start(context.Background(), "span")
+	span.End()
+	require.NoError(t, p.Stop())
+	require.Len(t, recorder.Ended(), 1)
+}
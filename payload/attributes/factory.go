@@ -21,12 +21,16 @@
 package attributes
 
 import (
+	"sync"
+
 	"github.com/berachain/beacon-kit/chain-spec/chain"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/metadata"
 )
 
 // Factory is a factory for creating payload attributes.
@@ -38,6 +42,13 @@ type Factory struct {
 	// suggestedFeeRecipient is the suggested fee recipient sent to
 	// the execution client for the payload build.
 	suggestedFeeRecipient common.ExecutionAddress
+	// mu protects suggestedFeeRecipient, which may be updated at runtime.
+	mu sync.RWMutex
+	// metadata is the validators metadata registry, which may override
+	// suggestedFeeRecipient for the validator run by the node.
+	metadata MetadataStore
+	// pubkey is the public key of the validator run by the node.
+	pubkey crypto.BLSPubkey
 }
 
 // NewAttributesFactory creates a new instance of AttributesFactory.
@@ -45,14 +56,58 @@ func NewAttributesFactory(
 	chainSpec chain.ChainSpec,
 	logger log.Logger,
 	suggestedFeeRecipient common.ExecutionAddress,
+	metadata MetadataStore,
+	pubkey crypto.BLSPubkey,
 ) *Factory {
 	return &Factory{
 		chainSpec:             chainSpec,
 		logger:                logger,
 		suggestedFeeRecipient: suggestedFeeRecipient,
+		metadata:              metadata,
+		pubkey:                pubkey,
 	}
 }
 
+// SuggestedFeeRecipient returns the fee recipient registered for the
+// validator run by the node, falling back to the configured one.
+func (f *Factory) SuggestedFeeRecipient() common.ExecutionAddress {
+	return f.Preferences().FeeRecipient
+}
+
+// Preferences returns the preferences registered for the validator run by
+// the node, e.g. through its proposer configuration file, the fee recipient
+// falling back to the configured one.
+func (f *Factory) Preferences() *metadata.Metadata {
+	f.mu.RLock()
+	defaultFeeRecipient := f.suggestedFeeRecipient
+	f.mu.RUnlock()
+
+	md, err := f.metadata.Get(f.pubkey)
+	if err != nil {
+		f.logger.Error(
+			"Could not get validator metadata, using default fee recipient",
+			"error",
+			err,
+		)
+		return &metadata.Metadata{FeeRecipient: defaultFeeRecipient}
+	}
+	if md.FeeRecipient == (common.ExecutionAddress{}) {
+		md.FeeRecipient = defaultFeeRecipient
+	}
+	return md
+}
+
+// SetSuggestedFeeRecipient sets the fee recipient used when none is
+// registered for the validator run by the node. It is safe to call at
+// runtime.
+func (f *Factory) SetSuggestedFeeRecipient(
+	feeRecipient common.ExecutionAddress,
+) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.suggestedFeeRecipient = feeRecipient
+}
+
 // BuildPayloadAttributes creates a new instance of PayloadAttributes.
 func (f *Factory) BuildPayloadAttributes(
 	st *statedb.StateDB,
@@ -88,7 +143,7 @@ func (f *Factory) BuildPayloadAttributes(
 		f.chainSpec.ActiveForkVersionForEpoch(epoch),
 		timestamp,
 		prevRandao,
-		f.suggestedFeeRecipient,
+		f.SuggestedFeeRecipient(),
 		withdrawals,
 		prevHeadRoot,
 	)
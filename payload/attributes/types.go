@@ -23,8 +23,16 @@ package attributes
 import (
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/storage/metadata"
 )
 
+// MetadataStore is the interface for the validators metadata registry.
+type MetadataStore interface {
+	// Get returns the metadata of the given validator.
+	Get(pubkey crypto.BLSPubkey) (*metadata.Metadata, error)
+}
+
 // PayloadAttributes is the interface for the payload attributes.
 type PayloadAttributes[SelfT any] interface {
 	engineprimitives.PayloadAttributer
@@ -189,17 +189,42 @@ func (pb *PayloadBuilder) RetrievePayload(
 
 	// If the payload was built by a different builder, something is
 	// wrong the EL<>CL setup.
-	if payload.GetFeeRecipient() != pb.cfg.SuggestedFeeRecipient {
+	feeRecipient := pb.attributesFactory.SuggestedFeeRecipient()
+	if payload.GetFeeRecipient() != feeRecipient {
 		pb.logger.Warn(
 			"Payload fee recipient does not match suggested fee recipient - "+
 				"please check both your CL and EL configuration",
 			"payload_fee_recipient", payload.GetFeeRecipient(),
-			"suggested_fee_recipient", pb.cfg.SuggestedFeeRecipient,
+			"suggested_fee_recipient", feeRecipient,
 		)
 	}
+	pb.checkPreferences(payload)
 	return envelope, err
 }
 
+// checkPreferences warns if the given payload does not follow the
+// preferences of the validator run by the node, which are enforced by the
+// execution client.
+func (pb *PayloadBuilder) checkPreferences(payload *ctypes.ExecutionPayload) {
+	prefs := pb.attributesFactory.Preferences()
+	if prefs.BuilderEnabled {
+		pb.logger.Warn(
+			"External builders are not supported, the payload was built " +
+				"by the local builder",
+		)
+	}
+	if payload.IsNil() || prefs.GasLimit == 0 ||
+		payload.GetGasLimit() == prefs.GasLimit {
+		return
+	}
+	pb.logger.Warn(
+		"Payload gas limit does not match the preferred gas limit - "+
+			"please check your EL configuration",
+		"payload_gas_limit", payload.GetGasLimit().Base10(),
+		"preferred_gas_limit", prefs.GasLimit.Base10(),
+	)
+}
+
 // SendForceHeadFCU builds a payload for the given slot and
 // returns the payload ID.
 //
@@ -29,6 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/math"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/metadata"
 )
 
 type PayloadCache[RootT, SlotT any] interface {
@@ -57,6 +58,12 @@ type AttributesFactory interface {
 		timestamp uint64,
 		prevHeadRoot [32]byte,
 	) (*engineprimitives.PayloadAttributes, error)
+	// SuggestedFeeRecipient returns the fee recipient of the payloads
+	// built for the validator run by the node.
+	SuggestedFeeRecipient() common.ExecutionAddress
+	// Preferences returns the preferences of the validator run by the
+	// node, e.g. its gas limit.
+	Preferences() *metadata.Metadata
 }
 
 // PayloadAttributes is the interface for the payload attributes.
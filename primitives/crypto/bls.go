@@ -24,6 +24,7 @@ import (
 	"fmt"
 
 	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/math"
 	cometencoding "github.com/cometbft/cometbft/crypto/encoding"
 )
 
@@ -63,3 +64,86 @@ type BLSSigner interface {
 	// VerifySignature verifies a signature against a message and a public key.
 	VerifySignature(pubKey BLSPubkey, msg []byte, signature BLSSignature) error
 }
+
+// The types of the messages signed, as named by the Web3Signer API.
+const (
+	SigningTypeBlock         = "BLOCK_V2"
+	SigningTypeRandaoReveal  = "RANDAO_REVEAL"
+	SigningTypeVoluntaryExit = "VOLUNTARY_EXIT"
+)
+
+// SigningRequest is a request to sign the signing root of a message, along
+// with the message itself and the fork it is signed under, for the signers
+// which check what they sign, e.g. remote signers enforcing their own
+// slashing protection.
+type SigningRequest struct {
+	// Type is the type of the message, e.g. SigningTypeBlock.
+	Type string
+	// SigningRoot is the signing root of the message.
+	SigningRoot bytes.B32
+	// Slot is the slot of the block, for the requests to sign a block.
+	Slot math.Slot
+	// ForkVersion is the version of the fork the message is signed under.
+	ForkVersion bytes.B4
+	// GenesisValidatorsRoot is the genesis validators root of the chain.
+	GenesisValidatorsRoot bytes.B32
+	// Message is the JSON encodable message, keyed by the name of its type
+	// in the Web3Signer API, e.g. "randao_reveal".
+	Message map[string]any
+}
+
+// BLSRequestSigner is a BLSSigner which signs requests rather than bare
+// signing roots.
+type BLSRequestSigner interface {
+	BLSSigner
+
+	// SignRequest signs the signing root of the given request.
+	SignRequest(req *SigningRequest) (BLSSignature, error)
+}
+
+// SignRequest signs the given request with signer, handing over the whole
+// request to the signers supporting it and only its signing root to the
+// others.
+func SignRequest(signer BLSSigner, req *SigningRequest) (BLSSignature, error) {
+	if rs, ok := signer.(BLSRequestSigner); ok {
+		return rs.SignRequest(req)
+	}
+	return signer.Sign(req.SigningRoot[:])
+}
+
+// SignatureSet is a signature along with the public key and message it is
+// expected to be valid for.
+type SignatureSet struct {
+	// Pubkey is the public key of the signer.
+	Pubkey BLSPubkey
+	// Message is the signed message, usually a signing root.
+	Message []byte
+	// Signature is the signature of the message.
+	Signature BLSSignature
+}
+
+// BLSBatchVerifier is a BLSSigner which verifies many signatures at once,
+// faster than one by one.
+type BLSBatchVerifier interface {
+	BLSSigner
+
+	// VerifySignatures verifies all the signature sets at once. It fails if
+	// any of them is invalid, without telling which.
+	VerifySignatures(sets []SignatureSet) error
+}
+
+// VerifySignatures verifies all the signature sets with signer, at once for
+// the signers supporting it and one by one for the others.
+func VerifySignatures(signer BLSSigner, sets []SignatureSet) error {
+	if bv, ok := signer.(BLSBatchVerifier); ok {
+		return bv.VerifySignatures(sets)
+	}
+	for _, set := range sets {
+		if err := signer.VerifySignature(
+			set.Pubkey, set.Message, set.Signature,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bls
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/primitives/encoding/hex"
+)
+
+// BenchmarkResult is the average duration of the operations of an
+// implementation.
+type BenchmarkResult struct {
+	// Implementation is the name of the implementation.
+	Implementation string
+	// Sign is the average duration of signing a message.
+	Sign time.Duration
+	// Verify is the average duration of verifying a signature.
+	Verify time.Duration
+}
+
+// Benchmark signs and verifies the self-test message the given number of
+// times with the implementation, after self-testing it.
+func Benchmark(impl Implementation, iterations int) (BenchmarkResult, error) {
+	result := BenchmarkResult{Implementation: impl.Name()}
+	if err := SelfTest(impl); err != nil {
+		return result, err
+	}
+	if iterations <= 0 {
+		return result, nil
+	}
+	secret := hex.MustToBytes(selfTestSecret)
+	msg := hex.MustToBytes(selfTestMsg)
+	pubkey, err := impl.SecretToPubkey(secret)
+	if err != nil {
+		return result, err
+	}
+
+	start := time.Now()
+	signature, err := impl.Sign(secret, msg)
+	for i := 1; i < iterations && err == nil; i++ {
+		signature, err = impl.Sign(secret, msg)
+	}
+	if err != nil {
+		return result, err
+	}
+	result.Sign = time.Since(start) / time.Duration(iterations)
+
+	start = time.Now()
+	for range iterations {
+		if !impl.Verify(pubkey, msg, signature) {
+			return result, ErrSelfTestFailed
+		}
+	}
+	result.Verify = time.Since(start) / time.Duration(iterations)
+	return result, nil
+}
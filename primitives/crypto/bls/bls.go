@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bls
+
+import (
+	"sync/atomic"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// ImplementationAuto selects the fastest implementation compiled into the
+// binary, i.e. supranational/blst when built with the bls12381 tag and the
+// pure-Go implementation otherwise.
+const ImplementationAuto = "auto"
+
+// dst is the hash-to-curve domain separation tag. It matches the one used by
+// CometBFT, so that signatures are interchangeable with its validator keys.
+//
+//nolint:gochecknoglobals // constant byte slice.
+var dst = []byte("BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_")
+
+// Implementation is an implementation of the BLS12-381 signature scheme, with
+// public keys in G1 and signatures in G2.
+type Implementation interface {
+	// Name returns the name of the implementation.
+	Name() string
+	// SecretToPubkey returns the compressed public key of a secret key.
+	SecretToPubkey(secret []byte) (crypto.BLSPubkey, error)
+	// Sign signs a message with a secret key.
+	Sign(secret []byte, msg []byte) (crypto.BLSSignature, error)
+	// Verify reports whether the signature of the message is valid for the
+	// compressed public key.
+	Verify(
+		pubkey crypto.BLSPubkey,
+		msg []byte,
+		signature crypto.BLSSignature,
+	) bool
+	// VerifyBatch reports whether all the non-empty signature sets are
+	// valid, verifying them at once.
+	VerifyBatch(sets []crypto.SignatureSet) bool
+}
+
+//nolint:gochecknoglobals // the implementation is selected once at startup.
+var active atomic.Pointer[Implementation]
+
+// Implementations returns the names of the implementations compiled into the
+// binary, fastest first.
+func Implementations() []string {
+	names := make([]string, 0, 2) //nolint:mnd // at most two.
+	if blstEnabled {
+		names = append(names, ImplementationBlst)
+	}
+	return append(names, ImplementationGo)
+}
+
+// New returns the implementation with the given name. An empty name, as read
+// from configurations predating the selection, is the same as "auto".
+func New(name string) (Implementation, error) {
+	switch name {
+	case ImplementationAuto, "":
+		return New(Implementations()[0])
+	case ImplementationGo:
+		return goImplementation{}, nil
+	case ImplementationBlst:
+		if blstEnabled {
+			return blstImplementation{}, nil
+		}
+		return nil, ErrBlstNotEnabled
+	default:
+		return nil, errors.Wrapf(
+			ErrUnsupportedImplementation,
+			"supplied: %s, supported: %s, %s, %s",
+			name, ImplementationAuto, ImplementationBlst, ImplementationGo,
+		)
+	}
+}
+
+// Select self-tests the implementation with the given name and makes it the
+// one used by the package level functions.
+func Select(name string) (Implementation, error) {
+	impl, err := New(name)
+	if err != nil {
+		return nil, err
+	}
+	if err = SelfTest(impl); err != nil {
+		return nil, err
+	}
+	active.Store(&impl)
+	return impl, nil
+}
+
+// Active returns the selected implementation, defaulting to the fastest one
+// compiled into the binary if none was selected.
+func Active() Implementation {
+	if impl := active.Load(); impl != nil {
+		return *impl
+	}
+	impl, err := New(ImplementationAuto)
+	if err != nil {
+		// unreachable, the pure-Go implementation is always available
+		panic(err)
+	}
+	return impl
+}
+
+// SecretToPubkey returns the compressed public key of a secret key, using the
+// selected implementation.
+func SecretToPubkey(secret []byte) (crypto.BLSPubkey, error) {
+	return Active().SecretToPubkey(secret)
+}
+
+// Sign signs a message with a secret key, using the selected implementation.
+func Sign(secret []byte, msg []byte) (crypto.BLSSignature, error) {
+	return Active().Sign(secret, msg)
+}
+
+// Verify reports whether the signature of the message is valid for the
+// compressed public key, using the selected implementation.
+func Verify(
+	pubkey crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) bool {
+	return Active().Verify(pubkey, msg, signature)
+}
+
+// VerifyBatch reports whether all the signature sets are valid, verifying
+// them at once with the selected implementation. An empty batch is valid.
+func VerifyBatch(sets []crypto.SignatureSet) bool {
+	if len(sets) == 0 {
+		return true
+	}
+	return Active().VerifyBatch(sets)
+}
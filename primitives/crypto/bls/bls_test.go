@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bls_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/bls"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImplementations(t *testing.T) {
+	t.Parallel()
+	for _, name := range bls.Implementations() {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			impl, err := bls.New(name)
+			require.NoError(t, err)
+			require.Equal(t, name, impl.Name())
+			require.NoError(t, bls.SelfTest(impl))
+		})
+	}
+}
+
+func TestGoImplementation(t *testing.T) {
+	t.Parallel()
+	impl, err := bls.New(bls.ImplementationGo)
+	require.NoError(t, err)
+
+	secret := make([]byte, 32)
+	_, err = impl.SecretToPubkey(secret)
+	require.ErrorIs(t, err, bls.ErrInvalidSecretKey)
+	_, err = impl.Sign(secret[:31], []byte("msg"))
+	require.ErrorIs(t, err, bls.ErrInvalidSecretKey)
+
+	secret[31] = 0x01
+	pubkey, err := impl.SecretToPubkey(secret)
+	require.NoError(t, err)
+	signature, err := impl.Sign(secret, []byte("msg"))
+	require.NoError(t, err)
+	require.True(t, impl.Verify(pubkey, []byte("msg"), signature))
+	require.False(t, impl.Verify(pubkey, []byte("other"), signature))
+	require.False(t, impl.Verify(crypto.BLSPubkey{}, []byte("msg"), signature))
+}
+
+func TestVerifyBatch(t *testing.T) {
+	t.Parallel()
+	require.True(t, bls.VerifyBatch(nil))
+	for _, name := range bls.Implementations() {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			impl, err := bls.New(name)
+			require.NoError(t, err)
+
+			sets := make([]crypto.SignatureSet, 4)
+			for i := range sets {
+				secret := make([]byte, 32)
+				secret[31] = byte(i + 1)
+				sets[i].Pubkey, err = impl.SecretToPubkey(secret)
+				require.NoError(t, err)
+				sets[i].Message = []byte{byte(i)}
+				sets[i].Signature, err = impl.Sign(secret, sets[i].Message)
+				require.NoError(t, err)
+			}
+			require.True(t, impl.VerifyBatch(sets))
+
+			// swapping two signatures invalidates the batch
+			sets[1].Signature, sets[2].Signature =
+				sets[2].Signature, sets[1].Signature
+			require.False(t, impl.VerifyBatch(sets))
+			require.False(t, impl.VerifyBatch(nil))
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	impl, err := bls.New(bls.ImplementationAuto)
+	require.NoError(t, err)
+	require.Equal(t, bls.Implementations()[0], impl.Name())
+
+	_, err = bls.New("unknown")
+	require.ErrorIs(t, err, bls.ErrUnsupportedImplementation)
+}
+
+func TestBenchmark(t *testing.T) {
+	t.Parallel()
+	impl, err := bls.New(bls.ImplementationGo)
+	require.NoError(t, err)
+	result, err := bls.Benchmark(impl, 2)
+	require.NoError(t, err)
+	require.Equal(t, bls.ImplementationGo, result.Implementation)
+	require.Positive(t, result.Sign)
+	require.Positive(t, result.Verify)
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build bls12381
+
+package bls
+
+import (
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// randBits is the number of random bits weighting each signature set of a
+// batch.
+const randBits = 64
+
+// ImplementationBlst is the supranational/blst implementation, through
+// CometBFT's bls12381 package. It requires cgo and the bls12381 tag.
+const ImplementationBlst = "supranational/blst"
+
+// blstEnabled reports whether supranational/blst is compiled in.
+const blstEnabled = true
+
+// blstImplementation implements the BLS12-381 signature scheme with
+// supranational/blst.
+type blstImplementation struct{}
+
+// Name returns the name of the implementation.
+func (blstImplementation) Name() string {
+	return ImplementationBlst
+}
+
+// SecretToPubkey returns the compressed public key of a secret key.
+func (blstImplementation) SecretToPubkey(
+	secret []byte,
+) (crypto.BLSPubkey, error) {
+	sk, err := bls12381.NewPrivateKeyFromBytes(secret)
+	if err != nil {
+		return crypto.BLSPubkey{}, ErrInvalidSecretKey
+	}
+	pk, err := bls12381.NewPublicKeyFromBytes(sk.PubKey().Bytes())
+	if err != nil {
+		return crypto.BLSPubkey{}, err
+	}
+	return crypto.BLSPubkey(pk.Compress()), nil
+}
+
+// Sign signs a message with a secret key.
+func (blstImplementation) Sign(
+	secret []byte,
+	msg []byte,
+) (crypto.BLSSignature, error) {
+	sk, err := bls12381.NewPrivateKeyFromBytes(secret)
+	if err != nil {
+		return crypto.BLSSignature{}, ErrInvalidSecretKey
+	}
+	sig, err := sk.Sign(msg)
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	return crypto.BLSSignature(sig), nil
+}
+
+// Verify reports whether the signature of the message is valid for the
+// compressed public key.
+func (blstImplementation) Verify(
+	pubkey crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) bool {
+	pk, err := bls12381.NewPublicKeyFromCompressedBytes(pubkey[:])
+	if err != nil {
+		return false
+	}
+	return pk.VerifySignature(msg, signature[:])
+}
+
+// VerifyBatch reports whether all the non-empty signature sets are valid,
+// verifying them at once, each weighted by a random 64-bit scalar.
+func (blstImplementation) VerifyBatch(sets []crypto.SignatureSet) bool {
+	pks := make([]*blst.P1Affine, len(sets))
+	sigs := make([]*blst.P2Affine, len(sets))
+	msgs := make([]blst.Message, len(sets))
+	for i, set := range sets {
+		if pks[i] = new(blst.P1Affine).Uncompress(
+			set.Pubkey[:],
+		); pks[i] == nil {
+			return false
+		}
+		if sigs[i] = new(blst.P2Affine).Uncompress(
+			set.Signature[:],
+		); sigs[i] == nil {
+			return false
+		}
+		msgs[i] = set.Message
+	}
+	var err error
+	randFn := func(s *blst.Scalar) {
+		var r [32]byte
+		if r, err = randomScalar(); err == nil {
+			s.Deserialize(r[:])
+		}
+	}
+	valid := new(blst.P2Affine).MultipleAggregateVerify(
+		sigs, true, pks, true, msgs, dst, randFn, randBits,
+	)
+	return valid && err == nil
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build !bls12381
+
+package bls
+
+import "github.com/berachain/beacon-kit/primitives/crypto"
+
+// ImplementationBlst is the supranational/blst implementation, through
+// CometBFT's bls12381 package. It requires cgo and the bls12381 tag.
+const ImplementationBlst = "supranational/blst"
+
+// blstEnabled reports whether supranational/blst is compiled in.
+const blstEnabled = false
+
+// blstImplementation is never constructed without the bls12381 tag.
+type blstImplementation struct{}
+
+// Name returns the name of the implementation.
+func (blstImplementation) Name() string {
+	return ImplementationBlst
+}
+
+// SecretToPubkey will error since supranational/blst is not compiled in.
+func (blstImplementation) SecretToPubkey([]byte) (crypto.BLSPubkey, error) {
+	return crypto.BLSPubkey{}, ErrBlstNotEnabled
+}
+
+// Sign will error since supranational/blst is not compiled in.
+func (blstImplementation) Sign([]byte, []byte) (crypto.BLSSignature, error) {
+	return crypto.BLSSignature{}, ErrBlstNotEnabled
+}
+
+// Verify always fails since supranational/blst is not compiled in.
+func (blstImplementation) Verify(
+	crypto.BLSPubkey,
+	[]byte,
+	crypto.BLSSignature,
+) bool {
+	return false
+}
+
+// VerifyBatch always fails since supranational/blst is not compiled in.
+func (blstImplementation) VerifyBatch([]crypto.SignatureSet) bool {
+	return false
+}
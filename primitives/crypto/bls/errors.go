@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bls
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrUnsupportedImplementation is returned when the BLS implementation
+	// is not supported.
+	ErrUnsupportedImplementation = errors.New("unsupported BLS implementation")
+
+	// ErrBlstNotEnabled is returned when supranational/blst is selected in a
+	// binary built without the bls12381 tag.
+	ErrBlstNotEnabled = errors.New(
+		"supranational/blst requires building with the bls12381 tag",
+	)
+
+	// ErrInvalidSecretKey is returned when a secret key is not a non-zero
+	// scalar below the curve order.
+	ErrInvalidSecretKey = errors.New("invalid BLS secret key")
+
+	// ErrSelfTestFailed is returned when an implementation does not produce
+	// the expected results for the known answer test.
+	ErrSelfTestFailed = errors.New("BLS self-test failed")
+
+	// ErrInvalidThreshold is returned when splitting a secret key into
+	// fewer shares than required to recover it.
+	ErrInvalidThreshold = errors.New("invalid threshold")
+
+	// ErrInvalidPartialSignature is returned when aggregating a partial
+	// signature which is not a valid point, or whose share index is zero.
+	ErrInvalidPartialSignature = errors.New("invalid partial signature")
+)
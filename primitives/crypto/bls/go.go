@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bls
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	kbls "github.com/kilic/bls12-381"
+)
+
+// ImplementationGo is the pure-Go kilic/bls12-381 implementation, which does
+// not require cgo.
+const ImplementationGo = "kilic/bls12-381"
+
+// curveOrder is the order r of the BLS12-381 subgroups.
+//
+//nolint:gochecknoglobals // constant.
+var curveOrder, _ = new(big.Int).SetString(
+	"73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16,
+)
+
+// goImplementation implements the BLS12-381 signature scheme with
+// kilic/bls12-381.
+type goImplementation struct{}
+
+// Name returns the name of the implementation.
+func (goImplementation) Name() string {
+	return ImplementationGo
+}
+
+// SecretToPubkey returns the compressed public key of a secret key.
+func (goImplementation) SecretToPubkey(
+	secret []byte,
+) (crypto.BLSPubkey, error) {
+	sk, err := goSecretKey(secret)
+	if err != nil {
+		return crypto.BLSPubkey{}, err
+	}
+	g1 := kbls.NewG1()
+	pk := g1.New()
+	g1.MulScalar(pk, g1.One(), sk)
+	return crypto.BLSPubkey(g1.ToCompressed(pk)), nil
+}
+
+// Sign signs a message with a secret key.
+func (goImplementation) Sign(
+	secret []byte,
+	msg []byte,
+) (crypto.BLSSignature, error) {
+	sk, err := goSecretKey(secret)
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	g2 := kbls.NewG2()
+	q, err := g2.HashToCurve(msg, dst)
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	sig := g2.New()
+	g2.MulScalar(sig, q, sk)
+	return crypto.BLSSignature(g2.ToCompressed(sig)), nil
+}
+
+// Verify reports whether the signature of the message is valid for the
+// compressed public key.
+func (goImplementation) Verify(
+	pubkey crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) bool {
+	// decompression checks that the points are in the correct subgroups
+	g1 := kbls.NewG1()
+	pk, err := g1.FromCompressed(pubkey[:])
+	if err != nil || g1.IsZero(pk) {
+		return false
+	}
+	g2 := kbls.NewG2()
+	sig, err := g2.FromCompressed(signature[:])
+	if err != nil {
+		return false
+	}
+	q, err := g2.HashToCurve(msg, dst)
+	if err != nil {
+		return false
+	}
+	// e(pk, H(msg)) == e(g1, sig)
+	return kbls.NewEngine().
+		AddPair(pk, q).
+		AddPairInv(g1.One(), sig).
+		Check()
+}
+
+// VerifyBatch reports whether all the non-empty signature sets are valid,
+// verifying them at once. Each set is weighted by a random 64-bit scalar r_i,
+// so that the product of e(r_i * pk_i, H(msg_i)) equals e(g1, sum(r_i * sig_i))
+// only if every signature is valid, except with negligible probability.
+func (goImplementation) VerifyBatch(sets []crypto.SignatureSet) bool {
+	if len(sets) == 0 {
+		return false
+	}
+	g1, g2 := kbls.NewG1(), kbls.NewG2()
+	engine := kbls.NewEngine()
+	aggregate := g2.Zero()
+	for _, set := range sets {
+		pk, err := g1.FromCompressed(set.Pubkey[:])
+		if err != nil || g1.IsZero(pk) {
+			return false
+		}
+		sig, err := g2.FromCompressed(set.Signature[:])
+		if err != nil {
+			return false
+		}
+		q, err := g2.HashToCurve(set.Message, dst)
+		if err != nil {
+			return false
+		}
+		r, err := randomScalar()
+		if err != nil {
+			return false
+		}
+		g1.MulScalar(pk, pk, kbls.NewFr().FromBytes(r[:]))
+		engine.AddPair(pk, q)
+		g2.MulScalar(sig, sig, kbls.NewFr().FromBytes(r[:]))
+		g2.Add(aggregate, aggregate, sig)
+	}
+	return engine.AddPairInv(g1.One(), aggregate).Check()
+}
+
+// goSecretKey parses a big-endian secret key, which must be a non-zero scalar
+// below the curve order.
+func goSecretKey(secret []byte) (*kbls.Fr, error) {
+	if len(secret) != constants.BLSSecretKeyLength {
+		return nil, ErrInvalidSecretKey
+	}
+	n := new(big.Int).SetBytes(secret)
+	if n.Sign() == 0 || n.Cmp(curveOrder) >= 0 {
+		return nil, ErrInvalidSecretKey
+	}
+	return kbls.NewFr().FromBytes(secret), nil
+}
+
+// randomScalar returns a random non-zero 64-bit scalar, big-endian encoded,
+// to weight the signature sets of a batch with.
+func randomScalar() ([constants.BLSSecretKeyLength]byte, error) {
+	var r [constants.BLSSecretKeyLength]byte
+	low := r[constants.BLSSecretKeyLength-8:]
+	if _, err := rand.Read(low); err != nil {
+		return r, err
+	}
+	if binary.BigEndian.Uint64(low) == 0 {
+		low[7] = 1
+	}
+	return r, nil
+}
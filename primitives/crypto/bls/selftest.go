@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bls
+
+import (
+	"bytes"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/hex"
+)
+
+// The known answer test of the self-test. The vector is produced by
+// supranational/blst with the CometBFT domain separation tag.
+//
+//nolint:lll // hex encoded vector.
+const (
+	selfTestSecret    = "0x1c5a33305a2580e1f172e14131b800c65aec39ca30cbed373340a954a2881d45"
+	selfTestMsg       = "0xab530a13e45914982b79f9b7e3fba994cfd1f3fb22f71cea1afbf02b460c6d1d"
+	selfTestPubkey    = "0xb89108f7991f707a35eff896e95055e7c83f8472a6944dbe1527c57f48970fca19546005fa1f200c6d5571f17c9774c8"
+	selfTestSignature = "0xa9f4d419de958345ebc3722070ae329ee6e5fe4418561ab788ca8619c3f4c58e28b20fa901b31c9c18fcc68adfaf266316e733cb09646eb716d2790028c3ba3c9eccee27f1ec3715886288630a92f7ea05564b214d017987aafee20996f0dea4"
+)
+
+// SelfTest checks that the implementation derives the public key, signs and
+// verifies the known answer test as expected, alone and in a batch, and
+// rejects a tampered message.
+func SelfTest(impl Implementation) error {
+	secret := hex.MustToBytes(selfTestSecret)
+	msg := hex.MustToBytes(selfTestMsg)
+
+	pubkey, err := impl.SecretToPubkey(secret)
+	if err != nil {
+		return errors.Wrapf(ErrSelfTestFailed, "%s: %v", impl.Name(), err)
+	}
+	if !bytes.Equal(pubkey[:], hex.MustToBytes(selfTestPubkey)) {
+		return errors.Wrapf(
+			ErrSelfTestFailed, "%s: unexpected public key", impl.Name(),
+		)
+	}
+
+	signature, err := impl.Sign(secret, msg)
+	if err != nil {
+		return errors.Wrapf(ErrSelfTestFailed, "%s: %v", impl.Name(), err)
+	}
+	if !bytes.Equal(signature[:], hex.MustToBytes(selfTestSignature)) {
+		return errors.Wrapf(
+			ErrSelfTestFailed, "%s: unexpected signature", impl.Name(),
+		)
+	}
+
+	if !impl.Verify(pubkey, msg, signature) {
+		return errors.Wrapf(
+			ErrSelfTestFailed, "%s: valid signature rejected", impl.Name(),
+		)
+	}
+	sets := []crypto.SignatureSet{
+		{Pubkey: pubkey, Message: msg, Signature: signature},
+	}
+	if !impl.VerifyBatch(sets) {
+		return errors.Wrapf(
+			ErrSelfTestFailed, "%s: valid batch rejected", impl.Name(),
+		)
+	}
+
+	tampered := hex.MustToBytes(selfTestMsg)
+	tampered[0] ^= 0x01
+	if impl.Verify(pubkey, tampered, signature) {
+		return errors.Wrapf(
+			ErrSelfTestFailed, "%s: invalid signature accepted", impl.Name(),
+		)
+	}
+	sets = append(sets, crypto.SignatureSet{
+		Pubkey: pubkey, Message: tampered, Signature: signature,
+	})
+	if impl.VerifyBatch(sets) {
+		return errors.Wrapf(
+			ErrSelfTestFailed, "%s: invalid batch accepted", impl.Name(),
+		)
+	}
+	return nil
+}
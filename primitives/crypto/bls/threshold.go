@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bls
+
+import (
+	"crypto/rand"
+	"math/big"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	kbls "github.com/kilic/bls12-381"
+)
+
+// SplitSecret splits a secret key into n shares, any threshold of which
+// recover it, with Shamir's secret sharing over the scalar field. The shares
+// are keyed by their index, from 1 to n. The partial signatures made with
+// threshold of the shares aggregate into the signature of the secret key.
+func SplitSecret(
+	secret []byte,
+	threshold, n int,
+) (map[uint64][]byte, error) {
+	if _, err := goSecretKey(secret); err != nil {
+		return nil, err
+	}
+	if threshold < 1 || n < threshold {
+		return nil, errors.Wrapf(
+			ErrInvalidThreshold, "%d of %d", threshold, n,
+		)
+	}
+
+	// The secret is the constant term of a random polynomial of degree
+	// threshold - 1, the shares its evaluations at their index.
+	coeffs := make([]*big.Int, threshold)
+	coeffs[0] = new(big.Int).SetBytes(secret)
+	for i := 1; i < threshold; i++ {
+		c, err := rand.Int(rand.Reader, curveOrder)
+		if err != nil {
+			return nil, err
+		}
+		coeffs[i] = c
+	}
+	shares := make(map[uint64][]byte, n)
+	for i := uint64(1); i <= uint64(n); i++ {
+		x := new(big.Int).SetUint64(i)
+		y := new(big.Int)
+		for k := len(coeffs) - 1; k >= 0; k-- {
+			y.Mul(y, x).Add(y, coeffs[k]).Mod(y, curveOrder)
+		}
+		if y.Sign() == 0 {
+			// A zero share is no valid secret key, which is negligibly
+			// likely: the secret is split with another polynomial.
+			return SplitSecret(secret, threshold, n)
+		}
+		shares[i] = y.FillBytes(make([]byte, constants.BLSSecretKeyLength))
+	}
+	return shares, nil
+}
+
+// AggregatePartials recovers the signature of a secret key split by
+// SplitSecret from the partial signatures of the same message made with
+// threshold of its shares, keyed by the index of their share, by Lagrange
+// interpolation at zero. The signature recovered is only valid if as many
+// partial signatures as the threshold are given, all of them valid.
+func AggregatePartials(
+	partials map[uint64]crypto.BLSSignature,
+) (crypto.BLSSignature, error) {
+	if len(partials) == 0 {
+		return crypto.BLSSignature{}, ErrInvalidPartialSignature
+	}
+	g2 := kbls.NewG2()
+	aggregate := g2.Zero()
+	for i, partial := range partials {
+		if i == 0 {
+			return crypto.BLSSignature{}, errors.Wrap(
+				ErrInvalidPartialSignature, "zero share index",
+			)
+		}
+		sig, err := g2.FromCompressed(partial[:])
+		if err != nil {
+			return crypto.BLSSignature{}, errors.Wrapf(
+				ErrInvalidPartialSignature, "share %d: %v", i, err,
+			)
+		}
+		coeff := lagrangeCoefficient(i, partials)
+		g2.MulScalar(sig, sig, kbls.NewFr().FromBytes(
+			coeff.FillBytes(make([]byte, constants.BLSSecretKeyLength)),
+		))
+		g2.Add(aggregate, aggregate, sig)
+	}
+	return crypto.BLSSignature(g2.ToCompressed(aggregate)), nil
+}
+
+// lagrangeCoefficient returns the Lagrange coefficient at zero of the share
+// i among the shares of the given partial signatures, i.e. the product of
+// j / (j - i) over the other shares j, modulo the curve order.
+func lagrangeCoefficient(
+	i uint64,
+	partials map[uint64]crypto.BLSSignature,
+) *big.Int {
+	xi := new(big.Int).SetUint64(i)
+	num, den := big.NewInt(1), big.NewInt(1)
+	for j := range partials {
+		if j == i {
+			continue
+		}
+		xj := new(big.Int).SetUint64(j)
+		num.Mul(num, xj).Mod(num, curveOrder)
+		diff := new(big.Int).Sub(xj, xi)
+		den.Mul(den, diff).Mod(den, curveOrder)
+	}
+	den.ModInverse(den, curveOrder)
+	return num.Mul(num, den).Mod(num, curveOrder)
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bls_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/bls"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThresholdSignature(t *testing.T) {
+	t.Parallel()
+	secret := make([]byte, 32)
+	secret[31] = 42
+	pubkey, err := bls.SecretToPubkey(secret)
+	require.NoError(t, err)
+	msg := []byte("threshold")
+
+	_, err = bls.SplitSecret(secret, 4, 3)
+	require.ErrorIs(t, err, bls.ErrInvalidThreshold)
+	shares, err := bls.SplitSecret(secret, 3, 5)
+	require.NoError(t, err)
+	require.Len(t, shares, 5)
+	partials := make(map[uint64]crypto.BLSSignature, len(shares))
+	for i, share := range shares {
+		partials[i], err = bls.Sign(share, msg)
+		require.NoError(t, err)
+	}
+
+	// Any threshold of the partial signatures recover the signature of the
+	// secret key, fewer do not.
+	for _, indices := range [][]uint64{{1, 2, 3}, {5, 2, 4}, {1, 3}} {
+		subset := make(map[uint64]crypto.BLSSignature, len(indices))
+		for _, i := range indices {
+			subset[i] = partials[i]
+		}
+		sig, aggErr := bls.AggregatePartials(subset)
+		require.NoError(t, aggErr)
+		require.Equal(t, len(indices) == 3, bls.Verify(pubkey, msg, sig))
+	}
+
+	_, err = bls.AggregatePartials(map[uint64]crypto.BLSSignature{
+		1: partials[1], 2: {},
+	})
+	require.ErrorIs(t, err, bls.ErrInvalidPartialSignature)
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package versioned
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/constraints"
+)
+
+// Codec marshals and unmarshals a kind of objects, e.g. beacon blocks, whose
+// SSZ encoding depends on the fork version they belong to.
+//
+// The types of the objects are registered once, at construction, and the
+// codec is read-only afterwards, so it is safe for concurrent use.
+type Codec[T constraints.SSZMarshallable] struct {
+	// kind names the objects in the errors.
+	kind string
+	// factories creates the empty objects of each fork version.
+	factories map[uint32]func() T
+}
+
+// NewCodec creates a codec of the given kind of objects, without any
+// registered fork version.
+func NewCodec[T constraints.SSZMarshallable](kind string) *Codec[T] {
+	return &Codec[T]{
+		kind:      kind,
+		factories: make(map[uint32]func() T),
+	}
+}
+
+// Register registers the factory of the empty objects of the given fork
+// version. Registering a fork version twice is a programming error, hence it
+// panics.
+func (c *Codec[T]) Register(forkVersion uint32, factory func() T) *Codec[T] {
+	if _, ok := c.factories[forkVersion]; ok {
+		panic(fmt.Sprintf(
+			"%s of fork version %d already registered",
+			c.kind, forkVersion,
+		))
+	}
+	c.factories[forkVersion] = factory
+	return c
+}
+
+// Empty returns an empty object of the given fork version.
+func (c *Codec[T]) Empty(forkVersion uint32) (T, error) {
+	factory, ok := c.factories[forkVersion]
+	if !ok {
+		var t T
+		return t, errors.Wrapf(
+			ErrForkVersionNotRegistered,
+			"%s of fork version %d", c.kind, forkVersion,
+		)
+	}
+	return factory(), nil
+}
+
+// Unmarshal decodes an object of the given fork version from its SSZ
+// encoding.
+func (c *Codec[T]) Unmarshal(forkVersion uint32, bz []byte) (T, error) {
+	t, err := c.Empty(forkVersion)
+	if err != nil {
+		return t, err
+	}
+	if err = t.UnmarshalSSZ(bz); err != nil {
+		var zero T
+		return zero, errors.Wrapf(
+			err, "unmarshal %s of fork version %d", c.kind, forkVersion,
+		)
+	}
+	return t, nil
+}
+
+// Marshal encodes an object of the given fork version in SSZ. It fails if
+// the fork version is not registered, so that objects are never persisted or
+// sent in an encoding that cannot be decoded back.
+func (c *Codec[T]) Marshal(forkVersion uint32, t T) ([]byte, error) {
+	if _, ok := c.factories[forkVersion]; !ok {
+		return nil, errors.Wrapf(
+			ErrForkVersionNotRegistered,
+			"%s of fork version %d", c.kind, forkVersion,
+		)
+	}
+	return t.MarshalSSZ()
+}
+
+// Versions returns the registered fork versions, in ascending order.
+func (c *Codec[T]) Versions() []uint32 {
+	versions := make([]uint32, 0, len(c.factories))
+	for v := range c.factories {
+		versions = append(versions, v)
+	}
+	slices.Sort(versions)
+	return versions
+}
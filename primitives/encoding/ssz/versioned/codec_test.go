@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package versioned_test
+
+import (
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/versioned"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func newFork() *ctypes.Fork { return &ctypes.Fork{} }
+
+func TestCodec(t *testing.T) {
+	codec := versioned.NewCodec[*ctypes.Fork]("fork").
+		Register(version.DenebPlus, newFork).
+		Register(version.Deneb, newFork)
+	require.Equal(
+		t, []uint32{version.Deneb, version.DenebPlus}, codec.Versions(),
+	)
+
+	fork := &ctypes.Fork{
+		PreviousVersion: common.Version{1},
+		CurrentVersion:  common.Version{2},
+		Epoch:           3,
+	}
+	bz, err := codec.Marshal(version.Deneb, fork)
+	require.NoError(t, err)
+	decoded, err := codec.Unmarshal(version.Deneb, bz)
+	require.NoError(t, err)
+	require.Equal(t, fork, decoded)
+
+	_, err = codec.Unmarshal(version.Deneb, bz[1:])
+	require.Error(t, err)
+
+	_, err = codec.Marshal(version.Electra, fork)
+	require.ErrorIs(t, err, versioned.ErrForkVersionNotRegistered)
+	_, err = codec.Unmarshal(version.Electra, bz)
+	require.ErrorIs(t, err, versioned.ErrForkVersionNotRegistered)
+
+	require.Panics(t, func() { codec.Register(version.Deneb, newFork) })
+}
@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package math
+
+import (
+	"math/bits"
+
+	"github.com/berachain/beacon-kit/errors"
+)
+
+// ----------------------- U64 Checked Arithmetic -----------------------
+
+// CheckedAdd returns u + v, or ErrOverflow if the sum overflows.
+func (u U64) CheckedAdd(v U64) (U64, error) {
+	sum, carry := bits.Add64(uint64(u), uint64(v), 0)
+	if carry != 0 {
+		return 0, errors.Wrapf(ErrOverflow, "%d + %d", u, v)
+	}
+	return U64(sum), nil
+}
+
+// CheckedSub returns u - v, or ErrUnderflow if v is greater than u.
+func (u U64) CheckedSub(v U64) (U64, error) {
+	diff, borrow := bits.Sub64(uint64(u), uint64(v), 0)
+	if borrow != 0 {
+		return 0, errors.Wrapf(ErrUnderflow, "%d - %d", u, v)
+	}
+	return U64(diff), nil
+}
+
+// CheckedMul returns u * v, or ErrOverflow if the product overflows.
+func (u U64) CheckedMul(v U64) (U64, error) {
+	hi, lo := bits.Mul64(uint64(u), uint64(v))
+	if hi != 0 {
+		return 0, errors.Wrapf(ErrOverflow, "%d * %d", u, v)
+	}
+	return U64(lo), nil
+}
+
+// SaturatingAdd returns u + v, capped at the maximum U64.
+func (u U64) SaturatingAdd(v U64) U64 {
+	sum, err := u.CheckedAdd(v)
+	if err != nil {
+		return ^U64(0)
+	}
+	return sum
+}
+
+// SaturatingSub returns u - v, floored at 0.
+func (u U64) SaturatingSub(v U64) U64 {
+	return u - min(u, v)
+}
+
+// SaturatingMul returns u * v, capped at the maximum U64.
+func (u U64) SaturatingMul(v U64) U64 {
+	product, err := u.CheckedMul(v)
+	if err != nil {
+		return ^U64(0)
+	}
+	return product
+}
+
+// ----------------------- U256 Checked Arithmetic -----------------------
+
+// CheckedAddU256 returns x + y, or ErrOverflow if the sum overflows.
+func CheckedAddU256(x, y *U256) (*U256, error) {
+	sum, overflow := new(U256).AddOverflow(x, y)
+	if overflow {
+		return nil, errors.Wrapf(ErrOverflow, "%s + %s", x, y)
+	}
+	return sum, nil
+}
+
+// CheckedSubU256 returns x - y, or ErrUnderflow if y is greater than x.
+func CheckedSubU256(x, y *U256) (*U256, error) {
+	diff, underflow := new(U256).SubOverflow(x, y)
+	if underflow {
+		return nil, errors.Wrapf(ErrUnderflow, "%s - %s", x, y)
+	}
+	return diff, nil
+}
+
+// CheckedMulU256 returns x * y, or ErrOverflow if the product overflows.
+func CheckedMulU256(x, y *U256) (*U256, error) {
+	product, overflow := new(U256).MulOverflow(x, y)
+	if overflow {
+		return nil, errors.Wrapf(ErrOverflow, "%s * %s", x, y)
+	}
+	return product, nil
+}
+
+// SaturatingAddU256 returns x + y, capped at the maximum U256.
+func SaturatingAddU256(x, y *U256) *U256 {
+	sum, err := CheckedAddU256(x, y)
+	if err != nil {
+		return new(U256).SetAllOne()
+	}
+	return sum
+}
+
+// SaturatingSubU256 returns x - y, floored at 0.
+func SaturatingSubU256(x, y *U256) *U256 {
+	diff, err := CheckedSubU256(x, y)
+	if err != nil {
+		return new(U256)
+	}
+	return diff
+}
+
+// SaturatingMulU256 returns x * y, capped at the maximum U256.
+func SaturatingMulU256(x, y *U256) *U256 {
+	product, err := CheckedMulU256(x, y)
+	if err != nil {
+		return new(U256).SetAllOne()
+	}
+	return product
+}
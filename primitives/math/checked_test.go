@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package math_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestU64_CheckedArithmetic(t *testing.T) {
+	const maxU64 = ^math.U64(0)
+
+	tests := []struct {
+		name       string
+		op         func(math.U64, math.U64) (math.U64, error)
+		saturating func(math.U64, math.U64) math.U64
+		x, y       math.U64
+		expected   math.U64
+		saturated  math.U64
+		err        error
+	}{
+		{
+			"add", math.U64.CheckedAdd, math.U64.SaturatingAdd,
+			2, 3, 5, 5, nil,
+		},
+		{
+			"add overflow", math.U64.CheckedAdd, math.U64.SaturatingAdd,
+			maxU64, 1, 0, maxU64, math.ErrOverflow,
+		},
+		{
+			"sub", math.U64.CheckedSub, math.U64.SaturatingSub,
+			5, 3, 2, 2, nil,
+		},
+		{
+			"sub underflow", math.U64.CheckedSub, math.U64.SaturatingSub,
+			3, 5, 0, 0, math.ErrUnderflow,
+		},
+		{
+			"mul", math.U64.CheckedMul, math.U64.SaturatingMul,
+			1 << 32, 1<<32 - 1, 1<<64 - 1<<32, 1<<64 - 1<<32, nil,
+		},
+		{
+			"mul overflow", math.U64.CheckedMul, math.U64.SaturatingMul,
+			1 << 32, 1 << 32, 0, maxU64, math.ErrOverflow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.op(tt.x, tt.y)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, result)
+			}
+			require.Equal(t, tt.saturated, tt.saturating(tt.x, tt.y))
+		})
+	}
+}
+
+func TestU256_CheckedArithmetic(t *testing.T) {
+	maxU256 := new(math.U256).SetAllOne()
+
+	sum, err := math.CheckedAddU256(math.NewU256(2), math.NewU256(3))
+	require.NoError(t, err)
+	require.Equal(t, math.NewU256(5), sum)
+	_, err = math.CheckedAddU256(maxU256, math.NewU256(1))
+	require.ErrorIs(t, err, math.ErrOverflow)
+	require.Equal(
+		t, maxU256, math.SaturatingAddU256(maxU256, math.NewU256(1)),
+	)
+
+	diff, err := math.CheckedSubU256(math.NewU256(5), math.NewU256(3))
+	require.NoError(t, err)
+	require.Equal(t, math.NewU256(2), diff)
+	_, err = math.CheckedSubU256(math.NewU256(3), math.NewU256(5))
+	require.ErrorIs(t, err, math.ErrUnderflow)
+	require.Equal(
+		t,
+		math.NewU256(0),
+		math.SaturatingSubU256(math.NewU256(3), math.NewU256(5)),
+	)
+
+	product, err := math.CheckedMulU256(math.NewU256(6), math.NewU256(7))
+	require.NoError(t, err)
+	require.Equal(t, math.NewU256(42), product)
+	_, err = math.CheckedMulU256(maxU256, math.NewU256(2))
+	require.ErrorIs(t, err, math.ErrOverflow)
+	require.Equal(
+		t, maxU256, math.SaturatingMulU256(maxU256, math.NewU256(2)),
+	)
+
+	// The operands are left untouched.
+	require.Equal(t, new(math.U256).SetAllOne(), maxU256)
+}
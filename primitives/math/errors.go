@@ -28,6 +28,13 @@ var (
 	// ErrUnexpectedInputLengthBase is the base error for unexpected input
 	// length errors.
 	ErrUnexpectedInputLengthBase = errors.New("unexpected input length")
+
+	// ErrOverflow is returned when the result of an operation overflows its
+	// type.
+	ErrOverflow = errors.New("integer overflow")
+
+	// ErrUnderflow is returned when the result of an operation is negative.
+	ErrUnderflow = errors.New("integer underflow")
 )
 
 // ErrUnexpectedInputLength returns an error indicating that the input length.
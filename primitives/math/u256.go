@@ -23,6 +23,7 @@ package math
 import (
 	"fmt"
 	"math/big"
+	"strconv"
 
 	"github.com/holiman/uint256"
 )
@@ -64,3 +65,23 @@ func (u *U256Hex) MarshalJSON() ([]byte, error) {
 func (u *U256Hex) UnmarshalJSON(data []byte) error {
 	return (*uint256.Int)(u).UnmarshalJSON(data)
 }
+
+// U256Dec represents a 256-bit unsigned integer that is marshaled to JSON
+// as a quoted decimal string, as per the Beacon API.
+type U256Dec uint256.Int
+
+// MarshalJSON implements the json.Marshaler interface.
+// It returns the quoted decimal string representation of the U256Dec value.
+func (u *U256Dec) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + (*uint256.Int)(u).Dec() + `"`), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It expects the input to be a quoted decimal string.
+func (u *U256Dec) UnmarshalJSON(data []byte) error {
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		return err
+	}
+	return (*uint256.Int)(u).SetFromDecimal(s)
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signing
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// ForkSchedule is the part of the chain spec scheduling the forks.
+type ForkSchedule interface {
+	// ActiveForkVersionForEpoch returns the active fork version for a given
+	// epoch.
+	ActiveForkVersionForEpoch(epoch math.Epoch) uint32
+}
+
+// ForkVersionAtEpoch returns the version of the fork active at the given
+// epoch in the fork schedule.
+func ForkVersionAtEpoch(fs ForkSchedule, epoch math.Epoch) common.Version {
+	return version.FromUint32[common.Version](
+		fs.ActiveForkVersionForEpoch(epoch),
+	)
+}
+
+// ComputeForkDataRoot as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_fork_data_root
+func ComputeForkDataRoot(
+	currentVersion common.Version,
+	genesisValidatorsRoot common.Root,
+) common.Root {
+	// The hash tree root of the ForkData container, whose two fields fit
+	// in a chunk each.
+	var chunks [2 * constants.RootLength]byte
+	copy(chunks[:], currentVersion[:])
+	copy(chunks[constants.RootLength:], genesisValidatorsRoot[:])
+	return sha256.Hash(chunks[:])
+}
+
+// ComputeForkDigest as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_fork_digest
+func ComputeForkDigest(
+	currentVersion common.Version,
+	genesisValidatorsRoot common.Root,
+) common.ForkDigest {
+	forkDataRoot := ComputeForkDataRoot(currentVersion, genesisValidatorsRoot)
+	return common.ForkDigest(forkDataRoot[:4])
+}
+
+// ComputeForkDigestAtEpoch computes the fork digest of the fork active at
+// the given epoch in the fork schedule.
+func ComputeForkDigestAtEpoch(
+	fs ForkSchedule,
+	epoch math.Epoch,
+	genesisValidatorsRoot common.Root,
+) common.ForkDigest {
+	return ComputeForkDigest(
+		ForkVersionAtEpoch(fs, epoch), genesisValidatorsRoot,
+	)
+}
+
+// ComputeDomain as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_domain
+func ComputeDomain(
+	domainType common.DomainType,
+	forkVersion common.Version,
+	genesisValidatorsRoot common.Root,
+) common.Domain {
+	forkDataRoot := ComputeForkDataRoot(forkVersion, genesisValidatorsRoot)
+	var domain common.Domain
+	copy(domain[:], domainType[:])
+	copy(domain[constants.DomainTypeLength:], forkDataRoot[:])
+	return domain
+}
+
+// ComputeDomainAtEpoch computes the domain of the given type for the fork
+// active at the given epoch in the fork schedule.
+func ComputeDomainAtEpoch(
+	fs ForkSchedule,
+	domainType common.DomainType,
+	epoch math.Epoch,
+	genesisValidatorsRoot common.Root,
+) common.Domain {
+	return ComputeDomain(
+		domainType, ForkVersionAtEpoch(fs, epoch), genesisValidatorsRoot,
+	)
+}
+
+// ComputeSigningRoot as defined in the Ethereum 2.0 specification, from the
+// hash tree root of the signed object.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_signing_root
+func ComputeSigningRoot(
+	objectRoot common.Root,
+	domain common.Domain,
+) common.Root {
+	// The hash tree root of the SigningData container.
+	var chunks [2 * constants.RootLength]byte
+	copy(chunks[:], objectRoot[:])
+	copy(chunks[constants.RootLength:], domain[:])
+	return sha256.Hash(chunks[:])
+}
+
+// ComputeSigningRootUInt64 computes the signing root of a uint64 value, such
+// as the epoch signed over by the RANDAO reveals.
+func ComputeSigningRootUInt64(
+	value uint64,
+	domain common.Domain,
+) common.Root {
+	var objectRoot common.Root
+	binary.LittleEndian.PutUint64(objectRoot[:], value)
+	return ComputeSigningRoot(objectRoot, domain)
+}
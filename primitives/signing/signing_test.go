@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signing_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/signing"
+	"github.com/berachain/beacon-kit/primitives/version"
+	zcommon "github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/protolambda/ztyp/tree"
+	"github.com/stretchr/testify/require"
+)
+
+// forkSchedule activates Deneb+ at epoch 10.
+type forkSchedule struct{}
+
+func (forkSchedule) ActiveForkVersionForEpoch(epoch math.Epoch) uint32 {
+	if epoch >= 10 {
+		return version.DenebPlus
+	}
+	return version.Deneb
+}
+
+func TestComputeDomain(t *testing.T) {
+	var (
+		domainType            = common.DomainType{0x07, 0x00, 0x00, 0x00}
+		forkVersion           = common.Version{0x04, 0x00, 0x00, 0x00}
+		genesisValidatorsRoot = common.Root{0x01, 0x02, 0x03}
+	)
+
+	require.Equal(
+		t,
+		common.Root(zcommon.ComputeForkDataRoot(
+			zcommon.Version(forkVersion), tree.Root(genesisValidatorsRoot),
+		)),
+		signing.ComputeForkDataRoot(forkVersion, genesisValidatorsRoot),
+	)
+	require.Equal(
+		t,
+		common.ForkDigest(zcommon.ComputeForkDigest(
+			zcommon.Version(forkVersion), tree.Root(genesisValidatorsRoot),
+		)),
+		signing.ComputeForkDigest(forkVersion, genesisValidatorsRoot),
+	)
+
+	domain := signing.ComputeDomain(
+		domainType, forkVersion, genesisValidatorsRoot,
+	)
+	require.Equal(
+		t,
+		common.Domain(zcommon.ComputeDomain(
+			zcommon.BLSDomainType(domainType),
+			zcommon.Version(forkVersion),
+			tree.Root(genesisValidatorsRoot),
+		)),
+		domain,
+	)
+
+	objectRoot := common.Root{0x0a, 0x0b}
+	require.Equal(
+		t,
+		common.Root(zcommon.ComputeSigningRoot(
+			tree.Root(objectRoot), zcommon.BLSDomain(domain),
+		)),
+		signing.ComputeSigningRoot(objectRoot, domain),
+	)
+	require.Equal(
+		t,
+		common.Root(zcommon.ComputeSigningRoot(
+			zcommon.Epoch(42).HashTreeRoot(tree.GetHashFn()),
+			zcommon.BLSDomain(domain),
+		)),
+		signing.ComputeSigningRootUInt64(42, domain),
+	)
+}
+
+func TestComputeDomainAtEpoch(t *testing.T) {
+	var (
+		domainType            = common.DomainType{0x02, 0x00, 0x00, 0x00}
+		genesisValidatorsRoot = common.Root{0x01, 0x02, 0x03}
+		deneb                 = version.FromUint32[common.Version](
+			version.Deneb,
+		)
+		denebPlus = version.FromUint32[common.Version](version.DenebPlus)
+	)
+
+	require.Equal(t, deneb, signing.ForkVersionAtEpoch(forkSchedule{}, 9))
+	require.Equal(
+		t, denebPlus, signing.ForkVersionAtEpoch(forkSchedule{}, 10),
+	)
+
+	require.Equal(
+		t,
+		signing.ComputeDomain(domainType, deneb, genesisValidatorsRoot),
+		signing.ComputeDomainAtEpoch(
+			forkSchedule{}, domainType, 9, genesisValidatorsRoot,
+		),
+	)
+	require.Equal(
+		t,
+		signing.ComputeDomain(domainType, denebPlus, genesisValidatorsRoot),
+		signing.ComputeDomainAtEpoch(
+			forkSchedule{}, domainType, 10, genesisValidatorsRoot,
+		),
+	)
+	require.NotEqual(
+		t,
+		signing.ComputeForkDigestAtEpoch(
+			forkSchedule{}, 9, genesisValidatorsRoot,
+		),
+		signing.ComputeForkDigestAtEpoch(
+			forkSchedule{}, 10, genesisValidatorsRoot,
+		),
+	)
+}
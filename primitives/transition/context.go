@@ -47,6 +47,9 @@ type Context struct {
 	// ConsensusTime returns the timestamp of current consensus request.
 	// It is used to build next payload and to validate currentpayload.
 	ConsensusTime math.U64
+	// Misbehaviors are the validators misbehaviors reported by consensus
+	// along with the block, to be slashed.
+	Misbehaviors []*Misbehavior
 }
 
 // GetOptimisticEngine returns whether to optimistically assume the execution
@@ -86,6 +89,12 @@ func (c *Context) GetConsensusTime() math.U64 {
 	return c.ConsensusTime
 }
 
+// GetMisbehaviors returns the validators misbehaviors reported by consensus
+// along with the block.
+func (c *Context) GetMisbehaviors() []*Misbehavior {
+	return c.Misbehaviors
+}
+
 // Unwrap returns the underlying standard context.
 func (c *Context) Unwrap() context.Context {
 	return c.Context
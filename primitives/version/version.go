@@ -34,6 +34,27 @@ const (
 	Electra
 )
 
+// Name returns the name of the fork of the given version, as reported by
+// the Beacon API, or an empty string for unknown versions.
+func Name(version uint32) string {
+	switch version {
+	case Phase0:
+		return "phase0"
+	case Altair:
+		return "altair"
+	case Bellatrix:
+		return "bellatrix"
+	case Capella:
+		return "capella"
+	case Deneb, DenebPlus:
+		return "deneb"
+	case Electra:
+		return "electra"
+	default:
+		return ""
+	}
+}
+
 // FromUint32 returns a Version from a uint32.
 func FromUint32[VersionT ~[4]byte](version uint32) VersionT {
 	versionBz := VersionT{}
@@ -36,9 +36,10 @@ import (
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/log/noop"
 	"github.com/berachain/beacon-kit/node-core/components"
-	nodemetrics "github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/observability/telemetry"
 	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	cryptomocks "github.com/berachain/beacon-kit/primitives/crypto/mocks"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
@@ -121,7 +122,7 @@ func setupChain(t *testing.T, chainSpecType string) chain.Spec[
 	t.Helper()
 
 	t.Setenv(components.ChainSpecTypeEnvVar, chainSpecType)
-	cs, err := components.ProvideChainSpec()
+	cs, err := components.ProvideChainSpec(components.ChainSpecInput{})
 	require.NoError(t, err)
 
 	return cs
@@ -139,14 +140,30 @@ func setupState(
 ) {
 	t.Helper()
 
-	execEngine := mocks.NewExecutionEngine(t)
-
 	mocksSigner := &cryptomocks.BLSSigner{}
 	mocksSigner.On(
 		"VerifySignature",
 		mock.Anything, mock.Anything, mock.Anything,
 	).Return(nil)
 
+	return setupStateWithSigner(t, cs, mocksSigner)
+}
+
+func setupStateWithSigner(
+	t *testing.T, cs chain.Spec[
+		bytes.B4, math.U64, math.U64, any,
+	],
+	signer crypto.BLSSigner,
+) (
+	*TestStateProcessorT,
+	*TestBeaconStateT,
+	*depositstore.KVStore,
+	*transition.Context,
+) {
+	t.Helper()
+
+	execEngine := mocks.NewExecutionEngine(t)
+
 	dummyProposerAddr := []byte{0xff}
 
 	kvStore, depositStore, err := initTestStores()
@@ -161,14 +178,15 @@ func setupState(
 		cs,
 		execEngine,
 		depositStore,
-		mocksSigner,
+		signer,
 		func(bytes.B48) ([]byte, error) {
 			return dummyProposerAddr, nil
 		},
-		nodemetrics.NewNoOpTelemetrySink(),
+		telemetry.NewNoOpSink(),
 	)
 
 	ctx := &transition.Context{
+		Context:                 context.Background(),
 		SkipPayloadVerification: true,
 		SkipValidateResult:      true,
 		ProposerAddress:         dummyProposerAddr,
@@ -177,6 +195,16 @@ func setupState(
 	return sp, beaconState, depositStore, ctx
 }
 
+// newEth1Data returns the Eth1Data committing to the given deposits.
+func newEth1Data(deposits types.Deposits) *types.Eth1Data {
+	var eth1Data *types.Eth1Data
+	return eth1Data.New(
+		deposits.HashTreeRoot(),
+		math.U64(len(deposits)),
+		common.ExecutionHash{},
+	)
+}
+
 func progressStateToSlot(
 	t *testing.T,
 	beaconState *TestBeaconStateT,
@@ -256,7 +284,7 @@ func moveToEndOfEpoch(
 	sp *TestStateProcessorT,
 	st *TestBeaconStateT,
 	ctx *transition.Context,
-	depRoot common.Root,
+	eth1Data *types.Eth1Data,
 ) *types.BeaconBlock {
 	t.Helper()
 	blk := tip
@@ -275,7 +303,7 @@ func moveToEndOfEpoch(
 					},
 					BaseFeePerGas: math.NewU256(0),
 				},
-				Eth1Data: &types.Eth1Data{DepositRoot: depRoot},
+				Eth1Data: eth1Data,
 				Deposits: []*types.Deposit{},
 			},
 		)
@@ -48,6 +48,14 @@ var (
 	// block is different from the correspondent one from store.
 	ErrDepositMismatch = errors.New("deposit mismatched")
 
+	// ErrDepositsNotObserved is returned when a block includes deposits
+	// which have not been observed on the execution layer by the node.
+	ErrDepositsNotObserved = errors.New("deposits not observed")
+
+	// ErrDepositCountMismatch is returned when the deposit count claimed by
+	// a block differs from the count of deposits observed by the node.
+	ErrDepositCountMismatch = errors.New("deposit count mismatch")
+
 	// ErrDepositIndexOutOfOrder is returned when deposits are not in
 	// contiguous order.
 	ErrDepositIndexOutOfOrder = errors.New("deposit index out of order")
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"context"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Types of the events emitted by EventHooks.
+const (
+	EventTypeDepositProcessed   = "deposit_processed"
+	EventTypeValidatorActivated = "validator_activated"
+	EventTypeValidatorExited    = "validator_exited"
+	EventTypeValidatorSlashed   = "validator_slashed"
+)
+
+// Attribute keys of the events emitted by EventHooks. Numeric values are
+// formatted in base 10 and amounts are denominated in Gwei.
+const (
+	AttributeKeyValidatorIndex    = "validator_index"
+	AttributeKeyPubkey            = "pubkey"
+	AttributeKeyDepositIndex      = "deposit_index"
+	AttributeKeyAmount            = "amount"
+	AttributeKeyActivationEpoch   = "activation_epoch"
+	AttributeKeyExitEpoch         = "exit_epoch"
+	AttributeKeyWithdrawableEpoch = "withdrawable_epoch"
+	AttributeKeyPenalty           = "penalty"
+)
+
+// EventHooks are StakingHooks which emit validator lifecycle events on the
+// Cosmos event manager of the hook context, so that they end up in the
+// events of the block being finalized.
+//
+// Hooks invoked with a context not carrying a Cosmos context are no-ops.
+type EventHooks struct{}
+
+// NewEventHooks creates a new EventHooks.
+func NewEventHooks() EventHooks {
+	return EventHooks{}
+}
+
+// OnDeposit emits an EventTypeDepositProcessed event.
+func (EventHooks) OnDeposit(
+	ctx context.Context,
+	idx math.ValidatorIndex,
+	dep *ctypes.Deposit,
+) error {
+	emitEvent(ctx, sdk.NewEvent(
+		EventTypeDepositProcessed,
+		sdk.NewAttribute(AttributeKeyValidatorIndex, idx.Base10()),
+		sdk.NewAttribute(AttributeKeyPubkey, dep.GetPubkey().String()),
+		sdk.NewAttribute(AttributeKeyDepositIndex, dep.GetIndex().Base10()),
+		sdk.NewAttribute(AttributeKeyAmount, dep.GetAmount().Base10()),
+	))
+	return nil
+}
+
+// OnActivation emits an EventTypeValidatorActivated event.
+func (EventHooks) OnActivation(
+	ctx context.Context,
+	idx math.ValidatorIndex,
+	val *ctypes.Validator,
+) error {
+	emitEvent(ctx, sdk.NewEvent(
+		EventTypeValidatorActivated,
+		sdk.NewAttribute(AttributeKeyValidatorIndex, idx.Base10()),
+		sdk.NewAttribute(AttributeKeyPubkey, val.GetPubkey().String()),
+		sdk.NewAttribute(
+			AttributeKeyActivationEpoch, val.GetActivationEpoch().Base10(),
+		),
+	))
+	return nil
+}
+
+// OnExit emits an EventTypeValidatorExited event.
+func (EventHooks) OnExit(
+	ctx context.Context,
+	idx math.ValidatorIndex,
+	val *ctypes.Validator,
+) error {
+	emitEvent(ctx, sdk.NewEvent(
+		EventTypeValidatorExited,
+		sdk.NewAttribute(AttributeKeyValidatorIndex, idx.Base10()),
+		sdk.NewAttribute(AttributeKeyPubkey, val.GetPubkey().String()),
+		sdk.NewAttribute(AttributeKeyExitEpoch, val.GetExitEpoch().Base10()),
+		sdk.NewAttribute(
+			AttributeKeyWithdrawableEpoch, val.GetWithdrawableEpoch().Base10(),
+		),
+	))
+	return nil
+}
+
+// OnSlash emits an EventTypeValidatorSlashed event.
+func (EventHooks) OnSlash(
+	ctx context.Context,
+	idx math.ValidatorIndex,
+	val *ctypes.Validator,
+	penalty math.Gwei,
+) error {
+	emitEvent(ctx, sdk.NewEvent(
+		EventTypeValidatorSlashed,
+		sdk.NewAttribute(AttributeKeyValidatorIndex, idx.Base10()),
+		sdk.NewAttribute(AttributeKeyPubkey, val.GetPubkey().String()),
+		sdk.NewAttribute(AttributeKeyPenalty, penalty.Base10()),
+		sdk.NewAttribute(AttributeKeyExitEpoch, val.GetExitEpoch().Base10()),
+		sdk.NewAttribute(
+			AttributeKeyWithdrawableEpoch, val.GetWithdrawableEpoch().Base10(),
+		),
+	))
+	return nil
+}
+
+// emitEvent emits the event on the event manager of the Cosmos context
+// carried by ctx, if any.
+func emitEvent(ctx context.Context, event sdk.Event) {
+	if ctx == nil {
+		return
+	}
+	sdkCtx, ok := sdk.TryUnwrapSDKContext(ctx)
+	if !ok {
+		return
+	}
+	sdkCtx.EventManager().EmitEvent(event)
+}
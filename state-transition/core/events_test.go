@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventHooks(t *testing.T) {
+	ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+	hooks := core.NewEventHooks()
+
+	dep := &types.Deposit{
+		Pubkey: [48]byte{0x01},
+		Amount: math.Gwei(32e9),
+		Credentials: types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{0x01},
+		),
+		Index: uint64(3),
+	}
+	val := &types.Validator{
+		Pubkey:            dep.Pubkey,
+		ActivationEpoch:   1,
+		ExitEpoch:         5,
+		WithdrawableEpoch: 6,
+	}
+	require.NoError(t, hooks.OnDeposit(ctx, 2, dep))
+	require.NoError(t, hooks.OnActivation(ctx, 2, val))
+	require.NoError(t, hooks.OnExit(ctx, 2, val))
+	require.NoError(t, hooks.OnSlash(ctx, 2, val, math.Gwei(1e9)))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 4)
+
+	require.Equal(t, core.EventTypeDepositProcessed, events[0].Type)
+	requireAttribute(t, events[0], core.AttributeKeyValidatorIndex, "2")
+	requireAttribute(t, events[0], core.AttributeKeyPubkey, dep.Pubkey.String())
+	requireAttribute(t, events[0], core.AttributeKeyDepositIndex, "3")
+	requireAttribute(t, events[0], core.AttributeKeyAmount, "32000000000")
+
+	require.Equal(t, core.EventTypeValidatorActivated, events[1].Type)
+	requireAttribute(t, events[1], core.AttributeKeyActivationEpoch, "1")
+
+	require.Equal(t, core.EventTypeValidatorExited, events[2].Type)
+	requireAttribute(t, events[2], core.AttributeKeyExitEpoch, "5")
+	requireAttribute(t, events[2], core.AttributeKeyWithdrawableEpoch, "6")
+
+	require.Equal(t, core.EventTypeValidatorSlashed, events[3].Type)
+	requireAttribute(t, events[3], core.AttributeKeyPenalty, "1000000000")
+
+	// hooks are no-ops outside of a Cosmos context
+	require.NoError(t, hooks.OnDeposit(context.Background(), 2, dep))
+}
+
+func requireAttribute(t *testing.T, event sdk.Event, key, value string) {
+	t.Helper()
+	attr, found := event.GetAttribute(key)
+	require.True(t, found, "missing attribute %s", key)
+	require.Equal(t, value, attr.Value)
+}
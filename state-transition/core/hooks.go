@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"context"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// StakingHooks are notified by the StateProcessor of validator lifecycle
+// events, so that external modules can mirror them into their own state.
+//
+// The context passed to each hook is the context of the beacon state being
+// transitioned. Writes performed through it follow the same lifecycle as the
+// beacon state itself: they are committed when the block is finalized and
+// discarded when the transition runs over a scratch copy of the state (e.g.
+// while verifying or building a proposal).
+//
+// Returning an error from a hook fails the whole state transition.
+type StakingHooks interface {
+	// OnDeposit is called once a deposit has been credited to a validator,
+	// either by creating a new validator or by topping up an existing one.
+	OnDeposit(
+		ctx context.Context,
+		idx math.ValidatorIndex,
+		dep *ctypes.Deposit,
+	) error
+	// OnActivation is called once a validator has been scheduled for
+	// activation. The validator activation epoch is already set.
+	OnActivation(
+		ctx context.Context,
+		idx math.ValidatorIndex,
+		val *ctypes.Validator,
+	) error
+	// OnExit is called once a validator has been scheduled for exit. The
+	// validator exit and withdrawable epochs are already set.
+	OnExit(
+		ctx context.Context,
+		idx math.ValidatorIndex,
+		val *ctypes.Validator,
+	) error
+	// OnSlash is called once a validator has been slashed, with the amount
+	// that has been deducted from its balance.
+	OnSlash(
+		ctx context.Context,
+		idx math.ValidatorIndex,
+		val *ctypes.Validator,
+		penalty math.Gwei,
+	) error
+}
+
+// MultiStakingHooks combines multiple StakingHooks. Hooks are invoked in
+// order and the first error aborts the remaining ones.
+type MultiStakingHooks []StakingHooks
+
+// NewMultiStakingHooks creates a new MultiStakingHooks.
+func NewMultiStakingHooks(hooks ...StakingHooks) MultiStakingHooks {
+	return hooks
+}
+
+// OnDeposit implements StakingHooks.
+func (h MultiStakingHooks) OnDeposit(
+	ctx context.Context,
+	idx math.ValidatorIndex,
+	dep *ctypes.Deposit,
+) error {
+	for _, hook := range h {
+		if err := hook.OnDeposit(ctx, idx, dep); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnActivation implements StakingHooks.
+func (h MultiStakingHooks) OnActivation(
+	ctx context.Context,
+	idx math.ValidatorIndex,
+	val *ctypes.Validator,
+) error {
+	for _, hook := range h {
+		if err := hook.OnActivation(ctx, idx, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnExit implements StakingHooks.
+func (h MultiStakingHooks) OnExit(
+	ctx context.Context,
+	idx math.ValidatorIndex,
+	val *ctypes.Validator,
+) error {
+	for _, hook := range h {
+		if err := hook.OnExit(ctx, idx, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnSlash implements StakingHooks.
+func (h MultiStakingHooks) OnSlash(
+	ctx context.Context,
+	idx math.ValidatorIndex,
+	val *ctypes.Validator,
+	penalty math.Gwei,
+) error {
+	for _, hook := range h {
+		if err := hook.OnSlash(ctx, idx, val, penalty); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingHooks records the validator indexes notified by each hook.
+type recordingHooks struct {
+	deposits    []math.ValidatorIndex
+	activations []math.ValidatorIndex
+	exits       []math.ValidatorIndex
+	slashings   []math.ValidatorIndex
+	err         error
+}
+
+func (h *recordingHooks) OnDeposit(
+	_ context.Context, idx math.ValidatorIndex, _ *types.Deposit,
+) error {
+	h.deposits = append(h.deposits, idx)
+	return h.err
+}
+
+func (h *recordingHooks) OnActivation(
+	_ context.Context, idx math.ValidatorIndex, _ *types.Validator,
+) error {
+	h.activations = append(h.activations, idx)
+	return h.err
+}
+
+func (h *recordingHooks) OnExit(
+	_ context.Context, idx math.ValidatorIndex, _ *types.Validator,
+) error {
+	h.exits = append(h.exits, idx)
+	return h.err
+}
+
+func (h *recordingHooks) OnSlash(
+	_ context.Context, idx math.ValidatorIndex, _ *types.Validator, _ math.Gwei,
+) error {
+	h.slashings = append(h.slashings, idx)
+	return h.err
+}
+
+func TestStakingHooksAtGenesis(t *testing.T) {
+	cs := setupChain(t, components.BetnetChainSpecType)
+	sp, st, _, _ := setupState(t, cs)
+
+	var (
+		maxBalance = math.Gwei(cs.MaxEffectiveBalance(false))
+		minBalance = math.Gwei(cs.EjectionBalance())
+	)
+
+	hooks1, hooks2 := &recordingHooks{}, &recordingHooks{}
+	sp.SetHooks(hooks1, hooks2)
+
+	genDeposits := []*types.Deposit{
+		{
+			Pubkey: [48]byte{0x01},
+			Amount: maxBalance,
+			Credentials: types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{0x01},
+			),
+			Index: uint64(0),
+		},
+		{
+			// too small to be activated
+			Pubkey: [48]byte{0x02},
+			Amount: minBalance,
+			Credentials: types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{0x02},
+			),
+			Index: uint64(1),
+		},
+		{
+			// top up of the first validator
+			Pubkey: [48]byte{0x01},
+			Amount: minBalance,
+			Credentials: types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{0x01},
+			),
+			Index: uint64(2),
+		},
+	}
+
+	_, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		genDeposits,
+		&types.ExecutionPayloadHeader{},
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+
+	for _, h := range []*recordingHooks{hooks1, hooks2} {
+		require.Equal(t, []math.ValidatorIndex{0, 1, 0}, h.deposits)
+		require.Equal(t, []math.ValidatorIndex{0}, h.activations)
+		require.Empty(t, h.exits)
+		require.Empty(t, h.slashings)
+	}
+}
+
+func TestStakingHooksErrorFailsTransition(t *testing.T) {
+	cs := setupChain(t, components.BetnetChainSpecType)
+	sp, st, _, _ := setupState(t, cs)
+
+	errHook := errors.New("hook failure")
+	hooks1, hooks2 := &recordingHooks{err: errHook}, &recordingHooks{}
+	sp.SetHooks(hooks1, hooks2)
+
+	genDeposits := []*types.Deposit{
+		{
+			Pubkey: [48]byte{0x01},
+			Amount: math.Gwei(cs.MaxEffectiveBalance(false)),
+			Credentials: types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{0x01},
+			),
+			Index: uint64(0),
+		},
+	}
+
+	_, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		genDeposits,
+		&types.ExecutionPayloadHeader{},
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.ErrorIs(t, err, errHook)
+
+	// hooks following the failing one must not be invoked
+	require.Len(t, hooks1.deposits, 1)
+	require.Empty(t, hooks2.deposits)
+}
@@ -119,6 +119,10 @@ type ReadOnlyValidators interface {
 	ValidatorByIndex(
 		math.ValidatorIndex,
 	) (*ctypes.Validator, error)
+
+	IterateValidators(
+		func(math.ValidatorIndex, *ctypes.Validator) (bool, error),
+	) error
 }
 
 // WriteOnlyEth1Data has write access to eth1 data.
@@ -43,3 +43,24 @@ func (s *stateProcessorMetrics) gaugeTimestamps(
 	diff := int64(payloadTimestamp) - int64(consensusTimestamp) //#nosec:G701
 	s.sink.SetGauge("beacon_kit.state.payload_consensus_timestamp_diff", diff)
 }
+
+// gaugeDeposits reports the number of deposits of the last processed block
+// and the index of the next deposit to process.
+func (s *stateProcessorMetrics) gaugeDeposits(
+	numDeposits int,
+	eth1DepositIndex uint64,
+) {
+	s.sink.SetGauge("beacon_kit.state.block_deposits", int64(numDeposits))
+	//#nosec:G701 // not an issue in practice.
+	s.sink.SetGauge(
+		"beacon_kit.state.eth1_deposit_index", int64(eth1DepositIndex),
+	)
+}
+
+// gaugeBlockSignatures reports the number of signatures verified at once for
+// the last processed block.
+func (s *stateProcessorMetrics) gaugeBlockSignatures(numSignatures int) {
+	s.sink.SetGauge(
+		"beacon_kit.state.block_signatures", int64(numSignatures),
+	)
+}
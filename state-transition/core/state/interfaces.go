@@ -82,6 +82,14 @@ type KVStore[T any] interface {
 	GetValidators() (ctypes.Validators, error)
 	// GetBalances retrieves all balances.
 	GetBalances() ([]uint64, error)
+	// IterateValidators calls the given function with every validator until
+	// it returns true or an error.
+	IterateValidators(
+		fn func(math.ValidatorIndex, *ctypes.Validator) (bool, error),
+	) error
+	// IterateBalances calls the given function with every balance until it
+	// returns true or an error.
+	IterateBalances(fn func(math.ValidatorIndex, math.Gwei) (bool, error)) error
 	// GetNextWithdrawalIndex retrieves the next withdrawal index.
 	GetNextWithdrawalIndex() (uint64, error)
 	// SetNextWithdrawalIndex sets the next withdrawal index.
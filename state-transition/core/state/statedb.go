@@ -58,6 +58,13 @@ func (s *StateDB) Copy(ctx context.Context) *StateDB {
 	return s.NewFromDB(s.KVStore.Copy(ctx), s.cs)
 }
 
+// Batch returns a copy of the beacon state accumulating its mutations, and a
+// function committing them all at once to the beacon state.
+func (s *StateDB) Batch() (*StateDB, func()) {
+	kv, write := s.KVStore.Batch()
+	return s.NewFromDB(kv, s.cs), write
+}
+
 // IncreaseBalance increases the balance of a validator.
 func (s *StateDB) IncreaseBalance(
 	idx math.ValidatorIndex,
@@ -67,7 +74,11 @@ func (s *StateDB) IncreaseBalance(
 	if err != nil {
 		return err
 	}
-	return s.SetBalance(idx, balance+delta)
+	newBalance, err := balance.CheckedAdd(delta)
+	if err != nil {
+		return errors.Wrapf(err, "increasing balance of validator %d", idx)
+	}
+	return s.SetBalance(idx, newBalance)
 }
 
 // DecreaseBalance decreases the balance of a validator.
@@ -79,7 +90,7 @@ func (s *StateDB) DecreaseBalance(
 	if err != nil {
 		return err
 	}
-	return s.SetBalance(idx, balance-min(balance, delta))
+	return s.SetBalance(idx, balance.SaturatingSub(delta))
 }
 
 // UpdateSlashingAtIndex sets the slashing amount in the store.
@@ -99,11 +110,15 @@ func (s *StateDB) UpdateSlashingAtIndex(
 	}
 
 	// Defensive check but total - oldValue should never underflow.
-	if oldValue > total {
-		return errors.New("count of total slashing is not up to date")
-	} else if err = s.SetTotalSlashing(
-		total - oldValue + amount,
-	); err != nil {
+	total, err = total.CheckedSub(oldValue)
+	if err != nil {
+		return errors.Wrap(err, "count of total slashing is not up to date")
+	}
+	total, err = total.CheckedAdd(amount)
+	if err != nil {
+		return errors.Wrap(err, "updating total slashing")
+	}
+	if err = s.SetTotalSlashing(total); err != nil {
 		return err
 	}
 
@@ -407,3 +422,83 @@ func (s *StateDB) HashTreeRoot() common.Root {
 	}
 	return st.HashTreeRoot()
 }
+
+// SetMarshallable writes the given beacon state into the underlying store,
+// which is expected to be empty. It reverses GetMarshallable.
+//
+//nolint:gocognit // sequential writes of the state fields.
+func (s *StateDB) SetMarshallable(bs *ctypes.BeaconState) error {
+	if len(bs.Balances) != len(bs.Validators) {
+		return errors.Wrapf(
+			ErrInvalidBeaconState, "%d validators but %d balances",
+			len(bs.Validators), len(bs.Balances),
+		)
+	}
+
+	if err := s.SetSlot(bs.Slot); err != nil {
+		return err
+	}
+	if err := s.SetFork(bs.Fork); err != nil {
+		return err
+	}
+	if err := s.SetGenesisValidatorsRoot(bs.GenesisValidatorsRoot); err != nil {
+		return err
+	}
+	if err := s.SetLatestBlockHeader(bs.LatestBlockHeader); err != nil {
+		return err
+	}
+	for i, root := range bs.BlockRoots {
+		if err := s.UpdateBlockRootAtIndex(uint64(i), root); err != nil {
+			return err
+		}
+	}
+	for i, root := range bs.StateRoots {
+		if err := s.UpdateStateRootAtIndex(uint64(i), root); err != nil {
+			return err
+		}
+	}
+	if err := s.SetEth1Data(bs.Eth1Data); err != nil {
+		return err
+	}
+	if err := s.SetEth1DepositIndex(bs.Eth1DepositIndex); err != nil {
+		return err
+	}
+	if err := s.SetLatestExecutionPayloadHeader(
+		bs.LatestExecutionPayloadHeader,
+	); err != nil {
+		return err
+	}
+
+	// Validators are added in order, so that they are assigned the same
+	// indices they have in the given state.
+	for i, val := range bs.Validators {
+		if err := s.AddValidator(val); err != nil {
+			return err
+		}
+		if err := s.SetBalance(
+			math.ValidatorIndex(i), math.Gwei(bs.Balances[i]),
+		); err != nil {
+			return err
+		}
+	}
+
+	for i, mix := range bs.RandaoMixes {
+		if err := s.UpdateRandaoMixAtIndex(uint64(i), mix); err != nil {
+			return err
+		}
+	}
+	if err := s.SetNextWithdrawalIndex(bs.NextWithdrawalIndex); err != nil {
+		return err
+	}
+	if err := s.SetNextWithdrawalValidatorIndex(
+		bs.NextWithdrawalValidatorIndex,
+	); err != nil {
+		return err
+	}
+	for i, amount := range bs.Slashings {
+		if err := s.SetSlashingAtIndex(uint64(i), amount); err != nil {
+			return err
+		}
+	}
+	return s.SetTotalSlashing(bs.TotalSlashing)
+}
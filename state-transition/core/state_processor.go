@@ -27,11 +27,13 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	"github.com/berachain/beacon-kit/state-transition/core/state"
+	lru "github.com/hashicorp/golang-lru/v2"
 )
 
 // StateProcessor is a basic Processor, which takes care of the
@@ -54,6 +56,11 @@ type StateProcessor[
 	executionEngine ExecutionEngine
 	// ds allows checking payload deposits against the deposit contract
 	ds DepositStore
+	// hooks are notified of validator lifecycle events.
+	hooks StakingHooks
+	// verified holds the keys of the signature sets verified along with the
+	// other signatures of a block, see verifyBlockSignatures.
+	verified *lru.Cache[[32]byte, struct{}]
 	// metrics is the metrics for the service.
 	metrics *stateProcessorMetrics
 }
@@ -74,6 +81,11 @@ func NewStateProcessor[
 	ContextT,
 	KVStoreT,
 ] {
+	verified, err := lru.New[[32]byte, struct{}](verifiedSignaturesCacheSize)
+	if err != nil {
+		// unreachable, the size is positive
+		panic(err)
+	}
 	return &StateProcessor[
 		ContextT,
 		KVStoreT,
@@ -84,10 +96,21 @@ func NewStateProcessor[
 		signer:                signer,
 		fGetAddressFromPubKey: fGetAddressFromPubKey,
 		ds:                    ds,
+		hooks:                 NewMultiStakingHooks(),
+		verified:              verified,
 		metrics:               newStateProcessorMetrics(telemetrySink),
 	}
 }
 
+// SetHooks sets the staking hooks notified of validator lifecycle events,
+// replacing any previously set ones. It must be called before the state
+// processor is used.
+func (sp *StateProcessor[
+	_, _,
+]) SetHooks(hooks ...StakingHooks) {
+	sp.hooks = NewMultiStakingHooks(hooks...)
+}
+
 // Transition is the main function for processing a state transition.
 func (sp *StateProcessor[
 	ContextT, _,
@@ -101,7 +124,12 @@ func (sp *StateProcessor[
 	}
 
 	// Process the slots.
+	_, span := tracing.Start(
+		ctx, "state.ProcessSlots", tracing.Slot(blk.GetSlot()),
+	)
 	validatorUpdates, err := sp.ProcessSlots(st, blk.GetSlot())
+	tracing.RecordError(span, err)
+	span.End()
 	if err != nil {
 		return nil, err
 	}
@@ -215,11 +243,27 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	if err := sp.processRandaoReveal(ctx, st, blk); err != nil {
+	_, span := tracing.Start(ctx, "state.VerifySignatures")
+	err := sp.verifyBlockSignatures(ctx, st, blk)
+	tracing.RecordError(span, err)
+	span.End()
+	if err != nil {
 		return err
 	}
 
-	if err := sp.processOperations(st, blk); err != nil {
+	if err = sp.processRandaoReveal(ctx, st, blk); err != nil {
+		return err
+	}
+
+	_, span = tracing.Start(ctx, "state.ProcessOperations")
+	err = sp.processOperations(st, blk)
+	tracing.RecordError(span, err)
+	span.End()
+	if err != nil {
+		return err
+	}
+
+	if err := sp.processMisbehaviors(st, ctx.GetMisbehaviors()); err != nil {
 		return err
 	}
 
@@ -231,7 +275,9 @@ func (sp *StateProcessor[
 
 	// Ensure the calculated state root matches the state root on
 	// the block.
+	_, span = tracing.Start(ctx, "state.HashTreeRoot")
 	stateRoot := st.HashTreeRoot()
+	span.End()
 	if blk.GetStateRoot() != stateRoot {
 		return errors.Wrapf(
 			ErrStateRootMismatch, "expected %s, got %s",
@@ -270,6 +316,9 @@ func (sp *StateProcessor[
 	if err = sp.processEffectiveBalanceUpdates(st, slot); err != nil {
 		return nil, err
 	}
+	if err = sp.processEjections(st); err != nil {
+		return nil, err
+	}
 	if err = sp.processSlashingsReset(st); err != nil {
 		return nil, err
 	}
@@ -383,11 +432,6 @@ func (sp *StateProcessor[
 	slot math.Slot,
 ) error {
 	// Update effective balances with hysteresis
-	validators, err := st.GetValidators()
-	if err != nil {
-		return err
-	}
-
 	var (
 		hysteresisIncrement = sp.cs.EffectiveBalanceIncrement() / sp.cs.HysteresisQuotient()
 		downwardThreshold   = math.Gwei(
@@ -397,34 +441,42 @@ func (sp *StateProcessor[
 			hysteresisIncrement * sp.cs.HysteresisUpwardMultiplier(),
 		)
 
-		idx     math.U64
-		balance math.Gwei
+		updated []pendingValidator
 	)
 
-	for _, val := range validators {
-		idx, err = st.ValidatorIndexByPubkey(val.GetPubkey())
-		if err != nil {
-			return err
-		}
-
-		balance, err = st.GetBalance(idx)
-		if err != nil {
-			return err
-		}
+	err := st.IterateValidators(
+		func(idx math.ValidatorIndex, val *ctypes.Validator) (bool, error) {
+			balance, err := st.GetBalance(idx)
+			if err != nil {
+				return true, err
+			}
 
-		if balance+downwardThreshold < val.GetEffectiveBalance() ||
-			val.GetEffectiveBalance()+upwardThreshold < balance {
-			updatedBalance := ctypes.ComputeEffectiveBalance(
-				balance,
-				math.U64(sp.cs.EffectiveBalanceIncrement()),
-				math.U64(sp.cs.MaxEffectiveBalance(
-					state.IsPostFork3(sp.cs.DepositEth1ChainID(), slot),
-				)),
-			)
-			val.SetEffectiveBalance(updatedBalance)
-			if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
-				return err
+			// The sums saturate, as an overflowing sum is never below a balance.
+			if balance.SaturatingAdd(downwardThreshold) <
+				val.GetEffectiveBalance() ||
+				val.GetEffectiveBalance().SaturatingAdd(upwardThreshold) <
+					balance {
+				updatedBalance := ctypes.ComputeEffectiveBalance(
+					balance,
+					math.U64(sp.cs.EffectiveBalanceIncrement()),
+					math.U64(sp.cs.MaxEffectiveBalance(
+						state.IsPostFork3(sp.cs.DepositEth1ChainID(), slot),
+					)),
+				)
+				val.SetEffectiveBalance(updatedBalance)
+				updated = append(updated, pendingValidator{idx: idx, val: val})
 			}
+			return false, nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	// The state cannot be written to while iterating over the registry.
+	for _, p := range updated {
+		if err = st.UpdateValidatorAtIndex(p.idx, p.val); err != nil {
+			return err
 		}
 	}
 	return nil
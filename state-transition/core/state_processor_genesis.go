@@ -197,6 +197,9 @@ func (sp *StateProcessor[
 			if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
 				return err
 			}
+			if err = sp.hooks.OnActivation(st.Context(), idx, val); err != nil {
+				return err
+			}
 		}
 		return nil
 	}
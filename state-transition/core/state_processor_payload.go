@@ -27,6 +27,8 @@ import (
 	"github.com/berachain/beacon-kit/config/spec"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/math"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 	"golang.org/x/sync/errgroup"
@@ -41,11 +43,20 @@ func (sp *StateProcessor[
 	st *statedb.StateDB,
 	blk *ctypes.BeaconBlock,
 ) error {
+	spanCtx, span := tracing.Start(
+		ctx, "state.ProcessExecutionPayload", tracing.Slot(blk.GetSlot()),
+	)
+	defer span.End()
+
+	// The calls to the execution client are traced as children of the span
+	// of the payload processing.
 	var (
 		body    = blk.GetBody()
 		payload = body.GetExecutionPayload()
 		header  *ctypes.ExecutionPayloadHeader
-		g, gCtx = errgroup.WithContext(context.Background())
+		g, gCtx = errgroup.WithContext(
+			tracing.WithSpanOf(context.Background(), spanCtx),
+		)
 	)
 
 	payloadTimestamp := payload.GetTimestamp().Unwrap()
@@ -53,8 +64,7 @@ func (sp *StateProcessor[
 
 	sp.metrics.gaugeTimestamps(payloadTimestamp, consensusTimestamp)
 
-	sp.logger.Info("processExecutionPayload",
-		"consensus height", blk.GetSlot().Unwrap(),
+	log.WithContext(sp.logger, ctx).Info("processExecutionPayload",
 		"payload height", payload.GetNumber().Unwrap(),
 		"payload timestamp", payloadTimestamp,
 		"consensus timestamp", consensusTimestamp,
@@ -83,6 +93,7 @@ func (sp *StateProcessor[
 	})
 
 	if err := g.Wait(); err != nil {
+		tracing.RecordError(span, err)
 		return err
 	}
 
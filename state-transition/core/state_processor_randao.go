@@ -26,7 +26,6 @@ import (
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
-	"github.com/berachain/beacon-kit/primitives/version"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 	"github.com/go-faster/xor"
 )
@@ -59,18 +58,14 @@ func (sp *StateProcessor[
 	epoch := sp.cs.SlotToEpoch(slot)
 	body := blk.GetBody()
 
-	fd := ctypes.NewForkData(
-		version.FromUint32[common.Version](
-			sp.cs.ActiveForkVersionForEpoch(epoch),
-		), genesisValidatorsRoot,
-	)
+	fd := ctypes.NewForkDataAtEpoch(sp.cs, epoch, genesisValidatorsRoot)
 
 	if !ctx.GetSkipValidateRandao() {
 		signingRoot := fd.ComputeRandaoSigningRoot(
 			sp.cs.DomainTypeRandao(), epoch,
 		)
 		reveal := body.GetRandaoReveal()
-		if err = sp.signer.VerifySignature(
+		if err = sp.verifySignature(
 			proposer.GetPubkey(),
 			signingRoot[:],
 			reveal,
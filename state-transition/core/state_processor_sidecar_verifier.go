@@ -22,9 +22,8 @@ package core
 
 import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
-	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
-	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/primitives/signing"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 )
 
@@ -47,12 +46,9 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
-	fd := *ctypes.NewForkData(
-		version.FromUint32[common.Version](
-			sp.cs.ActiveForkVersionForEpoch(epoch),
-		), genesisValidatorsRoot,
+	domain := signing.ComputeDomainAtEpoch(
+		sp.cs, sp.cs.DomainTypeProposer(), epoch, genesisValidatorsRoot,
 	)
-	domain := fd.ComputeDomain(sp.cs.DomainTypeProposer())
 
 	return func(
 		blkHeader *ctypes.BeaconBlockHeader,
@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"slices"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
+	"github.com/berachain/beacon-kit/primitives/signing"
+	"github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// verifiedSignaturesCacheSize is the number of signatures remembered as
+// verified, well above the number of signatures a block carries.
+const verifiedSignaturesCacheSize = 1024
+
+// verifyBlockSignatures verifies the signatures carried by the block ahead
+// of its processing: its RANDAO reveal on its own, and the signatures of the
+// deposits creating validators at once rather than one by one. The verified
+// signatures are remembered, so that processing the block does not verify
+// them again. The RANDAO reveal is kept out of the batch, for its
+// verification not to depend on the deposits, which are ignored rather than
+// failing the block when their signature is invalid. If the batch fails, the
+// deposit signatures are verified one by one to remember the valid ones, and
+// the invalid ones are handled as usual when processed.
+func (sp *StateProcessor[
+	ContextT, _,
+]) verifyBlockSignatures(
+	ctx ContextT,
+	st *state.StateDB,
+	blk *ctypes.BeaconBlock,
+) error {
+	randao, sets, err := sp.blockSignatureSets(ctx, st, blk)
+	if err != nil {
+		return err
+	}
+	// An invalid RANDAO reveal fails the block when it is processed.
+	if randao != nil && sp.signer.VerifySignature(
+		randao.Pubkey, randao.Message, randao.Signature,
+	) == nil {
+		sp.verified.Add(signatureSetKey(*randao), struct{}{})
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+	sp.metrics.gaugeBlockSignatures(len(sets))
+
+	if err = crypto.VerifySignatures(sp.signer, sets); err == nil {
+		for _, set := range sets {
+			sp.verified.Add(signatureSetKey(set), struct{}{})
+		}
+		return nil
+	}
+
+	var invalid int
+	for _, set := range sets {
+		if sp.signer.VerifySignature(
+			set.Pubkey, set.Message, set.Signature,
+		) != nil {
+			invalid++
+			continue
+		}
+		sp.verified.Add(signatureSetKey(set), struct{}{})
+	}
+	log.WithContext(sp.logger, st.Context()).Info(
+		"Deposit signatures verified one by one after batch failure",
+		"slot", blk.GetSlot(), "signatures", len(sets), "invalid", invalid,
+	)
+	return nil
+}
+
+// blockSignatureSets returns the signatures to verify for the block, as they
+// are verified when the block is processed: its RANDAO reveal, nil if it is
+// not validated, and the signatures of the deposits creating validators.
+func (sp *StateProcessor[
+	ContextT, _,
+]) blockSignatureSets(
+	ctx ContextT,
+	st *state.StateDB,
+	blk *ctypes.BeaconBlock,
+) (*crypto.SignatureSet, []crypto.SignatureSet, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, nil, err
+	}
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return nil, nil, err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+	forkVersion := signing.ForkVersionAtEpoch(sp.cs, epoch)
+
+	var randao *crypto.SignatureSet
+	if !ctx.GetSkipValidateRandao() {
+		proposer, vErr := st.ValidatorByIndex(blk.GetProposerIndex())
+		if vErr != nil {
+			return nil, nil, vErr
+		}
+		signingRoot := ctypes.NewForkData(
+			forkVersion, genesisValidatorsRoot,
+		).ComputeRandaoSigningRoot(sp.cs.DomainTypeRandao(), epoch)
+		randao = &crypto.SignatureSet{
+			Pubkey:    proposer.GetPubkey(),
+			Message:   signingRoot[:],
+			Signature: blk.GetBody().GetRandaoReveal(),
+		}
+	}
+
+	var sets []crypto.SignatureSet
+	collect := func(
+		pubkey crypto.BLSPubkey, msg []byte, signature crypto.BLSSignature,
+	) error {
+		sets = append(sets, crypto.SignatureSet{
+			Pubkey: pubkey, Message: msg, Signature: signature,
+		})
+		return nil
+	}
+
+	// At genesis, the validators sign over an empty root.
+	if slot == 0 {
+		genesisValidatorsRoot = common.Root{}
+	}
+	depositForkData := ctypes.NewForkData(forkVersion, genesisValidatorsRoot)
+	for _, dep := range blk.GetBody().GetDeposits() {
+		// Only the deposits creating validators are verified, see
+		// createValidator.
+		if !dep.HasEth1WithdrawalCredentials() {
+			continue
+		}
+		if _, err = st.ValidatorIndexByPubkey(dep.GetPubkey()); err == nil {
+			continue
+		}
+		_ = dep.VerifySignature(
+			depositForkData, sp.cs.DomainTypeDeposit(), collect,
+		)
+	}
+	return randao, sets, nil
+}
+
+// verifySignature verifies a signature, unless it was already verified along
+// with the other signatures of the block.
+func (sp *StateProcessor[
+	_, _,
+]) verifySignature(
+	pubkey crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	if sp.verified.Contains(signatureSetKey(crypto.SignatureSet{
+		Pubkey: pubkey, Message: msg, Signature: signature,
+	})) {
+		return nil
+	}
+	return sp.signer.VerifySignature(pubkey, msg, signature)
+}
+
+// signatureSetKey returns the key a verified signature set is remembered by.
+func signatureSetKey(set crypto.SignatureSet) [32]byte {
+	return sha256.Hash(slices.Concat(
+		set.Pubkey[:], set.Signature[:], set.Message,
+	))
+}
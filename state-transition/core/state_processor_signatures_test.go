@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/primitives/version"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/stretchr/testify/require"
+)
+
+// countingSigner counts the signatures it verifies one by one and at once.
+type countingSigner struct {
+	*signer.LegacySigner
+	verifications int
+	batches       int
+}
+
+func (s *countingSigner) VerifySignature(
+	pubkey crypto.BLSPubkey, msg []byte, signature crypto.BLSSignature,
+) error {
+	s.verifications++
+	return s.LegacySigner.VerifySignature(pubkey, msg, signature)
+}
+
+func (s *countingSigner) VerifySignatures(sets []crypto.SignatureSet) error {
+	s.batches++
+	return s.LegacySigner.VerifySignatures(sets)
+}
+
+func newLegacySigner(t *testing.T, seed byte) *signer.LegacySigner {
+	t.Helper()
+	s, err := signer.NewLegacySigner(signer.LegacyKey{31: seed})
+	require.NoError(t, err)
+	return s
+}
+
+func signedDeposit(
+	t *testing.T,
+	forkData *types.ForkData,
+	domainType common.DomainType,
+	s crypto.BLSSigner,
+	amount math.Gwei,
+	index uint64,
+) *types.Deposit {
+	t.Helper()
+	credentials := types.NewCredentialsFromExecutionAddress(
+		common.ExecutionAddress{},
+	)
+	msg, signature, err := types.CreateAndSignDepositMessage(
+		forkData, domainType, s, credentials, amount,
+	)
+	require.NoError(t, err)
+	return &types.Deposit{
+		Pubkey:      msg.Pubkey,
+		Credentials: credentials,
+		Amount:      amount,
+		Signature:   signature,
+		Index:       index,
+	}
+}
+
+// signaturesTest is the chain set up by setupSignaturesTest.
+type signaturesTest struct {
+	cs          chain.Spec[bytes.B4, math.U64, math.U64, any]
+	sp          *TestStateProcessorT
+	st          *TestBeaconStateT
+	ds          *depositstore.KVStore
+	ctx         *transition.Context
+	verifier    *countingSigner
+	genDeposits types.Deposits
+	forkData    *types.ForkData
+}
+
+// setupSignaturesTest sets up a chain whose single genesis validator is the
+// one of newLegacySigner(t, 1), verifying the signatures with a counting
+// signer.
+func setupSignaturesTest(t *testing.T) *signaturesTest {
+	t.Helper()
+	cs := setupChain(t, components.BetnetChainSpecType)
+	verifier := &countingSigner{LegacySigner: newLegacySigner(t, 1)}
+	sp, st, ds, ctx := setupStateWithSigner(t, cs, verifier)
+
+	forkVersion := version.FromUint32[common.Version](
+		cs.ActiveForkVersionForEpoch(0),
+	)
+	genDeposits := types.Deposits{
+		signedDeposit(
+			t, types.NewForkData(forkVersion, common.Root{}),
+			cs.DomainTypeDeposit(), newLegacySigner(t, 1),
+			math.Gwei(cs.MaxEffectiveBalance(false)), 0,
+		),
+	}
+	require.NoError(t, ds.EnqueueDeposits(genDeposits))
+	genVals, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		genDeposits,
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+	require.Len(t, genVals, 1)
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	require.NoError(t, err)
+	verifier.verifications, verifier.batches = 0, 0
+	return &signaturesTest{
+		cs:          cs,
+		sp:          sp,
+		st:          st,
+		ds:          ds,
+		ctx:         ctx,
+		verifier:    verifier,
+		genDeposits: genDeposits,
+		forkData:    types.NewForkData(forkVersion, genesisValidatorsRoot),
+	}
+}
+
+func TestTransitionBatchVerifiesSignatures(t *testing.T) {
+	c := setupSignaturesTest(t)
+	cs, sp, st, ds, ctx := c.cs, c.sp, c.st, c.ds, c.ctx
+	verifier, genDeposits, forkData := c.verifier, c.genDeposits, c.forkData
+	ctx.SkipValidateRandao = true
+	maxBalance := math.Gwei(cs.MaxEffectiveBalance(false))
+
+	// STEP 1: the valid deposit signatures are verified at once
+	valid := signedDeposit(
+		t, forkData, cs.DomainTypeDeposit(),
+		newLegacySigner(t, 2), maxBalance, 1,
+	)
+	eth1Data := newEth1Data(append(genDeposits, valid))
+	blk := buildNextBlock(t, st, &types.BeaconBlockBody{
+		ExecutionPayload: &types.ExecutionPayload{
+			Timestamp:    10,
+			ExtraData:    []byte("testing"),
+			Transactions: [][]byte{},
+			Withdrawals: []*engineprimitives.Withdrawal{
+				st.EVMInflationWithdrawal(),
+			},
+			BaseFeePerGas: math.NewU256(0),
+		},
+		Eth1Data: eth1Data,
+		Deposits: []*types.Deposit{valid},
+	})
+	require.NoError(t, ds.EnqueueDeposits(blk.Body.Deposits))
+	_, err := sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	require.Equal(t, 1, verifier.batches)
+	require.Zero(t, verifier.verifications)
+	_, err = st.ValidatorIndexByPubkey(valid.Pubkey)
+	require.NoError(t, err)
+
+	// STEP 2: an invalid deposit signature fails the batch, and the
+	// signatures are verified one by one instead
+	valid = signedDeposit(
+		t, forkData, cs.DomainTypeDeposit(),
+		newLegacySigner(t, 3), maxBalance, 2,
+	)
+	invalid := signedDeposit(
+		t, forkData, cs.DomainTypeDeposit(),
+		newLegacySigner(t, 4), maxBalance, 3,
+	)
+	invalid.Signature = valid.Signature
+	eth1Data = newEth1Data(slices.Concat(
+		genDeposits, blk.Body.Deposits, types.Deposits{valid, invalid},
+	))
+	blk = buildNextBlock(t, st, &types.BeaconBlockBody{
+		ExecutionPayload: &types.ExecutionPayload{
+			Timestamp:    11,
+			ExtraData:    []byte("testing"),
+			Transactions: [][]byte{},
+			Withdrawals: []*engineprimitives.Withdrawal{
+				st.EVMInflationWithdrawal(),
+			},
+			BaseFeePerGas: math.NewU256(0),
+		},
+		Eth1Data: eth1Data,
+		Deposits: []*types.Deposit{valid, invalid},
+	})
+	require.NoError(t, ds.EnqueueDeposits(blk.Body.Deposits))
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	require.Equal(t, 2, verifier.batches)
+	// both in the fallback, then the invalid one again when processed
+	require.Equal(t, 3, verifier.verifications)
+	_, err = st.ValidatorIndexByPubkey(valid.Pubkey)
+	require.NoError(t, err)
+	_, err = st.ValidatorIndexByPubkey(invalid.Pubkey)
+	require.Error(t, err)
+}
+
+func TestTransitionVerifiesRandaoOutOfBatch(t *testing.T) {
+	c := setupSignaturesTest(t)
+	maxBalance := math.Gwei(c.cs.MaxEffectiveBalance(false))
+	c.ctx.SkipValidateRandao = false
+
+	valid := signedDeposit(
+		t, c.forkData, c.cs.DomainTypeDeposit(),
+		newLegacySigner(t, 2), maxBalance, 1,
+	)
+	invalid := signedDeposit(
+		t, c.forkData, c.cs.DomainTypeDeposit(),
+		newLegacySigner(t, 3), maxBalance, 2,
+	)
+	invalid.Signature = valid.Signature
+
+	// The RANDAO reveal is signed by the genesis validator, proposing.
+	randaoRoot := c.forkData.ComputeRandaoSigningRoot(
+		c.cs.DomainTypeRandao(), 0,
+	)
+	reveal, err := newLegacySigner(t, 1).Sign(randaoRoot[:])
+	require.NoError(t, err)
+
+	blk := buildNextBlock(t, c.st, &types.BeaconBlockBody{
+		RandaoReveal: reveal,
+		ExecutionPayload: &types.ExecutionPayload{
+			Timestamp:    10,
+			ExtraData:    []byte("testing"),
+			Transactions: [][]byte{},
+			Withdrawals: []*engineprimitives.Withdrawal{
+				c.st.EVMInflationWithdrawal(),
+			},
+			BaseFeePerGas: math.NewU256(0),
+		},
+		Eth1Data: newEth1Data(slices.Concat(
+			c.genDeposits, types.Deposits{valid, invalid},
+		)),
+		Deposits: []*types.Deposit{valid, invalid},
+	})
+	require.NoError(t, c.ds.EnqueueDeposits(blk.Body.Deposits))
+
+	// The invalid deposit fails the batch of the deposit signatures and is
+	// ignored, while the RANDAO reveal, verified on its own, passes.
+	_, err = c.sp.Transition(c.ctx, c.st, blk)
+	require.NoError(t, err)
+	require.Equal(t, 1, c.verifier.batches)
+	// the RANDAO reveal, both deposits in the fallback, then the invalid
+	// one again when processed
+	require.Equal(t, 4, c.verifier.verifications)
+	_, err = c.st.ValidatorIndexByPubkey(valid.Pubkey)
+	require.NoError(t, err)
+	_, err = c.st.ValidatorIndexByPubkey(invalid.Pubkey)
+	require.Error(t, err)
+}
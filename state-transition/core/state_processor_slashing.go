@@ -21,8 +21,14 @@
 package core
 
 import (
+	"fmt"
+
+	"cosmossdk.io/collections"
 	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 )
 
@@ -33,28 +39,159 @@ func (sp *StateProcessor[
 ]) processSlashingsReset(
 	st *statedb.StateDB,
 ) error {
-	// processSlashingsReset does not really do anything right now.
-	// However we cannot simply drop it because appHash accounts
-	// for the list of operations carried out over the state
-	// even if the operations does not affect the final state
-	// (currently no slashing on beaconKit)
+	// processSlashingsReset is only relevant once validators get slashed.
+	// However we cannot simply drop it on chains that always performed it,
+	// because appHash accounts for the list of operations carried out over
+	// the state even if the operations does not affect the final state.
 
 	slot, err := st.GetSlot()
 	if err != nil {
 		return err
 	}
 
+	index := (sp.cs.SlotToEpoch(slot).Unwrap() + 1) % sp.cs.EpochsPerSlashingsVector()
 	switch {
 	case sp.cs.DepositEth1ChainID() == spec.BartioChainID:
 		// go head doing the processing
 	case sp.cs.DepositEth1ChainID() == spec.BoonetEth1ChainID &&
 		slot < math.U64(spec.BoonetFork3Height):
 		// go head doing the processing
-	default:
-		// no real need to perform slashing reset
+	case sp.cs.SlotToEpoch(slot) < sp.cs.SlashingForkEpoch():
+		// no real need to perform slashing reset before validators can be
+		// slashed
 		return nil
+	default:
+		// only reset the slashings vector if there is something to reset,
+		// so that state is left untouched unless some validator got slashed.
+		var amount math.Gwei
+		amount, err = st.GetSlashingAtIndex(index)
+		if err != nil {
+			return err
+		}
+		if amount == 0 {
+			return nil
+		}
 	}
 
-	index := (sp.cs.SlotToEpoch(slot).Unwrap() + 1) % sp.cs.EpochsPerSlashingsVector()
 	return st.UpdateSlashingAtIndex(index, 0)
 }
+
+// processMisbehaviors slashes the validators whose misbehaviors have been
+// reported by consensus along with the block.
+func (sp *StateProcessor[
+	_, _,
+]) processMisbehaviors(
+	st *statedb.StateDB,
+	misbehaviors []*transition.Misbehavior,
+) error {
+	// Consensus evidence is ignored before the slashing fork.
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	if sp.cs.SlotToEpoch(slot) < sp.cs.SlashingForkEpoch() {
+		return nil
+	}
+
+	for _, m := range misbehaviors {
+		idx, err := st.ValidatorIndexByCometBFTAddress(m.Address)
+		if errors.Is(err, collections.ErrNotFound) {
+			// Consensus reported a validator we do not know of.
+			// Nothing we can slash.
+			log.WithContext(sp.logger, st.Context()).Warn(
+				"ignoring misbehavior of unknown validator",
+				"address", fmt.Sprintf("%X", m.Address),
+				"height", m.Height,
+			)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf(
+				"misbehaviors, failed loading validator index: %w", err,
+			)
+		}
+		if err = sp.processSlash(st, idx); err != nil {
+			return fmt.Errorf(
+				"misbehaviors, failed slashing validator idx %d: %w",
+				idx,
+				err,
+			)
+		}
+	}
+	return nil
+}
+
+// processSlash slashes the validator at the given index, as defined by
+// slash_validator in the Ethereum 2.0 specification, minus whistleblower
+// rewards which do not apply to consensus reported misbehaviors.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#slash_validator
+func (sp *StateProcessor[
+	_, _,
+]) processSlash(
+	st *statedb.StateDB,
+	idx math.ValidatorIndex,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+	if !val.IsSlashable(epoch) {
+		// The same misbehavior may be reported multiple times,
+		// or be reported after the validator stopped being slashable.
+		log.WithContext(sp.logger, st.Context()).Info(
+			"ignoring misbehavior of non slashable validator",
+			"validator_index", idx,
+		)
+		return nil
+	}
+
+	// We do not currently have a cap on validators churn, so we stop
+	// slashed validators next epoch, as for validators set cap ejections.
+	nextEpoch := epoch + 1
+	if val.GetExitEpoch() > nextEpoch {
+		val.SetExitEpoch(nextEpoch)
+		val.SetWithdrawableEpoch(nextEpoch + 1)
+	}
+	val.SetSlashed(true)
+	val.SetWithdrawableEpoch(max(
+		val.GetWithdrawableEpoch(),
+		epoch+math.Epoch(sp.cs.EpochsPerSlashingsVector()),
+	))
+	if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+		return err
+	}
+
+	slashingIndex := epoch.Unwrap() % sp.cs.EpochsPerSlashingsVector()
+	slashing, err := st.GetSlashingAtIndex(slashingIndex)
+	if err != nil {
+		return err
+	}
+	slashing, err = slashing.CheckedAdd(val.GetEffectiveBalance())
+	if err != nil {
+		return fmt.Errorf("slashings of epoch %d: %w", epoch, err)
+	}
+	if err = st.UpdateSlashingAtIndex(slashingIndex, slashing); err != nil {
+		return err
+	}
+
+	penalty := val.GetEffectiveBalance() /
+		math.Gwei(sp.cs.MinSlashingPenaltyQuotient())
+	if err = st.DecreaseBalance(idx, penalty); err != nil {
+		return err
+	}
+
+	log.WithContext(sp.logger, st.Context()).Info(
+		"Slashed validator",
+		"validator_index", idx,
+		"penalty", float64(penalty.Unwrap())/math.GweiPerWei,
+		"exit_epoch", val.GetExitEpoch(),
+		"withdrawable_epoch", val.GetWithdrawableEpoch(),
+	)
+	return sp.hooks.OnSlash(st.Context(), idx, val, penalty)
+}
@@ -0,0 +1,330 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/primitives/version"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// newSlashingForkSpec returns a chain spec slashing the validators reported
+// as misbehaving from the given epoch.
+func newSlashingForkSpec(
+	t *testing.T, forkEpoch math.Epoch,
+) chain.Spec[bytes.B4, math.U64, math.U64, any] {
+	t.Helper()
+	csData := spec.BaseSpec()
+	csData.DepositEth1ChainID = spec.BetnetEth1ChainID
+	csData.SlashingForkEpoch = forkEpoch
+	cs, err := chain.NewChainSpec(csData)
+	require.NoError(t, err)
+	return cs
+}
+
+// TestTransitionSlashValidator shows that a validator reported as
+// misbehaving by consensus is slashed and evicted at the next epoch turn.
+func TestTransitionSlashValidator(t *testing.T) {
+	cs := newSlashingForkSpec(t, 0)
+	sp, st, ds, ctx := setupState(t, cs)
+
+	hooks := &recordingHooks{}
+	sp.SetHooks(hooks)
+
+	var (
+		maxBalance       = math.Gwei(cs.MaxEffectiveBalance(false))
+		emptyCredentials = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{},
+		)
+	)
+
+	// STEP 0: Setup initial state via genesis
+	var (
+		genDeposits = types.Deposits{
+			{
+				Pubkey:      [48]byte{0x00},
+				Credentials: emptyCredentials,
+				Amount:      maxBalance,
+				Index:       uint64(0),
+			},
+			{
+				Pubkey:      [48]byte{0x01},
+				Credentials: emptyCredentials,
+				Amount:      maxBalance,
+				Index:       uint64(1),
+			},
+		}
+		genPayloadHeader = new(types.ExecutionPayloadHeader).Empty()
+		genVersion       = version.FromUint32[common.Version](version.Deneb)
+	)
+	require.NoError(t, ds.EnqueueDeposits(genDeposits))
+	_, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		genDeposits,
+		genPayloadHeader,
+		genVersion,
+	)
+	require.NoError(t, err)
+
+	// STEP 1: consensus reports validator 1 (twice) and an unknown validator
+	slashedPk := genDeposits[1].Pubkey
+	slashedAddr := cmtcrypto.AddressHash(slashedPk[:]).Bytes()
+	ctx.Misbehaviors = []*transition.Misbehavior{
+		{Address: slashedAddr, Height: 1},
+		{Address: slashedAddr, Height: 1},
+		{Address: []byte{0xff}, Height: 1},
+	}
+
+	eth1Data := newEth1Data(genDeposits)
+	blk1 := buildNextBlock(
+		t,
+		st,
+		&types.BeaconBlockBody{
+			ExecutionPayload: &types.ExecutionPayload{
+				Timestamp:    10,
+				ExtraData:    []byte("testing"),
+				Transactions: [][]byte{},
+				Withdrawals: []*engineprimitives.Withdrawal{
+					st.EVMInflationWithdrawal(),
+				},
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: eth1Data,
+			Deposits: []*types.Deposit{},
+		},
+	)
+	valDiff, err := sp.Transition(ctx, st, blk1)
+	require.NoError(t, err)
+	require.Empty(t, valDiff) // validators set updates only at epoch turn
+	ctx.Misbehaviors = nil
+
+	// check the validator is slashed just once
+	idx, err := st.ValidatorIndexByPubkey(slashedPk)
+	require.NoError(t, err)
+	require.Equal(t, []math.ValidatorIndex{idx}, hooks.slashings)
+
+	val, err := st.ValidatorByIndex(idx)
+	require.NoError(t, err)
+	require.True(t, val.IsSlashed())
+	require.Equal(t, math.Epoch(1), val.GetExitEpoch())
+	require.Equal(
+		t,
+		math.Epoch(cs.EpochsPerSlashingsVector()),
+		val.GetWithdrawableEpoch(),
+	)
+
+	penalty := maxBalance / math.Gwei(cs.MinSlashingPenaltyQuotient())
+	balance, err := st.GetBalance(idx)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance-penalty, balance)
+
+	slashing, err := st.GetSlashingAtIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance, slashing)
+	totalSlashing, err := st.GetTotalSlashing()
+	require.NoError(t, err)
+	require.Equal(t, maxBalance, totalSlashing)
+
+	// STEP 2: check that the validator is evicted once next epoch arrives
+	blk := moveToEndOfEpoch(t, blk1, cs, sp, st, ctx, eth1Data)
+	blk = buildNextBlock(
+		t,
+		st,
+		&types.BeaconBlockBody{
+			ExecutionPayload: &types.ExecutionPayload{
+				Timestamp:    blk.Body.ExecutionPayload.Timestamp + 1,
+				ExtraData:    []byte("testing"),
+				Transactions: [][]byte{},
+				Withdrawals: []*engineprimitives.Withdrawal{
+					st.EVMInflationWithdrawal(),
+				},
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: eth1Data,
+			Deposits: []*types.Deposit{},
+		},
+	)
+	valDiff, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		transition.ValidatorUpdates{
+			&transition.ValidatorUpdate{
+				Pubkey:           slashedPk,
+				EffectiveBalance: 0,
+			},
+		},
+		valDiff,
+	)
+}
+
+// TestTransitionSlashingFork shows that consensus evidence is ignored, and
+// the slashings vector left untouched, until the slashing fork.
+func TestTransitionSlashingFork(t *testing.T) {
+	cs := newSlashingForkSpec(t, 1)
+	sp, st, ds, ctx := setupState(t, cs)
+
+	hooks := &recordingHooks{}
+	sp.SetHooks(hooks)
+
+	var (
+		maxBalance  = math.Gwei(cs.MaxEffectiveBalance(false))
+		credentials = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{},
+		)
+		genDeposits = types.Deposits{
+			{
+				Pubkey:      [48]byte{0x00},
+				Credentials: credentials,
+				Amount:      maxBalance,
+				Index:       uint64(0),
+			},
+			{
+				Pubkey:      [48]byte{0x01},
+				Credentials: credentials,
+				Amount:      maxBalance,
+				Index:       uint64(1),
+			},
+		}
+		eth1Data = newEth1Data(genDeposits)
+	)
+	require.NoError(t, ds.EnqueueDeposits(genDeposits))
+	_, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		genDeposits,
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+
+	slashedPk := genDeposits[1].Pubkey
+	misbehaviors := []*transition.Misbehavior{{
+		Address: cmtcrypto.AddressHash(slashedPk[:]).Bytes(),
+		Height:  1,
+	}}
+	idx, err := st.ValidatorIndexByPubkey(slashedPk)
+	require.NoError(t, err)
+	nextBlock := func(timestamp math.U64) *types.BeaconBlock {
+		return buildNextBlock(
+			t,
+			st,
+			&types.BeaconBlockBody{
+				ExecutionPayload: &types.ExecutionPayload{
+					Timestamp:    timestamp,
+					ExtraData:    []byte("testing"),
+					Transactions: [][]byte{},
+					Withdrawals: []*engineprimitives.Withdrawal{
+						st.EVMInflationWithdrawal(),
+					},
+					BaseFeePerGas: math.NewU256(0),
+				},
+				Eth1Data: eth1Data,
+				Deposits: []*types.Deposit{},
+			},
+		)
+	}
+
+	// Before the fork, the reported validator is not slashed.
+	ctx.Misbehaviors = misbehaviors
+	blk := nextBlock(10)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	ctx.Misbehaviors = nil
+	require.Empty(t, hooks.slashings)
+	val, err := st.ValidatorByIndex(idx)
+	require.NoError(t, err)
+	require.False(t, val.IsSlashed())
+	balance, err := st.GetBalance(idx)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance, balance)
+
+	blk = moveToEndOfEpoch(t, blk, cs, sp, st, ctx, eth1Data)
+	require.Equal(t, math.Epoch(0), cs.SlotToEpoch(blk.GetSlot()))
+
+	// From the fork, it is.
+	ctx.Misbehaviors = misbehaviors
+	blk = nextBlock(blk.Body.ExecutionPayload.Timestamp + 1)
+	require.Equal(t, math.Epoch(1), cs.SlotToEpoch(blk.GetSlot()))
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	ctx.Misbehaviors = nil
+	require.Equal(t, []math.ValidatorIndex{idx}, hooks.slashings)
+	val, err = st.ValidatorByIndex(idx)
+	require.NoError(t, err)
+	require.True(t, val.IsSlashed())
+
+	slashing, err := st.GetSlashingAtIndex(
+		1 % cs.EpochsPerSlashingsVector(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance, slashing)
+}
+
+// TestBalanceArithmeticChecked shows that balances overflowing are rejected
+// rather than wrapped around, while decreasing balances floors them at zero.
+func TestBalanceArithmeticChecked(t *testing.T) {
+	cs := setupChain(t, components.BetnetChainSpecType)
+	sp, st, ds, _ := setupState(t, cs)
+
+	var (
+		maxBalance  = math.Gwei(cs.MaxEffectiveBalance(false))
+		genDeposits = types.Deposits{
+			{
+				Pubkey: [48]byte{0x00},
+				Credentials: types.NewCredentialsFromExecutionAddress(
+					common.ExecutionAddress{},
+				),
+				Amount: maxBalance,
+				Index:  uint64(0),
+			},
+		}
+	)
+	require.NoError(t, ds.EnqueueDeposits(genDeposits))
+	_, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		genDeposits,
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+
+	err = st.IncreaseBalance(0, ^math.Gwei(0))
+	require.ErrorIs(t, err, math.ErrOverflow)
+	balance, err := st.GetBalance(0)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance, balance)
+
+	require.NoError(t, st.DecreaseBalance(0, maxBalance+1))
+	balance, err = st.GetBalance(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(0), balance)
+}
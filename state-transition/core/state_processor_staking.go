@@ -21,12 +21,15 @@
 package core
 
 import (
+	"context"
+
+	"cosmossdk.io/collections"
 	"github.com/berachain/beacon-kit/config/spec"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
-	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/berachain/beacon-kit/state-transition/core/state"
 )
 
@@ -53,7 +56,7 @@ func (sp *StateProcessor[
 		)
 	}
 	if err := sp.validateNonGenesisDeposits(
-		st, deposits, blk.GetBody().GetEth1Data().DepositRoot,
+		st, deposits, blk.GetBody().GetEth1Data(),
 	); err != nil {
 		return err
 	}
@@ -62,6 +65,11 @@ func (sp *StateProcessor[
 			return err
 		}
 	}
+	eth1DepositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return err
+	}
+	sp.metrics.gaugeDeposits(len(deposits), eth1DepositIndex)
 	return st.SetEth1Data(blk.GetBody().Eth1Data)
 }
 
@@ -81,7 +89,7 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	sp.logger.Info(
+	log.WithContext(sp.logger, st.Context()).Info(
 		"Processed deposit to set Eth 1 deposit index",
 		"previous", eth1DepositIndex, "new", eth1DepositIndex+1,
 	)
@@ -96,23 +104,32 @@ func (sp *StateProcessor[
 	st *state.StateDB,
 	dep *ctypes.Deposit,
 ) error {
-	idx, err := st.ValidatorIndexByPubkey(dep.GetPubkey())
+	slot, err := st.GetSlot()
 	if err != nil {
+		return err
+	}
+	idx, err := st.ValidatorIndexByPubkey(dep.GetPubkey())
+	if errors.Is(err, collections.ErrNotFound) {
 		// If the validator does not exist, we add the validator.
-		// TODO: improve error handling by distinguishing
-		// ErrNotFound from other kind of errors
+		if sp.cs.SlotToEpoch(slot) >= sp.cs.DepositBoundsForkEpoch() &&
+			!sp.isDepositAboveMin(st.Context(), dep) {
+			return nil
+		}
 		return sp.createValidator(st, dep)
 	}
+	if err != nil {
+		return err
+	}
+
+	// The validator already exists, so this is a top-up which credits
+	// the validator balance rather than creating a new validator. Top-ups
+	// are exempt from the min deposit amount, and the balance they push
+	// beyond the max effective balance is withdrawn by the sweep.
 
-	// The validator already exist and we need to update its balance.
 	// EffectiveBalance must be updated in processEffectiveBalanceUpdates
 	// However before BoonetFork2Height we mistakenly update EffectiveBalance
 	// every slot. We must preserve backward compatibility so we special case
 	// Boonet to allow proper bootstrapping.
-	slot, err := st.GetSlot()
-	if err != nil {
-		return err
-	}
 	if sp.cs.DepositEth1ChainID() == spec.BoonetEth1ChainID &&
 		slot < math.U64(spec.BoonetFork2Height) {
 		var val *ctypes.Validator
@@ -137,12 +154,29 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	sp.logger.Info(
+	log.WithContext(sp.logger, st.Context()).Info(
 		"Processed deposit to increase balance",
 		"deposit_amount", float64(dep.GetAmount().Unwrap())/math.GweiPerWei,
 		"validator_index", idx,
 	)
-	return nil
+	return sp.hooks.OnDeposit(st.Context(), idx, dep)
+}
+
+// isDepositAboveMin returns whether the deposit amount creating a validator
+// is at least MinDepositAmount. Deposits below it are ignored.
+func (sp *StateProcessor[
+	_, _,
+]) isDepositAboveMin(ctx context.Context, dep *ctypes.Deposit) bool {
+	amount := dep.GetAmount()
+	if amount >= math.Gwei(sp.cs.MinDepositAmount()) {
+		return true
+	}
+	log.WithContext(sp.logger, ctx).Info(
+		"ignoring deposit below min deposit amount",
+		"deposit_index", dep.GetIndex(),
+		"deposit_amount", float64(amount.Unwrap())/math.GweiPerWei,
+	)
+	return false
 }
 
 // createValidator creates a validator if the deposit is valid.
@@ -174,7 +208,7 @@ func (sp *StateProcessor[
 	// Verify that the deposit has the ETH1 withdrawal credentials.
 	if !dep.HasEth1WithdrawalCredentials() {
 		// Ignore deposits with non-ETH1 withdrawal credentials.
-		sp.logger.Info(
+		log.WithContext(sp.logger, st.Context()).Info(
 			"ignoring deposit with non-ETH1 withdrawal credentials",
 			"deposit_index", dep.GetIndex(),
 		)
@@ -183,17 +217,13 @@ func (sp *StateProcessor[
 
 	// Verify that the message was signed correctly.
 	err = dep.VerifySignature(
-		ctypes.NewForkData(
-			version.FromUint32[common.Version](
-				sp.cs.ActiveForkVersionForEpoch(epoch),
-			), genesisValidatorsRoot,
-		),
+		ctypes.NewForkDataAtEpoch(sp.cs, epoch, genesisValidatorsRoot),
 		sp.cs.DomainTypeDeposit(),
-		sp.signer.VerifySignature,
+		sp.verifySignature,
 	)
 	if err != nil {
 		// Ignore deposits that fail the signature check.
-		sp.logger.Info(
+		log.WithContext(sp.logger, st.Context()).Info(
 			"failed deposit signature verification",
 			"deposit_index", dep.GetIndex(),
 			"error", err,
@@ -228,7 +258,14 @@ func (sp *StateProcessor[
 	if sp.cs.DepositEth1ChainID() == spec.BartioChainID {
 		// Note in AddValidatorBartio we implicitly increase
 		// the balance from state st. This is unlike AddValidator.
-		return st.AddValidatorBartio(val)
+		if err := st.AddValidatorBartio(val); err != nil {
+			return err
+		}
+		idx, err := st.ValidatorIndexByPubkey(val.GetPubkey())
+		if err != nil {
+			return err
+		}
+		return sp.hooks.OnDeposit(st.Context(), idx, dep)
 	}
 
 	if err := st.AddValidator(val); err != nil {
@@ -241,10 +278,10 @@ func (sp *StateProcessor[
 	if err = st.IncreaseBalance(idx, dep.GetAmount()); err != nil {
 		return err
 	}
-	sp.logger.Info(
+	log.WithContext(sp.logger, st.Context()).Info(
 		"Processed deposit to create new validator",
 		"deposit_amount", float64(dep.GetAmount().Unwrap())/math.GweiPerWei,
 		"validator_index", idx, "withdrawal_epoch", val.GetWithdrawableEpoch(),
 	)
-	return nil
+	return sp.hooks.OnDeposit(st.Context(), idx, dep)
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	"github.com/berachain/beacon-kit/primitives/version"
@@ -95,7 +96,7 @@ func TestTransitionUpdateValidators(t *testing.T) {
 		Index:       uint64(len(genDeposits)),
 	}
 
-	depRoot := append(genDeposits, blkDeposit).HashTreeRoot()
+	eth1Data := newEth1Data(append(genDeposits, blkDeposit))
 	blk1 := buildNextBlock(
 		t,
 		st,
@@ -109,7 +110,7 @@ func TestTransitionUpdateValidators(t *testing.T) {
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
-			Eth1Data: &types.Eth1Data{DepositRoot: depRoot},
+			Eth1Data: eth1Data,
 			Deposits: []*types.Deposit{blkDeposit},
 		},
 	)
@@ -144,7 +145,7 @@ func TestTransitionUpdateValidators(t *testing.T) {
 	require.Equal(t, uint64(len(genDeposits)+1), latestValIdx)
 
 	// STEP 2: check that effective balance is updated once next epoch arrives
-	blk := moveToEndOfEpoch(t, blk1, cs, sp, st, ctx, depRoot)
+	blk := moveToEndOfEpoch(t, blk1, cs, sp, st, ctx, eth1Data)
 
 	// finally the block turning epoch
 	blk = buildNextBlock(
@@ -160,7 +161,7 @@ func TestTransitionUpdateValidators(t *testing.T) {
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
-			Eth1Data: &types.Eth1Data{DepositRoot: depRoot},
+			Eth1Data: eth1Data,
 			Deposits: []*types.Deposit{},
 		},
 	)
@@ -236,7 +237,7 @@ func TestTransitionCreateValidator(t *testing.T) {
 		Index:       uint64(len(genDeposits)),
 	}
 
-	depRoot := append(genDeposits, blkDeposit).HashTreeRoot()
+	eth1Data := newEth1Data(append(genDeposits, blkDeposit))
 	blk1 := buildNextBlock(
 		t,
 		st,
@@ -250,7 +251,7 @@ func TestTransitionCreateValidator(t *testing.T) {
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
-			Eth1Data: &types.Eth1Data{DepositRoot: depRoot},
+			Eth1Data: eth1Data,
 			Deposits: []*types.Deposit{blkDeposit},
 		},
 	)
@@ -286,7 +287,7 @@ func TestTransitionCreateValidator(t *testing.T) {
 
 	// STEP 2: move the chain to the next epoch and show that
 	// the extra validator is eligible for activation
-	blk := moveToEndOfEpoch(t, blk1, cs, sp, st, ctx, depRoot)
+	blk := moveToEndOfEpoch(t, blk1, cs, sp, st, ctx, eth1Data)
 
 	// finally the block turning epoch
 	blk = buildNextBlock(
@@ -302,7 +303,7 @@ func TestTransitionCreateValidator(t *testing.T) {
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
-			Eth1Data: &types.Eth1Data{DepositRoot: depRoot},
+			Eth1Data: eth1Data,
 			Deposits: []*types.Deposit{},
 		},
 	)
@@ -330,7 +331,7 @@ func TestTransitionCreateValidator(t *testing.T) {
 
 	// STEP 3: move the chain to the next epoch and show that
 	// the extra validator is activate
-	_ = moveToEndOfEpoch(t, blk, cs, sp, st, ctx, depRoot)
+	_ = moveToEndOfEpoch(t, blk, cs, sp, st, ctx, eth1Data)
 
 	// finally the block turning epoch
 	blk = buildNextBlock(
@@ -346,7 +347,7 @@ func TestTransitionCreateValidator(t *testing.T) {
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
-			Eth1Data: &types.Eth1Data{DepositRoot: depRoot},
+			Eth1Data: eth1Data,
 			Deposits: []*types.Deposit{},
 		},
 	)
@@ -532,7 +533,7 @@ func TestTransitionMaxWithdrawals(t *testing.T) {
 	require.Equal(t, maxBalance+minBalance, val1Bal)
 
 	// Create test inputs.
-	depRoot := genDeposits.HashTreeRoot()
+	eth1Data := newEth1Data(genDeposits)
 	blk := buildNextBlock(
 		t,
 		st,
@@ -554,7 +555,7 @@ func TestTransitionMaxWithdrawals(t *testing.T) {
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
-			Eth1Data: &types.Eth1Data{DepositRoot: depRoot},
+			Eth1Data: eth1Data,
 			Deposits: []*types.Deposit{},
 		},
 	)
@@ -600,7 +601,7 @@ func TestTransitionMaxWithdrawals(t *testing.T) {
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
-			Eth1Data: &types.Eth1Data{DepositRoot: depRoot},
+			Eth1Data: eth1Data,
 			Deposits: []*types.Deposit{},
 		},
 	)
@@ -682,7 +683,7 @@ func TestTransitionHittingValidatorsCap_ExtraSmall(t *testing.T) {
 		}
 	)
 
-	depRoot := append(genDeposits, extraValDeposit).HashTreeRoot()
+	eth1Data := newEth1Data(append(genDeposits, extraValDeposit))
 	blk1 := buildNextBlock(
 		t,
 		st,
@@ -696,7 +697,7 @@ func TestTransitionHittingValidatorsCap_ExtraSmall(t *testing.T) {
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
-			Eth1Data: &types.Eth1Data{DepositRoot: depRoot},
+			Eth1Data: eth1Data,
 			Deposits: []*types.Deposit{extraValDeposit},
 		},
 	)
@@ -732,7 +733,7 @@ func TestTransitionHittingValidatorsCap_ExtraSmall(t *testing.T) {
 
 	// STEP 2: move the chain to the next epoch and show that
 	// the extra validator is eligible for activation
-	_ = moveToEndOfEpoch(t, blk1, cs, sp, st, ctx, depRoot)
+	_ = moveToEndOfEpoch(t, blk1, cs, sp, st, ctx, eth1Data)
 
 	// finally the block turning epoch
 	blk := buildNextBlock(
@@ -748,7 +749,7 @@ func TestTransitionHittingValidatorsCap_ExtraSmall(t *testing.T) {
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
-			Eth1Data: &types.Eth1Data{DepositRoot: depRoot},
+			Eth1Data: eth1Data,
 			Deposits: []*types.Deposit{},
 		},
 	)
@@ -777,7 +778,7 @@ func TestTransitionHittingValidatorsCap_ExtraSmall(t *testing.T) {
 	// STEP 3: move the chain to the next epoch and show that the extra
 	// validator
 	// is activate and immediately marked for exit
-	_ = moveToEndOfEpoch(t, blk, cs, sp, st, ctx, depRoot)
+	_ = moveToEndOfEpoch(t, blk, cs, sp, st, ctx, eth1Data)
 
 	// finally the block turning epoch
 	blk = buildNextBlock(
@@ -793,7 +794,7 @@ func TestTransitionHittingValidatorsCap_ExtraSmall(t *testing.T) {
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
-			Eth1Data: &types.Eth1Data{DepositRoot: depRoot},
+			Eth1Data: eth1Data,
 			Deposits: []*types.Deposit{},
 		},
 	)
@@ -812,7 +813,7 @@ func TestTransitionHittingValidatorsCap_ExtraSmall(t *testing.T) {
 
 	// STEP 4: move the chain to the next epoch and show withdrawals
 	// for rejected validator are enqueued then
-	_ = moveToEndOfEpoch(t, blk, cs, sp, st, ctx, depRoot)
+	_ = moveToEndOfEpoch(t, blk, cs, sp, st, ctx, eth1Data)
 
 	// finally the block turning epoch
 	extraValAddr, err := extraValCreds.ToExecutionAddress()
@@ -836,7 +837,7 @@ func TestTransitionHittingValidatorsCap_ExtraSmall(t *testing.T) {
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
-			Eth1Data: &types.Eth1Data{DepositRoot: depRoot},
+			Eth1Data: eth1Data,
 			Deposits: []*types.Deposit{},
 		},
 	)
@@ -915,7 +916,7 @@ func TestTransitionHittingValidatorsCap_ExtraBig(t *testing.T) {
 		}
 	)
 
-	depRoot := append(genDeposits, extraValDeposit).HashTreeRoot()
+	eth1Data := newEth1Data(append(genDeposits, extraValDeposit))
 	blk1 := buildNextBlock(
 		t,
 		st,
@@ -929,7 +930,7 @@ func TestTransitionHittingValidatorsCap_ExtraBig(t *testing.T) {
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
-			Eth1Data: &types.Eth1Data{DepositRoot: depRoot},
+			Eth1Data: eth1Data,
 			Deposits: []*types.Deposit{extraValDeposit},
 		},
 	)
@@ -982,7 +983,7 @@ func TestTransitionHittingValidatorsCap_ExtraBig(t *testing.T) {
 
 	// STEP 2: move the chain to the next epoch and show that
 	// the extra validator is eligible for activation
-	_ = moveToEndOfEpoch(t, blk1, cs, sp, st, ctx, depRoot)
+	_ = moveToEndOfEpoch(t, blk1, cs, sp, st, ctx, eth1Data)
 
 	// finally the block turning epoch
 	blk := buildNextBlock(
@@ -998,7 +999,7 @@ func TestTransitionHittingValidatorsCap_ExtraBig(t *testing.T) {
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
-			Eth1Data: &types.Eth1Data{DepositRoot: depRoot},
+			Eth1Data: eth1Data,
 			Deposits: []*types.Deposit{},
 		},
 	)
@@ -1042,7 +1043,7 @@ func TestTransitionHittingValidatorsCap_ExtraBig(t *testing.T) {
 	// STEP 3: move the chain to the next epoch and show that the extra
 	// validator
 	// is activate and genesis validator immediately marked for exit
-	_ = moveToEndOfEpoch(t, blk, cs, sp, st, ctx, depRoot)
+	_ = moveToEndOfEpoch(t, blk, cs, sp, st, ctx, eth1Data)
 
 	// finally the block turning epoch
 	blk = buildNextBlock(
@@ -1058,7 +1059,7 @@ func TestTransitionHittingValidatorsCap_ExtraBig(t *testing.T) {
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
-			Eth1Data: &types.Eth1Data{DepositRoot: depRoot},
+			Eth1Data: eth1Data,
 			Deposits: []*types.Deposit{},
 		},
 	)
@@ -1104,7 +1105,7 @@ func TestTransitionHittingValidatorsCap_ExtraBig(t *testing.T) {
 
 	// STEP 4: move the chain to the next epoch and show withdrawal
 	// for rejected validator is enqueued
-	_ = moveToEndOfEpoch(t, blk, cs, sp, st, ctx, depRoot)
+	_ = moveToEndOfEpoch(t, blk, cs, sp, st, ctx, eth1Data)
 
 	valToEvict := genDeposits[0]
 	valToEvictAddr, err := valToEvict.Credentials.ToExecutionAddress()
@@ -1130,7 +1131,7 @@ func TestTransitionHittingValidatorsCap_ExtraBig(t *testing.T) {
 				},
 				BaseFeePerGas: math.NewU256(0),
 			},
-			Eth1Data: &types.Eth1Data{DepositRoot: depRoot},
+			Eth1Data: eth1Data,
 			Deposits: []*types.Deposit{},
 		},
 	)
@@ -1139,3 +1140,286 @@ func TestTransitionHittingValidatorsCap_ExtraBig(t *testing.T) {
 	_, err = sp.Transition(ctx, st, blk)
 	require.NoError(t, err)
 }
+
+// TestTransitionDepositBounds shows that, from the deposit bounds fork,
+// deposits creating a validator below the min deposit amount are ignored,
+// while top-ups are exempt from it and credited in full, the balance beyond
+// the max effective balance being withdrawn.
+func TestTransitionDepositBounds(t *testing.T) {
+	cs := setupChain(t, components.BetnetChainSpecType)
+	var (
+		maxBalance = math.Gwei(cs.MaxEffectiveBalance(false))
+		increment  = math.Gwei(cs.EffectiveBalanceIncrement())
+		minDeposit = math.Gwei(cs.MinDepositAmount())
+
+		genPubkey = crypto.BLSPubkey{0x00}
+		newPubkey = crypto.BLSPubkey{0x01}
+	)
+
+	tests := []struct {
+		name       string
+		forkEpoch  math.Epoch
+		pubkey     crypto.BLSPubkey
+		amount     math.Gwei
+		validators int
+		balance    math.Gwei
+		withdrawn  math.Gwei
+	}{
+		{
+			name:       "below min creating a validator is ignored",
+			pubkey:     newPubkey,
+			amount:     minDeposit - 1,
+			validators: 1,
+			balance:    maxBalance,
+		},
+		{
+			name:       "below min before the fork creates a validator",
+			forkEpoch:  1,
+			pubkey:     newPubkey,
+			amount:     minDeposit - 1,
+			validators: 2,
+			balance:    maxBalance,
+		},
+		{
+			name:       "top-up below min is credited",
+			pubkey:     genPubkey,
+			amount:     minDeposit - 1,
+			validators: 1,
+			balance:    maxBalance + minDeposit - 1,
+			withdrawn:  minDeposit - 1,
+		},
+		{
+			name:       "top-up above max effective balance is withdrawn",
+			pubkey:     genPubkey,
+			amount:     2 * increment,
+			validators: 1,
+			balance:    maxBalance + 2*increment,
+			withdrawn:  2 * increment,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs := newDepositBoundsForkSpec(t, tt.forkEpoch)
+			sp, st, ds, ctx := setupState(t, cs)
+
+			credentials := types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{0x01},
+			)
+
+			// STEP 0: Setup initial state via genesis
+			var (
+				genDeposits = types.Deposits{
+					{
+						Pubkey:      genPubkey,
+						Credentials: credentials,
+						Amount:      maxBalance,
+						Index:       uint64(0),
+					},
+				}
+				genPayloadHeader = new(types.ExecutionPayloadHeader).Empty()
+				genVersion       = version.FromUint32[common.Version](
+					version.Deneb,
+				)
+			)
+			require.NoError(t, ds.EnqueueDeposits(genDeposits))
+			_, err := sp.InitializePreminedBeaconStateFromEth1(
+				st,
+				genDeposits,
+				genPayloadHeader,
+				genVersion,
+			)
+			require.NoError(t, err)
+
+			// STEP 1: process the deposit
+			blkDeposits := types.Deposits{
+				{
+					Pubkey:      tt.pubkey,
+					Credentials: credentials,
+					Amount:      tt.amount,
+					Index:       uint64(1),
+				},
+			}
+			eth1Data := newEth1Data(append(genDeposits, blkDeposits...))
+			blk1 := buildNextBlock(
+				t,
+				st,
+				&types.BeaconBlockBody{
+					ExecutionPayload: &types.ExecutionPayload{
+						Timestamp:    10,
+						ExtraData:    []byte("testing"),
+						Transactions: [][]byte{},
+						Withdrawals: []*engineprimitives.Withdrawal{
+							st.EVMInflationWithdrawal(),
+						},
+						BaseFeePerGas: math.NewU256(0),
+					},
+					Eth1Data: eth1Data,
+					Deposits: blkDeposits,
+				},
+			)
+			require.NoError(t, ds.EnqueueDeposits(blk1.Body.Deposits))
+			_, err = sp.Transition(ctx, st, blk1)
+			require.NoError(t, err)
+
+			// check that the deposit is consumed in any case
+			depIdx, err := st.GetEth1DepositIndex()
+			require.NoError(t, err)
+			require.Equal(t, uint64(2), depIdx)
+
+			vals, err := st.GetValidators()
+			require.NoError(t, err)
+			require.Len(t, vals, tt.validators)
+
+			idx, err := st.ValidatorIndexByPubkey(genPubkey)
+			require.NoError(t, err)
+			balance, err := st.GetBalance(idx)
+			require.NoError(t, err)
+			require.Equal(t, tt.balance, balance)
+
+			// check that the balance beyond the max effective balance is
+			// withdrawn by the sweep.
+			withdrawals, err := st.ExpectedWithdrawals()
+			require.NoError(t, err)
+			var withdrawn math.Gwei
+			for _, w := range withdrawals[1:] {
+				if w.GetValidatorIndex() == idx {
+					withdrawn += w.GetAmount()
+				}
+			}
+			require.Equal(t, tt.withdrawn, withdrawn)
+		})
+	}
+}
+
+// TestTransitionDepositBoundsFork shows that a deposit below the min deposit
+// amount creates a validator up to the deposit bounds fork and is ignored
+// from it on the same chain.
+func TestTransitionDepositBoundsFork(t *testing.T) {
+	cs := newDepositBoundsForkSpec(t, 1)
+	sp, st, ds, ctx := setupState(t, cs)
+
+	var (
+		maxBalance  = math.Gwei(cs.MaxEffectiveBalance(false))
+		belowMin    = math.Gwei(cs.MinDepositAmount()) - 1
+		credentials = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{0x01},
+		)
+	)
+
+	// STEP 0: Setup initial state via genesis
+	var (
+		genDeposits = types.Deposits{
+			{
+				Pubkey:      crypto.BLSPubkey{0x00},
+				Credentials: credentials,
+				Amount:      maxBalance,
+				Index:       uint64(0),
+			},
+		}
+		genPayloadHeader = new(types.ExecutionPayloadHeader).Empty()
+		genVersion       = version.FromUint32[common.Version](version.Deneb)
+	)
+	require.NoError(t, ds.EnqueueDeposits(genDeposits))
+	_, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		genDeposits,
+		genPayloadHeader,
+		genVersion,
+	)
+	require.NoError(t, err)
+
+	// STEP 1: before the fork, a deposit below min creates a validator
+	preForkDeposits := types.Deposits{
+		{
+			Pubkey:      crypto.BLSPubkey{0x01},
+			Credentials: credentials,
+			Amount:      belowMin,
+			Index:       uint64(1),
+		},
+	}
+	deposits := append(genDeposits, preForkDeposits...)
+	eth1Data := newEth1Data(deposits)
+	blk1 := buildNextBlock(
+		t,
+		st,
+		&types.BeaconBlockBody{
+			ExecutionPayload: &types.ExecutionPayload{
+				Timestamp:    10,
+				ExtraData:    []byte("testing"),
+				Transactions: [][]byte{},
+				Withdrawals: []*engineprimitives.Withdrawal{
+					st.EVMInflationWithdrawal(),
+				},
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: eth1Data,
+			Deposits: preForkDeposits,
+		},
+	)
+	require.NoError(t, ds.EnqueueDeposits(blk1.Body.Deposits))
+	_, err = sp.Transition(ctx, st, blk1)
+	require.NoError(t, err)
+
+	vals, err := st.GetValidators()
+	require.NoError(t, err)
+	require.Len(t, vals, 2)
+
+	// STEP 2: move to the fork epoch
+	blk := moveToEndOfEpoch(t, blk1, cs, sp, st, ctx, eth1Data)
+
+	// STEP 3: from the fork, a deposit below min is ignored
+	postForkDeposits := types.Deposits{
+		{
+			Pubkey:      crypto.BLSPubkey{0x02},
+			Credentials: credentials,
+			Amount:      belowMin,
+			Index:       uint64(2),
+		},
+	}
+	deposits = append(deposits, postForkDeposits...)
+	blk = buildNextBlock(
+		t,
+		st,
+		&types.BeaconBlockBody{
+			ExecutionPayload: &types.ExecutionPayload{
+				Timestamp:    blk.Body.ExecutionPayload.Timestamp + 1,
+				ExtraData:    []byte("testing"),
+				Transactions: [][]byte{},
+				Withdrawals: []*engineprimitives.Withdrawal{
+					st.EVMInflationWithdrawal(),
+				},
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: newEth1Data(deposits),
+			Deposits: postForkDeposits,
+		},
+	)
+	require.Equal(t, math.Epoch(1), cs.SlotToEpoch(blk.GetSlot()))
+	require.NoError(t, ds.EnqueueDeposits(blk.Body.Deposits))
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	// check that the deposit is consumed but no validator is created
+	depIdx, err := st.GetEth1DepositIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), depIdx)
+
+	vals, err = st.GetValidators()
+	require.NoError(t, err)
+	require.Len(t, vals, 2)
+}
+
+// newDepositBoundsForkSpec returns a chain spec enforcing the min deposit
+// amount from forkEpoch.
+func newDepositBoundsForkSpec(
+	t *testing.T,
+	forkEpoch math.Epoch,
+) chain.Spec[bytes.B4, math.U64, math.U64, any] {
+	t.Helper()
+	csData := spec.BaseSpec()
+	csData.DepositEth1ChainID = spec.BetnetEth1ChainID
+	csData.DepositBoundsForkEpoch = forkEpoch
+	cs, err := chain.NewChainSpec(csData)
+	require.NoError(t, err)
+	return cs
+}
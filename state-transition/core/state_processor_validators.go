@@ -29,12 +29,21 @@ import (
 	"github.com/berachain/beacon-kit/config/spec"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 	"github.com/sourcegraph/conc/iter"
 )
 
+// pendingValidator is a validator modified while iterating over the
+// registry, written back to the state once the iteration is over.
+type pendingValidator struct {
+	idx       math.ValidatorIndex
+	val       *ctypes.Validator
+	activated bool
+}
+
 func (sp *StateProcessor[
 	_, _,
 ]) processRegistryUpdates(
@@ -57,11 +66,6 @@ func (sp *StateProcessor[
 		// processing below
 	}
 
-	vals, err := st.GetValidators()
-	if err != nil {
-		return fmt.Errorf("registry update, failed listing validators: %w", err)
-	}
-
 	currEpoch := sp.cs.SlotToEpoch(slot)
 	nextEpoch := currEpoch + 1
 
@@ -70,38 +74,56 @@ func (sp *StateProcessor[
 	)
 
 	// We do not currently have a cap on validator churn,
-	// so we can process validators activations in a single loop
-	var idx math.ValidatorIndex
-	for si, val := range vals {
-		valModified := false
-		if val.IsEligibleForActivationQueue(minEffectiveBalance) {
-			val.SetActivationEligibilityEpoch(nextEpoch)
-			valModified = true
-		}
-		if val.IsEligibleForActivation(currEpoch) {
-			val.SetActivationEpoch(nextEpoch)
-			valModified = true
-		}
-		// Note: without slashing and voluntary withdrawals, there is no way
-		// for an activa validator to have its balance less or equal to
-		// EjectionBalance
-
-		if valModified {
-			idx, err = st.ValidatorIndexByPubkey(val.GetPubkey())
-			if err != nil {
-				return fmt.Errorf(
-					"registry update, failed loading validator index, state index %d: %w",
-					si,
-					err,
-				)
+	// so we can process validators activations in a single loop.
+	// The state cannot be written to while iterating over the registry, so
+	// the modified validators are updated once it has been walked.
+	var modified []pendingValidator
+	err = st.IterateValidators(
+		func(idx math.ValidatorIndex, val *ctypes.Validator) (bool, error) {
+			valModified, valActivated := false, false
+			if val.IsEligibleForActivationQueue(minEffectiveBalance) {
+				val.SetActivationEligibilityEpoch(nextEpoch)
+				valModified = true
 			}
-			if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
-				return fmt.Errorf(
-					"registry update, failed updating validator idx %d: %w",
-					idx,
-					err,
-				)
+			if val.IsEligibleForActivation(currEpoch) {
+				val.SetActivationEpoch(nextEpoch)
+				valModified, valActivated = true, true
 			}
+			// Note: validators whose balance drops to EjectionBalance or
+			// below are ejected in processEjections, once effective
+			// balances are updated.
+
+			if valModified {
+				modified = append(modified, pendingValidator{
+					idx: idx, val: val, activated: valActivated,
+				})
+			}
+			return false, nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("registry update, failed listing validators: %w", err)
+	}
+
+	for _, p := range modified {
+		if err = st.UpdateValidatorAtIndex(p.idx, p.val); err != nil {
+			return fmt.Errorf(
+				"registry update, failed updating validator idx %d: %w",
+				p.idx,
+				err,
+			)
+		}
+		if !p.activated {
+			continue
+		}
+		if err = sp.hooks.OnActivation(
+			st.Context(), p.idx, p.val,
+		); err != nil {
+			return fmt.Errorf(
+				"registry update, activation hook failed, idx %d: %w",
+				p.idx,
+				err,
+			)
 		}
 	}
 
@@ -112,6 +134,74 @@ func (sp *StateProcessor[
 	return nil
 }
 
+// processEjections initiates the exit of active validators whose effective
+// balance is at or below EjectionBalance, as done in process_registry_updates
+// in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#registry-updates
+func (sp *StateProcessor[
+	_, _,
+]) processEjections(
+	st *statedb.StateDB,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return fmt.Errorf("ejections, failed loading slot: %w", err)
+	}
+
+	switch {
+	case sp.cs.DepositEth1ChainID() == spec.BartioChainID:
+		// Bartio does not properly handle validators registry
+		return nil
+	case sp.cs.DepositEth1ChainID() == spec.BoonetEth1ChainID &&
+		slot < math.U64(spec.BoonetFork3Height):
+		// Boonet inherits Bartio processing till fork 3
+		return nil
+	default:
+		// processing below
+	}
+
+	currEpoch := sp.cs.SlotToEpoch(slot)
+	nextEpoch := currEpoch + 1
+	ejectionBalance := math.Gwei(sp.cs.EjectionBalance())
+
+	// We do not currently have a cap on validators churn, so we stop
+	// validators next epoch and we withdraw them the epoch after
+	var ejected []pendingValidator
+	err = st.IterateValidators(
+		func(idx math.ValidatorIndex, val *ctypes.Validator) (bool, error) {
+			if val.IsActive(currEpoch) &&
+				val.GetEffectiveBalance() <= ejectionBalance &&
+				val.GetExitEpoch() == math.Epoch(constants.FarFutureEpoch) {
+				ejected = append(ejected, pendingValidator{idx: idx, val: val})
+			}
+			return false, nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("ejections, failed listing validators: %w", err)
+	}
+
+	for _, p := range ejected {
+		p.val.SetExitEpoch(nextEpoch)
+		p.val.SetWithdrawableEpoch(nextEpoch + 1)
+		if err = st.UpdateValidatorAtIndex(p.idx, p.val); err != nil {
+			return fmt.Errorf(
+				"ejections, failed ejecting validator idx %d: %w",
+				p.idx,
+				err,
+			)
+		}
+		if err = sp.hooks.OnExit(st.Context(), p.idx, p.val); err != nil {
+			return fmt.Errorf(
+				"ejections, exit hook failed, idx %d: %w",
+				p.idx,
+				err,
+			)
+		}
+	}
+	return nil
+}
+
 func (sp *StateProcessor[
 	_, _,
 ]) processValidatorSetCap(
@@ -182,6 +272,13 @@ func (sp *StateProcessor[
 				err,
 			)
 		}
+		if err = sp.hooks.OnExit(st.Context(), idx, valToEject); err != nil {
+			return fmt.Errorf(
+				"validator cap, exit hook failed, idx %d: %w",
+				idx,
+				err,
+			)
+		}
 	}
 
 	return nil
@@ -247,40 +344,39 @@ func getActiveVals(
 	st *statedb.StateDB,
 	epoch math.Epoch,
 ) ([]*ctypes.Validator, error) {
-	vals, err := st.GetValidators()
-	if err != nil {
-		return nil, err
-	}
-
 	slot, err := st.GetSlot()
 	if err != nil {
 		return nil, err
 	}
 
-	activeVals := make([]*ctypes.Validator, 0, len(vals))
+	var isActive func(*ctypes.Validator) bool
 	switch {
-	case cs.DepositEth1ChainID() == spec.BartioChainID:
+	case cs.DepositEth1ChainID() == spec.BartioChainID,
+		cs.DepositEth1ChainID() == spec.BoonetEth1ChainID &&
+			slot < math.U64(spec.BoonetFork3Height):
 		// Bartio does not properly handle validators epochs, so
-		// we have an ad-hoc definition of active validator there
-		for _, val := range vals {
-			if val.GetEffectiveBalance() > math.U64(cs.EjectionBalance()) {
-				activeVals = append(activeVals, val)
-			}
-		}
-	case cs.DepositEth1ChainID() == spec.BoonetEth1ChainID &&
-		slot < math.U64(spec.BoonetFork3Height):
-		// Boonet inherits Bartio processing till fork 3
-		for _, val := range vals {
-			if val.GetEffectiveBalance() > math.U64(cs.EjectionBalance()) {
-				activeVals = append(activeVals, val)
-			}
+		// we have an ad-hoc definition of active validator there.
+		// Boonet inherits Bartio processing till fork 3.
+		isActive = func(val *ctypes.Validator) bool {
+			return val.GetEffectiveBalance() > math.U64(cs.EjectionBalance())
 		}
 	default:
-		for _, val := range vals {
-			if val.IsActive(epoch) {
+		isActive = func(val *ctypes.Validator) bool {
+			return val.IsActive(epoch)
+		}
+	}
+
+	var activeVals []*ctypes.Validator
+	err = st.IterateValidators(
+		func(_ math.ValidatorIndex, val *ctypes.Validator) (bool, error) {
+			if isActive(val) {
 				activeVals = append(activeVals, val)
 			}
-		}
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
 	}
 	return activeVals, nil
 }
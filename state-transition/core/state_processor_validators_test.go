@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTransitionEjectLowBalanceValidator shows that a validator whose
+// effective balance drops to the ejection balance is evicted at the next
+// epoch turn.
+func TestTransitionEjectLowBalanceValidator(t *testing.T) {
+	cs := setupChain(t, components.BetnetChainSpecType)
+	sp, st, ds, ctx := setupState(t, cs)
+
+	hooks := &recordingHooks{}
+	sp.SetHooks(hooks)
+
+	var (
+		maxBalance       = math.Gwei(cs.MaxEffectiveBalance(false))
+		ejectionBalance  = math.Gwei(cs.EjectionBalance())
+		emptyCredentials = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{},
+		)
+	)
+
+	// STEP 0: Setup initial state via genesis
+	var (
+		genDeposits = types.Deposits{
+			{
+				Pubkey:      [48]byte{0x00},
+				Credentials: emptyCredentials,
+				Amount:      maxBalance,
+				Index:       uint64(0),
+			},
+			{
+				Pubkey:      [48]byte{0x01},
+				Credentials: emptyCredentials,
+				Amount:      maxBalance,
+				Index:       uint64(1),
+			},
+		}
+		genPayloadHeader = new(types.ExecutionPayloadHeader).Empty()
+		genVersion       = version.FromUint32[common.Version](version.Deneb)
+	)
+	require.NoError(t, ds.EnqueueDeposits(genDeposits))
+	_, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		genDeposits,
+		genPayloadHeader,
+		genVersion,
+	)
+	require.NoError(t, err)
+
+	// STEP 1: drop validator 1 balance down to the ejection balance
+	ejectedPk := genDeposits[1].Pubkey
+	idx, err := st.ValidatorIndexByPubkey(ejectedPk)
+	require.NoError(t, err)
+	require.NoError(t, st.DecreaseBalance(idx, maxBalance-ejectionBalance))
+
+	eth1Data := newEth1Data(genDeposits)
+	blk := buildNextBlock(
+		t,
+		st,
+		&types.BeaconBlockBody{
+			ExecutionPayload: &types.ExecutionPayload{
+				Timestamp:    10,
+				ExtraData:    []byte("testing"),
+				Transactions: [][]byte{},
+				Withdrawals: []*engineprimitives.Withdrawal{
+					st.EVMInflationWithdrawal(),
+				},
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: eth1Data,
+			Deposits: []*types.Deposit{},
+		},
+	)
+	valDiff, err := sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	require.Empty(t, valDiff) // validators set updates only at epoch turn
+
+	// STEP 2: check that the validator is evicted once next epoch arrives
+	blk = moveToEndOfEpoch(t, blk, cs, sp, st, ctx, eth1Data)
+	blk = buildNextBlock(
+		t,
+		st,
+		&types.BeaconBlockBody{
+			ExecutionPayload: &types.ExecutionPayload{
+				Timestamp:    blk.Body.ExecutionPayload.Timestamp + 1,
+				ExtraData:    []byte("testing"),
+				Transactions: [][]byte{},
+				Withdrawals: []*engineprimitives.Withdrawal{
+					st.EVMInflationWithdrawal(),
+				},
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: eth1Data,
+			Deposits: []*types.Deposit{},
+		},
+	)
+	valDiff, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		transition.ValidatorUpdates{
+			&transition.ValidatorUpdate{
+				Pubkey:           ejectedPk,
+				EffectiveBalance: 0,
+			},
+		},
+		valDiff,
+	)
+	require.Equal(t, []math.ValidatorIndex{idx}, hooks.exits)
+
+	val, err := st.ValidatorByIndex(idx)
+	require.NoError(t, err)
+	require.Equal(t, ejectionBalance, val.GetEffectiveBalance())
+	require.Equal(t, math.Epoch(1), val.GetExitEpoch())
+	require.Equal(t, math.Epoch(2), val.GetWithdrawableEpoch())
+}
@@ -27,6 +27,7 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/state-transition/core/state"
 	"github.com/davecgh/go-spew/spew"
@@ -276,7 +277,7 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	sp.logger.Info(
+	log.WithContext(sp.logger, st.Context()).Info(
 		"Processed withdrawals",
 		"num_withdrawals", numWithdrawals,
 		"bera_inflation", float64(
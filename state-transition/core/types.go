@@ -30,6 +30,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
 	"github.com/karalabe/ssz"
 )
 
@@ -56,6 +57,9 @@ type Context interface {
 	// GetConsensusTime returns the timestamp of current consensus request.
 	// It is used to build next payload and to validate currentpayload.
 	GetConsensusTime() math.U64
+	// GetMisbehaviors returns the validators misbehaviors reported by
+	// consensus along with the block.
+	GetMisbehaviors() []*transition.Misbehavior
 }
 
 // DepositStore defines the interface for deposit storage.
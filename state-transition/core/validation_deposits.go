@@ -23,7 +23,6 @@ package core
 import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
-	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 )
@@ -71,7 +70,7 @@ func (sp *StateProcessor[
 ]) validateNonGenesisDeposits(
 	st *statedb.StateDB,
 	blkDeposits []*ctypes.Deposit,
-	blkDepositRoot common.Root,
+	blkEth1Data *ctypes.Eth1Data,
 ) error {
 	depositIndex, err := st.GetEth1DepositIndex()
 	if err != nil {
@@ -92,8 +91,56 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	if !blkDepositRoot.Equals(deposits.HashTreeRoot()) {
+	// Blocks before the deposit count fork are only checked against the
+	// deposits root.
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	if sp.cs.SlotToEpoch(slot) >= sp.cs.DepositCountForkEpoch() {
+		if err = validateObservedDeposits(
+			blkDeposits, blkEth1Data.GetDepositCount(), deposits, depositIndex,
+		); err != nil {
+			return err
+		}
+	}
+
+	if !blkEth1Data.DepositRoot.Equals(deposits.HashTreeRoot()) {
 		return ErrDepositsRootMismatch
 	}
 	return nil
 }
+
+// validateObservedDeposits cross-checks the deposits included in a block, and
+// the deposit count it claims, against the deposits observed by the node on
+// the execution layer. Blocks referencing deposits the node has not observed
+// are rejected, rather than trusting the proposer view of the deposit tree.
+func validateObservedDeposits(
+	blkDeposits []*ctypes.Deposit,
+	blkDepositCount math.U64,
+	localDeposits ctypes.Deposits,
+	depositIndex uint64,
+) error {
+	expectedCount := depositIndex + uint64(len(blkDeposits))
+	if uint64(len(localDeposits)) < expectedCount {
+		return errors.Wrapf(ErrDepositsNotObserved,
+			"observed deposits: %d, expected: %d",
+			len(localDeposits), expectedCount,
+		)
+	}
+	if blkDepositCount.Unwrap() != expectedCount {
+		return errors.Wrapf(ErrDepositCountMismatch,
+			"block deposit count: %d, expected: %d",
+			blkDepositCount.Unwrap(), expectedCount,
+		)
+	}
+	for i, deposit := range blkDeposits {
+		local := localDeposits[depositIndex+uint64(i)]
+		if deposit.HashTreeRoot() != local.HashTreeRoot() {
+			return errors.Wrapf(ErrDepositMismatch,
+				"deposit index: %d", deposit.GetIndex().Unwrap(),
+			)
+		}
+	}
+	return nil
+}
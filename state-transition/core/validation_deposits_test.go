@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	"github.com/stretchr/testify/require"
+)
+
+// newDepositCountForkSpec returns a chain spec whose blocks commit to the
+// deposit count from the given epoch.
+func newDepositCountForkSpec(
+	t *testing.T, forkEpoch math.Epoch,
+) chain.Spec[bytes.B4, math.U64, math.U64, any] {
+	t.Helper()
+	csData := spec.BaseSpec()
+	csData.DepositEth1ChainID = spec.BetnetEth1ChainID
+	csData.DepositCountForkEpoch = forkEpoch
+	cs, err := chain.NewChainSpec(csData)
+	require.NoError(t, err)
+	return cs
+}
+
+// TestTransitionCrossChecksObservedDeposits shows that blocks whose deposits
+// do not match the deposits observed by the node are rejected.
+func TestTransitionCrossChecksObservedDeposits(t *testing.T) {
+	var (
+		maxBalance  = math.Gwei(32e9)
+		credentials = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{},
+		)
+		genDeposits = types.Deposits{
+			{
+				Pubkey:      [48]byte{0x00},
+				Credentials: credentials,
+				Amount:      maxBalance,
+				Index:       uint64(0),
+			},
+		}
+		blkDeposit = &types.Deposit{
+			Pubkey:      [48]byte{0x01},
+			Credentials: credentials,
+			Amount:      maxBalance,
+			Index:       uint64(1),
+		}
+		allDeposits = types.Deposits{genDeposits[0], blkDeposit}
+	)
+
+	tests := []struct {
+		name      string
+		observed  types.Deposits
+		included  *types.Deposit
+		eth1Data  *types.Eth1Data
+		expectErr error
+	}{
+		{
+			name:     "observed deposit",
+			observed: types.Deposits{blkDeposit},
+			included: blkDeposit,
+			eth1Data: newEth1Data(allDeposits),
+		},
+		{
+			name:      "deposit not observed",
+			observed:  types.Deposits{},
+			included:  blkDeposit,
+			eth1Data:  newEth1Data(allDeposits),
+			expectErr: core.ErrDepositsNotObserved,
+		},
+		{
+			name:     "deposit count mismatch",
+			observed: types.Deposits{blkDeposit},
+			included: blkDeposit,
+			eth1Data: (&types.Eth1Data{}).New(
+				allDeposits.HashTreeRoot(), 1, common.ExecutionHash{},
+			),
+			expectErr: core.ErrDepositCountMismatch,
+		},
+		{
+			name:     "deposit altered by proposer",
+			observed: types.Deposits{blkDeposit},
+			included: &types.Deposit{
+				Pubkey:      blkDeposit.Pubkey,
+				Credentials: credentials,
+				Amount:      2 * maxBalance,
+				Index:       blkDeposit.Index,
+			},
+			eth1Data:  newEth1Data(allDeposits),
+			expectErr: core.ErrDepositMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs := newDepositCountForkSpec(t, 0)
+			sp, st, ds, ctx := setupState(t, cs)
+
+			require.NoError(t, ds.EnqueueDeposits(genDeposits))
+			_, err := sp.InitializePreminedBeaconStateFromEth1(
+				st,
+				genDeposits,
+				new(types.ExecutionPayloadHeader).Empty(),
+				version.FromUint32[common.Version](version.Deneb),
+			)
+			require.NoError(t, err)
+
+			require.NoError(t, ds.EnqueueDeposits(tt.observed))
+			blk := buildNextBlock(
+				t,
+				st,
+				&types.BeaconBlockBody{
+					ExecutionPayload: &types.ExecutionPayload{
+						Timestamp:    10,
+						ExtraData:    []byte("testing"),
+						Transactions: [][]byte{},
+						Withdrawals: []*engineprimitives.Withdrawal{
+							st.EVMInflationWithdrawal(),
+						},
+						BaseFeePerGas: math.NewU256(0),
+					},
+					Eth1Data: tt.eth1Data,
+					Deposits: []*types.Deposit{tt.included},
+				},
+			)
+			_, err = sp.Transition(ctx, st, blk)
+			if tt.expectErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.expectErr)
+		})
+	}
+}
+
+// TestTransitionDepositCountFork shows that blocks carrying a zero deposit
+// count, as on the existing networks, are accepted until the deposit count
+// fork, and rejected from it.
+func TestTransitionDepositCountFork(t *testing.T) {
+	genDeposits := types.Deposits{
+		{
+			Pubkey: [48]byte{0x00},
+			Credentials: types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{},
+			),
+			Amount: math.Gwei(32e9),
+			Index:  uint64(0),
+		},
+	}
+	zeroCount := (&types.Eth1Data{}).New(
+		genDeposits.HashTreeRoot(), 0, common.ExecutionHash{},
+	)
+
+	tests := []struct {
+		name      string
+		eth1Data  *types.Eth1Data
+		expectErr error
+	}{
+		{
+			name:     "deposit count after the fork",
+			eth1Data: newEth1Data(genDeposits),
+		},
+		{
+			name:      "zero deposit count after the fork",
+			eth1Data:  zeroCount,
+			expectErr: core.ErrDepositCountMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs := newDepositCountForkSpec(t, 1)
+			sp, st, ds, ctx := setupState(t, cs)
+
+			require.NoError(t, ds.EnqueueDeposits(genDeposits))
+			_, err := sp.InitializePreminedBeaconStateFromEth1(
+				st,
+				genDeposits,
+				new(types.ExecutionPayloadHeader).Empty(),
+				version.FromUint32[common.Version](version.Deneb),
+			)
+			require.NoError(t, err)
+
+			nextBlock := func(
+				timestamp math.U64, eth1Data *types.Eth1Data,
+			) *types.BeaconBlock {
+				return buildNextBlock(
+					t,
+					st,
+					&types.BeaconBlockBody{
+						ExecutionPayload: &types.ExecutionPayload{
+							Timestamp:    timestamp,
+							ExtraData:    []byte("testing"),
+							Transactions: [][]byte{},
+							Withdrawals: []*engineprimitives.Withdrawal{
+								st.EVMInflationWithdrawal(),
+							},
+							BaseFeePerGas: math.NewU256(0),
+						},
+						Eth1Data: eth1Data,
+						Deposits: []*types.Deposit{},
+					},
+				)
+			}
+
+			// Before the fork, the deposit count is not checked.
+			blk := nextBlock(10, zeroCount)
+			_, err = sp.Transition(ctx, st, blk)
+			require.NoError(t, err)
+			blk = moveToEndOfEpoch(t, blk, cs, sp, st, ctx, zeroCount)
+			require.Equal(t, math.Epoch(0), cs.SlotToEpoch(blk.GetSlot()))
+
+			// From the fork, blocks must commit to the count of deposits.
+			blk = nextBlock(blk.Body.ExecutionPayload.Timestamp+1, tt.eth1Data)
+			require.Equal(t, math.Epoch(1), cs.SlotToEpoch(blk.GetSlot()))
+			_, err = sp.Transition(ctx, st, blk)
+			if tt.expectErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.expectErr)
+		})
+	}
+}
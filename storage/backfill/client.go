@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package backfill
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/consensus-types/registry"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// blockPath is the path of the node API serving the beacon block at a
+	// slot.
+	blockPath = "/eth/v2/beacon/blocks/"
+	// blobSidecarsPath is the path of the node API serving the blob
+	// sidecars of the beacon block at a slot.
+	blobSidecarsPath = "/eth/v1/beacon/blob_sidecars/"
+	// requestTimeout bounds each request to the node API.
+	requestTimeout = 30 * time.Second
+	// maxResponseSize bounds the size of the responses read from the node
+	// API.
+	maxResponseSize = 64 << 20
+	// signedBlockFixedSize is the size of the offset of the message and of
+	// the signature heading the SSZ encoding of a signed beacon block.
+	signedBlockFixedSize = 4 + 96
+)
+
+// ErrBackfill is returned when the node API does not serve the history to
+// backfill, or serves data not matching it.
+var ErrBackfill = errors.New("backfill failed")
+
+// client fetches the history from the node API of a trusted node.
+type client struct {
+	url         string
+	http        *http.Client
+	cs          chain.ChainSpec
+	sidecarSize int
+}
+
+// newClient creates a client of the node API served at the given URL.
+func newClient(url string, cs chain.ChainSpec) *client {
+	return &client{
+		url:         strings.TrimSuffix(url, "/"),
+		http:        &http.Client{Timeout: requestTimeout},
+		cs:          cs,
+		sidecarSize: int(ssz.Size(new(datypes.BlobSidecar))),
+	}
+}
+
+// block returns the beacon block at the given slot, along with its SSZ
+// encoding.
+func (c *client) block(
+	ctx context.Context, slot math.Slot,
+) (*ctypes.BeaconBlock, []byte, error) {
+	bz, err := c.get(ctx, blockPath, slot)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Beacon blocks are signed through CometBFT, the signature served along
+	// with the message is skipped.
+	if len(bz) < signedBlockFixedSize ||
+		binary.LittleEndian.Uint32(bz) != signedBlockFixedSize {
+		return nil, nil, errors.Wrapf(
+			ErrBackfill, "slot %d: invalid signed block encoding", slot,
+		)
+	}
+	bz = bz[signedBlockFixedSize:]
+	blk, err := registry.Default.BeaconBlocks.Unmarshal(
+		c.cs.ActiveForkVersionForSlot(slot), bz,
+	)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "slot %d", slot)
+	}
+	return blk, bz, nil
+}
+
+// blobSidecars returns the blob sidecars of the beacon block at the given
+// slot.
+func (c *client) blobSidecars(
+	ctx context.Context, slot math.Slot,
+) (datypes.BlobSidecars, error) {
+	bz, err := c.get(ctx, blobSidecarsPath, slot)
+	if err != nil {
+		return nil, err
+	}
+	// The sidecars have a fixed size and are encoded back to back.
+	if len(bz)%c.sidecarSize != 0 {
+		return nil, errors.Wrapf(
+			ErrBackfill, "slot %d: invalid blob sidecars encoding", slot,
+		)
+	}
+	sidecars := make(datypes.BlobSidecars, 0, len(bz)/c.sidecarSize)
+	for ; len(bz) > 0; bz = bz[c.sidecarSize:] {
+		sidecar := new(datypes.BlobSidecar)
+		if err = sidecar.UnmarshalSSZ(bz[:c.sidecarSize]); err != nil {
+			return nil, errors.Wrapf(err, "slot %d", slot)
+		}
+		sidecars = append(sidecars, sidecar)
+	}
+	return sidecars, nil
+}
+
+// get requests the SSZ encoded data served at the given path for the given
+// slot.
+func (c *client) get(
+	ctx context.Context, path string, slot math.Slot,
+) ([]byte, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.url+path+strconv.FormatUint(slot.Unwrap(), 10),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/octet-stream")
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "backfill node")
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxResponseSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "backfill node")
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"%w: slot %d: %s: %s", ErrBackfill, slot, res.Status,
+			strings.TrimSpace(string(body)),
+		)
+	}
+	return body, nil
+}
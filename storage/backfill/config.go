@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package backfill
+
+// Config is the configuration of the backfill of the history below the
+// checkpoint a node was synced from.
+type Config struct {
+	// Depth is the number of slots below the first block held by CometBFT
+	// whose beacon blocks, and blob sidecars within the DA period, are
+	// backfilled. Nothing is backfilled if zero, unless archiving.
+	Depth uint64 `mapstructure:"depth"`
+	// Archive backfills the beacon blocks down to genesis, whatever the
+	// depth.
+	Archive bool `mapstructure:"archive"`
+	// URL is the node API the beacon blocks and blob sidecars are fetched
+	// from. The URL of the node checkpoint synced from is used if unset.
+	URL string `mapstructure:"url"`
+}
+
+// DefaultConfig returns the default configuration of the backfill.
+func DefaultConfig() Config {
+	return Config{
+		Depth:   0,
+		Archive: false,
+		URL:     "",
+	}
+}
+
+// Enabled reports whether the history is backfilled.
+func (c Config) Enabled() bool {
+	return c.Archive || c.Depth > 0
+}
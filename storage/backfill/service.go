@@ -0,0 +1,340 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package backfill
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/consensus-types/registry"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	consensustypes "github.com/berachain/beacon-kit/consensus/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// retryInterval is the interval after which a failed backfill is retried.
+const retryInterval = time.Minute
+
+// Node is the interface of the node whose history is backfilled.
+type Node interface {
+	// AddCommitListener registers a listener notified of each block
+	// committed.
+	AddCommitListener(
+		listener func(height int64, changes []*storetypes.StoreKVPair),
+	)
+	// BeaconBlockBytes returns the SSZ encoded beacon block committed at the
+	// given height, nil if there is none.
+	BeaconBlockBytes(height int64) ([]byte, error)
+	// BlockBase returns the lowest height of the blocks held by the node.
+	BlockBase() (int64, error)
+}
+
+// AvailabilityStore is the interface of the store the blob sidecars
+// backfilled are persisted to.
+type AvailabilityStore interface {
+	// IsDataAvailable reports whether the sidecars of all the blobs of the
+	// given block body are stored.
+	IsDataAvailable(
+		ctx context.Context, slot math.Slot, body *ctypes.BeaconBlockBody,
+	) bool
+	// Persist stores the sidecars of the blobs of the block at the given
+	// slot.
+	Persist(slot math.Slot, sidecars datypes.BlobSidecars) error
+}
+
+// BlobProcessor is the interface of the verifier of the blob sidecars.
+type BlobProcessor interface {
+	// VerifySidecars verifies the inclusion and KZG proofs of the sidecars
+	// against the header of their block.
+	VerifySidecars(
+		ctx context.Context,
+		cs *consensustypes.ConsensusSidecars,
+		verifierFn func(
+			blkHeader *ctypes.BeaconBlockHeader,
+			signature crypto.BLSSignature,
+		) error,
+	) error
+}
+
+// Service backfills, in the background, the history below the first block
+// held by CometBFT once a node is checkpoint synced: the beacon blocks down
+// to a depth, or to genesis when archiving, along with the blob sidecars of
+// those within the DA period. They are fetched from the node API of a
+// trusted node and verified backwards, each block being the parent of the
+// block above it, down from the first block held by CometBFT.
+type Service struct {
+	depth   uint64
+	archive bool
+	cs      chain.ChainSpec
+	node    Node
+	client  *client
+	store   *Store
+	avs     AvailabilityStore
+	blobs   BlobProcessor
+	logger  log.Logger
+
+	// latest is the latest height committed.
+	latest atomic.Int64
+	// done is set once the history is backfilled.
+	done   atomic.Bool
+	wakeCh chan struct{}
+	stopCh chan struct{}
+}
+
+// NewService creates a new service backfilling the history to the given
+// store, from the node API at the configured URL, or at the given one if
+// unset.
+func NewService(
+	cfg Config,
+	url string,
+	cs chain.ChainSpec,
+	node Node,
+	store *Store,
+	avs AvailabilityStore,
+	blobs BlobProcessor,
+	logger log.Logger,
+) *Service {
+	if cfg.URL != "" {
+		url = cfg.URL
+	}
+	s := &Service{
+		depth:   cfg.Depth,
+		archive: cfg.Archive,
+		cs:      cs,
+		node:    node,
+		store:   store,
+		avs:     avs,
+		blobs:   blobs,
+		logger:  logger,
+		wakeCh:  make(chan struct{}, 1),
+		stopCh:  make(chan struct{}),
+	}
+	if url != "" {
+		s.client = newClient(url, cs)
+	}
+	return s
+}
+
+// Name returns the name of the service.
+func (*Service) Name() string {
+	return "backfill"
+}
+
+// Start registers the service as a commit listener and starts backfilling
+// the history in the background, once CometBFT holds blocks.
+func (s *Service) Start(ctx context.Context) error {
+	if !s.archive && s.depth == 0 {
+		return nil
+	}
+	if s.client == nil {
+		return errors.New("backfill requires the URL of a node API")
+	}
+	s.node.AddCommitListener(s.onCommit)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.stopCh:
+				return
+			case <-s.wakeCh:
+			}
+			if s.done.Load() {
+				return
+			}
+			if err := s.backfill(ctx); err != nil {
+				s.logger.Error("Failed to backfill history",
+					"error", err, "retry_in", retryInterval)
+				select {
+				case <-ctx.Done():
+					return
+				case <-s.stopCh:
+					return
+				case <-time.After(retryInterval):
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops backfilling the history.
+func (s *Service) Stop() error {
+	select {
+	case <-s.stopCh:
+	default:
+		close(s.stopCh)
+	}
+	return nil
+}
+
+// onCommit wakes the backfill once the given height is committed.
+func (s *Service) onCommit(height int64, _ []*storetypes.StoreKVPair) {
+	s.latest.Store(height)
+	select {
+	case s.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+// backfill fetches and stores the blocks below the oldest one held, down to
+// the target slot. It resumes from the oldest block backfilled, if any.
+func (s *Service) backfill(ctx context.Context) error {
+	first, next, root, ok, err := s.anchor()
+	if err != nil || !ok {
+		return err
+	}
+
+	target := math.Slot(1)
+	if !s.archive && first.Unwrap() > s.depth+1 {
+		target = first - math.Slot(s.depth)
+	}
+	if next >= target {
+		s.logger.Info("Backfilling history", "from", next, "to", target)
+	}
+	for slot := next; slot >= target; slot-- {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.stopCh:
+			return nil
+		default:
+		}
+		if root, err = s.backfillBlock(ctx, slot, root); err != nil {
+			return errors.Wrapf(err, "failed to backfill block %d", slot)
+		}
+	}
+	s.done.Store(true)
+	s.logger.Info("Backfilled history", "to", target)
+	return nil
+}
+
+// anchor returns the slot of the first block above the history backfilled,
+// along with the slot of the next block to backfill and its root, i.e. the
+// parent root of the oldest block held. It returns false if CometBFT holds no
+// block yet.
+func (s *Service) anchor() (math.Slot, math.Slot, common.Root, bool, error) {
+	oldest, err := s.store.Oldest()
+	if err != nil {
+		return 0, 0, common.Root{}, false, err
+	}
+	if oldest != nil {
+		var newest math.Slot
+		if newest, _, err = s.store.Newest(); err != nil {
+			return 0, 0, common.Root{}, false, err
+		}
+		return newest + 1, oldest.GetSlot() - 1, oldest.GetParentBlockRoot(),
+			true, nil
+	}
+
+	base, err := s.node.BlockBase()
+	if err != nil || base == 0 {
+		return 0, 0, common.Root{}, false, err
+	}
+	bz, err := s.node.BeaconBlockBytes(base)
+	if err != nil || bz == nil {
+		return 0, 0, common.Root{}, false, err
+	}
+	//#nosec:G115 // heights are positive.
+	slot := math.Slot(base)
+	blk, err := registry.Default.BeaconBlocks.Unmarshal(
+		s.cs.ActiveForkVersionForSlot(slot), bz,
+	)
+	if err != nil {
+		return 0, 0, common.Root{}, false, err
+	}
+	return slot, slot - 1, blk.GetParentBlockRoot(), true, nil
+}
+
+// backfillBlock fetches the block at the given slot, checks it has the given
+// root, and stores it along with its blob sidecars. It returns the root of
+// its parent.
+func (s *Service) backfillBlock(
+	ctx context.Context, slot math.Slot, root common.Root,
+) (common.Root, error) {
+	blk, bz, err := s.client.block(ctx, slot)
+	if err != nil {
+		return common.Root{}, err
+	}
+	if blk.GetSlot() != slot || blk.HashTreeRoot() != root {
+		return common.Root{}, errors.Wrapf(
+			ErrBackfill,
+			"block at slot %d is not the parent of the block above it", slot,
+		)
+	}
+	if err = s.backfillSidecars(ctx, blk); err != nil {
+		return common.Root{}, err
+	}
+	header, err := blk.GetHeader().MarshalSSZ()
+	if err != nil {
+		return common.Root{}, err
+	}
+	if err = s.store.Put(slot, bz, header); err != nil {
+		return common.Root{}, err
+	}
+	return blk.GetParentBlockRoot(), nil
+}
+
+// backfillSidecars fetches, verifies and persists the blob sidecars of the
+// given block, if it is within the DA period and they are not stored yet.
+func (s *Service) backfillSidecars(
+	ctx context.Context, blk *ctypes.BeaconBlock,
+) error {
+	slot, body := blk.GetSlot(), blk.GetBody()
+	commitments := body.GetBlobKzgCommitments()
+	//#nosec:G115 // heights are positive.
+	head := math.Slot(s.latest.Load())
+	if len(commitments) == 0 || !s.cs.WithinDAPeriod(slot, head) ||
+		s.avs.IsDataAvailable(ctx, slot, body) {
+		return nil
+	}
+
+	sidecars, err := s.client.blobSidecars(ctx, slot)
+	if err != nil {
+		return err
+	}
+	if len(sidecars) != len(commitments) {
+		return errors.Wrapf(
+			ErrBackfill, "slot %d: got %d blob sidecars, expected %d",
+			slot, len(sidecars), len(commitments),
+		)
+	}
+	// The header of the sidecars is checked to be the one of the block,
+	// verified by the chain of roots instead of by its signature.
+	if err = s.blobs.VerifySidecars(
+		ctx,
+		new(consensustypes.ConsensusSidecars).New(sidecars, blk.GetHeader()),
+		func(*ctypes.BeaconBlockHeader, crypto.BLSSignature) error {
+			return nil
+		},
+	); err != nil {
+		return errors.Wrapf(err, "slot %d: invalid blob sidecars", slot)
+	}
+	return s.avs.Persist(slot, sidecars)
+}
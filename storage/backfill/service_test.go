@@ -0,0 +1,275 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package backfill_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	consensustypes "github.com/berachain/beacon-kit/consensus/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/backfill"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+// testNode holds the beacon blocks of the heights from its base.
+type testNode struct {
+	blocks   map[int64][]byte
+	base     int64
+	listener func(int64, []*storetypes.StoreKVPair)
+}
+
+func (n *testNode) AddCommitListener(
+	listener func(int64, []*storetypes.StoreKVPair),
+) {
+	n.listener = listener
+}
+
+func (n *testNode) BeaconBlockBytes(height int64) ([]byte, error) {
+	if height < n.base {
+		return nil, nil
+	}
+	return n.blocks[height], nil
+}
+
+func (n *testNode) BlockBase() (int64, error) {
+	return n.base, nil
+}
+
+// testAvailabilityStore records the blob sidecars persisted.
+type testAvailabilityStore struct {
+	mu        sync.Mutex
+	persisted map[math.Slot]datypes.BlobSidecars
+}
+
+func (s *testAvailabilityStore) IsDataAvailable(
+	_ context.Context, slot math.Slot, _ *ctypes.BeaconBlockBody,
+) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.persisted[slot] != nil
+}
+
+func (s *testAvailabilityStore) Persist(
+	slot math.Slot, sidecars datypes.BlobSidecars,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.persisted[slot] = sidecars
+	return nil
+}
+
+// testBlobProcessor checks the sidecars have the header of their block.
+type testBlobProcessor struct{}
+
+func (testBlobProcessor) VerifySidecars(
+	_ context.Context,
+	cs *consensustypes.ConsensusSidecars,
+	verifierFn func(*ctypes.BeaconBlockHeader, crypto.BLSSignature) error,
+) error {
+	for _, sc := range cs.GetSidecars() {
+		if !sc.GetBeaconBlockHeader().Equals(cs.GetHeader()) {
+			return backfill.ErrBackfill
+		}
+		if err := verifierFn(cs.GetHeader(), crypto.BLSSignature{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// testChain is a chain of beacon blocks served by a node API.
+type testChain struct {
+	blocks   map[math.Slot]*ctypes.BeaconBlock
+	sidecars map[math.Slot]datypes.BlobSidecars
+	url      string
+}
+
+// newTestChain returns a chain of blocks up to the given slot, each one
+// referencing its parent, whose block at the given blob slot carries a blob.
+func newTestChain(t *testing.T, slots, blobSlot math.Slot) *testChain {
+	t.Helper()
+	c := &testChain{
+		blocks:   map[math.Slot]*ctypes.BeaconBlock{},
+		sidecars: map[math.Slot]datypes.BlobSidecars{},
+	}
+	parent := common.Root{0x01}
+	for slot := math.Slot(1); slot <= slots; slot++ {
+		blk := &ctypes.BeaconBlock{
+			Slot:       slot,
+			ParentRoot: parent,
+			Body: &ctypes.BeaconBlockBody{
+				ExecutionPayload: &ctypes.ExecutionPayload{
+					BaseFeePerGas: math.NewU256(0),
+				},
+				Eth1Data: &ctypes.Eth1Data{},
+			},
+		}
+		if slot == blobSlot {
+			blk.Body.BlobKzgCommitments = eip4844.KZGCommitments[common.ExecutionHash]{{0x02}}
+			c.sidecars[slot] = datypes.BlobSidecars{{
+				KzgCommitment: eip4844.KZGCommitment{0x02},
+				SignedBeaconBlockHeader: &ctypes.SignedBeaconBlockHeader{
+					Header: blk.GetHeader(),
+				},
+				InclusionProof: make([]common.Root, 8),
+			}}
+		}
+		c.blocks[slot] = blk
+		parent = blk.HashTreeRoot()
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			path, id := r.URL.Path[:strings.LastIndex(r.URL.Path, "/")+1],
+				r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			slot, err := strconv.ParseUint(id, 10, 64)
+			require.NoError(t, err)
+			blk, ok := c.blocks[math.Slot(slot)]
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			var bz []byte
+			switch path {
+			case "/eth/v2/beacon/blocks/":
+				bz, err = (&beacontypes.SignedBeaconBlock{
+					Message: blk,
+				}).MarshalSSZ()
+			case "/eth/v1/beacon/blob_sidecars/":
+				bz, err = beacontypes.BlobSidecarList(
+					c.sidecars[math.Slot(slot)],
+				).MarshalSSZ()
+			default:
+				http.NotFound(w, r)
+				return
+			}
+			require.NoError(t, err)
+			_, _ = w.Write(bz)
+		},
+	))
+	t.Cleanup(srv.Close)
+	c.url = srv.URL
+	return c
+}
+
+// node returns a node holding the blocks of the chain from the given base.
+func (c *testChain) node(t *testing.T, base int64) *testNode {
+	t.Helper()
+	n := &testNode{blocks: map[int64][]byte{}, base: base}
+	for slot, blk := range c.blocks {
+		bz, err := blk.MarshalSSZ()
+		require.NoError(t, err)
+		n.blocks[int64(slot)] = bz
+	}
+	return n
+}
+
+func TestBackfillService(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	chain := newTestChain(t, 10, 6)
+	node := chain.node(t, 8)
+	store := backfill.NewStore(storage.NewKVStoreProvider(dbm.NewMemDB()))
+	avs := &testAvailabilityStore{persisted: map[math.Slot]datypes.BlobSidecars{}}
+	svc := backfill.NewService(
+		backfill.Config{Depth: 4}, chain.url, cs, node, store, avs,
+		testBlobProcessor{}, noop.NewLogger[any](),
+	)
+	require.NoError(t, svc.Start(context.Background()))
+	t.Cleanup(func() { require.NoError(t, svc.Stop()) })
+
+	// The blocks down to the depth below the first block held by the node
+	// are backfilled, along with their blob sidecars.
+	node.listener(10, nil)
+	require.Eventually(t, func() bool {
+		bz, getErr := store.BlockBytes(4)
+		require.NoError(t, getErr)
+		return bz != nil
+	}, time.Second, time.Millisecond)
+	for slot, backfilled := range map[math.Slot]bool{
+		3: false, 4: true, 5: true, 6: true, 7: true, 8: false,
+	} {
+		bz, getErr := store.BlockBytes(slot)
+		require.NoError(t, getErr)
+		require.Equal(t, backfilled, bz != nil, "slot %d", slot)
+	}
+	header, err := store.HeaderBytes(5)
+	require.NoError(t, err)
+	expected, err := chain.blocks[5].GetHeader().MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, expected, header)
+
+	avs.mu.Lock()
+	defer avs.mu.Unlock()
+	require.Len(t, avs.persisted, 1)
+	require.Len(t, avs.persisted[6], 1)
+	require.Equal(t, chain.sidecars[6][0].KzgCommitment,
+		avs.persisted[6][0].KzgCommitment)
+}
+
+func TestBackfillServiceInvalidChain(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	chain := newTestChain(t, 10, 0)
+	node := chain.node(t, 8)
+	// The node API serves a block at slot 6 which is not the parent of the
+	// block at slot 7.
+	chain.blocks[6].StateRoot = common.Root{0x03}
+	store := backfill.NewStore(storage.NewKVStoreProvider(dbm.NewMemDB()))
+	svc := backfill.NewService(
+		backfill.Config{Archive: true}, chain.url, cs, node, store,
+		&testAvailabilityStore{}, testBlobProcessor{}, noop.NewLogger[any](),
+	)
+	require.NoError(t, svc.Start(context.Background()))
+	t.Cleanup(func() { require.NoError(t, svc.Stop()) })
+
+	// The blocks above the invalid one are backfilled, the ones below are
+	// not.
+	node.listener(10, nil)
+	require.Eventually(t, func() bool {
+		bz, getErr := store.BlockBytes(7)
+		require.NoError(t, getErr)
+		return bz != nil
+	}, time.Second, time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	for _, slot := range []math.Slot{1, 5, 6} {
+		bz, getErr := store.BlockBytes(slot)
+		require.NoError(t, getErr)
+		require.Nil(t, bz, "slot %d", slot)
+	}
+}
@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package backfill
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/migration"
+)
+
+const (
+	keyBlockPrefix  = "backfill_block"
+	keyHeaderPrefix = "backfill_header"
+)
+
+// Schemas returns the schemas of the keys of the store, migrated when the
+// database is opened.
+func Schemas() []migration.Schema {
+	return []migration.Schema{
+		{Prefix: keyBlockPrefix},
+		{Prefix: keyHeaderPrefix},
+	}
+}
+
+// Store is a KV store of the beacon blocks backfilled below the first block
+// held by CometBFT, along with their headers, keyed by slot. The backfilled
+// blocks are contiguous, down from the first block held by CometBFT.
+type Store struct {
+	blocks  sdkcollections.Map[uint64, []byte]
+	headers sdkcollections.Map[uint64, []byte]
+
+	// mu protects the maps against their database being closed.
+	mu sync.RWMutex
+	// closer closes the underlying database, if any.
+	closer io.Closer
+}
+
+// NewStore creates a new store of the backfilled blocks.
+func NewStore(kvsp store.KVStoreService) *Store {
+	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
+	res := &Store{
+		blocks: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(keyBlockPrefix)),
+			keyBlockPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
+		headers: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(keyHeaderPrefix)),
+			keyHeaderPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
+	}
+	if _, err := schemaBuilder.Build(); err != nil {
+		panic(errors.Wrap(err, "failed building backfill store schema"))
+	}
+	if closer, ok := kvsp.(io.Closer); ok {
+		res.closer = closer
+	}
+	return res
+}
+
+// Close closes the underlying database. The store must not be used
+// afterwards.
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closer == nil {
+		return nil
+	}
+	return s.closer.Close()
+}
+
+// Put stores the SSZ encoded beacon block backfilled at the given slot,
+// along with its header.
+func (s *Store) Put(slot math.Slot, block, header []byte) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	// The header is stored first, so that the blocks stored always have
+	// their header.
+	if err := s.headers.Set(context.TODO(), slot.Unwrap(), header); err != nil {
+		return errors.Wrapf(err, "failed to set header, slot: %d", slot)
+	}
+	if err := s.blocks.Set(context.TODO(), slot.Unwrap(), block); err != nil {
+		return errors.Wrapf(err, "failed to set block, slot: %d", slot)
+	}
+	return nil
+}
+
+// BlockBytes returns the SSZ encoded beacon block backfilled at the given
+// slot, nil if there is none.
+func (s *Store) BlockBytes(slot math.Slot) ([]byte, error) {
+	return s.get(s.blocks, slot)
+}
+
+// HeaderBytes returns the SSZ encoded header of the beacon block backfilled
+// at the given slot, nil if there is none.
+func (s *Store) HeaderBytes(slot math.Slot) ([]byte, error) {
+	return s.get(s.headers, slot)
+}
+
+// get returns the value of the given map at the given slot, nil if there is
+// none.
+func (s *Store) get(
+	m sdkcollections.Map[uint64, []byte], slot math.Slot,
+) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	bz, err := m.Get(context.TODO(), slot.Unwrap())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return nil, nil
+	}
+	return bz, err
+}
+
+// Oldest returns the header of the oldest beacon block backfilled, nil if
+// none was.
+func (s *Store) Oldest() (*ctypes.BeaconBlockHeader, error) {
+	slot, ok, err := s.first(new(sdkcollections.Range[uint64]))
+	if err != nil || !ok {
+		return nil, err
+	}
+	bz, err := s.HeaderBytes(slot)
+	if err != nil {
+		return nil, err
+	}
+	header := new(ctypes.BeaconBlockHeader)
+	if err = header.UnmarshalSSZ(bz); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode header, slot: %d", slot)
+	}
+	return header, nil
+}
+
+// Newest returns the slot of the newest beacon block backfilled, false if
+// none was.
+func (s *Store) Newest() (math.Slot, bool, error) {
+	return s.first(new(sdkcollections.Range[uint64]).Descending())
+}
+
+// first returns the first slot of the blocks iterated in the given range,
+// false if there is none.
+func (s *Store) first(
+	ranger sdkcollections.Ranger[uint64],
+) (math.Slot, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	iter, err := s.blocks.Iterate(context.TODO(), ranger)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "failed to iterate blocks")
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, false, nil
+	}
+	slot, err := iter.Key()
+	if err != nil {
+		return 0, false, err
+	}
+	return math.Slot(slot), true, nil
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatch(t *testing.T) {
+	cms := store.NewCommitMultiStore(
+		dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics(),
+	)
+	cms.MountStoreWithDB(testStoreKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	kv := beacondb.New(
+		components.NewKVStoreService(testStoreKey), testCodec,
+	).WithContext(sdk.NewContext(cms, true, log.NewNopLogger()))
+	require.NoError(t, kv.SetSlot(1))
+
+	// Writes to the batch are not visible until it is written.
+	batch, write := kv.Batch()
+	require.NoError(t, batch.SetSlot(2))
+	require.NoError(t, batch.SetBalance(0, 32))
+	slot, err := kv.GetSlot()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(1), slot)
+	_, err = kv.GetBalance(0)
+	require.Error(t, err)
+
+	write()
+	slot, err = kv.GetSlot()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(2), slot)
+	balance, err := kv.GetBalance(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(32), balance)
+
+	// Writes of a batch never written are discarded.
+	batch, _ = kv.Batch()
+	require.NoError(t, batch.SetSlot(3))
+	slot, err = kv.GetSlot()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(2), slot)
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"slices"
+
+	sdkcollections "cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/beacondb/keys"
+)
+
+// ChangedValidatorIndices returns the indices, in increasing order, of the
+// validators whose record or balance is written by the given changes to the
+// store of the beacon state.
+func ChangedValidatorIndices(
+	changes []*storetypes.StoreKVPair,
+) []math.ValidatorIndex {
+	changed := make(map[uint64]struct{})
+	for _, change := range changes {
+		key := change.GetKey()
+		if len(key) == 0 {
+			continue
+		}
+		switch key[0] {
+		case keys.ValidatorByIndexPrefix, keys.BalancesPrefix:
+		default:
+			continue
+		}
+		read, index, err := sdkcollections.Uint64Key.Decode(key[1:])
+		if err != nil || read != len(key)-1 {
+			continue
+		}
+		changed[index] = struct{}{}
+	}
+
+	indices := make([]math.ValidatorIndex, 0, len(changed))
+	for index := range changed {
+		indices = append(indices, math.ValidatorIndex(index))
+	}
+	slices.Sort(indices)
+	return indices
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+	"github.com/berachain/beacon-kit/storage/db"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangedValidatorIndices(t *testing.T) {
+	memDB, err := db.OpenDB("", dbm.MemDBBackend)
+	require.NoError(t, err)
+	cms := store.NewCommitMultiStore(
+		memDB, log.NewNopLogger(), metrics.NewNoOpMetrics(),
+	)
+	cms.MountStoreWithDB(testStoreKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	cms.AddListeners([]storetypes.StoreKey{testStoreKey})
+	kvStore := beacondb.New(
+		&testKVStoreService{ctx: sdk.NewContext(cms, true, log.NewNopLogger())},
+		testCodec,
+	)
+
+	for i := range 3 {
+		require.NoError(t, kvStore.AddValidator(
+			&types.Validator{Pubkey: bytes.B48{byte(i + 1)}},
+		))
+		require.NoError(t, kvStore.SetBalance(math.ValidatorIndex(i), 32e9))
+	}
+	require.NoError(t, kvStore.SetSlot(1))
+	require.Equal(t,
+		[]math.ValidatorIndex{0, 1, 2},
+		beacondb.ChangedValidatorIndices(cms.PopStateCache()),
+	)
+
+	// Only the validators written since the last changes are reported.
+	require.NoError(t, kvStore.SetBalance(2, 31e9))
+	val, err := kvStore.ValidatorByIndex(0)
+	require.NoError(t, err)
+	val.EffectiveBalance = 31e9
+	require.NoError(t, kvStore.UpdateValidatorAtIndex(0, val))
+	require.NoError(t, kvStore.SetSlot(2))
+	require.Equal(t,
+		[]math.ValidatorIndex{0, 2},
+		beacondb.ChangedValidatorIndices(cms.PopStateCache()),
+	)
+
+	require.NoError(t, kvStore.SetSlot(3))
+	require.Empty(t, beacondb.ChangedValidatorIndices(cms.PopStateCache()))
+}
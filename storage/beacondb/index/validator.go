@@ -37,6 +37,15 @@ const (
 	validatorEffectiveBalanceToIndexPrefix = "val_eff_bal_to_idx"
 )
 
+// Prefixes returns the collection prefixes of the validator indexes.
+func Prefixes() []string {
+	return []string{
+		validatorPubkeyToIndexPrefix,
+		validatorConsAddrToIndexPrefix,
+		validatorEffectiveBalanceToIndexPrefix,
+	}
+}
+
 // Validator is an interface that combines the ssz.Marshaler and
 // ssz.Unmarshaler interfaces.
 type Validator interface {
@@ -71,3 +71,37 @@ const (
 	NextWithdrawalValidatorIndexPrefixHumanReadable     = "NextWithdrawalValidatorIndexPrefix"
 	ForkPrefixHumanReadable                             = "ForkPrefix"
 )
+
+// Name returns the human readable name of the given collection prefix, and
+// false if the prefix is unknown.
+func Name(prefix byte) (string, bool) {
+	names := [...]string{
+		WithdrawalQueuePrefix:                  WithdrawalQueuePrefixHumanReadable,
+		RandaoMixPrefix:                        RandaoMixPrefixHumanReadable,
+		SlashingsPrefix:                        SlashingsPrefixHumanReadable,
+		TotalSlashingPrefix:                    TotalSlashingPrefixHumanReadable,
+		ValidatorIndexPrefix:                   ValidatorIndexPrefixHumanReadable,
+		BlockRootsPrefix:                       BlockRootsPrefixHumanReadable,
+		StateRootsPrefix:                       StateRootsPrefixHumanReadable,
+		ValidatorByIndexPrefix:                 ValidatorByIndexPrefixHumanReadable,
+		ValidatorPubkeyToIndexPrefix:           ValidatorPubkeyToIndexPrefixHumanReadable,
+		ValidatorConsAddrToIndexPrefix:         ValidatorConsAddrToIndexPrefixHumanReadable,
+		ValidatorEffectiveBalanceToIndexPrefix: ValidatorEffectiveBalanceToIndexPrefixHumanReadable,
+		LatestBeaconBlockHeaderPrefix:          LatestBeaconBlockHeaderPrefixHumanReadable,
+		SlotPrefix:                             SlotPrefixHumanReadable,
+		BalancesPrefix:                         BalancesPrefixHumanReadable,
+		Eth1BlockHashPrefix:                    Eth1BlockHashPrefixHumanReadable,
+		Eth1DataPrefix:                         Eth1DataPrefixHumanReadable,
+		Eth1DepositIndexPrefix:                 Eth1DepositIndexPrefixHumanReadable,
+		LatestExecutionPayloadHeaderPrefix:     LatestExecutionPayloadHeaderPrefixHumanReadable,
+		LatestExecutionPayloadVersionPrefix:    LatestExecutionPayloadVersionPrefixHumanReadable,
+		GenesisValidatorsRootPrefix:            GenesisValidatorsRootPrefixHumanReadable,
+		NextWithdrawalIndexPrefix:              NextWithdrawalIndexPrefixHumanReadable,
+		NextWithdrawalValidatorIndexPrefix:     NextWithdrawalValidatorIndexPrefixHumanReadable,
+		ForkPrefix:                             ForkPrefixHumanReadable,
+	}
+	if int(prefix) >= len(names) {
+		return "", false
+	}
+	return names[prefix], true
+}
@@ -238,6 +238,14 @@ func (kv *KVStore) Copy(ctx context.Context) *KVStore {
 	return ss
 }
 
+// Batch returns a copy of the Store accumulating its writes in memory, and a
+// function writing them all at once to the Store. The writes are discarded
+// if the function is never called.
+func (kv *KVStore) Batch() (*KVStore, func()) {
+	cctx, write := sdk.UnwrapSDKContext(kv.ctx).CacheContext()
+	return kv.WithContext(cctx), write
+}
+
 // Context returns the context of the Store.
 func (kv *KVStore) Context() context.Context {
 	return kv.ctx
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/storage/beacondb/keys"
+)
+
+const (
+	// opsKey counts the operations on the beacon state store.
+	opsKey = "beacon_kit.storage.beacon_state.ops"
+	// opDurationKey measures the latency of the operations on the beacon
+	// state store.
+	opDurationKey = "beacon_kit.storage.beacon_state.op_duration"
+)
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments the counter identified by the provided
+	// key.
+	IncrementCounter(key string, args ...string)
+	// MeasureSince measures the time since the provided start time,
+	// identified by the provided key.
+	MeasureSince(key string, start time.Time, args ...string)
+}
+
+// NewMetricsKVStoreService wraps the given store service so that every read
+// and write of the beacon state is counted and timed, labeled by operation
+// and collection.
+func NewMetricsKVStoreService(
+	service store.KVStoreService,
+	sink TelemetrySink,
+) store.KVStoreService {
+	return metricsKVStoreService{service: service, sink: sink}
+}
+
+// metricsKVStoreService opens stores reporting their operations to the sink.
+type metricsKVStoreService struct {
+	service store.KVStoreService
+	sink    TelemetrySink
+}
+
+// OpenKVStore implements store.KVStoreService.
+func (s metricsKVStoreService) OpenKVStore(ctx context.Context) store.KVStore {
+	return metricsKVStore{KVStore: s.service.OpenKVStore(ctx), sink: s.sink}
+}
+
+// metricsKVStore is a store.KVStore reporting its operations to the sink.
+type metricsKVStore struct {
+	store.KVStore
+	sink TelemetrySink
+}
+
+// Get implements store.KVStore.
+func (s metricsKVStore) Get(key []byte) ([]byte, error) {
+	defer s.measure("get", key, time.Now())
+	return s.KVStore.Get(key)
+}
+
+// Has implements store.KVStore.
+func (s metricsKVStore) Has(key []byte) (bool, error) {
+	defer s.measure("has", key, time.Now())
+	return s.KVStore.Has(key)
+}
+
+// Set implements store.KVStore.
+func (s metricsKVStore) Set(key, value []byte) error {
+	defer s.measure("set", key, time.Now())
+	return s.KVStore.Set(key, value)
+}
+
+// Delete implements store.KVStore.
+func (s metricsKVStore) Delete(key []byte) error {
+	defer s.measure("delete", key, time.Now())
+	return s.KVStore.Delete(key)
+}
+
+// Iterator implements store.KVStore.
+func (s metricsKVStore) Iterator(start, end []byte) (store.Iterator, error) {
+	defer s.measure("iterate", start, time.Now())
+	return s.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator implements store.KVStore.
+func (s metricsKVStore) ReverseIterator(
+	start, end []byte,
+) (store.Iterator, error) {
+	defer s.measure("iterate", start, time.Now())
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// measure reports an operation on the given key, started at the given time.
+func (s metricsKVStore) measure(op string, key []byte, start time.Time) {
+	labels := []string{"op", op, "collection", collection(key)}
+	s.sink.IncrementCounter(opsKey, labels...)
+	s.sink.MeasureSince(opDurationKey, start, labels...)
+}
+
+// collection returns the name of the collection the key belongs to.
+func collection(key []byte) string {
+	if len(key) == 0 {
+		return "all"
+	}
+	if name, ok := keys.Name(key[0]); ok {
+		return name
+	}
+	return "unknown"
+}
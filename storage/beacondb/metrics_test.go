@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingSink records the labels of the metrics reported to it.
+type recordingSink struct {
+	counters  map[string][][]string
+	durations map[string][][]string
+}
+
+func (s *recordingSink) IncrementCounter(key string, args ...string) {
+	s.counters[key] = append(s.counters[key], args)
+}
+
+func (s *recordingSink) MeasureSince(key string, _ time.Time, args ...string) {
+	s.durations[key] = append(s.durations[key], args)
+}
+
+func TestMetricsKVStoreService(t *testing.T) {
+	cms := store.NewCommitMultiStore(
+		dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics(),
+	)
+	cms.MountStoreWithDB(testStoreKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	sink := &recordingSink{
+		counters:  make(map[string][][]string),
+		durations: make(map[string][][]string),
+	}
+	kv := beacondb.New(
+		beacondb.NewMetricsKVStoreService(
+			&testKVStoreService{
+				ctx: sdk.NewContext(cms, true, log.NewNopLogger()),
+			},
+			sink,
+		),
+		testCodec,
+	)
+
+	require.NoError(t, kv.SetSlot(math.Slot(7)))
+	slot, err := kv.GetSlot()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(7), slot)
+
+	expected := [][]string{
+		{"op", "set", "collection", "SlotPrefix"},
+		{"op", "get", "collection", "SlotPrefix"},
+	}
+	require.Equal(
+		t, expected, sink.counters["beacon_kit.storage.beacon_state.ops"],
+	)
+	require.Equal(
+		t, expected,
+		sink.durations["beacon_kit.storage.beacon_state.op_duration"],
+	)
+}
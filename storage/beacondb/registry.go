@@ -143,13 +143,35 @@ func (kv *KVStore) GetValidators() (
 	return vals, err
 }
 
+// IterateValidators calls fn with every validator of the beacon state in
+// index order, without loading the whole registry, until fn returns true or
+// an error. The state must not be written to from fn.
+func (kv *KVStore) IterateValidators(
+	fn func(math.ValidatorIndex, *ctypes.Validator) (bool, error),
+) error {
+	return kv.validators.Walk(
+		kv.ctx, nil,
+		func(idx uint64, val *ctypes.Validator) (bool, error) {
+			return fn(math.ValidatorIndex(idx), val)
+		},
+	)
+}
+
 // GetTotalValidators returns the total number of validators.
-func (kv *KVStore) GetTotalValidators() (uint64, error) {
-	validators, err := kv.GetValidators()
+func (kv *KVStore) GetTotalValidators() (total uint64, err error) {
+	iter, err := kv.validators.Iterate(kv.ctx, nil)
 	if err != nil {
 		return 0, err
 	}
-	return uint64(len(validators)), nil
+	defer func() {
+		err = errors.Join(err, iter.Close())
+	}()
+
+	// Count the keys only, which spares decoding the validators.
+	for ; iter.Valid(); iter.Next() {
+		total++
+	}
+	return total, nil
 }
 
 // GetValidatorsByEffectiveBalance retrieves all validators sorted by
@@ -226,6 +248,20 @@ func (kv *KVStore) GetBalances() ([]uint64, error) {
 	return balances, err
 }
 
+// IterateBalances calls fn with the balance of every validator of the
+// beacon state in index order, without loading all the balances, until fn
+// returns true or an error. The state must not be written to from fn.
+func (kv *KVStore) IterateBalances(
+	fn func(math.ValidatorIndex, math.Gwei) (bool, error),
+) error {
+	return kv.balances.Walk(
+		kv.ctx, nil,
+		func(idx uint64, balance uint64) (bool, error) {
+			return fn(math.ValidatorIndex(idx), math.Gwei(balance))
+		},
+	)
+}
+
 // GetTotalActiveBalances returns the total active balances of all validatorkv.
 // TODO: unhood this and probably store this as just a value changed on writekv.
 // TODO: this shouldn't live in KVStore
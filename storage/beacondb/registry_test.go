@@ -197,6 +197,75 @@ func TestValidators(t *testing.T) {
 	require.Equal(t, inUpdatedVal2, res[1])
 }
 
+func TestIterateValidators(t *testing.T) {
+	store, err := initTestStore()
+	require.NoError(t, err)
+
+	// nothing to iterate over to start
+	calls := 0
+	require.NoError(t, store.IterateValidators(
+		func(math.ValidatorIndex, *types.Validator) (bool, error) {
+			calls++
+			return false, nil
+		},
+	))
+	require.Zero(t, calls)
+
+	for i := range byte(3) {
+		require.NoError(t, store.AddValidator(&types.Validator{
+			Pubkey:           bytes.B48{i},
+			EffectiveBalance: math.Gwei(i) * 1e9,
+		}))
+		require.NoError(t, store.SetBalance(
+			math.ValidatorIndex(i), math.Gwei(i)*2e9,
+		))
+	}
+
+	// validators are iterated over in index order
+	var indices []math.ValidatorIndex
+	require.NoError(t, store.IterateValidators(
+		func(idx math.ValidatorIndex, val *types.Validator) (bool, error) {
+			require.Equal(t, bytes.B48{byte(idx)}, val.GetPubkey())
+			indices = append(indices, idx)
+			return false, nil
+		},
+	))
+	require.Equal(t, []math.ValidatorIndex{0, 1, 2}, indices)
+
+	// iteration stops when asked to
+	indices = nil
+	require.NoError(t, store.IterateValidators(
+		func(idx math.ValidatorIndex, _ *types.Validator) (bool, error) {
+			indices = append(indices, idx)
+			return idx == 1, nil
+		},
+	))
+	require.Equal(t, []math.ValidatorIndex{0, 1}, indices)
+
+	// iteration errors are returned
+	errStop := fmt.Errorf("stop")
+	err = store.IterateValidators(
+		func(math.ValidatorIndex, *types.Validator) (bool, error) {
+			return false, errStop
+		},
+	)
+	require.ErrorIs(t, err, errStop)
+
+	var balances []math.Gwei
+	require.NoError(t, store.IterateBalances(
+		func(idx math.ValidatorIndex, balance math.Gwei) (bool, error) {
+			require.Equal(t, math.Gwei(idx)*2e9, balance)
+			balances = append(balances, balance)
+			return false, nil
+		},
+	))
+	require.Len(t, balances, 3)
+
+	total, err := store.GetTotalValidators()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), total)
+}
+
 func initTestStore() (*beacondb.KVStore, error) {
 	db, err := db.OpenDB("", dbm.MemDBBackend)
 	if err != nil {
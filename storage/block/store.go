@@ -44,6 +44,14 @@ type KVStore[BeaconBlockT BeaconBlock] struct {
 	// Beacon state root to slot mapping is injective for finalized blocks.
 	stateRoots *lru.Cache[common.Root, math.Slot]
 
+	// Execution block hash to slot mapping is injective for finalized
+	// blocks, each one carrying a different execution payload.
+	executionHashes *lru.Cache[common.ExecutionHash, math.Slot]
+
+	// Slot to beacon block root and execution block hash mapping, the
+	// reverse of the blockRoots and executionHashes mappings.
+	slots *lru.Cache[math.Slot, blockHashes]
+
 	// Logger for the store.
 	logger log.Logger
 }
@@ -65,22 +73,41 @@ func NewStore[BeaconBlockT BeaconBlock](
 	if err != nil {
 		panic(err)
 	}
+	executionHashes, err := lru.New[common.ExecutionHash, math.Slot](
+		availabilityWindow,
+	)
+	if err != nil {
+		panic(err)
+	}
+	slots, err := lru.New[math.Slot, blockHashes](availabilityWindow)
+	if err != nil {
+		panic(err)
+	}
 	return &KVStore[BeaconBlockT]{
-		blockRoots: blockRoots,
-		timestamps: timestamps,
-		stateRoots: stateRoots,
-		logger:     logger,
+		blockRoots:      blockRoots,
+		timestamps:      timestamps,
+		stateRoots:      stateRoots,
+		executionHashes: executionHashes,
+		slots:           slots,
+		logger:          logger,
 	}
 }
 
 // Set sets the block by a given index in the store, storing the block root,
-// timestamp, and state root. Only this function may potentially evict
-// entries from the store if the availability window is reached.
+// timestamp, state root, and execution block hash. Only this function may
+// potentially evict entries from the store if the availability window is
+// reached.
 func (kv *KVStore[BeaconBlockT]) Set(blk BeaconBlockT) error {
 	slot := blk.GetSlot()
-	kv.blockRoots.Add(blk.HashTreeRoot(), slot)
+	blockRoot, executionHash := blk.HashTreeRoot(), blk.GetExecutionHash()
+	kv.blockRoots.Add(blockRoot, slot)
 	kv.timestamps.Add(blk.GetTimestamp(), slot)
 	kv.stateRoots.Add(blk.GetStateRoot(), slot)
+	kv.executionHashes.Add(executionHash, slot)
+	kv.slots.Add(slot, blockHashes{
+		blockRoot:     blockRoot,
+		executionHash: executionHash,
+	})
 	return nil
 }
 
@@ -121,3 +148,42 @@ func (kv *KVStore[BeaconBlockT]) GetSlotByStateRoot(
 	}
 	return slot, nil
 }
+
+// GetSlotByExecutionHash retrieves the slot by a given execution block hash
+// from the store.
+func (kv *KVStore[BeaconBlockT]) GetSlotByExecutionHash(
+	executionHash common.ExecutionHash,
+) (math.Slot, error) {
+	slot, ok := kv.executionHashes.Peek(executionHash)
+	if !ok {
+		return 0, fmt.Errorf(
+			"slot not found at execution hash: %s", executionHash,
+		)
+	}
+	return slot, nil
+}
+
+// GetBlockRootBySlot retrieves the block root by a given slot from the store.
+func (kv *KVStore[BeaconBlockT]) GetBlockRootBySlot(
+	slot math.Slot,
+) (common.Root, error) {
+	hashes, ok := kv.slots.Peek(slot)
+	if !ok {
+		return common.Root{}, fmt.Errorf("block root not found at slot: %d", slot)
+	}
+	return hashes.blockRoot, nil
+}
+
+// GetExecutionHashBySlot retrieves the execution block hash by a given slot
+// from the store.
+func (kv *KVStore[BeaconBlockT]) GetExecutionHashBySlot(
+	slot math.Slot,
+) (common.ExecutionHash, error) {
+	hashes, ok := kv.slots.Peek(slot)
+	if !ok {
+		return common.ExecutionHash{}, fmt.Errorf(
+			"execution hash not found at slot: %d", slot,
+		)
+	}
+	return hashes.executionHash, nil
+}
@@ -50,6 +50,10 @@ func (m MockBeaconBlock) GetStateRoot() common.Root {
 	return [32]byte{byte(m.slot)}
 }
 
+func (m MockBeaconBlock) GetExecutionHash() common.ExecutionHash {
+	return [32]byte{0xee, byte(m.slot)}
+}
+
 func TestBlockStore(t *testing.T) {
 	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 5)
 
@@ -78,6 +82,18 @@ func TestBlockStore(t *testing.T) {
 		slot, err = blockStore.GetSlotByStateRoot([32]byte{byte(i)})
 		require.NoError(t, err)
 		require.Equal(t, i, slot)
+
+		slot, err = blockStore.GetSlotByExecutionHash([32]byte{0xee, byte(i)})
+		require.NoError(t, err)
+		require.Equal(t, i, slot)
+
+		root, rootErr := blockStore.GetBlockRootBySlot(i)
+		require.NoError(t, rootErr)
+		require.Equal(t, common.Root{byte(i)}, root)
+
+		hash, hashErr := blockStore.GetExecutionHashBySlot(i)
+		require.NoError(t, hashErr)
+		require.Equal(t, common.ExecutionHash{0xee, byte(i)}, hash)
 	}
 
 	// Try getting a slot that doesn't exist.
@@ -85,4 +101,8 @@ func TestBlockStore(t *testing.T) {
 	require.ErrorContains(t, err, "not found")
 	_, err = blockStore.GetParentSlotByTimestamp(2)
 	require.ErrorContains(t, err, "not found")
+	_, err = blockStore.GetSlotByExecutionHash([32]byte{0xee, byte(2)})
+	require.ErrorContains(t, err, "not found")
+	_, err = blockStore.GetBlockRootBySlot(2)
+	require.ErrorContains(t, err, "not found")
 }
@@ -25,11 +25,19 @@ import (
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
+// blockHashes are the beacon block root and execution block hash of a
+// finalized block.
+type blockHashes struct {
+	blockRoot     common.Root
+	executionHash common.ExecutionHash
+}
+
 // BeaconBlock is a block in the beacon chain that has a slot, block root (hash
-// tree root), timestamp, and state root.
+// tree root), timestamp, state root, and execution block hash.
 type BeaconBlock interface {
 	GetSlot() math.U64
 	HashTreeRoot() common.Root
 	GetTimestamp() math.U64
 	GetStateRoot() common.Root
+	GetExecutionHash() common.ExecutionHash
 }
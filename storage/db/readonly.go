@@ -0,0 +1,261 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/cockroachdb/pebble"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// ErrReadOnly is returned when writing to a store opened read-only.
+var ErrReadOnly = errors.New("store is opened read-only")
+
+// errKeyEmpty is returned when iterating over a domain bounded by an empty
+// key, as the cosmos-db backends do.
+var errKeyEmpty = errors.New("key cannot be empty")
+
+// OpenReadOnlyDB opens the application database without allowing any write
+// to it.
+func OpenReadOnlyDB(rootDir string) (dbm.DB, error) {
+	return OpenReadOnly("application", filepath.Join(rootDir, "data"))
+}
+
+// OpenReadOnly opens the existing pebble database of the given name in the
+// given directory, as laid out by the cosmos-db pebble backend, without
+// allowing any write to it. Writes return ErrReadOnly.
+//
+// NOTE: pebble locks the database directory even when opened read-only, so
+// the database cannot be opened while a node is running against it.
+func OpenReadOnly(name, dir string) (dbm.DB, error) {
+	db, err := pebble.Open(
+		filepath.Join(dir, name+".db"), &pebble.Options{ReadOnly: true},
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open %s read-only", name)
+	}
+	return &readOnlyDB{db: db}, nil
+}
+
+// readOnlyDB is a dbm.DB over a pebble database opened read-only.
+type readOnlyDB struct {
+	db *pebble.DB
+}
+
+// Get implements dbm.DB.
+func (db *readOnlyDB) Get(key []byte) ([]byte, error) {
+	value, closer, err := db.db.Get(key)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	return bytes.Clone(value), nil
+}
+
+// Has implements dbm.DB.
+func (db *readOnlyDB) Has(key []byte) (bool, error) {
+	value, err := db.Get(key)
+	return value != nil, err
+}
+
+// Set implements dbm.DB.
+func (*readOnlyDB) Set([]byte, []byte) error {
+	return ErrReadOnly
+}
+
+// SetSync implements dbm.DB.
+func (*readOnlyDB) SetSync([]byte, []byte) error {
+	return ErrReadOnly
+}
+
+// Delete implements dbm.DB.
+func (*readOnlyDB) Delete([]byte) error {
+	return ErrReadOnly
+}
+
+// DeleteSync implements dbm.DB.
+func (*readOnlyDB) DeleteSync([]byte) error {
+	return ErrReadOnly
+}
+
+// Iterator implements dbm.DB.
+func (db *readOnlyDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	return db.newIterator(start, end, false)
+}
+
+// ReverseIterator implements dbm.DB.
+func (db *readOnlyDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	return db.newIterator(start, end, true)
+}
+
+// Close implements dbm.DB.
+func (db *readOnlyDB) Close() error {
+	return db.db.Close()
+}
+
+// NewBatch implements dbm.DB.
+func (*readOnlyDB) NewBatch() dbm.Batch {
+	return readOnlyBatch{}
+}
+
+// NewBatchWithSize implements dbm.DB.
+func (*readOnlyDB) NewBatchWithSize(int) dbm.Batch {
+	return readOnlyBatch{}
+}
+
+// Print implements dbm.DB.
+func (db *readOnlyDB) Print() error {
+	itr, err := db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		//nolint:forbidigo // mirrors the cosmos-db backends.
+		fmt.Printf("[%X]:\t[%X]\n", itr.Key(), itr.Value())
+	}
+	return nil
+}
+
+// Stats implements dbm.DB.
+func (*readOnlyDB) Stats() map[string]string {
+	return nil
+}
+
+// newIterator returns an iterator over the [start, end) domain, a nil bound
+// leaving the domain open on its side.
+func (db *readOnlyDB) newIterator(
+	start, end []byte, reverse bool,
+) (*readOnlyIterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, errKeyEmpty
+	}
+	source, err := db.db.NewIter(&pebble.IterOptions{
+		LowerBound: start,
+		UpperBound: end,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if reverse {
+		source.Last()
+	} else {
+		source.First()
+	}
+	return &readOnlyIterator{
+		source: source, start: start, end: end, reverse: reverse,
+	}, nil
+}
+
+// readOnlyBatch is a dbm.Batch which cannot be written.
+type readOnlyBatch struct{}
+
+// Set implements dbm.Batch.
+func (readOnlyBatch) Set([]byte, []byte) error {
+	return ErrReadOnly
+}
+
+// Delete implements dbm.Batch.
+func (readOnlyBatch) Delete([]byte) error {
+	return ErrReadOnly
+}
+
+// Write implements dbm.Batch.
+func (readOnlyBatch) Write() error {
+	return ErrReadOnly
+}
+
+// WriteSync implements dbm.Batch.
+func (readOnlyBatch) WriteSync() error {
+	return ErrReadOnly
+}
+
+// Close implements dbm.Batch.
+func (readOnlyBatch) Close() error {
+	return nil
+}
+
+// GetByteSize implements dbm.Batch.
+func (readOnlyBatch) GetByteSize() (int, error) {
+	return 0, nil
+}
+
+// readOnlyIterator is a dbm.Iterator over a pebble iterator bounded to its
+// domain.
+type readOnlyIterator struct {
+	source     *pebble.Iterator
+	start, end []byte
+	reverse    bool
+}
+
+// Domain implements dbm.Iterator.
+func (itr *readOnlyIterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+// Valid implements dbm.Iterator.
+func (itr *readOnlyIterator) Valid() bool {
+	return itr.source.Valid()
+}
+
+// Next implements dbm.Iterator.
+func (itr *readOnlyIterator) Next() {
+	itr.assertIsValid()
+	if itr.reverse {
+		itr.source.Prev()
+	} else {
+		itr.source.Next()
+	}
+}
+
+// Key implements dbm.Iterator.
+func (itr *readOnlyIterator) Key() []byte {
+	itr.assertIsValid()
+	return bytes.Clone(itr.source.Key())
+}
+
+// Value implements dbm.Iterator.
+func (itr *readOnlyIterator) Value() []byte {
+	itr.assertIsValid()
+	return bytes.Clone(itr.source.Value())
+}
+
+// Error implements dbm.Iterator.
+func (itr *readOnlyIterator) Error() error {
+	return itr.source.Error()
+}
+
+// Close implements dbm.Iterator.
+func (itr *readOnlyIterator) Close() error {
+	return itr.source.Close()
+}
+
+func (itr *readOnlyIterator) assertIsValid() {
+	if !itr.Valid() {
+		panic("iterator is invalid")
+	}
+}
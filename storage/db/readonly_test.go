@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/storage/db"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenReadOnly(t *testing.T) {
+	dir := t.TempDir()
+	rw, err := dbm.NewDB("test", dbm.PebbleDBBackend, dir)
+	require.NoError(t, err)
+	for _, key := range []string{"a", "b", "c"} {
+		require.NoError(t, rw.Set([]byte(key), []byte("value-"+key)))
+	}
+	require.NoError(t, rw.Close())
+
+	ro, err := db.OpenReadOnly("test", dir)
+	require.NoError(t, err)
+	defer ro.Close()
+
+	value, err := ro.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value-b"), value)
+	value, err = ro.Get([]byte("d"))
+	require.NoError(t, err)
+	require.Nil(t, value)
+	has, err := ro.Has([]byte("a"))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	keys := func(itr dbm.Iterator, err error) []string {
+		require.NoError(t, err)
+		defer itr.Close()
+		var keys []string
+		for ; itr.Valid(); itr.Next() {
+			keys = append(keys, string(itr.Key()))
+		}
+		require.NoError(t, itr.Error())
+		return keys
+	}
+	require.Equal(t, []string{"a", "b", "c"}, keys(ro.Iterator(nil, nil)))
+	require.Equal(t, []string{"b"}, keys(ro.Iterator([]byte("b"), []byte("c"))))
+	require.Equal(t, []string{"c", "b"}, keys(ro.ReverseIterator([]byte("b"), nil)))
+	_, err = ro.Iterator([]byte{}, nil)
+	require.Error(t, err)
+
+	require.ErrorIs(t, ro.Set([]byte("d"), []byte("value-d")), db.ErrReadOnly)
+	require.ErrorIs(t, ro.Delete([]byte("a")), db.ErrReadOnly)
+	batch := ro.NewBatch()
+	require.ErrorIs(t, batch.Set([]byte("d"), []byte("value-d")), db.ErrReadOnly)
+	require.ErrorIs(t, batch.WriteSync(), db.ErrReadOnly)
+	require.NoError(t, batch.Close())
+}
+
+func TestOpenReadOnlyMissing(t *testing.T) {
+	_, err := db.OpenReadOnly("missing", t.TempDir())
+	require.Error(t, err)
+}
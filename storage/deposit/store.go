@@ -22,6 +22,7 @@ package deposit
 
 import (
 	"context"
+	"io"
 	"sync"
 
 	sdkcollections "cosmossdk.io/collections"
@@ -30,21 +31,65 @@ import (
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/storage/encoding"
+	"github.com/berachain/beacon-kit/storage/migration"
 	"github.com/berachain/beacon-kit/storage/pruner"
+	"github.com/berachain/beacon-kit/storage/wal"
 )
 
-const KeyDepositPrefix = "deposit"
+const (
+	KeyDepositPrefix       = "deposit"
+	KeyFailedBlockPrefix   = "failed_block"
+	KeyProgressPrefix      = "progress"
+	KeyBlockDepositsPrefix = "block_deposits"
+)
+
+// Schemas returns the schemas of the keys of the store, migrated when the
+// database is opened.
+func Schemas() []migration.Schema {
+	return []migration.Schema{
+		{Prefix: KeyDepositPrefix},
+		{Prefix: KeyFailedBlockPrefix},
+		{Prefix: KeyProgressPrefix},
+		{Prefix: KeyBlockDepositsPrefix},
+	}
+}
 
 // KVStore is a simple KV store based implementation that assumes
 // the deposit indexes are tracked outside of the kv store.
 type KVStore struct {
 	store sdkcollections.Map[uint64, *ctypes.Deposit]
 
-	// mu protects store for concurrent access
+	// failedBlocks holds the execution blocks whose deposits failed to be
+	// fetched and must be retried. They are persisted along with deposits so
+	// that no deposit is dropped if the node restarts before the retry.
+	failedBlocks sdkcollections.KeySet[uint64]
+
+	// progress holds the markers of how far the execution layer has been
+	// followed, keyed by progress marker, from which following resumes after
+	// a restart.
+	progress sdkcollections.Map[string, uint64]
+
+	// blockDeposits holds the index of the first deposit fetched from each
+	// execution block with deposits, keyed by block number, from which the
+	// store is rewound.
+	blockDeposits sdkcollections.Map[uint64, uint64]
+
+	// mu protects store, failedBlocks, progress and blockDeposits for
+	// concurrent access
 	mu sync.RWMutex
 
+	// wal logs the operations on the store before they are applied, if set.
+	// The store does not sync its writes to disk, so they may be lost on a
+	// crash after the execution layer blocks they come from are processed.
+	wal *wal.WAL
+	// walRecords is the number of records logged since the last reset.
+	walRecords int
+
 	// logger is used for logging information and errors.
 	logger log.Logger
+
+	// closer closes the underlying database, if it can be closed.
+	closer io.Closer
 }
 
 // NewStore creates a new deposit store.
@@ -61,14 +106,52 @@ func NewStore(
 			sdkcollections.Uint64Key,
 			encoding.SSZValueCodec[*ctypes.Deposit]{},
 		),
+		failedBlocks: sdkcollections.NewKeySet(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyFailedBlockPrefix)),
+			KeyFailedBlockPrefix,
+			sdkcollections.Uint64Key,
+		),
+		progress: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyProgressPrefix)),
+			KeyProgressPrefix,
+			sdkcollections.StringKey,
+			sdkcollections.Uint64Value,
+		),
+		blockDeposits: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyBlockDepositsPrefix)),
+			KeyBlockDepositsPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
 		logger: logger,
 	}
 	if _, err := schemaBuilder.Build(); err != nil {
 		panic(errors.Wrap(err, "failed building KVStore schema"))
 	}
+	if closer, ok := kvsp.(io.Closer); ok {
+		res.closer = closer
+	}
 	return res
 }
 
+// Close waits for pending writes to complete and closes the underlying
+// database. The store must not be used afterwards.
+func (kv *KVStore) Close() error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	var errs []error
+	if kv.wal != nil {
+		errs = append(errs, kv.wal.Close())
+	}
+	if kv.closer != nil {
+		errs = append(errs, kv.closer.Close())
+	}
+	return errors.Join(errs...)
+}
+
 // GetDepositsByIndex returns the first N deposits starting from the given
 // index. If N is greater than the number of deposits, it returns up to the
 // last deposit.
@@ -101,11 +184,43 @@ func (kv *KVStore) GetDepositsByIndex(
 	return deposits, nil
 }
 
+// GetDepositsFromIndex returns all the deposits starting from the given
+// index, ordered by index.
+func (kv *KVStore) GetDepositsFromIndex(
+	startIndex uint64,
+) (ctypes.Deposits, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	iter, err := kv.store.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).StartInclusive(startIndex),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err, "failed to iterate deposits, start: %d", startIndex,
+		)
+	}
+	defer iter.Close()
+	return iter.Values()
+}
+
 // EnqueueDeposits pushes multiple deposits to the queue.
 func (kv *KVStore) EnqueueDeposits(deposits []*ctypes.Deposit) error {
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
 
+	records := make([][]byte, 0, len(deposits))
+	for _, deposit := range deposits {
+		record, err := depositRecord(deposit)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+	}
+	if err := kv.log(records...); err != nil {
+		return err
+	}
+
 	for _, deposit := range deposits {
 		idx := deposit.GetIndex().Unwrap()
 		if err := kv.store.Set(context.TODO(), idx, deposit); err != nil {
@@ -140,5 +255,156 @@ func (kv *KVStore) Prune(start, end uint64) error {
 	}
 
 	kv.logger.Debug("Pruned deposits", "start", start, "end", end)
+	return kv.compactWAL()
+}
+
+// AddFailedBlock records that the deposits of the given execution block
+// failed to be fetched.
+func (kv *KVStore) AddFailedBlock(blockNum uint64) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if err := kv.log(blockRecord(recordFailedBlock, blockNum)); err != nil {
+		return err
+	}
+	if err := kv.failedBlocks.Set(context.TODO(), blockNum); err != nil {
+		return errors.Wrapf(err, "failed to add failed block %d", blockNum)
+	}
+	return nil
+}
+
+// RemoveFailedBlock records that the deposits of the given execution block
+// have been fetched.
+func (kv *KVStore) RemoveFailedBlock(blockNum uint64) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if err := kv.log(blockRecord(recordFetchedBlock, blockNum)); err != nil {
+		return err
+	}
+	if err := kv.failedBlocks.Remove(context.TODO(), blockNum); err != nil {
+		return errors.Wrapf(err, "failed to remove failed block %d", blockNum)
+	}
+	return nil
+}
+
+// GetFailedBlocks returns the execution blocks whose deposits failed to be
+// fetched, in ascending order.
+func (kv *KVStore) GetFailedBlocks() ([]uint64, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	iter, err := kv.failedBlocks.Iterate(context.TODO(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate failed blocks")
+	}
+	defer iter.Close()
+	return iter.Keys()
+}
+
+// GetProgress returns the execution block number recorded for the given
+// progress marker, or zero if none is recorded.
+func (kv *KVStore) GetProgress(marker string) (uint64, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	blockNum, err := kv.progress.Get(context.TODO(), marker)
+	switch {
+	case err == nil:
+		return blockNum, nil
+	case errors.Is(err, sdkcollections.ErrNotFound):
+		return 0, nil
+	default:
+		return 0, errors.Wrapf(err, "failed to get progress %s", marker)
+	}
+}
+
+// SetProgress records the execution block number reached by the given
+// progress marker. Progress is not logged to the write-ahead log: a lost
+// write only leaves the marker behind, from where following is safely
+// resumed again.
+func (kv *KVStore) SetProgress(marker string, blockNum uint64) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if err := kv.progress.Set(context.TODO(), marker, blockNum); err != nil {
+		return errors.Wrapf(err, "failed to set progress %s", marker)
+	}
+	return nil
+}
+
+// SetBlockDeposits records the index of the first deposit fetched from the
+// given execution block. It is not logged to the write-ahead log: a lost
+// write only keeps the deposits of the block when the store is rewound
+// before it, and those are fetched again anyway.
+func (kv *KVStore) SetBlockDeposits(blockNum, firstIndex uint64) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if err := kv.blockDeposits.Set(
+		context.TODO(), blockNum, firstIndex,
+	); err != nil {
+		return errors.Wrapf(err, "failed to set deposits of block %d", blockNum)
+	}
 	return nil
 }
+
+// Rewind rewinds the store to the given execution block, as when the beacon
+// state is rolled back: the deposits fetched from the blocks after it are
+// removed, along with the blocks after it whose deposits failed to be
+// fetched, and the progress markers past it are moved back to it.
+func (kv *KVStore) Rewind(blockNum uint64) error {
+	var (
+		ctx   = context.TODO()
+		after = new(sdkcollections.Range[uint64]).StartExclusive(blockNum)
+	)
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	// Deposit indexes increase with the execution blocks they are fetched
+	// from, so the deposits from the first one of the blocks after the given
+	// one were all fetched from them.
+	var (
+		first   uint64
+		rewound bool
+	)
+	if err := kv.blockDeposits.Walk(
+		ctx, after, func(_ uint64, index uint64) (bool, error) {
+			if !rewound || index < first {
+				first, rewound = index, true
+			}
+			return false, nil
+		},
+	); err != nil {
+		return errors.Wrapf(err, "failed to walk deposits after block %d", blockNum)
+	}
+	if rewound {
+		if err := kv.store.Clear(
+			ctx, new(sdkcollections.Range[uint64]).StartInclusive(first),
+		); err != nil {
+			return errors.Wrapf(err, "failed to remove deposits from %d", first)
+		}
+	}
+	if err := kv.blockDeposits.Clear(ctx, after); err != nil {
+		return errors.Wrapf(err, "failed to remove blocks after %d", blockNum)
+	}
+	if err := kv.failedBlocks.Clear(ctx, after); err != nil {
+		return errors.Wrapf(
+			err, "failed to remove failed blocks after %d", blockNum,
+		)
+	}
+
+	iter, err := kv.progress.Iterate(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to iterate progress")
+	}
+	progress, err := iter.KeyValues()
+	if err != nil {
+		return errors.Wrap(err, "failed to get progress")
+	}
+	for _, p := range progress {
+		if p.Value <= blockNum {
+			continue
+		}
+		if err = kv.progress.Set(ctx, p.Key, blockNum); err != nil {
+			return errors.Wrapf(err, "failed to set progress %s", p.Key)
+		}
+	}
+
+	kv.logger.Info("Rewound deposit store", "block", blockNum)
+	return kv.compactWAL()
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/berachain/beacon-kit/storage/wal"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingDepositsSurviveRestart(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := deposit.NewStore(
+		storage.NewKVStoreProvider(db), noop.NewLogger[any](),
+	)
+
+	// deposits may be enqueued out of order
+	require.NoError(t, store.EnqueueDeposits([]*ctypes.Deposit{
+		{Index: 2}, {Index: 0}, {Index: 1},
+	}))
+	require.NoError(t, store.AddFailedBlock(12))
+	require.NoError(t, store.AddFailedBlock(10))
+	require.NoError(t, store.AddFailedBlock(11))
+	require.NoError(t, store.RemoveFailedBlock(11))
+	require.NoError(t, store.SetProgress("deposits_scanned", 12))
+
+	// reopen the store over the same db, as on restart
+	store = deposit.NewStore(
+		storage.NewKVStoreProvider(db), noop.NewLogger[any](),
+	)
+
+	deposits, err := store.GetDepositsFromIndex(1)
+	require.NoError(t, err)
+	require.Len(t, deposits, 2)
+	require.Equal(t, uint64(1), deposits[0].GetIndex().Unwrap())
+	require.Equal(t, uint64(2), deposits[1].GetIndex().Unwrap())
+
+	deposits, err = store.GetDepositsFromIndex(3)
+	require.NoError(t, err)
+	require.Empty(t, deposits)
+
+	failedBlocks, err := store.GetFailedBlocks()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10, 12}, failedBlocks)
+
+	scanned, err := store.GetProgress("deposits_scanned")
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), scanned)
+	head, err := store.GetProgress("forkchoice_head")
+	require.NoError(t, err)
+	require.Zero(t, head)
+}
+
+func TestWALRestoresLostWrites(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deposits.wal")
+	open := func(db dbm.DB) *deposit.KVStore {
+		t.Helper()
+		w, err := wal.Open(path)
+		require.NoError(t, err)
+		store := deposit.NewStore(
+			storage.NewKVStoreProvider(db), noop.NewLogger[any](),
+		)
+		require.NoError(t, store.UseWAL(w))
+		return store
+	}
+
+	store := open(dbm.NewMemDB())
+	require.NoError(t, store.EnqueueDeposits([]*ctypes.Deposit{
+		{Index: 0}, {Index: 1}, {Index: 2},
+	}))
+	require.NoError(t, store.AddFailedBlock(10))
+	require.NoError(t, store.AddFailedBlock(11))
+	require.NoError(t, store.RemoveFailedBlock(10))
+	require.NoError(t, store.Close())
+
+	// The writes to the db are lost, as on a crash before they are synced.
+	store = open(dbm.NewMemDB())
+	deposits, err := store.GetDepositsFromIndex(0)
+	require.NoError(t, err)
+	require.Len(t, deposits, 3)
+	failedBlocks, err := store.GetFailedBlocks()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{11}, failedBlocks)
+
+	// Pruned deposits, included in finalized blocks, are dropped from the
+	// log.
+	require.NoError(t, store.Prune(0, 2))
+	require.NoError(t, store.Close())
+
+	store = open(dbm.NewMemDB())
+	deposits, err = store.GetDepositsFromIndex(0)
+	require.NoError(t, err)
+	require.Len(t, deposits, 1)
+	require.Equal(t, uint64(2), deposits[0].GetIndex().Unwrap())
+	failedBlocks, err = store.GetFailedBlocks()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{11}, failedBlocks)
+	require.NoError(t, store.Close())
+}
@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"encoding/binary"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/storage/wal"
+)
+
+// The kinds of the records of the write-ahead log, their first byte.
+const (
+	// recordDeposit is followed by the SSZ encoding of the enqueued deposit.
+	recordDeposit byte = iota
+	// recordFailedBlock is followed by the big endian number of the
+	// execution block whose deposits failed to be fetched.
+	recordFailedBlock
+	// recordFetchedBlock is followed by the big endian number of the
+	// execution block whose deposits have been fetched.
+	recordFetchedBlock
+)
+
+// ErrInvalidWALRecord is returned when a record of the write-ahead log of the
+// store is malformed.
+var ErrInvalidWALRecord = errors.New("invalid deposit write-ahead log record")
+
+// UseWAL replays the operations logged to the write-ahead log onto the store,
+// restoring the ones whose writes were lost on a crash, and logs the next
+// operations to it before applying them. Replaying is idempotent: deposits
+// are keyed by index, so replayed deposits overwrite themselves.
+func (kv *KVStore) UseWAL(w *wal.WAL) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	var replayed int
+	if err := w.Records(func(record []byte) error {
+		replayed++
+		return kv.apply(record)
+	}); err != nil {
+		return errors.Wrap(err, "failed to replay deposit write-ahead log")
+	}
+	if replayed > 0 {
+		kv.logger.Info("Replayed deposit write-ahead log", "records", replayed)
+	}
+	kv.wal, kv.walRecords = w, replayed
+	return nil
+}
+
+// log logs the records to the write-ahead log, if any.
+func (kv *KVStore) log(records ...[]byte) error {
+	if kv.wal == nil || len(records) == 0 {
+		return nil
+	}
+	if err := kv.wal.Append(records...); err != nil {
+		return errors.Wrap(err, "failed to log to deposit write-ahead log")
+	}
+	kv.walRecords += len(records)
+	return nil
+}
+
+// compactWAL resets the write-ahead log, if any, to the deposits and failed
+// blocks left in the store, dropping the records of the pruned deposits,
+// which have been included in finalized blocks.
+func (kv *KVStore) compactWAL() error {
+	if kv.wal == nil || kv.walRecords == 0 {
+		return nil
+	}
+	ctx := context.TODO()
+	var records [][]byte
+	if err := kv.store.Walk(
+		ctx, nil, func(_ uint64, deposit *ctypes.Deposit) (bool, error) {
+			record, err := depositRecord(deposit)
+			records = append(records, record)
+			return false, err
+		},
+	); err != nil {
+		return err
+	}
+	if err := kv.failedBlocks.Walk(
+		ctx, nil, func(blockNum uint64) (bool, error) {
+			records = append(records, blockRecord(recordFailedBlock, blockNum))
+			return false, nil
+		},
+	); err != nil {
+		return err
+	}
+	if err := kv.wal.Reset(records...); err != nil {
+		return errors.Wrap(err, "failed to compact deposit write-ahead log")
+	}
+	kv.walRecords = 0
+	return nil
+}
+
+// apply applies the operation of the record to the store.
+func (kv *KVStore) apply(record []byte) error {
+	if len(record) == 0 {
+		return ErrInvalidWALRecord
+	}
+	ctx := context.TODO()
+	kind, payload := record[0], record[1:]
+	switch kind {
+	case recordDeposit:
+		deposit := new(ctypes.Deposit)
+		if err := deposit.UnmarshalSSZ(payload); err != nil {
+			return errors.Join(ErrInvalidWALRecord, err)
+		}
+		return kv.store.Set(ctx, deposit.GetIndex().Unwrap(), deposit)
+	case recordFailedBlock, recordFetchedBlock:
+		if len(payload) != 8 { //nolint:mnd // uint64.
+			return ErrInvalidWALRecord
+		}
+		blockNum := binary.BigEndian.Uint64(payload)
+		if kind == recordFailedBlock {
+			return kv.failedBlocks.Set(ctx, blockNum)
+		}
+		return kv.failedBlocks.Remove(ctx, blockNum)
+	default:
+		return errors.Wrapf(ErrInvalidWALRecord, "unknown kind %d", kind)
+	}
+}
+
+// depositRecord returns the record of the enqueued deposit.
+func depositRecord(deposit *ctypes.Deposit) ([]byte, error) {
+	bz, err := deposit.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{recordDeposit}, bz...), nil
+}
+
+// blockRecord returns the record of the given kind of the execution block.
+func blockRecord(kind byte, blockNum uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte{kind}, blockNum)
+}
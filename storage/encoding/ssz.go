@@ -22,6 +22,7 @@ package encoding
 
 import (
 	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/versioned"
 	"github.com/davecgh/go-spew/spew"
 )
 
@@ -67,13 +68,25 @@ func (SSZValueCodec[T]) ValueType() string {
 //
 // This type exists for codecs for interfaces, which require a factory function
 // to create new instances of the underlying hard type since reflect cannot
-// infer the type of an interface.
+// infer the type of an interface. The values are decoded with the types
+// registered in the versioned codec, if any, and by NewFromSSZ otherwise.
 type SSZInterfaceCodec[T interface {
 	constraints.SSZMarshallable
 	constraints.Versionable
 	NewFromSSZ([]byte, uint32) (T, error)
 }] struct {
 	latestVersion uint32
+	versioned     *versioned.Codec[T]
+}
+
+// NewSSZInterfaceCodec creates a codec decoding the values with the types
+// registered in the given versioned codec for the active fork version.
+func NewSSZInterfaceCodec[T interface {
+	constraints.SSZMarshallable
+	constraints.Versionable
+	NewFromSSZ([]byte, uint32) (T, error)
+}](codec *versioned.Codec[T]) *SSZInterfaceCodec[T] {
+	return &SSZInterfaceCodec[T]{versioned: codec}
 }
 
 // SetActiveForkVersion sets the fork version for the codec.
@@ -88,6 +101,9 @@ func (cdc *SSZInterfaceCodec[T]) Encode(value T) ([]byte, error) {
 
 // Decode unmarshals the provided bytes into a value of type T.
 func (cdc SSZInterfaceCodec[T]) Decode(b []byte) (T, error) {
+	if cdc.versioned != nil {
+		return cdc.versioned.Unmarshal(cdc.latestVersion, b)
+	}
 	var t T
 	return t.NewFromSSZ(b, cdc.latestVersion)
 }
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+
+	"github.com/berachain/beacon-kit/errors"
+)
+
+// sealedPrefix prefixes the values sealed by the database cipher, which
+// tells them apart from the values written before encryption was enabled.
+const sealedPrefix = "\x00bkenc\x01"
+
+var (
+	// ErrNoCipher is returned when reading a sealed value from a database
+	// without a cipher.
+	ErrNoCipher = errors.New("value is encrypted but no cipher is set")
+
+	// ErrDecrypt is returned when a sealed value cannot be decrypted.
+	ErrDecrypt = errors.New("failed to decrypt value")
+)
+
+// NewAESGCM returns an AES-GCM cipher with the given AES key, which must be
+// 16, 24 or 32 bytes long.
+func NewAESGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts the value stored under the given key, binding it to the
+// key. It returns the value as is if the database has no cipher.
+func (db *DB) seal(key, value []byte) ([]byte, error) {
+	if db.aead == nil {
+		return value, nil
+	}
+	nonce := make([]byte, db.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	sealed := make(
+		[]byte, 0,
+		len(sealedPrefix)+len(nonce)+len(value)+db.aead.Overhead(),
+	)
+	sealed = append(sealed, sealedPrefix...)
+	sealed = append(sealed, nonce...)
+	return db.aead.Seal(sealed, nonce, value, key), nil
+}
+
+// open decrypts the value stored under the given key. Values which are not
+// sealed, having been written before encryption was enabled, are returned
+// as is.
+func (db *DB) open(key, value []byte) ([]byte, error) {
+	if !bytes.HasPrefix(value, []byte(sealedPrefix)) {
+		return value, nil
+	}
+	if db.aead == nil {
+		return nil, ErrNoCipher
+	}
+	sealed := value[len(sealedPrefix):]
+	n := db.aead.NonceSize()
+	if len(sealed) < n {
+		return nil, ErrDecrypt
+	}
+	plaintext, err := db.aead.Open(nil, sealed[:n], sealed[n:], key)
+	if err != nil {
+		return nil, errors.Join(ErrDecrypt, err)
+	}
+	return plaintext, nil
+}
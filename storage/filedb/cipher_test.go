@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	file "github.com/berachain/beacon-kit/storage/filedb"
+	"github.com/stretchr/testify/require"
+)
+
+func newEncryptedDB(t *testing.T, dir string, key byte) *file.DB {
+	t.Helper()
+	aead, err := file.NewAESGCM(bytes.Repeat([]byte{key}, 32))
+	require.NoError(t, err)
+	return file.NewDB(
+		file.WithRootDirectory(dir),
+		file.WithFileExtension("ssz"),
+		file.WithDirectoryPermissions(0o700),
+		file.WithLogger(log.NewNopLogger()),
+		file.WithCipher(aead),
+	)
+}
+
+func TestDBCipher(t *testing.T) {
+	dir := t.TempDir()
+	db := newEncryptedDB(t, dir, 0x01)
+	value := []byte("sidecar")
+
+	require.NoError(t, db.Set([]byte("1/a"), value))
+	got, err := db.Get([]byte("1/a"))
+	require.NoError(t, err)
+	require.Equal(t, value, got)
+
+	// The value is not stored in the clear.
+	raw, err := os.ReadFile(filepath.Join(dir, "1/a.ssz"))
+	require.NoError(t, err)
+	require.NotContains(t, string(raw), string(value))
+
+	// A sealed value is bound to its key.
+	require.NoError(t, os.Rename(
+		filepath.Join(dir, "1/a.ssz"), filepath.Join(dir, "1/b.ssz"),
+	))
+	_, err = db.Get([]byte("1/b"))
+	require.ErrorIs(t, err, file.ErrDecrypt)
+
+	// A sealed value cannot be read with another key or without a cipher.
+	require.NoError(t, db.Set([]byte("1/a"), value))
+	_, err = newEncryptedDB(t, dir, 0x02).Get([]byte("1/a"))
+	require.ErrorIs(t, err, file.ErrDecrypt)
+	plain := file.NewDB(
+		file.WithRootDirectory(dir),
+		file.WithFileExtension("ssz"),
+		file.WithLogger(log.NewNopLogger()),
+	)
+	_, err = plain.Get([]byte("1/a"))
+	require.ErrorIs(t, err, file.ErrNoCipher)
+
+	// Values written in the clear remain readable once encrypted.
+	require.NoError(t, plain.Set([]byte("2/a"), value))
+	got, err = db.Get([]byte("2/a"))
+	require.NoError(t, err)
+	require.Equal(t, value, got)
+}
@@ -21,11 +21,13 @@
 package filedb
 
 import (
+	"crypto/cipher"
 	"os"
 	"path/filepath"
 
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
+	storagedb "github.com/berachain/beacon-kit/storage/db"
 	"github.com/spf13/afero"
 )
 
@@ -38,6 +40,8 @@ type DB struct {
 	rootDir   string
 	extension string
 	dirPerms  os.FileMode
+	aead      cipher.AEAD
+	readOnly  bool
 }
 
 // NewDB creates a new instance of the DB.
@@ -50,12 +54,19 @@ func NewDB(opts ...Option) *DB {
 	}
 
 	db.fs = afero.NewBasePathFs(afero.NewOsFs(), db.rootDir)
+	if db.readOnly {
+		db.fs = afero.NewReadOnlyFs(db.fs)
+	}
 	return db
 }
 
 // Get retrieves the value for a key.
 func (db *DB) Get(key []byte) ([]byte, error) {
-	return afero.ReadFile(db.fs, db.pathForKey(key))
+	value, err := afero.ReadFile(db.fs, db.pathForKey(key))
+	if err != nil {
+		return nil, err
+	}
+	return db.open(key, value)
 }
 
 // Has returns true if the key exists in the database.
@@ -69,6 +80,9 @@ func (db *DB) Has(key []byte) (bool, error) {
 
 // Set stores the value for a key.
 func (db *DB) Set(key []byte, value []byte) error {
+	if db.readOnly {
+		return storagedb.ErrReadOnly
+	}
 	if exists, err := afero.Exists(db.fs, db.pathForKey(key)); err != nil {
 		return err
 	} else if exists {
@@ -81,6 +95,11 @@ func (db *DB) Set(key []byte, value []byte) error {
 		return err
 	}
 
+	value, err := db.seal(key, value)
+	if err != nil {
+		return errors.Wrap(err, "failed to encrypt value")
+	}
+
 	file, err := db.fs.Create(db.pathForKey(key))
 	if err != nil {
 		return errors.Wrap(err, "failed to create file")
@@ -98,6 +117,9 @@ func (db *DB) Set(key []byte, value []byte) error {
 
 // Delete removes the value for a key.
 func (db *DB) Delete(key []byte) error {
+	if db.readOnly {
+		return storagedb.ErrReadOnly
+	}
 	return db.fs.RemoveAll(db.pathForKey(key))
 }
 
@@ -21,6 +21,7 @@
 package filedb
 
 import (
+	"crypto/cipher"
 	"os"
 
 	"github.com/berachain/beacon-kit/log"
@@ -38,6 +39,15 @@ func WithAferoFS(fs afero.Fs) Option {
 	}
 }
 
+// WithCipher sets the cipher encrypting the values at rest. Values written
+// before it was set remain readable.
+func WithCipher(aead cipher.AEAD) Option {
+	return func(db *DB) error {
+		db.aead = aead
+		return nil
+	}
+}
+
 // WithDirectoryPermissions sets the permissions for the directory.
 func WithDirectoryPermissions(permissions os.FileMode) Option {
 	return func(db *DB) error {
@@ -62,6 +72,14 @@ func WithLogger(logger log.Logger) Option {
 	}
 }
 
+// WithReadOnly prevents any write to the database.
+func WithReadOnly() Option {
+	return func(db *DB) error {
+		db.readOnly = true
+		return nil
+	}
+}
+
 // WithRootDirectory sets the root directory for the database.
 func WithRootDirectory(rootDir string) Option {
 	return func(db *DB) error {
@@ -25,6 +25,7 @@ import (
 
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/storage/db"
 	file "github.com/berachain/beacon-kit/storage/filedb"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
@@ -158,6 +159,29 @@ func TestDB(t *testing.T) {
 	})
 }
 
+func TestDB_ReadOnly(t *testing.T) {
+	dir := t.TempDir()
+	opts := []file.Option{
+		file.WithRootDirectory(dir),
+		file.WithFileExtension("txt"),
+		file.WithDirectoryPermissions(0o700),
+		file.WithLogger(log.NewNopLogger()),
+	}
+	require.NoError(t, file.NewDB(opts...).Set([]byte("key"), []byte("value")))
+
+	readOnly := file.NewDB(append(opts, file.WithReadOnly())...)
+	value, err := readOnly.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	require.ErrorIs(t, readOnly.Set([]byte("key"), nil), db.ErrReadOnly)
+	require.ErrorIs(t, readOnly.Delete([]byte("key")), db.ErrReadOnly)
+	rangeDB := file.NewRangeDB(readOnly)
+	require.ErrorIs(t, rangeDB.DeleteRange(0, 1), db.ErrReadOnly)
+	has, err := readOnly.Has([]byte("key"))
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
 // Test with `etc` as root directory to cause creation failure
 // due to permission denied.
 func TestDB_SetExistingKey_CreateError(t *testing.T) {
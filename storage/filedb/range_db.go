@@ -23,12 +23,16 @@ package filedb
 import (
 	"bytes"
 	"fmt"
+	"io/fs"
 	"strconv"
+	"strings"
 
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/encoding/hex"
+	storagedb "github.com/berachain/beacon-kit/storage/db"
 	db "github.com/berachain/beacon-kit/storage/interfaces"
 	"github.com/berachain/beacon-kit/storage/pruner"
+	"github.com/spf13/afero"
 )
 
 // two is a constant for the number 2.
@@ -85,6 +89,66 @@ func (db *RangeDB) Delete(index uint64, key []byte) error {
 	return db.DB.Delete(db.prefix(index, key))
 }
 
+// Keys returns the keys stored at the given index, in lexicographic order of
+// their hex encoding.
+func (db *RangeDB) Keys(index uint64) ([][]byte, error) {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return nil, errors.New("rangedb: keys not supported for this db")
+	}
+	infos, err := afero.ReadDir(f.fs, strconv.FormatUint(index, 10))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	keys := make([][]byte, 0, len(infos))
+	for _, info := range infos {
+		name, found := strings.CutSuffix(info.Name(), "."+f.extension)
+		if info.IsDir() || !found {
+			continue
+		}
+		key, err := hex.ToBytes(name)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// IndexSizes returns the size in bytes of the files stored at each index.
+func (db *RangeDB) IndexSizes() (map[uint64]uint64, error) {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return nil, errors.New("rangedb: index sizes not supported for this db")
+	}
+	dirs, err := afero.ReadDir(f.fs, ".")
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	sizes := make(map[uint64]uint64, len(dirs))
+	for _, dir := range dirs {
+		index, err := strconv.ParseUint(dir.Name(), 10, 64)
+		if !dir.IsDir() || err != nil {
+			continue
+		}
+		infos, err := afero.ReadDir(f.fs, dir.Name())
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range infos {
+			if !info.IsDir() {
+				//#nosec:G115 // file sizes are not negative.
+				sizes[index] += uint64(info.Size())
+			}
+		}
+	}
+	return sizes, nil
+}
+
 // DeleteRange removes all values associated with the given index from the
 // filesystem. It is INCLUSIVE of the `from` index and EXCLUSIVE of
 // the `to“ index.
@@ -93,6 +157,9 @@ func (db *RangeDB) DeleteRange(from, to uint64) error {
 	if !ok {
 		return errors.New("rangedb: delete range not supported for this db")
 	}
+	if f.readOnly {
+		return storagedb.ErrReadOnly
+	}
 	if from > to {
 		return fmt.Errorf(
 			"RangeDB DeleteRange start: %d, end: %d: %w",
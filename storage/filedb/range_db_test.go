@@ -96,6 +96,29 @@ func TestRangeDB(t *testing.T) {
 				require.False(t, exists)
 			},
 		},
+		{
+			name: "Keys",
+			setupFunc: func(rdb *file.RangeDB) error {
+				for _, key := range []string{"key2", "key1"} {
+					if err := rdb.Set(
+						1, []byte(key), []byte("testValue"),
+					); err != nil {
+						return err
+					}
+				}
+				return rdb.Set(2, []byte("key3"), []byte("testValue"))
+			},
+			testFunc: func(t *testing.T, rdb *file.RangeDB) {
+				t.Helper()
+				keys, err := rdb.Keys(1)
+				require.NoError(t, err)
+				require.Equal(t, [][]byte{[]byte("key1"), []byte("key2")}, keys)
+
+				keys, err = rdb.Keys(3)
+				require.NoError(t, err)
+				require.Empty(t, keys)
+			},
+		},
 		{
 			name: "DeleteRange",
 			setupFunc: func(rdb *file.RangeDB) error {
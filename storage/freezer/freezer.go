@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package freezer
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+const (
+	blocksTable    = "blocks"
+	headersTable   = "headers"
+	snapshotsTable = "snapshots"
+)
+
+// Freezer is the cold storage of the ancient data of the chain: the beacon
+// blocks and headers finalized, and the snapshots of the beacon state taken,
+// before a threshold. They are moved out of the hot stores into append-only
+// flat-file tables, keyed by slot.
+type Freezer struct {
+	blocks    *Table
+	headers   *Table
+	snapshots *Table
+}
+
+// Open opens, or creates, the freezer in the given directory.
+func Open(dir string) (*Freezer, error) {
+	return open(dir, OpenTable)
+}
+
+// OpenReadOnly opens the existing freezer in the given directory without
+// allowing anything to be frozen in it.
+func OpenReadOnly(dir string) (*Freezer, error) {
+	return open(dir, OpenTableReadOnly)
+}
+
+// open opens the tables of the freezer in the given directory.
+func open(
+	dir string, openTable func(dir, name string) (*Table, error),
+) (*Freezer, error) {
+	f := new(Freezer)
+	var err error
+	for name, table := range map[string]**Table{
+		blocksTable:    &f.blocks,
+		headersTable:   &f.headers,
+		snapshotsTable: &f.snapshots,
+	} {
+		if *table, err = openTable(dir, name); err != nil {
+			return nil, errors.Join(err, f.Close())
+		}
+	}
+	return f, nil
+}
+
+// FreezeBlock appends the SSZ encoded beacon block finalized at the given
+// slot, along with its header.
+func (f *Freezer) FreezeBlock(slot math.Slot, block, header []byte) error {
+	// The header is appended first, and skipped if it was appended before a
+	// crash prevented the block from being appended.
+	if last, ok := f.headers.Last(); !ok || last < slot {
+		if err := f.headers.Append(slot, header); err != nil {
+			return err
+		}
+	}
+	return f.blocks.Append(slot, block)
+}
+
+// BlockBytes returns the SSZ encoded beacon block frozen at the given slot,
+// nil if there is none.
+func (f *Freezer) BlockBytes(slot math.Slot) ([]byte, error) {
+	return f.blocks.Get(slot)
+}
+
+// HeaderBytes returns the SSZ encoded header of the beacon block frozen at
+// the given slot, nil if there is none.
+func (f *Freezer) HeaderBytes(slot math.Slot) ([]byte, error) {
+	return f.headers.Get(slot)
+}
+
+// LastBlockSlot returns the slot of the last beacon block frozen, false if
+// none was.
+func (f *Freezer) LastBlockSlot() (math.Slot, bool) {
+	return f.blocks.Last()
+}
+
+// Snapshots returns the table of the frozen snapshots of the beacon state.
+func (f *Freezer) Snapshots() *Table {
+	return f.snapshots
+}
+
+// Rewind removes the blocks, headers and snapshots frozen after the given
+// slot, as when the chain is rolled back.
+func (f *Freezer) Rewind(slot math.Slot) error {
+	return errors.Join(
+		f.blocks.Truncate(slot),
+		f.headers.Truncate(slot),
+		f.snapshots.Truncate(slot),
+	)
+}
+
+// Sync flushes the freezer to disk.
+func (f *Freezer) Sync() error {
+	return errors.Join(
+		f.blocks.Sync(), f.headers.Sync(), f.snapshots.Sync(),
+	)
+}
+
+// Close flushes and closes the freezer.
+func (f *Freezer) Close() error {
+	var errs []error
+	for _, table := range []*Table{f.blocks, f.headers, f.snapshots} {
+		if table != nil {
+			errs = append(errs, table.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
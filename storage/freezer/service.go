@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package freezer
+
+import (
+	"context"
+	"sync/atomic"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/consensus-types/registry"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// Node is the interface of the node the ancient blocks are frozen from.
+type Node interface {
+	// AddCommitListener registers a listener notified of each block
+	// committed.
+	AddCommitListener(
+		listener func(height int64, changes []*storetypes.StoreKVPair),
+	)
+	// BeaconBlockBytes returns the SSZ encoded beacon block committed at the
+	// given height, nil if there is none.
+	BeaconBlockBytes(height int64) ([]byte, error)
+	// BlockBase returns the lowest height of the blocks held by the node.
+	BlockBase() (int64, error)
+	// SetRetainedFrom sets the function returning the lowest height whose
+	// block must not be pruned by the node.
+	SetRetainedFrom(retainedFrom func() int64)
+}
+
+// SnapshotStore is the interface of the hot store of the beacon state
+// snapshots.
+type SnapshotStore interface {
+	// Freeze moves the snapshots taken before the given slot to the
+	// freezer.
+	Freeze(before math.Slot) error
+}
+
+// Service moves the beacon blocks and headers finalized, and the snapshots
+// of the beacon state taken, more than a threshold of slots ago out of the
+// hot stores into the freezer, in the background. Blocks are retained by
+// CometBFT until frozen.
+type Service struct {
+	threshold uint64
+	cs        chain.ChainSpec
+	node      Node
+	freezer   *Freezer
+	snapshots SnapshotStore
+	logger    log.Logger
+
+	// next is the next height whose block is to be frozen.
+	next atomic.Int64
+	// latest is the latest height committed.
+	latest atomic.Int64
+	wakeCh chan struct{}
+	stopCh chan struct{}
+}
+
+// NewService creates a new service moving the ancient data to the given
+// freezer.
+func NewService(
+	cfg Config,
+	cs chain.ChainSpec,
+	node Node,
+	freezer *Freezer,
+	snapshots SnapshotStore,
+	logger log.Logger,
+) *Service {
+	return &Service{
+		threshold: cfg.Threshold,
+		cs:        cs,
+		node:      node,
+		freezer:   freezer,
+		snapshots: snapshots,
+		logger:    logger,
+		wakeCh:    make(chan struct{}, 1),
+		stopCh:    make(chan struct{}),
+	}
+}
+
+// Name returns the name of the service.
+func (*Service) Name() string {
+	return "freezer"
+}
+
+// Start registers the service as a commit listener and starts freezing the
+// ancient data in the background.
+func (s *Service) Start(ctx context.Context) error {
+	if s.threshold == 0 {
+		return nil
+	}
+	next := int64(1)
+	if last, ok := s.freezer.LastBlockSlot(); ok {
+		//#nosec:G115 // slots fit an int64.
+		next = int64(last.Unwrap()) + 1
+	}
+	s.next.Store(next)
+	s.node.SetRetainedFrom(s.next.Load)
+	s.node.AddCommitListener(s.onCommit)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.stopCh:
+				return
+			case <-s.wakeCh:
+				if err := s.freeze(s.latest.Load()); err != nil {
+					s.logger.Error("Failed to freeze ancient data",
+						"error", err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops freezing the ancient data.
+func (s *Service) Stop() error {
+	select {
+	case <-s.stopCh:
+	default:
+		close(s.stopCh)
+	}
+	return nil
+}
+
+// onCommit wakes the freezing of the data that became ancient with the
+// given height.
+func (s *Service) onCommit(height int64, _ []*storetypes.StoreKVPair) {
+	s.latest.Store(height)
+	select {
+	case s.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+// freeze moves the data older than the threshold at the given height to the
+// freezer.
+func (s *Service) freeze(height int64) error {
+	//#nosec:G115 // the threshold is bounded by the heights.
+	target := height - int64(s.threshold)
+	if target <= 0 {
+		return nil
+	}
+
+	// Blocks pruned before being frozen are skipped.
+	base, err := s.node.BlockBase()
+	if err != nil {
+		return err
+	}
+	if next := s.next.Load(); next < base {
+		s.logger.Warn("Blocks pruned before being frozen",
+			"from", next, "to", base-1)
+		s.next.Store(base)
+	}
+
+	for h := s.next.Load(); h <= target; h++ {
+		select {
+		case <-s.stopCh:
+			return s.freezer.Sync()
+		default:
+		}
+		if err = s.freezeBlock(h); err != nil {
+			return errors.Wrapf(err, "failed to freeze block %d", h)
+		}
+		s.next.Store(h + 1)
+	}
+	if err = s.freezer.Sync(); err != nil {
+		return err
+	}
+
+	//#nosec:G115 // target is positive.
+	return s.snapshots.Freeze(math.Slot(target + 1))
+}
+
+// freezeBlock appends the beacon block committed at the given height, and
+// its header, to the freezer.
+func (s *Service) freezeBlock(height int64) error {
+	bz, err := s.node.BeaconBlockBytes(height)
+	if err != nil || bz == nil {
+		return err
+	}
+	//#nosec:G115 // heights are positive.
+	slot := math.Slot(height)
+	blk, err := registry.Default.BeaconBlocks.Unmarshal(
+		s.cs.ActiveForkVersionForSlot(slot), bz,
+	)
+	if err != nil {
+		return err
+	}
+	header, err := blk.GetHeader().MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	return s.freezer.FreezeBlock(blk.GetSlot(), bz, header)
+}
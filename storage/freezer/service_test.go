@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package freezer_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/freezer"
+	"github.com/stretchr/testify/require"
+)
+
+// testNode holds the beacon blocks of the heights from its base.
+type testNode struct {
+	mu           sync.Mutex
+	blocks       map[int64][]byte
+	base         int64
+	listener     func(int64, []*storetypes.StoreKVPair)
+	retainedFrom func() int64
+}
+
+func (n *testNode) AddCommitListener(
+	listener func(int64, []*storetypes.StoreKVPair),
+) {
+	n.listener = listener
+}
+
+func (n *testNode) BeaconBlockBytes(height int64) ([]byte, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.blocks[height], nil
+}
+
+func (n *testNode) BlockBase() (int64, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.base, nil
+}
+
+func (n *testNode) SetRetainedFrom(retainedFrom func() int64) {
+	n.retainedFrom = retainedFrom
+}
+
+// testSnapshotStore records the slots snapshots are frozen before.
+type testSnapshotStore struct {
+	mu     sync.Mutex
+	before math.Slot
+}
+
+func (s *testSnapshotStore) Freeze(before math.Slot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.before = before
+	return nil
+}
+
+func testBlock(t *testing.T, slot math.Slot) []byte {
+	t.Helper()
+	bz, err := (&ctypes.BeaconBlock{
+		Slot: slot,
+		Body: &ctypes.BeaconBlockBody{
+			ExecutionPayload: &ctypes.ExecutionPayload{
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: &ctypes.Eth1Data{},
+		},
+	}).MarshalSSZ()
+	require.NoError(t, err)
+	return bz
+}
+
+func TestFreezerService(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	f, err := freezer.Open(t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+	// The block at height 3 holds no beacon block.
+	node := &testNode{blocks: map[int64][]byte{}, base: 1}
+	for _, h := range []int64{1, 2, 4, 5, 8, 9, 10} {
+		node.blocks[h] = testBlock(t, math.Slot(h))
+	}
+	snapshots := &testSnapshotStore{}
+	svc := freezer.NewService(
+		freezer.Config{Threshold: 2}, cs, node, f, snapshots,
+		noop.NewLogger[any](),
+	)
+	require.NoError(t, svc.Start(context.Background()))
+	t.Cleanup(func() { require.NoError(t, svc.Stop()) })
+	require.Equal(t, int64(1), node.retainedFrom())
+
+	// Blocks older than the threshold are frozen, and retained by the node
+	// until then.
+	node.listener(5, nil)
+	require.Eventually(t, func() bool {
+		return node.retainedFrom() == 4
+	}, time.Second, time.Millisecond)
+	for h, frozen := range map[math.Slot]bool{1: true, 2: true, 3: false, 4: false} {
+		bz, getErr := f.BlockBytes(h)
+		require.NoError(t, getErr)
+		require.Equal(t, frozen, bz != nil, "slot %d", h)
+	}
+	header, err := f.HeaderBytes(2)
+	require.NoError(t, err)
+	var blk *ctypes.BeaconBlock
+	blk, err = blk.NewFromSSZ(node.blocks[2], cs.ActiveForkVersionForSlot(2))
+	require.NoError(t, err)
+	expected, err := blk.GetHeader().MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, expected, header)
+	require.Eventually(t, func() bool {
+		snapshots.mu.Lock()
+		defer snapshots.mu.Unlock()
+		return snapshots.before == 4
+	}, time.Second, time.Millisecond)
+
+	// Blocks pruned before being frozen are skipped.
+	node.mu.Lock()
+	node.base = 8
+	node.mu.Unlock()
+	node.listener(10, nil)
+	require.Eventually(t, func() bool {
+		return node.retainedFrom() == 9
+	}, time.Second, time.Millisecond)
+	last, ok := f.LastBlockSlot()
+	require.True(t, ok)
+	require.Equal(t, math.Slot(8), last)
+}
@@ -0,0 +1,321 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package freezer
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/db"
+)
+
+// entrySize is the size of an index entry: the slot of the item followed by
+// the offset its data ends at in the data file, both big endian.
+const entrySize = 16
+
+// ErrOutOfOrder is returned when appending an item at a slot not after the
+// slot of the last item.
+var ErrOutOfOrder = errors.New("item appended out of order")
+
+// Table is an append-only flat-file table of items keyed by strictly
+// increasing slots, not necessarily contiguous. Item data is appended to a
+// data file, and an index file holds an entry per item, looked up by binary
+// search. Tables are never compacted, they only grow, unless truncated when
+// the chain is rolled back.
+type Table struct {
+	mu       sync.RWMutex
+	index    *os.File
+	data     *os.File
+	readOnly bool
+
+	// entries is the number of items in the table.
+	entries int64
+	// size is the size of the data of the items in the table.
+	size int64
+	// last is the slot of the last item, if any.
+	last math.Slot
+}
+
+// OpenTable opens, or creates, the table of the given name in the given
+// directory. Items partially appended before a crash are dropped.
+func OpenTable(dir, name string) (*Table, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return openTable(dir, name, os.O_RDWR|os.O_CREATE)
+}
+
+// OpenTableReadOnly opens the existing table of the given name in the given
+// directory without allowing appends to it. Items partially appended before
+// a crash are ignored.
+func OpenTableReadOnly(dir, name string) (*Table, error) {
+	return openTable(dir, name, os.O_RDONLY)
+}
+
+// openTable opens the index and data files of the table of the given name
+// with the given flags.
+func openTable(dir, name string, flag int) (*Table, error) {
+	index, err := os.OpenFile(
+		filepath.Join(dir, name+".idx"), flag, 0o600,
+	)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.OpenFile(
+		filepath.Join(dir, name+".dat"), flag, 0o600,
+	)
+	if err != nil {
+		return nil, errors.Join(err, index.Close())
+	}
+	t := &Table{index: index, data: data, readOnly: flag == os.O_RDONLY}
+	if err = t.repair(); err != nil {
+		return nil, errors.Join(
+			errors.Wrapf(err, "failed to open freezer table %s", name),
+			t.Close(),
+		)
+	}
+	return t, nil
+}
+
+// repair truncates the index and data files to the last item fully
+// appended, the data of an item being written before its index entry. The
+// files of read-only tables are left untouched.
+func (t *Table) repair() error {
+	indexInfo, err := t.index.Stat()
+	if err != nil {
+		return err
+	}
+	dataInfo, err := t.data.Stat()
+	if err != nil {
+		return err
+	}
+	t.entries = indexInfo.Size() / entrySize
+	for ; t.entries > 0; t.entries-- {
+		var slot uint64
+		if slot, t.size, err = t.readEntry(t.entries - 1); err != nil {
+			return err
+		}
+		if t.size <= dataInfo.Size() {
+			t.last = math.Slot(slot)
+			break
+		}
+	}
+	if t.entries == 0 {
+		t.size = 0
+	}
+	if t.readOnly {
+		return nil
+	}
+	if err = t.index.Truncate(t.entries * entrySize); err != nil {
+		return err
+	}
+	return t.data.Truncate(t.size)
+}
+
+// Append appends the item of the given slot, which must be after the slot of
+// the last item.
+func (t *Table) Append(slot math.Slot, bz []byte) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.readOnly {
+		return db.ErrReadOnly
+	}
+	if t.entries > 0 && slot <= t.last {
+		return errors.Wrapf(
+			ErrOutOfOrder, "slot %d, last slot %d", slot, t.last,
+		)
+	}
+
+	if _, err := t.data.WriteAt(bz, t.size); err != nil {
+		return err
+	}
+	entry := make([]byte, entrySize)
+	binary.BigEndian.PutUint64(entry, slot.Unwrap())
+	//#nosec:G115 // sizes are positive.
+	binary.BigEndian.PutUint64(entry[8:], uint64(t.size+int64(len(bz))))
+	if _, err := t.index.WriteAt(entry, t.entries*entrySize); err != nil {
+		return err
+	}
+	t.entries++
+	t.size += int64(len(bz))
+	t.last = slot
+	return nil
+}
+
+// Truncate removes the items after the given slot, as when the chain is
+// rolled back.
+func (t *Table) Truncate(slot math.Slot) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.readOnly {
+		return db.ErrReadOnly
+	}
+	if t.entries == 0 || t.last <= slot {
+		return nil
+	}
+	i, err := t.search(slot + 1)
+	if err != nil {
+		return err
+	}
+	var (
+		size int64
+		last uint64
+	)
+	if i > 0 {
+		if last, size, err = t.readEntry(i - 1); err != nil {
+			return err
+		}
+	}
+	// The index is truncated first, so that the data of the removed items
+	// is dropped on open if a crash prevented its truncation.
+	if err = t.index.Truncate(i * entrySize); err != nil {
+		return err
+	}
+	if err = t.data.Truncate(size); err != nil {
+		return err
+	}
+	t.entries, t.size, t.last = i, size, math.Slot(last)
+	return nil
+}
+
+// Get returns the item of the given slot, nil if there is none.
+func (t *Table) Get(slot math.Slot) ([]byte, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	i, err := t.search(slot)
+	if err != nil || i == t.entries {
+		return nil, err
+	}
+	itemSlot, _, err := t.readEntry(i)
+	if err != nil || itemSlot != slot.Unwrap() {
+		return nil, err
+	}
+	return t.readItem(i)
+}
+
+// GetAtOrBefore returns the slot and item of the last item at or before the
+// given slot, nil if there is none.
+func (t *Table) GetAtOrBefore(slot math.Slot) (math.Slot, []byte, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	i, err := t.search(slot)
+	if err != nil {
+		return 0, nil, err
+	}
+	if i < t.entries {
+		var itemSlot uint64
+		if itemSlot, _, err = t.readEntry(i); err != nil {
+			return 0, nil, err
+		}
+		if itemSlot == slot.Unwrap() {
+			i++
+		}
+	}
+	if i == 0 {
+		return 0, nil, nil
+	}
+	itemSlot, _, err := t.readEntry(i - 1)
+	if err != nil {
+		return 0, nil, err
+	}
+	bz, err := t.readItem(i - 1)
+	return math.Slot(itemSlot), bz, err
+}
+
+// Last returns the slot of the last item, false if the table is empty.
+func (t *Table) Last() (math.Slot, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.last, t.entries > 0
+}
+
+// Sync flushes the table to disk.
+func (t *Table) Sync() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.readOnly {
+		return nil
+	}
+	return errors.Join(t.data.Sync(), t.index.Sync())
+}
+
+// Close flushes and closes the table.
+func (t *Table) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.readOnly {
+		return errors.Join(t.data.Close(), t.index.Close())
+	}
+	return errors.Join(
+		t.data.Sync(), t.index.Sync(), t.data.Close(), t.index.Close(),
+	)
+}
+
+// search returns the index of the first item at or after the given slot.
+func (t *Table) search(slot math.Slot) (int64, error) {
+	var err error
+	//#nosec:G115 // the number of entries fits an int.
+	i := sort.Search(int(t.entries), func(i int) bool {
+		if err != nil {
+			return true
+		}
+		var itemSlot uint64
+		itemSlot, _, err = t.readEntry(int64(i))
+		return itemSlot >= slot.Unwrap()
+	})
+	return int64(i), err
+}
+
+// readEntry returns the slot and end offset of the item at the given index.
+func (t *Table) readEntry(i int64) (uint64, int64, error) {
+	entry := make([]byte, entrySize)
+	if _, err := t.index.ReadAt(entry, i*entrySize); err != nil {
+		return 0, 0, err
+	}
+	//#nosec:G115 // offsets fit an int64.
+	return binary.BigEndian.Uint64(entry),
+		int64(binary.BigEndian.Uint64(entry[8:])), nil
+}
+
+// readItem returns the data of the item at the given index.
+func (t *Table) readItem(i int64) ([]byte, error) {
+	var start int64
+	if i > 0 {
+		var err error
+		if _, start, err = t.readEntry(i - 1); err != nil {
+			return nil, err
+		}
+	}
+	_, end, err := t.readEntry(i)
+	if err != nil {
+		return nil, err
+	}
+	bz := make([]byte, end-start)
+	if _, err = t.data.ReadAt(bz, start); err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package freezer_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/db"
+	"github.com/berachain/beacon-kit/storage/freezer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTable(t *testing.T) {
+	dir := t.TempDir()
+	table, err := freezer.OpenTable(dir, "test")
+	require.NoError(t, err)
+
+	_, ok := table.Last()
+	require.False(t, ok)
+	bz, err := table.Get(1)
+	require.NoError(t, err)
+	require.Nil(t, bz)
+
+	// Items are keyed by increasing, not necessarily contiguous, slots.
+	for _, slot := range []math.Slot{2, 3, 7} {
+		require.NoError(t, table.Append(slot, []byte{byte(slot), 0xff}))
+	}
+	require.ErrorIs(t, table.Append(7, nil), freezer.ErrOutOfOrder)
+	require.NoError(t, table.Append(9, []byte{}))
+
+	last, ok := table.Last()
+	require.True(t, ok)
+	require.Equal(t, math.Slot(9), last)
+	for slot, expected := range map[math.Slot][]byte{
+		1: nil, 2: {2, 0xff}, 3: {3, 0xff}, 5: nil, 7: {7, 0xff}, 9: {}, 10: nil,
+	} {
+		bz, err = table.Get(slot)
+		require.NoError(t, err)
+		require.Equal(t, expected, bz, "slot %d", slot)
+	}
+	for slot, expected := range map[math.Slot]math.Slot{
+		2: 2, 6: 3, 7: 7, 8: 7, 100: 9,
+	} {
+		var itemSlot math.Slot
+		itemSlot, _, err = table.GetAtOrBefore(slot)
+		require.NoError(t, err)
+		require.Equal(t, expected, itemSlot, "slot %d", slot)
+	}
+	_, bz, err = table.GetAtOrBefore(1)
+	require.NoError(t, err)
+	require.Nil(t, bz)
+	require.NoError(t, table.Close())
+
+	// An item whose data was not fully written before a crash is dropped
+	// upon reopening.
+	require.NoError(t, os.Truncate(filepath.Join(dir, "test.dat"), 5))
+	table, err = freezer.OpenTable(dir, "test")
+	require.NoError(t, err)
+	last, ok = table.Last()
+	require.True(t, ok)
+	require.Equal(t, math.Slot(3), last)
+	bz, err = table.Get(7)
+	require.NoError(t, err)
+	require.Nil(t, bz)
+	require.NoError(t, table.Append(7, []byte{7}))
+	bz, err = table.Get(7)
+	require.NoError(t, err)
+	require.Equal(t, []byte{7}, bz)
+	require.NoError(t, table.Close())
+}
+
+func TestTableReadOnly(t *testing.T) {
+	dir := t.TempDir()
+	_, err := freezer.OpenTableReadOnly(dir, "test")
+	require.Error(t, err)
+
+	table, err := freezer.OpenTable(dir, "test")
+	require.NoError(t, err)
+	for _, slot := range []math.Slot{2, 3} {
+		require.NoError(t, table.Append(slot, []byte{byte(slot)}))
+	}
+	require.NoError(t, table.Close())
+
+	// A partially appended item is ignored without truncating the files.
+	dataPath := filepath.Join(dir, "test.dat")
+	require.NoError(t, os.Truncate(dataPath, 1))
+	table, err = freezer.OpenTableReadOnly(dir, "test")
+	require.NoError(t, err)
+	last, ok := table.Last()
+	require.True(t, ok)
+	require.Equal(t, math.Slot(2), last)
+	bz, err := table.Get(2)
+	require.NoError(t, err)
+	require.Equal(t, []byte{2}, bz)
+	require.ErrorIs(t, table.Append(4, []byte{4}), db.ErrReadOnly)
+	require.NoError(t, table.Close())
+
+	info, err := os.Stat(filepath.Join(dir, "test.idx"))
+	require.NoError(t, err)
+	require.EqualValues(t, 32, info.Size())
+}
+
+func TestTableTruncate(t *testing.T) {
+	dir := t.TempDir()
+	table, err := freezer.OpenTable(dir, "test")
+	require.NoError(t, err)
+	for _, slot := range []math.Slot{2, 3, 7, 9} {
+		require.NoError(t, table.Append(slot, []byte{byte(slot)}))
+	}
+
+	// Truncating after the last item is a no-op.
+	require.NoError(t, table.Truncate(9))
+	last, ok := table.Last()
+	require.True(t, ok)
+	require.Equal(t, math.Slot(9), last)
+
+	// The items after the slot are removed, and appending resumes after
+	// the last item left.
+	require.NoError(t, table.Truncate(5))
+	last, ok = table.Last()
+	require.True(t, ok)
+	require.Equal(t, math.Slot(3), last)
+	bz, err := table.Get(7)
+	require.NoError(t, err)
+	require.Nil(t, bz)
+	require.NoError(t, table.Append(4, []byte{4}))
+	require.NoError(t, table.Close())
+
+	table, err = freezer.OpenTable(dir, "test")
+	require.NoError(t, err)
+	for slot, expected := range map[math.Slot][]byte{
+		2: {2}, 3: {3}, 4: {4}, 7: nil,
+	} {
+		bz, err = table.Get(slot)
+		require.NoError(t, err)
+		require.Equal(t, expected, bz, "slot %d", slot)
+	}
+
+	// Truncating before the first item empties the table.
+	require.NoError(t, table.Truncate(1))
+	_, ok = table.Last()
+	require.False(t, ok)
+	require.NoError(t, table.Close())
+}
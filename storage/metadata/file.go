@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metadata
+
+import (
+	"encoding/json"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/spf13/afero"
+)
+
+// fileEntry is the metadata of a validator as specified in a metadata file.
+type fileEntry struct {
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+	Graffiti     string                  `json:"graffiti"`
+	GasLimit     uint64                  `json:"gas_limit"`
+	Builder      *fileBuilder            `json:"builder"`
+}
+
+// fileBuilder is the builder preference of a validator as specified in a
+// metadata file.
+type fileBuilder struct {
+	Enabled bool `json:"enabled"`
+	// GasLimit overrides the gas limit of the validator when the builder is
+	// enabled.
+	GasLimit uint64 `json:"gas_limit"`
+}
+
+// LoadFile reads validators metadata from a JSON proposer configuration
+// file mapping validator pubkeys to their metadata, e.g.
+//
+//	{
+//	  "0x...": {
+//	    "fee_recipient": "0x...",
+//	    "graffiti": "hello",
+//	    "gas_limit": 30000000,
+//	    "builder": {
+//	      "enabled": true,
+//	      "gas_limit": 36000000
+//	    }
+//	  }
+//	}
+//
+// The gas limit of the builder, if any, overrides the one of the validator
+// when the builder is enabled.
+func LoadFile(filepath string) (map[crypto.BLSPubkey]*Metadata, error) {
+	data, err := afero.ReadFile(afero.NewOsFs(), filepath)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries map[crypto.BLSPubkey]fileEntry
+	if err = json.Unmarshal(data, &entries); err != nil {
+		return nil, errors.Wrapf(err, "failed parsing metadata file")
+	}
+
+	res := make(map[crypto.BLSPubkey]*Metadata, len(entries))
+	for pubkey, entry := range entries {
+		graffiti, gErr := NewGraffiti(entry.Graffiti)
+		if gErr != nil {
+			return nil, errors.Wrapf(
+				gErr, "invalid graffiti for validator %s", pubkey,
+			)
+		}
+		md := &Metadata{
+			FeeRecipient: entry.FeeRecipient,
+			Graffiti:     graffiti,
+			GasLimit:     math.U64(entry.GasLimit),
+		}
+		if entry.Builder != nil && entry.Builder.Enabled {
+			md.BuilderEnabled = true
+			if entry.Builder.GasLimit != 0 {
+				md.GasLimit = math.U64(entry.Builder.GasLimit)
+			}
+		}
+		res[pubkey] = md
+	}
+	return res, nil
+}
+
+// Import stores the given validators metadata, replacing any previous one.
+func (kv *KVStore) Import(entries map[crypto.BLSPubkey]*Metadata) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	for pubkey, md := range entries {
+		if err := kv.set(pubkey, md); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metadata
+
+import (
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// MetadataSize is the size of the Metadata object in bytes.
+	// 20 bytes for FeeRecipient + 32 bytes for Graffiti + 8 bytes for
+	// GasLimit + 1 byte for BuilderEnabled.
+	MetadataSize = 61
+	// legacyMetadataSize is the size of the Metadata objects stored before
+	// BuilderEnabled was introduced, which are still decoded.
+	legacyMetadataSize = 60
+)
+
+var (
+	_ ssz.StaticObject            = (*Metadata)(nil)
+	_ constraints.SSZMarshallable = (*Metadata)(nil)
+)
+
+// Metadata holds the operator preferences of a validator. Zero values mean
+// that the node wide defaults apply.
+type Metadata struct {
+	// FeeRecipient is the address receiving the fees of the payloads
+	// built for the validator.
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+	// Graffiti is included in the beacon blocks proposed by the validator.
+	Graffiti common.Bytes32 `json:"graffiti"`
+	// GasLimit is the gas limit the validator prefers builders to target.
+	GasLimit math.U64 `json:"gas_limit"`
+	// BuilderEnabled is set if the validator prefers its payloads to be
+	// built by an external builder.
+	BuilderEnabled bool `json:"builder_enabled"`
+}
+
+// Empty creates an empty Metadata.
+func (m *Metadata) Empty() *Metadata {
+	return &Metadata{}
+}
+
+// IsEmpty returns true if no preference is set.
+func (m *Metadata) IsEmpty() bool {
+	return *m == Metadata{}
+}
+
+// NewGraffiti right pads the given string into a graffiti. Strings longer
+// than 32 bytes are rejected.
+func NewGraffiti(s string) (common.Bytes32, error) {
+	return bytes.ToBytes32(bytes.ExtendToSize([]byte(s), bytes.B32Size))
+}
+
+// SizeSSZ returns the SSZ encoded size of the Metadata object in bytes.
+func (m *Metadata) SizeSSZ(*ssz.Sizer) uint32 {
+	return MetadataSize
+}
+
+// DefineSSZ defines the SSZ encoding for the Metadata object.
+func (m *Metadata) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &m.FeeRecipient)
+	ssz.DefineStaticBytes(codec, &m.Graffiti)
+	ssz.DefineUint64(codec, &m.GasLimit)
+	ssz.DefineBool(codec, &m.BuilderEnabled)
+}
+
+// MarshalSSZ marshals the Metadata object to SSZ format.
+func (m *Metadata) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(m))
+	return buf, ssz.EncodeToBytes(buf, m)
+}
+
+// UnmarshalSSZ unmarshals the Metadata object from SSZ format.
+func (m *Metadata) UnmarshalSSZ(buf []byte) error {
+	if len(buf) == legacyMetadataSize {
+		buf = append(buf[:legacyMetadataSize:legacyMetadataSize], 0)
+	}
+	return ssz.DecodeFromBytes(buf, m)
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metadata
+
+import (
+	"context"
+	"sync"
+
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/storage/encoding"
+	"github.com/berachain/beacon-kit/storage/migration"
+)
+
+const KeyMetadataPrefix = "metadata"
+
+// Schemas returns the schemas of the keys of the store, migrated when the
+// database is opened.
+func Schemas() []migration.Schema {
+	return []migration.Schema{{Prefix: KeyMetadataPrefix}}
+}
+
+// KVStore is a KV store based registry of validators metadata, keyed by
+// validator pubkey.
+type KVStore struct {
+	store sdkcollections.Map[[]byte, *Metadata]
+
+	// mu protects store for concurrent access
+	mu sync.RWMutex
+
+	// logger is used for logging information and errors.
+	logger log.Logger
+}
+
+// NewStore creates a new metadata store.
+func NewStore(
+	kvsp store.KVStoreService,
+	logger log.Logger,
+) *KVStore {
+	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
+	res := &KVStore{
+		store: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyMetadataPrefix)),
+			KeyMetadataPrefix,
+			sdkcollections.BytesKey,
+			encoding.SSZValueCodec[*Metadata]{},
+		),
+		logger: logger,
+	}
+	if _, err := schemaBuilder.Build(); err != nil {
+		panic(errors.Wrap(err, "failed building KVStore schema"))
+	}
+	return res
+}
+
+// Get returns the metadata of the given validator. Empty metadata is
+// returned if none is stored.
+func (kv *KVStore) Get(pubkey crypto.BLSPubkey) (*Metadata, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	return kv.get(pubkey)
+}
+
+// Set stores the metadata of the given validator, replacing any previous
+// one. Storing empty metadata removes the validator from the registry.
+func (kv *KVStore) Set(pubkey crypto.BLSPubkey, md *Metadata) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	return kv.set(pubkey, md)
+}
+
+// Update applies fn to the metadata of the given validator and stores the
+// result.
+func (kv *KVStore) Update(
+	pubkey crypto.BLSPubkey,
+	fn func(*Metadata),
+) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	md, err := kv.get(pubkey)
+	if err != nil {
+		return err
+	}
+	fn(md)
+	return kv.set(pubkey, md)
+}
+
+func (kv *KVStore) get(pubkey crypto.BLSPubkey) (*Metadata, error) {
+	md, err := kv.store.Get(context.TODO(), pubkey[:])
+	switch {
+	case err == nil:
+		return md, nil
+	case errors.Is(err, sdkcollections.ErrNotFound):
+		return new(Metadata), nil
+	default:
+		return nil, errors.Wrapf(
+			err, "failed to get metadata of validator %s", pubkey,
+		)
+	}
+}
+
+func (kv *KVStore) set(pubkey crypto.BLSPubkey, md *Metadata) error {
+	var err error
+	if md.IsEmpty() {
+		err = kv.store.Remove(context.TODO(), pubkey[:])
+	} else {
+		err = kv.store.Set(context.TODO(), pubkey[:], md)
+	}
+	if err != nil {
+		return errors.Wrapf(
+			err, "failed to set metadata of validator %s", pubkey,
+		)
+	}
+	kv.logger.Debug("Set validator metadata", "pubkey", pubkey)
+	return nil
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metadata_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/metadata"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataStore(t *testing.T) {
+	store := metadata.NewStore(
+		storage.NewKVStoreProvider(dbm.NewMemDB()),
+		noop.NewLogger[any](),
+	)
+	pubkey := crypto.BLSPubkey{0x01}
+
+	// no metadata to start
+	md, err := store.Get(pubkey)
+	require.NoError(t, err)
+	require.True(t, md.IsEmpty())
+
+	// set and update metadata
+	feeRecipient := common.ExecutionAddress{0xaa}
+	require.NoError(t, store.Set(pubkey, &metadata.Metadata{
+		FeeRecipient: feeRecipient,
+	}))
+	require.NoError(t, store.Update(pubkey, func(md *metadata.Metadata) {
+		md.GasLimit = math.U64(30_000_000)
+	}))
+
+	md, err = store.Get(pubkey)
+	require.NoError(t, err)
+	require.Equal(t, &metadata.Metadata{
+		FeeRecipient: feeRecipient,
+		GasLimit:     math.U64(30_000_000),
+	}, md)
+
+	// metadata stored before the builder preference are still decoded
+	legacy, err := (&metadata.Metadata{
+		FeeRecipient: feeRecipient,
+	}).MarshalSSZ()
+	require.NoError(t, err)
+	md = new(metadata.Metadata)
+	require.NoError(t, md.UnmarshalSSZ(legacy[:metadata.MetadataSize-1]))
+	require.Equal(t, &metadata.Metadata{FeeRecipient: feeRecipient}, md)
+
+	// clearing all fields removes the validator from the registry
+	require.NoError(t, store.Update(pubkey, func(md *metadata.Metadata) {
+		*md = metadata.Metadata{}
+	}))
+	md, err = store.Get(pubkey)
+	require.NoError(t, err)
+	require.True(t, md.IsEmpty())
+}
+
+func TestLoadFile(t *testing.T) {
+	pubkey, other := crypto.BLSPubkey{0x01}, crypto.BLSPubkey{0x02}
+	path := filepath.Join(t.TempDir(), "metadata.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"`+pubkey.String()+`": {
+			"fee_recipient": "0x00000000000000000000000000000000000000aa",
+			"graffiti": "hello",
+			"gas_limit": 30000000
+		},
+		"`+other.String()+`": {
+			"gas_limit": 30000000,
+			"builder": {"enabled": true, "gas_limit": 36000000}
+		}
+	}`), 0o600))
+
+	entries, err := metadata.LoadFile(path)
+	require.NoError(t, err)
+
+	graffiti, err := metadata.NewGraffiti("hello")
+	require.NoError(t, err)
+	require.Equal(t, map[crypto.BLSPubkey]*metadata.Metadata{
+		pubkey: {
+			FeeRecipient: common.NewExecutionAddressFromHex(
+				"0x00000000000000000000000000000000000000aa",
+			),
+			Graffiti: graffiti,
+			GasLimit: math.U64(30_000_000),
+		},
+		other: {
+			GasLimit:       math.U64(36_000_000),
+			BuilderEnabled: true,
+		},
+	}, entries)
+
+	// graffiti longer than 32 bytes are rejected
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"`+pubkey.String()+`": {
+			"graffiti": "this graffiti is way too long to fit in a block"
+		}
+	}`), 0o600))
+	_, err = metadata.LoadFile(path)
+	require.Error(t, err)
+}
@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package migration
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+)
+
+// versionKeyPrefix prefixes the keys holding the schema version of each store
+// prefix, as a big endian uint64.
+const versionKeyPrefix = "schema_version/"
+
+var (
+	// ErrUnsupportedVersion is returned when the schema version of a store
+	// prefix is newer than the latest one known, e.g. when the database was
+	// written by a newer release.
+	ErrUnsupportedVersion = errors.New("unsupported schema version")
+
+	// ErrOutdatedVersion is returned when checking a store prefix whose keys
+	// must be migrated to the latest schema version.
+	ErrOutdatedVersion = errors.New("outdated schema version")
+
+	// ErrInvalidVersion is returned when a schema version key is malformed.
+	ErrInvalidVersion = errors.New("invalid schema version")
+)
+
+// Migration upgrades the keys of a store prefix from a schema version to the
+// next one.
+type Migration struct {
+	// Description describes the change of the keys.
+	Description string
+	// Migrate reads the keys to upgrade from r and writes the upgraded ones
+	// to w. The writes are applied atomically along with the new version.
+	Migrate func(r store.Reader, w store.Batch) error
+}
+
+// Schema is the history of the encoding of the keys of a store prefix. The
+// migration at index i upgrades the keys from version i to version i+1, so
+// the latest version is the number of migrations. Migrations must only be
+// appended.
+type Schema struct {
+	// Prefix is the prefix of the keys of the schema.
+	Prefix string
+	// Migrations are the migrations of the keys, in order.
+	Migrations []Migration
+}
+
+// Latest returns the latest version of the schema.
+func (s Schema) Latest() uint64 {
+	return uint64(len(s.Migrations))
+}
+
+// Version returns the schema version of the given store prefix, false if it
+// has none, i.e. if the prefix was written before versioning or never.
+func Version(r store.Reader, prefix string) (uint64, bool, error) {
+	bz, err := r.Get(versionKey(prefix))
+	if err != nil || bz == nil {
+		return 0, false, err
+	}
+	if len(bz) != 8 { //nolint:mnd // uint64.
+		return 0, false, errors.Wrapf(ErrInvalidVersion, "prefix %s", prefix)
+	}
+	return binary.BigEndian.Uint64(bz), true, nil
+}
+
+// Run upgrades the keys of each schema prefix in the database to the latest
+// schema version. Prefixes without keys are new and set to the latest version
+// as is, while prefixes with keys but no version are upgraded from version 0.
+// Each migration is synced to disk along with the version it upgrades to, so
+// that an interrupted run resumes at the failed migration.
+func Run(
+	db store.KVStoreWithBatch,
+	logger log.Logger,
+	schemas ...Schema,
+) error {
+	for _, s := range schemas {
+		if err := run(db, logger, s); err != nil {
+			return errors.Wrapf(err, "failed to migrate prefix %s", s.Prefix)
+		}
+	}
+	return nil
+}
+
+// Check checks that the keys of each schema prefix in the database are at the
+// latest schema version, without migrating them. It is used by databases
+// opened read-only, which cannot be migrated.
+func Check(r store.Reader, schemas ...Schema) error {
+	for _, s := range schemas {
+		version, ok, err := Version(r, s.Prefix)
+		if err == nil && !ok {
+			var empty bool
+			if empty, err = isEmpty(r, s.Prefix); empty {
+				version = s.Latest()
+			}
+		}
+		switch {
+		case err != nil:
+			return errors.Wrapf(err, "failed to check prefix %s", s.Prefix)
+		case version > s.Latest():
+			return errors.Wrapf(
+				ErrUnsupportedVersion, "prefix %s, version %d, latest %d",
+				s.Prefix, version, s.Latest(),
+			)
+		case version < s.Latest():
+			return errors.Wrapf(
+				ErrOutdatedVersion, "prefix %s, version %d, latest %d",
+				s.Prefix, version, s.Latest(),
+			)
+		}
+	}
+	return nil
+}
+
+// run upgrades the keys of the schema prefix to the latest schema version.
+func run(db store.KVStoreWithBatch, logger log.Logger, s Schema) error {
+	version, ok, err := Version(db, s.Prefix)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		var empty bool
+		if empty, err = isEmpty(db, s.Prefix); err != nil {
+			return err
+		}
+		if empty {
+			return setVersion(db, s.Prefix, s.Latest(), nil)
+		}
+	}
+	if version > s.Latest() {
+		return errors.Wrapf(
+			ErrUnsupportedVersion, "version %d, latest %d", version, s.Latest(),
+		)
+	}
+
+	for ; version < s.Latest(); version++ {
+		m := s.Migrations[version]
+		logger.Info(
+			"Migrating store schema", "prefix", s.Prefix,
+			"version", version+1, "description", m.Description,
+		)
+		if err = setVersion(db, s.Prefix, version+1, m.Migrate); err != nil {
+			return errors.Wrapf(err, "migration to version %d", version+1)
+		}
+	}
+	if !ok && s.Latest() == 0 {
+		// Stamp the prefix written before versioning.
+		return setVersion(db, s.Prefix, 0, nil)
+	}
+	return nil
+}
+
+// setVersion applies the migration, if any, and sets the schema version of
+// the prefix atomically.
+func setVersion(
+	db store.KVStoreWithBatch,
+	prefix string,
+	version uint64,
+	migrate func(store.Reader, store.Batch) error,
+) error {
+	batch := db.NewBatch()
+	defer batch.Close()
+	if migrate != nil {
+		if err := migrate(db, batch); err != nil {
+			return err
+		}
+	}
+	if err := batch.Set(
+		versionKey(prefix), binary.BigEndian.AppendUint64(nil, version),
+	); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// isEmpty returns true if no key starts with the prefix.
+func isEmpty(r store.Reader, prefix string) (bool, error) {
+	iter, err := r.Iterator(
+		[]byte(prefix), storetypes.PrefixEndBytes([]byte(prefix)),
+	)
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+	return !iter.Valid(), nil
+}
+
+// versionKey returns the key of the schema version of the prefix.
+func versionKey(prefix string) []byte {
+	return []byte(versionKeyPrefix + prefix)
+}
@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package migration_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/storage/migration"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+// widenKeys is a migration encoding the keys of the "item/" prefix as big
+// endian uint64s rather than single bytes.
+var widenKeys = migration.Migration{
+	Description: "widen item keys",
+	Migrate: func(r store.Reader, w store.Batch) error {
+		iter, err := r.Iterator([]byte("item/"), []byte("item0"))
+		if err != nil {
+			return err
+		}
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			key := binary.BigEndian.AppendUint64(
+				[]byte("item/"), uint64(iter.Key()[len("item/")]),
+			)
+			if err = errors.Join(
+				w.Delete(iter.Key()), w.Set(key, iter.Value()),
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+func version(t *testing.T, db dbm.DB, prefix string) uint64 {
+	t.Helper()
+	v, ok, err := migration.Version(db, prefix)
+	require.NoError(t, err)
+	require.True(t, ok)
+	return v
+}
+
+func TestRunStampsNewPrefixes(t *testing.T) {
+	db := dbm.NewMemDB()
+	schema := migration.Schema{
+		Prefix:     "item/",
+		Migrations: []migration.Migration{widenKeys},
+	}
+	require.NoError(t, migration.Run(db, noop.NewLogger[any](), schema))
+	require.Equal(t, uint64(1), version(t, db, "item/"))
+
+	// Keys written afterwards use the latest encoding, and are not migrated
+	// again.
+	key := binary.BigEndian.AppendUint64([]byte("item/"), 7)
+	require.NoError(t, db.Set(key, []byte("seven")))
+	require.NoError(t, migration.Run(db, noop.NewLogger[any](), schema))
+	bz, err := db.Get(key)
+	require.NoError(t, err)
+	require.Equal(t, []byte("seven"), bz)
+}
+
+func TestRunMigratesUnversionedPrefixes(t *testing.T) {
+	db := dbm.NewMemDB()
+	require.NoError(t, db.Set([]byte("item/\x01"), []byte("one")))
+	require.NoError(t, db.Set([]byte("item/\x02"), []byte("two")))
+	require.NoError(t, db.Set([]byte("other"), []byte("other")))
+
+	schemas := []migration.Schema{
+		{Prefix: "item/", Migrations: []migration.Migration{widenKeys}},
+		{Prefix: "other"},
+	}
+	require.NoError(t, migration.Run(db, noop.NewLogger[any](), schemas...))
+	require.Equal(t, uint64(1), version(t, db, "item/"))
+	require.Equal(t, uint64(0), version(t, db, "other"))
+
+	has, err := db.Has([]byte("item/\x01"))
+	require.NoError(t, err)
+	require.False(t, has)
+	bz, err := db.Get(binary.BigEndian.AppendUint64([]byte("item/"), 2))
+	require.NoError(t, err)
+	require.Equal(t, []byte("two"), bz)
+}
+
+func TestRunFailsAtomically(t *testing.T) {
+	db := dbm.NewMemDB()
+	require.NoError(t, db.Set([]byte("item/\x01"), []byte("one")))
+
+	errFailed := errors.New("failed")
+	schema := migration.Schema{
+		Prefix: "item/",
+		Migrations: []migration.Migration{
+			widenKeys,
+			{
+				Description: "fail midway",
+				Migrate: func(_ store.Reader, w store.Batch) error {
+					require.NoError(t, w.Set([]byte("item/partial"), []byte{}))
+					return errFailed
+				},
+			},
+		},
+	}
+	err := migration.Run(db, noop.NewLogger[any](), schema)
+	require.ErrorIs(t, err, errFailed)
+	require.Equal(t, uint64(1), version(t, db, "item/"))
+	has, err := db.Has([]byte("item/partial"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// A database written by a newer release is not downgraded.
+	err = migration.Run(db, noop.NewLogger[any](), migration.Schema{
+		Prefix: "item/",
+	})
+	require.ErrorIs(t, err, migration.ErrUnsupportedVersion)
+}
+
+func TestCheck(t *testing.T) {
+	db := dbm.NewMemDB()
+	schema := migration.Schema{
+		Prefix:     "item/",
+		Migrations: []migration.Migration{widenKeys},
+	}
+	// Empty prefixes need no migration.
+	require.NoError(t, migration.Check(db, schema))
+
+	require.NoError(t, db.Set([]byte("item/\x07"), []byte("seven")))
+	require.ErrorIs(t, migration.Check(db, schema), migration.ErrOutdatedVersion)
+	require.NoError(t, migration.Run(db, noop.NewLogger[any](), schema))
+	require.NoError(t, migration.Check(db, schema))
+
+	schema.Migrations = nil
+	require.ErrorIs(t, migration.Check(db, schema), migration.ErrUnsupportedVersion)
+}
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package pruner
+
+// Config is the configuration of the pruning of the historical beacon
+// states, once finalized.
+type Config struct {
+	// Retention is the number of most recent beacon states kept in the
+	// multistore. Older states are pruned once each block is finalized, on
+	// nodes pruning the multistore. States are only pruned per the pruning
+	// options of the multistore if zero.
+	Retention uint64 `mapstructure:"retention"`
+}
+
+// DefaultConfig returns the default configuration of the pruning of the
+// historical beacon states.
+func DefaultConfig() Config {
+	return Config{
+		Retention: 0,
+	}
+}
+
+// Enabled reports whether the historical beacon states are pruned.
+func (c Config) Enabled() bool {
+	return c.Retention > 0
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package pruner
+
+import (
+	"context"
+
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+)
+
+// ErrUnsupportedMultiStore is returned when pruning a multistore which is
+// not a root multistore.
+var ErrUnsupportedMultiStore = errors.New("unsupported multistore")
+
+// Node is the interface of the node whose historical states are pruned.
+type Node interface {
+	// AddCommitListener registers a listener notified of each block
+	// committed.
+	AddCommitListener(
+		listener func(height int64, changes []*storetypes.StoreKVPair),
+	)
+	// CommitMultiStore returns the multistore of the node.
+	CommitMultiStore() storetypes.CommitMultiStore
+}
+
+// Service prunes the beacon states committed before the retention from the
+// multistore, once each block is committed, and so finalized. Archive nodes,
+// which do not prune the multistore, keep every state.
+type Service struct {
+	cfg    Config
+	node   Node
+	logger log.Logger
+}
+
+// NewService creates a new service pruning the historical beacon states of
+// the given node.
+func NewService(cfg Config, node Node, logger log.Logger) *Service {
+	return &Service{
+		cfg:    cfg,
+		node:   node,
+		logger: logger,
+	}
+}
+
+// Name returns the name of the service.
+func (*Service) Name() string {
+	return "pruner"
+}
+
+// Start registers the service as a commit listener.
+func (s *Service) Start(context.Context) error {
+	if !s.cfg.Enabled() {
+		return nil
+	}
+	s.node.AddCommitListener(s.onCommit)
+	return nil
+}
+
+// Stop is a no-op, pruning runs as blocks are committed.
+func (*Service) Stop() error {
+	return nil
+}
+
+// onCommit prunes the states committed before the retention, counting back
+// from the given height. It runs as the block is committed, before the next
+// one is finalized, as pruning must not race with the commit of the
+// multistore.
+func (s *Service) onCommit(height int64, _ []*storetypes.StoreKVPair) {
+	cms := s.node.CommitMultiStore()
+	if cms.GetPruning().GetPruningStrategy() == pruningtypes.PruningNothing {
+		return
+	}
+	pruned, err := PruneStates(cms, height, s.cfg.Retention)
+	if err != nil {
+		s.logger.Error("Failed to prune states",
+			"height", height, "error", err)
+		return
+	}
+	if pruned > 0 {
+		s.logger.Debug("Pruned states", "up_to_height", pruned)
+	}
+}
+
+// PruneStates prunes the states committed before the given number of most
+// recent ones from the given multistore, whose latest state is committed at
+// the given height. It returns the height states were pruned up to, zero if
+// none were.
+func PruneStates(
+	cms storetypes.CommitMultiStore, latest int64, keepRecent uint64,
+) (int64, error) {
+	rms, ok := cms.(*rootmulti.Store)
+	if !ok {
+		return 0, errors.Wrapf(ErrUnsupportedMultiStore, "%T", cms)
+	}
+	//#nosec:G115 // latest is positive.
+	if latest <= 0 || uint64(latest) <= keepRecent {
+		return 0, nil
+	}
+	//#nosec:G115 // keepRecent is lower than latest.
+	target := latest - int64(keepRecent)
+	if err := rms.PruneStores(target); err != nil {
+		return 0, err
+	}
+	return target, nil
+}
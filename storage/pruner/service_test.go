@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package pruner_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/storage/pruner"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+var testStoreKey = storetypes.NewKVStoreKey("pruner-tests")
+
+// testNode is a node notifying its listener of each height committed.
+type testNode struct {
+	cms      storetypes.CommitMultiStore
+	listener func(int64, []*storetypes.StoreKVPair)
+}
+
+func (n *testNode) AddCommitListener(
+	listener func(int64, []*storetypes.StoreKVPair),
+) {
+	n.listener = listener
+}
+
+func (n *testNode) CommitMultiStore() storetypes.CommitMultiStore {
+	return n.cms
+}
+
+// commit commits a new state, notifying the listener if any.
+func (n *testNode) commit(t *testing.T) int64 {
+	t.Helper()
+	height := n.cms.LastCommitID().Version + 1
+	n.cms.GetCommitKVStore(testStoreKey).Set(
+		[]byte("height"), []byte{byte(height)},
+	)
+	require.Equal(t, height, n.cms.Commit().Version)
+	if n.listener != nil {
+		n.listener(height, nil)
+	}
+	return height
+}
+
+func newTestNode(
+	t *testing.T, strategy pruningtypes.PruningStrategy,
+) *testNode {
+	t.Helper()
+	cms := rootmulti.NewStore(
+		dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics(),
+	)
+	// States are pruned in the background otherwise.
+	cms.SetIAVLSyncPruning(true)
+	cms.MountStoreWithDB(testStoreKey, storetypes.StoreTypeIAVL, nil)
+	cms.SetPruning(pruningtypes.NewPruningOptions(strategy))
+	require.NoError(t, cms.LoadLatestVersion())
+	return &testNode{cms: cms}
+}
+
+// requireKept checks whether the states committed in [from, to] are kept,
+// reading the value committed at each height, or pruned.
+func requireKept(
+	t *testing.T, cms storetypes.CommitMultiStore, from, to int64, kept bool,
+) {
+	t.Helper()
+	for height := from; height <= to; height++ {
+		ms, err := cms.CacheMultiStoreWithVersion(height)
+		if !kept {
+			require.Error(t, err, "height %d", height)
+			continue
+		}
+		require.NoError(t, err, "height %d", height)
+		require.Equal(t,
+			[]byte{byte(height)},
+			ms.GetKVStore(testStoreKey).Get([]byte("height")),
+		)
+	}
+}
+
+// unsupportedMultiStore is a multistore which is not a root multistore.
+type unsupportedMultiStore struct {
+	storetypes.CommitMultiStore
+}
+
+func TestPrunerService(t *testing.T) {
+	node := newTestNode(t, pruningtypes.PruningDefault)
+	svc := pruner.NewService(
+		pruner.Config{Retention: 3}, node, noop.NewLogger[any](),
+	)
+	require.NoError(t, svc.Start(context.Background()))
+	t.Cleanup(func() { require.NoError(t, svc.Stop()) })
+	require.NotNil(t, node.listener)
+
+	// Nothing is pruned until more states than the retention are committed.
+	for range 3 {
+		node.commit(t)
+	}
+	requireKept(t, node.cms, 1, 3, true)
+
+	// States older than the retention are pruned as blocks are committed,
+	// the most recent ones are kept.
+	for range 7 {
+		node.commit(t)
+	}
+	requireKept(t, node.cms, 1, 7, false)
+	requireKept(t, node.cms, 8, 10, true)
+}
+
+func TestPrunerServiceDisabled(t *testing.T) {
+	node := newTestNode(t, pruningtypes.PruningDefault)
+	svc := pruner.NewService(pruner.Config{}, node, noop.NewLogger[any]())
+	require.NoError(t, svc.Start(context.Background()))
+	require.Nil(t, node.listener)
+
+	for range 5 {
+		node.commit(t)
+	}
+	requireKept(t, node.cms, 1, 5, true)
+}
+
+func TestPrunerServiceArchive(t *testing.T) {
+	// Archive nodes keep every state.
+	node := newTestNode(t, pruningtypes.PruningNothing)
+	svc := pruner.NewService(
+		pruner.Config{Retention: 1}, node, noop.NewLogger[any](),
+	)
+	require.NoError(t, svc.Start(context.Background()))
+
+	for range 5 {
+		node.commit(t)
+	}
+	requireKept(t, node.cms, 1, 5, true)
+}
+
+func TestPruneStates(t *testing.T) {
+	node := newTestNode(t, pruningtypes.PruningDefault)
+	for range 5 {
+		node.commit(t)
+	}
+
+	// Nothing is pruned when keeping at least every state.
+	pruned, err := pruner.PruneStates(node.cms, 5, 5)
+	require.NoError(t, err)
+	require.Zero(t, pruned)
+	requireKept(t, node.cms, 1, 5, true)
+
+	pruned, err = pruner.PruneStates(node.cms, 5, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), pruned)
+	requireKept(t, node.cms, 1, 3, false)
+	requireKept(t, node.cms, 4, 5, true)
+
+	// Only root multistores are pruned.
+	_, err = pruner.PruneStates(
+		unsupportedMultiStore{node.cms}, 5, 2,
+	)
+	require.ErrorIs(t, err, pruner.ErrUnsupportedMultiStore)
+}
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package snapshot
+
+// Config is the configuration of the beacon state snapshots.
+type Config struct {
+	// Interval is the number of epochs between the snapshots of the beacon
+	// state, taken at epoch boundaries. States are not snapshotted if zero.
+	Interval uint64 `mapstructure:"interval"`
+	// Retention is the number of epochs snapshots are kept for by nodes
+	// pruning the multistore. Older snapshots are compacted away in the
+	// background. Snapshots are kept forever if zero.
+	Retention uint64 `mapstructure:"retention"`
+}
+
+// DefaultConfig returns the default configuration of the beacon state
+// snapshots.
+func DefaultConfig() Config {
+	return Config{
+		Interval:  0,
+		Retention: 0,
+	}
+}
+
+// Enabled reports whether the beacon state is snapshotted.
+func (c Config) Enabled() bool {
+	return c.Interval > 0
+}
@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package snapshot
+
+import (
+	"context"
+
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// queueSize is the number of epoch boundary heights waiting to be
+// snapshotted. Boundaries committed while the queue is full are skipped.
+const queueSize = 4
+
+// Node is the interface of the node the beacon state is snapshotted from.
+type Node interface {
+	// AddCommitListener registers a listener notified of each block
+	// committed.
+	AddCommitListener(
+		listener func(height int64, changes []*storetypes.StoreKVPair),
+	)
+	// CreateQueryContext creates a query context for a given height.
+	CreateQueryContext(height int64, prove bool) (sdk.Context, error)
+	// CommitMultiStore returns the multistore of the node.
+	CommitMultiStore() storetypes.CommitMultiStore
+}
+
+// StorageBackend is the interface of the backend the beacon state is read
+// from.
+type StorageBackend interface {
+	// StateFromContext returns the beacon state of the given context.
+	StateFromContext(ctx context.Context) *statedb.StateDB
+}
+
+// Service snapshots the beacon state committed at epoch boundaries, every
+// configured number of epochs, and compacts away the snapshots older than
+// the retention on nodes pruning the multistore. Snapshots are taken in the
+// background, not to delay the commit of blocks.
+type Service struct {
+	cfg    Config
+	cs     chain.ChainSpec
+	node   Node
+	sb     StorageBackend
+	store  *KVStore
+	logger log.Logger
+
+	heights chan int64
+	stopCh  chan struct{}
+}
+
+// NewService creates a new service snapshotting the beacon state into the
+// given store.
+func NewService(
+	cfg Config,
+	cs chain.ChainSpec,
+	node Node,
+	sb StorageBackend,
+	store *KVStore,
+	logger log.Logger,
+) *Service {
+	return &Service{
+		cfg:     cfg,
+		cs:      cs,
+		node:    node,
+		sb:      sb,
+		store:   store,
+		logger:  logger,
+		heights: make(chan int64, queueSize),
+		stopCh:  make(chan struct{}),
+	}
+}
+
+// Name returns the name of the service.
+func (*Service) Name() string {
+	return "state-snapshots"
+}
+
+// Start registers the service as a commit listener and starts snapshotting
+// the epoch boundary states in the background.
+func (s *Service) Start(ctx context.Context) error {
+	if !s.cfg.Enabled() {
+		return nil
+	}
+	s.node.AddCommitListener(s.onCommit)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.stopCh:
+				return
+			case height := <-s.heights:
+				s.snapshot(height)
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops snapshotting the beacon state.
+func (s *Service) Stop() error {
+	select {
+	case <-s.stopCh:
+	default:
+		close(s.stopCh)
+	}
+	return nil
+}
+
+// onCommit queues the given height to be snapshotted, if it is on the
+// snapshot interval.
+func (s *Service) onCommit(height int64, _ []*storetypes.StoreKVPair) {
+	//#nosec:G115 // heights are positive.
+	if uint64(height)%s.intervalSlots() != 0 {
+		return
+	}
+	select {
+	case s.heights <- height:
+	default:
+		s.logger.Warn("Skipping state snapshot, snapshots are lagging",
+			"height", height)
+	}
+}
+
+// snapshot stores the snapshot of the beacon state committed at the given
+// height, then compacts the snapshots out of the retention.
+func (s *Service) snapshot(height int64) {
+	ctx, err := s.node.CreateQueryContext(height, false)
+	if err != nil {
+		s.logger.Error("Failed to load state to snapshot",
+			"height", height, "error", err)
+		return
+	}
+	st, err := s.sb.StateFromContext(ctx).GetMarshallable()
+	if err == nil {
+		err = s.store.Set(st)
+	}
+	if err != nil {
+		s.logger.Error("Failed to snapshot state",
+			"height", height, "error", err)
+		return
+	}
+	s.logger.Info("Snapshotted state", "slot", st.Slot)
+
+	if err = s.compact(st.Slot); err != nil {
+		s.logger.Error("Failed to compact state snapshots",
+			"slot", st.Slot, "error", err)
+	}
+}
+
+// compact removes the snapshots older than the retention before the given
+// slot. Archive nodes, which do not prune the multistore, keep every
+// snapshot.
+func (s *Service) compact(slot math.Slot) error {
+	if s.cfg.Retention == 0 || s.node.CommitMultiStore().GetPruning().
+		GetPruningStrategy() == pruningtypes.PruningNothing {
+		return nil
+	}
+	retention := s.cfg.Retention * s.cs.SlotsPerEpoch()
+	if slot.Unwrap() <= retention {
+		return nil
+	}
+	return s.store.Prune(slot - math.Slot(retention))
+}
+
+// intervalSlots returns the number of slots between the snapshots.
+func (s *Service) intervalSlots() uint64 {
+	return s.cfg.Interval * s.cs.SlotsPerEpoch()
+}
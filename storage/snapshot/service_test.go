@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package snapshot_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+	"github.com/berachain/beacon-kit/storage/encoding"
+	"github.com/berachain/beacon-kit/storage/snapshot"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var testStoreKey = storetypes.NewKVStoreKey("snapshot-tests")
+
+// testNode is a node committing the heights it is notified of, the state
+// of each height being at the slot of the height.
+type testNode struct {
+	cms      storetypes.CommitMultiStore
+	listener func(int64, []*storetypes.StoreKVPair)
+}
+
+func (n *testNode) AddCommitListener(
+	listener func(int64, []*storetypes.StoreKVPair),
+) {
+	n.listener = listener
+}
+
+func (n *testNode) CreateQueryContext(height int64, _ bool) (sdk.Context, error) {
+	return sdk.NewContext(n.cms, true, log.NewNopLogger()).
+		WithBlockHeight(height), nil
+}
+
+func (n *testNode) CommitMultiStore() storetypes.CommitMultiStore {
+	return n.cms
+}
+
+type testKVStoreService struct {
+	ctx sdk.Context
+}
+
+func (kvs *testKVStoreService) OpenKVStore(context.Context) corestore.KVStore {
+	return components.NewKVStore(kvs.ctx.KVStore(testStoreKey))
+}
+
+// testBackend returns in-memory beacon states at the slot of the height of
+// the given contexts.
+type testBackend struct {
+	t  *testing.T
+	cs chain.ChainSpec
+}
+
+func (b *testBackend) StateFromContext(ctx context.Context) *statedb.StateDB {
+	cms := newTestCMS(b.t)
+	kv := beacondb.New(
+		&testKVStoreService{ctx: sdk.NewContext(cms, false, log.NewNopLogger())},
+		&encoding.SSZInterfaceCodec[*ctypes.ExecutionPayloadHeader]{},
+	)
+	st := new(statedb.StateDB).NewFromDB(kv, b.cs)
+	//#nosec:G115 // heights are positive.
+	slot := math.Slot(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	require.NoError(b.t, st.SetMarshallable(testState(b.t, slot)))
+	return st
+}
+
+func newTestCMS(t *testing.T) storetypes.CommitMultiStore {
+	t.Helper()
+	cms := store.NewCommitMultiStore(
+		dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics(),
+	)
+	cms.MountStoreWithDB(testStoreKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	return cms
+}
+
+func TestSnapshotService(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	spe := math.Slot(cs.SlotsPerEpoch())
+	store := snapshot.NewStore(
+		storage.NewKVStoreProvider(dbm.NewMemDB()),
+		nil,
+		noop.NewLogger[any](),
+	)
+	node := &testNode{cms: newTestCMS(t)}
+	node.cms.SetPruning(
+		pruningtypes.NewPruningOptions(pruningtypes.PruningDefault),
+	)
+
+	svc := snapshot.NewService(
+		snapshot.Config{Interval: 1, Retention: 2},
+		cs, node, &testBackend{t: t, cs: cs}, store, noop.NewLogger[any](),
+	)
+	require.NoError(t, svc.Start(context.Background()))
+	t.Cleanup(func() { require.NoError(t, svc.Stop()) })
+
+	commit := func(slot math.Slot) {
+		t.Helper()
+		node.listener(int64(slot), nil) //#nosec:G115 // small slots.
+		require.Eventually(t, func() bool {
+			bs, latestErr := store.Latest()
+			return latestErr == nil && bs.Slot == slot
+		}, time.Second, time.Millisecond)
+	}
+
+	// Only the states at epoch boundaries are snapshotted.
+	node.listener(int64(spe)+1, nil) //#nosec:G115 // small slots.
+	for epoch := range math.Slot(4) {
+		commit((epoch + 1) * spe)
+	}
+	bs, err := store.GetAtOrBefore(3*spe - 1)
+	require.NoError(t, err)
+	require.Equal(t, 2*spe, bs.Slot)
+
+	// Snapshots older than the retention are compacted away.
+	_, err = store.GetAtOrBefore(2*spe - 1)
+	require.ErrorIs(t, err, snapshot.ErrNotFound)
+
+	// Archive nodes keep every snapshot.
+	node.cms.SetPruning(
+		pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+	)
+	commit(5 * spe)
+	bs, err = store.GetAtOrBefore(3*spe - 1)
+	require.NoError(t, err)
+	require.Equal(t, 2*spe, bs.Slot)
+}
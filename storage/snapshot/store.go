@@ -0,0 +1,271 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package snapshot
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/migration"
+)
+
+const KeySnapshotPrefix = "snapshot"
+
+// ErrNotFound is returned when no snapshot is stored at or before a slot.
+var ErrNotFound = errors.New("no state snapshot found")
+
+// ColdStore is the cold storage ancient snapshots are moved to, out of the
+// KV store.
+type ColdStore interface {
+	// Append appends the snapshot taken at the given slot, after the last
+	// one.
+	Append(slot math.Slot, bz []byte) error
+	// GetAtOrBefore returns the slot and the latest snapshot taken at or
+	// before the given slot, nil if there is none.
+	GetAtOrBefore(slot math.Slot) (math.Slot, []byte, error)
+	// Last returns the slot of the last snapshot, false if there is none.
+	Last() (math.Slot, bool)
+}
+
+// Schemas returns the schemas of the keys of the store, migrated when the
+// database is opened.
+func Schemas() []migration.Schema {
+	return []migration.Schema{{Prefix: KeySnapshotPrefix}}
+}
+
+// KVStore is a KV store of snapshots of the beacon state, keyed by slot,
+// from which the states pruned from the multistore are regenerated.
+type KVStore struct {
+	store sdkcollections.Map[uint64, []byte]
+	// cold holds the snapshots frozen out of store, if any.
+	cold ColdStore
+
+	// mu protects store for concurrent access
+	mu sync.RWMutex
+	// closer closes the underlying database, if any.
+	closer io.Closer
+
+	// logger is used for logging information and errors.
+	logger log.Logger
+}
+
+// NewStore creates a new state snapshot store, backed by the given cold
+// store, if any, for the snapshots frozen out of it.
+func NewStore(
+	kvsp store.KVStoreService,
+	cold ColdStore,
+	logger log.Logger,
+) *KVStore {
+	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
+	res := &KVStore{
+		store: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeySnapshotPrefix)),
+			KeySnapshotPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
+		cold:   cold,
+		logger: logger,
+	}
+	if _, err := schemaBuilder.Build(); err != nil {
+		panic(errors.Wrap(err, "failed building KVStore schema"))
+	}
+	if closer, ok := kvsp.(io.Closer); ok {
+		res.closer = closer
+	}
+	return res
+}
+
+// Close closes the underlying database. The store must not be used
+// afterwards.
+func (kv *KVStore) Close() error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if kv.closer == nil {
+		return nil
+	}
+	return kv.closer.Close()
+}
+
+// Set stores the snapshot of the given beacon state, taken at its slot.
+func (kv *KVStore) Set(st *ctypes.BeaconState) error {
+	bz, err := st.MarshalSSZ()
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode snapshot, slot: %d", st.Slot)
+	}
+
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if err = kv.store.Set(context.TODO(), st.Slot.Unwrap(), bz); err != nil {
+		return errors.Wrapf(err, "failed to set snapshot, slot: %d", st.Slot)
+	}
+	kv.logger.Debug("Set state snapshot", "slot", st.Slot)
+	return nil
+}
+
+// Latest returns the latest snapshot, or ErrNotFound if there is none.
+func (kv *KVStore) Latest() (*ctypes.BeaconState, error) {
+	return kv.GetAtOrBefore(math.Slot(^uint64(0)))
+}
+
+// GetAtOrBefore returns the latest snapshot taken at or before the given
+// slot, or ErrNotFound if there is none.
+func (kv *KVStore) GetAtOrBefore(slot math.Slot) (*ctypes.BeaconState, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	iter, err := kv.store.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).
+			EndInclusive(slot.Unwrap()).
+			Descending(),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err, "failed to iterate snapshots, slot: %d", slot,
+		)
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return kv.getColdAtOrBefore(slot)
+	}
+
+	bz, err := iter.Value()
+	if err != nil {
+		return nil, err
+	}
+	return decodeSnapshot(bz, slot)
+}
+
+// getColdAtOrBefore returns the latest snapshot frozen at or before the
+// given slot, or ErrNotFound if there is none.
+func (kv *KVStore) getColdAtOrBefore(slot math.Slot) (*ctypes.BeaconState, error) {
+	if kv.cold == nil {
+		return nil, errors.Wrapf(ErrNotFound, "slot: %d", slot)
+	}
+	_, bz, err := kv.cold.GetAtOrBefore(slot)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err, "failed to get frozen snapshot, slot: %d", slot,
+		)
+	}
+	if bz == nil {
+		return nil, errors.Wrapf(ErrNotFound, "slot: %d", slot)
+	}
+	return decodeSnapshot(bz, slot)
+}
+
+// decodeSnapshot decodes the snapshot found for the given slot.
+func decodeSnapshot(bz []byte, slot math.Slot) (*ctypes.BeaconState, error) {
+	st := new(ctypes.BeaconState)
+	if err := st.UnmarshalSSZ(bz); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode snapshot, slot: %d", slot)
+	}
+	return st, nil
+}
+
+// Prune removes the snapshots taken before the given slot.
+func (kv *KVStore) Prune(before math.Slot) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if err := kv.store.Clear(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).EndExclusive(before.Unwrap()),
+	); err != nil {
+		return errors.Wrapf(err, "failed to prune snapshots before %d", before)
+	}
+	kv.logger.Debug("Pruned state snapshots", "before", before)
+	return nil
+}
+
+// Rewind removes the snapshots taken after the given slot, as when the chain
+// is rolled back. The snapshots frozen after it are removed by rewinding the
+// cold store.
+func (kv *KVStore) Rewind(slot math.Slot) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if err := kv.store.Clear(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).StartExclusive(slot.Unwrap()),
+	); err != nil {
+		return errors.Wrapf(err, "failed to rewind snapshots to %d", slot)
+	}
+	kv.logger.Debug("Rewound state snapshots", "slot", slot)
+	return nil
+}
+
+// Freeze moves the snapshots taken before the given slot to the cold store,
+// if any.
+func (kv *KVStore) Freeze(before math.Slot) error {
+	if kv.cold == nil {
+		return nil
+	}
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	ranger := new(sdkcollections.Range[uint64]).EndExclusive(before.Unwrap())
+	frozen, err := kv.freeze(ranger)
+	if err != nil {
+		return errors.Wrapf(err, "failed to freeze snapshots before %d", before)
+	}
+	if err = kv.store.Clear(context.TODO(), ranger); err != nil {
+		return errors.Wrapf(err, "failed to remove snapshots before %d", before)
+	}
+	if frozen > 0 {
+		kv.logger.Info("Froze state snapshots",
+			"count", frozen, "before", before)
+	}
+	return nil
+}
+
+// freeze appends the snapshots of the given range to the cold store, and
+// returns the number of snapshots appended.
+func (kv *KVStore) freeze(ranger sdkcollections.Ranger[uint64]) (int, error) {
+	iter, err := kv.store.Iterate(context.TODO(), ranger)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+	frozen := 0
+	for ; iter.Valid(); iter.Next() {
+		kvPair, kvErr := iter.KeyValue()
+		if kvErr != nil {
+			return frozen, kvErr
+		}
+		slot := math.Slot(kvPair.Key)
+		// Snapshots frozen before a crash prevented their removal are
+		// skipped.
+		if last, ok := kv.cold.Last(); ok && slot <= last {
+			continue
+		}
+		if err = kv.cold.Append(slot, kvPair.Value); err != nil {
+			return frozen, err
+		}
+		frozen++
+	}
+	return frozen, nil
+}
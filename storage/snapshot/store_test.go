@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package snapshot_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/freezer"
+	"github.com/berachain/beacon-kit/storage/snapshot"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func testState(t *testing.T, slot math.Slot) *ctypes.BeaconState {
+	t.Helper()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	bs, err := new(ctypes.BeaconState).New(
+		0,
+		common.Root{0x01},
+		slot,
+		&ctypes.Fork{},
+		&ctypes.BeaconBlockHeader{Slot: slot},
+		make([]common.Root, cs.SlotsPerHistoricalRoot()),
+		make([]common.Root, cs.SlotsPerHistoricalRoot()),
+		&ctypes.Eth1Data{},
+		0,
+		&ctypes.ExecutionPayloadHeader{BaseFeePerGas: &math.U256{}},
+		nil,
+		nil,
+		make([]common.Bytes32, cs.EpochsPerHistoricalVector()),
+		0,
+		0,
+		[]math.Gwei{0},
+		0,
+	)
+	require.NoError(t, err)
+	return bs
+}
+
+func TestSnapshotStore(t *testing.T) {
+	store := snapshot.NewStore(
+		storage.NewKVStoreProvider(dbm.NewMemDB()),
+		nil,
+		noop.NewLogger[any](),
+	)
+
+	_, err := store.GetAtOrBefore(10)
+	require.ErrorIs(t, err, snapshot.ErrNotFound)
+
+	for _, slot := range []math.Slot{4, 8} {
+		require.NoError(t, store.Set(testState(t, slot)))
+	}
+
+	_, err = store.GetAtOrBefore(3)
+	require.ErrorIs(t, err, snapshot.ErrNotFound)
+	for slot, expected := range map[math.Slot]math.Slot{
+		4: 4, 7: 4, 8: 8, 100: 8,
+	} {
+		bs, getErr := store.GetAtOrBefore(slot)
+		require.NoError(t, getErr)
+		require.Equal(t, expected, bs.Slot)
+		require.Equal(
+			t, testState(t, expected).HashTreeRoot(), bs.HashTreeRoot(),
+		)
+	}
+	bs, err := store.Latest()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(8), bs.Slot)
+
+	// Only the snapshots taken before the given slot are pruned.
+	require.NoError(t, store.Prune(8))
+	_, err = store.GetAtOrBefore(7)
+	require.ErrorIs(t, err, snapshot.ErrNotFound)
+	bs, err = store.Latest()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(8), bs.Slot)
+}
+
+func TestSnapshotStoreFreeze(t *testing.T) {
+	cold, err := freezer.OpenTable(t.TempDir(), "snapshots")
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, cold.Close()) })
+	store := snapshot.NewStore(
+		storage.NewKVStoreProvider(dbm.NewMemDB()),
+		cold,
+		noop.NewLogger[any](),
+	)
+	for _, slot := range []math.Slot{4, 8, 12} {
+		require.NoError(t, store.Set(testState(t, slot)))
+	}
+
+	// Snapshots before the given slot are moved to the cold store, from
+	// which they are still served.
+	require.NoError(t, store.Freeze(12))
+	last, ok := cold.Last()
+	require.True(t, ok)
+	require.Equal(t, math.Slot(8), last)
+	for slot, expected := range map[math.Slot]math.Slot{
+		5: 4, 11: 8, 12: 12, 100: 12,
+	} {
+		bs, getErr := store.GetAtOrBefore(slot)
+		require.NoError(t, getErr)
+		require.Equal(t, expected, bs.Slot)
+	}
+	_, err = store.GetAtOrBefore(3)
+	require.ErrorIs(t, err, snapshot.ErrNotFound)
+
+	// Freezing again is a no-op.
+	require.NoError(t, store.Freeze(12))
+	bs, err := store.GetAtOrBefore(11)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(8), bs.Slot)
+}
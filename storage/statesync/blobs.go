@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package statesync
+
+import (
+	"bytes"
+	"io"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"github.com/berachain/beacon-kit/chain-spec/chain"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// blobsFormat is the format of the blob sidecars in the snapshots, each
+// payload being the availability store archive of the sidecars of one slot.
+const blobsFormat uint32 = 1
+
+// BlobsSnapshotter adds the blob sidecars still within the data availability
+// period to the state sync snapshots, so that restored nodes serve them.
+//
+// Unlike the multistore, sidecars are not covered by the app hash CometBFT
+// verifies the restored state against. They are only checked to be well
+// formed, as when importing an archive, so snapshots must be restored from
+// trusted peers.
+type BlobsSnapshotter struct {
+	store     AvailabilityStore
+	chainSpec chain.ChainSpec
+}
+
+// NewBlobsSnapshotter creates a new BlobsSnapshotter.
+func NewBlobsSnapshotter(
+	store AvailabilityStore, chainSpec chain.ChainSpec,
+) *BlobsSnapshotter {
+	return &BlobsSnapshotter{store: store, chainSpec: chainSpec}
+}
+
+// SnapshotName returns the name of the blob sidecars in the snapshots.
+func (*BlobsSnapshotter) SnapshotName() string {
+	return "blobs"
+}
+
+// SnapshotFormat returns the format the blob sidecars are snapshotted in.
+func (*BlobsSnapshotter) SnapshotFormat() uint32 {
+	return blobsFormat
+}
+
+// SupportedFormats returns the formats the blob sidecars are restored from.
+func (*BlobsSnapshotter) SupportedFormats() []uint32 {
+	return []uint32{blobsFormat}
+}
+
+// SnapshotExtension writes the blob sidecars of the slots within the data
+// availability period at the given height, one slot at a time.
+func (b *BlobsSnapshotter) SnapshotExtension(
+	height uint64, write snapshottypes.ExtensionPayloadWriter,
+) error {
+	var buf bytes.Buffer
+	for slot := b.firstSlot(height); slot <= height; slot++ {
+		buf.Reset()
+		count, err := b.store.Export(&buf, math.Slot(slot), math.Slot(slot))
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			continue
+		}
+		if err = write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RestoreExtension stores the blob sidecars of a snapshot.
+func (b *BlobsSnapshotter) RestoreExtension(
+	_ uint64, format uint32, read snapshottypes.ExtensionPayloadReader,
+) error {
+	if format != blobsFormat {
+		return errors.Wrapf(
+			snapshottypes.ErrUnknownFormat, "blobs format %d", format,
+		)
+	}
+	for {
+		bz, err := read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if _, err = b.store.Import(bytes.NewReader(bz)); err != nil {
+			return err
+		}
+	}
+}
+
+// firstSlot returns the first slot whose blob sidecars are within the data
+// availability period at the given slot.
+func (b *BlobsSnapshotter) firstSlot(slot uint64) uint64 {
+	var (
+		slotsPerEpoch = b.chainSpec.SlotsPerEpoch()
+		epoch         = slot / slotsPerEpoch
+		minEpochs     = b.chainSpec.MinEpochsForBlobsSidecarsRequest()
+	)
+	if epoch <= minEpochs {
+		return 0
+	}
+	return (epoch - minEpochs) * slotsPerEpoch
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package statesync
+
+// defaultKeepRecent is the default number of recent snapshots kept.
+const defaultKeepRecent = 2
+
+// Config is the configuration of the state sync snapshots served to the
+// nodes joining the network with CometBFT state sync.
+type Config struct {
+	// Interval is the number of blocks between state sync snapshots. No
+	// snapshot is taken if zero.
+	Interval uint64 `mapstructure:"interval"`
+	// KeepRecent is the number of recent snapshots kept, all of them if zero.
+	KeepRecent uint32 `mapstructure:"keep-recent"`
+}
+
+// DefaultConfig returns the default configuration of the state sync
+// snapshots.
+func DefaultConfig() Config {
+	return Config{
+		Interval:   0,
+		KeepRecent: defaultKeepRecent,
+	}
+}
+
+// Enabled reports whether state sync snapshots are taken.
+func (c Config) Enabled() bool {
+	return c.Interval > 0
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package statesync
+
+import (
+	"io"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+)
+
+// depositsFormat is the format of the deposits in the snapshots, each SSZ
+// encoded in its own payload.
+const depositsFormat uint32 = 1
+
+// DepositsSnapshotter adds the deposits of the deposit store, which the
+// multistore does not hold, to the state sync snapshots.
+type DepositsSnapshotter struct {
+	store DepositStore
+}
+
+// NewDepositsSnapshotter creates a new DepositsSnapshotter.
+func NewDepositsSnapshotter(store DepositStore) *DepositsSnapshotter {
+	return &DepositsSnapshotter{store: store}
+}
+
+// SnapshotName returns the name of the deposits in the snapshots.
+func (*DepositsSnapshotter) SnapshotName() string {
+	return "deposits"
+}
+
+// SnapshotFormat returns the format the deposits are snapshotted in.
+func (*DepositsSnapshotter) SnapshotFormat() uint32 {
+	return depositsFormat
+}
+
+// SupportedFormats returns the formats the deposits are restored from.
+func (*DepositsSnapshotter) SupportedFormats() []uint32 {
+	return []uint32{depositsFormat}
+}
+
+// SnapshotExtension writes all the stored deposits. Deposits are only ever
+// appended, so the ones stored past the snapshot height are harmless.
+func (d *DepositsSnapshotter) SnapshotExtension(
+	_ uint64, write snapshottypes.ExtensionPayloadWriter,
+) error {
+	deposits, err := d.store.GetDepositsFromIndex(0)
+	if err != nil {
+		return err
+	}
+	for _, deposit := range deposits {
+		bz, err := deposit.MarshalSSZ()
+		if err != nil {
+			return err
+		}
+		if err = write(bz); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RestoreExtension stores the deposits of a snapshot.
+func (d *DepositsSnapshotter) RestoreExtension(
+	_ uint64, format uint32, read snapshottypes.ExtensionPayloadReader,
+) error {
+	if format != depositsFormat {
+		return errors.Wrapf(
+			snapshottypes.ErrUnknownFormat, "deposits format %d", format,
+		)
+	}
+	var deposits []*ctypes.Deposit
+	for {
+		bz, err := read()
+		if errors.Is(err, io.EOF) {
+			return d.store.EnqueueDeposits(deposits)
+		} else if err != nil {
+			return err
+		}
+		deposit := new(ctypes.Deposit)
+		if err = deposit.UnmarshalSSZ(bz); err != nil {
+			return err
+		}
+		deposits = append(deposits, deposit)
+	}
+}
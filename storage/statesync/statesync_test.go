@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package statesync_test
+
+import (
+	"io"
+	"testing"
+
+	"cosmossdk.io/log"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/da/store"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/filedb"
+	"github.com/berachain/beacon-kit/storage/statesync"
+	"github.com/stretchr/testify/require"
+)
+
+// payloads collects the payloads written by an extension snapshotter and
+// reads them back.
+type payloads [][]byte
+
+func (p *payloads) write(bz []byte) error {
+	*p = append(*p, bz)
+	return nil
+}
+
+func (p *payloads) reader() snapshottypes.ExtensionPayloadReader {
+	remaining := *p
+	return func() ([]byte, error) {
+		if len(remaining) == 0 {
+			return nil, io.EOF
+		}
+		bz := remaining[0]
+		remaining = remaining[1:]
+		return bz, nil
+	}
+}
+
+// depositStore is an in-memory DepositStore.
+type depositStore struct {
+	deposits ctypes.Deposits
+}
+
+func (s *depositStore) GetDepositsFromIndex(
+	startIndex uint64,
+) (ctypes.Deposits, error) {
+	var deposits ctypes.Deposits
+	for _, deposit := range s.deposits {
+		if deposit.GetIndex().Unwrap() >= startIndex {
+			deposits = append(deposits, deposit)
+		}
+	}
+	return deposits, nil
+}
+
+func (s *depositStore) EnqueueDeposits(deposits []*ctypes.Deposit) error {
+	s.deposits = append(s.deposits, deposits...)
+	return nil
+}
+
+func TestDepositsSnapshotter(t *testing.T) {
+	src := &depositStore{}
+	for i := range 3 {
+		require.NoError(t, src.EnqueueDeposits([]*ctypes.Deposit{{
+			Amount: math.Gwei(32e9),
+			Index:  uint64(i),
+		}}))
+	}
+
+	var p payloads
+	snapshotter := statesync.NewDepositsSnapshotter(src)
+	require.NoError(t, snapshotter.SnapshotExtension(10, p.write))
+	require.Len(t, p, 3)
+
+	dst := &depositStore{}
+	require.NoError(t, statesync.NewDepositsSnapshotter(dst).RestoreExtension(
+		10, snapshotter.SnapshotFormat(), p.reader(),
+	))
+	require.Equal(t, src.deposits, dst.deposits)
+
+	err := statesync.NewDepositsSnapshotter(dst).RestoreExtension(
+		10, snapshotter.SnapshotFormat()+1, p.reader(),
+	)
+	require.ErrorIs(t, err, snapshottypes.ErrUnknownFormat)
+}
+
+func newAvailabilityStore(t *testing.T) *store.Store {
+	t.Helper()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	logger := log.NewNopLogger()
+	return store.New(
+		filedb.NewRangeDB(filedb.NewDB(
+			filedb.WithRootDirectory(t.TempDir()),
+			filedb.WithFileExtension("ssz"),
+			filedb.WithDirectoryPermissions(0o700),
+			filedb.WithLogger(logger),
+		)),
+		logger,
+		cs,
+	)
+}
+
+// persistSidecar stores a sidecar of a block of the given slot.
+func persistSidecar(t *testing.T, s *store.Store, slot math.Slot) {
+	t.Helper()
+	header := &ctypes.SignedBeaconBlockHeader{
+		Header: &ctypes.BeaconBlockHeader{Slot: slot},
+	}
+	require.NoError(t, s.Persist(slot, datypes.BlobSidecars{
+		datypes.BuildBlobSidecar(
+			0,
+			header,
+			&eip4844.Blob{},
+			eip4844.KZGCommitment{byte(slot)},
+			eip4844.KZGProof{},
+			make([]common.Root, 8),
+		),
+	}))
+}
+
+func TestBlobsSnapshotter(t *testing.T) {
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	var (
+		// The sidecars of the first slot are past the data availability
+		// period at the snapshot height, unlike the ones of the last slot.
+		height = (cs.MinEpochsForBlobsSidecarsRequest() + 2) *
+			cs.SlotsPerEpoch()
+		old    = math.Slot(1)
+		recent = math.Slot(height - 1)
+		src    = newAvailabilityStore(t)
+	)
+	persistSidecar(t, src, old)
+	persistSidecar(t, src, recent)
+
+	var p payloads
+	snapshotter := statesync.NewBlobsSnapshotter(src, cs)
+	require.NoError(t, snapshotter.SnapshotExtension(height, p.write))
+	require.Len(t, p, 1)
+
+	dst := newAvailabilityStore(t)
+	require.NoError(t, statesync.NewBlobsSnapshotter(dst, cs).RestoreExtension(
+		height, snapshotter.SnapshotFormat(), p.reader(),
+	))
+	for slot, expected := range map[math.Slot]bool{old: false, recent: true} {
+		commitment := eip4844.KZGCommitment{byte(slot)}
+		ok, err := dst.Has(slot.Unwrap(), commitment[:])
+		require.NoError(t, err)
+		require.Equal(t, expected, ok, "slot %d", slot)
+	}
+}
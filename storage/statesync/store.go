@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package statesync
+
+import (
+	"os"
+	"path/filepath"
+
+	"cosmossdk.io/store/snapshots"
+	"github.com/berachain/beacon-kit/errors"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// Dir returns the directory of the state sync snapshots of the node with the
+// given home directory.
+func Dir(homeDir string) string {
+	return filepath.Join(homeDir, "data", "snapshots")
+}
+
+// OpenStore opens the store of the state sync snapshots in the given
+// directory, creating it if needed.
+func OpenStore(dir string) (*snapshots.Store, error) {
+	store, _, err := openStore(dir)
+	return store, err
+}
+
+// Rewind removes the state sync snapshots taken after the given height from
+// the store in the given directory, if any, as when the chain is rolled
+// back.
+func Rewind(dir string, height uint64) error {
+	if _, err := os.Stat(dir); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	store, db, err := openStore(dir)
+	if err != nil {
+		return err
+	}
+	return errors.Join(rewind(store, height), db.Close())
+}
+
+// rewind removes the snapshots of the given store taken after the given
+// height.
+func rewind(store *snapshots.Store, height uint64) error {
+	list, err := store.List()
+	if err != nil {
+		return err
+	}
+	for _, snapshot := range list {
+		if snapshot.Height <= height {
+			continue
+		}
+		if err = store.Delete(snapshot.Height, snapshot.Format); err != nil {
+			return errors.Wrapf(
+				err, "failed to delete state sync snapshot at height %d",
+				snapshot.Height,
+			)
+		}
+	}
+	return nil
+}
+
+// openStore opens the store of the state sync snapshots in the given
+// directory, creating it if needed, along with its metadata database.
+func openStore(dir string) (*snapshots.Store, dbm.DB, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, nil, err
+	}
+	db, err := dbm.NewDB("metadata", dbm.PebbleDBBackend, dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	store, err := snapshots.NewStore(db, dir)
+	if err != nil {
+		return nil, nil, errors.Join(err, db.Close())
+	}
+	return store, db, nil
+}
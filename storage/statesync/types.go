@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package statesync
+
+import (
+	"io"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// AvailabilityStore is the store of the blob sidecars.
+type AvailabilityStore interface {
+	// Export writes the blob sidecars stored for the slots in [from, to] to
+	// w, and returns the number of sidecars written.
+	Export(w io.Writer, from, to math.Slot) (int, error)
+	// Import stores the blob sidecars written by Export to r, and returns
+	// the number of sidecars stored.
+	Import(r io.Reader) (int, error)
+}
+
+// DepositStore is the store of the deposits.
+type DepositStore interface {
+	// GetDepositsFromIndex returns all the deposits starting from the given
+	// index, ordered by index.
+	GetDepositsFromIndex(startIndex uint64) (ctypes.Deposits, error)
+	// EnqueueDeposits stores the given deposits.
+	EnqueueDeposits(deposits []*ctypes.Deposit) error
+}
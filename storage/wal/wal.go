@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package wal
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/berachain/beacon-kit/errors"
+)
+
+// headerSize is the size of a record header: the length of the record
+// followed by its CRC-32C checksum, both big endian.
+const headerSize = 8
+
+//nolint:gochecknoglobals // lookup table.
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// WAL is a write-ahead log of opaque records, appended to a single file.
+// Records are synced to disk before Append returns, and a record partially
+// appended before a crash is dropped when the log is opened. The log only
+// grows until it is reset to the records still needed.
+type WAL struct {
+	mu   sync.Mutex
+	path string
+	file *os.File
+
+	// size is the size of the records in the log.
+	size int64
+}
+
+// Open opens, or creates, the log at the given path.
+func Open(path string) (*WAL, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	w := &WAL{path: path, file: file}
+	if err = w.repair(); err != nil {
+		return nil, errors.Join(
+			errors.Wrapf(err, "failed to open write-ahead log %s", path),
+			file.Close(),
+		)
+	}
+	return w, nil
+}
+
+// repair truncates the log to the last record fully appended.
+func (w *WAL) repair() error {
+	if err := w.read(func([]byte) error { return nil }); err != nil {
+		return err
+	}
+	return w.file.Truncate(w.size)
+}
+
+// read calls fn with the records of the log in order, and sets the size of
+// the log to the end of the last valid one.
+func (w *WAL) read(fn func([]byte) error) error {
+	info, err := w.file.Stat()
+	if err != nil {
+		return err
+	}
+	r := io.NewSectionReader(w.file, 0, info.Size())
+	header := make([]byte, headerSize)
+	for w.size = 0; ; {
+		if _, err = io.ReadFull(r, header); err != nil {
+			return ignoreTruncated(err)
+		}
+		length := int64(binary.BigEndian.Uint32(header))
+		if w.size+headerSize+length > info.Size() {
+			return nil
+		}
+		record := make([]byte, length)
+		if _, err = io.ReadFull(r, record); err != nil {
+			return err
+		}
+		if crc32.Checksum(record, castagnoli) !=
+			binary.BigEndian.Uint32(header[4:]) {
+			return nil
+		}
+		if err = fn(record); err != nil {
+			return err
+		}
+		w.size += headerSize + int64(len(record))
+	}
+}
+
+// Records calls fn with the records of the log in order.
+func (w *WAL) Records(fn func([]byte) error) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.read(fn)
+}
+
+// Append appends the records to the log and syncs it to disk.
+func (w *WAL) Append(records ...[]byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	bz := encode(records)
+	if _, err := w.file.WriteAt(bz, w.size); err != nil {
+		return err
+	}
+	if err := w.file.Sync(); err != nil {
+		return err
+	}
+	w.size += int64(len(bz))
+	return nil
+}
+
+// Reset atomically replaces the records of the log with the given ones.
+func (w *WAL) Reset(records ...[]byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	bz := encode(records)
+	tmp := w.path + ".tmp"
+	if err := writeFileSync(tmp, bz); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, w.path); err != nil {
+		return err
+	}
+	if err := syncDir(filepath.Dir(w.path)); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(w.path, os.O_RDWR, 0o600)
+	if err != nil {
+		return err
+	}
+	if err = w.file.Close(); err != nil {
+		return errors.Join(err, file.Close())
+	}
+	w.file, w.size = file, int64(len(bz))
+	return nil
+}
+
+// Close closes the log.
+func (w *WAL) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.file.Close()
+}
+
+// encode returns the records with their headers.
+func encode(records [][]byte) []byte {
+	var bz []byte
+	for _, record := range records {
+		//#nosec:G115 // records are far smaller than 4GiB.
+		bz = binary.BigEndian.AppendUint32(bz, uint32(len(record)))
+		bz = binary.BigEndian.AppendUint32(
+			bz, crc32.Checksum(record, castagnoli),
+		)
+		bz = append(bz, record...)
+	}
+	return bz
+}
+
+// ignoreTruncated returns nil if err results from the log ending before a
+// record does.
+func ignoreTruncated(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil
+	}
+	return err
+}
+
+// writeFileSync writes the file at the given path and syncs it to disk.
+func writeFileSync(path string, bz []byte) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err = file.Write(bz); err != nil {
+		return errors.Join(err, file.Close())
+	}
+	return errors.Join(file.Sync(), file.Close())
+}
+
+// syncDir syncs the directory at the given path to disk, persisting the
+// files renamed in it.
+func syncDir(path string) error {
+	dir, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	return errors.Join(dir.Sync(), dir.Close())
+}
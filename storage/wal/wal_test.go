@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package wal_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/storage/wal"
+	"github.com/stretchr/testify/require"
+)
+
+func records(t *testing.T, w *wal.WAL) []string {
+	t.Helper()
+	var got []string
+	require.NoError(t, w.Records(func(record []byte) error {
+		got = append(got, string(record))
+		return nil
+	}))
+	return got
+}
+
+func TestWAL(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.wal")
+	w, err := wal.Open(path)
+	require.NoError(t, err)
+	require.Empty(t, records(t, w))
+
+	require.NoError(t, w.Append([]byte("a"), []byte("bb")))
+	require.NoError(t, w.Append([]byte("")))
+	require.NoError(t, w.Append([]byte("ccc")))
+	require.Equal(t, []string{"a", "bb", "", "ccc"}, records(t, w))
+	require.NoError(t, w.Close())
+
+	w, err = wal.Open(path)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "bb", "", "ccc"}, records(t, w))
+
+	require.NoError(t, w.Reset([]byte("d")))
+	require.NoError(t, w.Append([]byte("e")))
+	require.Equal(t, []string{"d", "e"}, records(t, w))
+	require.NoError(t, w.Close())
+
+	w, err = wal.Open(path)
+	require.NoError(t, err)
+	require.Equal(t, []string{"d", "e"}, records(t, w))
+	require.NoError(t, w.Close())
+}
+
+func TestWALDropsTornRecords(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.wal")
+	w, err := wal.Open(path)
+	require.NoError(t, err)
+	require.NoError(t, w.Append([]byte("first"), []byte("second")))
+	require.NoError(t, w.Close())
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	// A crash may leave the last record partially written, or with its
+	// header written but not its data.
+	for _, torn := range [][]byte{
+		bz[:len(bz)-1],
+		append(bz[:len(bz)-len("second")], make([]byte, len("second"))...),
+	} {
+		require.NoError(t, os.WriteFile(path, torn, 0o600))
+		w, err = wal.Open(path)
+		require.NoError(t, err)
+		require.Equal(t, []string{"first"}, records(t, w))
+
+		// Records appended after the torn one are kept.
+		require.NoError(t, w.Append([]byte("third")))
+		require.NoError(t, w.Close())
+		w, err = wal.Open(path)
+		require.NoError(t, err)
+		require.Equal(t, []string{"first", "third"}, records(t, w))
+		require.NoError(t, w.Close())
+	}
+}